@@ -171,6 +171,9 @@ controllers:
     deletionGracePeriodShootPurposes:
 {{ toYaml .Values.config.controllers.backupEntry.deletionGracePeriodShootPurposes | indent 4 }}
     {{- end }}
+    {{- if .Values.config.controllers.backupEntry.replicationSyncPeriod }}
+    replicationSyncPeriod: {{ .Values.config.controllers.backupEntry.replicationSyncPeriod }}
+    {{- end }}
   bastion:
     concurrentSyncs: {{ required ".Values.config.controllers.bastion.concurrentSyncs is required" .Values.config.controllers.bastion.concurrentSyncs }}
   {{- if .Values.config.controllers.controllerInstallation }}
@@ -340,6 +343,10 @@ etcdConfig:
 exposureClassHandlers:
 {{ toYaml .Values.config.exposureClassHandlers }}
 {{- end }}
+{{- if .Values.config.imageRewriteRules }}
+imageRewriteRules:
+{{ toYaml .Values.config.imageRewriteRules }}
+{{- end }}
 {{- if .Values.nodeToleration }}
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
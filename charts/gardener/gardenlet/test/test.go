@@ -168,10 +168,18 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Verbs:     []string{"get", "list", "watch", "patch", "update"},
 			},
 			{
-				APIGroups:     []string{""},
-				Resources:     []string{"persistentvolumeclaims"},
-				ResourceNames: []string{"vali-vali-0"},
-				Verbs:         []string{"delete"},
+				APIGroups: []string{""},
+				Resources: []string{"persistentvolumeclaims"},
+				ResourceNames: []string{
+					"vali-vali-0",
+					"main-etcd-etcd-main-0",
+					"main-etcd-etcd-main-1",
+					"main-etcd-etcd-main-2",
+					"etcd-events-etcd-events-0",
+					"etcd-events-etcd-events-1",
+					"etcd-events-etcd-events-2",
+				},
+				Verbs: []string{"delete"},
 			},
 			{
 				APIGroups: []string{"admissionregistration.k8s.io"},
@@ -339,6 +347,16 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Resources: []string{"servicemonitors", "scrapeconfigs", "prometheusrules"},
 				Verbs:     []string{"list", "watch", "get", "create", "patch", "update", "delete"},
 			},
+			{
+				APIGroups: []string{"authentication.k8s.io"},
+				Resources: []string{"tokenreviews"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{"authorization.k8s.io"},
+				Resources: []string{"subjectaccessreviews"},
+				Verbs:     []string{"create"},
+			},
 		},
 	}
 }
@@ -645,6 +663,7 @@ func ComputeExpectedGardenletConfiguration(
 			BackupEntry: &gardenletv1alpha1.BackupEntryControllerConfiguration{
 				ConcurrentSyncs:          &twenty,
 				DeletionGracePeriodHours: &zero,
+				ReplicationSyncPeriod:    &metav1.Duration{Duration: 30 * time.Minute},
 			},
 			Bastion: &gardenletv1alpha1.BastionControllerConfiguration{
 				ConcurrentSyncs: &twenty,
@@ -126,6 +126,10 @@ config.yaml: |
       concurrentSyncs: {{ .Values.config.controllers.vpaEvictionRequirements.concurrentSyncs }}
       {{- end }}
     {{- end }}
+  {{- if .Values.config.imageRewriteRules }}
+  imageRewriteRules:
+{{ toYaml .Values.config.imageRewriteRules | indent 4 }}
+  {{- end }}
   {{- if .Values.nodeToleration }}
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
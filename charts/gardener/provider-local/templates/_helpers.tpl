@@ -17,3 +17,7 @@ app.kubernetes.io/instance: {{ .Release.Name }}
 {{- define "poddisruptionbudgetversion" -}}
 policy/v1
 {{- end -}}
+
+{{- define "imageRewriteRules" -}}
+{{- .Values.imageRewriteRules | default (dig "gardener" "gardenlet" "imageRewriteRules" list .Values.AsMap) | toYaml }}
+{{- end -}}
@@ -8,9 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"net/http"
 	"os"
-	goruntime "runtime"
 	"strconv"
 	"time"
 
@@ -27,8 +25,8 @@ import (
 	"github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
-	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 // Name is a const for the name of this component.
@@ -43,11 +41,11 @@ func NewCommand() *cobra.Command {
 		Short: "Launch the " + Name,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			log, err := utils.InitRun(cmd, opts, Name)
+			log, logLevels, err := utils.InitRun(cmd, opts, Name)
 			if err != nil {
 				return err
 			}
-			return run(cmd.Context(), log, opts.config)
+			return run(cmd.Context(), log, logLevels, opts.config)
 		},
 	}
 
@@ -58,7 +56,7 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-func run(ctx context.Context, log logr.Logger, cfg *config.AdmissionControllerConfiguration) error {
+func run(ctx context.Context, log logr.Logger, logLevels *logger.Levels, cfg *config.AdmissionControllerConfiguration) error {
 	log.Info("Getting rest config")
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.GardenClientConnection.Kubeconfig = kubeconfig
@@ -69,12 +67,9 @@ func run(ctx context.Context, log logr.Logger, cfg *config.AdmissionControllerCo
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
-	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
-		if cfg.Debugging.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
+	extraHandlers, err := utils.MetricsServerExtraHandlers(restConfig, cfg.Debugging, logLevels)
+	if err != nil {
+		return err
 	}
 
 	log.Info("Setting up manager")
@@ -63,6 +63,7 @@ import (
 	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/workloadidentity"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
@@ -375,6 +376,17 @@ func (o *Options) Run(ctx context.Context) error {
 		return err
 	}
 
+	if signer, ok := config.ExtraConfig.WorkloadIdentitySigningKey.(*workloadidentity.RemoteSigner); ok {
+		if err := server.GenericAPIServer.AddPostStartHook("start-workload-identity-remote-signer-refresh", func(_ genericapiserver.PostStartHookContext) error {
+			go signer.Start(ctx, config.ExtraConfig.WorkloadIdentitySignerRefresh, func(err error) {
+				log.Error(err, "Failed refreshing public keys of workload identity remote signer")
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
 	return server.GenericAPIServer.PrepareRun().Run(ctx.Done())
 }
 
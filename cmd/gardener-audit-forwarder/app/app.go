@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/component-base/version/verflag"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/auditforwarder/apis/config"
+	"github.com/gardener/gardener/pkg/auditforwarder/backend"
+	"github.com/gardener/gardener/pkg/auditforwarder/filter"
+	"github.com/gardener/gardener/pkg/auditforwarder/queue"
+	"github.com/gardener/gardener/pkg/auditforwarder/receiver"
+)
+
+const (
+	// Name is a const for the name of this component.
+	Name = "gardener-audit-forwarder"
+
+	// drainPeriod is the period for which the forwarder keeps receiving and delivering audit events after it was asked to
+	// terminate. The kube-apiserver in the same pod sends its last audit events while it is shutting down.
+	drainPeriod = 20 * time.Second
+
+	// logBackendMaxBatchEvents and logBackendMaxWait are the batch settings of the log backend. Writing to the standard
+	// output does not benefit from large batches, but committing the position of the reader for every event would.
+	logBackendMaxBatchEvents = 100
+	logBackendMaxWait        = time.Second
+)
+
+// NewCommand creates a new cobra.Command for running gardener-audit-forwarder.
+func NewCommand() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: "Launch the " + Name,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			log, _, err := utils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), log, opts.config)
+		},
+	}
+
+	flags := cmd.Flags()
+	verflag.AddFlags(flags)
+	opts.addFlags(flags)
+
+	return cmd
+}
+
+func run(ctx context.Context, log logr.Logger, cfg *config.AuditForwarderConfiguration) error {
+	var readerNames []string
+	if cfg.Backends.Log != nil {
+		readerNames = append(readerNames, "log")
+	}
+	if cfg.Backends.Webhook != nil {
+		readerNames = append(readerNames, "webhook")
+	}
+
+	log.Info("Opening queue", "directory", cfg.Queue.Directory)
+	q, err := queue.Open(cfg.Queue.Directory, cfg.Queue.MaxSize.Value(), queue.DefaultSegmentSize, readerNames...)
+	if err != nil {
+		return fmt.Errorf("failed opening queue: %w", err)
+	}
+	defer func() {
+		if err := q.Close(); err != nil {
+			log.Error(err, "Failed closing queue")
+		}
+	}()
+
+	var backends []*backend.Backend
+	if cfg.Backends.Log != nil {
+		b, err := newLogBackend(log, q, cfg.Backends.Log)
+		if err != nil {
+			return fmt.Errorf("failed creating log backend: %w", err)
+		}
+		backends = append(backends, b)
+	}
+	if cfg.Backends.Webhook != nil {
+		b, err := newWebhookBackend(log, q, cfg.Backends.Webhook)
+		if err != nil {
+			return fmt.Errorf("failed creating webhook backend: %w", err)
+		}
+		backends = append(backends, b)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(receiver.Path, &receiver.Handler{Queue: q, Log: log.WithName("receiver")})
+	servers := []*http.Server{{
+		Addr:              net.JoinHostPort(cfg.Server.Events.BindAddress, strconv.Itoa(cfg.Server.Events.Port)),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}}
+
+	if cfg.Server.HealthProbes != nil {
+		healthMux := http.NewServeMux()
+		healthMux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+		healthMux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+		servers = append(servers, &http.Server{
+			Addr:              net.JoinHostPort(cfg.Server.HealthProbes.BindAddress, strconv.Itoa(cfg.Server.HealthProbes.Port)),
+			Handler:           healthMux,
+			ReadHeaderTimeout: 10 * time.Second,
+		})
+	}
+
+	// The backends and servers are not stopped with the given context but only after the drain period, see drainPeriod.
+	runCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		errCh = make(chan error, len(backends)+len(servers))
+	)
+
+	for _, b := range backends {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Info("Starting backend", "backend", b.Name)
+			if err := b.Run(runCtx); err != nil {
+				errCh <- fmt.Errorf("backend %s failed: %w", b.Name, err)
+			}
+		}()
+	}
+
+	for _, server := range servers {
+		go func() {
+			log.Info("Starting server", "address", server.Addr)
+			if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				errCh <- fmt.Errorf("server %s failed: %w", server.Addr, err)
+			}
+		}()
+	}
+
+	var runErr error
+	select {
+	case runErr = <-errCh:
+	case <-ctx.Done():
+		log.Info("Draining audit events before terminating", "drainPeriod", drainPeriod)
+		select {
+		case runErr = <-errCh:
+		case <-time.After(drainPeriod):
+		}
+	}
+
+	log.Info("Stopping servers and backends")
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	for _, server := range servers {
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error(err, "Failed shutting down server", "address", server.Addr)
+		}
+	}
+
+	cancel()
+	wg.Wait()
+
+	for _, b := range backends {
+		if err := b.Reader.Close(); err != nil {
+			log.Error(err, "Failed closing queue reader", "backend", b.Name)
+		}
+	}
+
+	return runErr
+}
+
+func newLogBackend(log logr.Logger, q *queue.Queue, cfg *config.LogBackendConfiguration) (*backend.Backend, error) {
+	reader, err := q.Reader("log")
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := newFilter(cfg.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &backend.Backend{
+		Name:           "log",
+		Reader:         reader,
+		Filter:         f,
+		Sink:           &backend.LogSink{Writer: os.Stdout},
+		Clock:          clock.RealClock{},
+		Log:            log.WithName("backend"),
+		MaxBatchEvents: logBackendMaxBatchEvents,
+		MaxWait:        logBackendMaxWait,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}, nil
+}
+
+func newWebhookBackend(log logr.Logger, q *queue.Queue, cfg *config.WebhookBackendConfiguration) (*backend.Backend, error) {
+	reader, err := q.Reader("webhook")
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := newFilter(cfg.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CAFile != nil {
+		ca, err := os.ReadFile(*cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading CA file: %w", err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("CA file does not contain any certificate")
+		}
+	}
+
+	sink := &backend.WebhookSink{
+		URL: cfg.URL,
+		Client: &http.Client{
+			Timeout:   cfg.Timeout.Duration,
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+		},
+		TokenFile: ptr.Deref(cfg.TokenFile, ""),
+	}
+
+	if cfg.SigningKeyFile != nil {
+		key, err := os.ReadFile(*cfg.SigningKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading signing key file: %w", err)
+		}
+
+		if sink.Signer, err = backend.NewSigner(key); err != nil {
+			return nil, err
+		}
+	}
+
+	b := &backend.Backend{
+		Name:           "webhook",
+		Reader:         reader,
+		Filter:         f,
+		Sink:           sink,
+		Clock:          clock.RealClock{},
+		Log:            log.WithName("backend"),
+		MaxBatchEvents: int(*cfg.Batch.MaxSize),
+		MaxWait:        cfg.Batch.MaxWait.Duration,
+		InitialBackoff: cfg.Retry.InitialBackoff.Duration,
+		MaxBackoff:     cfg.Retry.MaxBackoff.Duration,
+	}
+
+	if cfg.Truncate != nil {
+		if cfg.Truncate.MaxBatchSize != nil {
+			b.MaxBatchBytes = cfg.Truncate.MaxBatchSize.Value()
+		}
+		if cfg.Truncate.MaxEventSize != nil {
+			b.MaxEventBytes = cfg.Truncate.MaxEventSize.Value()
+		}
+	}
+
+	return b, nil
+}
+
+func newFilter(policyFile *string) (*filter.Filter, error) {
+	if policyFile == nil {
+		return nil, nil
+	}
+	return filter.NewFromFile(*policyFile)
+}
@@ -8,9 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"net/http"
 	"os"
-	goruntime "runtime"
 	"strconv"
 	"time"
 
@@ -31,9 +29,9 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller"
-	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 // Name is a const for the name of this component.
@@ -48,11 +46,11 @@ func NewCommand() *cobra.Command {
 		Short: "Launch the " + Name,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			log, err := utils.InitRun(cmd, opts, Name)
+			log, logLevels, err := utils.InitRun(cmd, opts, Name)
 			if err != nil {
 				return err
 			}
-			return run(cmd.Context(), log, opts.config)
+			return run(cmd.Context(), log, logLevels, opts.config)
 		},
 	}
 
@@ -63,7 +61,7 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-func run(ctx context.Context, log logr.Logger, cfg *config.ControllerManagerConfiguration) error {
+func run(ctx context.Context, log logr.Logger, logLevels *logger.Levels, cfg *config.ControllerManagerConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
 	// This is like importing the automaxprocs package for its init func (it will in turn call maxprocs.Set).
@@ -85,12 +83,9 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ControllerManagerConf
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
-	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
-		if cfg.Debugging.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
+	extraHandlers, err := utils.MetricsServerExtraHandlers(restConfig, cfg.Debugging, logLevels)
+	if err != nil {
+		return err
 	}
 
 	log.Info("Setting up manager")
@@ -9,10 +9,8 @@ import (
 	"fmt"
 	"io/fs"
 	"net"
-	"net/http"
 	"os"
 	"path/filepath"
-	goruntime "runtime"
 	"strconv"
 	"time"
 
@@ -24,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/utils/ptr"
@@ -39,12 +38,13 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
-	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/nodeagent"
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
@@ -63,12 +63,12 @@ func NewCommand() *cobra.Command {
 		Short: "Launch the " + Name,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			log, err := utils.InitRun(cmd, opts, Name)
+			log, logLevels, err := utils.InitRun(cmd, opts, Name)
 			if err != nil {
 				return err
 			}
 			ctx, cancel := context.WithCancel(cmd.Context())
-			return run(ctx, cancel, log, opts.config)
+			return run(ctx, cancel, log, logLevels, opts.config)
 		},
 	}
 
@@ -86,7 +86,7 @@ func getBootstrapCommand(opts *options) *cobra.Command {
 		Short: "Bootstrap the " + Name,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			log, err := utils.InitRun(cmd, opts, "gardener-node-init")
+			log, _, err := utils.InitRun(cmd, opts, "gardener-node-init")
 			if err != nil {
 				return err
 			}
@@ -101,7 +101,7 @@ func getBootstrapCommand(opts *options) *cobra.Command {
 	return bootstrapCmd
 }
 
-func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *config.NodeAgentConfiguration) error {
+func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, logLevels *logger.Levels, cfg *config.NodeAgentConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
@@ -127,19 +127,26 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		}
 
 		if mustFetchAccessToken {
+			tokenRESTConfig := restConfig
+			if cfg.Bootstrap != nil && cfg.Bootstrap.Attestation != nil {
+				log.Info("Proving identity of machine via attestation", "providerType", cfg.Bootstrap.Attestation.ProviderType)
+				tokenRESTConfig, err = requestAttestedClientCertificate(ctx, log, restConfig)
+				if err != nil {
+					return fmt.Errorf("failed requesting attested client certificate: %w", err)
+				}
+			}
+
 			log.Info("Fetching access token")
-			if err := fetchAccessToken(ctx, log, restConfig); err != nil {
+			if err := fetchAccessToken(ctx, log, tokenRESTConfig); err != nil {
 				return fmt.Errorf("failed fetching access token: %w", err)
 			}
+			restConfig.BearerTokenFile = nodeagentv1alpha1.TokenFilePath
 		}
 	}
 
-	var extraHandlers map[string]http.Handler
-	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
-		if cfg.Debugging.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
+	extraHandlers, err := utils.MetricsServerExtraHandlers(restConfig, cfg.Debugging, logLevels)
+	if err != nil {
+		return err
 	}
 
 	log.Info("Fetching hostname")
@@ -284,10 +291,31 @@ func fetchAccessToken(ctx context.Context, log logr.Logger, restConfig *rest.Con
 	}
 
 	log.Info("Token written to disk")
-	restConfig.BearerTokenFile = nodeagentv1alpha1.TokenFilePath
 	return nil
 }
 
+func requestAttestedClientCertificate(ctx context.Context, log logr.Logger, restConfig *rest.Config) (*rest.Config, error) {
+	clientSet, err := kubernetesclientset.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client set with bootstrap token: %w", err)
+	}
+
+	hostName, err := nodeagent.GetHostName()
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching hostname: %w", err)
+	}
+
+	if err := bootstrap.RequestAttestedClientCertificate(ctx, log, afero.Afero{Fs: afero.NewOsFs()}, clientSet, attestation.NewExecAttestor(nodeagentv1alpha1.AttestorFilePath), hostName); err != nil {
+		return nil, err
+	}
+
+	attestedRESTConfig := rest.CopyConfig(restConfig)
+	attestedRESTConfig.BearerTokenFile = ""
+	attestedRESTConfig.CertFile = nodeagentv1alpha1.AttestedClientCertificateFilePath
+	attestedRESTConfig.KeyFile = nodeagentv1alpha1.AttestedClientCertificateFilePath
+	return attestedRESTConfig, nil
+}
+
 func fetchNodeName(ctx context.Context, restConfig *rest.Config, hostName string) (string, error) {
 	c, err := client.New(restConfig, client.Options{})
 	if err != nil {
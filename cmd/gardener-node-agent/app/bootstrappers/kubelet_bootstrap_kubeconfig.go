@@ -64,10 +64,23 @@ func (k *KubeletBootstrapKubeconfig) Start(_ context.Context) error {
 		return fmt.Errorf("unable to create kubelet directory %q: %w", kubelet.PathKubeletDirectory, err)
 	}
 
+	authInfo := clientcmdv1.AuthInfo{Token: strings.TrimSpace(string(bootstrapToken))}
+	if _, err := k.FS.Stat(nodeagentv1alpha1.AttestedClientCertificateFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed checking whether attested client certificate file %q exists: %w", nodeagentv1alpha1.AttestedClientCertificateFilePath, err)
+	} else if err == nil {
+		// The machine proved its identity via attestation, hence kubelet must use the attested client certificate
+		// instead of the bootstrap token for requesting its own client certificate.
+		k.Log.Info("Using attested client certificate for kubelet bootstrap kubeconfig", "path", nodeagentv1alpha1.AttestedClientCertificateFilePath)
+		authInfo = clientcmdv1.AuthInfo{
+			ClientCertificate: nodeagentv1alpha1.AttestedClientCertificateFilePath,
+			ClientKey:         nodeagentv1alpha1.AttestedClientCertificateFilePath,
+		}
+	}
+
 	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
 		"kubelet-bootstrap",
 		clientcmdv1.Cluster{Server: k.APIServerConfig.Server, CertificateAuthorityData: k.APIServerConfig.CABundle},
-		clientcmdv1.AuthInfo{Token: strings.TrimSpace(string(bootstrapToken))},
+		authInfo,
 	))
 	if err != nil {
 		return fmt.Errorf("unable to encode kubeconfig: %w", err)
@@ -112,6 +112,36 @@ users:
 				test.AssertDirectoryOnDisk(fakeFS, pathKubeletDirectory)
 				test.AssertFileOnDisk(fakeFS, pathKubeletBootstrapKubeconfigFile, expectedBootstrapKubeconfig, 0600)
 			})
+
+			It("should create the bootstrap kubeconfig file with the attested client certificate", func() {
+				pathAttestedClientCertFile := filepath.Join("/", "var", "lib", "gardener-node-agent", "credentials", "attested-client.pem")
+				Expect(fakeFS.WriteFile(pathAttestedClientCertFile, []byte("cert-and-key"), 0600)).To(Succeed())
+
+				expectedBootstrapKubeconfig := `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: ` + utils.EncodeBase64(apiServerConfig.CABundle) + `
+    server: https://` + apiServerConfig.Server + `
+  name: kubelet-bootstrap
+contexts:
+- context:
+    cluster: kubelet-bootstrap
+    user: kubelet-bootstrap
+  name: kubelet-bootstrap
+current-context: kubelet-bootstrap
+kind: Config
+preferences: {}
+users:
+- name: kubelet-bootstrap
+  user:
+    client-certificate: ` + pathAttestedClientCertFile + `
+    client-key: ` + pathAttestedClientCertFile + `
+`
+
+				Expect(runnable.Start(ctx)).To(Succeed())
+
+				test.AssertFileOnDisk(fakeFS, pathKubeletBootstrapKubeconfigFile, expectedBootstrapKubeconfig, 0600)
+			})
 		})
 	})
 })
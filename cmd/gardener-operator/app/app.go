@@ -8,9 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"net/http"
 	"os"
-	goruntime "runtime"
 	"strconv"
 	"time"
 
@@ -33,14 +31,16 @@ import (
 	"github.com/gardener/gardener/cmd/utils"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/certificates"
+	"github.com/gardener/gardener/imagevector"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
 	"github.com/gardener/gardener/pkg/controllerutils"
-	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
+	"github.com/gardener/gardener/pkg/operator/apis/config/helper"
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
@@ -58,11 +58,11 @@ func NewCommand() *cobra.Command {
 		Short: "Launch the " + Name,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			log, err := utils.InitRun(cmd, opts, Name)
+			log, logLevels, err := utils.InitRun(cmd, opts, Name)
 			if err != nil {
 				return err
 			}
-			return run(cmd.Context(), log, opts.config)
+			return run(cmd.Context(), log, logLevels, opts.config)
 		},
 	}
 
@@ -73,9 +73,14 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-func run(ctx context.Context, log logr.Logger, cfg *config.OperatorConfiguration) error {
+func run(ctx context.Context, log logr.Logger, logLevels *logger.Levels, cfg *config.OperatorConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if rules := helper.ImageRewriteRules(cfg); len(rules) > 0 {
+		log.Info("Applying image rewrite rules", "rules", rules)
+		imagevector.ApplyRewriteRules(rules)
+	}
+
 	log.Info("Getting rest config")
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.RuntimeClientConnection.Kubeconfig = kubeconfig
@@ -86,12 +91,9 @@ func run(ctx context.Context, log logr.Logger, cfg *config.OperatorConfiguration
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
-	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
-		if cfg.Debugging.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
+	extraHandlers, err := utils.MetricsServerExtraHandlers(restConfig, cfg.Debugging, logLevels)
+	if err != nil {
+		return err
 	}
 
 	log.Info("Setting up manager")
@@ -8,9 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"net/http"
 	"os"
-	goruntime "runtime"
 	"strconv"
 	"time"
 
@@ -38,8 +36,8 @@ import (
 	"github.com/gardener/gardener/pkg/api/indexer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
-	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller"
@@ -58,11 +56,11 @@ func NewCommand() *cobra.Command {
 		Short: "Launch the " + Name,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			log, err := utils.InitRun(cmd, opts, Name)
+			log, logLevels, err := utils.InitRun(cmd, opts, Name)
 			if err != nil {
 				return err
 			}
-			return run(cmd.Context(), log, opts.config)
+			return run(cmd.Context(), log, logLevels, opts.config)
 		},
 	}
 
@@ -73,7 +71,7 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-func run(ctx context.Context, log logr.Logger, cfg *config.ResourceManagerConfiguration) error {
+func run(ctx context.Context, log logr.Logger, logLevels *logger.Levels, cfg *config.ResourceManagerConfiguration) error {
 	log.Info("Getting rest configs")
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.SourceClientConnection.Kubeconfig = kubeconfig
@@ -103,12 +101,9 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ResourceManagerConfig
 		managerScheme = resourcemanagerclient.SourceScheme
 	}
 
-	var extraHandlers map[string]http.Handler
-	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
-		if cfg.Debugging.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
+	extraHandlers, err := utils.MetricsServerExtraHandlers(sourceRESTConfig, cfg.Debugging, logLevels)
+	if err != nil {
+		return err
 	}
 
 	log.Info("Setting up manager")
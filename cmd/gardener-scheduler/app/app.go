@@ -8,9 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"net/http"
 	"os"
-	goruntime "runtime"
 	"strconv"
 	"time"
 
@@ -31,9 +29,9 @@ import (
 	cmdutils "github.com/gardener/gardener/cmd/utils"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
-	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 	"github.com/gardener/gardener/pkg/scheduler/controller"
 	"github.com/gardener/gardener/pkg/utils"
@@ -51,11 +49,11 @@ func NewCommand() *cobra.Command {
 		Short: "Launch the " + Name,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			log, err := cmdutils.InitRun(cmd, opts, Name)
+			log, logLevels, err := cmdutils.InitRun(cmd, opts, Name)
 			if err != nil {
 				return err
 			}
-			return run(cmd.Context(), log, opts.config)
+			return run(cmd.Context(), log, logLevels, opts.config)
 		},
 	}
 
@@ -66,7 +64,7 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguration) error {
+func run(ctx context.Context, log logr.Logger, logLevels *logger.Levels, cfg *config.SchedulerConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
 	log.Info("Getting rest config")
@@ -79,12 +77,9 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
-	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
-		if cfg.Debugging.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
+	extraHandlers, err := cmdutils.MetricsServerExtraHandlers(restCfg, cfg.Debugging, logLevels)
+	if err != nil {
+		return err
 	}
 
 	log.Info("Setting up manager")
@@ -6,15 +6,22 @@ package utils
 
 import (
 	"fmt"
+	"maps"
+	"net/http"
+	goruntime "runtime"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+	componentbaseconfig "k8s.io/component-base/config"
 	"k8s.io/component-base/version"
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/klog/v2"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 
+	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/logger"
 )
 
@@ -29,22 +36,23 @@ type Options interface {
 }
 
 // InitRun initializes the run command by completing and validating the options, creating and settings a logger,
-// printing all command line flags, and configuring command settings.
-func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error) {
+// printing all command line flags, and configuring command settings. It returns the logger and its levels which can be
+// changed at runtime.
+func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, *logger.Levels, error) {
 	verflag.PrintAndExitIfRequested()
 
 	if err := opts.Complete(); err != nil {
-		return logr.Discard(), err
+		return logr.Discard(), nil, err
 	}
 
 	if err := opts.Validate(); err != nil {
-		return logr.Discard(), err
+		return logr.Discard(), nil, err
 	}
 
 	logLevel, logFormat := opts.LogConfig()
-	log, err := logger.NewZapLogger(logLevel, logFormat)
+	log, levels, err := logger.NewZapLoggerWithLevels(logLevel, logFormat)
 	if err != nil {
-		return logr.Discard(), fmt.Errorf("error instantiating zap logger: %w", err)
+		return logr.Discard(), nil, fmt.Errorf("error instantiating zap logger: %w", err)
 	}
 
 	logf.SetLogger(log)
@@ -60,5 +68,47 @@ func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error)
 	// further errors will be logged properly, don't duplicate
 	cmd.SilenceErrors = true
 
-	return log, nil
+	return log, levels, nil
+}
+
+// MetricsServerExtraHandlers returns the extra handlers for the metrics server of a component. The handlers are only
+// served if profiling is enabled in the given debugging configuration. Besides the profiling handlers, this includes the
+// handler for changing the given log levels at runtime (see logger.LevelsHandlerPath) if they are not nil. Since the
+// latter changes the behaviour of the component, its requests are authenticated and authorized via TokenReviews and
+// SubjectAccessReviews in the cluster of the given rest config.
+func MetricsServerExtraHandlers(restConfig *rest.Config, debugging *componentbaseconfig.DebuggingConfiguration, logLevels *logger.Levels) (map[string]http.Handler, error) {
+	extraHandlers := make(map[string]http.Handler)
+
+	if debugging == nil || !debugging.EnableProfiling {
+		return extraHandlers, nil
+	}
+
+	maps.Copy(extraHandlers, routes.ProfilingHandlers)
+	if debugging.EnableContentionProfiling {
+		goruntime.SetBlockProfileRate(1)
+	}
+
+	if logLevels != nil {
+		handler, err := withAuthenticationAndAuthorization(restConfig, logLevels)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating handler for changing the log levels: %w", err)
+		}
+		extraHandlers[logger.LevelsHandlerPath] = handler
+	}
+
+	return extraHandlers, nil
+}
+
+func withAuthenticationAndAuthorization(restConfig *rest.Config, handler http.Handler) (http.Handler, error) {
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := filters.WithAuthenticationAndAuthorization(restConfig, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter(logf.Log.WithName("log-level-handler"), handler)
 }
@@ -46,6 +46,11 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, be *extension
 }
 
 func (a *actuator) deployEtcdBackupSecret(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	if strings.HasPrefix(be.Name, v1beta1constants.BackupBastionSessionsPrefix+"-") {
+		// BackupEntries for bastion session recordings are not used by etcd.
+		return nil
+	}
+
 	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(be.Name)
 
 	namespace := &corev1.Namespace{}
@@ -120,6 +125,9 @@ func emptyEtcdBackupSecret(backupEntryName string) *corev1.Secret {
 	if strings.HasPrefix(backupEntryName, v1beta1constants.BackupSourcePrefix) {
 		secretName = fmt.Sprintf("%s-%s", v1beta1constants.BackupSourcePrefix, v1beta1constants.BackupSecretName)
 	}
+	if strings.HasPrefix(backupEntryName, v1beta1constants.BackupReplicaPrefix+"-") {
+		secretName = fmt.Sprintf("%s-%s", v1beta1constants.BackupReplicaPrefix, v1beta1constants.BackupSecretName)
+	}
 	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(backupEntryName)
 
 	return &corev1.Secret{
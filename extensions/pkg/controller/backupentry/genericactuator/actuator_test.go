@@ -148,6 +148,47 @@ var _ = Describe("Actuator", func() {
 			})
 		})
 
+		Context("bastion sessions backupentry", func() {
+			It("should not create etcd-backup secret", func() {
+				backupEntry.Name = "bastion-sessions-" + shootTechnicalID + "--" + shootUID
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(seedNamespace, backupEntrySecret).Build()
+				mgr.EXPECT().GetClient().Return(fakeClient)
+
+				a = genericactuator.NewActuator(mgr, backupEntryDelegate)
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, etcdBackupSecretKey, &corev1.Secret{})).To(BeNotFoundError())
+			})
+		})
+
+		Context("replica backupentry", func() {
+			It("should create replica-etcd-backup secret", func() {
+				backupEntry.Name = "replica-" + shootTechnicalID + "--" + shootUID
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(seedNamespace, backupEntrySecret).Build()
+				mgr.EXPECT().GetClient().Return(fakeClient)
+				backupEntryDelegate.EXPECT().GetETCDSecretData(ctx, gomock.AssignableToTypeOf(logr.Logger{}), backupEntry, backupProviderSecretData).Return(etcdBackupSecretData, nil)
+
+				a = genericactuator.NewActuator(mgr, backupEntryDelegate)
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: shootTechnicalID, Name: "replica-etcd-backup"}, &corev1.Secret{})).To(Succeed())
+				Expect(fakeClient.Get(ctx, etcdBackupSecretKey, &corev1.Secret{})).To(BeNotFoundError())
+			})
+
+			It("should not treat backupentries which only start with the replica prefix as replicas", func() {
+				seedNamespace.Name = "replicas" + shootTechnicalID
+				backupEntry.Name = "replicas" + shootTechnicalID + "--" + shootUID
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(seedNamespace, backupEntrySecret).Build()
+				mgr.EXPECT().GetClient().Return(fakeClient)
+				backupEntryDelegate.EXPECT().GetETCDSecretData(ctx, gomock.AssignableToTypeOf(logr.Logger{}), backupEntry, backupProviderSecretData).Return(etcdBackupSecretData, nil)
+
+				a = genericactuator.NewActuator(mgr, backupEntryDelegate)
+				Expect(a.Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: seedNamespace.Name, Name: "etcd-backup"}, &corev1.Secret{})).To(Succeed())
+			})
+		})
+
 		Context("seed namespace does not exist", func() {
 			It("should not create etcd-backup secret", func() {
 				fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(backupEntrySecret).Build()
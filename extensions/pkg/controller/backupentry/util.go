@@ -14,6 +14,7 @@ import (
 // ExtractShootDetailsFromBackupEntryName returns Shoot resource technicalID its UID from provided <backupEntryName>.
 func ExtractShootDetailsFromBackupEntryName(backupEntryName string) (shootTechnicalID, shootUID string) {
 	backupEntryName = strings.TrimPrefix(backupEntryName, fmt.Sprintf("%s-", v1beta1constants.BackupSourcePrefix))
+	backupEntryName = strings.TrimPrefix(backupEntryName, fmt.Sprintf("%s-", v1beta1constants.BackupReplicaPrefix))
 	tokens := strings.Split(backupEntryName, "--")
 	shootUID = tokens[len(tokens)-1]
 	shootTechnicalID = strings.TrimSuffix(backupEntryName, "--"+shootUID)
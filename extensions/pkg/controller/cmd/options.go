@@ -24,6 +24,7 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 const (
@@ -64,6 +65,10 @@ const (
 	// GardenerVersionFlag is the name of the command line flag containing the Gardener version.
 	GardenerVersionFlag = "gardener-version"
 
+	// ImageRewriteRulesFileFlag is the name of the command line flag to specify the file containing the rewrite rules
+	// for the images of the extension's image vector.
+	ImageRewriteRulesFileFlag = "image-rewrite-rules-file"
+
 	// LogLevelFlag is the name of the command line flag containing the log level.
 	LogLevelFlag = "log-level"
 
@@ -460,6 +465,8 @@ type SwitchConfig struct {
 type GeneralOptions struct {
 	// GardenerVersion is the version of the Gardener.
 	GardenerVersion string
+	// ImageRewriteRulesFile is the path to the file containing the image rewrite rules.
+	ImageRewriteRulesFile string
 
 	config *GeneralConfig
 }
@@ -468,11 +475,22 @@ type GeneralOptions struct {
 type GeneralConfig struct {
 	// GardenerVersion is the version of the Gardener.
 	GardenerVersion string
+	// ImageRewriteRules are the rewrite rules for the images of the extension's image vector.
+	ImageRewriteRules []imagevector.RewriteRule
 }
 
 // Complete implements Complete.
 func (r *GeneralOptions) Complete() error {
-	r.config = &GeneralConfig{r.GardenerVersion}
+	r.config = &GeneralConfig{GardenerVersion: r.GardenerVersion}
+
+	if r.ImageRewriteRulesFile != "" {
+		rules, err := imagevector.ReadRewriteRulesFile(r.ImageRewriteRulesFile)
+		if err != nil {
+			return fmt.Errorf("failed reading image rewrite rules: %w", err)
+		}
+		r.config.ImageRewriteRules = rules
+	}
+
 	return nil
 }
 
@@ -484,4 +502,5 @@ func (r *GeneralOptions) Completed() *GeneralConfig {
 // AddFlags implements Flagger.AddFlags.
 func (r *GeneralOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&r.GardenerVersion, GardenerVersionFlag, "", "Version of the gardenlet.")
+	fs.StringVar(&r.ImageRewriteRulesFile, ImageRewriteRulesFileFlag, "", "Path to a file containing the rewrite rules for the images of the extension.")
 }
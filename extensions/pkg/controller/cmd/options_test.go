@@ -7,6 +7,8 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -22,6 +24,7 @@ import (
 
 	extensionsmockcmd "github.com/gardener/gardener/extensions/pkg/controller/cmd/mock"
 	extensionsmockcontroller "github.com/gardener/gardener/extensions/pkg/controller/mock"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
 
@@ -764,6 +767,12 @@ var _ = Describe("Options", func() {
 
 				Expect(opts.Complete()).NotTo(HaveOccurred())
 			})
+
+			It("should fail if the image rewrite rules file does not exist", func() {
+				opts := GeneralOptions{ImageRewriteRulesFile: "/does/not/exist"}
+
+				Expect(opts.Complete()).To(MatchError(ContainSubstring("failed reading image rewrite rules")))
+			})
 		})
 
 		Describe("#Completed", func() {
@@ -777,6 +786,26 @@ var _ = Describe("Options", func() {
 					GardenerVersion: gardenerVersion,
 				}))
 			})
+
+			It("should yield the image rewrite rules read from the file", func() {
+				rewriteRulesFile := filepath.Join(GinkgoT().TempDir(), "rewrite_rules.yaml")
+				Expect(os.WriteFile(rewriteRulesFile, []byte(`
+rewriteRules:
+- prefix: registry.k8s.io
+  mirror: registry.example.com/k8s
+`), 0600)).To(Succeed())
+
+				opts := GeneralOptions{
+					GardenerVersion:       gardenerVersion,
+					ImageRewriteRulesFile: rewriteRulesFile,
+				}
+
+				Expect(opts.Complete()).NotTo(HaveOccurred())
+				Expect(opts.Completed()).To(Equal(&GeneralConfig{
+					GardenerVersion:   gardenerVersion,
+					ImageRewriteRules: []imagevector.RewriteRule{{Prefix: "registry.k8s.io", Mirror: "registry.example.com/k8s"}},
+				}))
+			})
 		})
 	})
 })
@@ -23,6 +23,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 // reconciler reconciles ContainerRuntime resources of Gardener's
@@ -62,6 +63,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
 	}
 
+	ctx, log = logger.WithOperationIDFromObject(ctx, log, cr)
+
 	cluster, err := extensionscontroller.GetCluster(ctx, r.client, cr.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
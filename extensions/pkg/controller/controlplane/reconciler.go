@@ -24,6 +24,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 // RequeueAfter is the duration to requeue a controlplane reconciliation if indicated by the actuator.
@@ -63,6 +64,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
 	}
 
+	ctx, log = logger.WithOperationIDFromObject(ctx, log, cp)
+
 	cluster, err := extensionscontroller.GetCluster(ctx, r.client, cp.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -25,6 +25,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 // reconciler reconciles OperatingSystemConfig resources of Gardener's `extensions.gardener.cloud`
@@ -67,6 +68,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
 	}
 
+	ctx, log = logger.WithOperationIDFromObject(ctx, log, osc)
+
 	cluster, err := extensionscontroller.GetCluster(ctx, r.client, osc.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -6,6 +6,7 @@ package genericactuator
 
 import (
 	"context"
+	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -16,10 +17,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
@@ -300,4 +304,24 @@ var _ = Describe("Actuator", func() {
 			Expect(restoreMachineSetsAndMachines(ctx, logger, a.seedClient, machineDeployments)).To(Succeed())
 		})
 	})
+
+	Describe("#ReadMachineConfiguration", func() {
+		var pool extensionsv1alpha1.WorkerPool
+
+		BeforeEach(func() {
+			pool = extensionsv1alpha1.WorkerPool{
+				MachineControllerManagerSettings: &gardencorev1beta1.MachineControllerManagerSettings{
+					MachineHealthTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+					NodeConditions:       []string{"KernelDeadlock", "ReadonlyFilesystem"},
+				},
+			}
+		})
+
+		It("should return the machine-controller-manager settings of the worker pool", func() {
+			Expect(ReadMachineConfiguration(pool)).To(Equal(&machinev1alpha1.MachineConfiguration{
+				MachineHealthTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+				NodeConditions:       ptr.To("KernelDeadlock,ReadonlyFilesystem"),
+			}))
+		})
+	})
 })
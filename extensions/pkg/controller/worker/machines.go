@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -43,6 +44,7 @@ type MachineDeployment struct {
 	State                        *shootstate.MachineDeploymentState
 	MachineConfiguration         *machinev1alpha1.MachineConfiguration
 	ClusterAutoscalerAnnotations map[string]string
+	Priority                     *int32
 }
 
 // MachineDeployments is a list of machine deployments.
@@ -101,20 +103,31 @@ func WorkerPoolHash(pool extensionsv1alpha1.WorkerPool, cluster *extensionscontr
 }
 
 // WorkerPoolHashV1 returns a hash value for a given worker pool and a given cluster resource.
+// For worker pools which are updated in-place, the Kubernetes and machine image versions are not part of the hash since
+// gardener-node-agent rolls them out on the existing nodes.
 func WorkerPoolHashV1(pool extensionsv1alpha1.WorkerPool, cluster *extensionscontroller.Cluster, additionalData ...string) (string, error) {
-	kubernetesVersion := cluster.Shoot.Spec.Kubernetes.Version
-	if pool.KubernetesVersion != nil {
-		kubernetesVersion = *pool.KubernetesVersion
-	}
-	shootVersionMajorMinor, err := util.VersionMajorMinor(kubernetesVersion)
-	if err != nil {
-		return "", err
-	}
+	var data []string
+
+	if helper.IsUpdateStrategyInPlace(pool.UpdateStrategy) {
+		data = []string{
+			pool.MachineType,
+			pool.MachineImage.Name,
+		}
+	} else {
+		kubernetesVersion := cluster.Shoot.Spec.Kubernetes.Version
+		if pool.KubernetesVersion != nil {
+			kubernetesVersion = *pool.KubernetesVersion
+		}
+		shootVersionMajorMinor, err := util.VersionMajorMinor(kubernetesVersion)
+		if err != nil {
+			return "", err
+		}
 
-	data := []string{
-		shootVersionMajorMinor,
-		pool.MachineType,
-		pool.MachineImage.Name + pool.MachineImage.Version,
+		data = []string{
+			shootVersionMajorMinor,
+			pool.MachineType,
+			pool.MachineImage.Name + pool.MachineImage.Version,
+		}
 	}
 
 	if pool.Volume != nil {
@@ -263,3 +276,53 @@ func FetchUserData(ctx context.Context, c client.Client, namespace string, pool
 
 	return userData, nil
 }
+
+// PrioritizedWorkerPool is a worker pool for exactly one of the machine types of a worker pool together with the
+// priority of this machine type for the cluster-autoscaler.
+type PrioritizedWorkerPool struct {
+	extensionsv1alpha1.WorkerPool
+	// Priority is the priority of the machine type. It is nil if the worker pool has no alternative machine types.
+	Priority *int32
+}
+
+// PrioritizedWorkerPools returns one worker pool per machine type of the given <pool>. The first entry is the pool for
+// the primary machine type, it is returned unchanged if there are no alternative machine types. For each alternative
+// machine type, a pool with a name suffixed by `-alt<index>` is returned whose machine type, architecture, provider
+// config and node template are replaced. Its minimum is 0 since the minimum only applies to the primary machine type,
+// while it keeps the maximum of <pool> since the maximum applies to each machine type individually. Hence, the pool can
+// grow to its maximum times the number of machine types, which is accounted for in quotas and validated for the nodes
+// network of the shoot.
+// Machine types earlier in the list get a higher priority, the last alternative machine type gets priority 0.
+func PrioritizedWorkerPools(pool extensionsv1alpha1.WorkerPool) []PrioritizedWorkerPool {
+	if len(pool.AlternativeMachineTypes) == 0 {
+		return []PrioritizedWorkerPool{{WorkerPool: pool}}
+	}
+
+	primary := *pool.DeepCopy()
+	primary.AlternativeMachineTypes = nil
+	maxPriority := int32(len(pool.AlternativeMachineTypes))
+
+	pools := []PrioritizedWorkerPool{{WorkerPool: primary, Priority: ptr.To(maxPriority)}}
+
+	for i, alternative := range pool.AlternativeMachineTypes {
+		p := *primary.DeepCopy()
+		p.Name = fmt.Sprintf("%s-alt%d", pool.Name, i+1)
+		p.MachineType = alternative.MachineType
+		p.Minimum = 0
+		p.NodeTemplate = alternative.NodeTemplate
+
+		if alternative.Architecture != nil {
+			p.Architecture = alternative.Architecture
+			if p.Labels != nil {
+				p.Labels[corev1.LabelArchStable] = *alternative.Architecture
+			}
+		}
+		if alternative.ProviderConfig != nil {
+			p.ProviderConfig = alternative.ProviderConfig
+		}
+
+		pools = append(pools, PrioritizedWorkerPool{WorkerPool: p, Priority: ptr.To(maxPriority - int32(i) - 1)})
+	}
+
+	return pools
+}
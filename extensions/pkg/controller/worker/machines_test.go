@@ -10,8 +10,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -278,6 +280,71 @@ var _ = Describe("Machines", func() {
 		})
 	})
 
+	Describe("#WorkerPoolHashV1 for worker pools updated in-place", func() {
+		var (
+			p    extensionsv1alpha1.WorkerPool
+			c    *extensionscontroller.Cluster
+			hash string
+		)
+
+		BeforeEach(func() {
+			p = extensionsv1alpha1.WorkerPool{
+				Name:              "test-worker",
+				MachineType:       "foo",
+				MachineImage:      extensionsv1alpha1.MachineImage{Name: "bar", Version: "baz"},
+				KubernetesVersion: ptr.To("1.2.3"),
+				UpdateStrategy:    ptr.To(gardencorev1beta1.AutoInPlaceUpdate),
+			}
+			c = &extensionscontroller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.2.3"},
+					},
+				},
+			}
+
+			var err error
+			hash, err = WorkerPoolHashV1(p, c)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("hash value should not change", func() {
+			AfterEach(func() {
+				actual, err := WorkerPoolHashV1(p, c)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual).To(Equal(hash))
+			})
+
+			It("when changing machine image version", func() {
+				p.MachineImage.Version = "new-version"
+			})
+
+			It("when changing the kubernetes major/minor version of the worker pool version", func() {
+				p.KubernetesVersion = ptr.To("1.3.3")
+			})
+
+			It("when changing the kubernetes major/minor version of the control plane version", func() {
+				c.Shoot.Spec.Kubernetes.Version = "1.3.3"
+			})
+		})
+
+		Context("hash value should change", func() {
+			AfterEach(func() {
+				actual, err := WorkerPoolHashV1(p, c)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual).NotTo(Equal(hash))
+			})
+
+			It("when changing machine type", func() {
+				p.MachineType = "small"
+			})
+
+			It("when changing machine image name", func() {
+				p.MachineImage.Name = "new-image"
+			})
+		})
+	})
+
 	Describe("#WorkerPoolHashV2", func() {
 		var (
 			p                extensionsv1alpha1.WorkerPool
@@ -475,4 +542,65 @@ var _ = Describe("Machines", func() {
 			Expect(result).To(Equal(userData))
 		})
 	})
+
+	Describe("#PrioritizedWorkerPools", func() {
+		var pool extensionsv1alpha1.WorkerPool
+
+		BeforeEach(func() {
+			pool = extensionsv1alpha1.WorkerPool{
+				Name:           "pool",
+				MachineType:    "amd-type",
+				Architecture:   ptr.To("amd64"),
+				Minimum:        2,
+				Maximum:        5,
+				Labels:         map[string]string{"kubernetes.io/arch": "amd64", "worker.gardener.cloud/pool": "pool"},
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				NodeTemplate:   &extensionsv1alpha1.NodeTemplate{Capacity: corev1.ResourceList{"cpu": resource.MustParse("2")}},
+			}
+		})
+
+		It("should return the pool unchanged if there are no alternative machine types", func() {
+			Expect(PrioritizedWorkerPools(pool)).To(Equal([]PrioritizedWorkerPool{{WorkerPool: pool}}))
+		})
+
+		It("should return one pool per machine type with descending priorities", func() {
+			armProviderConfig := &runtime.RawExtension{Raw: []byte(`{"bar":"baz"}`)}
+			armNodeTemplate := &extensionsv1alpha1.NodeTemplate{Capacity: corev1.ResourceList{"cpu": resource.MustParse("4")}}
+
+			pool.AlternativeMachineTypes = []extensionsv1alpha1.AlternativeMachineType{
+				{MachineType: "other-amd-type"},
+				{MachineType: "arm-type", Architecture: ptr.To("arm64"), ProviderConfig: armProviderConfig, NodeTemplate: armNodeTemplate},
+			}
+
+			pools := PrioritizedWorkerPools(pool)
+			Expect(pools).To(HaveLen(3))
+
+			Expect(pools[0].Name).To(Equal("pool"))
+			Expect(pools[0].MachineType).To(Equal("amd-type"))
+			Expect(pools[0].Minimum).To(Equal(int32(2)))
+			Expect(pools[0].AlternativeMachineTypes).To(BeEmpty())
+			Expect(pools[0].Priority).To(PointTo(Equal(int32(2))))
+
+			Expect(pools[1].Name).To(Equal("pool-alt1"))
+			Expect(pools[1].MachineType).To(Equal("other-amd-type"))
+			Expect(pools[1].Architecture).To(PointTo(Equal("amd64")))
+			Expect(pools[1].Minimum).To(BeZero())
+			Expect(pools[1].Maximum).To(Equal(int32(5)))
+			Expect(pools[1].ProviderConfig).To(Equal(pool.ProviderConfig))
+			Expect(pools[1].NodeTemplate).To(BeNil())
+			Expect(pools[1].Labels).To(HaveKeyWithValue("kubernetes.io/arch", "amd64"))
+			Expect(pools[1].Priority).To(PointTo(Equal(int32(1))))
+
+			Expect(pools[2].Name).To(Equal("pool-alt2"))
+			Expect(pools[2].MachineType).To(Equal("arm-type"))
+			Expect(pools[2].Architecture).To(PointTo(Equal("arm64")))
+			Expect(pools[2].Minimum).To(BeZero())
+			Expect(pools[2].ProviderConfig).To(Equal(armProviderConfig))
+			Expect(pools[2].NodeTemplate).To(Equal(armNodeTemplate))
+			Expect(pools[2].Labels).To(Equal(map[string]string{"kubernetes.io/arch": "arm64", "worker.gardener.cloud/pool": "pool"}))
+			Expect(pools[2].Priority).To(PointTo(BeZero()))
+
+			Expect(pool.Labels).To(HaveKeyWithValue("kubernetes.io/arch", "amd64"))
+		})
+	})
 })
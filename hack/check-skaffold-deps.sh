@@ -21,6 +21,7 @@ function run() {
 
 # skaffold.yaml
 run "skaffold.yaml" "gardener-admission-controller"             "controlplane"
+run "skaffold.yaml" "gardener-audit-forwarder"                  "gardenlet"
 run "skaffold.yaml" "gardener-apiserver"                        "controlplane"
 run "skaffold.yaml" "gardener-controller-manager"               "controlplane"
 run "skaffold.yaml" "gardener-extension-provider-local"         "provider-local"
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/imagevector"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+func main() {
+	var (
+		rewriteRulesFile   string
+		kubernetesVersions []string
+		output             string
+	)
+
+	const short = "List all images of the Gardener image vectors resolved to their (rewritten) references"
+	cmd := &cobra.Command{
+		Use: "list-images",
+
+		Short: short,
+		Long: short + `
+
+list-images resolves all images of the container and chart image vectors embedded into Gardener and prints the
+source and target reference of each image. The target reference is the result of applying the image rewrite rules,
+see docs/deployment/image_vector.md. Images without a tag in the image vector are tagged with the Kubernetes version
+at runtime, hence they are listed for each of the given Kubernetes versions.
+The output can be used for pre-pulling all images into a registry mirror.
+`,
+
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			var rules []imagevectorutils.RewriteRule
+			if rewriteRulesFile != "" {
+				var err error
+				if rules, err = imagevectorutils.ReadRewriteRulesFile(rewriteRulesFile); err != nil {
+					return fmt.Errorf("failed reading rewrite rules: %w", err)
+				}
+			}
+
+			vector := append(imagevector.Containers(), imagevector.Charts()...)
+			images, err := imagevectorutils.ResolveImages(vector, kubernetesVersions, rules)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case "text":
+				for _, image := range images {
+					fmt.Println(image.Source, image.Target)
+				}
+				return nil
+			case "json":
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(images)
+			case "yaml":
+				out, err := yaml.Marshal(images)
+				if err != nil {
+					return err
+				}
+				_, err = os.Stdout.Write(out)
+				return err
+			default:
+				return fmt.Errorf("unsupported output format %q, must be one of text, json, yaml", output)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&rewriteRulesFile, "rewrite-rules", "", "Path to a file containing the image rewrite rules")
+	cmd.Flags().StringSliceVar(&kubernetesVersions, "kubernetes-versions", nil, "Kubernetes versions for which images without tag are resolved")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format, one of text, json, yaml")
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
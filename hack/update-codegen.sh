@@ -32,6 +32,7 @@ AVAILABLE_CODEGEN_OPTIONS=(
   "provider_local_groups"
   "extensions_config_groups"
   "nodeagent_groups"
+  "auditforwarder_groups"
 )
 
 # setup virtual GOPATH
@@ -422,6 +423,30 @@ nodeagent_groups() {
 }
 export -f nodeagent_groups
 
+# Componentconfig for audit-forwarder
+
+auditforwarder_groups() {
+  echo "Generating API groups for pkg/auditforwarder/apis/config"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    deepcopy,defaulter \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/pkg/auditforwarder/apis \
+    github.com/gardener/gardener/pkg/auditforwarder/apis \
+    "config:v1alpha1" \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    conversion \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/pkg/auditforwarder/apis \
+    github.com/gardener/gardener/pkg/auditforwarder/apis \
+    "config:v1alpha1" \
+    --extra-peer-dirs=github.com/gardener/gardener/pkg/auditforwarder/apis/config,github.com/gardener/gardener/pkg/auditforwarder/apis/config/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+}
+export -f auditforwarder_groups
+
 # Componentconfig for admission plugins
 
 shoottolerationrestriction_groups() {
@@ -47,6 +47,8 @@ const (
 	ContainerImageNameGardenerAdmissionController = "gardener-admission-controller"
 	// ContainerImageNameGardenerApiserver is a constant for an image in the image vector with name 'gardener-apiserver'.
 	ContainerImageNameGardenerApiserver = "gardener-apiserver"
+	// ContainerImageNameGardenerAuditForwarder is a constant for an image in the image vector with name 'gardener-audit-forwarder'.
+	ContainerImageNameGardenerAuditForwarder = "gardener-audit-forwarder"
 	// ContainerImageNameGardenerControllerManager is a constant for an image in the image vector with name 'gardener-controller-manager'.
 	ContainerImageNameGardenerControllerManager = "gardener-controller-manager"
 	// ContainerImageNameGardenerDashboard is a constant for an image in the image vector with name 'gardener-dashboard'.
@@ -48,3 +48,10 @@ func Containers() imagevector.ImageVector {
 func Charts() imagevector.ImageVector {
 	return chartsImageVector
 }
+
+// ApplyRewriteRules applies the given rewrite rules to the images of the container and chart image vectors. It is
+// called by the components on startup with the rewrite rules of their component configuration.
+func ApplyRewriteRules(rules []imagevector.RewriteRule) {
+	containersImageVector = imagevector.WithRewriteRules(containersImageVector, rules)
+	chartsImageVector = imagevector.WithRewriteRules(chartsImageVector, rules)
+}
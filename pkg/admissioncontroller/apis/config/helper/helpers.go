@@ -46,6 +46,24 @@ func VersionMatches(limit admissioncontrollerconfig.ResourceLimit, version strin
 	return false
 }
 
+// RateLimitMatches returns `true` if the given group, version, resource, and operation have a match in the given rate
+// limit.
+func RateLimitMatches(rateLimit admissioncontrollerconfig.ResourceRateLimit, group, version, resource, operation string) bool {
+	return valueMatches(rateLimit.APIGroups, group) &&
+		valueMatches(rateLimit.APIVersions, version) &&
+		valueMatches(rateLimit.Resources, resource) &&
+		valueMatches(rateLimit.Operations, operation)
+}
+
+func valueMatches(values []string, value string) bool {
+	for _, v := range values {
+		if v == admissioncontrollerconfig.WildcardAll || v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // UserMatches returns `true` if the given user in the subject has a match in the given userConfig.
 func UserMatches(subject rbacv1.Subject, userInfo authenticationv1.UserInfo) bool {
 	if subject.Kind != rbacv1.UserKind {
@@ -96,6 +96,23 @@ var _ = Describe("Helpers test", func() {
 		Entry("resource is found because of wildcard", limitWildcard, "seeds", BeTrue()),
 	)
 
+	DescribeTable("#RateLimitMatches",
+		func(group, version, resource, operation string, matcher gomegatypes.GomegaMatcher) {
+			rateLimit := admissioncontrollerconfig.ResourceRateLimit{
+				APIGroups:   []string{"core.gardener.cloud"},
+				APIVersions: []string{"*"},
+				Resources:   []string{"shoots"},
+				Operations:  []string{"CREATE"},
+			}
+
+			Expect(RateLimitMatches(rateLimit, group, version, resource, operation)).To(matcher)
+		},
+		Entry("rate limit is found", "core.gardener.cloud", "v1beta1", "shoots", "CREATE", BeTrue()),
+		Entry("group is not found", "extensions.gardener.cloud", "v1beta1", "shoots", "CREATE", BeFalse()),
+		Entry("resource is not found", "core.gardener.cloud", "v1beta1", "seeds", "CREATE", BeFalse()),
+		Entry("operation is not found", "core.gardener.cloud", "v1beta1", "shoots", "UPDATE", BeFalse()),
+	)
+
 	DescribeTable("#UserMatches",
 		func(subject rbacv1.Subject, userName string, matcher gomegatypes.GomegaMatcher) {
 			Expect(UserMatches(subject, authenticationv1.UserInfo{Username: userName})).To(matcher)
@@ -56,6 +56,21 @@ func SetDefaults_ResourceAdmissionConfiguration(obj *ResourceAdmissionConfigurat
 	}
 }
 
+// SetDefaults_ResourceRateLimit sets defaults for the resource rate limit.
+func SetDefaults_ResourceRateLimit(obj *ResourceRateLimit) {
+	if len(obj.Operations) == 0 {
+		obj.Operations = []string{"CREATE", "UPDATE"}
+	}
+
+	if obj.Scope == "" {
+		obj.Scope = RateLimitScopeUser
+	}
+
+	if obj.Burst == 0 {
+		obj.Burst = obj.RequestsPerMinute
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	if obj.QPS == 0.0 {
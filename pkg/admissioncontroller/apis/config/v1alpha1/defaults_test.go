@@ -168,4 +168,45 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Server.ResourceAdmissionConfiguration).To(Equal(expected))
 		})
 	})
+
+	Describe("ResourceRateLimit defaulting", func() {
+		It("should correctly default the resource rate limit", func() {
+			obj = &AdmissionControllerConfiguration{
+				Server: ServerConfiguration{
+					ResourceAdmissionConfiguration: &ResourceAdmissionConfiguration{
+						RateLimits: []ResourceRateLimit{{Resources: []string{"shoots"}, RequestsPerMinute: 10}},
+					},
+				},
+			}
+			SetObjectDefaults_AdmissionControllerConfiguration(obj)
+
+			Expect(obj.Server.ResourceAdmissionConfiguration.RateLimits).To(ConsistOf(ResourceRateLimit{
+				Resources:         []string{"shoots"},
+				Operations:        []string{"CREATE", "UPDATE"},
+				Scope:             RateLimitScopeUser,
+				RequestsPerMinute: 10,
+				Burst:             10,
+			}))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj = &AdmissionControllerConfiguration{
+				Server: ServerConfiguration{
+					ResourceAdmissionConfiguration: &ResourceAdmissionConfiguration{
+						RateLimits: []ResourceRateLimit{{
+							Resources:         []string{"shoots"},
+							Operations:        []string{"CREATE"},
+							Scope:             RateLimitScopeProject,
+							RequestsPerMinute: 10,
+							Burst:             50,
+						}},
+					},
+				},
+			}
+			expected := obj.Server.ResourceAdmissionConfiguration.DeepCopy()
+			SetObjectDefaults_AdmissionControllerConfiguration(obj)
+
+			Expect(obj.Server.ResourceAdmissionConfiguration).To(Equal(expected))
+		})
+	})
 })
@@ -54,7 +54,10 @@ type ServerConfiguration struct {
 type ResourceAdmissionConfiguration struct {
 	// Limits contains configuration for resources which are subjected to size limitations.
 	Limits []ResourceLimit `json:"limits"`
-	// UnrestrictedSubjects contains references to users, groups, or service accounts which aren't subjected to any resource size limit.
+	// RateLimits contains configuration for resources which are subjected to rate limitations.
+	// +optional
+	RateLimits []ResourceRateLimit `json:"rateLimits,omitempty"`
+	// UnrestrictedSubjects contains references to users, groups, or service accounts which aren't subjected to any resource size or rate limit.
 	// +optional
 	UnrestrictedSubjects []rbacv1.Subject `json:"unrestrictedSubjects,omitempty"`
 	// OperationMode specifies the mode the webhooks operates in. Allowed values are "block" and "log". Defaults to "block".
@@ -82,6 +85,41 @@ type ResourceLimit struct {
 	Size resource.Quantity `json:"size"`
 }
 
+// ResourceRateLimit contains settings about a kind and the rate at which resources may be created or updated.
+type ResourceRateLimit struct {
+	// APIGroups is the name of the APIGroup that contains the limited resource. WildcardAll represents all groups.
+	// +optional
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// APIVersions is the version of the resource. WildcardAll represents all versions.
+	// +optional
+	APIVersions []string `json:"apiVersions,omitempty"`
+	// Resources is the name of the resource this rule applies to. WildcardAll represents all resources.
+	Resources []string `json:"resources"`
+	// Operations are the operations this rule applies to. Allowed values are "CREATE" and "UPDATE". WildcardAll
+	// represents all operations. Defaults to ["CREATE", "UPDATE"].
+	// +optional
+	Operations []string `json:"operations,omitempty"`
+	// Scope specifies whether the requests are accounted per user or per project. Allowed values are "User" and
+	// "Project". Defaults to "User".
+	// +optional
+	Scope ResourceRateLimitScope `json:"scope,omitempty"`
+	// RequestsPerMinute is the number of requests which are allowed per minute on average.
+	RequestsPerMinute int32 `json:"requestsPerMinute"`
+	// Burst is the maximum number of requests which are allowed at once. Defaults to RequestsPerMinute.
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+}
+
+// ResourceRateLimitScope is an alias type for the scope of a resource rate limit.
+type ResourceRateLimitScope string
+
+const (
+	// RateLimitScopeUser specifies that the requests are accounted per user.
+	RateLimitScopeUser ResourceRateLimitScope = "User"
+	// RateLimitScopeProject specifies that the requests are accounted per project, i.e., per namespace.
+	RateLimitScopeProject ResourceRateLimitScope = "Project"
+)
+
 // Server contains information for HTTP(S) server configuration.
 type Server struct {
 	// BindAddress is the IP address on which to listen for the specified port.
@@ -67,6 +67,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResourceRateLimit)(nil), (*config.ResourceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceRateLimit_To_config_ResourceRateLimit(a.(*ResourceRateLimit), b.(*config.ResourceRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ResourceRateLimit)(nil), (*ResourceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ResourceRateLimit_To_v1alpha1_ResourceRateLimit(a.(*config.ResourceRateLimit), b.(*ResourceRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -184,6 +194,7 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 
 func autoConvert_v1alpha1_ResourceAdmissionConfiguration_To_config_ResourceAdmissionConfiguration(in *ResourceAdmissionConfiguration, out *config.ResourceAdmissionConfiguration, s conversion.Scope) error {
 	out.Limits = *(*[]config.ResourceLimit)(unsafe.Pointer(&in.Limits))
+	out.RateLimits = *(*[]config.ResourceRateLimit)(unsafe.Pointer(&in.RateLimits))
 	out.UnrestrictedSubjects = *(*[]v1.Subject)(unsafe.Pointer(&in.UnrestrictedSubjects))
 	out.OperationMode = (*config.ResourceAdmissionWebhookMode)(unsafe.Pointer(in.OperationMode))
 	return nil
@@ -196,6 +207,7 @@ func Convert_v1alpha1_ResourceAdmissionConfiguration_To_config_ResourceAdmission
 
 func autoConvert_config_ResourceAdmissionConfiguration_To_v1alpha1_ResourceAdmissionConfiguration(in *config.ResourceAdmissionConfiguration, out *ResourceAdmissionConfiguration, s conversion.Scope) error {
 	out.Limits = *(*[]ResourceLimit)(unsafe.Pointer(&in.Limits))
+	out.RateLimits = *(*[]ResourceRateLimit)(unsafe.Pointer(&in.RateLimits))
 	out.UnrestrictedSubjects = *(*[]v1.Subject)(unsafe.Pointer(&in.UnrestrictedSubjects))
 	out.OperationMode = (*ResourceAdmissionWebhookMode)(unsafe.Pointer(in.OperationMode))
 	return nil
@@ -232,6 +244,38 @@ func Convert_config_ResourceLimit_To_v1alpha1_ResourceLimit(in *config.ResourceL
 	return autoConvert_config_ResourceLimit_To_v1alpha1_ResourceLimit(in, out, s)
 }
 
+func autoConvert_v1alpha1_ResourceRateLimit_To_config_ResourceRateLimit(in *ResourceRateLimit, out *config.ResourceRateLimit, s conversion.Scope) error {
+	out.APIGroups = *(*[]string)(unsafe.Pointer(&in.APIGroups))
+	out.APIVersions = *(*[]string)(unsafe.Pointer(&in.APIVersions))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
+	out.Scope = config.ResourceRateLimitScope(in.Scope)
+	out.RequestsPerMinute = in.RequestsPerMinute
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1alpha1_ResourceRateLimit_To_config_ResourceRateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceRateLimit_To_config_ResourceRateLimit(in *ResourceRateLimit, out *config.ResourceRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceRateLimit_To_config_ResourceRateLimit(in, out, s)
+}
+
+func autoConvert_config_ResourceRateLimit_To_v1alpha1_ResourceRateLimit(in *config.ResourceRateLimit, out *ResourceRateLimit, s conversion.Scope) error {
+	out.APIGroups = *(*[]string)(unsafe.Pointer(&in.APIGroups))
+	out.APIVersions = *(*[]string)(unsafe.Pointer(&in.APIVersions))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
+	out.Scope = ResourceRateLimitScope(in.Scope)
+	out.RequestsPerMinute = in.RequestsPerMinute
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_config_ResourceRateLimit_To_v1alpha1_ResourceRateLimit is an autogenerated conversion function.
+func Convert_config_ResourceRateLimit_To_v1alpha1_ResourceRateLimit(in *config.ResourceRateLimit, out *ResourceRateLimit, s conversion.Scope) error {
+	return autoConvert_config_ResourceRateLimit_To_v1alpha1_ResourceRateLimit(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
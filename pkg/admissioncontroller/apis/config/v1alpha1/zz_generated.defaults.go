@@ -29,5 +29,9 @@ func SetObjectDefaults_AdmissionControllerConfiguration(in *AdmissionControllerC
 	SetDefaults_ServerConfiguration(&in.Server)
 	if in.Server.ResourceAdmissionConfiguration != nil {
 		SetDefaults_ResourceAdmissionConfiguration(in.Server.ResourceAdmissionConfiguration)
+		for i := range in.Server.ResourceAdmissionConfiguration.RateLimits {
+			a := &in.Server.ResourceAdmissionConfiguration.RateLimits[i]
+			SetDefaults_ResourceRateLimit(a)
+		}
 	}
 }
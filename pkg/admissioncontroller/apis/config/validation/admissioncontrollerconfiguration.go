@@ -114,5 +114,57 @@ func ValidateResourceAdmissionConfiguration(config *admissioncontrollerconfig.Re
 		}
 	}
 
+	var (
+		allowedOperations = sets.New("CREATE", "UPDATE", admissioncontrollerconfig.WildcardAll)
+		allowedScopes     = sets.New(string(admissioncontrollerconfig.RateLimitScopeUser), string(admissioncontrollerconfig.RateLimitScopeProject))
+	)
+
+	for i, rateLimit := range config.RateLimits {
+		fld := fldPath.Child("rateLimits").Index(i)
+
+		if len(rateLimit.Resources) == 0 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("resources"), rateLimit.Resources, "must at least have one element"))
+		}
+		for j, resource := range rateLimit.Resources {
+			if resource == "" {
+				allErrs = append(allErrs, field.Invalid(fld.Child("resources").Index(j), resource, "must not be empty"))
+			}
+		}
+
+		if len(rateLimit.APIGroups) < 1 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("apiGroups"), rateLimit.APIGroups, "must at least have one element"))
+		}
+
+		if len(rateLimit.APIVersions) == 0 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("versions"), rateLimit.APIVersions, "must at least have one element"))
+		}
+		for j, version := range rateLimit.APIVersions {
+			if version == "" {
+				allErrs = append(allErrs, field.Invalid(fld.Child("versions").Index(j), version, "must not be empty"))
+			}
+		}
+
+		if len(rateLimit.Operations) == 0 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("operations"), rateLimit.Operations, "must at least have one element"))
+		}
+		for j, operation := range rateLimit.Operations {
+			if !allowedOperations.Has(operation) {
+				allErrs = append(allErrs, field.NotSupported(fld.Child("operations").Index(j), operation, sets.List(allowedOperations)))
+			}
+		}
+
+		if !allowedScopes.Has(string(rateLimit.Scope)) {
+			allErrs = append(allErrs, field.NotSupported(fld.Child("scope"), rateLimit.Scope, sets.List(allowedScopes)))
+		}
+
+		if rateLimit.RequestsPerMinute <= 0 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("requestsPerMinute"), rateLimit.RequestsPerMinute, "must be greater than 0"))
+		}
+
+		if rateLimit.Burst <= 0 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("burst"), rateLimit.Burst, "must be greater than 0"))
+		}
+	}
+
 	return allErrs
 }
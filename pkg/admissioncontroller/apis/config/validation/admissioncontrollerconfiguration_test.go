@@ -12,6 +12,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	admissioncontrollerconfig "github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
 	. "github.com/gardener/gardener/pkg/admissioncontroller/apis/config/validation"
@@ -115,6 +116,71 @@ var _ = Describe("#ValidateAdmissionControllerConfiguration", func() {
 			),
 		)
 
+		DescribeTable("Rate limits validation",
+			func(mutate func(*admissioncontrollerconfig.ResourceRateLimit), matcher gomegatypes.GomegaMatcher) {
+				rateLimit := admissioncontrollerconfig.ResourceRateLimit{
+					APIGroups:         apiGroups,
+					APIVersions:       versions,
+					Resources:         resources,
+					Operations:        []string{"CREATE"},
+					Scope:             admissioncontrollerconfig.RateLimitScopeUser,
+					RequestsPerMinute: 10,
+					Burst:             20,
+				}
+				mutate(&rateLimit)
+
+				config := &admissioncontrollerconfig.AdmissionControllerConfiguration{
+					LogLevel:  "info",
+					LogFormat: "json",
+					Server: admissioncontrollerconfig.ServerConfiguration{
+						ResourceAdmissionConfiguration: &admissioncontrollerconfig.ResourceAdmissionConfiguration{
+							RateLimits: []admissioncontrollerconfig.ResourceRateLimit{rateLimit},
+						},
+					},
+				}
+
+				errs := ValidateAdmissionControllerConfiguration(config)
+
+				Expect(errs).To(matcher)
+			},
+			Entry("should allow request", func(*admissioncontrollerconfig.ResourceRateLimit) {},
+				BeEmpty(),
+			),
+			Entry("should allow project scope and wildcard operation", func(r *admissioncontrollerconfig.ResourceRateLimit) {
+				r.Scope = admissioncontrollerconfig.RateLimitScopeProject
+				r.Operations = []string{"*"}
+			},
+				BeEmpty(),
+			),
+			Entry("should deny empty apiGroups", func(r *admissioncontrollerconfig.ResourceRateLimit) { r.APIGroups = nil },
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].apiGroups")}))),
+			),
+			Entry("should deny versions w/ zero length", func(r *admissioncontrollerconfig.ResourceRateLimit) { r.APIVersions = []string{""} },
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].versions[0]")}))),
+			),
+			Entry("should deny empty resources", func(r *admissioncontrollerconfig.ResourceRateLimit) { r.Resources = nil },
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].resources")}))),
+			),
+			Entry("should deny empty operations", func(r *admissioncontrollerconfig.ResourceRateLimit) { r.Operations = nil },
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].operations")}))),
+			),
+			Entry("should deny unsupported operations", func(r *admissioncontrollerconfig.ResourceRateLimit) { r.Operations = []string{"DELETE"} },
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].operations[0]")}))),
+			),
+			Entry("should deny unsupported scope", func(r *admissioncontrollerconfig.ResourceRateLimit) { r.Scope = "Namespace" },
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].scope")}))),
+			),
+			Entry("should deny non-positive requests per minute and burst", func(r *admissioncontrollerconfig.ResourceRateLimit) {
+				r.RequestsPerMinute = 0
+				r.Burst = -1
+			},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].requestsPerMinute")})),
+					PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("server.resourceAdmissionConfiguration.rateLimits[0].burst")})),
+				),
+			),
+		)
+
 		var (
 			userName       = "admin"
 			namespace      = "default"
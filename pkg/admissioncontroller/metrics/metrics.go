@@ -31,4 +31,19 @@ var (
 			"reason",
 		},
 	)
+
+	// ThrottledRequests defines the counter throttled_requests_total. It intentionally has no namespace or subject label
+	// since every project and user could create a new time series. The affected subjects are logged instead.
+	ThrottledRequests = Factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "throttled_requests_total",
+			Help:      "Total number of requests rejected because of an exceeded rate limit.",
+		},
+		[]string{
+			"operation",
+			"kind",
+			"scope",
+		},
+	)
 )
@@ -13,6 +13,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/admissionpluginsecret"
+	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/alertingrules"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/auditpolicy"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/authenticationconfig"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/internaldomainsecret"
@@ -38,6 +39,15 @@ func AddToManager(
 		return fmt.Errorf("failed adding %s webhook handler: %w", auditpolicy.HandlerName, err)
 	}
 
+	if err := (&alertingrules.Handler{
+		Logger:    mgr.GetLogger().WithName("webhook").WithName(alertingrules.HandlerName),
+		APIReader: mgr.GetAPIReader(),
+		Client:    mgr.GetClient(),
+		Decoder:   admission.NewDecoder(mgr.GetScheme()),
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding %s webhook handler: %w", alertingrules.HandlerName, err)
+	}
+
 	if err := (&authenticationconfig.Handler{
 		Logger:    mgr.GetLogger().WithName("webhook").WithName(authenticationconfig.HandlerName),
 		APIReader: mgr.GetAPIReader(),
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alertingrules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/versioning"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	admissionwebhook "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencoreinstall "github.com/gardener/gardener/pkg/apis/core/install"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+)
+
+var (
+	internalDecoder runtime.Decoder
+
+	shootGK     = schema.GroupKind{Group: "core.gardener.cloud", Kind: "Shoot"}
+	configmapGK = schema.GroupKind{Group: "", Kind: "ConfigMap"}
+)
+
+func init() {
+	// create decoder that decodes Shoots from all known API versions to the internal version, but does not perform defaulting
+	gardencoreScheme := runtime.NewScheme()
+	gardencoreinstall.Install(gardencoreScheme)
+	codecFactory := serializer.NewCodecFactory(gardencoreScheme)
+	internalDecoder = versioning.NewCodec(nil, codecFactory.UniversalDeserializer(), runtime.UnsafeObjectConvertor(gardencoreScheme),
+		gardencoreScheme, gardencoreScheme, nil, runtime.DisabledGroupVersioner, runtime.InternalGroupVersioner, gardencoreScheme.Name())
+}
+
+// Handler validates user-defined alerting rules.
+type Handler struct {
+	Logger    logr.Logger
+	APIReader client.Reader
+	Client    client.Reader
+	Decoder   *admission.Decoder
+}
+
+// Handle validates user-defined alerting rules.
+func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	requestGK := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+
+	switch requestGK {
+	case shootGK:
+		return h.admitShoot(ctx, req)
+	case configmapGK:
+		return h.admitConfigMap(ctx, req)
+	}
+	return admissionwebhook.Allowed("resource is not *core.gardener.cloud/v1beta1.Shoot or *corev1.ConfigMap")
+}
+
+func (h *Handler) admitShoot(ctx context.Context, request admission.Request) admission.Response {
+	shoot := &gardencore.Shoot{}
+	if err := runtime.DecodeInto(internalDecoder, request.Object.Raw, shoot); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		// the custom alerting rules are not deployed anymore once the shoot is marked for deletion, hence there is no
+		// need to validate them
+		return admissionwebhook.Allowed("shoot is already marked for deletion")
+	}
+
+	var oldConfigMapName, newConfigMapName string
+
+	if request.Operation == admissionv1.Update {
+		oldShoot := &gardencore.Shoot{}
+		if err := runtime.DecodeInto(internalDecoder, request.OldObject.Raw, oldShoot); err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		oldConfigMapName = gardencorehelper.GetShootAlertingCustomRulesConfigMapName(oldShoot.Spec.Monitoring)
+	}
+	newConfigMapName = gardencorehelper.GetShootAlertingCustomRulesConfigMapName(shoot.Spec.Monitoring)
+
+	if newConfigMapName == "" {
+		return admissionwebhook.Allowed("shoot resource is not specifying any custom alerting rules")
+	}
+
+	// The rules only need to be validated if the reference changed (or is set for the first time), changes of the
+	// content of the config map are validated by admitConfigMap. This way, users and gardenlet can always update the
+	// shoot without touching the custom alerting rules.
+	if oldConfigMapName == newConfigMapName {
+		return admissionwebhook.Allowed("custom alerting rules configmap was not changed")
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: shoot.Namespace, Name: newConfigMapName}}
+	if err := h.APIReader.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		status := http.StatusInternalServerError
+		if apierrors.IsNotFound(err) {
+			status = http.StatusUnprocessableEntity
+		}
+		return admission.Errored(int32(status), fmt.Errorf("could not retrieve configmap: %w", err))
+	}
+
+	rules, err := getAlertingRules(configMap)
+	if err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, fmt.Errorf("error getting custom alerting rules from configmap %s: %w", client.ObjectKeyFromObject(configMap), err))
+	}
+
+	if _, err := shootprometheus.DecodeCustomRules(rules); err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, fmt.Errorf("provided invalid custom alerting rules: %w", err))
+	}
+
+	return admissionwebhook.Allowed("referenced custom alerting rules are valid")
+}
+
+func (h *Handler) admitConfigMap(ctx context.Context, request admission.Request) admission.Response {
+	var (
+		oldConfigMap = &corev1.ConfigMap{}
+		configMap    = &corev1.ConfigMap{}
+	)
+
+	if request.Operation != admissionv1.Update {
+		return admissionwebhook.Allowed("operation is not update")
+	}
+
+	if err := h.Decoder.Decode(request, configMap); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	// only config maps referenced by a shoot in the same namespace contain custom alerting rules
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := h.Client.List(ctx, shootList, client.InNamespace(request.Namespace)); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	var configMapIsReferenced bool
+	for _, shoot := range shootList.Items {
+		if v1beta1helper.GetShootAlertingCustomRulesConfigMapName(shoot.Spec.Monitoring) == request.Name {
+			configMapIsReferenced = true
+			break
+		}
+	}
+
+	if !configMapIsReferenced {
+		return admissionwebhook.Allowed("configmap is not referenced by a Shoot")
+	}
+
+	rules, err := getAlertingRules(configMap)
+	if err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, err)
+	}
+
+	if err = h.getOldObject(request, oldConfigMap); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	oldRules, ok := oldConfigMap.Data[shootprometheus.CustomRulesConfigMapDataKey]
+	if ok && oldRules == rules {
+		return admissionwebhook.Allowed("custom alerting rules not changed")
+	}
+
+	if _, err := shootprometheus.DecodeCustomRules(rules); err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, fmt.Errorf("provided invalid custom alerting rules: %w", err))
+	}
+
+	return admissionwebhook.Allowed("configmap change is valid")
+}
+
+func (h *Handler) getOldObject(request admission.Request, oldObj runtime.Object) error {
+	if len(request.OldObject.Raw) != 0 {
+		return h.Decoder.DecodeRaw(request.OldObject, oldObj)
+	}
+	return errors.New("could not find old object")
+}
+
+func getAlertingRules(cm *corev1.ConfigMap) (string, error) {
+	rules, ok := cm.Data[shootprometheus.CustomRulesConfigMapDataKey]
+	if !ok {
+		return "", fmt.Errorf("missing '.data[%s]' in custom alerting rules configmap", shootprometheus.CustomRulesConfigMapDataKey)
+	}
+	if len(rules) == 0 {
+		return "", errors.New("empty custom alerting rules. Provide non-empty custom alerting rules")
+	}
+	return rules, nil
+}
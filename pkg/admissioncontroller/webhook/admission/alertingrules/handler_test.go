@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alertingrules_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/alertingrules"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+)
+
+var _ = Describe("handler", func() {
+	var (
+		ctx = context.TODO()
+		log logr.Logger
+
+		request admission.Request
+		decoder *admission.Decoder
+		handler *Handler
+
+		ctrl       *gomock.Controller
+		mockReader *mockclient.MockReader
+		fakeClient client.Client
+
+		statusCodeAllowed       int32 = http.StatusOK
+		statusCodeInvalid       int32 = http.StatusUnprocessableEntity
+		statusCodeInternalError int32 = http.StatusInternalServerError
+
+		testEncoder runtime.Encoder
+
+		cmName         = "fake-cm-name"
+		cmNameOther    = "fake-cm-name-other"
+		cmNamespace    = "fake-cm-namespace"
+		shootName      = "fake-shoot-name"
+		shootNamespace = cmNamespace
+
+		cm           *corev1.ConfigMap
+		shootv1beta1 *gardencorev1beta1.Shoot
+
+		validRules = `
+groups:
+- name: my-rules
+  rules:
+  - alert: ApiServerTooManyRequests
+    expr: sum(rate(apiserver_request_total{code="429"}[5m])) > 10
+    for: 10m
+    labels:
+      severity: warning
+`
+		anotherValidRules = `
+groups:
+- name: my-rules
+  rules:
+  - alert: ApiServerTooManyRequests
+    expr: sum(rate(apiserver_request_total{code="429"}[5m])) > 100
+    for: 5m
+    labels:
+      severity: critical
+`
+		invalidRules = `
+groups:
+- name: my-rules
+  rules:
+  - record: apiserver:request_total:rate5m
+    expr: sum(rate(apiserver_request_total[5m]))
+`
+	)
+
+	BeforeEach(func() {
+		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
+		testEncoder = &jsonserializer.Serializer{}
+
+		ctrl = gomock.NewController(GinkgoT())
+		mockReader = mockclient.NewMockReader(ctrl)
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		decoder = admission.NewDecoder(kubernetes.GardenScheme)
+
+		handler = &Handler{Logger: log, APIReader: mockReader, Client: fakeClient, Decoder: decoder}
+
+		request = admission.Request{}
+
+		shootv1beta1 = &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+				Kind:       "Shoot",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      shootName,
+				Namespace: shootNamespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Monitoring: &gardencorev1beta1.Monitoring{
+					Alerting: &gardencorev1beta1.Alerting{
+						CustomRules: &gardencorev1beta1.AlertingCustomRules{
+							ConfigMapName: cmName,
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	test := func(op admissionv1.Operation, oldObj runtime.Object, obj runtime.Object, expectedAllowed bool, expectedStatusCode int32, expectedMsg string) {
+		request.Operation = op
+
+		if oldObj != nil {
+			objData, err := runtime.Encode(testEncoder, oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			request.OldObject.Raw = objData
+		}
+
+		if obj != nil {
+			objData, err := runtime.Encode(testEncoder, obj)
+			Expect(err).NotTo(HaveOccurred())
+			request.Object.Raw = objData
+		}
+
+		response := handler.Handle(ctx, request)
+		Expect(response).To(Not(BeNil()))
+		Expect(response.Allowed).To(Equal(expectedAllowed))
+		Expect(response.Result.Code).To(Equal(expectedStatusCode))
+		if expectedMsg != "" {
+			Expect(response.Result.Message).To(ContainSubstring(expectedMsg))
+		}
+		Expect(response.Patches).To(BeEmpty())
+	}
+
+	returnConfigMap := func(name, rules string) {
+		mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: name}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+			*cm = corev1.ConfigMap{Data: map[string]string{"rules.yaml": rules}}
+			return nil
+		})
+	}
+
+	Context("Shoots", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "core.gardener.cloud", Version: "v1beta1", Kind: "Shoot"}
+		})
+
+		Context("Allow", func() {
+			It("has no monitoring config", func() {
+				shootv1beta1.Spec.Monitoring = nil
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "shoot resource is not specifying any custom alerting rules")
+			})
+
+			It("has no custom rules", func() {
+				shootv1beta1.Spec.Monitoring.Alerting.CustomRules = nil
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "shoot resource is not specifying any custom alerting rules")
+			})
+
+			It("references valid custom rules (CREATE)", func() {
+				returnConfigMap(cmName, validRules)
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "referenced custom alerting rules are valid")
+			})
+
+			It("referenced configmap name was not changed (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Monitoring.Alerting.EmailReceivers = []string{"foo@example.com"}
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "custom alerting rules configmap was not changed")
+			})
+
+			It("referenced configmap name was changed (UPDATE)", func() {
+				returnConfigMap(cmNameOther, validRules)
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Monitoring.Alerting.CustomRules.ConfigMapName = cmNameOther
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "referenced custom alerting rules are valid")
+			})
+
+			It("referenced configmap was removed (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Monitoring = nil
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "shoot resource is not specifying any custom alerting rules")
+			})
+
+			It("should not validate custom rules if already marked for deletion (UPDATE)", func() {
+				now := metav1.Now()
+				shootv1beta1.DeletionTimestamp = &now
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Labels = map[string]string{"foo": "bar"}
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "marked for deletion")
+			})
+
+			It("should not validate custom rules if the reference wasn't changed (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Labels = map[string]string{"foo": "bar"}
+				newShoot.Spec.Kubernetes.Version = "1.31.1"
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "custom alerting rules configmap was not changed")
+			})
+		})
+
+		Context("Deny", func() {
+			It("references a configmap that does not exist", func() {
+				mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmName}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, cmName))
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "could not retrieve configmap: configmaps \"fake-cm-name\" not found")
+			})
+
+			It("fails getting cm", func() {
+				mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmName}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(errors.New("fake"))
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInternalError, "could not retrieve configmap: fake")
+			})
+
+			It("references configmap without a rules.yaml key", func() {
+				mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmName}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(nil)
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "missing '.data[rules.yaml]' in custom alerting rules configmap")
+			})
+
+			It("references invalid custom rules", func() {
+				returnConfigMap(cmName, invalidRules)
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "groups[0].rules[0].record: Forbidden: only alerting rules are supported")
+			})
+
+			It("references custom rules with an invalid PromQL expression", func() {
+				returnConfigMap(cmName, strings.Replace(validRules, "[5m]))", "[5m])", 1))
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "must be a valid PromQL expression")
+			})
+
+			It("references custom rules exceeding the size limit", func() {
+				returnConfigMap(cmName, validRules+strings.Repeat("#", 64*1024))
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "alerting rules must not exceed 65536 bytes")
+			})
+		})
+	})
+
+	Context("ConfigMaps", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"}
+			request.Name = cmName
+			request.Namespace = cmNamespace
+
+			cm = &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cmName,
+					Namespace: cmNamespace,
+				},
+				Data: map[string]string{
+					"rules.yaml": validRules,
+				},
+			}
+		})
+
+		Context("Allow", func() {
+			It("is not an update", func() {
+				test(admissionv1.Create, nil, cm, true, statusCodeAllowed, "operation is not update")
+			})
+
+			It("is not referenced by any shoot", func() {
+				shootInSameNamespaceButNotReferencing := shootv1beta1.DeepCopy()
+				shootInSameNamespaceButNotReferencing.Spec.Monitoring = nil
+				Expect(fakeClient.Create(ctx, shootInSameNamespaceButNotReferencing)).To(Succeed())
+				shootInDifferentNamespaceAndReferencing := shootv1beta1.DeepCopy()
+				shootInDifferentNamespaceAndReferencing.Namespace = shootNamespace + "other"
+				Expect(fakeClient.Create(ctx, shootInDifferentNamespaceAndReferencing)).To(Succeed())
+
+				test(admissionv1.Update, cm, cm, true, statusCodeAllowed, "configmap is not referenced by a Shoot")
+			})
+
+			It("did not change rules.yaml field", func() {
+				Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+				test(admissionv1.Update, cm, cm, true, statusCodeAllowed, "custom alerting rules not changed")
+			})
+
+			It("should allow if the rules are changed to something valid", func() {
+				Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+				newCm := cm.DeepCopy()
+				newCm.Data["rules.yaml"] = anotherValidRules
+				test(admissionv1.Update, cm, newCm, true, statusCodeAllowed, "configmap change is valid")
+			})
+		})
+
+		Context("Deny", func() {
+			BeforeEach(func() {
+				Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+			})
+
+			It("has no data key", func() {
+				newCm := cm.DeepCopy()
+				newCm.Data = nil
+				test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "missing '.data[rules.yaml]' in custom alerting rules configmap")
+			})
+
+			It("has empty rules.yaml", func() {
+				newCm := cm.DeepCopy()
+				newCm.Data["rules.yaml"] = ""
+				test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "empty custom alerting rules. Provide non-empty custom alerting rules")
+			})
+
+			It("holds invalid rules", func() {
+				newCm := cm.DeepCopy()
+				newCm.Data["rules.yaml"] = invalidRules
+				test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "groups[0].rules[0].record: Forbidden: only alerting rules are supported")
+			})
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/go-logr/logr"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -94,7 +95,10 @@ func (h *Handler) admitShoot(ctx context.Context, request admission.Request) adm
 		return admissionwebhook.Allowed("shoot is already marked for deletion")
 	}
 
-	var oldAuditPolicyConfigMapName, newAuditPolicyConfigMapName, oldShootKubernetesVersion, newShootKubernetesVersion string
+	var (
+		oldAuditPolicyConfigMapNames, newAuditPolicyConfigMapNames []string
+		oldShootKubernetesVersion, newShootKubernetesVersion       string
+	)
 
 	if request.Operation == admissionv1.Update {
 		oldShoot := &gardencore.Shoot{}
@@ -109,40 +113,63 @@ func (h *Handler) admitShoot(ctx context.Context, request admission.Request) adm
 		}
 
 		oldShootKubernetesVersion = oldShoot.Spec.Kubernetes.Version
-		oldAuditPolicyConfigMapName = gardencorehelper.GetShootAuditPolicyConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer)
+		oldAuditPolicyConfigMapNames = getAuditPolicyConfigMapNames(oldShoot.Spec.Kubernetes.KubeAPIServer)
 	}
 	newShootKubernetesVersion = shoot.Spec.Kubernetes.Version
-	newAuditPolicyConfigMapName = gardencorehelper.GetShootAuditPolicyConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer)
+	newAuditPolicyConfigMapNames = getAuditPolicyConfigMapNames(shoot.Spec.Kubernetes.KubeAPIServer)
 
-	if newAuditPolicyConfigMapName == "" {
+	if len(newAuditPolicyConfigMapNames) == 0 {
 		return admissionwebhook.Allowed("shoot resource is not specifying any audit policy")
 	}
 
-	// oldAuditPolicyConfigMapName is empty for CREATE shoot requests that specify audit policy reference
-	// if Kubernetes version is changed we need to revalidate if the audit policy API version is compatible with
-	// new Kubernetes version
-	if oldAuditPolicyConfigMapName == newAuditPolicyConfigMapName && oldShootKubernetesVersion == newShootKubernetesVersion {
+	var validated bool
+	for _, name := range newAuditPolicyConfigMapNames {
+		// oldAuditPolicyConfigMapNames is empty for CREATE shoot requests that specify audit policy references
+		// if Kubernetes version is changed we need to revalidate if the audit policy API version is compatible with
+		// new Kubernetes version
+		if slices.Contains(oldAuditPolicyConfigMapNames, name) && oldShootKubernetesVersion == newShootKubernetesVersion {
+			continue
+		}
+
+		if errCode, err := h.validateAuditPolicyConfigMap(ctx, shoot.Namespace, name); err != nil {
+			return admission.Errored(errCode, err)
+		}
+		validated = true
+	}
+
+	if !validated {
 		return admissionwebhook.Allowed("audit policy configmap was not changed")
 	}
 
+	return admissionwebhook.Allowed("referenced audit policy is valid")
+}
+
+// validateAuditPolicyConfigMap validates the audit policy in the given ConfigMap.
+func (h *Handler) validateAuditPolicyConfigMap(ctx context.Context, namespace, name string) (errCode int32, err error) {
 	auditPolicyCm := &corev1.ConfigMap{}
-	if err := h.APIReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: newAuditPolicyConfigMapName}, auditPolicyCm); err != nil {
+	if err := h.APIReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, auditPolicyCm); err != nil {
 		if apierrors.IsNotFound(err) {
-			return admission.Errored(http.StatusUnprocessableEntity, fmt.Errorf("referenced audit policy does not exist: namespace: %s, name: %s", shoot.Namespace, newAuditPolicyConfigMapName))
+			return http.StatusUnprocessableEntity, fmt.Errorf("referenced audit policy does not exist: namespace: %s, name: %s", namespace, name)
 		}
-		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("could not retrieve config map: %s", err))
+		return http.StatusInternalServerError, fmt.Errorf("could not retrieve config map: %s", err)
 	}
 
 	auditPolicy, err := getAuditPolicy(auditPolicyCm)
 	if err != nil {
-		return admission.Errored(http.StatusUnprocessableEntity, fmt.Errorf("error getting auditlog policy from ConfigMap %s/%s: %w", shoot.Namespace, newAuditPolicyConfigMapName, err))
+		return http.StatusUnprocessableEntity, fmt.Errorf("error getting auditlog policy from ConfigMap %s/%s: %w", namespace, name, err)
 	}
 
-	if errCode, err := validateAuditPolicySemantics(auditPolicy); err != nil {
-		return admission.Errored(errCode, err)
-	}
+	return validateAuditPolicySemantics(auditPolicy)
+}
 
-	return admissionwebhook.Allowed("referenced audit policy is valid")
+// getAuditPolicyConfigMapNames returns the names of the ConfigMaps containing the audit policy of the kube-apiserver
+// and the audit policies of the audit backends.
+func getAuditPolicyConfigMapNames(apiServerConfig *gardencore.KubeAPIServerConfig) []string {
+	var names []string
+	if name := gardencorehelper.GetShootAuditPolicyConfigMapName(apiServerConfig); name != "" {
+		names = append(names, name)
+	}
+	return append(names, gardencorehelper.GetShootAuditBackendPolicyConfigMapNames(apiServerConfig)...)
 }
 
 func (h *Handler) admitConfigMap(ctx context.Context, request admission.Request) admission.Response {
@@ -167,7 +194,8 @@ func (h *Handler) admitConfigMap(ctx context.Context, request admission.Request)
 
 	var configMapIsReferenced bool
 	for _, shoot := range shootList.Items {
-		if v1beta1helper.GetShootAuditPolicyConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer) == request.Name {
+		if v1beta1helper.GetShootAuditPolicyConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer) == request.Name ||
+			slices.Contains(v1beta1helper.GetShootAuditBackendPolicyConfigMapNames(shoot.Spec.Kubernetes.KubeAPIServer), request.Name) {
 			configMapIsReferenced = true
 			break
 		}
@@ -275,6 +275,32 @@ rules:
 				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "shoot resource is not specifying any audit policy", "")
 			})
 
+			It("references valid audit backend policies (CREATE)", func() {
+				shootv1beta1.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends = &gardencorev1beta1.AuditBackends{
+					Log:     &gardencorev1beta1.AuditLogBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: cmNameOther}}},
+					Webhook: &gardencorev1beta1.AuditWebhookBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: cmNameOther}}},
+				}
+				for _, name := range []string{cmName, cmNameOther} {
+					mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: name}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+						*cm = corev1.ConfigMap{Data: map[string]string{"policy": validAuditPolicy}}
+						return nil
+					})
+				}
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "referenced audit policy is valid", "")
+			})
+
+			It("audit backend policy was added (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends = &gardencorev1beta1.AuditBackends{
+					Webhook: &gardencorev1beta1.AuditWebhookBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: cmNameOther}}},
+				}
+				mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmNameOther}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+					*cm = corev1.ConfigMap{Data: map[string]string{"policy": validAuditPolicy}}
+					return nil
+				})
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "referenced audit policy is valid", "")
+			})
+
 			It("should not validate auditPolicy if already marked for deletion (UPDATE)", func() {
 				now := metav1.Now()
 				shootv1beta1.DeletionTimestamp = &now
@@ -345,6 +371,21 @@ rules:
 				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "did not find expected key", "")
 			})
 
+			It("references an audit backend policy which breaks validation rules", func() {
+				shootv1beta1.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends = &gardencorev1beta1.AuditBackends{
+					Log: &gardencorev1beta1.AuditLogBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: cmNameOther}}},
+				}
+				mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmName}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+					*cm = corev1.ConfigMap{Data: map[string]string{"policy": validAuditPolicy}}
+					return nil
+				})
+				mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmNameOther}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+					*cm = corev1.ConfigMap{Data: map[string]string{"policy": invalidAuditPolicy}}
+					return nil
+				})
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "Unsupported value: \"FakeLevel\"", "")
+			})
+
 			It("references a deprecated auditPolicy/v1alpha1", func() {
 				returnedCm := corev1.ConfigMap{
 					TypeMeta:   metav1.TypeMeta{},
@@ -397,6 +438,19 @@ rules:
 					test(admissionv1.Update, cm, cm, true, statusCodeAllowed, "configmap is not referenced by a Shoot", "")
 				})
 
+				It("should allow if the audit backend policy is changed to something valid", func() {
+					shootv1beta1.Spec.Kubernetes.KubeAPIServer.AuditConfig = &gardencorev1beta1.AuditConfig{
+						Backends: &gardencorev1beta1.AuditBackends{
+							Webhook: &gardencorev1beta1.AuditWebhookBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: cmName}}},
+						},
+					}
+					Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+					newCm := cm.DeepCopy()
+					newCm.Data["policy"] = anotherValidAuditPolicy
+
+					test(admissionv1.Update, cm, newCm, true, statusCodeAllowed, "configmap change is valid", "")
+				})
+
 				It("did not change policy field", func() {
 					Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
 					test(admissionv1.Update, cm, cm, true, statusCodeAllowed, "audit policy not changed", "")
@@ -436,6 +490,22 @@ rules:
 					test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "Unsupported value: \"FakeLevel\"", "")
 				})
 
+				It("holds audit backend policy which breaks validation rules", func() {
+					shootReferencingBackendPolicy := shootv1beta1.DeepCopy()
+					shootReferencingBackendPolicy.Name = shootName + "-other"
+					shootReferencingBackendPolicy.ResourceVersion = ""
+					shootReferencingBackendPolicy.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends = &gardencorev1beta1.AuditBackends{
+						Log: &gardencorev1beta1.AuditLogBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: cmNameOther}}},
+					}
+					Expect(fakeClient.Create(ctx, shootReferencingBackendPolicy)).To(Succeed())
+
+					request.Name = cmNameOther
+					newCm := cm.DeepCopy()
+					newCm.Data["policy"] = invalidAuditPolicy
+
+					test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "Unsupported value: \"FakeLevel\"", "")
+				})
+
 				It("holds audit policy with invalid YAML structure", func() {
 					newCm := cm.DeepCopy()
 					newCm.Data["policy"] = missingKeyAuditPolicy
@@ -5,6 +5,7 @@
 package resourcesize
 
 import (
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -18,6 +19,10 @@ const (
 
 // AddToManager adds Handler to the given manager.
 func (h *Handler) AddToManager(mgr manager.Manager) error {
+	if h.Clock == nil {
+		h.Clock = clock.RealClock{}
+	}
+
 	webhook := &admission.Webhook{
 		Handler:      h,
 		RecoverPanic: true,
@@ -8,8 +8,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -21,6 +24,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	admissioncontrollerconfig "github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
@@ -28,16 +33,26 @@ import (
 	"github.com/gardener/gardener/pkg/admissioncontroller/metrics"
 )
 
-// metricReasonSizeExceeded is a metric reason value for a reason when an object size was exceeded.
-const metricReasonSizeExceeded = "Size Exceeded"
+const (
+	// metricReasonSizeExceeded is a metric reason value for a reason when an object size was exceeded.
+	metricReasonSizeExceeded = "Size Exceeded"
 
-// Handler checks the resource sizes.
+	// rateLimiterCleanupInterval is the interval in which unused rate limiters are removed.
+	rateLimiterCleanupInterval = 10 * time.Minute
+)
+
+// Handler checks the resource sizes and the request rates.
 type Handler struct {
 	Logger logr.Logger
 	Config *admissioncontrollerconfig.ResourceAdmissionConfiguration
+	Clock  clock.Clock
+
+	rateLimitersLock      sync.Mutex
+	rateLimiters          map[string]*tokenBucket
+	rateLimitersCleanedAt time.Time
 }
 
-// Handle checks the resource sizes.
+// Handle checks the resource sizes and the request rates.
 func (h *Handler) Handle(_ context.Context, req admission.Request) admission.Response {
 	var err error
 
@@ -78,6 +93,15 @@ func (h *Handler) handle(req admission.Request) error {
 		requestedResource = req.RequestResource
 	}
 
+	if err := h.checkSize(log, req, requestedResource); err != nil {
+		return err
+	}
+
+	// The rate limits are checked last, so that requests denied because of other reasons don't consume any tokens.
+	return h.checkRateLimits(log, req, requestedResource)
+}
+
+func (h *Handler) checkSize(log logr.Logger, req admission.Request, requestedResource *metav1.GroupVersionResource) error {
 	limit := findLimitForGVR(h.Config.Limits, requestedResource)
 	if limit == nil {
 		return nil
@@ -88,7 +112,7 @@ func (h *Handler) handle(req admission.Request) error {
 		return err
 	}
 	if limit.CmpInt64(objectSize) == -1 {
-		if h.Config.OperationMode == nil || *h.Config.OperationMode == admissioncontrollerconfig.AdmissionModeBlock {
+		if h.isBlockingMode() {
 			log.Info("Maximum resource size exceeded, rejected request", "requestObjectSize", objectSize, "limit", limit)
 			metrics.RejectedResources.WithLabelValues(
 				fmt.Sprint(req.Operation),
@@ -105,6 +129,116 @@ func (h *Handler) handle(req admission.Request) error {
 	return nil
 }
 
+// checkRateLimits checks the rate limits matching the given request. If the request is admitted, it consumes a token
+// of each matching rate limit.
+func (h *Handler) checkRateLimits(log logr.Logger, req admission.Request, requestedResource *metav1.GroupVersionResource) error {
+	if len(h.Config.RateLimits) == 0 {
+		return nil
+	}
+
+	h.rateLimitersLock.Lock()
+	defer h.rateLimitersLock.Unlock()
+
+	now := h.Clock.Now()
+	h.cleanupRateLimiters(now)
+
+	var (
+		keys              []string
+		exceededRateLimit *admissioncontrollerconfig.ResourceRateLimit
+		delay             time.Duration
+	)
+
+	for i, rateLimit := range h.Config.RateLimits {
+		if !admissioncontrollerhelper.RateLimitMatches(rateLimit, requestedResource.Group, requestedResource.Version, requestedResource.Resource, string(req.Operation)) {
+			continue
+		}
+
+		subject := rateLimitSubject(rateLimit.Scope, req)
+		if subject == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%d/%s", i, subject)
+		keys = append(keys, key)
+
+		if d := h.getRateLimiter(key, rateLimit).delay(now); d > delay {
+			delay = d
+			exceededRateLimit = &h.Config.RateLimits[i]
+		}
+	}
+
+	if exceededRateLimit == nil {
+		// Dry-run requests are never persisted, hence they must not consume any tokens.
+		if ptr.Deref(req.DryRun, false) {
+			return nil
+		}
+
+		for _, key := range keys {
+			h.rateLimiters[key].take(now)
+		}
+		return nil
+	}
+
+	var (
+		subject           = rateLimitSubject(exceededRateLimit.Scope, req)
+		retryAfterSeconds = int(math.Ceil(delay.Seconds()))
+	)
+
+	if h.isBlockingMode() {
+		log.Info("Rate limit exceeded, rejected request", "scope", exceededRateLimit.Scope, "subject", subject, "requestsPerMinute", exceededRateLimit.RequestsPerMinute, "retryAfterSeconds", retryAfterSeconds)
+		metrics.ThrottledRequests.WithLabelValues(
+			fmt.Sprint(req.Operation),
+			req.Kind.Kind,
+			string(exceededRateLimit.Scope),
+		).Inc()
+		return apierrors.NewTooManyRequests(fmt.Sprintf("rate limit of %d %s requests per minute for %s exceeded for %s %q", exceededRateLimit.RequestsPerMinute, req.Operation, requestedResource.Resource, strings.ToLower(string(exceededRateLimit.Scope)), subject), retryAfterSeconds)
+	}
+
+	// The request would not be admitted in blocking mode, hence it does not consume any tokens.
+	log.Info("Rate limit exceeded, request would be denied in blocking mode", "scope", exceededRateLimit.Scope, "subject", subject, "requestsPerMinute", exceededRateLimit.RequestsPerMinute)
+	return nil
+}
+
+func (h *Handler) getRateLimiter(key string, rateLimit admissioncontrollerconfig.ResourceRateLimit) *tokenBucket {
+	if h.rateLimiters == nil {
+		h.rateLimiters = make(map[string]*tokenBucket)
+	}
+
+	limiter, ok := h.rateLimiters[key]
+	if !ok {
+		limiter = newTokenBucket(float64(rateLimit.RequestsPerMinute)/60, float64(rateLimit.Burst), h.Clock.Now())
+		h.rateLimiters[key] = limiter
+	}
+
+	return limiter
+}
+
+// cleanupRateLimiters removes the rate limiters whose token buckets are full again. They behave exactly like newly
+// created rate limiters, hence removing them prevents the map from growing with every user ever seen.
+func (h *Handler) cleanupRateLimiters(now time.Time) {
+	if now.Sub(h.rateLimitersCleanedAt) < rateLimiterCleanupInterval {
+		return
+	}
+
+	for key, limiter := range h.rateLimiters {
+		if limiter.full(now) {
+			delete(h.rateLimiters, key)
+		}
+	}
+	h.rateLimitersCleanedAt = now
+}
+
+func (h *Handler) isBlockingMode() bool {
+	return h.Config.OperationMode == nil || *h.Config.OperationMode == admissioncontrollerconfig.AdmissionModeBlock
+}
+
+func rateLimitSubject(scope admissioncontrollerconfig.ResourceRateLimitScope, req admission.Request) string {
+	if scope == admissioncontrollerconfig.RateLimitScopeProject {
+		return req.Namespace
+	}
+	return req.UserInfo.Username
+}
+
 func relevantObjectSize(rawObject []byte) (int64, error) {
 	var obj map[string]any
 	err := json.Unmarshal(rawObject, &obj)
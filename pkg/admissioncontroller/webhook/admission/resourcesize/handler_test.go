@@ -8,6 +8,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -24,6 +25,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -43,6 +46,7 @@ var _ = Describe("handler", func() {
 		handler *Handler
 
 		logBuffer   *gbytes.Buffer
+		fakeClock   *testclock.FakeClock
 		testEncoder runtime.Encoder
 
 		projectsSizeLimit, _ = resource.ParseQuantity("0M")
@@ -193,7 +197,8 @@ var _ = Describe("handler", func() {
 		logBuffer = gbytes.NewBuffer()
 		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(io.MultiWriter(GinkgoWriter, logBuffer)), logzap.Level(zapcore.Level(0)))
 
-		handler = &Handler{Logger: log, Config: config()}
+		fakeClock = testclock.NewFakeClock(time.Now())
+		handler = &Handler{Logger: log, Config: config(), Clock: fakeClock}
 
 		testEncoder = &json.Serializer{}
 		request = admission.Request{}
@@ -328,4 +333,119 @@ var _ = Describe("handler", func() {
 	It("should fail because of restricted service account", func() {
 		test(project, restrictedServiceAccount, false)
 	})
+
+	Context("rate limits", func() {
+		var (
+			userA = authenticationv1.UserInfo{Username: "user-a"}
+			userB = authenticationv1.UserInfo{Username: "user-b"}
+		)
+
+		BeforeEach(func() {
+			handler.Config.RateLimits = []admissioncontrollerconfig.ResourceRateLimit{{
+				APIGroups:         []string{""},
+				APIVersions:       []string{"*"},
+				Resources:         []string{"configmaps"},
+				Operations:        []string{"CREATE"},
+				Scope:             admissioncontrollerconfig.RateLimitScopeUser,
+				RequestsPerMinute: 6,
+				Burst:             2,
+			}}
+
+			request.Operation = admissionv1.Create
+			request.Namespace = "garden-my-project"
+			request.Resource = metav1.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+			request.Kind = metav1.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+			request.Object = runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap"}`)}
+		})
+
+		handle := func(userInfo authenticationv1.UserInfo) admission.Response {
+			request.UserInfo = userInfo
+			return handler.Handle(ctx, request)
+		}
+
+		It("should reject requests exceeding the rate limit of a user with Retry-After", func() {
+			Expect(handle(userA).Allowed).To(BeTrue())
+			Expect(handle(userA).Allowed).To(BeTrue())
+
+			response := handle(userA)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Code).To(Equal(int32(http.StatusTooManyRequests)))
+			Expect(response.Result.Reason).To(Equal(metav1.StatusReasonTooManyRequests))
+			Expect(response.Result.Details.RetryAfterSeconds).To(Equal(int32(10)))
+			Expect(logBuffer).To(gbytes.Say("Rate limit exceeded, rejected request"))
+
+			By("Allow other users")
+			Expect(handle(userB).Allowed).To(BeTrue())
+
+			By("Allow requests again after tokens were refilled")
+			fakeClock.Step(10 * time.Second)
+			Expect(handle(userA).Allowed).To(BeTrue())
+			Expect(handle(userA).Allowed).To(BeFalse())
+		})
+
+		It("should account requests per project", func() {
+			handler.Config.RateLimits[0].Scope = admissioncontrollerconfig.RateLimitScopeProject
+
+			Expect(handle(userA).Allowed).To(BeTrue())
+			Expect(handle(userB).Allowed).To(BeTrue())
+			Expect(handle(userA).Allowed).To(BeFalse())
+
+			request.Namespace = "garden-other-project"
+			Expect(handle(userA).Allowed).To(BeTrue())
+		})
+
+		It("should not limit operations which are not configured", func() {
+			request.Operation = admissionv1.Update
+
+			for range 5 {
+				Expect(handle(userA).Allowed).To(BeTrue())
+			}
+		})
+
+		It("should not limit unrestricted subjects", func() {
+			for range 5 {
+				Expect(handle(unrestrictedUser()).Allowed).To(BeTrue())
+			}
+		})
+
+		It("should not consume tokens for dry-run requests", func() {
+			request.DryRun = ptr.To(true)
+
+			for range 5 {
+				Expect(handle(userA).Allowed).To(BeTrue())
+			}
+
+			request.DryRun = nil
+			Expect(handle(userA).Allowed).To(BeTrue())
+			Expect(handle(userA).Allowed).To(BeTrue())
+			Expect(handle(userA).Allowed).To(BeFalse())
+		})
+
+		It("should not consume tokens for requests exceeding the size limit", func() {
+			handler.Config.Limits = append(handler.Config.Limits, admissioncontrollerconfig.ResourceLimit{
+				APIGroups:   []string{""},
+				APIVersions: []string{"v1"},
+				Resources:   []string{"configmaps"},
+				Size:        resource.MustParse("1"),
+			})
+
+			for range 5 {
+				Expect(handle(userA).Allowed).To(BeFalse())
+			}
+
+			handler.Config.Limits = nil
+			Expect(handle(userA).Allowed).To(BeTrue())
+			Expect(handle(userA).Allowed).To(BeTrue())
+			Expect(handle(userA).Allowed).To(BeFalse())
+		})
+
+		It("should only log exceeded rate limits in log mode", func() {
+			handler.Config.OperationMode = ptr.To(admissioncontrollerconfig.AdmissionModeLog)
+
+			for range 3 {
+				Expect(handle(userA).Allowed).To(BeTrue())
+			}
+			Expect(logBuffer).To(gbytes.Say("Rate limit exceeded, request would be denied in blocking mode"))
+		})
+	})
 })
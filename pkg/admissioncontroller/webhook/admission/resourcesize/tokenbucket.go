@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcesize
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket is a token bucket rate limiter. Unlike rate.Limiter, it allows to check whether a token is available
+// without consuming it, and whether the bucket is full again.
+type tokenBucket struct {
+	// rate is the number of tokens added per second.
+	rate float64
+	// burst is the maximum number of tokens.
+	burst float64
+
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: now}
+}
+
+func (b *tokenBucket) advance(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// delay returns the duration until a token is available.
+func (b *tokenBucket) delay(now time.Time) time.Duration {
+	b.advance(now)
+	if b.tokens >= 1 {
+		return 0
+	}
+	if b.rate <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(math.Ceil((1 - b.tokens) / b.rate * float64(time.Second)))
+}
+
+// take consumes a token.
+func (b *tokenBucket) take(now time.Time) {
+	b.advance(now)
+	b.tokens--
+}
+
+// full returns true if no tokens are consumed.
+func (b *tokenBucket) full(now time.Time) bool {
+	b.advance(now)
+	return b.tokens >= b.burst
+}
@@ -116,12 +116,12 @@ func (h *Handler) admitBackupBucket(ctx context.Context, seedName string, reques
 
 	case admissionv1.Delete:
 		// If a gardenlet tries to delete a BackupBucket then it may only be allowed if the name is equal to the UID of
-		// the gardenlet's seed.
+		// the gardenlet's seed or to the name of the replica BackupBucket of the gardenlet's seed.
 		seed := &gardencorev1beta1.Seed{}
 		if err := h.Client.Get(ctx, client.ObjectKey{Name: seedName}, seed); err != nil {
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
-		if string(seed.UID) != request.Name {
+		if string(seed.UID) != request.Name && fmt.Sprintf("%s-%s", v1beta1constants.BackupReplicaPrefix, seed.UID) != request.Name {
 			return admission.Errored(http.StatusForbidden, errors.New("cannot delete unrelated BackupBucket"))
 		}
 		return admission.Allowed("")
@@ -356,6 +356,18 @@ var _ = Describe("handler", func() {
 
 						Expect(handler.Handle(ctx, request)).To(Equal(responseAllowed))
 					})
+
+					It("should allow the request because the bucket is the replica bucket of the seed", func() {
+						uid := "some-seed-uid"
+						request.Name = "replica-" + uid
+
+						mockCache.EXPECT().Get(ctx, client.ObjectKey{Name: seedName}, gomock.AssignableToTypeOf(&gardencorev1beta1.Seed{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Seed, _ ...client.GetOption) error {
+							(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid)}}).DeepCopyInto(obj)
+							return nil
+						})
+
+						Expect(handler.Handle(ctx, request)).To(Equal(responseAllowed))
+					})
 				})
 			})
 
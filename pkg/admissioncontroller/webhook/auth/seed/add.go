@@ -34,6 +34,7 @@ func (h *Handler) AddToManager(ctx context.Context, mgr manager.Manager, enableD
 		if ptr.Deref(enableDebugHandlers, false) {
 			h.Logger.Info("Registering debug handlers")
 			mgr.GetWebhookServer().Register(seedauthorizergraph.DebugHandlerPath, seedauthorizergraph.NewDebugHandler(graph))
+			mgr.GetWebhookServer().Register(seedauthorizergraph.ExplainHandlerPath, seedauthorizergraph.NewExplainHandler(graph))
 		}
 	}
 
@@ -6,45 +6,61 @@ package graph
 
 import (
 	"fmt"
+	"html"
 	"net/http"
 	"net/url"
 	"sort"
 	"strings"
 
 	gonumgraph "gonum.org/v1/gonum/graph"
-	"gonum.org/v1/gonum/graph/simple"
 )
 
 // DebugHandlerPath is the HTTP handler path for this debug handler.
 const DebugHandlerPath = "/debug/resource-dependency-graph"
 
 type handler struct {
-	graph *simple.DirectedGraph
+	graph *graph
 }
 
 // NewDebugHandler creates a new HTTP handler for debugging the resource dependency graph.
 func NewDebugHandler(graph *graph) http.HandlerFunc {
-	return (&handler{graph.graph}).Handle
+	return (&handler{graph}).Handle
 }
 
 func (h *handler) Handle(w http.ResponseWriter, r *http.Request) {
+	h.graph.lock.RLock()
+	defer h.graph.lock.RUnlock()
+
 	var (
 		out string
 
 		kind      = getQueryParameter(r.URL.Query(), "kind")
 		namespace = getQueryParameter(r.URL.Query(), "namespace")
 		name      = getQueryParameter(r.URL.Query(), "name")
+		seed      = getQueryParameter(r.URL.Query(), "seed")
 
-		nodesIterator = h.graph.Nodes()
+		nodesIterator = h.graph.graph.Nodes()
 		nodes         []*vertex
+		seedFilter    map[int64]struct{}
 	)
 
 	// On large landscapes, if there are many vertices then the entire graph cannot be rendered fast enough. Hence, we
 	// apply some default filtering for the 'seed' kind.
-	if kind == "" && namespace == "" && name == "" && nodesIterator.Len() > 2000 {
+	if kind == "" && namespace == "" && name == "" && seed == "" && nodesIterator.Len() > 2000 {
 		kind = "Seed"
 	}
 
+	// If a seed is given, only the vertices which have a path to this seed are relevant, i.e., the vertices the
+	// gardenlet of this seed is allowed to access.
+	if seed != "" {
+		seedFilter = map[int64]struct{}{}
+		if seedVertex, ok := h.graph.getVertex(VertexTypeSeed, "", seed); ok {
+			for _, v := range h.graph.verticesWithPathTo(seedVertex) {
+				seedFilter[v.ID()] = struct{}{}
+			}
+		}
+	}
+
 	// Filter for all relevant nodes and sort them.
 	for nodesIterator.Next() {
 		v := nodesIterator.Node().(*vertex)
@@ -55,6 +71,12 @@ func (h *handler) Handle(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
+		if seedFilter != nil {
+			if _, ok := seedFilter[v.ID()]; !ok {
+				continue
+			}
+		}
+
 		nodes = append(nodes, v)
 	}
 	sort.Sort(vertexSorter(nodes))
@@ -69,8 +91,9 @@ func (h *handler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 	out += `
   </select>
-  <input type="test" name="namespace" value="` + namespace + `" />
-  <input type="test" name="name" value="` + name + `" />
+  <input type="test" name="namespace" value="` + html.EscapeString(namespace) + `" />
+  <input type="test" name="name" value="` + html.EscapeString(name) + `" />
+  <input type="test" name="seed" placeholder="seed" value="` + html.EscapeString(seed) + `" />
   <input type="submit" value="go" />
 </form>` + separate(true)
 
@@ -82,8 +105,8 @@ func (h *handler) Handle(w http.ResponseWriter, r *http.Request) {
 			prefix   string
 			iterator gonumgraph.Nodes
 		}{
-			{"<- (incoming)", h.graph.To(v.ID())},
-			{"-> (outgoing)", h.graph.From(v.ID())},
+			{"<- (incoming)", h.graph.graph.To(v.ID())},
+			{"-> (outgoing)", h.graph.graph.From(v.ID())},
 		} {
 			var neighbors []*vertex
 			for n.iterator.Next() {
@@ -6,6 +6,7 @@ package graph
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -49,8 +50,13 @@ func (g *graph) setupShootWatch(ctx context.Context, informer cache.Informer) er
 				!apiequality.Semantic.DeepEqual(oldShoot.Spec.CloudProfileName, newShoot.Spec.CloudProfileName) ||
 				!apiequality.Semantic.DeepEqual(oldShoot.Spec.CloudProfile, newShoot.Spec.CloudProfile) ||
 				v1beta1helper.GetShootAuditPolicyConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuditPolicyConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
+				v1beta1helper.GetShootAuditWebhookSecretName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuditWebhookSecretName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
+				!slices.Equal(v1beta1helper.GetShootAuditBackendPolicyConfigMapNames(oldShoot.Spec.Kubernetes.KubeAPIServer), v1beta1helper.GetShootAuditBackendPolicyConfigMapNames(newShoot.Spec.Kubernetes.KubeAPIServer)) ||
 				v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
+				v1beta1helper.GetShootAlertingCustomRulesConfigMapName(oldShoot.Spec.Monitoring) != v1beta1helper.GetShootAlertingCustomRulesConfigMapName(newShoot.Spec.Monitoring) ||
 				!v1beta1helper.ShootDNSProviderSecretNamesEqual(oldShoot.Spec.DNS, newShoot.Spec.DNS) ||
+				!v1beta1helper.ShootMonitoringRemoteWriteSecretNamesEqual(oldShoot.Spec.Monitoring, newShoot.Spec.Monitoring) ||
+				!v1beta1helper.ShootLoggingOutputSecretNamesEqual(oldShoot.Spec.Logging, newShoot.Spec.Logging) ||
 				!v1beta1helper.ShootResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources) ||
 				v1beta1helper.HasManagedIssuer(oldShoot) != v1beta1helper.HasManagedIssuer(newShoot) {
 				g.handleShootCreateOrUpdate(ctx, newShoot)
@@ -141,12 +147,37 @@ func (g *graph) handleShootCreateOrUpdate(ctx context.Context, shoot *gardencore
 			g.addEdge(configMapVertex, shootVertex)
 		}
 
+		if secretName := v1beta1helper.GetShootAuditWebhookSecretName(kubeAPIServer); len(secretName) > 0 {
+			secretVertex := g.getOrCreateVertex(VertexTypeSecret, shoot.Namespace, secretName)
+			g.addEdge(secretVertex, shootVertex)
+		}
+
+		for _, configMapName := range v1beta1helper.GetShootAuditBackendPolicyConfigMapNames(kubeAPIServer) {
+			configMapVertex := g.getOrCreateVertex(VertexTypeConfigMap, shoot.Namespace, configMapName)
+			g.addEdge(configMapVertex, shootVertex)
+		}
+
 		if len(v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(kubeAPIServer)) > 0 {
 			configMapVertex := g.getOrCreateVertex(VertexTypeConfigMap, shoot.Namespace, shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication.ConfigMapName)
 			g.addEdge(configMapVertex, shootVertex)
 		}
 	}
 
+	if configMapName := v1beta1helper.GetShootAlertingCustomRulesConfigMapName(shoot.Spec.Monitoring); len(configMapName) > 0 {
+		configMapVertex := g.getOrCreateVertex(VertexTypeConfigMap, shoot.Namespace, configMapName)
+		g.addEdge(configMapVertex, shootVertex)
+	}
+
+	for _, secretName := range v1beta1helper.GetShootMonitoringRemoteWriteSecretNames(shoot.Spec.Monitoring).UnsortedList() {
+		secretVertex := g.getOrCreateVertex(VertexTypeSecret, shoot.Namespace, secretName)
+		g.addEdge(secretVertex, shootVertex)
+	}
+
+	for _, secretName := range v1beta1helper.GetShootLoggingOutputSecretNames(shoot.Spec.Logging).UnsortedList() {
+		secretVertex := g.getOrCreateVertex(VertexTypeSecret, shoot.Namespace, secretName)
+		g.addEdge(secretVertex, shootVertex)
+	}
+
 	if shoot.Spec.DNS != nil {
 		for _, provider := range shoot.Spec.DNS.Providers {
 			if provider.SecretName != nil {
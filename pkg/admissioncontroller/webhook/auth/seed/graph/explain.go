@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"sort"
+)
+
+// ExplainHandlerPath is the HTTP handler path for the handler explaining the relationship between a seed and an object.
+const ExplainHandlerPath = DebugHandlerPath + "/explain"
+
+// Explanation describes whether and why an object is related to a seed in the resource dependency graph.
+type Explanation struct {
+	// Object is the vertex of the requested object.
+	Object string `json:"object"`
+	// Seed is the vertex of the seed.
+	Seed string `json:"seed"`
+	// Related is true if there is a path from the object to the seed.
+	Related bool `json:"related"`
+	// Reason is a human-readable explanation of the result.
+	Reason string `json:"reason"`
+	// Path contains the vertices on a shortest path from the object to the seed if the object is related to the seed.
+	Path []string `json:"path,omitempty"`
+	// RelatedSeeds contains the seeds to which the object is related if it is not related to the requested seed.
+	RelatedSeeds []string `json:"relatedSeeds,omitempty"`
+}
+
+// Explain explains whether the object identified by the given vertex type, namespace and name is related to the seed
+// with the given name. This is the relationship the seed authorizer checks for most resources. Note that the seed
+// authorizer additionally checks the verb and the subresource of a request, and that some resources are handled
+// without consulting the graph.
+func (g *graph) Explain(vertexType VertexType, namespace, name, seedName string) Explanation {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	explanation := Explanation{
+		Object: newVertex(vertexType, namespace, name, 0).String(),
+		Seed:   newVertex(VertexTypeSeed, "", seedName, 0).String(),
+	}
+
+	objectVertex, ok := g.getVertex(vertexType, namespace, name)
+	if !ok {
+		explanation.Reason = "the object is not part of the graph, i.e., it does not exist or it is not referenced by any resource related to a seed"
+		return explanation
+	}
+
+	seedVertex, ok := g.getVertex(VertexTypeSeed, "", seedName)
+	if ok {
+		if path := g.shortestPath(objectVertex, seedVertex); path != nil {
+			explanation.Related = true
+			explanation.Reason = "the object is related to the seed"
+			for _, v := range path {
+				explanation.Path = append(explanation.Path, v.String())
+			}
+			return explanation
+		}
+	}
+
+	for _, v := range g.reachableVerticesOfType(objectVertex, VertexTypeSeed) {
+		explanation.RelatedSeeds = append(explanation.RelatedSeeds, v.name)
+	}
+	sort.Strings(explanation.RelatedSeeds)
+
+	if !ok {
+		explanation.Reason = "the seed is not part of the graph"
+	} else {
+		explanation.Reason = "there is no path from the object to the seed"
+	}
+
+	return explanation
+}
+
+type explainHandler struct {
+	graph *graph
+}
+
+// NewExplainHandler creates a new HTTP handler explaining the relationship between a seed and an object in the resource
+// dependency graph. Append `format=json` to the query for a machine-readable output.
+func NewExplainHandler(graph *graph) http.HandlerFunc {
+	return (&explainHandler{graph}).Handle
+}
+
+func (h *explainHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var (
+		out string
+
+		seed      = getQueryParameter(r.URL.Query(), "seed")
+		kind      = getQueryParameter(r.URL.Query(), "kind")
+		namespace = getQueryParameter(r.URL.Query(), "namespace")
+		name      = getQueryParameter(r.URL.Query(), "name")
+		format    = getQueryParameter(r.URL.Query(), "format")
+	)
+
+	var (
+		explanation *Explanation
+		errMessage  string
+	)
+
+	if seed != "" || kind != "" || name != "" {
+		vertexType, ok := vertexTypeFromKind(kind)
+		switch {
+		case !ok:
+			errMessage = fmt.Sprintf("unknown kind %q", kind)
+		case seed == "" || name == "":
+			errMessage = "seed and name must be provided"
+		default:
+			e := h.graph.Explain(vertexType, namespace, name, seed)
+			explanation = &e
+		}
+	}
+
+	if format == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		if explanation == nil {
+			if errMessage == "" {
+				errMessage = "seed, kind and name must be provided"
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": errMessage})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(explanation)
+		return
+	}
+
+	// Render form.
+	out += `
+<form action="` + ExplainHandlerPath + `" method="GET">
+  <input type="test" name="seed" placeholder="seed" value="` + html.EscapeString(seed) + `" />
+  <select name="kind">`
+	for _, vt := range sortedVertexTypes() {
+		out += fmt.Sprintf(`<option value="%s"%s>%s</option>`, vt, selected(vt, kind), vt)
+	}
+	out += `
+  </select>
+  <input type="test" name="namespace" placeholder="namespace" value="` + html.EscapeString(namespace) + `" />
+  <input type="test" name="name" placeholder="name" value="` + html.EscapeString(name) + `" />
+  <input type="submit" value="explain" />
+</form>` + separate(true)
+
+	switch {
+	case errMessage != "":
+		out += indent(0, "error: %s", html.EscapeString(errMessage))
+	case explanation != nil:
+		out += indent(0, "# %s -> %s", html.EscapeString(explanation.Object), html.EscapeString(explanation.Seed))
+		out += indent(1, "related: %t", explanation.Related)
+		out += indent(1, "reason: %s", explanation.Reason)
+		if len(explanation.Path) > 0 {
+			out += indent(1, "path (%d)", len(explanation.Path))
+			for _, v := range explanation.Path {
+				out += indent(2, html.EscapeString(v))
+			}
+		}
+		if len(explanation.RelatedSeeds) > 0 {
+			out += indent(1, "related seeds (%d)", len(explanation.RelatedSeeds))
+			for _, s := range explanation.RelatedSeeds {
+				out += indent(2, html.EscapeString(s))
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, `<font size="2" face="Courier New">`+out+`</font>`)
+}
+
+func vertexTypeFromKind(kind string) (VertexType, bool) {
+	for vertexType, k := range vertexTypes {
+		if k == kind {
+			return vertexType, true
+		}
+	}
+	return 0, false
+}
+
+func sortedVertexTypes() []string {
+	out := make([]string, 0, len(vertexTypes))
+	for _, kind := range vertexTypes {
+		out = append(out, kind)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Explain", func() {
+	var g *graph
+
+	BeforeEach(func() {
+		g = New(logr.Discard(), nil)
+
+		// Secret:ns/secret -> Shoot:ns/shoot -> Seed:seed1
+		// Secret:ns/other -> Shoot:ns/other -> Seed:seed2
+		secret := g.getOrCreateVertex(VertexTypeSecret, "ns", "secret")
+		shoot := g.getOrCreateVertex(VertexTypeShoot, "ns", "shoot")
+		seed1 := g.getOrCreateVertex(VertexTypeSeed, "", "seed1")
+		g.addEdge(secret, shoot)
+		g.addEdge(shoot, seed1)
+
+		otherSecret := g.getOrCreateVertex(VertexTypeSecret, "ns", "other")
+		otherShoot := g.getOrCreateVertex(VertexTypeShoot, "ns", "other")
+		seed2 := g.getOrCreateVertex(VertexTypeSeed, "", "seed2")
+		g.addEdge(otherSecret, otherShoot)
+		g.addEdge(otherShoot, seed2)
+	})
+
+	Describe("#Explain", func() {
+		It("should return the path if the object is related to the seed", func() {
+			Expect(g.Explain(VertexTypeSecret, "ns", "secret", "seed1")).To(Equal(Explanation{
+				Object:  "Secret:ns/secret",
+				Seed:    "Seed:seed1",
+				Related: true,
+				Reason:  "the object is related to the seed",
+				Path:    []string{"Secret:ns/secret", "Shoot:ns/shoot", "Seed:seed1"},
+			}))
+		})
+
+		It("should return the related seeds if the object is not related to the seed", func() {
+			Expect(g.Explain(VertexTypeSecret, "ns", "other", "seed1")).To(Equal(Explanation{
+				Object:       "Secret:ns/other",
+				Seed:         "Seed:seed1",
+				Reason:       "there is no path from the object to the seed",
+				RelatedSeeds: []string{"seed2"},
+			}))
+		})
+
+		It("should explain that the object is not part of the graph", func() {
+			explanation := g.Explain(VertexTypeSecret, "ns", "unknown", "seed1")
+			Expect(explanation.Related).To(BeFalse())
+			Expect(explanation.Reason).To(ContainSubstring("the object is not part of the graph"))
+		})
+
+		It("should explain that the seed is not part of the graph", func() {
+			Expect(g.Explain(VertexTypeSecret, "ns", "secret", "unknown")).To(Equal(Explanation{
+				Object:       "Secret:ns/secret",
+				Seed:         "Seed:unknown",
+				Reason:       "the seed is not part of the graph",
+				RelatedSeeds: []string{"seed1"},
+			}))
+		})
+	})
+
+	Describe("#NewExplainHandler", func() {
+		It("should return the explanation as JSON", func() {
+			recorder := httptest.NewRecorder()
+			NewExplainHandler(g)(recorder, httptest.NewRequest(http.MethodGet, ExplainHandlerPath+"?seed=seed1&kind=Secret&namespace=ns&name=secret&format=json", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			explanation := Explanation{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &explanation)).To(Succeed())
+			Expect(explanation.Related).To(BeTrue())
+			Expect(explanation.Path).To(Equal([]string{"Secret:ns/secret", "Shoot:ns/shoot", "Seed:seed1"}))
+		})
+
+		It("should reject unknown kinds", func() {
+			recorder := httptest.NewRecorder()
+			NewExplainHandler(g)(recorder, httptest.NewRequest(http.MethodGet, ExplainHandlerPath+"?seed=seed1&kind=Foo&name=secret&format=json", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring(`unknown kind \"Foo\"`))
+		})
+
+		It("should render the explanation as HTML", func() {
+			recorder := httptest.NewRecorder()
+			NewExplainHandler(g)(recorder, httptest.NewRequest(http.MethodGet, ExplainHandlerPath+"?seed=seed1&kind=Secret&namespace=ns&name=other", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(And(
+				ContainSubstring("# Secret:ns/other -> Seed:seed1"),
+				ContainSubstring("related: false"),
+				ContainSubstring("seed2"),
+			))
+		})
+	})
+
+	Describe("#NewDebugHandler", func() {
+		It("should only render the vertices related to the given seed", func() {
+			recorder := httptest.NewRecorder()
+			NewDebugHandler(g)(recorder, httptest.NewRequest(http.MethodGet, DebugHandlerPath+"?seed=seed1", nil))
+
+			Expect(recorder.Body.String()).To(And(
+				ContainSubstring("# <a href=\"/debug/resource-dependency-graph?kind=Secret\">Secret</a>:<a href=\"/debug/resource-dependency-graph?kind=Secret&namespace=ns\">ns</a>/<a href=\"/debug/resource-dependency-graph?kind=Secret&namespace=ns&name=secret\">secret</a>"),
+				ContainSubstring("# <a href=\"/debug/resource-dependency-graph?kind=Seed\">Seed</a>:<a href=\"/debug/resource-dependency-graph?kind=Seed&name=seed1\">seed1</a>"),
+				Not(ContainSubstring("# <a href=\"/debug/resource-dependency-graph?kind=Seed\">Seed</a>:<a href=\"/debug/resource-dependency-graph?kind=Seed&name=seed2\">seed2</a>")),
+				Not(ContainSubstring("name=other")),
+			))
+		})
+	})
+})
@@ -114,6 +114,75 @@ func (g *graph) HasPathFrom(fromType VertexType, fromNamespace, fromName string,
 	}) != nil
 }
 
+// shortestPath returns the vertices on a shortest path from <from> to <to> (both inclusive). It returns nil if there
+// is no such path.
+func (g *graph) shortestPath(from, to *vertex) []*vertex {
+	var (
+		parents = map[int64]*vertex{from.ID(): nil}
+		queue   = []*vertex{from}
+	)
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		if v.ID() == to.ID() {
+			var path []*vertex
+			for u := v; u != nil; u = parents[u.ID()] {
+				path = append([]*vertex{u}, path...)
+			}
+			return path
+		}
+
+		g.visit(g.graph.From(v.ID()), func(neighbor gonumgraph.Node) {
+			if _, ok := parents[neighbor.ID()]; !ok {
+				parents[neighbor.ID()] = v
+				queue = append(queue, neighbor.(*vertex))
+			}
+		})
+	}
+
+	return nil
+}
+
+// verticesWithPathTo returns all vertices which have a path to <to>, including <to> itself.
+func (g *graph) verticesWithPathTo(to *vertex) []*vertex {
+	var (
+		visited = map[int64]struct{}{to.ID(): {}}
+		queue   = []*vertex{to}
+		out     []*vertex
+	)
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		out = append(out, v)
+
+		g.visit(g.graph.To(v.ID()), func(neighbor gonumgraph.Node) {
+			if _, ok := visited[neighbor.ID()]; !ok {
+				visited[neighbor.ID()] = struct{}{}
+				queue = append(queue, neighbor.(*vertex))
+			}
+		})
+	}
+
+	return out
+}
+
+// reachableVerticesOfType returns all vertices of the given type to which <from> has a path.
+func (g *graph) reachableVerticesOfType(from *vertex, vertexType VertexType) []*vertex {
+	var out []*vertex
+
+	(&traverse.DepthFirst{}).Walk(g.graph, from, func(n gonumgraph.Node) bool {
+		if v := n.(*vertex); v.vertexType == vertexType && v.ID() != from.ID() {
+			out = append(out, v)
+		}
+		return false
+	})
+
+	return out
+}
+
 func (g *graph) getOrCreateVertex(vertexType VertexType, namespace, name string) *vertex {
 	if v, ok := g.getVertex(vertexType, namespace, name); ok {
 		return v
@@ -739,6 +739,86 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasPathFrom(VertexTypeShoot, shoot1.Namespace, shoot1.Name, VertexTypeSeed, "", seed1.Name)).To(BeTrue())
 		Expect(graph.HasPathFrom(VertexTypeShootState, shoot1.Namespace, shoot1.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
 
+		By("Update (custom alerting rules config map name)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Monitoring = &gardencorev1beta1.Monitoring{Alerting: &gardencorev1beta1.Alerting{CustomRules: &gardencorev1beta1.AlertingCustomRules{ConfigMapName: "alerting-rules"}}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(20))
+		Expect(graph.graph.Edges().Len()).To(Equal(19))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "alerting-rules", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Monitoring = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(19))
+		Expect(graph.graph.Edges().Len()).To(Equal(18))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "alerting-rules", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
+		By("Update (remote write secret names)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Monitoring = &gardencorev1beta1.Monitoring{RemoteWrite: []gardencorev1beta1.MonitoringRemoteWrite{{Name: "central", SecretName: ptr.To("remote-write-credentials")}}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(20))
+		Expect(graph.graph.Edges().Len()).To(Equal(19))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "remote-write-credentials", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Monitoring = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(19))
+		Expect(graph.graph.Edges().Len()).To(Equal(18))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "remote-write-credentials", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
+		By("Update (logging output secret names)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Logging = &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{Name: "central", SecretName: ptr.To("log-export-credentials")}}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(20))
+		Expect(graph.graph.Edges().Len()).To(Equal(19))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "log-export-credentials", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Logging = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(19))
+		Expect(graph.graph.Edges().Len()).To(Equal(18))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "log-export-credentials", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
+		By("Update (audit webhook secret name)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer.AuditConfig = &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{Webhook: &gardencorev1beta1.AuditWebhookBackend{SecretName: ptr.To("audit-webhook-credentials")}}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(20))
+		Expect(graph.graph.Edges().Len()).To(Equal(19))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "audit-webhook-credentials", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer.AuditConfig = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(19))
+		Expect(graph.graph.Edges().Len()).To(Equal(18))
+		Expect(graph.HasPathFrom(VertexTypeSecret, shoot1.Namespace, "audit-webhook-credentials", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
+		By("Update (audit backend policy config map names)")
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer.AuditConfig = &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{
+			Log:     &gardencorev1beta1.AuditLogBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "audit-log-policy"}}},
+			Webhook: &gardencorev1beta1.AuditWebhookBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "audit-webhook-policy"}}},
+		}}
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(21))
+		Expect(graph.graph.Edges().Len()).To(Equal(20))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "audit-log-policy", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "audit-webhook-policy", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		shoot1Copy = shoot1.DeepCopy()
+		shoot1.Spec.Kubernetes.KubeAPIServer.AuditConfig = nil
+		fakeInformerShoot.Update(shoot1Copy, shoot1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(19))
+		Expect(graph.graph.Edges().Len()).To(Equal(18))
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "audit-log-policy", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+		Expect(graph.HasPathFrom(VertexTypeConfigMap, shoot1.Namespace, "audit-webhook-policy", VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+
 		By("Update (dns provider secrets)")
 		shoot1Copy = shoot1.DeepCopy()
 		shoot1.Spec.DNS = nil
@@ -7,6 +7,7 @@ package helper
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -343,6 +344,35 @@ func GetShootAuditPolicyConfigMapRef(apiServerConfig *core.KubeAPIServerConfig)
 	return nil
 }
 
+// GetShootAuditBackendPolicyConfigMapNames returns the names of the ConfigMaps containing the audit policies of the
+// Shoot's audit backends.
+func GetShootAuditBackendPolicyConfigMapNames(apiServerConfig *core.KubeAPIServerConfig) []string {
+	if apiServerConfig == nil || apiServerConfig.AuditConfig == nil || apiServerConfig.AuditConfig.Backends == nil {
+		return nil
+	}
+
+	var (
+		backends = apiServerConfig.AuditConfig.Backends
+		policies []*core.AuditPolicy
+		names    []string
+	)
+
+	if backends.Log != nil {
+		policies = append(policies, backends.Log.Policy)
+	}
+	if backends.Webhook != nil {
+		policies = append(policies, backends.Webhook.Policy)
+	}
+
+	for _, policy := range policies {
+		if policy != nil && policy.ConfigMapRef != nil && !slices.Contains(names, policy.ConfigMapRef.Name) {
+			names = append(names, policy.ConfigMapRef.Name)
+		}
+	}
+
+	return names
+}
+
 // GetShootAuthenticationConfigurationConfigMapName returns the Shoot's ConfigMap reference name for the aithentication configuration.
 func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *core.KubeAPIServerConfig) string {
 	if apiServerConfig != nil &&
@@ -352,6 +382,16 @@ func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *core.Kube
 	return ""
 }
 
+// GetShootAlertingCustomRulesConfigMapName returns the Shoot's ConfigMap reference name for the custom alerting rules.
+func GetShootAlertingCustomRulesConfigMapName(monitoring *core.Monitoring) string {
+	if monitoring != nil &&
+		monitoring.Alerting != nil &&
+		monitoring.Alerting.CustomRules != nil {
+		return monitoring.Alerting.CustomRules.ConfigMapName
+	}
+	return ""
+}
+
 // HibernationIsEnabled checks if the given shoot's desired state is hibernated.
 func HibernationIsEnabled(shoot *core.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && ptr.Deref(shoot.Spec.Hibernation.Enabled, false)
@@ -534,3 +574,20 @@ func DeterminePrimaryIPFamily(ipFamilies []core.IPFamily) core.IPFamily {
 func HasManagedIssuer(shoot *core.Shoot) bool {
 	return shoot.GetAnnotations()[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged
 }
+
+// GetMachineArchitectures returns the distinct CPU architectures of the machine type and the alternative machine types
+// of the given machine. Alternative machine types without an architecture use the one of the machine type.
+func GetMachineArchitectures(machine core.Machine) []string {
+	var architectures []string
+	if machine.Architecture != nil {
+		architectures = append(architectures, *machine.Architecture)
+	}
+
+	for _, alternativeType := range machine.AlternativeTypes {
+		if alternativeType.Architecture != nil && !slices.Contains(architectures, *alternativeType.Architecture) {
+			architectures = append(architectures, *alternativeType.Architecture)
+		}
+	}
+
+	return architectures
+}
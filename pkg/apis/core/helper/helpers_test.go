@@ -457,6 +457,34 @@ var _ = Describe("helper", func() {
 		}, "foo")
 	})
 
+	DescribeTable("#GetShootAuditBackendPolicyConfigMapNames",
+		func(kubeAPIServerConfig *core.KubeAPIServerConfig, expectedNames []string) {
+			Expect(GetShootAuditBackendPolicyConfigMapNames(kubeAPIServerConfig)).To(Equal(expectedNames))
+		},
+
+		Entry("KubeAPIServerConfig = nil", nil, nil),
+		Entry("AuditConfig = nil", &core.KubeAPIServerConfig{}, nil),
+		Entry("Backends = nil", &core.KubeAPIServerConfig{AuditConfig: &core.AuditConfig{}}, nil),
+		Entry("policies not set", &core.KubeAPIServerConfig{
+			AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{
+				Log:     &core.AuditLogBackend{},
+				Webhook: &core.AuditWebhookBackend{Policy: &core.AuditPolicy{}},
+			}},
+		}, nil),
+		Entry("policies set", &core.KubeAPIServerConfig{
+			AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{
+				Log:     &core.AuditLogBackend{Policy: &core.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "foo"}}},
+				Webhook: &core.AuditWebhookBackend{Policy: &core.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "bar"}}},
+			}},
+		}, []string{"foo", "bar"}),
+		Entry("same policy for both backends", &core.KubeAPIServerConfig{
+			AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{
+				Log:     &core.AuditLogBackend{Policy: &core.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "foo"}}},
+				Webhook: &core.AuditWebhookBackend{Policy: &core.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "foo"}}},
+			}},
+		}, []string{"foo"}),
+	)
+
 	Describe("GetShootAuditPolicyConfigMapRef", func() {
 		test := func(description string, config *core.KubeAPIServerConfig, expectedRef *corev1.ObjectReference) {
 			It(description, Offset(1), func() {
@@ -501,6 +529,23 @@ var _ = Describe("helper", func() {
 		}, "foo"),
 	)
 
+	DescribeTable("#GetShootAlertingCustomRulesConfigMapName",
+		func(monitoring *core.Monitoring, expectedName string) {
+			Expect(GetShootAlertingCustomRulesConfigMapName(monitoring)).To(Equal(expectedName))
+		},
+
+		Entry("Monitoring = nil", nil, ""),
+		Entry("Alerting = nil", &core.Monitoring{}, ""),
+		Entry("CustomRules = nil", &core.Monitoring{Alerting: &core.Alerting{}}, ""),
+		Entry("ConfigMapName set", &core.Monitoring{
+			Alerting: &core.Alerting{
+				CustomRules: &core.AlertingCustomRules{
+					ConfigMapName: "foo",
+				},
+			},
+		}, "foo"),
+	)
+
 	DescribeTable("#HibernationIsEnabled",
 		func(shoot *core.Shoot, hibernated bool) {
 			Expect(HibernationIsEnabled(shoot)).To(Equal(hibernated))
@@ -1024,4 +1069,21 @@ var _ = Describe("helper", func() {
 			Expect(DeterminePrimaryIPFamily([]core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4})).To(Equal(core.IPFamilyIPv6))
 		})
 	})
+
+	DescribeTable("#GetMachineArchitectures",
+		func(machine core.Machine, expected []string) {
+			Expect(GetMachineArchitectures(machine)).To(Equal(expected))
+		},
+
+		Entry("no architecture", core.Machine{}, nil),
+		Entry("only machine architecture", core.Machine{Architecture: ptr.To("amd64")}, []string{"amd64"}),
+		Entry("alternative types with same or without architecture", core.Machine{
+			Architecture:     ptr.To("amd64"),
+			AlternativeTypes: []core.AlternativeMachineType{{Type: "foo", Architecture: ptr.To("amd64")}, {Type: "bar"}},
+		}, []string{"amd64"}),
+		Entry("alternative types with different architectures", core.Machine{
+			Architecture:     ptr.To("amd64"),
+			AlternativeTypes: []core.AlternativeMachineType{{Type: "foo", Architecture: ptr.To("arm64")}, {Type: "bar", Architecture: ptr.To("amd64")}},
+		}, []string{"amd64", "arm64"}),
+	)
 })
@@ -47,6 +47,9 @@ type BackupBucketSpec struct {
 	// SeedName holds the name of the seed allocated to BackupBucket for running controller.
 	// This field is immutable.
 	SeedName *string
+	// Replication configures the continuous replication of the backups stored in this bucket to a secondary object
+	// store, e.g., in a different region or of a different provider.
+	Replication *BackupBucketReplication
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -72,3 +75,20 @@ type BackupBucketProvider struct {
 	// Region is the region of the bucket.
 	Region string
 }
+
+// BackupBucketReplication contains the configuration for replicating the backups of a BackupBucket to a secondary
+// object store.
+type BackupBucketReplication struct {
+	// Provider holds the details of cloud provider of the secondary object store.
+	Provider BackupBucketProvider
+	// ProviderConfig is the configuration passed to the BackupBucket resource of the secondary object store.
+	ProviderConfig *runtime.RawExtension
+	// SecretRef is a reference to a secret that contains the credentials to access the secondary object store.
+	SecretRef corev1.SecretReference
+	// MaxBackupAge is the maximum age in days of the backups which are copied to the secondary object store.
+	// Older backups are not replicated. If not set, all backups are replicated.
+	MaxBackupAge *int32
+	// MaxBackups is the maximum number of the most recent full backups which are copied to the secondary object
+	// store. If not set, all backups are replicated.
+	MaxBackups *int32
+}
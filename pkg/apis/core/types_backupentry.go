@@ -60,4 +60,18 @@ type BackupEntryStatus struct {
 	SeedName *string
 	// MigrationStartTime is the time when a migration to a different seed was initiated.
 	MigrationStartTime *metav1.Time
+	// Replication contains information about the replication of this BackupEntry to the secondary object store.
+	Replication *BackupEntryReplicationStatus
+}
+
+// BackupEntryReplicationStatus contains information about the replication of a BackupEntry to the secondary object
+// store of its BackupBucket.
+type BackupEntryReplicationStatus struct {
+	// LastReplicationTime is the time when the last successful replication was started. All backups taken before this
+	// time are available in the secondary object store.
+	LastReplicationTime *metav1.Time
+	// Lag is the duration between now and the last successful replication at the time the status was updated.
+	Lag *metav1.Duration
+	// LastError holds information about the last occurred error during the replication.
+	LastError *LastError
 }
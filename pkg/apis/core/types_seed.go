@@ -111,6 +111,9 @@ type SeedBackup struct {
 	// the object store where backups should be stored. It should have enough privileges to manipulate
 	// the objects as well as buckets.
 	SecretRef corev1.SecretReference
+	// Replication configures the continuous replication of the backups to a secondary object store, e.g., in a
+	// different region or of a different provider.
+	Replication *BackupBucketReplication
 }
 
 // SeedDNS contains the external domain and configuration for the DNS provider
@@ -107,6 +107,8 @@ type ShootSpec struct {
 	// CredentialsBindingName is the name of the a CredentialsBinding that has a reference to the provider credentials.
 	// The credentials will be used to create the shoot in the respective account. The field is mutually exclusive with SecretBindingName.
 	CredentialsBindingName *string
+	// Logging contains information about custom logging configurations for the shoot.
+	Logging *Logging
 }
 
 var _ Object = (*Shoot)(nil)
@@ -165,6 +167,8 @@ type ShootStatus struct {
 	EncryptedResources []string
 	// Networking contains information about cluster networking such as CIDRs.
 	Networking *NetworkingStatus
+	// LastETCDRestore holds information about the last restore of the main etcd from its backup.
+	LastETCDRestore *LastETCDRestore
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -179,6 +183,18 @@ type LastMaintenance struct {
 	FailureReason *string
 }
 
+// LastETCDRestore holds information about a restore of the main etcd of the Shoot from the latest snapshot in its backup. Restoring to an arbitrary point in time is not supported.
+type LastETCDRestore struct {
+	// A human-readable message containing details about the restore.
+	Description string
+	// TriggeredTime is the time when the restore was triggered.
+	TriggeredTime metav1.Time
+	// Status of the restore, one of Processing, Succeeded.
+	State LastOperationState
+	// CompletionTime is the time when the restore was completed.
+	CompletionTime *metav1.Time
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -686,6 +702,56 @@ type ServiceAccountConfig struct {
 type AuditConfig struct {
 	// AuditPolicy contains configuration settings for audit policy of the kube-apiserver.
 	AuditPolicy *AuditPolicy
+	// Backends contains the configuration of the backends to which the audit events are forwarded.
+	Backends *AuditBackends
+}
+
+// AuditBackends contains the configuration of the backends to which the audit events are forwarded.
+type AuditBackends struct {
+	// Log ships the audit events into the logging stack of the shoot control plane.
+	Log *AuditLogBackend
+	// Webhook sends the audit events to an external HTTPS endpoint.
+	Webhook *AuditWebhookBackend
+}
+
+// AuditLogBackend contains the configuration of the audit log backend.
+type AuditLogBackend struct {
+	// Policy contains the audit policy for this backend.
+	Policy *AuditPolicy
+}
+
+// AuditWebhookBackend contains the configuration of the audit webhook backend.
+type AuditWebhookBackend struct {
+	// URL is the HTTPS endpoint to which the audit events are sent.
+	URL string
+	// SecretName is the name of a secret in the project namespace containing the credentials for the endpoint and the
+	// key for signing the batches.
+	SecretName *string
+	// Policy contains the audit policy for this backend.
+	Policy *AuditPolicy
+	// Batch contains the settings for batching audit events.
+	Batch *AuditBatch
+	// Truncate contains the settings for truncating audit events and batches.
+	Truncate *AuditTruncate
+	// InitialBackoff is the amount of time to wait before retrying the first failed request.
+	InitialBackoff *metav1.Duration
+}
+
+// AuditBatch contains the settings for batching audit events.
+type AuditBatch struct {
+	// MaxSize is the maximum number of events in a batch.
+	MaxSize *int32
+	// MaxWait is the amount of time to wait before force sending a batch that has not reached the maximum size.
+	MaxWait *metav1.Duration
+}
+
+// AuditTruncate contains the settings for truncating audit events and batches.
+type AuditTruncate struct {
+	// MaxBatchSize is the maximum size of a batch sent to the backend. Larger batches are split.
+	MaxBatchSize *resource.Quantity
+	// MaxEventSize is the maximum size of an audit event sent to the backend. Larger events are truncated by removing
+	// the request and response objects, or dropped if this is not sufficient.
+	MaxEventSize *resource.Quantity
 }
 
 // AuditPolicy contains audit policy for kube-apiserver
@@ -1102,12 +1168,115 @@ type MaintenanceTimeWindow struct {
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
 	Alerting *Alerting
+	// RemoteWrite is a list of endpoints to which metrics of the shoot control plane are exported via the Prometheus
+	// remote write protocol.
+	RemoteWrite []MonitoringRemoteWrite
+}
+
+// MonitoringRemoteWrite contains information about an endpoint to which metrics of the shoot control plane are
+// exported.
+type MonitoringRemoteWrite struct {
+	// Name is the name of the remote write endpoint.
+	Name string
+	// URL is the HTTPS endpoint to which metrics are sent.
+	URL string
+	// SecretName is the name of a secret in the project namespace which contains basic auth credentials (`username`
+	// and `password`) for the remote write endpoint.
+	SecretName *string
+	// Keep is the list of names of metrics which are exported to the remote write endpoint. All other metrics are
+	// dropped.
+	Keep []string
+}
+
+// Logging contains information about the logging configuration for the shoot.
+type Logging struct {
+	// Outputs is a list of additional endpoints to which logs of the shoot control plane and/or the shoot events are
+	// exported. The logs are still sent to the Gardener-managed logging stack.
+	Outputs []LoggingOutput
+}
+
+// LoggingOutput contains information about an endpoint to which logs are exported.
+type LoggingOutput struct {
+	// Name is the name of the output.
+	Name string
+	// Type is the protocol used to send the logs to the endpoint. Supported values are `OTLP` (OpenTelemetry protocol
+	// over HTTP) and `HTTP` (JSON lines over HTTP).
+	Type LoggingOutputType
+	// URL is the HTTPS endpoint to which logs are sent.
+	URL string
+	// SecretName is the name of a secret in the project namespace which contains basic auth credentials (`username`
+	// and `password`) for the endpoint.
+	SecretName *string
+	// Sources is the list of log streams which are exported to the endpoint. Supported values are `ControlPlane` (logs
+	// of the shoot control plane components) and `Events` (events of the shoot cluster collected by the event logger).
+	Sources []LoggingOutputSource
+	// RateLimit limits the rate with which log records are sent to the endpoint. Records exceeding the limit are
+	// dropped.
+	RateLimit *LoggingOutputRateLimit
+	// Buffer contains settings for buffering log records which could not be sent to the endpoint yet.
+	Buffer *LoggingOutputBuffer
+}
+
+// LoggingOutputType is a type alias for the protocol of a logging output.
+type LoggingOutputType string
+
+const (
+	// LoggingOutputTypeOTLP is a constant for the OpenTelemetry protocol (OTLP/HTTP).
+	LoggingOutputTypeOTLP LoggingOutputType = "OTLP"
+	// LoggingOutputTypeHTTP is a constant for a generic HTTP endpoint receiving JSON lines.
+	LoggingOutputTypeHTTP LoggingOutputType = "HTTP"
+)
+
+// LoggingOutputSource is a type alias for a log stream which can be exported.
+type LoggingOutputSource string
+
+const (
+	// LoggingOutputSourceControlPlane is a constant for the logs of the shoot control plane components.
+	LoggingOutputSourceControlPlane LoggingOutputSource = "ControlPlane"
+	// LoggingOutputSourceEvents is a constant for the events of the shoot cluster collected by the event logger.
+	LoggingOutputSourceEvents LoggingOutputSource = "Events"
+)
+
+// LoggingOutputRateLimit contains settings for limiting the rate of exported log records.
+type LoggingOutputRateLimit struct {
+	// RecordsPerSecond is the maximum number of log records per second which are sent to the endpoint.
+	RecordsPerSecond int32
+}
+
+// LoggingOutputBuffer contains settings for buffering log records.
+type LoggingOutputBuffer struct {
+	// MemoryLimit is the maximum amount of memory used for buffering log records of this output.
+	MemoryLimit *resource.Quantity
+	// RetryLimit is the maximum number of retries for sending a chunk of log records before it is discarded.
+	RetryLimit *int32
 }
 
 // Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).
 type Alerting struct {
 	// MonitoringEmailReceivers is a list of recipients for alerts
 	EmailReceivers []string
+	// WebhookReceivers is a list of generic HTTP endpoints to which alerts are sent.
+	WebhookReceivers []AlertingWebhookReceiver
+	// CustomRules references user-defined Prometheus alerting rules which are evaluated by the monitoring stack of the
+	// shoot cluster.
+	CustomRules *AlertingCustomRules
+}
+
+// AlertingWebhookReceiver contains information about a generic HTTP endpoint to which alerts are sent.
+type AlertingWebhookReceiver struct {
+	// Name is the name of the receiver.
+	Name string
+	// URL is the HTTPS endpoint to which alerts are sent in the Alertmanager webhook format. It must not point to loopback, private, link-local or cluster-internal addresses.
+	URL string
+	// SendResolved controls whether notifications about resolved alerts are sent (default: true).
+	SendResolved *bool
+}
+
+// AlertingCustomRules references user-defined Prometheus alerting rules.
+type AlertingCustomRules struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains the alerting rules under the
+	// 'rules.yaml' key. The rules must be given in the format of the `spec` of a PrometheusRule resource.
+	ConfigMapName string
 }
 
 // Provider contains provider-specific information that are handed-over to the provider-specific
@@ -1177,6 +1346,88 @@ type Worker struct {
 	Sysctls map[string]string
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	ClusterAutoscaler *ClusterAutoscalerOptions
+	// KernelModules is a list of kernel modules to load on all machines in this worker pool.
+	KernelModules []KernelModule
+	// HugePages is a list of huge pages configurations to apply on all machines in this worker pool.
+	HugePages []HugePages
+	// UpdateStrategy specifies how the machines of this worker pool are updated when their configuration changes.
+	UpdateStrategy *MachineUpdateStrategy
+	// DrainPolicy contains the policy for draining the nodes of this worker pool before their machines are replaced or
+	// updated in-place. The drain timeout and the maximum number of eviction retries are configured in the
+	// `machineControllerManager` settings.
+	DrainPolicy *WorkerDrainPolicy
+}
+
+// MachineUpdateStrategy is the update strategy for the machines of a worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// AutoRollingUpdate is the update strategy which replaces the machines of a worker pool with new machines.
+	AutoRollingUpdate MachineUpdateStrategy = "AutoRollingUpdate"
+	// AutoInPlaceUpdate is the update strategy which updates the operating system and the kubelet on the existing
+	// machines of a worker pool. Changes which cannot be applied in-place (e.g., the machine type) still replace the
+	// machines.
+	AutoInPlaceUpdate MachineUpdateStrategy = "AutoInPlaceUpdate"
+)
+
+// WorkerDrainPolicy contains the policy for draining the nodes of a worker pool.
+type WorkerDrainPolicy struct {
+	// EvictionOrder specifies the order in which the pods of a node are evicted.
+	EvictionOrder *PodEvictionOrder
+	// PreDrainHooks is a list of webhooks which are called before the pods of a node are evicted.
+	PreDrainHooks []PreDrainHook
+}
+
+// PodEvictionOrder is the order in which the pods of a node are evicted.
+type PodEvictionOrder string
+
+const (
+	// PodEvictionOrderParallel evicts all pods of a node at the same time.
+	PodEvictionOrderParallel PodEvictionOrder = "Parallel"
+	// PodEvictionOrderPriorityAscending evicts the pods of a node in ascending order of their priority. Pods with a
+	// higher priority are only evicted once all pods with a lower priority are gone.
+	PodEvictionOrderPriorityAscending PodEvictionOrder = "PriorityAscending"
+)
+
+// PreDrainHook is a webhook which is called before the pods of a node are evicted.
+type PreDrainHook struct {
+	// Name is the name of the hook.
+	Name string
+	// URL is the HTTPS URL of the webhook.
+	URL string
+	// CABundle is a PEM encoded CA bundle which is used to verify the serving certificate of the webhook. If it is not
+	// set, the system trust roots of the machine are used.
+	CABundle *string
+	// Timeout is the maximum duration for the webhook call.
+	Timeout *metav1.Duration
+	// FailurePolicy defines how a failed webhook call is handled.
+	FailurePolicy *PreDrainHookFailurePolicy
+}
+
+// PreDrainHookFailurePolicy defines how a failed pre-drain hook call is handled.
+type PreDrainHookFailurePolicy string
+
+const (
+	// PreDrainHookFailurePolicyFail aborts the drain if the hook call fails. The drain is retried later.
+	PreDrainHookFailurePolicyFail PreDrainHookFailurePolicy = "Fail"
+	// PreDrainHookFailurePolicyIgnore continues the drain if the hook call fails.
+	PreDrainHookFailurePolicyIgnore PreDrainHookFailurePolicy = "Ignore"
+)
+
+// KernelModule contains configuration for a kernel module.
+type KernelModule struct {
+	// Name is the name of the kernel module.
+	Name string
+	// Parameters is a map of parameters passed to the kernel module when it is loaded.
+	Parameters map[string]string
+}
+
+// HugePages contains configuration for huge pages of a certain size.
+type HugePages struct {
+	// PageSize is the size of the huge pages, e.g., 2Mi or 1Gi.
+	PageSize resource.Quantity
+	// Count is the number of huge pages of the given size which are allocated.
+	Count int32
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -1234,6 +1485,20 @@ type Machine struct {
 	Image *ShootMachineImage
 	// Architecture is the CPU architecture of the machines in this worker pool.
 	Architecture *string
+	// AlternativeTypes is a prioritized list of further machine types of the worker group. They are used if no capacity
+	// is available for machines of a type with a higher priority.
+	AlternativeTypes []AlternativeMachineType
+}
+
+// AlternativeMachineType contains information about an alternative machine type of a worker group.
+type AlternativeMachineType struct {
+	// Type is the machine type.
+	Type string
+	// Architecture is the CPU architecture of the machines of this type.
+	Architecture *string
+	// ProviderConfig is the provider-specific configuration for the machines of this type, e.g., whether spot
+	// instances shall be used.
+	ProviderConfig *runtime.RawExtension
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1278,6 +1543,18 @@ type CRI struct {
 	Name CRIName
 	// ContainerRuntimes is the list of the required container runtimes supported for a worker pool.
 	ContainerRuntimes []ContainerRuntime
+	// ImagePrePull configures images which are pre-pulled on the nodes of the worker pool and pinned against garbage
+	// collection.
+	ImagePrePull *ImagePrePull
+}
+
+// ImagePrePull contains configuration for pre-pulling images on the nodes of a worker pool.
+type ImagePrePull struct {
+	// SystemComponents states whether the images of the system components running on every node of the worker pool
+	// (e.g., kube-proxy, node-local-dns) are pre-pulled.
+	SystemComponents *bool
+	// Images is a list of additional image references which are pre-pulled.
+	Images []string
 }
 
 // CRIName is a type alias for the CRI name string.
@@ -1376,6 +1653,8 @@ const (
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
 	ShootEventK8sVersionMaintenance = "KubernetesVersionMaintenance"
+	// ShootEventMaintenanceRolloutDeferred indicates that an automatic update has been deferred by the rollout policy.
+	ShootEventMaintenanceRolloutDeferred = "MaintenanceRolloutDeferred"
 	// ShootEventHibernationEnabled indicates that hibernation started.
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
@@ -1402,6 +1681,9 @@ const (
 	// ShootMaintenancePreconditionsSatisfied is a constant for a condition type indicating whether all preconditions
 	// for a shoot maintenance operation are satisfied.
 	ShootMaintenancePreconditionsSatisfied ConditionType = "MaintenancePreconditionsSatisfied"
+	// ShootNoRemovedAPIsInUse is a constant for a condition type indicating whether the Shoot cluster does not use any
+	// deprecated APIs which are removed in the next minor Kubernetes version.
+	ShootNoRemovedAPIsInUse ConditionType = "NoRemovedAPIsInUse"
 )
 
 // DNSUnmanaged is a constant for the 'unmanaged' DNS provider.
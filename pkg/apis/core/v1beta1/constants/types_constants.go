@@ -211,6 +211,10 @@ const (
 	// GardenerTimestamp is a constant for an annotation on a resource that describes the timestamp when a reconciliation has been requested.
 	// It is only used to guarantee an update event for watching clients in case the operation-annotation is already present.
 	GardenerTimestamp = "gardener.cloud/timestamp"
+	// GardenerOperationID is a constant for an annotation on a resource that contains the ID of the operation (e.g., a
+	// Shoot reconciliation) which last requested its reconciliation. It is used to correlate the logs of the components
+	// involved in this operation.
+	GardenerOperationID = "gardener.cloud/operation-id"
 	// GardenerOperationMigrate is a constant for the value of the operation annotation describing a migration
 	// operation.
 	GardenerOperationMigrate = "migrate"
@@ -371,6 +375,9 @@ const (
 	// ShootOperationRotateSSHKeypair is a constant for an annotation on a Shoot indicating that the SSH keypair for the
 	// shoot nodes shall be rotated.
 	ShootOperationRotateSSHKeypair = "rotate-ssh-keypair"
+	// ShootOperationRestoreETCD is a constant for an annotation on a Shoot indicating that the main etcd of the Shoot
+	// shall be restored from the latest snapshot in its backup. Restoring to an arbitrary point in time is not supported.
+	ShootOperationRestoreETCD = "restore-etcd"
 	// OperationRotateCAStart is a constant for an annotation indicating that the rotation of the certificate
 	// authorities shall be started.
 	OperationRotateCAStart = "rotate-ca-start"
@@ -523,6 +530,11 @@ const (
 	// garden or shoot components which serve a webhook endpoint that must be reachable by the kube-apiserver.
 	// See https://github.com/gardener/gardener/blob/master/docs/concepts/resource-manager.md#overwriting-the-pod-selector-label.
 	LabelNetworkPolicyWebhookTargets = "all-webhook-targets"
+	// LabelNetworkPolicyAttestationVerifierTargets is a constant for pod selector label which can be used on Services
+	// for shoot components which verify attestation documents of machines and must be reachable by the
+	// gardener-resource-manager.
+	// See https://github.com/gardener/gardener/blob/master/docs/concepts/resource-manager.md#overwriting-the-pod-selector-label.
+	LabelNetworkPolicyAttestationVerifierTargets = "all-attestation-verifier-targets"
 	// LabelNetworkPolicyShootNamespaceAlias is a constant for the alias for shoot namespaces used in NetworkPolicy
 	// labels.
 	LabelNetworkPolicyShootNamespaceAlias = "all-shoots"
@@ -650,6 +662,16 @@ const (
 	AnnotationShootSkipCleanup = "shoot.gardener.cloud/skip-cleanup"
 	// AnnotationShootSkipReadiness is a key for an annotation on a Shoot resource that instructs the shoot flow to skip readiness steps during reconciliation.
 	AnnotationShootSkipReadiness = "shoot.gardener.cloud/skip-readiness"
+	// AnnotationShootSkipRemovedAPIsCheck is a key for an annotation on a Shoot resource that allows updating the
+	// Kubernetes version to the next minor version even though the 'NoRemovedAPIsInUse' constraint reports that APIs
+	// which are removed in this version are still in use. In this case, only a warning is returned.
+	AnnotationShootSkipRemovedAPIsCheck = "shoot.gardener.cloud/skip-removed-apis-check"
+	// AnnotationShootBastionApprovalRequired is a key for an annotation on a Shoot resource that enforces that Bastions
+	// for this Shoot must be approved by a user other than their creator before the bastion host is created.
+	AnnotationShootBastionApprovalRequired = "shoot.gardener.cloud/bastion-approval-required"
+	// AnnotationShootBastionSessionRecordingRequired is a key for an annotation on a Shoot resource that enforces that
+	// shell sessions on Bastions for this Shoot are recorded.
+	AnnotationShootBastionSessionRecordingRequired = "shoot.gardener.cloud/bastion-session-recording-required"
 	// AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds is a key for an annotation on a Shoot resource that
 	// declares the grace period in seconds for finalizing the resources handled in the 'cleanup webhooks' step.
 	// Concretely, after the specified seconds, all the finalizers of the affected resources are forcefully removed.
@@ -672,6 +694,10 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationETCDRestoreTriggeredTime is a key for an annotation on the main Etcd resource of a Shoot. Its value is
+	// the time when the restore of the main etcd from its backup was triggered for which the Etcd resource was
+	// re-created. It is used to perform the restore at most once.
+	AnnotationETCDRestoreTriggeredTime = "shoot.gardener.cloud/etcd-restore-triggered-time"
 
 	// AnnotationAuthenticationIssuer is the key for an annotation applied to a Shoot which specifies
 	// if the shoot's issuer is managed by Gardener.
@@ -790,6 +816,11 @@ const (
 	DataKeyBackupBucketName string = "bucketName"
 	// BackupSourcePrefix is the prefix for names of resources related to source backupentries when copying backups.
 	BackupSourcePrefix = "source"
+	// BackupReplicaPrefix is the prefix for names of resources related to the replicas of backupbuckets and
+	// backupentries in the secondary object store.
+	BackupReplicaPrefix = "replica"
+	// BackupBastionSessionsPrefix is the prefix for names of backupentries holding the session recordings of bastions.
+	BackupBastionSessionsPrefix = "bastion-sessions"
 
 	// GardenerAudience is the identifier for Gardener controllers when interacting with the API Server
 	GardenerAudience = "gardener"
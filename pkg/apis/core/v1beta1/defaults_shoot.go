@@ -52,6 +52,12 @@ func SetDefaults_Shoot(obj *Shoot) {
 			obj.Spec.Provider.Workers[i].Machine.Architecture = ptr.To(v1beta1constants.ArchitectureAMD64)
 		}
 
+		for j, alternativeType := range obj.Spec.Provider.Workers[i].Machine.AlternativeTypes {
+			if alternativeType.Architecture == nil {
+				obj.Spec.Provider.Workers[i].Machine.AlternativeTypes[j].Architecture = ptr.To(*obj.Spec.Provider.Workers[i].Machine.Architecture)
+			}
+		}
+
 		if worker.CRI == nil {
 			obj.Spec.Provider.Workers[i].CRI = &CRI{Name: CRINameContainerD}
 		}
@@ -278,6 +284,17 @@ func SetDefaults_VerticalPodAutoscaler(obj *VerticalPodAutoscaler) {
 
 // SetDefaults_Worker sets default values for Worker objects.
 func SetDefaults_Worker(obj *Worker) {
+	// Machines of worker pools updated in-place are not surged, hence at least one machine must be allowed to be
+	// unavailable.
+	if obj.UpdateStrategy != nil && *obj.UpdateStrategy == AutoInPlaceUpdate {
+		if obj.MaxSurge == nil {
+			obj.MaxSurge = &DefaultInPlaceWorkerMaxSurge
+		}
+		if obj.MaxUnavailable == nil {
+			obj.MaxUnavailable = &DefaultInPlaceWorkerMaxUnavailable
+		}
+	}
+
 	if obj.MaxSurge == nil {
 		obj.MaxSurge = &DefaultWorkerMaxSurge
 	}
@@ -289,6 +306,18 @@ func SetDefaults_Worker(obj *Worker) {
 			Allow: DefaultWorkerSystemComponentsAllow,
 		}
 	}
+
+	if obj.DrainPolicy != nil {
+		for i := range obj.DrainPolicy.PreDrainHooks {
+			hook := &obj.DrainPolicy.PreDrainHooks[i]
+			if hook.Timeout == nil {
+				hook.Timeout = &metav1.Duration{Duration: 30 * time.Second}
+			}
+			if hook.FailurePolicy == nil {
+				hook.FailurePolicy = ptr.To(PreDrainHookFailurePolicyFail)
+			}
+		}
+	}
 }
 
 // SetDefaults_ClusterAutoscaler sets default values for ClusterAutoscaler object.
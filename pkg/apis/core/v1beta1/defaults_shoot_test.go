@@ -701,6 +701,24 @@ var _ = Describe("Shoot defaulting", func() {
 		Expect(*obj.Spec.Provider.Workers[1].Machine.Architecture).To(Equal("test"))
 	})
 
+	It("should default architecture of alternative machine types to the one of the worker's machine", func() {
+		obj.Spec.Provider.Workers = []Worker{
+			{Name: "worker",
+				Machine: Machine{
+					Architecture: ptr.To(v1beta1constants.ArchitectureARM64),
+					AlternativeTypes: []AlternativeMachineType{
+						{Type: "type-1"},
+						{Type: "type-2", Architecture: ptr.To(v1beta1constants.ArchitectureAMD64)},
+					},
+				}},
+		}
+
+		SetObjectDefaults_Shoot(obj)
+
+		Expect(obj.Spec.Provider.Workers[0].Machine.AlternativeTypes[0].Architecture).To(PointTo(Equal(v1beta1constants.ArchitectureARM64)))
+		Expect(obj.Spec.Provider.Workers[0].Machine.AlternativeTypes[1].Architecture).To(PointTo(Equal(v1beta1constants.ArchitectureAMD64)))
+	})
+
 	It("should default worker cri.name to containerd", func() {
 		obj.Spec.Provider.Workers = []Worker{
 			{Name: "DefaultWorker"},
@@ -797,6 +815,31 @@ var _ = Describe("Shoot defaulting", func() {
 				Expect(worker.SystemComponents.Allow).To(BeFalse())
 			}
 		})
+
+		It("should default maxSurge and maxUnavailable for workers updated in-place", func() {
+			obj.Spec.Provider.Workers = []Worker{{UpdateStrategy: ptr.To(AutoInPlaceUpdate)}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			worker := obj.Spec.Provider.Workers[0]
+			Expect(worker.MaxSurge).To(PointTo(Equal(intstr.FromInt32(0))))
+			Expect(worker.MaxUnavailable).To(PointTo(Equal(intstr.FromInt32(1))))
+		})
+
+		It("should default the timeout and the failure policy of pre-drain hooks", func() {
+			obj.Spec.Provider.Workers = []Worker{{DrainPolicy: &WorkerDrainPolicy{PreDrainHooks: []PreDrainHook{
+				{Name: "foo"},
+				{Name: "bar", Timeout: &metav1.Duration{Duration: time.Minute}, FailurePolicy: ptr.To(PreDrainHookFailurePolicyIgnore)},
+			}}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			hooks := obj.Spec.Provider.Workers[0].DrainPolicy.PreDrainHooks
+			Expect(hooks[0].Timeout).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+			Expect(hooks[0].FailurePolicy).To(PointTo(Equal(PreDrainHookFailurePolicyFail)))
+			Expect(hooks[1].Timeout).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(hooks[1].FailurePolicy).To(PointTo(Equal(PreDrainHookFailurePolicyIgnore)))
+		})
 	})
 
 	Describe("ClusterAutoscaler defaulting", func() {
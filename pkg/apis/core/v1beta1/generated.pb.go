@@ -15,11 +15,11 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
 	v14 "k8s.io/api/rbac/v1"
 	v12 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
-	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	math "math"
@@ -210,10 +210,150 @@ func (m *Alerting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Alerting proto.InternalMessageInfo
 
+func (m *AlertingCustomRules) Reset()      { *m = AlertingCustomRules{} }
+func (*AlertingCustomRules) ProtoMessage() {}
+func (*AlertingCustomRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{6}
+}
+func (m *AlertingCustomRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertingCustomRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AlertingCustomRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertingCustomRules.Merge(m, src)
+}
+func (m *AlertingCustomRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertingCustomRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertingCustomRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertingCustomRules proto.InternalMessageInfo
+
+func (m *AlertingWebhookReceiver) Reset()      { *m = AlertingWebhookReceiver{} }
+func (*AlertingWebhookReceiver) ProtoMessage() {}
+func (*AlertingWebhookReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{7}
+}
+func (m *AlertingWebhookReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertingWebhookReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AlertingWebhookReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertingWebhookReceiver.Merge(m, src)
+}
+func (m *AlertingWebhookReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertingWebhookReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertingWebhookReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertingWebhookReceiver proto.InternalMessageInfo
+
+func (m *AlternativeMachineType) Reset()      { *m = AlternativeMachineType{} }
+func (*AlternativeMachineType) ProtoMessage() {}
+func (*AlternativeMachineType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
+}
+func (m *AlternativeMachineType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlternativeMachineType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AlternativeMachineType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlternativeMachineType.Merge(m, src)
+}
+func (m *AlternativeMachineType) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlternativeMachineType) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlternativeMachineType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlternativeMachineType proto.InternalMessageInfo
+
+func (m *AuditBackends) Reset()      { *m = AuditBackends{} }
+func (*AuditBackends) ProtoMessage() {}
+func (*AuditBackends) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
+}
+func (m *AuditBackends) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditBackends) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditBackends) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditBackends.Merge(m, src)
+}
+func (m *AuditBackends) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditBackends) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditBackends.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditBackends proto.InternalMessageInfo
+
+func (m *AuditBatch) Reset()      { *m = AuditBatch{} }
+func (*AuditBatch) ProtoMessage() {}
+func (*AuditBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+}
+func (m *AuditBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditBatch.Merge(m, src)
+}
+func (m *AuditBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditBatch proto.InternalMessageInfo
+
 func (m *AuditConfig) Reset()      { *m = AuditConfig{} }
 func (*AuditConfig) ProtoMessage() {}
 func (*AuditConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{6}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
 }
 func (m *AuditConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,10 +378,38 @@ func (m *AuditConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuditConfig proto.InternalMessageInfo
 
+func (m *AuditLogBackend) Reset()      { *m = AuditLogBackend{} }
+func (*AuditLogBackend) ProtoMessage() {}
+func (*AuditLogBackend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+}
+func (m *AuditLogBackend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditLogBackend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditLogBackend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogBackend.Merge(m, src)
+}
+func (m *AuditLogBackend) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLogBackend) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogBackend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogBackend proto.InternalMessageInfo
+
 func (m *AuditPolicy) Reset()      { *m = AuditPolicy{} }
 func (*AuditPolicy) ProtoMessage() {}
 func (*AuditPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{7}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
 }
 func (m *AuditPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,10 +434,66 @@ func (m *AuditPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuditPolicy proto.InternalMessageInfo
 
+func (m *AuditTruncate) Reset()      { *m = AuditTruncate{} }
+func (*AuditTruncate) ProtoMessage() {}
+func (*AuditTruncate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
+}
+func (m *AuditTruncate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditTruncate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditTruncate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditTruncate.Merge(m, src)
+}
+func (m *AuditTruncate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditTruncate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditTruncate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditTruncate proto.InternalMessageInfo
+
+func (m *AuditWebhookBackend) Reset()      { *m = AuditWebhookBackend{} }
+func (*AuditWebhookBackend) ProtoMessage() {}
+func (*AuditWebhookBackend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
+}
+func (m *AuditWebhookBackend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditWebhookBackend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditWebhookBackend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditWebhookBackend.Merge(m, src)
+}
+func (m *AuditWebhookBackend) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditWebhookBackend) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditWebhookBackend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditWebhookBackend proto.InternalMessageInfo
+
 func (m *AvailabilityZone) Reset()      { *m = AvailabilityZone{} }
 func (*AvailabilityZone) ProtoMessage() {}
 func (*AvailabilityZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
 }
 func (m *AvailabilityZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +521,7 @@ var xxx_messageInfo_AvailabilityZone proto.InternalMessageInfo
 func (m *BackupBucket) Reset()      { *m = BackupBucket{} }
 func (*BackupBucket) ProtoMessage() {}
 func (*BackupBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
 }
 func (m *BackupBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +549,7 @@ var xxx_messageInfo_BackupBucket proto.InternalMessageInfo
 func (m *BackupBucketList) Reset()      { *m = BackupBucketList{} }
 func (*BackupBucketList) ProtoMessage() {}
 func (*BackupBucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
 }
 func (m *BackupBucketList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +577,7 @@ var xxx_messageInfo_BackupBucketList proto.InternalMessageInfo
 func (m *BackupBucketProvider) Reset()      { *m = BackupBucketProvider{} }
 func (*BackupBucketProvider) ProtoMessage() {}
 func (*BackupBucketProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
 }
 func (m *BackupBucketProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,10 +602,38 @@ func (m *BackupBucketProvider) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BackupBucketProvider proto.InternalMessageInfo
 
+func (m *BackupBucketReplication) Reset()      { *m = BackupBucketReplication{} }
+func (*BackupBucketReplication) ProtoMessage() {}
+func (*BackupBucketReplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+}
+func (m *BackupBucketReplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupBucketReplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BackupBucketReplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupBucketReplication.Merge(m, src)
+}
+func (m *BackupBucketReplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupBucketReplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupBucketReplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupBucketReplication proto.InternalMessageInfo
+
 func (m *BackupBucketSpec) Reset()      { *m = BackupBucketSpec{} }
 func (*BackupBucketSpec) ProtoMessage() {}
 func (*BackupBucketSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
 }
 func (m *BackupBucketSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +661,7 @@ var xxx_messageInfo_BackupBucketSpec proto.InternalMessageInfo
 func (m *BackupBucketStatus) Reset()      { *m = BackupBucketStatus{} }
 func (*BackupBucketStatus) ProtoMessage() {}
 func (*BackupBucketStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
 }
 func (m *BackupBucketStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +689,7 @@ var xxx_messageInfo_BackupBucketStatus proto.InternalMessageInfo
 func (m *BackupEntry) Reset()      { *m = BackupEntry{} }
 func (*BackupEntry) ProtoMessage() {}
 func (*BackupEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
 }
 func (m *BackupEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +717,7 @@ var xxx_messageInfo_BackupEntry proto.InternalMessageInfo
 func (m *BackupEntryList) Reset()      { *m = BackupEntryList{} }
 func (*BackupEntryList) ProtoMessage() {}
 func (*BackupEntryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
 }
 func (m *BackupEntryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,10 +742,38 @@ func (m *BackupEntryList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BackupEntryList proto.InternalMessageInfo
 
+func (m *BackupEntryReplicationStatus) Reset()      { *m = BackupEntryReplicationStatus{} }
+func (*BackupEntryReplicationStatus) ProtoMessage() {}
+func (*BackupEntryReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+}
+func (m *BackupEntryReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupEntryReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BackupEntryReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupEntryReplicationStatus.Merge(m, src)
+}
+func (m *BackupEntryReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupEntryReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupEntryReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupEntryReplicationStatus proto.InternalMessageInfo
+
 func (m *BackupEntrySpec) Reset()      { *m = BackupEntrySpec{} }
 func (*BackupEntrySpec) ProtoMessage() {}
 func (*BackupEntrySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *BackupEntrySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +801,7 @@ var xxx_messageInfo_BackupEntrySpec proto.InternalMessageInfo
 func (m *BackupEntryStatus) Reset()      { *m = BackupEntryStatus{} }
 func (*BackupEntryStatus) ProtoMessage() {}
 func (*BackupEntryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *BackupEntryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +829,7 @@ var xxx_messageInfo_BackupEntryStatus proto.InternalMessageInfo
 func (m *Bastion) Reset()      { *m = Bastion{} }
 func (*Bastion) ProtoMessage() {}
 func (*Bastion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *Bastion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +857,7 @@ var xxx_messageInfo_Bastion proto.InternalMessageInfo
 func (m *BastionMachineImage) Reset()      { *m = BastionMachineImage{} }
 func (*BastionMachineImage) ProtoMessage() {}
 func (*BastionMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *BastionMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +885,7 @@ var xxx_messageInfo_BastionMachineImage proto.InternalMessageInfo
 func (m *BastionMachineType) Reset()      { *m = BastionMachineType{} }
 func (*BastionMachineType) ProtoMessage() {}
 func (*BastionMachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
 }
 func (m *BastionMachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +913,7 @@ var xxx_messageInfo_BastionMachineType proto.InternalMessageInfo
 func (m *CARotation) Reset()      { *m = CARotation{} }
 func (*CARotation) ProtoMessage() {}
 func (*CARotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *CARotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,7 +941,7 @@ var xxx_messageInfo_CARotation proto.InternalMessageInfo
 func (m *CRI) Reset()      { *m = CRI{} }
 func (*CRI) ProtoMessage() {}
 func (*CRI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *CRI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +969,7 @@ var xxx_messageInfo_CRI proto.InternalMessageInfo
 func (m *CloudProfile) Reset()      { *m = CloudProfile{} }
 func (*CloudProfile) ProtoMessage() {}
 func (*CloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
 }
 func (m *CloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +997,7 @@ var xxx_messageInfo_CloudProfile proto.InternalMessageInfo
 func (m *CloudProfileList) Reset()      { *m = CloudProfileList{} }
 func (*CloudProfileList) ProtoMessage() {}
 func (*CloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *CloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +1025,7 @@ var xxx_messageInfo_CloudProfileList proto.InternalMessageInfo
 func (m *CloudProfileReference) Reset()      { *m = CloudProfileReference{} }
 func (*CloudProfileReference) ProtoMessage() {}
 func (*CloudProfileReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *CloudProfileReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +1053,7 @@ var xxx_messageInfo_CloudProfileReference proto.InternalMessageInfo
 func (m *CloudProfileSpec) Reset()      { *m = CloudProfileSpec{} }
 func (*CloudProfileSpec) ProtoMessage() {}
 func (*CloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *CloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -801,7 +1081,7 @@ var xxx_messageInfo_CloudProfileSpec proto.InternalMessageInfo
 func (m *ClusterAutoscaler) Reset()      { *m = ClusterAutoscaler{} }
 func (*ClusterAutoscaler) ProtoMessage() {}
 func (*ClusterAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *ClusterAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,7 +1109,7 @@ var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 func (m *ClusterAutoscalerOptions) Reset()      { *m = ClusterAutoscalerOptions{} }
 func (*ClusterAutoscalerOptions) ProtoMessage() {}
 func (*ClusterAutoscalerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ClusterAutoscalerOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,7 +1137,7 @@ var xxx_messageInfo_ClusterAutoscalerOptions proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -885,7 +1165,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1193,7 @@ var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1221,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1249,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -997,7 +1277,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1305,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1333,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1361,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1109,7 +1389,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1417,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1165,7 +1445,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1193,7 +1473,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1221,7 +1501,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,7 +1529,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1277,7 +1557,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1305,7 +1585,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1613,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1641,7 @@ var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,7 +1669,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1417,7 +1697,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1445,7 +1725,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1473,7 +1753,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1501,7 +1781,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *DualApprovalForDeletion) Reset()      { *m = DualApprovalForDeletion{} }
 func (*DualApprovalForDeletion) ProtoMessage() {}
 func (*DualApprovalForDeletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *DualApprovalForDeletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1529,7 +1809,7 @@ var xxx_messageInfo_DualApprovalForDeletion proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1557,7 +1837,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1585,7 +1865,7 @@ var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1613,7 +1893,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1641,7 +1921,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1669,7 +1949,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1697,7 +1977,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1725,7 +2005,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1753,7 +2033,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1781,7 +2061,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1809,7 +2089,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1837,7 +2117,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *HelmControllerDeployment) Reset()      { *m = HelmControllerDeployment{} }
 func (*HelmControllerDeployment) ProtoMessage() {}
 func (*HelmControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *HelmControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1865,7 +2145,7 @@ var xxx_messageInfo_HelmControllerDeployment proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1893,7 +2173,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1921,7 +2201,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,7 +2229,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1974,10 +2254,66 @@ func (m *HorizontalPodAutoscalerConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 
+func (m *HugePages) Reset()      { *m = HugePages{} }
+func (*HugePages) ProtoMessage() {}
+func (*HugePages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+}
+func (m *HugePages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HugePages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HugePages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HugePages.Merge(m, src)
+}
+func (m *HugePages) XXX_Size() int {
+	return m.Size()
+}
+func (m *HugePages) XXX_DiscardUnknown() {
+	xxx_messageInfo_HugePages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HugePages proto.InternalMessageInfo
+
+func (m *ImagePrePull) Reset()      { *m = ImagePrePull{} }
+func (*ImagePrePull) ProtoMessage() {}
+func (*ImagePrePull) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+}
+func (m *ImagePrePull) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImagePrePull) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ImagePrePull) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePrePull.Merge(m, src)
+}
+func (m *ImagePrePull) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImagePrePull) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePrePull.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePrePull proto.InternalMessageInfo
+
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2005,7 +2341,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2033,7 +2369,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2061,7 +2397,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2086,10 +2422,38 @@ func (m *InternalSecretList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 
+func (m *KernelModule) Reset()      { *m = KernelModule{} }
+func (*KernelModule) ProtoMessage() {}
+func (*KernelModule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+}
+func (m *KernelModule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KernelModule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KernelModule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KernelModule.Merge(m, src)
+}
+func (m *KernelModule) XXX_Size() int {
+	return m.Size()
+}
+func (m *KernelModule) XXX_DiscardUnknown() {
+	xxx_messageInfo_KernelModule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KernelModule proto.InternalMessageInfo
+
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2117,7 +2481,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2145,7 +2509,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2173,7 +2537,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2201,7 +2565,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2229,7 +2593,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2257,7 +2621,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2285,7 +2649,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2313,7 +2677,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2341,7 +2705,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2369,7 +2733,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2397,7 +2761,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2425,7 +2789,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2450,10 +2814,38 @@ func (m *KubernetesSettings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 
+func (m *LastETCDRestore) Reset()      { *m = LastETCDRestore{} }
+func (*LastETCDRestore) ProtoMessage() {}
+func (*LastETCDRestore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+}
+func (m *LastETCDRestore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastETCDRestore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LastETCDRestore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastETCDRestore.Merge(m, src)
+}
+func (m *LastETCDRestore) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastETCDRestore) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastETCDRestore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastETCDRestore proto.InternalMessageInfo
+
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2481,7 +2873,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2509,7 +2901,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2537,7 +2929,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *LoadBalancerServicesProxyProtocol) Reset()      { *m = LoadBalancerServicesProxyProtocol{} }
 func (*LoadBalancerServicesProxyProtocol) ProtoMessage() {}
 func (*LoadBalancerServicesProxyProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *LoadBalancerServicesProxyProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2562,10 +2954,122 @@ func (m *LoadBalancerServicesProxyProtocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LoadBalancerServicesProxyProtocol proto.InternalMessageInfo
 
+func (m *Logging) Reset()      { *m = Logging{} }
+func (*Logging) ProtoMessage() {}
+func (*Logging) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+}
+func (m *Logging) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Logging) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Logging) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Logging.Merge(m, src)
+}
+func (m *Logging) XXX_Size() int {
+	return m.Size()
+}
+func (m *Logging) XXX_DiscardUnknown() {
+	xxx_messageInfo_Logging.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Logging proto.InternalMessageInfo
+
+func (m *LoggingOutput) Reset()      { *m = LoggingOutput{} }
+func (*LoggingOutput) ProtoMessage() {}
+func (*LoggingOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+}
+func (m *LoggingOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoggingOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LoggingOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingOutput.Merge(m, src)
+}
+func (m *LoggingOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoggingOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoggingOutput proto.InternalMessageInfo
+
+func (m *LoggingOutputBuffer) Reset()      { *m = LoggingOutputBuffer{} }
+func (*LoggingOutputBuffer) ProtoMessage() {}
+func (*LoggingOutputBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+}
+func (m *LoggingOutputBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoggingOutputBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LoggingOutputBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingOutputBuffer.Merge(m, src)
+}
+func (m *LoggingOutputBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoggingOutputBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingOutputBuffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoggingOutputBuffer proto.InternalMessageInfo
+
+func (m *LoggingOutputRateLimit) Reset()      { *m = LoggingOutputRateLimit{} }
+func (*LoggingOutputRateLimit) ProtoMessage() {}
+func (*LoggingOutputRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+}
+func (m *LoggingOutputRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoggingOutputRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LoggingOutputRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingOutputRateLimit.Merge(m, src)
+}
+func (m *LoggingOutputRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoggingOutputRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingOutputRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoggingOutputRateLimit proto.InternalMessageInfo
+
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2593,7 +3097,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2621,7 +3125,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2649,7 +3153,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2677,7 +3181,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2705,7 +3209,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2733,7 +3237,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2761,7 +3265,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2789,7 +3293,7 @@ var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2817,7 +3321,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2845,7 +3349,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2870,10 +3374,38 @@ func (m *Monitoring) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 
+func (m *MonitoringRemoteWrite) Reset()      { *m = MonitoringRemoteWrite{} }
+func (*MonitoringRemoteWrite) ProtoMessage() {}
+func (*MonitoringRemoteWrite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+}
+func (m *MonitoringRemoteWrite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonitoringRemoteWrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MonitoringRemoteWrite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitoringRemoteWrite.Merge(m, src)
+}
+func (m *MonitoringRemoteWrite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonitoringRemoteWrite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitoringRemoteWrite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitoringRemoteWrite proto.InternalMessageInfo
+
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2901,7 +3433,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
 func (*NamespacedCloudProfile) ProtoMessage() {}
 func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2929,7 +3461,7 @@ var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
 func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
 func (*NamespacedCloudProfileList) ProtoMessage() {}
 func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2957,7 +3489,7 @@ var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
 func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
 func (*NamespacedCloudProfileSpec) ProtoMessage() {}
 func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2985,7 +3517,7 @@ var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
 func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
 func (*NamespacedCloudProfileStatus) ProtoMessage() {}
 func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3013,7 +3545,7 @@ var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3041,7 +3573,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NetworkingStatus) Reset()      { *m = NetworkingStatus{} }
 func (*NetworkingStatus) ProtoMessage() {}
 func (*NetworkingStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *NetworkingStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3069,7 +3601,7 @@ var xxx_messageInfo_NetworkingStatus proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3097,7 +3629,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3125,7 +3657,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OCIRepository) Reset()      { *m = OCIRepository{} }
 func (*OCIRepository) ProtoMessage() {}
 func (*OCIRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *OCIRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3153,7 +3685,7 @@ var xxx_messageInfo_OCIRepository proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3181,7 +3713,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3209,7 +3741,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3234,10 +3766,38 @@ func (m *OpenIDConnectClientAuthentication) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 
+func (m *PreDrainHook) Reset()      { *m = PreDrainHook{} }
+func (*PreDrainHook) ProtoMessage() {}
+func (*PreDrainHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+}
+func (m *PreDrainHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreDrainHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PreDrainHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreDrainHook.Merge(m, src)
+}
+func (m *PreDrainHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreDrainHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreDrainHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreDrainHook proto.InternalMessageInfo
+
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3265,7 +3825,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3293,7 +3853,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3321,7 +3881,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3349,7 +3909,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3377,7 +3937,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3405,7 +3965,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3433,7 +3993,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3461,7 +4021,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3489,7 +4049,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3517,7 +4077,7 @@ var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3545,7 +4105,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3573,7 +4133,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3601,7 +4161,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3629,7 +4189,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3657,7 +4217,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3685,7 +4245,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3713,7 +4273,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3741,7 +4301,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3769,7 +4329,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3797,7 +4357,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3825,7 +4385,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3853,7 +4413,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3881,7 +4441,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3909,7 +4469,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3937,7 +4497,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3965,7 +4525,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3993,7 +4553,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4021,7 +4581,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4051,7 +4611,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4079,7 +4639,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4107,7 +4667,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4135,7 +4695,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4163,7 +4723,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4191,7 +4751,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4219,7 +4779,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4247,7 +4807,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4275,7 +4835,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4303,7 +4863,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4331,7 +4891,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4359,7 +4919,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4387,7 +4947,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4415,7 +4975,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4443,7 +5003,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4471,7 +5031,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4499,7 +5059,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4527,7 +5087,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4555,7 +5115,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4583,7 +5143,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4611,7 +5171,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4639,7 +5199,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4667,7 +5227,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4695,7 +5255,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4723,7 +5283,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4751,7 +5311,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4779,7 +5339,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4807,7 +5367,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4835,7 +5395,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4863,7 +5423,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4891,7 +5451,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4919,7 +5479,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4947,7 +5507,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4975,7 +5535,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5003,7 +5563,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5031,7 +5591,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5059,7 +5619,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5087,7 +5647,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5112,10 +5672,38 @@ func (m *Worker) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Worker proto.InternalMessageInfo
 
+func (m *WorkerDrainPolicy) Reset()      { *m = WorkerDrainPolicy{} }
+func (*WorkerDrainPolicy) ProtoMessage() {}
+func (*WorkerDrainPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
+}
+func (m *WorkerDrainPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerDrainPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkerDrainPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerDrainPolicy.Merge(m, src)
+}
+func (m *WorkerDrainPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerDrainPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerDrainPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerDrainPolicy proto.InternalMessageInfo
+
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5143,7 +5731,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5171,7 +5759,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{204}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5203,16 +5791,26 @@ func init() {
 	proto.RegisterType((*Addons)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Addons")
 	proto.RegisterType((*AdmissionPlugin)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AdmissionPlugin")
 	proto.RegisterType((*Alerting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Alerting")
+	proto.RegisterType((*AlertingCustomRules)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AlertingCustomRules")
+	proto.RegisterType((*AlertingWebhookReceiver)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AlertingWebhookReceiver")
+	proto.RegisterType((*AlternativeMachineType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AlternativeMachineType")
+	proto.RegisterType((*AuditBackends)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditBackends")
+	proto.RegisterType((*AuditBatch)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditBatch")
 	proto.RegisterType((*AuditConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditConfig")
+	proto.RegisterType((*AuditLogBackend)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditLogBackend")
 	proto.RegisterType((*AuditPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditPolicy")
+	proto.RegisterType((*AuditTruncate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditTruncate")
+	proto.RegisterType((*AuditWebhookBackend)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditWebhookBackend")
 	proto.RegisterType((*AvailabilityZone)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AvailabilityZone")
 	proto.RegisterType((*BackupBucket)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucket")
 	proto.RegisterType((*BackupBucketList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucketList")
 	proto.RegisterType((*BackupBucketProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucketProvider")
+	proto.RegisterType((*BackupBucketReplication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucketReplication")
 	proto.RegisterType((*BackupBucketSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucketSpec")
 	proto.RegisterType((*BackupBucketStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucketStatus")
 	proto.RegisterType((*BackupEntry)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntry")
 	proto.RegisterType((*BackupEntryList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntryList")
+	proto.RegisterType((*BackupEntryReplicationStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntryReplicationStatus")
 	proto.RegisterType((*BackupEntrySpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntrySpec")
 	proto.RegisterType((*BackupEntryStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntryStatus")
 	proto.RegisterType((*Bastion)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Bastion")
@@ -5267,12 +5865,16 @@ func init() {
 	proto.RegisterType((*HibernationSchedule)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationSchedule")
 	proto.RegisterType((*HighAvailability)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HighAvailability")
 	proto.RegisterType((*HorizontalPodAutoscalerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HorizontalPodAutoscalerConfig")
+	proto.RegisterType((*HugePages)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HugePages")
+	proto.RegisterType((*ImagePrePull)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ImagePrePull")
 	proto.RegisterType((*Ingress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Ingress")
 	proto.RegisterType((*IngressController)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.IngressController")
 	proto.RegisterType((*InternalSecret)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret")
 	proto.RegisterMapType((map[string][]byte)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret.DataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret.StringDataEntry")
 	proto.RegisterType((*InternalSecretList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecretList")
+	proto.RegisterType((*KernelModule)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KernelModule")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KernelModule.ParametersEntry")
 	proto.RegisterType((*KubeAPIServerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerConfig")
 	proto.RegisterMapType((map[string]bool)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerConfig.RuntimeConfigEntry")
 	proto.RegisterType((*KubeControllerManagerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeControllerManagerConfig")
@@ -5288,10 +5890,15 @@ func init() {
 	proto.RegisterMapType((map[string]bool)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubernetesConfig.FeatureGatesEntry")
 	proto.RegisterType((*KubernetesDashboard)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubernetesDashboard")
 	proto.RegisterType((*KubernetesSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubernetesSettings")
+	proto.RegisterType((*LastETCDRestore)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LastETCDRestore")
 	proto.RegisterType((*LastError)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LastError")
 	proto.RegisterType((*LastMaintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LastMaintenance")
 	proto.RegisterType((*LastOperation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LastOperation")
 	proto.RegisterType((*LoadBalancerServicesProxyProtocol)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LoadBalancerServicesProxyProtocol")
+	proto.RegisterType((*Logging)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Logging")
+	proto.RegisterType((*LoggingOutput)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LoggingOutput")
+	proto.RegisterType((*LoggingOutputBuffer)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LoggingOutputBuffer")
+	proto.RegisterType((*LoggingOutputRateLimit)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.LoggingOutputRateLimit")
 	proto.RegisterType((*Machine)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Machine")
 	proto.RegisterType((*MachineControllerManagerSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineControllerManagerSettings")
 	proto.RegisterType((*MachineImage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImage")
@@ -5303,6 +5910,7 @@ func init() {
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
 	proto.RegisterType((*MemorySwapConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MemorySwapConfiguration")
 	proto.RegisterType((*Monitoring)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Monitoring")
+	proto.RegisterType((*MonitoringRemoteWrite)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MonitoringRemoteWrite")
 	proto.RegisterType((*NamedResourceReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamedResourceReference")
 	proto.RegisterType((*NamespacedCloudProfile)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfile")
 	proto.RegisterType((*NamespacedCloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfileList")
@@ -5319,6 +5927,7 @@ func init() {
 	proto.RegisterType((*ObservabilityRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ObservabilityRotation")
 	proto.RegisterType((*OpenIDConnectClientAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication.ExtraConfigEntry")
+	proto.RegisterType((*PreDrainHook)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PreDrainHook")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
 	proto.RegisterType((*ProjectList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectList")
 	proto.RegisterType((*ProjectMember)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember")
@@ -5397,6 +6006,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.SysctlsEntry")
+	proto.RegisterType((*WorkerDrainPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerDrainPolicy")
 	proto.RegisterType((*WorkerKubernetes)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerKubernetes")
 	proto.RegisterType((*WorkerSystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerSystemComponents")
 	proto.RegisterType((*WorkersSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkersSettings")
@@ -5407,816 +6017,896 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 12944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x6c, 0x25, 0xd7,
-	0x79, 0x18, 0xee, 0xb9, 0x7c, 0x7f, 0x7c, 0x2c, 0x79, 0xf6, 0x45, 0x51, 0xd2, 0xde, 0xf5, 0x48,
-	0xf2, 0x4f, 0x8a, 0x6c, 0x6e, 0xa4, 0xc8, 0x96, 0xb5, 0x8a, 0x2c, 0x93, 0x97, 0xdc, 0xdd, 0xeb,
-	0x25, 0xb9, 0xf4, 0xb9, 0xa4, 0xa4, 0x28, 0xf9, 0x29, 0x19, 0xce, 0x1c, 0x5e, 0x8e, 0x76, 0xee,
-	0xcc, 0xd5, 0xcc, 0x5c, 0x2e, 0x29, 0xd9, 0x75, 0xec, 0xe6, 0x65, 0x27, 0x0e, 0xd2, 0xa0, 0xad,
-	0x21, 0x3b, 0x41, 0x9c, 0x06, 0x49, 0xdb, 0xa4, 0x70, 0x8b, 0x14, 0x29, 0x90, 0x04, 0x05, 0xd2,
-	0x00, 0x69, 0xec, 0x20, 0x09, 0x82, 0xa4, 0x45, 0x1d, 0xb4, 0x61, 0x6a, 0x36, 0x4d, 0x0a, 0xb4,
-	0x08, 0x8a, 0x06, 0x45, 0x90, 0x6d, 0x90, 0x14, 0xe7, 0x31, 0x67, 0xce, 0xbc, 0x2e, 0x2f, 0xe7,
-	0x92, 0xb4, 0xd5, 0xe4, 0x2f, 0xf2, 0x9e, 0xef, 0x9c, 0xef, 0x3b, 0xaf, 0xf9, 0xce, 0x77, 0xbe,
-	0xf3, 0x3d, 0x60, 0xb1, 0x69, 0x87, 0x3b, 0x9d, 0xad, 0x79, 0xd3, 0x6b, 0x5d, 0x6b, 0x1a, 0xbe,
-	0x45, 0x5c, 0xe2, 0xc7, 0xff, 0xb4, 0xef, 0x36, 0xaf, 0x19, 0x6d, 0x3b, 0xb8, 0x66, 0x7a, 0x3e,
-	0xb9, 0xb6, 0xfb, 0xd4, 0x16, 0x09, 0x8d, 0xa7, 0xae, 0x35, 0x29, 0xcc, 0x08, 0x89, 0x35, 0xdf,
-	0xf6, 0xbd, 0xd0, 0x43, 0x4f, 0xc7, 0x38, 0xe6, 0xa3, 0xa6, 0xf1, 0x3f, 0xed, 0xbb, 0xcd, 0x79,
-	0x8a, 0x63, 0x9e, 0xe2, 0x98, 0x17, 0x38, 0xe6, 0xde, 0xa7, 0xd2, 0xf5, 0x9a, 0xde, 0x35, 0x86,
-	0x6a, 0xab, 0xb3, 0xcd, 0x7e, 0xb1, 0x1f, 0xec, 0x3f, 0x4e, 0x62, 0xee, 0x89, 0xbb, 0x1f, 0x0c,
-	0xe6, 0x6d, 0x8f, 0x76, 0xe6, 0x9a, 0xd1, 0x09, 0xbd, 0xc0, 0x34, 0x1c, 0xdb, 0x6d, 0x5e, 0xdb,
-	0xcd, 0xf4, 0x66, 0x4e, 0x57, 0xaa, 0x8a, 0x6e, 0x77, 0xad, 0xe3, 0x6f, 0x19, 0x66, 0x5e, 0x9d,
-	0x5b, 0x71, 0x1d, 0xb2, 0x17, 0x12, 0x37, 0xb0, 0x3d, 0x37, 0x78, 0x1f, 0x1d, 0x09, 0xf1, 0x77,
-	0xd5, 0xb9, 0x49, 0x54, 0xc8, 0xc3, 0xf4, 0x4c, 0x8c, 0xa9, 0x65, 0x98, 0x3b, 0xb6, 0x4b, 0xfc,
-	0xfd, 0xa8, 0xf9, 0x35, 0x9f, 0x04, 0x5e, 0xc7, 0x37, 0xc9, 0xb1, 0x5a, 0x05, 0xd7, 0x5a, 0x24,
-	0x34, 0xf2, 0x68, 0x5d, 0x2b, 0x6a, 0xe5, 0x77, 0xdc, 0xd0, 0x6e, 0x65, 0xc9, 0x7c, 0xe0, 0xa8,
-	0x06, 0x81, 0xb9, 0x43, 0x5a, 0x46, 0xa6, 0xdd, 0xb7, 0x14, 0xb5, 0xeb, 0x84, 0xb6, 0x73, 0xcd,
-	0x76, 0xc3, 0x20, 0xf4, 0xd3, 0x8d, 0xf4, 0xcf, 0x68, 0x30, 0xbd, 0xb0, 0x5e, 0x6f, 0xb0, 0x19,
-	0x5c, 0xf1, 0x9a, 0x4d, 0xdb, 0x6d, 0xa2, 0x27, 0x61, 0x6c, 0x97, 0xf8, 0x5b, 0x5e, 0x60, 0x87,
-	0xfb, 0xb3, 0xda, 0x55, 0xed, 0xf1, 0xa1, 0xc5, 0xc9, 0xc3, 0x83, 0xea, 0xd8, 0x4b, 0x51, 0x21,
-	0x8e, 0xe1, 0xa8, 0x0e, 0xe7, 0x77, 0xc2, 0xb0, 0xbd, 0x60, 0x9a, 0x24, 0x08, 0x64, 0x8d, 0xd9,
-	0x0a, 0x6b, 0x76, 0xf9, 0xf0, 0xa0, 0x7a, 0xfe, 0xd6, 0xc6, 0xc6, 0x7a, 0x0a, 0x8c, 0xf3, 0xda,
-	0xe8, 0x3f, 0xaf, 0xc1, 0x8c, 0xec, 0x0c, 0x26, 0x6f, 0x74, 0x48, 0x10, 0x06, 0x08, 0xc3, 0xa5,
-	0x96, 0xb1, 0xb7, 0xe6, 0xb9, 0xab, 0x9d, 0xd0, 0x08, 0x6d, 0xb7, 0x59, 0x77, 0xb7, 0x1d, 0xbb,
-	0xb9, 0x13, 0x8a, 0xae, 0xcd, 0x1d, 0x1e, 0x54, 0x2f, 0xad, 0xe6, 0xd6, 0xc0, 0x05, 0x2d, 0x69,
-	0xa7, 0x5b, 0xc6, 0x5e, 0x06, 0xa1, 0xd2, 0xe9, 0xd5, 0x2c, 0x18, 0xe7, 0xb5, 0xd1, 0x9f, 0x86,
-	0xa1, 0x05, 0xcb, 0xf2, 0x5c, 0xf4, 0x04, 0x8c, 0x10, 0xd7, 0xd8, 0x72, 0x88, 0xc5, 0x3a, 0x36,
-	0xba, 0x78, 0xee, 0xcb, 0x07, 0xd5, 0x77, 0x1d, 0x1e, 0x54, 0x47, 0x96, 0x79, 0x31, 0x8e, 0xe0,
-	0xfa, 0x3f, 0xa8, 0xc0, 0x30, 0x6b, 0x14, 0xa0, 0x1f, 0xd5, 0xe0, 0xfc, 0xdd, 0xce, 0x16, 0xf1,
-	0x5d, 0x12, 0x92, 0x60, 0xc9, 0x08, 0x76, 0xb6, 0x3c, 0xc3, 0xe7, 0x28, 0xc6, 0x9f, 0xbe, 0x39,
-	0x7f, 0xfc, 0x2f, 0x79, 0xfe, 0x76, 0x16, 0x1d, 0x1f, 0x53, 0x0e, 0x00, 0xe7, 0x11, 0x47, 0xbb,
-	0x30, 0xe1, 0x36, 0x6d, 0x77, 0xaf, 0xee, 0x36, 0x7d, 0x12, 0x04, 0x6c, 0x5e, 0xc6, 0x9f, 0xfe,
-	0x70, 0x99, 0xce, 0xac, 0x29, 0x78, 0x16, 0xa7, 0x0f, 0x0f, 0xaa, 0x13, 0x6a, 0x09, 0x4e, 0xd0,
-	0xd1, 0xff, 0x4a, 0x83, 0x73, 0x0b, 0x56, 0xcb, 0x0e, 0xe8, 0x97, 0xbb, 0xee, 0x74, 0x9a, 0xb6,
-	0x8b, 0xae, 0xc2, 0xa0, 0x6b, 0xb4, 0x08, 0x9b, 0x90, 0xb1, 0xc5, 0x09, 0x31, 0xa7, 0x83, 0x6b,
-	0x46, 0x8b, 0x60, 0x06, 0x41, 0x1f, 0x85, 0x61, 0xd3, 0x73, 0xb7, 0xed, 0xa6, 0xe8, 0xe7, 0xfb,
-	0xe6, 0xf9, 0x97, 0x30, 0xaf, 0x7e, 0x09, 0xac, 0x7b, 0xe2, 0x0b, 0x9a, 0xc7, 0xc6, 0xbd, 0xe5,
-	0x88, 0x41, 0x2c, 0xc2, 0xe1, 0x41, 0x75, 0xb8, 0xc6, 0x10, 0x60, 0x81, 0x08, 0x3d, 0x0e, 0xa3,
-	0x96, 0x1d, 0xf0, 0xc5, 0x1c, 0x60, 0x8b, 0x39, 0x71, 0x78, 0x50, 0x1d, 0x5d, 0x12, 0x65, 0x58,
-	0x42, 0xd1, 0x0a, 0x5c, 0xa0, 0x33, 0xc8, 0xdb, 0x35, 0x88, 0xe9, 0x93, 0x90, 0x76, 0x6d, 0x76,
-	0x90, 0x75, 0x77, 0xf6, 0xf0, 0xa0, 0x7a, 0xe1, 0x76, 0x0e, 0x1c, 0xe7, 0xb6, 0xd2, 0x6f, 0xc0,
-	0xe8, 0x82, 0x43, 0x7c, 0xba, 0xc1, 0xd0, 0x75, 0x98, 0x22, 0x2d, 0xc3, 0x76, 0x30, 0x31, 0x89,
-	0xbd, 0x4b, 0xfc, 0x60, 0x56, 0xbb, 0x3a, 0xf0, 0xf8, 0xd8, 0x22, 0x3a, 0x3c, 0xa8, 0x4e, 0x2d,
-	0x27, 0x20, 0x38, 0x55, 0x53, 0xff, 0xa4, 0x06, 0xe3, 0x0b, 0x1d, 0xcb, 0x0e, 0xf9, 0xb8, 0x90,
-	0x0f, 0xe3, 0x06, 0xfd, 0xb9, 0xee, 0x39, 0xb6, 0xb9, 0x2f, 0x36, 0xd7, 0x8b, 0x65, 0xd6, 0x73,
-	0x21, 0x46, 0xb3, 0x78, 0xee, 0xf0, 0xa0, 0x3a, 0xae, 0x14, 0x60, 0x95, 0x88, 0xbe, 0x03, 0x2a,
-	0x0c, 0x7d, 0x1b, 0x4c, 0xf0, 0xe1, 0xae, 0x1a, 0x6d, 0x4c, 0xb6, 0x45, 0x1f, 0x1e, 0x51, 0xd6,
-	0x2a, 0x22, 0x34, 0x7f, 0x67, 0xeb, 0x75, 0x62, 0x86, 0x98, 0x6c, 0x13, 0x9f, 0xb8, 0x26, 0xe1,
-	0xdb, 0xa6, 0xa6, 0x34, 0xc6, 0x09, 0x54, 0xfa, 0x1f, 0x52, 0x26, 0xb6, 0x6b, 0xd8, 0x8e, 0xb1,
-	0x65, 0x3b, 0x76, 0xb8, 0xff, 0xaa, 0xe7, 0x92, 0x1e, 0xf6, 0xcd, 0x26, 0x5c, 0xee, 0xb8, 0x06,
-	0x6f, 0xe7, 0x90, 0x55, 0xbe, 0x53, 0x36, 0xf6, 0xdb, 0x84, 0x6e, 0x78, 0x3a, 0xd3, 0x0f, 0x1e,
-	0x1e, 0x54, 0x2f, 0x6f, 0xe6, 0x57, 0xc1, 0x45, 0x6d, 0x29, 0xbf, 0x52, 0x40, 0x2f, 0x79, 0x4e,
-	0xa7, 0x25, 0xb0, 0x0e, 0x30, 0xac, 0x8c, 0x5f, 0x6d, 0xe6, 0xd6, 0xc0, 0x05, 0x2d, 0xf5, 0x2f,
-	0x57, 0x60, 0x62, 0xd1, 0x30, 0xef, 0x76, 0xda, 0x8b, 0x1d, 0xf3, 0x2e, 0x09, 0xd1, 0x77, 0xc1,
-	0x28, 0x3d, 0x70, 0x2c, 0x23, 0x34, 0xc4, 0x4c, 0x7e, 0x73, 0xe1, 0xae, 0x67, 0x8b, 0x48, 0x6b,
-	0xc7, 0x73, 0xbb, 0x4a, 0x42, 0x63, 0x11, 0x89, 0x39, 0x81, 0xb8, 0x0c, 0x4b, 0xac, 0x68, 0x1b,
-	0x06, 0x83, 0x36, 0x31, 0xc5, 0x37, 0xb5, 0x54, 0x66, 0xaf, 0xa8, 0x3d, 0x6e, 0xb4, 0x89, 0x19,
-	0xaf, 0x02, 0xfd, 0x85, 0x19, 0x7e, 0xe4, 0xc2, 0x70, 0x10, 0x1a, 0x61, 0x27, 0x60, 0x1f, 0xda,
-	0xf8, 0xd3, 0x37, 0xfa, 0xa6, 0xc4, 0xb0, 0x2d, 0x4e, 0x09, 0x5a, 0xc3, 0xfc, 0x37, 0x16, 0x54,
-	0xf4, 0xff, 0xa0, 0xc1, 0xb4, 0x5a, 0x7d, 0xc5, 0x0e, 0x42, 0xf4, 0x1d, 0x99, 0xe9, 0x9c, 0xef,
-	0x6d, 0x3a, 0x69, 0x6b, 0x36, 0x99, 0xd3, 0x82, 0xdc, 0x68, 0x54, 0xa2, 0x4c, 0x25, 0x81, 0x21,
-	0x3b, 0x24, 0x2d, 0xbe, 0xad, 0x4a, 0xf2, 0x51, 0xb5, 0xcb, 0x8b, 0x93, 0x82, 0xd8, 0x50, 0x9d,
-	0xa2, 0xc5, 0x1c, 0xbb, 0xfe, 0x5d, 0x70, 0x41, 0xad, 0xb5, 0xee, 0x7b, 0xbb, 0xb6, 0x45, 0x7c,
-	0xfa, 0x25, 0x84, 0xfb, 0xed, 0xcc, 0x97, 0x40, 0x77, 0x16, 0x66, 0x10, 0xf4, 0x1e, 0x18, 0xf6,
-	0x49, 0xd3, 0xf6, 0x5c, 0xb6, 0xda, 0x63, 0xf1, 0xdc, 0x61, 0x56, 0x8a, 0x05, 0x54, 0xff, 0xdf,
-	0x95, 0xe4, 0xdc, 0xd1, 0x65, 0x44, 0xbb, 0x30, 0xda, 0x16, 0xa4, 0xc4, 0xdc, 0xdd, 0xea, 0x77,
-	0x80, 0x51, 0xd7, 0xe3, 0x59, 0x8d, 0x4a, 0xb0, 0xa4, 0x85, 0x6c, 0x98, 0x8a, 0xfe, 0xaf, 0xf5,
-	0xc1, 0xfe, 0x19, 0x3b, 0x5d, 0x4f, 0x20, 0xc2, 0x29, 0xc4, 0x68, 0x03, 0xc6, 0x02, 0xc6, 0xa4,
-	0x29, 0xe3, 0x1a, 0x28, 0x66, 0x5c, 0x8d, 0xa8, 0x92, 0x60, 0x5c, 0x33, 0xa2, 0xfb, 0x63, 0x12,
-	0x80, 0x63, 0x44, 0xf4, 0x90, 0x09, 0x08, 0xb1, 0x94, 0xe3, 0x82, 0x1d, 0x32, 0x0d, 0x51, 0x86,
-	0x25, 0x54, 0xff, 0xe2, 0x20, 0xa0, 0xec, 0x16, 0x57, 0x67, 0x80, 0x97, 0x88, 0xf9, 0xef, 0x67,
-	0x06, 0xc4, 0xd7, 0x92, 0x42, 0x8c, 0xde, 0x84, 0x49, 0xc7, 0x08, 0xc2, 0x3b, 0x6d, 0x2a, 0x3d,
-	0x46, 0x1b, 0x65, 0xfc, 0xe9, 0x85, 0x32, 0x2b, 0xbd, 0xa2, 0x22, 0x5a, 0x9c, 0x39, 0x3c, 0xa8,
-	0x4e, 0x26, 0x8a, 0x70, 0x92, 0x14, 0x7a, 0x1d, 0xc6, 0x68, 0xc1, 0xb2, 0xef, 0x7b, 0xbe, 0x98,
-	0xfd, 0x17, 0xca, 0xd2, 0x65, 0x48, 0xb8, 0x34, 0x2b, 0x7f, 0xe2, 0x18, 0x3d, 0xfa, 0x08, 0x20,
-	0x6f, 0x8b, 0xdd, 0x27, 0xac, 0x9b, 0x5c, 0x54, 0xa6, 0x83, 0xa5, 0xab, 0x33, 0xb0, 0x38, 0x27,
-	0x56, 0x13, 0xdd, 0xc9, 0xd4, 0xc0, 0x39, 0xad, 0xd0, 0x5d, 0x40, 0x52, 0xdc, 0x96, 0x1b, 0x60,
-	0x76, 0xa8, 0xf7, 0xed, 0x73, 0x89, 0x12, 0xbb, 0x99, 0x41, 0x81, 0x73, 0xd0, 0xea, 0xbf, 0x56,
-	0x81, 0x71, 0xbe, 0x45, 0x96, 0xdd, 0xd0, 0xdf, 0x3f, 0x83, 0x03, 0x82, 0x24, 0x0e, 0x88, 0x5a,
-	0xf9, 0x6f, 0x9e, 0x75, 0xb8, 0xf0, 0x7c, 0x68, 0xa5, 0xce, 0x87, 0xe5, 0x7e, 0x09, 0x75, 0x3f,
-	0x1e, 0xfe, 0xbd, 0x06, 0xe7, 0x94, 0xda, 0x67, 0x70, 0x3a, 0x58, 0xc9, 0xd3, 0xe1, 0xc5, 0x3e,
-	0xc7, 0x57, 0x70, 0x38, 0x78, 0x89, 0x61, 0x31, 0xc6, 0xfd, 0x34, 0xc0, 0x16, 0x63, 0x27, 0x6b,
-	0xb1, 0x9c, 0x24, 0x97, 0x7c, 0x51, 0x42, 0xb0, 0x52, 0x2b, 0xc1, 0xb3, 0x2a, 0x5d, 0x79, 0xd6,
-	0x7f, 0x1d, 0x80, 0x99, 0xcc, 0xb4, 0x67, 0xf9, 0x88, 0xf6, 0x75, 0xe2, 0x23, 0x95, 0xaf, 0x07,
-	0x1f, 0x19, 0x28, 0xc5, 0x47, 0x7a, 0x3e, 0x27, 0x90, 0x0f, 0xa8, 0x65, 0x37, 0x79, 0xb3, 0x46,
-	0x68, 0xf8, 0xe1, 0x86, 0xdd, 0x22, 0x82, 0xe3, 0x7c, 0x53, 0x6f, 0x5b, 0x96, 0xb6, 0xe0, 0x8c,
-	0x67, 0x35, 0x83, 0x09, 0xe7, 0x60, 0xd7, 0xff, 0x6e, 0x05, 0x46, 0x16, 0x8d, 0x80, 0xf5, 0xf4,
-	0xe3, 0x30, 0x21, 0x50, 0xd7, 0x5b, 0x46, 0x93, 0xf4, 0x73, 0x89, 0x15, 0x28, 0x57, 0x15, 0x74,
-	0xfc, 0x1e, 0xa0, 0x96, 0xe0, 0x04, 0x39, 0xb4, 0x0f, 0xe3, 0xad, 0x58, 0x12, 0x17, 0x4b, 0x7c,
-	0xa3, 0x7f, 0xea, 0x14, 0x1b, 0xbf, 0xec, 0x28, 0x05, 0x58, 0xa5, 0xa5, 0xbf, 0x06, 0xe7, 0x73,
-	0x7a, 0xdc, 0xc3, 0x25, 0xe4, 0x31, 0x18, 0xa1, 0x37, 0xb6, 0x58, 0xf6, 0x1a, 0x3f, 0x3c, 0xa8,
-	0x8e, 0xbc, 0xc4, 0x8b, 0x70, 0x04, 0xd3, 0x3f, 0x40, 0x05, 0x80, 0x74, 0x9f, 0x8e, 0x46, 0xaf,
-	0xff, 0xee, 0x20, 0x40, 0x6d, 0x01, 0x7b, 0x21, 0xdf, 0x4a, 0x2f, 0xc2, 0x50, 0x7b, 0xc7, 0x08,
-	0xa2, 0x16, 0x4f, 0x44, 0xac, 0x62, 0x9d, 0x16, 0xde, 0x3f, 0xa8, 0xce, 0xd6, 0x7c, 0x62, 0x11,
-	0x37, 0xb4, 0x0d, 0x27, 0x88, 0x1a, 0x31, 0x18, 0xe6, 0xed, 0xe8, 0x0e, 0xa3, 0x9b, 0xbc, 0xe6,
-	0xb5, 0xda, 0x0e, 0xa1, 0x50, 0xb6, 0xc3, 0x2a, 0xe5, 0x76, 0xd8, 0x4a, 0x06, 0x13, 0xce, 0xc1,
-	0x1e, 0xd1, 0xac, 0xbb, 0x76, 0x68, 0x1b, 0x92, 0xe6, 0x40, 0x79, 0x9a, 0x49, 0x4c, 0x38, 0x07,
-	0x3b, 0xfa, 0x8c, 0x06, 0x73, 0xc9, 0xe2, 0x1b, 0xb6, 0x6b, 0x07, 0x3b, 0xc4, 0x62, 0xc4, 0x07,
-	0x8f, 0x4d, 0xfc, 0xca, 0xe1, 0x41, 0x75, 0x6e, 0xa5, 0x10, 0x23, 0xee, 0x42, 0x0d, 0x7d, 0x56,
-	0x83, 0x07, 0x53, 0xf3, 0xe2, 0xdb, 0xcd, 0x26, 0xf1, 0x45, 0x6f, 0x8e, 0xff, 0x81, 0x57, 0x0f,
-	0x0f, 0xaa, 0x0f, 0xae, 0x14, 0xa3, 0xc4, 0xdd, 0xe8, 0xe9, 0xbf, 0xaa, 0xc1, 0x40, 0x0d, 0xd7,
-	0xd1, 0x93, 0x89, 0xed, 0x77, 0x59, 0xdd, 0x7e, 0xf7, 0x0f, 0xaa, 0x23, 0x35, 0x5c, 0x57, 0x36,
-	0xfa, 0x67, 0x35, 0x98, 0x31, 0x3d, 0x37, 0x34, 0x68, 0xbf, 0x30, 0x97, 0x43, 0xa3, 0x33, 0xaf,
-	0xd4, 0xed, 0xb2, 0x96, 0x42, 0xb6, 0xf8, 0x80, 0xe8, 0xc0, 0x4c, 0x1a, 0x12, 0xe0, 0x2c, 0x65,
-	0xfd, 0xab, 0x1a, 0x4c, 0xd4, 0x1c, 0xaf, 0x63, 0xad, 0xfb, 0xde, 0xb6, 0xed, 0x90, 0x77, 0xc6,
-	0x95, 0x5a, 0xed, 0x71, 0x91, 0xc8, 0xc4, 0xae, 0xb8, 0x6a, 0xc5, 0x77, 0xc8, 0x15, 0x57, 0xed,
-	0x72, 0x81, 0x14, 0xf3, 0xed, 0x70, 0x51, 0xad, 0x25, 0x45, 0x65, 0xca, 0x09, 0xef, 0xda, 0xae,
-	0x95, 0xe6, 0x84, 0xb7, 0x6d, 0xd7, 0xc2, 0x0c, 0x22, 0x79, 0x65, 0xa5, 0x90, 0x57, 0xfe, 0xc5,
-	0x48, 0x72, 0xda, 0x98, 0x90, 0xf4, 0x38, 0x8c, 0x9a, 0xc6, 0x62, 0xc7, 0xb5, 0x1c, 0xc9, 0x66,
-	0xe9, 0x14, 0xd4, 0x16, 0x78, 0x19, 0x96, 0x50, 0xf4, 0x26, 0x40, 0xac, 0x4b, 0xed, 0xe7, 0xf0,
-	0x89, 0xd5, 0xb4, 0x0d, 0x12, 0x86, 0xb6, 0xdb, 0x0c, 0xe2, 0x7d, 0x15, 0xc3, 0xb0, 0x42, 0x0d,
-	0x7d, 0x1c, 0x26, 0xd5, 0x93, 0x90, 0xab, 0x9a, 0x4a, 0x2e, 0x43, 0xe2, 0xc8, 0xbd, 0x28, 0x08,
-	0x4f, 0xaa, 0xa5, 0x01, 0x4e, 0x52, 0x43, 0xfb, 0xf2, 0xdc, 0xe7, 0x8a, 0xae, 0xc1, 0xf2, 0x92,
-	0xac, 0x7a, 0xe4, 0x5e, 0x10, 0xc4, 0x27, 0x12, 0x8a, 0xb7, 0x04, 0xa9, 0x1c, 0x2d, 0xc0, 0xd0,
-	0x69, 0x69, 0x01, 0x08, 0x8c, 0x70, 0x3d, 0x48, 0x30, 0x3b, 0xcc, 0x06, 0x78, 0xbd, 0xcc, 0x00,
-	0xb9, 0x4a, 0x25, 0x7e, 0x1c, 0xe0, 0xbf, 0x03, 0x1c, 0xe1, 0x46, 0xbb, 0x30, 0x41, 0x05, 0xba,
-	0x06, 0x71, 0x88, 0x19, 0x7a, 0xfe, 0xec, 0x48, 0x79, 0xe5, 0x7b, 0x43, 0xc1, 0xc3, 0xa5, 0x27,
-	0xb5, 0x04, 0x27, 0xe8, 0x48, 0x35, 0xd1, 0x68, 0xa1, 0x9a, 0xa8, 0x03, 0xe3, 0xbb, 0x8a, 0x3a,
-	0x73, 0x8c, 0x4d, 0xc2, 0x87, 0xca, 0x74, 0x2c, 0xd6, 0x6d, 0x2e, 0x9e, 0x17, 0x84, 0xc6, 0x55,
-	0x3d, 0xa8, 0x4a, 0x07, 0x6d, 0xc1, 0xc8, 0x16, 0x97, 0x7d, 0x66, 0x81, 0xcd, 0xc5, 0xf3, 0x7d,
-	0x88, 0x74, 0x5c, 0xbe, 0x12, 0x3f, 0x70, 0x84, 0x58, 0xff, 0xd2, 0x38, 0xcc, 0xd4, 0x9c, 0x4e,
-	0x10, 0x12, 0x7f, 0x41, 0xbc, 0x66, 0x12, 0x1f, 0x7d, 0x4a, 0x83, 0x4b, 0xec, 0xdf, 0x25, 0xef,
-	0x9e, 0xbb, 0x44, 0x1c, 0x63, 0x7f, 0x61, 0x9b, 0xd6, 0xb0, 0xac, 0xe3, 0xb1, 0xd0, 0xa5, 0x8e,
-	0xb8, 0xa4, 0x30, 0xdd, 0x6f, 0x23, 0x17, 0x23, 0x2e, 0xa0, 0x84, 0x7e, 0x50, 0x83, 0x07, 0x72,
-	0x40, 0x4b, 0xc4, 0x21, 0x61, 0x24, 0x7a, 0x1d, 0xb7, 0x1f, 0x0f, 0x1f, 0x1e, 0x54, 0x1f, 0x68,
-	0x14, 0x21, 0xc5, 0xc5, 0xf4, 0xd0, 0x0f, 0x6b, 0x30, 0x97, 0x03, 0xbd, 0x61, 0xd8, 0x4e, 0xc7,
-	0x8f, 0xa4, 0xb2, 0xe3, 0x76, 0x87, 0x09, 0x47, 0x8d, 0x42, 0xac, 0xb8, 0x0b, 0x45, 0xf4, 0x09,
-	0xb8, 0x28, 0xa1, 0x9b, 0xae, 0x4b, 0x88, 0x95, 0x90, 0xd1, 0x8e, 0xdb, 0x95, 0x07, 0x0e, 0x0f,
-	0xaa, 0x17, 0x1b, 0x79, 0x08, 0x71, 0x3e, 0x1d, 0xd4, 0x84, 0x87, 0x63, 0x40, 0x68, 0x3b, 0xf6,
-	0x9b, 0x5c, 0x8c, 0xdc, 0xf1, 0x49, 0xb0, 0xe3, 0x39, 0x16, 0x63, 0x48, 0xda, 0xe2, 0xbb, 0x0f,
-	0x0f, 0xaa, 0x0f, 0x37, 0xba, 0x55, 0xc4, 0xdd, 0xf1, 0x20, 0x0b, 0x26, 0x02, 0xd3, 0x70, 0xeb,
-	0x6e, 0x48, 0xfc, 0x5d, 0xc3, 0x99, 0x1d, 0x2e, 0x35, 0x40, 0xce, 0x06, 0x14, 0x3c, 0x38, 0x81,
-	0x15, 0x7d, 0x10, 0x46, 0xc9, 0x5e, 0xdb, 0x70, 0x2d, 0xc2, 0x59, 0xcf, 0xd8, 0xe2, 0x43, 0xf4,
-	0xc0, 0x5b, 0x16, 0x65, 0xf7, 0x0f, 0xaa, 0x13, 0xd1, 0xff, 0xab, 0x9e, 0x45, 0xb0, 0xac, 0x8d,
-	0x3e, 0x06, 0x17, 0xd8, 0x73, 0xab, 0x45, 0x18, 0x23, 0x0d, 0x22, 0x49, 0x7d, 0xb4, 0x54, 0x3f,
-	0xd9, 0xd3, 0xd9, 0x6a, 0x0e, 0x3e, 0x9c, 0x4b, 0x85, 0x2e, 0x43, 0xcb, 0xd8, 0xbb, 0xe9, 0x1b,
-	0x26, 0xd9, 0xee, 0x38, 0x1b, 0xc4, 0x6f, 0xd9, 0x2e, 0xbf, 0xaa, 0x12, 0xd3, 0x73, 0x2d, 0xca,
-	0xae, 0xb4, 0xc7, 0x87, 0xf8, 0x32, 0xac, 0x76, 0xab, 0x88, 0xbb, 0xe3, 0x41, 0xcf, 0xc0, 0x84,
-	0xdd, 0x74, 0x3d, 0x9f, 0x6c, 0x18, 0xb6, 0x1b, 0x06, 0xb3, 0xc0, 0x5e, 0x75, 0xd8, 0xb4, 0xd6,
-	0x95, 0x72, 0x9c, 0xa8, 0x85, 0x76, 0x01, 0xb9, 0xe4, 0xde, 0xba, 0x67, 0xb1, 0x2d, 0xb0, 0xd9,
-	0x66, 0x1b, 0x79, 0x76, 0xbc, 0xd4, 0xd4, 0xb0, 0x8b, 0xcc, 0x5a, 0x06, 0x1b, 0xce, 0xa1, 0x80,
-	0x6e, 0x00, 0x6a, 0x19, 0x7b, 0xcb, 0xad, 0x76, 0xb8, 0xbf, 0xd8, 0x71, 0xee, 0x0a, 0xae, 0x31,
-	0xc1, 0xe6, 0x82, 0x5f, 0xf3, 0x33, 0x50, 0x9c, 0xd3, 0x02, 0x19, 0xf0, 0x20, 0x1f, 0xcf, 0x92,
-	0x41, 0x5a, 0x9e, 0x1b, 0x90, 0x30, 0x50, 0x36, 0xe9, 0xec, 0x24, 0x7b, 0x24, 0x65, 0xd7, 0x8a,
-	0x7a, 0x71, 0x35, 0xdc, 0x0d, 0x47, 0xd2, 0xec, 0x60, 0xaa, 0xbb, 0xd9, 0x81, 0xfe, 0xbf, 0x06,
-	0x61, 0x36, 0xc3, 0xb0, 0xef, 0xb4, 0x43, 0x76, 0x84, 0x1e, 0xf9, 0x49, 0x6a, 0x27, 0xf4, 0x49,
-	0xb6, 0xe1, 0xaa, 0xac, 0x70, 0xb3, 0xdd, 0xc9, 0xa5, 0x55, 0x61, 0xb4, 0x1e, 0x3d, 0x3c, 0xa8,
-	0x5e, 0x6d, 0x1c, 0x51, 0x17, 0x1f, 0x89, 0xad, 0x98, 0xdd, 0x0d, 0x9c, 0x11, 0xbb, 0xfb, 0x18,
-	0x5c, 0x50, 0x00, 0x3e, 0x31, 0xac, 0xfd, 0x3e, 0xd8, 0x2d, 0xfb, 0xca, 0x1b, 0x39, 0xf8, 0x70,
-	0x2e, 0x95, 0x42, 0x1e, 0x33, 0x74, 0x16, 0x3c, 0x46, 0x3f, 0x18, 0x80, 0xb1, 0x9a, 0xe7, 0x5a,
-	0x36, 0xdb, 0xaf, 0x4f, 0x25, 0xde, 0xd5, 0x1e, 0x56, 0x05, 0xa6, 0xfb, 0x07, 0xd5, 0x49, 0x59,
-	0x51, 0x91, 0xa0, 0x9e, 0x93, 0xca, 0x6c, 0x7e, 0x0d, 0x79, 0x77, 0x52, 0x0b, 0x7d, 0xff, 0xa0,
-	0x7a, 0x4e, 0x36, 0x4b, 0x2a, 0xa6, 0x29, 0x03, 0xa1, 0x77, 0xf2, 0x0d, 0xdf, 0x70, 0x03, 0xbb,
-	0x0f, 0x2d, 0x88, 0xd4, 0x3e, 0xae, 0x64, 0xb0, 0xe1, 0x1c, 0x0a, 0xe8, 0x75, 0x98, 0xa2, 0xa5,
-	0x9b, 0x6d, 0xcb, 0x08, 0x49, 0x49, 0xe5, 0xc7, 0x25, 0x41, 0x73, 0x6a, 0x25, 0x81, 0x09, 0xa7,
-	0x30, 0xf3, 0x77, 0x48, 0x23, 0xf0, 0x5c, 0xb6, 0x9e, 0x89, 0x77, 0x48, 0x5a, 0x8a, 0x05, 0x14,
-	0x3d, 0x01, 0x23, 0x2d, 0x12, 0x04, 0x46, 0x93, 0xb0, 0x43, 0x70, 0x2c, 0x96, 0xa6, 0x57, 0x79,
-	0x31, 0x8e, 0xe0, 0xe8, 0xbd, 0x30, 0x64, 0x7a, 0x16, 0x09, 0x66, 0x47, 0x18, 0x9b, 0xa6, 0x2c,
-	0x6f, 0xa8, 0x46, 0x0b, 0xee, 0x1f, 0x54, 0xc7, 0x98, 0xae, 0x96, 0xfe, 0xc2, 0xbc, 0x92, 0xfe,
-	0x13, 0xf4, 0xe6, 0x9c, 0x52, 0x15, 0xf4, 0xf0, 0x7e, 0x7a, 0x76, 0x4f, 0x91, 0xfa, 0xe7, 0x34,
-	0x98, 0xa0, 0x3d, 0xf4, 0x3d, 0x67, 0xdd, 0x31, 0x5c, 0x82, 0xbe, 0x4f, 0x83, 0xe9, 0x1d, 0xbb,
-	0xb9, 0xa3, 0x1a, 0x40, 0x08, 0xe9, 0xb4, 0x94, 0x86, 0xe1, 0x56, 0x0a, 0xd7, 0xe2, 0x85, 0xc3,
-	0x83, 0xea, 0x74, 0xba, 0x14, 0x67, 0x68, 0xea, 0x9f, 0xae, 0xc0, 0x05, 0xd1, 0x33, 0x87, 0x8a,
-	0x8b, 0x6d, 0xc7, 0xdb, 0x6f, 0x11, 0xf7, 0x2c, 0x6c, 0x15, 0xa2, 0x15, 0xaa, 0x14, 0xae, 0x50,
-	0x2b, 0xb3, 0x42, 0x03, 0x65, 0x56, 0x48, 0x6e, 0xe4, 0x23, 0x56, 0xe9, 0x4f, 0x34, 0x98, 0xcd,
-	0x9b, 0x8b, 0x33, 0xd0, 0xc4, 0xb4, 0x92, 0x9a, 0x98, 0x5b, 0x65, 0x55, 0x6b, 0xe9, 0xae, 0x17,
-	0x68, 0x64, 0xfe, 0xb8, 0x02, 0x97, 0xe2, 0xea, 0x75, 0x37, 0x08, 0x0d, 0xc7, 0xe1, 0xe7, 0xf9,
-	0xe9, 0xaf, 0x7b, 0x3b, 0xa1, 0x50, 0x5b, 0xeb, 0x6f, 0xa8, 0x6a, 0xdf, 0x0b, 0x5f, 0x23, 0xf7,
-	0x52, 0xaf, 0x91, 0xeb, 0x27, 0x48, 0xb3, 0xfb, 0xc3, 0xe4, 0x7f, 0xd7, 0x60, 0x2e, 0xbf, 0xe1,
-	0x19, 0x6c, 0x2a, 0x2f, 0xb9, 0xa9, 0x3e, 0x72, 0x72, 0xa3, 0x2e, 0xd8, 0x56, 0x3f, 0x5f, 0x29,
-	0x1a, 0x2d, 0xd3, 0xca, 0x6d, 0xc3, 0x39, 0x9f, 0x34, 0xed, 0x20, 0x14, 0xcf, 0x66, 0xc7, 0xb3,
-	0x27, 0x8b, 0x34, 0xd5, 0xe7, 0x70, 0x12, 0x07, 0x4e, 0x23, 0x45, 0x6b, 0x30, 0x12, 0x10, 0x62,
-	0x51, 0xfc, 0x95, 0xde, 0xf1, 0xcb, 0xd3, 0xa8, 0xc1, 0xdb, 0xe2, 0x08, 0x09, 0xfa, 0x0e, 0x98,
-	0xb4, 0xe4, 0x17, 0x75, 0x84, 0x31, 0x49, 0x1a, 0x2b, 0x7b, 0xe0, 0x5c, 0x52, 0x5b, 0xe3, 0x24,
-	0x32, 0xfd, 0x2f, 0x35, 0x78, 0xa8, 0xdb, 0xde, 0x42, 0x6f, 0x00, 0x98, 0x91, 0x78, 0xc1, 0xcd,
-	0x09, 0x4b, 0x3e, 0x81, 0x4a, 0x21, 0x25, 0xfe, 0x40, 0x65, 0x51, 0x80, 0x15, 0x22, 0x39, 0x36,
-	0x2a, 0x95, 0x53, 0xb2, 0x51, 0xd1, 0xff, 0x87, 0xa6, 0xb2, 0x22, 0x75, 0x6d, 0xdf, 0x69, 0xac,
-	0x48, 0xed, 0x7b, 0xa1, 0x96, 0xff, 0xf7, 0x2a, 0x70, 0x35, 0xbf, 0x89, 0x72, 0xf6, 0x7e, 0x18,
-	0x86, 0xdb, 0xdc, 0xe6, 0x73, 0x80, 0x9d, 0x8d, 0x8f, 0x53, 0xce, 0xc2, 0x2d, 0x32, 0xef, 0x1f,
-	0x54, 0xe7, 0xf2, 0x18, 0xbd, 0xb0, 0xe5, 0x14, 0xed, 0x90, 0x9d, 0x52, 0x47, 0x72, 0xe9, 0xef,
-	0x5b, 0x7a, 0x64, 0x2e, 0xc6, 0x16, 0x71, 0x7a, 0xd6, 0x40, 0x7e, 0x52, 0x83, 0xa9, 0xc4, 0x8e,
-	0x0e, 0x66, 0x87, 0xd8, 0x1e, 0x2d, 0x65, 0x1e, 0x90, 0xf8, 0x54, 0xe2, 0x93, 0x3b, 0x51, 0x1c,
-	0xe0, 0x14, 0xc1, 0x14, 0x9b, 0x55, 0x67, 0xf5, 0x1d, 0xc7, 0x66, 0xd5, 0xce, 0x17, 0xb0, 0xd9,
-	0x1f, 0xab, 0x14, 0x8d, 0x96, 0xb1, 0xd9, 0x7b, 0x30, 0x16, 0x79, 0x43, 0x44, 0xec, 0xe2, 0x46,
-	0xbf, 0x7d, 0xe2, 0xe8, 0x62, 0xd3, 0xb8, 0xa8, 0x24, 0xc0, 0x31, 0x2d, 0xf4, 0x3d, 0x1a, 0x40,
-	0xbc, 0x30, 0xe2, 0xa3, 0xda, 0x38, 0xb9, 0xe9, 0x50, 0xc4, 0x9a, 0x29, 0xfa, 0x49, 0x2b, 0x9b,
-	0x42, 0xa1, 0xab, 0xff, 0xc5, 0x00, 0xa0, 0x6c, 0xdf, 0x7b, 0x7b, 0x6c, 0x3a, 0x42, 0x20, 0x7d,
-	0x01, 0xce, 0x35, 0x1d, 0x6f, 0xcb, 0x70, 0x9c, 0x7d, 0xe1, 0x1e, 0x20, 0x0c, 0xcd, 0xcf, 0xd3,
-	0x83, 0xe9, 0x66, 0x12, 0x84, 0xd3, 0x75, 0x51, 0x1b, 0xa6, 0x7d, 0x62, 0x7a, 0xae, 0x69, 0x3b,
-	0xec, 0xea, 0xe4, 0x75, 0xc2, 0x92, 0x37, 0x70, 0x26, 0xde, 0xe3, 0x14, 0x2e, 0x9c, 0xc1, 0x8e,
-	0x1e, 0x83, 0x91, 0xb6, 0x6f, 0xb7, 0x0c, 0x7f, 0x9f, 0x5d, 0xce, 0x46, 0xb9, 0x22, 0x7d, 0x9d,
-	0x17, 0xe1, 0x08, 0x86, 0x3e, 0x06, 0x63, 0x8e, 0xbd, 0x4d, 0xcc, 0x7d, 0xd3, 0x21, 0x42, 0x43,
-	0x79, 0xe7, 0x64, 0xb6, 0xcc, 0x4a, 0x84, 0x56, 0x98, 0xdd, 0x44, 0x3f, 0x71, 0x4c, 0x10, 0xd5,
-	0xe1, 0xfc, 0x3d, 0xcf, 0xbf, 0x4b, 0x7c, 0x87, 0x04, 0x41, 0xa3, 0xd3, 0x6e, 0x7b, 0x7e, 0x48,
-	0x2c, 0xa6, 0xc7, 0x1c, 0xe5, 0x3e, 0x10, 0x2f, 0x67, 0xc1, 0x38, 0xaf, 0x8d, 0xfe, 0x99, 0x0a,
-	0x3c, 0xd8, 0xa5, 0x13, 0x08, 0xd3, 0x6f, 0x43, 0xcc, 0x91, 0xd8, 0x09, 0xcf, 0xf0, 0xfd, 0x2c,
-	0x0a, 0xef, 0x1f, 0x54, 0x1f, 0xe9, 0x82, 0xa0, 0x41, 0xb7, 0x22, 0x69, 0xee, 0xe3, 0x18, 0x0d,
-	0xaa, 0xc3, 0xb0, 0x15, 0xab, 0xf5, 0xc7, 0x16, 0x9f, 0xa2, 0xdc, 0x9a, 0x2b, 0xe0, 0x7a, 0xc5,
-	0x26, 0x10, 0xa0, 0x15, 0x18, 0xe1, 0xc6, 0x3a, 0x44, 0x70, 0xfe, 0xa7, 0xd9, 0xf5, 0x98, 0x17,
-	0xf5, 0x8a, 0x2c, 0x42, 0xa1, 0xff, 0xb9, 0x06, 0x23, 0x35, 0xcf, 0x27, 0x4b, 0x6b, 0x0d, 0xb4,
-	0x0f, 0xe3, 0x8a, 0xc3, 0x97, 0xe0, 0x82, 0x25, 0xd9, 0x02, 0xc3, 0xb8, 0x10, 0x63, 0x8b, 0x5c,
-	0x0a, 0x64, 0x01, 0x56, 0x69, 0xa1, 0x37, 0xe8, 0x9c, 0xdf, 0xf3, 0xed, 0x90, 0x12, 0xee, 0xe7,
-	0x15, 0x9d, 0x13, 0xc6, 0x11, 0x2e, 0xbe, 0xa3, 0xe4, 0x4f, 0x1c, 0x53, 0xd1, 0xd7, 0x29, 0x07,
-	0x48, 0x77, 0x13, 0x5d, 0x87, 0xc1, 0x96, 0x67, 0x45, 0xeb, 0xfe, 0x9e, 0xe8, 0xfb, 0x5e, 0xf5,
-	0x2c, 0x3a, 0xb7, 0x97, 0xb2, 0x2d, 0x98, 0xaa, 0x9c, 0xb5, 0xd1, 0xd7, 0x60, 0x3a, 0x4d, 0x1f,
-	0x5d, 0x87, 0x29, 0xd3, 0x6b, 0xb5, 0x3c, 0xb7, 0xd1, 0xd9, 0xde, 0xb6, 0xf7, 0x48, 0xc2, 0xd7,
-	0xa3, 0x96, 0x80, 0xe0, 0x54, 0x4d, 0xfd, 0x0b, 0x1a, 0x0c, 0xd0, 0x75, 0xd1, 0x61, 0xd8, 0xf2,
-	0x5a, 0x86, 0xed, 0x8a, 0x5e, 0x31, 0xbf, 0x96, 0x25, 0x56, 0x82, 0x05, 0x04, 0xb5, 0x61, 0x2c,
-	0x12, 0x9a, 0xfa, 0xb2, 0x37, 0x5c, 0x5a, 0x6b, 0x48, 0x1b, 0x6d, 0xc9, 0xc9, 0xa3, 0x92, 0x00,
-	0xc7, 0x44, 0x74, 0x03, 0x66, 0x96, 0xd6, 0x1a, 0x75, 0xd7, 0x74, 0x3a, 0x16, 0x59, 0xde, 0x63,
-	0x7f, 0x28, 0x2f, 0xb1, 0x79, 0x89, 0x18, 0x27, 0xe3, 0x25, 0xa2, 0x12, 0x8e, 0x60, 0xb4, 0x1a,
-	0xe1, 0x2d, 0x84, 0x43, 0x06, 0xab, 0x26, 0x90, 0xe0, 0x08, 0xa6, 0x7f, 0xb5, 0x02, 0xe3, 0x4a,
-	0x87, 0x90, 0x03, 0x23, 0x7c, 0xb8, 0x91, 0x3d, 0xf4, 0x72, 0xc9, 0x21, 0x26, 0x7b, 0xcd, 0xa9,
-	0xf3, 0x09, 0x0d, 0x70, 0x44, 0x42, 0xe5, 0x8b, 0x95, 0x2e, 0x7c, 0x71, 0x1e, 0x20, 0x88, 0xbd,
-	0x83, 0xf8, 0x27, 0xc9, 0x8e, 0x1e, 0xc5, 0x27, 0x48, 0xa9, 0x81, 0x1e, 0x12, 0x27, 0x08, 0x37,
-	0xf8, 0x1b, 0x4d, 0x9d, 0x1e, 0xdb, 0x30, 0xf4, 0xa6, 0xe7, 0x92, 0x40, 0xe8, 0x3d, 0x4f, 0x68,
-	0x80, 0x63, 0x54, 0x3e, 0x78, 0x95, 0xe2, 0xc5, 0x1c, 0xbd, 0xfe, 0x93, 0x1a, 0xc0, 0x92, 0x11,
-	0x1a, 0xfc, 0x6d, 0xb6, 0x07, 0x73, 0xb6, 0x87, 0x12, 0x07, 0xdf, 0x68, 0xc6, 0xcf, 0x60, 0x30,
-	0xb0, 0xdf, 0x8c, 0x86, 0x2f, 0x05, 0x6a, 0x8e, 0xbd, 0x61, 0xbf, 0x49, 0x30, 0x83, 0xa3, 0x27,
-	0x61, 0x8c, 0xb8, 0xa6, 0xbf, 0xdf, 0xa6, 0xcc, 0x7b, 0x90, 0xcd, 0x2a, 0xfb, 0x42, 0x97, 0xa3,
-	0x42, 0x1c, 0xc3, 0xf5, 0xa7, 0x20, 0x79, 0x2b, 0xea, 0xc1, 0x2a, 0xee, 0xaf, 0x34, 0xb8, 0xbc,
-	0xd4, 0x31, 0x9c, 0x85, 0x36, 0xdd, 0xa8, 0x86, 0x73, 0xc3, 0xe3, 0xcf, 0x9b, 0xf4, 0xaa, 0xf0,
-	0x5e, 0x18, 0x8d, 0xe4, 0x10, 0x81, 0x41, 0x4a, 0x6c, 0x11, 0xa3, 0xc4, 0xb2, 0x06, 0x32, 0x60,
-	0x34, 0x88, 0x24, 0xe3, 0x4a, 0x1f, 0x92, 0x71, 0x44, 0x42, 0x4a, 0xc6, 0x12, 0x2d, 0xc2, 0x70,
-	0x49, 0x7c, 0x10, 0x0d, 0xe2, 0xef, 0xda, 0x26, 0x59, 0x30, 0x4d, 0xaf, 0xe3, 0x86, 0x81, 0x10,
-	0x18, 0xd8, 0x9b, 0x72, 0x3d, 0xb7, 0x06, 0x2e, 0x68, 0xa9, 0x7f, 0x6d, 0x10, 0x1e, 0x58, 0xde,
-	0xa8, 0x2d, 0x89, 0x09, 0xb5, 0x3d, 0xf7, 0x36, 0xd9, 0xff, 0x5b, 0x2b, 0xc1, 0xbf, 0xb5, 0x12,
-	0x3c, 0x41, 0x2b, 0xc1, 0x17, 0x61, 0x3a, 0xde, 0x5e, 0xc2, 0x84, 0xe6, 0xc9, 0xf4, 0x85, 0x62,
-	0x2c, 0x3a, 0x7a, 0xb3, 0x97, 0x00, 0xfd, 0xbe, 0x06, 0xd3, 0xcb, 0x7b, 0x6d, 0xdb, 0x67, 0xde,
-	0x70, 0xdc, 0x10, 0x16, 0x3d, 0x11, 0xdb, 0xcb, 0x6a, 0x49, 0xd5, 0x7f, 0xda, 0x66, 0x16, 0x6d,
-	0xc3, 0x14, 0x61, 0xcd, 0x99, 0xc4, 0x6f, 0x84, 0x65, 0x76, 0x20, 0x77, 0xb6, 0x4c, 0x60, 0xc1,
-	0x29, 0xac, 0xa8, 0x01, 0x53, 0xa6, 0x63, 0x04, 0x81, 0xbd, 0x6d, 0x9b, 0xb1, 0x9d, 0xf7, 0xd8,
-	0xe2, 0x93, 0xec, 0xf0, 0x4e, 0x40, 0xee, 0x1f, 0x54, 0x2f, 0x8a, 0x7e, 0x26, 0x01, 0x38, 0x85,
-	0x42, 0x7f, 0xbb, 0x02, 0x93, 0xcb, 0x7b, 0x6d, 0x2f, 0xe8, 0xf8, 0x84, 0x55, 0x3d, 0x03, 0x1d,
-	0xc6, 0x13, 0x30, 0xb2, 0x63, 0xb8, 0x96, 0x43, 0x7c, 0xc1, 0xbf, 0xe5, 0xdc, 0xde, 0xe2, 0xc5,
-	0x38, 0x82, 0xa3, 0xb7, 0x00, 0x02, 0x73, 0x87, 0x58, 0x1d, 0x26, 0x03, 0xf2, 0xaf, 0xec, 0x76,
-	0x99, 0x53, 0x28, 0x31, 0xc6, 0x86, 0x44, 0x29, 0xce, 0x46, 0xf9, 0x1b, 0x2b, 0xe4, 0xf4, 0xdf,
-	0xd7, 0x60, 0x26, 0xd1, 0xee, 0x0c, 0xae, 0xe6, 0xdb, 0xc9, 0xab, 0xf9, 0x42, 0xdf, 0x63, 0x2d,
-	0xb8, 0x91, 0xff, 0x40, 0x05, 0x2e, 0x17, 0xcc, 0x49, 0xc6, 0x32, 0x4c, 0x3b, 0x23, 0xcb, 0xb0,
-	0x0e, 0x8c, 0x87, 0x9e, 0x23, 0xdc, 0x11, 0xa2, 0x19, 0x28, 0x65, 0xf7, 0xb5, 0x21, 0xd1, 0xc4,
-	0x76, 0x5f, 0x71, 0x59, 0x80, 0x55, 0x3a, 0xfa, 0xaf, 0x6a, 0x30, 0x26, 0x35, 0x80, 0xdf, 0x50,
-	0xaf, 0x70, 0xbd, 0xfb, 0x87, 0xeb, 0xbf, 0x59, 0x81, 0x4b, 0x12, 0x77, 0xc4, 0xe6, 0x1a, 0x21,
-	0xe5, 0x1b, 0x47, 0xab, 0x11, 0x1e, 0x4a, 0xd8, 0xac, 0x8e, 0x66, 0x5d, 0x07, 0xda, 0x1d, 0xbf,
-	0xed, 0x05, 0x91, 0x40, 0xc5, 0x25, 0x4f, 0x5e, 0x84, 0x23, 0x18, 0x5a, 0x83, 0xa1, 0x80, 0xd2,
-	0x13, 0xc7, 0xd1, 0x31, 0x67, 0x83, 0xc9, 0x84, 0xac, 0xbf, 0x98, 0xa3, 0x41, 0x6f, 0xa9, 0x3c,
-	0x7c, 0xa8, 0xbc, 0xa2, 0x8a, 0x8e, 0xc4, 0x92, 0x22, 0x55, 0xd6, 0x67, 0x32, 0xf7, 0x4c, 0x58,
-	0x81, 0x69, 0x61, 0xf8, 0xc5, 0xb7, 0x8d, 0x6b, 0x12, 0xf4, 0xc1, 0xc4, 0xce, 0x78, 0x34, 0xf5,
-	0x0e, 0x7f, 0x21, 0x5d, 0x3f, 0xde, 0x31, 0x7a, 0x00, 0xa3, 0x37, 0x45, 0x27, 0xd1, 0x1c, 0x54,
-	0xec, 0x68, 0x2d, 0x40, 0xe0, 0xa8, 0xd4, 0x97, 0x70, 0xc5, 0xee, 0xc1, 0x76, 0x58, 0x3d, 0x96,
-	0x06, 0xba, 0x1f, 0x4b, 0xfa, 0x1f, 0x55, 0xe0, 0x42, 0x44, 0x35, 0x1a, 0xe3, 0x92, 0x78, 0xc5,
-	0x3c, 0x42, 0xba, 0x3e, 0x5a, 0xad, 0x74, 0x07, 0x06, 0x19, 0x03, 0x2c, 0xf5, 0xba, 0x29, 0x11,
-	0xd2, 0xee, 0x60, 0x86, 0x08, 0x7d, 0x0c, 0x86, 0x1d, 0x2a, 0xaa, 0x46, 0x46, 0xbd, 0xa5, 0x94,
-	0x70, 0x79, 0xc3, 0xe5, 0x12, 0x70, 0xc0, 0x7d, 0xd6, 0xe4, 0xa3, 0x17, 0x2f, 0xc4, 0x82, 0xe6,
-	0xdc, 0x73, 0x30, 0xae, 0x54, 0x43, 0xd3, 0x30, 0x70, 0x97, 0xf0, 0xd7, 0xed, 0x31, 0x4c, 0xff,
-	0x45, 0x17, 0x60, 0x68, 0xd7, 0x70, 0x3a, 0x62, 0x4a, 0x30, 0xff, 0x71, 0xbd, 0xf2, 0x41, 0x4d,
-	0xff, 0x42, 0x05, 0x66, 0x6f, 0x11, 0xa7, 0x95, 0xfb, 0x24, 0x5d, 0x85, 0x21, 0x73, 0xc7, 0xf0,
-	0x79, 0x08, 0x91, 0x09, 0xbe, 0xc9, 0x6b, 0xb4, 0x00, 0xf3, 0x72, 0xb4, 0x05, 0xc3, 0x0c, 0x55,
-	0xf4, 0x5c, 0xf1, 0x21, 0x65, 0x26, 0xe3, 0xd8, 0x32, 0xdf, 0x29, 0x83, 0xcf, 0xc4, 0x03, 0x4f,
-	0x54, 0xa0, 0xc7, 0xcb, 0x47, 0x1a, 0x77, 0xd6, 0xf8, 0x65, 0xfc, 0x25, 0x86, 0x11, 0x0b, 0xcc,
-	0xe8, 0x4d, 0x98, 0xf4, 0x4c, 0x1b, 0x93, 0xb6, 0x17, 0xd8, 0xa1, 0xe7, 0xef, 0x8b, 0x45, 0x2b,
-	0x75, 0xb4, 0xdc, 0xa9, 0xd5, 0x63, 0x44, 0xfc, 0xa9, 0x28, 0x51, 0x84, 0x93, 0xa4, 0xf4, 0x2f,
-	0x69, 0x30, 0x7e, 0xcb, 0xde, 0x22, 0x3e, 0xb7, 0x6d, 0x63, 0x57, 0xed, 0x44, 0xf0, 0x92, 0xf1,
-	0xbc, 0xc0, 0x25, 0x68, 0x0f, 0xc6, 0xc4, 0x39, 0x2c, 0x7d, 0x37, 0x6e, 0x96, 0x33, 0x32, 0x90,
-	0xa4, 0xc5, 0xf9, 0xa6, 0x3a, 0x4b, 0x47, 0x14, 0x70, 0x4c, 0x4c, 0x7f, 0x0b, 0xce, 0xe7, 0x34,
-	0xa2, 0x0b, 0x19, 0x84, 0xd1, 0x42, 0x8e, 0x49, 0x6e, 0x45, 0x17, 0x92, 0x95, 0xa3, 0x07, 0x60,
-	0x80, 0xb8, 0x96, 0xf8, 0x62, 0x46, 0x0e, 0x0f, 0xaa, 0x03, 0xcb, 0xae, 0x85, 0x69, 0x19, 0x65,
-	0xe2, 0x8e, 0x97, 0x90, 0xd8, 0x18, 0x13, 0x5f, 0x11, 0x65, 0x58, 0x42, 0x99, 0x59, 0x48, 0xda,
-	0x02, 0x82, 0x0a, 0xff, 0xd3, 0xdb, 0x29, 0xde, 0xd2, 0x8f, 0xe1, 0x45, 0x9a, 0x4f, 0x2d, 0xce,
-	0x8a, 0x09, 0xc9, 0x70, 0x3c, 0x9c, 0xa1, 0xab, 0xff, 0xd2, 0x20, 0x3c, 0x7c, 0xcb, 0xf3, 0xed,
-	0x37, 0x3d, 0x37, 0x34, 0x9c, 0x75, 0xcf, 0x8a, 0x8d, 0xe2, 0xc4, 0x91, 0xf5, 0xbd, 0x1a, 0x5c,
-	0x36, 0xdb, 0x1d, 0x7e, 0x79, 0x88, 0xec, 0xca, 0xd6, 0x89, 0x6f, 0x7b, 0x65, 0x8d, 0x99, 0x59,
-	0x78, 0x8c, 0xda, 0xfa, 0x66, 0x1e, 0x4a, 0x5c, 0x44, 0x8b, 0xd9, 0x54, 0x5b, 0xde, 0x3d, 0x97,
-	0x75, 0xae, 0x11, 0xb2, 0xd9, 0x7c, 0x33, 0x5e, 0x84, 0x92, 0x36, 0xd5, 0x4b, 0xb9, 0x18, 0x71,
-	0x01, 0x25, 0xf4, 0x09, 0xb8, 0x68, 0xf3, 0xce, 0x61, 0x62, 0x58, 0xb6, 0x4b, 0x82, 0x80, 0x1b,
-	0x64, 0xf6, 0x61, 0x34, 0x5c, 0xcf, 0x43, 0x88, 0xf3, 0xe9, 0xa0, 0xd7, 0x00, 0x82, 0x7d, 0xd7,
-	0x14, 0xf3, 0x5f, 0xce, 0x7a, 0x8d, 0x8b, 0xc8, 0x12, 0x0b, 0x56, 0x30, 0xd2, 0x8b, 0x56, 0x28,
-	0x37, 0xe5, 0x30, 0xb3, 0x40, 0x64, 0x17, 0xad, 0x78, 0x0f, 0xc5, 0x70, 0xfd, 0x9f, 0x69, 0x30,
-	0x22, 0x42, 0xf0, 0xa0, 0xf7, 0xa4, 0xb4, 0x88, 0x92, 0x33, 0xa7, 0x34, 0x89, 0xfb, 0xec, 0x29,
-	0x59, 0x70, 0x56, 0xc1, 0x24, 0x4b, 0xa9, 0xa1, 0x04, 0xe1, 0x98, 0x4d, 0x27, 0x9e, 0x94, 0x23,
-	0x15, 0xb5, 0x42, 0x4c, 0xff, 0xa2, 0x06, 0x33, 0x99, 0x56, 0x3d, 0x48, 0x53, 0x67, 0x68, 0xa5,
-	0xf5, 0x7b, 0x83, 0x30, 0xc5, 0x2c, 0xaa, 0x5d, 0xc3, 0xe1, 0x0a, 0xbe, 0x33, 0xb8, 0xbe, 0x3d,
-	0x09, 0x63, 0x76, 0xab, 0xd5, 0x09, 0x29, 0xab, 0x16, 0x6f, 0x34, 0x6c, 0xcd, 0xeb, 0x51, 0x21,
-	0x8e, 0xe1, 0xc8, 0x15, 0x82, 0x02, 0x67, 0xe2, 0x2b, 0xe5, 0x56, 0x4e, 0x1d, 0xe0, 0x3c, 0x3d,
-	0xd4, 0xf9, 0x69, 0x9e, 0x27, 0x47, 0x7c, 0x9f, 0x06, 0x10, 0x84, 0xbe, 0xed, 0x36, 0x69, 0xa1,
-	0x10, 0x26, 0xf0, 0x09, 0x90, 0x6d, 0x48, 0xa4, 0x9c, 0xb8, 0x9c, 0xa3, 0x18, 0x80, 0x15, 0xca,
-	0x68, 0x41, 0xc8, 0x50, 0x9c, 0xe3, 0xbf, 0x2f, 0x25, 0x2d, 0x3e, 0x9c, 0x8d, 0x55, 0x27, 0xc2,
-	0x32, 0xc4, 0x42, 0xd6, 0xdc, 0xb3, 0x30, 0x26, 0xe9, 0x1d, 0x25, 0x93, 0x4c, 0x28, 0x32, 0xc9,
-	0xdc, 0x0b, 0x70, 0x2e, 0xd5, 0xdd, 0x63, 0x89, 0x34, 0xff, 0x51, 0x03, 0x94, 0x1c, 0xfd, 0x19,
-	0x5c, 0x7c, 0x9b, 0xc9, 0x8b, 0xef, 0x62, 0xff, 0x4b, 0x56, 0x70, 0xf3, 0xfd, 0xd4, 0x34, 0xb0,
-	0x08, 0x65, 0x32, 0x02, 0x9c, 0x38, 0xb8, 0xe8, 0x39, 0x1b, 0xbb, 0xba, 0x89, 0x2f, 0xb7, 0x8f,
-	0x73, 0xf6, 0x76, 0x0a, 0x57, 0x7c, 0xce, 0xa6, 0x21, 0x38, 0x43, 0x17, 0x7d, 0x5a, 0x83, 0x69,
-	0x23, 0x19, 0xa1, 0x2c, 0x9a, 0x99, 0x52, 0x11, 0x30, 0x52, 0xd1, 0xce, 0xe2, 0xbe, 0xa4, 0x00,
-	0x01, 0xce, 0x90, 0x45, 0xcf, 0xc0, 0x84, 0xd1, 0xb6, 0x17, 0x3a, 0x96, 0x4d, 0x2f, 0x4e, 0x51,
-	0x78, 0x29, 0x76, 0x99, 0x5f, 0x58, 0xaf, 0xcb, 0x72, 0x9c, 0xa8, 0x25, 0x43, 0x81, 0x89, 0x89,
-	0x1c, 0xec, 0x33, 0x14, 0x98, 0x98, 0xc3, 0x38, 0x14, 0x98, 0x98, 0x3a, 0x95, 0x08, 0x72, 0x01,
-	0x3c, 0xdb, 0x32, 0x05, 0xc9, 0x61, 0x21, 0x51, 0x97, 0x11, 0x73, 0xeb, 0x4b, 0x35, 0x41, 0x91,
-	0x9d, 0x7e, 0xf1, 0x6f, 0xac, 0x50, 0x40, 0x9f, 0xd3, 0x60, 0x52, 0xf0, 0x6e, 0x41, 0x73, 0x84,
-	0x2d, 0xd1, 0xab, 0x65, 0xf7, 0x4b, 0x6a, 0x4f, 0xce, 0x63, 0x15, 0x39, 0xe7, 0x3b, 0xd2, 0x53,
-	0x32, 0x01, 0xc3, 0xc9, 0x7e, 0xa0, 0x7f, 0xa8, 0xc1, 0x85, 0x20, 0xa1, 0x8c, 0x17, 0x1d, 0x1c,
-	0x2d, 0x1f, 0x39, 0xa9, 0x91, 0x83, 0x4f, 0x18, 0xd6, 0xe7, 0x40, 0x70, 0x2e, 0x7d, 0x2a, 0x96,
-	0x9d, 0xbb, 0x67, 0x84, 0xe6, 0x4e, 0xcd, 0x30, 0x77, 0xd8, 0x5b, 0x0c, 0xf7, 0x98, 0x29, 0xb9,
-	0xaf, 0x5f, 0x4e, 0xa2, 0xe2, 0x56, 0x0d, 0xa9, 0x42, 0x9c, 0x26, 0x88, 0x3c, 0x18, 0xf5, 0x45,
-	0xd8, 0x47, 0xe1, 0xea, 0x57, 0x4a, 0xa4, 0xc8, 0xc4, 0x90, 0xe4, 0x82, 0x7d, 0xf4, 0x0b, 0x4b,
-	0x22, 0xa8, 0x09, 0x0f, 0xf3, 0xab, 0xcd, 0x82, 0xeb, 0xb9, 0xfb, 0x2d, 0xaf, 0x13, 0x2c, 0x74,
-	0xc2, 0x1d, 0xe2, 0x86, 0x91, 0x26, 0x77, 0x9c, 0x1d, 0xa3, 0xcc, 0x51, 0x64, 0xb9, 0x5b, 0x45,
-	0xdc, 0x1d, 0x0f, 0x7a, 0x05, 0x46, 0xc9, 0x2e, 0x71, 0xc3, 0x8d, 0x8d, 0x15, 0xe6, 0x7c, 0x73,
-	0x7c, 0x69, 0x8f, 0x0d, 0x61, 0x59, 0xe0, 0xc0, 0x12, 0x1b, 0xba, 0x0b, 0x23, 0x0e, 0x8f, 0xdb,
-	0xc9, 0x9c, 0x70, 0x4a, 0x32, 0xc5, 0x74, 0x0c, 0x50, 0x7e, 0xff, 0x13, 0x3f, 0x70, 0x44, 0x01,
-	0xb5, 0xe1, 0xaa, 0x45, 0xb6, 0x8d, 0x8e, 0x13, 0xae, 0x79, 0x21, 0x66, 0x5e, 0x19, 0x52, 0x61,
-	0x17, 0xf9, 0x59, 0x4d, 0xb1, 0x20, 0x27, 0xcc, 0xdf, 0x65, 0xe9, 0x88, 0xba, 0xf8, 0x48, 0x6c,
-	0x68, 0x1f, 0x1e, 0x11, 0x75, 0x98, 0x1b, 0x88, 0xb9, 0x43, 0x67, 0x39, 0x4b, 0xf4, 0x1c, 0x23,
-	0xfa, 0xff, 0x1d, 0x1e, 0x54, 0x1f, 0x59, 0x3a, 0xba, 0x3a, 0xee, 0x05, 0x27, 0xb3, 0xac, 0x27,
-	0xa9, 0x17, 0x8c, 0xd9, 0xe9, 0xf2, 0x73, 0x9c, 0x7e, 0x0d, 0xe1, 0xa6, 0x37, 0xe9, 0x52, 0x9c,
-	0xa1, 0x89, 0x7e, 0x46, 0x83, 0xd9, 0x20, 0xf4, 0x3b, 0x66, 0xd8, 0xf1, 0x89, 0x95, 0xda, 0xa1,
-	0x33, 0xac, 0x43, 0xa5, 0x04, 0xb8, 0x46, 0x01, 0x4e, 0xe6, 0xf1, 0x37, 0x5b, 0x04, 0xc5, 0x85,
-	0x7d, 0x99, 0xfb, 0x30, 0xa0, 0x2c, 0x67, 0x3c, 0x4a, 0xc4, 0x19, 0x55, 0x45, 0x9c, 0xcf, 0x0f,
-	0xc1, 0x83, 0x94, 0xe1, 0xc6, 0x82, 0xfd, 0xaa, 0xe1, 0x1a, 0xcd, 0x6f, 0x4c, 0x61, 0xe0, 0x4b,
-	0x1a, 0x5c, 0xde, 0xc9, 0xbf, 0x74, 0x8b, 0xab, 0xc5, 0x47, 0x4b, 0x29, 0x47, 0xba, 0xdd, 0xe3,
-	0x39, 0x2f, 0xea, 0x5a, 0x05, 0x17, 0x75, 0x0a, 0x7d, 0x18, 0xa6, 0x5d, 0xcf, 0x22, 0xb5, 0xfa,
-	0x12, 0x5e, 0x35, 0x82, 0xbb, 0x8d, 0xe8, 0x2d, 0x7e, 0x88, 0x6f, 0xc5, 0xb5, 0x14, 0x0c, 0x67,
-	0x6a, 0xa3, 0x5d, 0x40, 0x6d, 0xcf, 0x5a, 0xde, 0xb5, 0xcd, 0xe8, 0x11, 0xb4, 0xbc, 0xe5, 0x19,
-	0x7b, 0x69, 0x5d, 0xcf, 0x60, 0xc3, 0x39, 0x14, 0x98, 0xd6, 0x80, 0x76, 0x66, 0xd5, 0x73, 0xed,
-	0xd0, 0xf3, 0x99, 0x7b, 0x66, 0x5f, 0x97, 0x67, 0xa6, 0x35, 0x58, 0xcb, 0xc5, 0x88, 0x0b, 0x28,
-	0xe9, 0xff, 0x53, 0x83, 0x73, 0x74, 0x5b, 0xac, 0xfb, 0xde, 0xde, 0xfe, 0x37, 0xe2, 0x86, 0x7c,
-	0x42, 0x98, 0x25, 0x71, 0x6d, 0xd7, 0x45, 0xc5, 0x24, 0x69, 0x8c, 0xf5, 0x39, 0xb6, 0x42, 0x52,
-	0x15, 0x7e, 0x03, 0xc5, 0x0a, 0x3f, 0xfd, 0x73, 0x15, 0x2e, 0x94, 0x47, 0x0a, 0xb7, 0x6f, 0xc8,
-	0xef, 0xf0, 0x59, 0x98, 0xa4, 0x65, 0xab, 0xc6, 0xde, 0xfa, 0xd2, 0x4b, 0x9e, 0x13, 0x39, 0xd7,
-	0x31, 0x2d, 0xe8, 0x6d, 0x15, 0x80, 0x93, 0xf5, 0xd0, 0x75, 0x18, 0x69, 0xf3, 0x58, 0x1f, 0xe2,
-	0x3a, 0x78, 0x95, 0xdb, 0xee, 0xb0, 0xa2, 0xfb, 0x07, 0xd5, 0x99, 0xf8, 0xf1, 0x2d, 0x8a, 0x38,
-	0x12, 0x35, 0xd0, 0xff, 0xfa, 0x3c, 0x30, 0xe4, 0x0e, 0x09, 0xbf, 0x11, 0xe7, 0xe4, 0x29, 0x18,
-	0x37, 0xdb, 0x9d, 0xda, 0x8d, 0xc6, 0x47, 0x3b, 0x1e, 0xbb, 0xe6, 0xb3, 0x88, 0xd4, 0x54, 0x4a,
-	0xaf, 0xad, 0x6f, 0x46, 0xc5, 0x58, 0xad, 0x43, 0xb9, 0x83, 0xd9, 0xee, 0x08, 0x7e, 0xbb, 0xae,
-	0x5a, 0x8d, 0x33, 0xee, 0x50, 0x5b, 0xdf, 0x4c, 0xc0, 0x70, 0xa6, 0x36, 0xfa, 0x04, 0x4c, 0x10,
-	0xf1, 0xe1, 0xde, 0x32, 0x7c, 0x4b, 0xf0, 0x85, 0x7a, 0xd9, 0xc1, 0xcb, 0xa9, 0x8d, 0xb8, 0x01,
-	0xbf, 0xdc, 0x2c, 0x2b, 0x24, 0x70, 0x82, 0x20, 0xfa, 0x76, 0x78, 0x20, 0xfa, 0x4d, 0x57, 0xd9,
-	0xb3, 0xd2, 0x8c, 0x62, 0x88, 0x87, 0x3e, 0x58, 0x2e, 0xaa, 0x84, 0x8b, 0xdb, 0xa3, 0x9f, 0xd3,
-	0xe0, 0x92, 0x84, 0xda, 0xae, 0xdd, 0xea, 0xb4, 0x30, 0x31, 0x1d, 0xc3, 0x6e, 0x89, 0x2b, 0xcd,
-	0xcb, 0x27, 0x36, 0xd0, 0x24, 0x7a, 0xce, 0xac, 0xf2, 0x61, 0xb8, 0xa0, 0x4b, 0xe8, 0x8b, 0x1a,
-	0x5c, 0x8d, 0x40, 0xeb, 0x3e, 0x09, 0x82, 0x8e, 0x4f, 0x62, 0xd7, 0x4e, 0x31, 0x25, 0x23, 0xa5,
-	0x78, 0x27, 0x93, 0xed, 0x96, 0x8f, 0xc0, 0x8d, 0x8f, 0xa4, 0xae, 0x6e, 0x97, 0x86, 0xb7, 0x1d,
-	0x8a, 0x3b, 0xd0, 0x69, 0x6d, 0x17, 0x4a, 0x02, 0x27, 0x08, 0xa2, 0x7f, 0xae, 0xc1, 0x65, 0xb5,
-	0x40, 0xdd, 0x2d, 0xfc, 0xf2, 0xf3, 0xca, 0x89, 0x75, 0x26, 0x85, 0x9f, 0x6b, 0xcf, 0x0b, 0x80,
-	0xb8, 0xa8, 0x57, 0x94, 0x6d, 0xb7, 0xd8, 0xc6, 0xe4, 0x17, 0xa4, 0x21, 0xce, 0xb6, 0xf9, 0x5e,
-	0x0d, 0x70, 0x04, 0x43, 0xcf, 0xc0, 0x44, 0xdb, 0xb3, 0xd6, 0x6d, 0x2b, 0x58, 0xb1, 0x5b, 0x76,
-	0xc8, 0xae, 0x31, 0x03, 0x7c, 0x3a, 0xd6, 0x3d, 0x6b, 0xbd, 0xbe, 0xc4, 0xcb, 0x71, 0xa2, 0x16,
-	0x9a, 0x07, 0xd8, 0x36, 0x6c, 0xa7, 0x71, 0xcf, 0x68, 0xdf, 0x89, 0x5c, 0xfa, 0xd9, 0x35, 0xfb,
-	0x86, 0x2c, 0xc5, 0x4a, 0x0d, 0xba, 0x7e, 0x94, 0xef, 0x60, 0xc2, 0x43, 0x16, 0x32, 0xc9, 0xff,
-	0x24, 0xd6, 0x2f, 0x42, 0xc8, 0x3b, 0x7c, 0x5b, 0x21, 0x81, 0x13, 0x04, 0xd1, 0xf7, 0x6a, 0x30,
-	0x15, 0xec, 0x07, 0x21, 0x69, 0xc9, 0x3e, 0x9c, 0x3b, 0xe9, 0x3e, 0x30, 0x75, 0x6f, 0x23, 0x41,
-	0x04, 0xa7, 0x88, 0xb2, 0xe0, 0x08, 0x2d, 0xa3, 0x49, 0x6e, 0xd6, 0x6e, 0xd9, 0xcd, 0x1d, 0xe9,
-	0xac, 0xbf, 0x4e, 0x7c, 0x93, 0xb8, 0x21, 0xbb, 0x33, 0x0c, 0x89, 0xe0, 0x08, 0xc5, 0xd5, 0x70,
-	0x37, 0x1c, 0xe8, 0x35, 0x98, 0x13, 0xe0, 0x15, 0xef, 0x5e, 0x86, 0xc2, 0x0c, 0xa3, 0xc0, 0xac,
-	0xc7, 0xea, 0x85, 0xb5, 0x70, 0x17, 0x0c, 0xa8, 0x0e, 0xe7, 0x03, 0xe2, 0xb3, 0xd7, 0x1a, 0x1e,
-	0xd5, 0x69, 0xbd, 0xe3, 0x38, 0xc1, 0x2c, 0x8a, 0x2d, 0xe7, 0x1b, 0x59, 0x30, 0xce, 0x6b, 0x83,
-	0x5e, 0x90, 0xce, 0x79, 0xfb, 0xb4, 0xe0, 0xa3, 0xeb, 0x8d, 0xd9, 0xf3, 0xac, 0x7f, 0xe7, 0x15,
-	0x9f, 0xbb, 0x08, 0x84, 0xd3, 0x75, 0xe9, 0x69, 0x1e, 0x15, 0x2d, 0x76, 0xfc, 0x20, 0x9c, 0xbd,
-	0xc0, 0x1a, 0xb3, 0xd3, 0x1c, 0xab, 0x00, 0x9c, 0xac, 0x87, 0xae, 0xc3, 0x54, 0x40, 0x4c, 0xd3,
-	0x6b, 0xb5, 0xc5, 0x15, 0x70, 0xf6, 0x22, 0xeb, 0x3d, 0x5f, 0xc1, 0x04, 0x04, 0xa7, 0x6a, 0xa2,
-	0x7d, 0x38, 0x2f, 0x43, 0xc4, 0xad, 0x78, 0xcd, 0x55, 0x63, 0x8f, 0x09, 0xc7, 0x97, 0x8e, 0xe6,
-	0x8f, 0xf3, 0x91, 0x71, 0xc2, 0xfc, 0x47, 0x3b, 0x86, 0x1b, 0xda, 0xe1, 0x3e, 0x9f, 0xae, 0x5a,
-	0x16, 0x1d, 0xce, 0xa3, 0x81, 0x56, 0xe0, 0x42, 0xaa, 0xf8, 0x86, 0xed, 0x90, 0x60, 0xf6, 0x32,
-	0x1b, 0x36, 0xd3, 0xe3, 0xd4, 0x72, 0xe0, 0x38, 0xb7, 0x15, 0xba, 0x03, 0x17, 0xdb, 0xbe, 0x17,
-	0x12, 0x33, 0xbc, 0x4d, 0x05, 0x02, 0x47, 0x0c, 0x30, 0x98, 0x9d, 0x65, 0x73, 0xc1, 0x5e, 0xaa,
-	0xd6, 0xf3, 0x2a, 0xe0, 0xfc, 0x76, 0xe8, 0xf3, 0x1a, 0x5c, 0x09, 0x42, 0x9f, 0x18, 0x2d, 0xdb,
-	0x6d, 0xd6, 0x3c, 0xd7, 0x25, 0x8c, 0x31, 0xd5, 0xad, 0xd8, 0xf1, 0xe4, 0x81, 0x52, 0xa7, 0x88,
-	0x7e, 0x78, 0x50, 0xbd, 0xd2, 0xe8, 0x8a, 0x19, 0x1f, 0x41, 0x19, 0xbd, 0x05, 0xd0, 0x22, 0x2d,
-	0xcf, 0xdf, 0xa7, 0x1c, 0x69, 0x76, 0xae, 0xbc, 0x19, 0xda, 0xaa, 0xc4, 0xc2, 0x3f, 0xff, 0xc4,
-	0x1b, 0x5b, 0x0c, 0xc4, 0x0a, 0x39, 0xfd, 0xa0, 0x02, 0x17, 0x73, 0x59, 0x3d, 0xfd, 0x02, 0x78,
-	0xbd, 0x85, 0x28, 0x98, 0xbf, 0x78, 0x96, 0x62, 0x5f, 0xc0, 0x6a, 0x12, 0x84, 0xd3, 0x75, 0xa9,
-	0x20, 0xc6, 0xbe, 0xd4, 0x1b, 0x8d, 0xb8, 0x7d, 0x25, 0x16, 0xc4, 0xea, 0x29, 0x18, 0xce, 0xd4,
-	0x46, 0x35, 0x98, 0x11, 0x65, 0x75, 0x7a, 0x97, 0x09, 0x6e, 0xf8, 0x24, 0x12, 0x71, 0xe9, 0xad,
-	0x60, 0xa6, 0x9e, 0x06, 0xe2, 0x6c, 0x7d, 0x3a, 0x0a, 0xfa, 0x43, 0xed, 0xc5, 0x60, 0x3c, 0x8a,
-	0xb5, 0x24, 0x08, 0xa7, 0xeb, 0x46, 0x97, 0xcd, 0x44, 0x17, 0x86, 0xe2, 0x51, 0xac, 0xa5, 0x60,
-	0x38, 0x53, 0x5b, 0xff, 0x4f, 0x83, 0xf0, 0x48, 0x0f, 0xe2, 0x11, 0x6a, 0xe5, 0x4f, 0xf7, 0xf1,
-	0x3f, 0xdc, 0xde, 0x96, 0xa7, 0x5d, 0xb0, 0x3c, 0xc7, 0xa7, 0xd7, 0xeb, 0x72, 0x06, 0x45, 0xcb,
-	0x79, 0x7c, 0x92, 0xbd, 0x2f, 0x7f, 0x2b, 0x7f, 0xf9, 0x4b, 0xce, 0xea, 0x91, 0xdb, 0xa5, 0x5d,
-	0xb0, 0x5d, 0x4a, 0xce, 0x6a, 0x0f, 0xdb, 0xeb, 0x0f, 0x06, 0xe1, 0xd1, 0x5e, 0x44, 0xb5, 0x92,
-	0xfb, 0x2b, 0x87, 0xe5, 0x9d, 0xea, 0xfe, 0x2a, 0xf2, 0xed, 0x3b, 0xc5, 0xfd, 0x95, 0x43, 0xf2,
-	0xb4, 0xf7, 0x57, 0xd1, 0xac, 0x9e, 0xd6, 0xfe, 0x2a, 0x9a, 0xd5, 0x1e, 0xf6, 0xd7, 0x9f, 0xa5,
-	0xcf, 0x07, 0x29, 0x2f, 0xd6, 0x61, 0xc0, 0x6c, 0x77, 0x4a, 0x32, 0x29, 0x66, 0xc4, 0x54, 0x5b,
-	0xdf, 0xc4, 0x14, 0x07, 0xc2, 0x30, 0xcc, 0xf7, 0x4f, 0x49, 0x16, 0xc4, 0x0c, 0xd3, 0xf8, 0x96,
-	0xc4, 0x02, 0x13, 0x9d, 0x2a, 0xd2, 0xde, 0x21, 0x2d, 0xe2, 0x1b, 0x4e, 0x23, 0xf4, 0x7c, 0xa3,
-	0x59, 0x96, 0xdb, 0x70, 0x0d, 0x77, 0x0a, 0x17, 0xce, 0x60, 0xa7, 0x13, 0xd2, 0xb6, 0xad, 0x92,
-	0xfc, 0x85, 0x4d, 0xc8, 0x7a, 0x7d, 0x09, 0x53, 0x1c, 0xfa, 0x57, 0x46, 0x41, 0x89, 0x92, 0x8a,
-	0x3e, 0xa3, 0xc1, 0x8c, 0x99, 0x8e, 0x13, 0xd6, 0x8f, 0xbd, 0x4a, 0x26, 0xe8, 0x18, 0xdf, 0xf2,
-	0x99, 0x62, 0x9c, 0x25, 0x8b, 0xbe, 0x5b, 0xe3, 0x9a, 0x2a, 0xf9, 0xda, 0x22, 0xa6, 0xf5, 0xe6,
-	0x09, 0xbd, 0x4b, 0xc6, 0x2a, 0xaf, 0xf8, 0x09, 0x2c, 0x49, 0x10, 0x7d, 0x51, 0x83, 0x8b, 0x77,
-	0xf3, 0x14, 0xec, 0x62, 0xf2, 0xef, 0x94, 0xed, 0x4a, 0x81, 0xc6, 0x9e, 0x4b, 0x9c, 0xb9, 0x15,
-	0x70, 0x7e, 0x47, 0xe4, 0x2c, 0x49, 0x9d, 0xa3, 0xf8, 0x4e, 0x4b, 0xcf, 0x52, 0x4a, 0x79, 0x19,
-	0xcf, 0x92, 0x04, 0xe0, 0x24, 0x41, 0xd4, 0x86, 0xb1, 0xbb, 0x91, 0xa2, 0x57, 0x28, 0x77, 0x6a,
-	0x65, 0xa9, 0x2b, 0xda, 0x62, 0x6e, 0x8f, 0x23, 0x0b, 0x71, 0x4c, 0x04, 0xed, 0xc0, 0xc8, 0x5d,
-	0xce, 0x2b, 0x84, 0x52, 0x66, 0xa1, 0xef, 0x2b, 0x2c, 0xd7, 0x0d, 0x88, 0x22, 0x1c, 0xa1, 0x57,
-	0x4d, 0x95, 0x47, 0x8f, 0xf0, 0xa0, 0xf9, 0xbc, 0x06, 0x17, 0x77, 0x89, 0x1f, 0xda, 0x66, 0xfa,
-	0x79, 0x63, 0xac, 0xfc, 0x35, 0xfb, 0xa5, 0x3c, 0x84, 0x7c, 0x9b, 0xe4, 0x82, 0x70, 0x7e, 0x17,
-	0xe8, 0xa5, 0x9b, 0x6b, 0xa9, 0x1b, 0xa1, 0x11, 0xda, 0xe6, 0x86, 0x77, 0x97, 0xb8, 0x71, 0xaa,
-	0x35, 0xa6, 0x1e, 0x11, 0x11, 0x09, 0x97, 0x8b, 0xab, 0xe1, 0x6e, 0x38, 0xf4, 0x3f, 0xd6, 0x20,
-	0xa3, 0x6b, 0x45, 0x3f, 0xa2, 0xc1, 0xc4, 0x36, 0x31, 0xc2, 0x8e, 0x4f, 0x6e, 0x1a, 0xa1, 0x0c,
-	0x8c, 0xf0, 0xd2, 0x49, 0xa8, 0x78, 0xe7, 0x6f, 0x28, 0x88, 0xb9, 0x5d, 0x81, 0x0c, 0x82, 0xac,
-	0x82, 0x70, 0xa2, 0x07, 0x73, 0x2f, 0xc2, 0x4c, 0xa6, 0xe1, 0xb1, 0x9e, 0xdd, 0xfe, 0xb5, 0x06,
-	0x79, 0xd9, 0x01, 0xd1, 0x6b, 0x30, 0x64, 0x58, 0x96, 0x4c, 0xf7, 0xf3, 0x5c, 0x39, 0x13, 0x17,
-	0x4b, 0x8d, 0x3f, 0xc1, 0x7e, 0x62, 0x8e, 0x16, 0xdd, 0x00, 0x64, 0x24, 0x9e, 0x10, 0x57, 0x63,
-	0xaf, 0x6a, 0xf6, 0x3c, 0xb4, 0x90, 0x81, 0xe2, 0x9c, 0x16, 0xfa, 0x0f, 0x68, 0x80, 0xb2, 0x61,
-	0xb3, 0x91, 0x0f, 0xa3, 0x62, 0x2b, 0x47, 0xab, 0xb4, 0x54, 0xd2, 0x6f, 0x27, 0xe1, 0x84, 0x16,
-	0xdb, 0x4b, 0x89, 0x82, 0x00, 0x4b, 0x3a, 0xfa, 0x5f, 0x6a, 0x10, 0xa7, 0x04, 0x41, 0xef, 0x87,
-	0x71, 0x8b, 0x04, 0xa6, 0x6f, 0xb7, 0xc3, 0xd8, 0x65, 0x4d, 0xba, 0xbe, 0x2c, 0xc5, 0x20, 0xac,
-	0xd6, 0x43, 0x3a, 0x0c, 0x87, 0x46, 0x70, 0xb7, 0xbe, 0x24, 0xee, 0x7d, 0xec, 0x94, 0xde, 0x60,
-	0x25, 0x58, 0x40, 0xe2, 0xc8, 0x76, 0x03, 0x3d, 0x44, 0xb6, 0x43, 0xdb, 0x27, 0x10, 0xc6, 0x0f,
-	0x1d, 0x1d, 0xc2, 0x4f, 0xff, 0xe9, 0x0a, 0x9c, 0xa3, 0x55, 0x56, 0x0d, 0xdb, 0x0d, 0x89, 0xcb,
-	0x1c, 0x34, 0x4a, 0x4e, 0x42, 0x13, 0x26, 0xc3, 0x84, 0x07, 0xe3, 0xf1, 0xdd, 0xf7, 0xa4, 0x51,
-	0x4e, 0xd2, 0x6f, 0x31, 0x89, 0x17, 0x3d, 0x17, 0x79, 0xc8, 0xf0, 0x1b, 0xf2, 0x23, 0xd1, 0x56,
-	0x65, 0x6e, 0x2f, 0xf7, 0x85, 0x3b, 0xa8, 0xcc, 0x23, 0x93, 0x70, 0x86, 0x79, 0x16, 0x26, 0x85,
-	0x2d, 0x36, 0x0f, 0x51, 0x28, 0x6e, 0xc8, 0xec, 0x84, 0xb9, 0xa1, 0x02, 0x70, 0xb2, 0x9e, 0xfe,
-	0xbb, 0x15, 0x48, 0x66, 0xab, 0x29, 0x3b, 0x4b, 0xd9, 0xf8, 0x8c, 0x95, 0x53, 0x8b, 0xcf, 0xf8,
-	0x5e, 0x96, 0xea, 0x8d, 0xe7, 0x04, 0xe5, 0xef, 0xc6, 0x6a, 0x82, 0x36, 0x9e, 0xd1, 0x53, 0xd6,
-	0x88, 0xa7, 0x75, 0xf0, 0xd8, 0xd3, 0xfa, 0x7e, 0x61, 0xa4, 0x39, 0x94, 0x88, 0x92, 0x19, 0x19,
-	0x69, 0xce, 0x24, 0x1a, 0x2a, 0xfe, 0x3c, 0x6b, 0xf0, 0xee, 0x15, 0xcf, 0xb0, 0x16, 0x0d, 0x87,
-	0xee, 0x3b, 0x5f, 0x98, 0x3f, 0x05, 0xec, 0x84, 0x5d, 0xf7, 0xbd, 0xd0, 0x33, 0x3d, 0x87, 0x9e,
-	0x7f, 0x86, 0xe3, 0x78, 0xf7, 0xb2, 0x79, 0x5a, 0x17, 0x78, 0x31, 0x8e, 0xe0, 0xfa, 0x57, 0x34,
-	0x18, 0x11, 0xb1, 0xe7, 0x7b, 0xf0, 0x3f, 0xdb, 0x86, 0x21, 0x76, 0xcb, 0xe9, 0x47, 0xba, 0x6c,
-	0xec, 0x78, 0x5e, 0x98, 0x88, 0xc0, 0xcf, 0x5c, 0x1a, 0x78, 0xb6, 0x1b, 0x8e, 0x9e, 0xd9, 0xfd,
-	0xf9, 0xe6, 0x8e, 0x1d, 0x12, 0x66, 0x84, 0x21, 0x76, 0x2d, 0xb7, 0xfb, 0x53, 0xca, 0x71, 0xa2,
-	0x96, 0xfe, 0x85, 0x41, 0xb8, 0x2a, 0x10, 0x67, 0x44, 0x2e, 0xc9, 0x30, 0xf7, 0xe1, 0xbc, 0xd8,
-	0x2b, 0x4b, 0xbe, 0x61, 0xcb, 0xf7, 0xfd, 0x72, 0xb7, 0x5d, 0x91, 0x47, 0x37, 0x83, 0x0e, 0xe7,
-	0xd1, 0xe0, 0x91, 0x5d, 0x59, 0xf1, 0x2d, 0x62, 0x38, 0xe1, 0x4e, 0x44, 0xbb, 0xd2, 0x4f, 0x64,
-	0xd7, 0x2c, 0x3e, 0x9c, 0x4b, 0x85, 0xd9, 0x17, 0x08, 0x40, 0xcd, 0x27, 0x86, 0x6a, 0xdc, 0xd0,
-	0x87, 0x57, 0xc2, 0x6a, 0x2e, 0x46, 0x5c, 0x40, 0x89, 0xa9, 0x0d, 0x8d, 0x3d, 0xa6, 0x85, 0xc0,
-	0x24, 0xf4, 0x6d, 0x96, 0x49, 0x41, 0x2a, 0xce, 0x57, 0x93, 0x20, 0x9c, 0xae, 0x8b, 0xae, 0xc3,
-	0x14, 0xb3, 0xd7, 0x88, 0x23, 0xbc, 0x0d, 0xc5, 0x41, 0x44, 0xd6, 0x12, 0x10, 0x9c, 0xaa, 0xa9,
-	0x7f, 0xb2, 0x02, 0x13, 0xc7, 0xcc, 0x5c, 0xd4, 0x51, 0x0e, 0xd7, 0x3e, 0x5c, 0x81, 0x54, 0xaa,
-	0x3d, 0x9c, 0xaf, 0xe8, 0x15, 0x98, 0xea, 0x30, 0x8e, 0x14, 0x45, 0xa9, 0x11, 0xfb, 0xff, 0x9b,
-	0xe9, 0x28, 0x37, 0x13, 0x90, 0xfb, 0x07, 0xd5, 0x39, 0x15, 0x7d, 0x12, 0x8a, 0x53, 0x78, 0xf4,
-	0xcf, 0x0e, 0xc0, 0xf9, 0x9c, 0xde, 0xb0, 0x77, 0x7d, 0x92, 0x12, 0x01, 0xfa, 0x79, 0xd7, 0xcf,
-	0x88, 0x13, 0xf2, 0x5d, 0x3f, 0x0d, 0xc1, 0x19, 0xba, 0xe8, 0x25, 0x18, 0x30, 0x7d, 0x5b, 0x4c,
-	0xf8, 0xb3, 0xa5, 0x2e, 0xb0, 0xb8, 0xbe, 0x38, 0x2e, 0x28, 0x0e, 0xd4, 0x70, 0x1d, 0x53, 0x84,
-	0xf4, 0x20, 0x53, 0xd9, 0x45, 0x24, 0x55, 0xb0, 0x83, 0x4c, 0xe5, 0x2a, 0x01, 0x4e, 0xd6, 0x43,
-	0xaf, 0xc0, 0xac, 0xb8, 0x59, 0x44, 0x8e, 0xed, 0x9e, 0x1b, 0x84, 0xf4, 0xcb, 0x0e, 0x05, 0xe3,
-	0x67, 0xd6, 0x64, 0xb7, 0x0b, 0xea, 0xe0, 0xc2, 0xd6, 0xfa, 0x9f, 0x0e, 0x80, 0x9a, 0x70, 0x0b,
-	0xad, 0xf6, 0xa3, 0x35, 0x89, 0x47, 0x1c, 0x69, 0x4e, 0x56, 0x61, 0xa0, 0xd9, 0xee, 0x94, 0x54,
-	0x9b, 0x48, 0x74, 0x37, 0x29, 0xba, 0x66, 0xbb, 0x83, 0x5e, 0x92, 0x8a, 0x98, 0x72, 0xaa, 0x12,
-	0xe9, 0x68, 0x93, 0x52, 0xc6, 0x44, 0x1f, 0xe2, 0x60, 0xe1, 0x87, 0xd8, 0x82, 0x91, 0x40, 0x68,
-	0x69, 0x86, 0xca, 0x07, 0x63, 0x52, 0x66, 0x5a, 0x68, 0x65, 0xf8, 0xfd, 0x31, 0x52, 0xda, 0x44,
-	0x34, 0xa8, 0x6c, 0xda, 0x61, 0xce, 0xcd, 0xec, 0x62, 0x3c, 0xca, 0x65, 0xd3, 0x4d, 0x56, 0x82,
-	0x05, 0x24, 0x73, 0x44, 0x8d, 0xf4, 0x74, 0x44, 0x7d, 0x7f, 0x05, 0x50, 0xb6, 0x1b, 0xe8, 0x11,
-	0x18, 0x62, 0xc1, 0x11, 0x04, 0x2f, 0x92, 0x37, 0x09, 0xe6, 0x1e, 0x8f, 0x39, 0x0c, 0x35, 0x44,
-	0x68, 0x99, 0x72, 0xcb, 0xc9, 0x0c, 0x63, 0x04, 0x3d, 0x25, 0x0e, 0xcd, 0xd5, 0x84, 0xaf, 0x48,
-	0xde, 0x99, 0xbf, 0x09, 0x23, 0x2d, 0xdb, 0x65, 0x6f, 0x85, 0xe5, 0x94, 0x57, 0xfc, 0xfd, 0x9e,
-	0xa3, 0xc0, 0x11, 0x2e, 0xfd, 0x0f, 0x2a, 0x74, 0xeb, 0xc7, 0x12, 0xf4, 0x3e, 0x80, 0xd1, 0x09,
-	0x3d, 0xce, 0xc0, 0xc4, 0x17, 0x50, 0x2f, 0xb7, 0xca, 0x12, 0xe9, 0x82, 0x44, 0xc8, 0x5f, 0xb9,
-	0xe2, 0xdf, 0x58, 0x21, 0x46, 0x49, 0x87, 0x76, 0x8b, 0xbc, 0x6c, 0xbb, 0x96, 0x77, 0x4f, 0x4c,
-	0x6f, 0xbf, 0xa4, 0x37, 0x24, 0x42, 0x4e, 0x3a, 0xfe, 0x8d, 0x15, 0x62, 0x94, 0xb5, 0xb0, 0x8b,
-	0xb8, 0xcb, 0x52, 0x31, 0x89, 0xbe, 0x79, 0x8e, 0x13, 0x9d, 0xca, 0xa3, 0x9c, 0xb5, 0xd4, 0x0a,
-	0xea, 0xe0, 0xc2, 0xd6, 0xfa, 0xcf, 0x69, 0x70, 0x31, 0x77, 0x2a, 0xd0, 0x4d, 0x98, 0x89, 0x6d,
-	0xa9, 0x54, 0x66, 0x3f, 0x1a, 0xe7, 0x17, 0xbb, 0x9d, 0xae, 0x80, 0xb3, 0x6d, 0x50, 0x5d, 0x8a,
-	0x52, 0xea, 0x61, 0x22, 0x0c, 0xb1, 0x54, 0xd1, 0x48, 0x05, 0xe3, 0xbc, 0x36, 0xfa, 0xb7, 0x27,
-	0x3a, 0x1b, 0x4f, 0x16, 0xfd, 0x32, 0xb6, 0x48, 0x53, 0xfa, 0xea, 0xc9, 0x2f, 0x63, 0x91, 0x16,
-	0x62, 0x0e, 0x43, 0x0f, 0xab, 0x1e, 0xb0, 0x92, 0x6f, 0x45, 0x5e, 0xb0, 0xfa, 0x77, 0xc2, 0xe5,
-	0x82, 0xc7, 0x4f, 0xb4, 0x04, 0x13, 0xc1, 0x3d, 0xa3, 0xbd, 0x48, 0x76, 0x8c, 0x5d, 0x5b, 0xc4,
-	0x9b, 0xe0, 0x36, 0x72, 0x13, 0x0d, 0xa5, 0xfc, 0x7e, 0xea, 0x37, 0x4e, 0xb4, 0xd2, 0x43, 0x00,
-	0x61, 0x4b, 0x69, 0xbb, 0x4d, 0xb4, 0x0d, 0xa3, 0x86, 0xc8, 0x70, 0x2f, 0xf6, 0xf1, 0xb7, 0x96,
-	0x52, 0x2a, 0x08, 0x1c, 0xdc, 0x2c, 0x3e, 0xfa, 0x85, 0x25, 0x6e, 0xfd, 0x9f, 0x6a, 0x70, 0x29,
-	0x3f, 0xc2, 0x40, 0x0f, 0xa2, 0x4d, 0x0b, 0xc6, 0xfd, 0xb8, 0x99, 0xd8, 0xf4, 0x1f, 0x50, 0x83,
-	0xf4, 0x2a, 0x51, 0xe9, 0xa8, 0xd8, 0x57, 0xf3, 0xbd, 0x20, 0x5a, 0xf9, 0x74, 0xdc, 0x5e, 0x79,
-	0x85, 0x53, 0x7a, 0x82, 0x55, 0xfc, 0x2c, 0x86, 0x36, 0xa5, 0x1e, 0xb4, 0x0d, 0x93, 0x58, 0x67,
-	0x9c, 0x94, 0xee, 0x04, 0x02, 0xd7, 0xe6, 0xf7, 0xfd, 0x74, 0x63, 0x68, 0x17, 0xd0, 0x3c, 0x3a,
-	0x86, 0x76, 0x7e, 0xc3, 0x77, 0x48, 0x70, 0xd7, 0xfc, 0xce, 0x17, 0x38, 0xd4, 0xfd, 0xa3, 0xa1,
-	0xa2, 0xd1, 0x1e, 0x33, 0xb3, 0xdd, 0xee, 0x29, 0x66, 0xb6, 0x9b, 0xfa, 0xdb, 0xac, 0x76, 0x39,
-	0x59, 0xed, 0x52, 0x99, 0xd6, 0x86, 0xcf, 0x28, 0xd3, 0xda, 0x1b, 0x30, 0xdc, 0x36, 0x7c, 0xe2,
-	0x46, 0x6f, 0x10, 0xf5, 0x7e, 0xd3, 0x38, 0xc6, 0x5c, 0x50, 0x7e, 0x92, 0xeb, 0x8c, 0x00, 0x16,
-	0x84, 0xf4, 0x3f, 0xd7, 0xe0, 0xa1, 0x6e, 0xdf, 0x32, 0xbb, 0x7d, 0x99, 0xa9, 0xbd, 0xdb, 0xcf,
-	0xed, 0x2b, 0xc3, 0xa2, 0xe4, 0xed, 0x2b, 0x0d, 0xc1, 0x19, 0xba, 0x05, 0x69, 0x9d, 0x2b, 0x65,
-	0xd2, 0x3a, 0xeb, 0xbf, 0x54, 0x01, 0x58, 0x23, 0xe1, 0x3d, 0xcf, 0xbf, 0x4b, 0x0f, 0xc6, 0x87,
-	0x12, 0xfa, 0xa5, 0xd1, 0xaf, 0x5f, 0x6c, 0xa3, 0x87, 0x60, 0xb0, 0xed, 0x59, 0x81, 0x10, 0x7a,
-	0x59, 0x47, 0x98, 0x71, 0x29, 0x2b, 0x45, 0x55, 0x18, 0x62, 0x2f, 0xdc, 0xe2, 0x3e, 0xc2, 0xb4,
-	0x53, 0x6b, 0xb4, 0x00, 0xf3, 0x72, 0x9e, 0xad, 0x9a, 0xeb, 0xdd, 0x84, 0xfa, 0x4e, 0x64, 0xab,
-	0xe6, 0x65, 0x58, 0x42, 0xd1, 0x75, 0x00, 0xbb, 0x7d, 0xc3, 0x68, 0xd9, 0x8e, 0x2d, 0xf6, 0xf8,
-	0x18, 0x53, 0x9b, 0x40, 0x7d, 0x3d, 0x2a, 0xbd, 0x7f, 0x50, 0x1d, 0x15, 0xbf, 0xf6, 0xb1, 0x52,
-	0x5b, 0x7f, 0x0b, 0xa6, 0xe3, 0xb9, 0x13, 0x3b, 0x25, 0xea, 0x38, 0x8f, 0x2b, 0x57, 0xd8, 0x71,
-	0x1e, 0x4a, 0xb4, 0x7b, 0xc7, 0xf9, 0xe5, 0xb7, 0xa0, 0xe3, 0xfa, 0x5f, 0x0d, 0xc0, 0xc4, 0x5a,
-	0xd3, 0x76, 0xf7, 0xa2, 0xa0, 0x09, 0xf2, 0x99, 0x44, 0x3b, 0x9d, 0x67, 0x92, 0x57, 0x60, 0xd6,
-	0x51, 0xf5, 0x9a, 0x5c, 0x72, 0x30, 0xdc, 0xa6, 0x1c, 0x0e, 0x13, 0x84, 0x57, 0x0a, 0xea, 0xe0,
-	0xc2, 0xd6, 0x28, 0x84, 0x61, 0x33, 0xca, 0x87, 0x52, 0x3a, 0x10, 0x80, 0x3a, 0x17, 0xf3, 0xaa,
-	0x4f, 0xac, 0xfc, 0xe8, 0xc5, 0x56, 0x13, 0xb4, 0xd0, 0xa7, 0x34, 0xb8, 0x48, 0xf6, 0xb8, 0x4f,
-	0xf8, 0x86, 0x6f, 0x6c, 0x6f, 0xdb, 0xa6, 0xf0, 0x37, 0xe0, 0xbb, 0x6a, 0xe5, 0xf0, 0xa0, 0x7a,
-	0x71, 0x39, 0xaf, 0xc2, 0xfd, 0x83, 0xea, 0xb5, 0x5c, 0x17, 0x7d, 0xb6, 0x34, 0xb9, 0x4d, 0x70,
-	0x3e, 0xa9, 0xb9, 0xe7, 0x60, 0xfc, 0x18, 0x5e, 0x6a, 0x09, 0x47, 0xfc, 0x5f, 0xae, 0xc0, 0x04,
-	0xdd, 0x3b, 0x2b, 0x9e, 0x69, 0x38, 0x4b, 0x6b, 0x0d, 0xf4, 0x44, 0x3a, 0x7c, 0x8e, 0xd4, 0x29,
-	0x67, 0x42, 0xe8, 0xac, 0xc0, 0x85, 0x6d, 0xcf, 0x37, 0xc9, 0x46, 0x6d, 0x7d, 0xc3, 0x13, 0x56,
-	0x03, 0x4b, 0x6b, 0x0d, 0x71, 0x31, 0x60, 0x7a, 0xcb, 0x1b, 0x39, 0x70, 0x9c, 0xdb, 0x0a, 0xdd,
-	0x81, 0x8b, 0x71, 0xf9, 0x66, 0x9b, 0x9b, 0x4b, 0x52, 0x74, 0x03, 0xb1, 0xb9, 0xe7, 0x8d, 0xbc,
-	0x0a, 0x38, 0xbf, 0x1d, 0x32, 0xe0, 0x41, 0x11, 0xbb, 0xec, 0x86, 0xe7, 0xdf, 0x33, 0x7c, 0x2b,
-	0x89, 0x76, 0x30, 0x7e, 0x55, 0x5d, 0x2a, 0xae, 0x86, 0xbb, 0xe1, 0xd0, 0xdf, 0xd6, 0x20, 0x19,
-	0x9c, 0x08, 0x3d, 0x00, 0x03, 0xbe, 0x48, 0xe1, 0x21, 0x82, 0xf4, 0x50, 0x19, 0x99, 0x96, 0xa1,
-	0x79, 0x00, 0x3f, 0x8e, 0x90, 0x54, 0x89, 0xe3, 0xe6, 0x2a, 0xb1, 0x8d, 0x94, 0x1a, 0x14, 0x55,
-	0x68, 0x34, 0x05, 0xf3, 0x62, 0xa8, 0x36, 0x8c, 0x26, 0xa6, 0x65, 0x2c, 0x40, 0xb2, 0xdd, 0x24,
-	0x41, 0xa4, 0x97, 0xe2, 0x01, 0x92, 0x59, 0x09, 0x16, 0x10, 0xfd, 0xc7, 0x86, 0x41, 0x71, 0x2a,
-	0x3f, 0x86, 0x8c, 0xf4, 0x53, 0x1a, 0x5c, 0x30, 0x1d, 0x9b, 0xb8, 0x61, 0xca, 0x3f, 0x93, 0xf3,
-	0xe9, 0xcd, 0x52, 0xde, 0xee, 0x6d, 0xe2, 0xd6, 0x97, 0x84, 0xe5, 0x6b, 0x2d, 0x07, 0xb9, 0xb0,
-	0x0e, 0xce, 0x81, 0xe0, 0xdc, 0xce, 0xb0, 0xf1, 0xb0, 0xf2, 0xfa, 0x92, 0x1a, 0xf2, 0xa8, 0x26,
-	0xca, 0xb0, 0x84, 0xa2, 0xa7, 0x60, 0xbc, 0xe9, 0x7b, 0x9d, 0x76, 0x50, 0x63, 0x0e, 0x2e, 0x7c,
-	0xc6, 0x98, 0x9a, 0xe4, 0x66, 0x5c, 0x8c, 0xd5, 0x3a, 0xe8, 0x19, 0x98, 0xe0, 0x3f, 0xd7, 0x7d,
-	0xb2, 0x6d, 0xef, 0x09, 0xee, 0xcf, 0x94, 0x3e, 0x37, 0x95, 0x72, 0x9c, 0xa8, 0xc5, 0xa2, 0x96,
-	0x04, 0x41, 0x87, 0xf8, 0x9b, 0x78, 0x45, 0x64, 0xf3, 0xe2, 0x51, 0x4b, 0xa2, 0x42, 0x1c, 0xc3,
-	0xd1, 0x8f, 0x6a, 0x30, 0xe5, 0x93, 0x37, 0x3a, 0xb6, 0x4f, 0x65, 0x05, 0xc3, 0x6e, 0x05, 0xc2,
-	0xb3, 0x1f, 0xf7, 0x17, 0x4d, 0x60, 0x1e, 0x27, 0x90, 0x72, 0xee, 0x25, 0x5f, 0xc5, 0x92, 0x40,
-	0x9c, 0xea, 0x01, 0x9d, 0xaa, 0xc0, 0x6e, 0xba, 0xb6, 0xdb, 0x5c, 0x70, 0x9a, 0xc1, 0xec, 0x28,
-	0x63, 0xc8, 0x5c, 0xa3, 0x14, 0x17, 0x63, 0xb5, 0x0e, 0x7a, 0x16, 0x26, 0x3b, 0x01, 0xe5, 0x49,
-	0x2d, 0xc2, 0xe7, 0x77, 0x2c, 0x7e, 0x36, 0xdc, 0x54, 0x01, 0x38, 0x59, 0x0f, 0x5d, 0x87, 0xa9,
-	0xa8, 0x40, 0xcc, 0x32, 0xf0, 0x58, 0xca, 0x4c, 0xfb, 0x9d, 0x80, 0xe0, 0x54, 0xcd, 0xb9, 0x05,
-	0x38, 0x9f, 0x33, 0xcc, 0x63, 0x31, 0xbe, 0xbf, 0xd6, 0xe0, 0x22, 0x17, 0x6f, 0xa2, 0x3c, 0x60,
-	0x51, 0xcc, 0xe0, 0xfc, 0xf0, 0xbb, 0xda, 0xa9, 0x86, 0xdf, 0xfd, 0x3a, 0x84, 0x19, 0xd6, 0xff,
-	0x71, 0x05, 0xde, 0x7d, 0xe4, 0x77, 0x89, 0x7e, 0x5c, 0x83, 0x71, 0xb2, 0x17, 0xfa, 0x86, 0xf4,
-	0x02, 0xa4, 0x9b, 0x74, 0xfb, 0x54, 0x98, 0xc0, 0xfc, 0x72, 0x4c, 0x88, 0x6f, 0x5c, 0x29, 0xe8,
-	0x2b, 0x10, 0xac, 0xf6, 0x87, 0xb2, 0x42, 0x1e, 0x6b, 0x5c, 0xb5, 0x2f, 0xe0, 0xd1, 0x59, 0xb0,
-	0x80, 0xcc, 0x7d, 0x08, 0xa6, 0xd3, 0x98, 0x8f, 0xb5, 0x57, 0x7e, 0xb1, 0x02, 0x23, 0xeb, 0xbe,
-	0xf7, 0x3a, 0x31, 0xcf, 0x22, 0xf8, 0x91, 0x91, 0x50, 0x63, 0x94, 0xba, 0xa4, 0x89, 0xce, 0x16,
-	0xea, 0x2d, 0xec, 0x94, 0xde, 0x62, 0xa1, 0x1f, 0x22, 0xdd, 0x15, 0x15, 0xbf, 0xa5, 0xc1, 0xb8,
-	0xa8, 0x79, 0x06, 0x9a, 0x89, 0xef, 0x4a, 0x6a, 0x26, 0x9e, 0xef, 0x63, 0x5c, 0x05, 0xaa, 0x88,
-	0xcf, 0x6b, 0x30, 0x29, 0x6a, 0xac, 0x92, 0xd6, 0x16, 0xf1, 0xd1, 0x0d, 0x18, 0x09, 0x3a, 0x6c,
-	0x21, 0xc5, 0x80, 0x1e, 0x54, 0xd5, 0x6b, 0xfe, 0x96, 0x61, 0xd2, 0xee, 0x37, 0x78, 0x15, 0x25,
-	0xa3, 0x16, 0x2f, 0xc0, 0x51, 0x63, 0x74, 0x15, 0x06, 0x7d, 0xcf, 0xc9, 0x84, 0xc4, 0xc4, 0x9e,
-	0x43, 0x30, 0x83, 0x50, 0xc1, 0x9f, 0xfe, 0x8d, 0x84, 0x7a, 0x26, 0xf8, 0x53, 0x70, 0x80, 0x79,
-	0xb9, 0xfe, 0xa5, 0x21, 0x39, 0xd9, 0xec, 0x92, 0x77, 0x0b, 0xc6, 0x4c, 0x9f, 0x18, 0x21, 0xb1,
-	0x16, 0xf7, 0x7b, 0xe9, 0x1c, 0x3b, 0xae, 0x6a, 0x51, 0x0b, 0x1c, 0x37, 0xa6, 0x27, 0x83, 0x6a,
-	0xd2, 0x51, 0x89, 0x0f, 0xd1, 0x42, 0x73, 0x8e, 0x6f, 0x85, 0x21, 0xef, 0x9e, 0x2b, 0x2d, 0x43,
-	0xbb, 0x12, 0x66, 0x43, 0xb9, 0x43, 0x6b, 0x63, 0xde, 0x48, 0x0d, 0x09, 0x3b, 0xd8, 0x25, 0x24,
-	0xac, 0x03, 0x23, 0x2d, 0xb6, 0x0c, 0x7d, 0x25, 0x58, 0x4a, 0x2c, 0xa8, 0x9a, 0x82, 0x93, 0x61,
-	0xc6, 0x11, 0x09, 0x7a, 0xc2, 0xbb, 0xd1, 0x0d, 0x5f, 0x3d, 0xe1, 0xe5, 0xb5, 0x1f, 0xc7, 0x70,
-	0xb4, 0x9f, 0x8c, 0x35, 0x3c, 0x52, 0x5e, 0xd9, 0x24, 0xba, 0xa7, 0x84, 0x17, 0xe6, 0x53, 0x5f,
-	0x14, 0x6f, 0x18, 0xfd, 0x8c, 0x06, 0x97, 0xad, 0xfc, 0xac, 0x00, 0xec, 0x50, 0x2f, 0xe9, 0x5a,
-	0x54, 0x90, 0x68, 0x60, 0xb1, 0x2a, 0x26, 0xac, 0x28, 0x13, 0x01, 0x2e, 0xea, 0x8c, 0xfe, 0x83,
-	0x83, 0xf2, 0x6b, 0x12, 0x57, 0xdf, 0x7c, 0xbd, 0x84, 0x56, 0x46, 0x2f, 0x81, 0xbe, 0x25, 0x8a,
-	0xfe, 0x5f, 0x49, 0xe4, 0xb5, 0x95, 0xd1, 0xff, 0x27, 0x04, 0xe9, 0x44, 0xc4, 0xff, 0x0e, 0x9c,
-	0x0f, 0x42, 0xc3, 0x21, 0x0d, 0x5b, 0xbc, 0x50, 0x04, 0xa1, 0xd1, 0x6a, 0x97, 0x08, 0xbf, 0xcf,
-	0x5d, 0x0d, 0xb3, 0xa8, 0x70, 0x1e, 0x7e, 0xf4, 0x3d, 0x2c, 0x32, 0x8a, 0xe1, 0xb0, 0x17, 0x1c,
-	0x9e, 0x28, 0x27, 0x26, 0x7e, 0x7c, 0x03, 0x37, 0x11, 0xf7, 0x24, 0x1f, 0x1f, 0x2e, 0xa4, 0x84,
-	0xde, 0x82, 0x8b, 0x54, 0x54, 0x58, 0x30, 0x43, 0x7b, 0xd7, 0x0e, 0xf7, 0xe3, 0x2e, 0x1c, 0x3f,
-	0xe6, 0x3e, 0xbb, 0xb1, 0xad, 0xe4, 0x21, 0xc3, 0xf9, 0x34, 0xf4, 0x3f, 0xd3, 0x00, 0x65, 0xf7,
-	0x3a, 0x72, 0x60, 0xd4, 0x8a, 0x7c, 0xff, 0xb4, 0x13, 0x89, 0xd8, 0x2d, 0x8f, 0x10, 0xe9, 0x32,
-	0x28, 0x29, 0x20, 0x0f, 0xc6, 0xee, 0xed, 0xd8, 0x21, 0x71, 0xec, 0x20, 0x3c, 0xa1, 0x00, 0xe1,
-	0x32, 0x1e, 0xec, 0xcb, 0x11, 0x62, 0x1c, 0xd3, 0xd0, 0x7f, 0x68, 0x10, 0x46, 0x65, 0xc6, 0x97,
-	0xa3, 0x6d, 0xb3, 0x3a, 0x80, 0x4c, 0x25, 0x6b, 0x6e, 0x3f, 0x3a, 0x34, 0x26, 0x2d, 0xd6, 0x32,
-	0xc8, 0x70, 0x0e, 0x01, 0xf4, 0x16, 0x5c, 0xb0, 0xdd, 0x6d, 0xdf, 0x90, 0x11, 0x73, 0xfa, 0x49,
-	0x3e, 0xcb, 0x2e, 0x7b, 0xf5, 0x1c, 0x74, 0x38, 0x97, 0x08, 0x22, 0x30, 0xc2, 0x13, 0x5b, 0x45,
-	0xaa, 0xeb, 0xeb, 0xa5, 0x22, 0x79, 0x31, 0x14, 0x31, 0x7b, 0xe7, 0xbf, 0x03, 0x1c, 0xe1, 0xe6,
-	0x91, 0xc3, 0xf8, 0xff, 0x91, 0x56, 0x5f, 0xec, 0xfb, 0x5a, 0x79, 0x7a, 0xf1, 0x03, 0x01, 0x8f,
-	0x1c, 0x96, 0x2c, 0xc4, 0x69, 0x82, 0xfa, 0x6f, 0x68, 0x30, 0xc4, 0xa3, 0x58, 0x9c, 0xbe, 0xa8,
-	0xf9, 0x9d, 0x09, 0x51, 0xb3, 0x54, 0xfe, 0x4c, 0xd6, 0xd5, 0xc2, 0xcc, 0x8e, 0x5f, 0xd1, 0x60,
-	0x8c, 0xd5, 0x38, 0x03, 0xd9, 0xef, 0xb5, 0xa4, 0xec, 0xf7, 0x5c, 0xe9, 0xd1, 0x14, 0x48, 0x7e,
-	0xbf, 0x31, 0x20, 0xc6, 0xc2, 0x44, 0xab, 0x3a, 0x9c, 0x17, 0x5e, 0x31, 0x2b, 0xf6, 0x36, 0xa1,
-	0x5b, 0x7c, 0xc9, 0xd8, 0xe7, 0x86, 0x1d, 0x43, 0xc2, 0x6d, 0x3a, 0x0b, 0xc6, 0x79, 0x6d, 0xd0,
-	0x2f, 0x6b, 0x54, 0x88, 0x09, 0x7d, 0xdb, 0xec, 0xeb, 0x45, 0x4d, 0xf6, 0x6d, 0x7e, 0x95, 0x23,
-	0xe3, 0x57, 0xa8, 0xcd, 0x58, 0x9a, 0x61, 0xa5, 0xf7, 0x0f, 0xaa, 0xd5, 0x1c, 0xbd, 0x63, 0x9c,
-	0x3a, 0x2d, 0x08, 0x3f, 0xf5, 0x87, 0x5d, 0xab, 0xb0, 0xe7, 0xe5, 0xa8, 0xc7, 0xe8, 0x16, 0x0c,
-	0x05, 0xa6, 0xd7, 0x26, 0xc7, 0x49, 0x00, 0x2b, 0x27, 0xb8, 0x41, 0x5b, 0x62, 0x8e, 0x60, 0xee,
-	0x75, 0x98, 0x50, 0x7b, 0x9e, 0x73, 0x45, 0x5b, 0x52, 0xaf, 0x68, 0xc7, 0xb6, 0x50, 0x51, 0xaf,
-	0x74, 0xbf, 0x52, 0x81, 0x61, 0x4c, 0x9a, 0x22, 0x1d, 0xc3, 0x11, 0x8f, 0xe8, 0x76, 0x94, 0xa3,
-	0xaa, 0x52, 0xde, 0xf2, 0x5e, 0x0d, 0xb8, 0xfd, 0xaa, 0xe7, 0x2a, 0x73, 0xa0, 0xa6, 0xa9, 0x42,
-	0xae, 0x0c, 0x52, 0x3f, 0x50, 0x3e, 0x49, 0x25, 0x1f, 0xd8, 0x69, 0x87, 0xa5, 0xff, 0x6d, 0x0d,
-	0x26, 0x12, 0x51, 0xff, 0x5b, 0xb1, 0xee, 0xb3, 0xbc, 0x8d, 0x41, 0x64, 0x5b, 0xfd, 0x60, 0x97,
-	0x4a, 0x5c, 0x9f, 0x7a, 0x47, 0xc6, 0xfd, 0x3d, 0x99, 0x04, 0x01, 0xfa, 0xe7, 0x34, 0xb8, 0x14,
-	0x0d, 0x28, 0x19, 0xe0, 0x11, 0x3d, 0x0e, 0xa3, 0x46, 0xdb, 0x66, 0xba, 0x3f, 0x55, 0x7b, 0xba,
-	0xb0, 0x5e, 0x67, 0x65, 0x58, 0x42, 0x13, 0x49, 0xb7, 0x2a, 0x47, 0x26, 0xdd, 0x7a, 0x4c, 0x49,
-	0x23, 0x36, 0x14, 0xcb, 0x09, 0x92, 0x30, 0xb7, 0xde, 0xd2, 0x3f, 0x00, 0x63, 0x8d, 0xc6, 0xad,
-	0x05, 0xd3, 0x24, 0x41, 0x70, 0x0c, 0x0d, 0xbd, 0xfe, 0xe9, 0x01, 0x98, 0x14, 0x91, 0x6a, 0x6d,
-	0xd7, 0xb2, 0xdd, 0xe6, 0x19, 0x9c, 0x29, 0x1b, 0x30, 0xc6, 0xd5, 0x2e, 0x47, 0xa4, 0x9a, 0x6e,
-	0x44, 0x95, 0xd2, 0xd9, 0x32, 0x24, 0x00, 0xc7, 0x88, 0xd0, 0x6d, 0x18, 0x7e, 0x83, 0xf2, 0xb7,
-	0xe8, 0xbb, 0xe8, 0x89, 0xcd, 0xc8, 0x4d, 0xcf, 0x58, 0x63, 0x80, 0x05, 0x0a, 0x14, 0x30, 0xe3,
-	0x7f, 0x26, 0x70, 0xf5, 0x13, 0xd8, 0x29, 0x31, 0xb3, 0x32, 0x89, 0xe0, 0x84, 0xf0, 0x21, 0x60,
-	0xbf, 0xb0, 0x24, 0xc4, 0x52, 0xfd, 0x24, 0x5a, 0xbc, 0x43, 0x52, 0xfd, 0x24, 0xfa, 0x5c, 0x70,
-	0x34, 0x3e, 0x07, 0x17, 0x73, 0x27, 0xe3, 0x68, 0x71, 0x56, 0xff, 0x17, 0x15, 0x18, 0x6c, 0x10,
-	0x62, 0x9d, 0xc1, 0xce, 0x7c, 0x2d, 0x21, 0xed, 0x7c, 0x6b, 0xe9, 0x64, 0x43, 0x45, 0x5a, 0xb5,
-	0xed, 0x94, 0x56, 0xed, 0x43, 0xa5, 0x29, 0x74, 0x57, 0xa9, 0xfd, 0x44, 0x05, 0x80, 0x56, 0x5b,
-	0x34, 0xcc, 0xbb, 0x9c, 0xe3, 0xc8, 0xdd, 0x9c, 0x4a, 0xf3, 0x97, 0xdd, 0x86, 0x67, 0xf9, 0xfc,
-	0xae, 0xc3, 0xb0, 0xcf, 0x4e, 0x22, 0xf1, 0x40, 0xc3, 0x54, 0xb3, 0xfc, 0x6c, 0xc2, 0x02, 0x92,
-	0xe4, 0x16, 0x83, 0x27, 0xc4, 0x2d, 0xf4, 0x3d, 0x60, 0x09, 0xeb, 0x97, 0xd6, 0x1a, 0xa8, 0xa5,
-	0xcc, 0x4e, 0xa5, 0xbc, 0x2c, 0x2f, 0xd0, 0x1d, 0xf9, 0x95, 0x7f, 0x5a, 0x83, 0x73, 0xa9, 0xba,
-	0x3d, 0xdc, 0xe9, 0x4e, 0x85, 0x67, 0xea, 0xbf, 0xae, 0xc1, 0x28, 0xed, 0xcb, 0x19, 0x30, 0x9a,
-	0xff, 0x3f, 0xc9, 0x68, 0x3e, 0x58, 0x76, 0x8a, 0x0b, 0xf8, 0xcb, 0x9f, 0x54, 0x80, 0x65, 0xf5,
-	0x12, 0x86, 0x12, 0x8a, 0x09, 0x84, 0x56, 0x60, 0xbb, 0x71, 0x55, 0x58, 0x50, 0xa4, 0x94, 0xa9,
-	0x8a, 0x15, 0xc5, 0x7b, 0x13, 0x46, 0x12, 0x89, 0xcf, 0x26, 0xc7, 0xc2, 0xe3, 0x4d, 0x98, 0x0c,
-	0x76, 0x3c, 0x2f, 0x94, 0x41, 0x88, 0x06, 0xcb, 0x2b, 0xce, 0x99, 0x67, 0x54, 0x34, 0x14, 0xfe,
-	0x52, 0xd6, 0x50, 0x71, 0xe3, 0x24, 0x29, 0x34, 0x0f, 0xb0, 0xe5, 0x78, 0xe6, 0xdd, 0x5a, 0x7d,
-	0x09, 0x47, 0x9e, 0x30, 0xec, 0xe1, 0x78, 0x51, 0x96, 0x62, 0xa5, 0x46, 0x5f, 0xd6, 0x28, 0x7f,
-	0xa4, 0xf1, 0x99, 0x3e, 0xc6, 0xe6, 0x3d, 0x43, 0x8e, 0xf2, 0x9e, 0x14, 0x47, 0x91, 0x1c, 0x32,
-	0xc5, 0x55, 0xaa, 0x91, 0xc0, 0x3e, 0x18, 0x2b, 0xca, 0x13, 0xd9, 0x60, 0x7f, 0x51, 0x0c, 0x53,
-	0x26, 0x86, 0x6b, 0xc3, 0xa4, 0xa3, 0xe6, 0x31, 0x15, 0xdf, 0x48, 0xa9, 0x14, 0xa8, 0xd2, 0x26,
-	0x2f, 0x51, 0x8c, 0x93, 0x04, 0xd0, 0xb3, 0x30, 0x19, 0x8d, 0x8e, 0x9b, 0xc6, 0x55, 0x62, 0x37,
-	0x95, 0x75, 0x15, 0x80, 0x93, 0xf5, 0xf4, 0xb7, 0x2b, 0xf0, 0x30, 0xef, 0x3b, 0xd3, 0x18, 0x2c,
-	0x91, 0x36, 0x71, 0x2d, 0xe2, 0x9a, 0xfb, 0x4c, 0x66, 0xb5, 0xbc, 0x26, 0x7a, 0x0b, 0x86, 0xef,
-	0x11, 0x62, 0x49, 0xd5, 0xfb, 0xcb, 0xe5, 0xf3, 0xea, 0x15, 0x90, 0x78, 0x99, 0xa1, 0xe7, 0x1c,
-	0x9d, 0xff, 0x8f, 0x05, 0x49, 0x4a, 0xbc, 0xed, 0x7b, 0x5b, 0x52, 0xb4, 0x3a, 0x79, 0xe2, 0xeb,
-	0x0c, 0x3d, 0x27, 0xce, 0xff, 0xc7, 0x82, 0xa4, 0xbe, 0x0e, 0x8f, 0xf4, 0xd0, 0xf4, 0x38, 0x22,
-	0xf4, 0x51, 0x18, 0xf9, 0xe8, 0x8f, 0x83, 0xf1, 0xf7, 0x35, 0x78, 0x54, 0x41, 0xb9, 0xbc, 0x47,
-	0xa5, 0xfa, 0x9a, 0xd1, 0x36, 0x4c, 0x7a, 0x47, 0x65, 0x81, 0x55, 0x8e, 0x95, 0xc9, 0xea, 0xd3,
-	0x1a, 0x8c, 0x70, 0x6b, 0xa4, 0x88, 0xfd, 0xbe, 0xd6, 0xe7, 0x94, 0x17, 0x76, 0x29, 0x4a, 0x91,
-	0x10, 0x8d, 0x8d, 0xff, 0x0e, 0x70, 0x44, 0x5f, 0xff, 0xb7, 0x43, 0xf0, 0x4d, 0xbd, 0x23, 0x42,
-	0x7f, 0xa4, 0xa5, 0xb3, 0xa8, 0x8e, 0x3f, 0xdd, 0x3a, 0xdd, 0xce, 0x4b, 0x2d, 0x86, 0xb8, 0x18,
-	0xbf, 0x9c, 0x49, 0xd2, 0x77, 0x42, 0x0a, 0x92, 0x78, 0x60, 0xe8, 0x67, 0x35, 0x98, 0xa0, 0xc7,
-	0x52, 0x23, 0xce, 0xaf, 0x4c, 0x47, 0xda, 0x3e, 0xe5, 0x91, 0xae, 0x29, 0x24, 0x53, 0x11, 0x18,
-	0x54, 0x10, 0x4e, 0xf4, 0x0d, 0x6d, 0x26, 0x9f, 0xad, 0xf8, 0x75, 0xeb, 0x4a, 0x9e, 0x34, 0x72,
-	0x9c, 0x14, 0x98, 0x73, 0x0e, 0x4c, 0x25, 0x67, 0xfe, 0x34, 0xd5, 0x3b, 0x73, 0x2f, 0xc2, 0x4c,
-	0x66, 0xf4, 0xc7, 0x52, 0x6e, 0xfc, 0xfd, 0x21, 0xa8, 0x2a, 0x53, 0x9d, 0xe7, 0x8b, 0x8d, 0xbe,
-	0xa0, 0xc1, 0xb8, 0xe1, 0xba, 0xc2, 0x6e, 0x24, 0xda, 0xbf, 0x56, 0x9f, 0xab, 0x9a, 0x47, 0x6a,
-	0x7e, 0x21, 0x26, 0x93, 0x32, 0x8c, 0x50, 0x20, 0x58, 0xed, 0x4d, 0x17, 0xcb, 0xc4, 0xca, 0x99,
-	0x59, 0x26, 0xa2, 0x8f, 0x47, 0x07, 0x31, 0xdf, 0x46, 0xaf, 0x9c, 0xc2, 0xdc, 0xb0, 0x73, 0xbd,
-	0x40, 0x9b, 0xf6, 0xc3, 0x1a, 0x3b, 0x64, 0x63, 0x97, 0x79, 0x71, 0x26, 0x95, 0xb2, 0x61, 0x3b,
-	0xd2, 0x1f, 0x5f, 0x9e, 0xdd, 0x71, 0x11, 0x4e, 0x92, 0x9f, 0xfb, 0x10, 0x4c, 0xa7, 0x97, 0xf2,
-	0x58, 0xdb, 0xf2, 0xdf, 0x0c, 0x26, 0xce, 0x8e, 0xc2, 0xf9, 0xe8, 0x41, 0xa9, 0xf9, 0xc5, 0xd4,
-	0xee, 0xe5, 0x3c, 0xc9, 0x3e, 0xad, 0x15, 0x3a, 0xd9, 0x2d, 0x3c, 0x70, 0x76, 0x5b, 0xf8, 0xff,
-	0xb9, 0x3d, 0xb4, 0x08, 0x17, 0x95, 0x05, 0x53, 0x92, 0x32, 0x3f, 0x01, 0x23, 0xbb, 0x76, 0x60,
-	0x47, 0x41, 0x01, 0x15, 0x19, 0xe6, 0x25, 0x5e, 0x8c, 0x23, 0xb8, 0xbe, 0x92, 0xe0, 0x8e, 0x1b,
-	0x5e, 0xdb, 0x73, 0xbc, 0xe6, 0xfe, 0xc2, 0x3d, 0xc3, 0x27, 0xd8, 0xeb, 0x84, 0x02, 0x5b, 0xaf,
-	0x12, 0xd1, 0x2a, 0x5c, 0x55, 0xb0, 0xe5, 0x86, 0x4e, 0x3a, 0x0e, 0xba, 0xdf, 0x1a, 0x89, 0x84,
-	0x7b, 0x11, 0x0b, 0xe2, 0x17, 0x34, 0x78, 0x80, 0x14, 0x1d, 0x96, 0x42, 0xd2, 0x7f, 0xe5, 0xb4,
-	0x0e, 0x63, 0x11, 0xa6, 0xbd, 0x08, 0x8c, 0x8b, 0x7b, 0x86, 0xf6, 0x13, 0xa9, 0xc9, 0x2b, 0xfd,
-	0x68, 0x2a, 0x73, 0xd6, 0xbb, 0x5b, 0x62, 0x72, 0xf4, 0x93, 0x1a, 0x5c, 0x70, 0x72, 0x36, 0xab,
-	0xd8, 0xfc, 0x8d, 0x53, 0x60, 0x13, 0xfc, 0x55, 0x38, 0x0f, 0x82, 0x73, 0xbb, 0x82, 0x7e, 0xba,
-	0x30, 0xa6, 0x17, 0x7f, 0xb4, 0xdd, 0xe8, 0xb3, 0x93, 0x27, 0x15, 0xde, 0xeb, 0x6d, 0x0d, 0x90,
-	0x95, 0xb9, 0x38, 0x08, 0x83, 0xa0, 0x8f, 0x9e, 0xf8, 0xf5, 0x88, 0x3f, 0xeb, 0x67, 0xcb, 0x71,
-	0x4e, 0x27, 0xd8, 0x3a, 0x87, 0x39, 0x9f, 0xaf, 0x88, 0x60, 0xdf, 0xef, 0x3a, 0xe7, 0x71, 0x06,
-	0xbe, 0xce, 0x79, 0x10, 0x9c, 0xdb, 0x15, 0xfd, 0xd7, 0x86, 0xb9, 0x1e, 0x8b, 0xbd, 0xbb, 0x6e,
-	0xc1, 0xf0, 0x16, 0xd3, 0x7b, 0x8a, 0xef, 0xb6, 0xb4, 0x92, 0x95, 0x6b, 0x4f, 0xf9, 0x2d, 0x92,
-	0xff, 0x8f, 0x05, 0x66, 0xf4, 0x2a, 0x0c, 0x58, 0x6e, 0xe4, 0x1e, 0xf8, 0x7c, 0x1f, 0xea, 0xc2,
-	0xd8, 0x49, 0x79, 0x69, 0xad, 0x81, 0x29, 0x52, 0xe4, 0xc2, 0xa8, 0x2b, 0x54, 0x3f, 0xe2, 0x76,
-	0x5e, 0x3a, 0xeb, 0xbd, 0x54, 0x21, 0x49, 0xc5, 0x55, 0x54, 0x82, 0x25, 0x0d, 0x4a, 0x2f, 0xf5,
-	0xd6, 0x51, 0x9a, 0x9e, 0x54, 0x7e, 0x76, 0xd3, 0x2f, 0x13, 0x18, 0x0e, 0x0d, 0xdb, 0x0d, 0x23,
-	0x57, 0xbf, 0x17, 0xca, 0x52, 0xdb, 0xa0, 0x58, 0x62, 0x0d, 0x0f, 0xfb, 0x19, 0x60, 0x81, 0x9c,
-	0x65, 0xb5, 0x66, 0xee, 0x7e, 0xe2, 0x33, 0x2a, 0xbd, 0x0d, 0xb8, 0x07, 0xa1, 0xc8, 0x6a, 0xcd,
-	0xfe, 0xc7, 0x02, 0x33, 0x7a, 0x1d, 0x46, 0x83, 0xc8, 0x0c, 0x64, 0xb4, 0xbf, 0xa9, 0x93, 0x36,
-	0x20, 0xc2, 0x11, 0x4b, 0x18, 0x7f, 0x48, 0xfc, 0x68, 0x0b, 0x46, 0x6c, 0xee, 0x76, 0x24, 0x02,
-	0x12, 0x3e, 0xdf, 0x47, 0x06, 0x5a, 0xae, 0x28, 0x10, 0x3f, 0x70, 0x84, 0x58, 0xff, 0x2d, 0xe0,
-	0xef, 0x06, 0xc2, 0xd2, 0x6e, 0x1b, 0x46, 0x23, 0x74, 0xfd, 0xf8, 0xaf, 0x47, 0x19, 0xd1, 0xf9,
-	0xd0, 0x64, 0x7e, 0x74, 0x89, 0x1b, 0xd5, 0xf2, 0xe2, 0x10, 0xc4, 0x79, 0x7d, 0x7a, 0x8b, 0x41,
-	0xf0, 0x06, 0x4b, 0xd2, 0x1b, 0x45, 0x03, 0x1a, 0x28, 0xbf, 0xb5, 0x64, 0xa4, 0xa0, 0x44, 0x72,
-	0xde, 0x28, 0x98, 0x90, 0x42, 0xa4, 0xc0, 0x12, 0x71, 0xb0, 0x94, 0x25, 0xe2, 0x0b, 0x70, 0x4e,
-	0x58, 0x7e, 0xd4, 0x2d, 0xc2, 0x6e, 0xab, 0xc2, 0xa7, 0x84, 0xd9, 0x04, 0xd5, 0x92, 0x20, 0x9c,
-	0xae, 0x8b, 0x7e, 0x45, 0x83, 0x51, 0x53, 0x08, 0x08, 0xe2, 0xbb, 0x5a, 0xe9, 0xef, 0x71, 0x69,
-	0x3e, 0x92, 0x37, 0xb8, 0x2c, 0xfe, 0x52, 0xf4, 0x45, 0x47, 0xc5, 0x27, 0xa4, 0x04, 0x91, 0xbd,
-	0x46, 0xbf, 0x49, 0xaf, 0x1b, 0x0e, 0xcb, 0x43, 0xce, 0x22, 0xae, 0x70, 0x67, 0x97, 0x3b, 0x7d,
-	0x8e, 0x62, 0x21, 0xc6, 0xc8, 0x07, 0xf2, 0x6d, 0xf2, 0x52, 0x11, 0x43, 0x4e, 0x68, 0x2c, 0x6a,
-	0xf7, 0xd1, 0x3f, 0xd1, 0xe0, 0x51, 0xee, 0x61, 0x54, 0xa3, 0x67, 0xfe, 0xb6, 0x6d, 0x1a, 0x21,
-	0xe1, 0x41, 0x8f, 0x22, 0x07, 0x0b, 0x6e, 0x37, 0x39, 0x7a, 0x6c, 0xbb, 0xc9, 0xc7, 0x0f, 0x0f,
-	0xaa, 0x8f, 0xd6, 0x7a, 0xc0, 0x8d, 0x7b, 0xea, 0x01, 0x7a, 0x13, 0x26, 0x1d, 0x35, 0xca, 0x9c,
-	0x60, 0x30, 0xa5, 0x9e, 0x2e, 0x12, 0xe1, 0xea, 0xf8, 0x5d, 0x25, 0x51, 0x84, 0x93, 0xa4, 0xe6,
-	0xee, 0xc2, 0x64, 0x62, 0xa3, 0x9d, 0xaa, 0xd2, 0xc7, 0x85, 0xe9, 0xf4, 0x7e, 0x38, 0x55, 0x1b,
-	0xa2, 0xdb, 0x30, 0x26, 0x0f, 0x2a, 0xf4, 0xb0, 0x42, 0x28, 0x3e, 0xf6, 0x6f, 0x93, 0x7d, 0x4e,
-	0xb5, 0x9a, 0xb8, 0x8e, 0xf1, 0x17, 0x89, 0x97, 0x68, 0x81, 0x40, 0xa8, 0xff, 0x8e, 0x78, 0x91,
-	0xd8, 0x20, 0xad, 0xb6, 0x63, 0x84, 0xe4, 0x9d, 0xff, 0x1e, 0xae, 0xff, 0x37, 0x8d, 0x9f, 0x37,
-	0xfc, 0x58, 0x45, 0x06, 0x8c, 0xb7, 0x78, 0xb6, 0x03, 0x16, 0x64, 0x48, 0x2b, 0x1f, 0xde, 0x68,
-	0x35, 0x46, 0x83, 0x55, 0x9c, 0xe8, 0x1e, 0x8c, 0x45, 0x82, 0x48, 0xa4, 0xd0, 0xb8, 0xd1, 0x9f,
-	0x60, 0x20, 0x65, 0x1e, 0xf9, 0xd4, 0x1a, 0x95, 0x04, 0x38, 0xa6, 0xa5, 0x1b, 0x80, 0xb2, 0x6d,
-	0xe8, 0x9d, 0x35, 0xf2, 0x61, 0xd0, 0x92, 0xf1, 0x89, 0x33, 0x7e, 0x0c, 0x91, 0xbe, 0xa6, 0x52,
-	0xa4, 0xaf, 0xd1, 0x7f, 0xb5, 0x02, 0xb9, 0x59, 0x70, 0x91, 0x0e, 0xc3, 0xdc, 0xad, 0x50, 0x10,
-	0x61, 0xa2, 0x0c, 0xf7, 0x39, 0xc4, 0x02, 0x82, 0xee, 0x70, 0x45, 0x8a, 0x6b, 0xb1, 0xb8, 0xc0,
-	0x31, 0x97, 0x50, 0x9d, 0x6b, 0x97, 0xf3, 0x2a, 0xe0, 0xfc, 0x76, 0x68, 0x17, 0x50, 0xcb, 0xd8,
-	0x4b, 0x63, 0xeb, 0x23, 0x7b, 0xe2, 0x6a, 0x06, 0x1b, 0xce, 0xa1, 0x40, 0x0f, 0x52, 0xc3, 0x34,
-	0x49, 0x3b, 0x24, 0x16, 0x1f, 0x62, 0xf4, 0x20, 0xca, 0x0e, 0xd2, 0x85, 0x24, 0x08, 0xa7, 0xeb,
-	0xea, 0x5f, 0x1b, 0x84, 0x07, 0x92, 0x93, 0x48, 0xbf, 0xd0, 0xc8, 0xf3, 0xef, 0xc5, 0xc8, 0x5f,
-	0x80, 0x4f, 0xe4, 0x13, 0x69, 0x7f, 0x81, 0xd9, 0x9a, 0x4f, 0xd8, 0x91, 0x6c, 0x38, 0x41, 0xd4,
-	0x28, 0xe1, 0x3b, 0xf0, 0x75, 0x70, 0xe3, 0x2b, 0x70, 0x57, 0x1c, 0x38, 0x55, 0x77, 0xc5, 0xcf,
-	0x68, 0x30, 0x97, 0x2c, 0xbe, 0x61, 0xbb, 0x76, 0xb0, 0x23, 0xa2, 0xdb, 0x1e, 0xdf, 0x5d, 0x81,
-	0xe5, 0x7b, 0x5a, 0x29, 0xc4, 0x88, 0xbb, 0x50, 0x43, 0x9f, 0xd5, 0xe0, 0xc1, 0xd4, 0xbc, 0x24,
-	0x62, 0xed, 0x1e, 0xdf, 0x73, 0x81, 0x39, 0x85, 0xaf, 0x14, 0xa3, 0xc4, 0xdd, 0xe8, 0xe9, 0xff,
-	0xb2, 0x02, 0x43, 0xec, 0x3d, 0xff, 0x9d, 0x61, 0xc0, 0xcd, 0xba, 0x5a, 0x68, 0xd3, 0xd4, 0x4c,
-	0xd9, 0x34, 0xbd, 0x58, 0x9e, 0x44, 0x77, 0xa3, 0xa6, 0x6f, 0x83, 0x4b, 0xac, 0xda, 0x82, 0xc5,
-	0x94, 0x28, 0x01, 0xb1, 0x16, 0x2c, 0x8b, 0x85, 0xa4, 0x38, 0x5a, 0x95, 0xfd, 0x30, 0x0c, 0x74,
-	0x7c, 0x27, 0x1d, 0x17, 0x6c, 0x13, 0xaf, 0x60, 0x5a, 0xae, 0x7f, 0x46, 0x83, 0x69, 0x86, 0x5b,
-	0xf9, 0x7c, 0xd1, 0x2e, 0x8c, 0xfa, 0xe2, 0x13, 0x16, 0x6b, 0xb3, 0x52, 0x7a, 0x68, 0x39, 0x6c,
-	0x41, 0xe4, 0xe9, 0x16, 0xbf, 0xb0, 0xa4, 0xa5, 0x7f, 0x75, 0x18, 0x66, 0x8b, 0x1a, 0xa1, 0x1f,
-	0xd5, 0xe0, 0x92, 0x19, 0x4b, 0x73, 0x0b, 0x9d, 0x70, 0xc7, 0xf3, 0xed, 0xd0, 0x16, 0x86, 0x2e,
-	0x25, 0xaf, 0xb9, 0xb5, 0x05, 0xd9, 0x2b, 0x16, 0xcb, 0xb5, 0x96, 0x4b, 0x01, 0x17, 0x50, 0x46,
-	0x6f, 0xf1, 0x98, 0x49, 0xa6, 0x6a, 0xdb, 0x71, 0xbb, 0xf4, 0x5c, 0x29, 0x01, 0xeb, 0xa3, 0x4e,
-	0xc9, 0xc0, 0x49, 0xa2, 0x5c, 0x21, 0x47, 0x89, 0x07, 0xc1, 0xce, 0x6d, 0xb2, 0xdf, 0x36, 0xec,
-	0xc8, 0x9c, 0xa1, 0x3c, 0xf1, 0x46, 0xe3, 0x96, 0x40, 0x95, 0x24, 0xae, 0x94, 0x2b, 0xe4, 0xd0,
-	0xa7, 0x34, 0x98, 0xf4, 0x54, 0x1f, 0xf1, 0x7e, 0xac, 0x45, 0x73, 0x9d, 0xcd, 0xb9, 0x08, 0x9d,
-	0x04, 0x25, 0x49, 0xd2, 0x3d, 0x31, 0x13, 0xa4, 0x8f, 0x2c, 0xc1, 0xd4, 0x56, 0xfb, 0x4f, 0xb2,
-	0xaf, 0x9c, 0x7f, 0xfc, 0x3a, 0x9e, 0x05, 0x67, 0xc9, 0xb3, 0x4e, 0x91, 0xd0, 0xb4, 0xe2, 0x94,
-	0xdf, 0xb4, 0x53, 0xc3, 0xe5, 0x3b, 0xb5, 0xbc, 0x51, 0x5b, 0x4a, 0x20, 0x4b, 0x76, 0x2a, 0x0b,
-	0xce, 0x92, 0xd7, 0x3f, 0x59, 0x81, 0xcb, 0x05, 0x7b, 0xec, 0x6f, 0x8c, 0x53, 0xff, 0x57, 0x34,
-	0x18, 0x63, 0x73, 0xf0, 0x0e, 0x71, 0xb8, 0x61, 0x7d, 0x2d, 0xb0, 0xfa, 0xfb, 0x75, 0x0d, 0x66,
-	0x32, 0x51, 0xc4, 0x7b, 0x72, 0xd7, 0x38, 0x33, 0x83, 0xb4, 0xc7, 0xe2, 0x0c, 0x24, 0x03, 0xb1,
-	0x97, 0x72, 0x3a, 0xfb, 0x88, 0xfe, 0x32, 0x4c, 0x26, 0x8c, 0xfe, 0x94, 0x00, 0x4f, 0x79, 0x91,
-	0xa9, 0xd4, 0xf8, 0x4d, 0x95, 0x6e, 0x81, 0xa7, 0xe2, 0x2d, 0x9f, 0xe5, 0x6c, 0x7f, 0x63, 0xb6,
-	0xfc, 0xcf, 0xce, 0x88, 0x2d, 0xcf, 0xde, 0x07, 0x5e, 0x83, 0x61, 0x16, 0x69, 0x2a, 0x3a, 0x31,
-	0xaf, 0x97, 0x8e, 0x60, 0x15, 0xf0, 0x9b, 0x14, 0xff, 0x1f, 0x0b, 0xac, 0x2c, 0xb5, 0xb4, 0x12,
-	0x4b, 0x6d, 0x2d, 0xbe, 0xb4, 0x5d, 0x48, 0x47, 0x5e, 0x63, 0x5b, 0x32, 0x53, 0x1b, 0x61, 0xfe,
-	0xba, 0xc0, 0xcf, 0xb2, 0x52, 0x71, 0xaf, 0x97, 0xd6, 0x1a, 0x3c, 0x20, 0x90, 0x7c, 0x55, 0x78,
-	0x03, 0x80, 0x44, 0x1b, 0x37, 0xf2, 0x91, 0x7c, 0xa1, 0x5c, 0x44, 0x6f, 0xb9, 0xfd, 0x23, 0xc1,
-	0x53, 0x16, 0x05, 0x58, 0x21, 0x82, 0x7c, 0x18, 0xdf, 0xb1, 0xb7, 0x88, 0xef, 0x72, 0x19, 0x6a,
-	0xa8, 0xbc, 0x78, 0x78, 0x2b, 0x46, 0xc3, 0xef, 0xf7, 0x4a, 0x01, 0x56, 0x89, 0x20, 0x3f, 0x11,
-	0xbe, 0x71, 0xb8, 0xbc, 0x48, 0x14, 0xeb, 0x9c, 0xe3, 0x71, 0x16, 0x84, 0x6e, 0x74, 0x01, 0x5c,
-	0x19, 0x9f, 0xad, 0x9f, 0xd7, 0x86, 0x38, 0xca, 0x1b, 0x17, 0x3a, 0xe2, 0xdf, 0x58, 0xa1, 0x40,
-	0xe7, 0xb5, 0x15, 0x87, 0xc8, 0x15, 0xfa, 0xc3, 0x17, 0xfb, 0x0c, 0x53, 0x2c, 0xf4, 0x26, 0x71,
-	0x01, 0x56, 0x89, 0xd0, 0x31, 0xb6, 0x64, 0x60, 0x5b, 0xa1, 0x1f, 0x2c, 0x35, 0xc6, 0x38, 0x3c,
-	0xae, 0xc8, 0x37, 0x2a, 0x7f, 0x63, 0x85, 0x02, 0x7a, 0x5d, 0x79, 0x94, 0x82, 0xf2, 0xda, 0xa7,
-	0x9e, 0x1e, 0xa4, 0xde, 0x1f, 0x2b, 0x61, 0xc6, 0xd9, 0x77, 0xfa, 0xa0, 0xa2, 0x80, 0x61, 0x01,
-	0x7f, 0x29, 0xef, 0xc8, 0x28, 0x64, 0x62, 0x53, 0xe3, 0x89, 0xae, 0xa6, 0xc6, 0x35, 0x2a, 0x9d,
-	0x29, 0xae, 0x2f, 0x8c, 0x21, 0x4c, 0xc6, 0xaf, 0x1b, 0x8d, 0x34, 0x10, 0x67, 0xeb, 0x73, 0x86,
-	0x4f, 0x2c, 0xd6, 0x76, 0x4a, 0x65, 0xf8, 0xbc, 0x0c, 0x4b, 0x28, 0xda, 0x85, 0x89, 0x40, 0xb1,
-	0x5b, 0x16, 0x49, 0xa2, 0xfb, 0x78, 0x97, 0x12, 0x36, 0xcb, 0x2c, 0xb6, 0x95, 0x5a, 0x82, 0x13,
-	0x74, 0xd0, 0x5b, 0xaa, 0xa1, 0xe6, 0x74, 0x7f, 0x61, 0x5f, 0xb3, 0x81, 0x8c, 0x63, 0xed, 0x9a,
-	0xb4, 0x11, 0x54, 0xed, 0x27, 0x3b, 0x49, 0x93, 0xc4, 0x99, 0x13, 0x71, 0xca, 0x3f, 0xd2, 0x64,
-	0x91, 0x2e, 0x2d, 0xd9, 0x6b, 0x7b, 0x41, 0xc7, 0x27, 0x2c, 0x40, 0x3b, 0x5b, 0x1e, 0x14, 0x2f,
-	0xed, 0x72, 0x1a, 0x88, 0xb3, 0xf5, 0xd1, 0xf7, 0x69, 0x30, 0xcd, 0x73, 0x6c, 0xd3, 0x63, 0xcb,
-	0x73, 0x89, 0x1b, 0x06, 0x2c, 0x89, 0x74, 0x49, 0x3f, 0xd2, 0x46, 0x0a, 0x17, 0x3f, 0x76, 0xd2,
-	0xa5, 0x38, 0x43, 0x93, 0xee, 0x1c, 0xd5, 0xad, 0x9f, 0xe5, 0xa2, 0x2e, 0xb9, 0x73, 0xd4, 0x90,
-	0x01, 0x7c, 0xe7, 0xa8, 0x25, 0x38, 0x41, 0x07, 0x3d, 0x0b, 0x93, 0x41, 0x94, 0x8d, 0x8e, 0xcd,
-	0xe0, 0xc5, 0x38, 0x40, 0x58, 0x43, 0x05, 0xe0, 0x64, 0x3d, 0xf4, 0x09, 0x98, 0x50, 0xcf, 0x4e,
-	0x91, 0xc1, 0xfa, 0x04, 0x03, 0xb9, 0xf2, 0x9e, 0xab, 0xa0, 0x04, 0x41, 0x84, 0xe1, 0x92, 0x19,
-	0x5f, 0xd2, 0xd5, 0xef, 0xfb, 0x32, 0x1b, 0x02, 0xbf, 0x4c, 0xe7, 0xd6, 0xc0, 0x05, 0x2d, 0xf5,
-	0x7f, 0xa7, 0x01, 0x48, 0x75, 0xc8, 0x59, 0x28, 0xf9, 0xad, 0x84, 0x86, 0x68, 0xb1, 0x2f, 0xf5,
-	0x4d, 0x61, 0x20, 0x6c, 0xfd, 0xf7, 0x34, 0x98, 0x8a, 0xab, 0x9d, 0xc1, 0xdd, 0xc3, 0x4c, 0xde,
-	0x3d, 0x3e, 0xd4, 0xdf, 0xb8, 0x0a, 0x2e, 0x20, 0xff, 0xa7, 0xa2, 0x8e, 0x8a, 0x89, 0x97, 0xbb,
-	0x89, 0x47, 0x73, 0x4a, 0xfa, 0x56, 0x3f, 0x8f, 0xe6, 0xaa, 0xff, 0x74, 0x3c, 0xde, 0x9c, 0x47,
-	0xf4, 0xbf, 0x93, 0x10, 0xf0, 0xfa, 0x88, 0x12, 0x20, 0xa5, 0xb9, 0x88, 0x34, 0x9f, 0x80, 0xa3,
-	0xa4, 0xbd, 0x37, 0x54, 0xfe, 0xdf, 0x47, 0xf0, 0xea, 0xc4, 0x80, 0xbb, 0x72, 0x7d, 0xfd, 0xfb,
-	0xcf, 0xc1, 0xb8, 0xa2, 0x39, 0x4c, 0x99, 0x00, 0x68, 0x67, 0x61, 0x02, 0x10, 0xc2, 0xb8, 0x29,
-	0xb3, 0xb8, 0x44, 0xd3, 0xde, 0x27, 0x4d, 0x79, 0xee, 0xc4, 0xf9, 0x61, 0x02, 0xac, 0x92, 0xa1,
-	0xd2, 0x91, 0xdc, 0x63, 0x03, 0x27, 0x60, 0x98, 0xd1, 0x6d, 0x5f, 0x3d, 0x03, 0x10, 0x09, 0xd8,
-	0xc4, 0x12, 0x31, 0x51, 0xa5, 0x97, 0x40, 0x3d, 0xb8, 0x25, 0x61, 0x58, 0xa9, 0x97, 0x7d, 0x52,
-	0x1e, 0x3a, 0xb3, 0x27, 0x65, 0xba, 0x0d, 0x9c, 0x28, 0x29, 0x61, 0x5f, 0x46, 0x46, 0x32, 0xb5,
-	0x61, 0xbc, 0x0d, 0x64, 0x51, 0x80, 0x15, 0x22, 0x05, 0x96, 0x20, 0x23, 0xa5, 0x2c, 0x41, 0x3a,
-	0x70, 0xde, 0x27, 0xa1, 0xbf, 0x5f, 0xdb, 0x37, 0x59, 0x60, 0x70, 0x3f, 0x64, 0x57, 0xe4, 0xd1,
-	0x72, 0xe1, 0xa5, 0x70, 0x16, 0x15, 0xce, 0xc3, 0x9f, 0x90, 0x30, 0xc7, 0xba, 0x4a, 0x98, 0xef,
-	0x87, 0xf1, 0x90, 0x98, 0x3b, 0xae, 0x6d, 0x1a, 0x4e, 0x7d, 0x49, 0x04, 0xe5, 0x8c, 0x85, 0xa5,
-	0x18, 0x84, 0xd5, 0x7a, 0x68, 0x11, 0x06, 0x3a, 0xb6, 0x25, 0x44, 0xec, 0x6f, 0x96, 0x3a, 0xf8,
-	0xfa, 0xd2, 0xfd, 0x83, 0xea, 0xbb, 0x63, 0xd3, 0x0a, 0x39, 0xaa, 0x6b, 0xed, 0xbb, 0xcd, 0x6b,
-	0xe1, 0x7e, 0x9b, 0x04, 0xf3, 0x9b, 0xf5, 0x25, 0x4c, 0x1b, 0xe7, 0x59, 0xc9, 0x4c, 0x1c, 0xc3,
-	0x4a, 0xe6, 0x6d, 0x0d, 0xce, 0x1b, 0xe9, 0xe7, 0x03, 0x12, 0xcc, 0x4e, 0x96, 0xe7, 0x96, 0xf9,
-	0x4f, 0x12, 0x8b, 0x0f, 0x8a, 0xf1, 0x9d, 0x5f, 0xc8, 0x92, 0xc3, 0x79, 0x7d, 0x40, 0x3e, 0xa0,
-	0x96, 0xdd, 0x94, 0xf9, 0x01, 0xc5, 0xaa, 0x4f, 0x95, 0x53, 0x8c, 0xac, 0x66, 0x30, 0xe1, 0x1c,
-	0xec, 0xe8, 0x1e, 0x8c, 0x2b, 0x52, 0x88, 0xb8, 0x2a, 0x2c, 0x9d, 0xc4, 0x2b, 0x07, 0xbf, 0x4e,
-	0xaa, 0x2f, 0x18, 0x2a, 0x25, 0xf9, 0x3c, 0xa8, 0xdc, 0xe3, 0xc5, 0x13, 0x19, 0x1b, 0xf5, 0x74,
-	0xf9, 0xe7, 0xc1, 0x7c, 0x8c, 0xb8, 0x0b, 0x35, 0x16, 0xd4, 0xc9, 0x49, 0xa6, 0xf1, 0x9c, 0x9d,
-	0x29, 0xef, 0x08, 0x9e, 0xca, 0x08, 0xca, 0xb7, 0x66, 0xaa, 0x10, 0xa7, 0x09, 0xa2, 0x1b, 0x80,
-	0x08, 0xd7, 0x55, 0xc7, 0xb7, 0x9f, 0x60, 0x16, 0xc9, 0x74, 0xa7, 0x68, 0x39, 0x03, 0xc5, 0x39,
-	0x2d, 0x50, 0x98, 0x50, 0x46, 0xf4, 0x71, 0x8d, 0x48, 0x87, 0x9c, 0xef, 0xa6, 0x92, 0xd0, 0x7f,
-	0x57, 0x13, 0xfa, 0xcb, 0x33, 0x34, 0x4e, 0x39, 0xed, 0x97, 0x4d, 0xfd, 0x65, 0x98, 0x6d, 0x44,
-	0x61, 0xc6, 0xac, 0x54, 0xd0, 0xdb, 0xe7, 0x61, 0x92, 0xbf, 0x1f, 0xac, 0x1a, 0xed, 0xb5, 0x58,
-	0xd9, 0x2c, 0x1d, 0x7b, 0x6b, 0x2a, 0x10, 0x27, 0xeb, 0xea, 0x7f, 0xaa, 0x41, 0xe6, 0x3e, 0x86,
-	0xb6, 0x60, 0x84, 0xf6, 0x6d, 0x69, 0xad, 0x21, 0xe6, 0xeb, 0xf9, 0x72, 0x52, 0x04, 0x43, 0xc1,
-	0xb5, 0xcc, 0xe2, 0x07, 0x8e, 0x10, 0xd3, 0x1b, 0x9e, 0xab, 0x84, 0x72, 0x17, 0x53, 0x57, 0x4a,
-	0x4c, 0x53, 0x43, 0xc2, 0xf3, 0x7b, 0x92, 0x5a, 0x82, 0x13, 0x74, 0xf4, 0x15, 0x80, 0xf8, 0x0e,
-	0xdd, 0xb7, 0x21, 0xd4, 0x8f, 0x8f, 0xc3, 0xc5, 0x7e, 0x5d, 0x40, 0x58, 0x12, 0x4c, 0xb2, 0x6b,
-	0x9b, 0xe1, 0xc2, 0x76, 0x48, 0xfc, 0x3b, 0x77, 0x56, 0x37, 0x76, 0x7c, 0x12, 0xec, 0x78, 0x8e,
-	0x55, 0x32, 0x0b, 0x27, 0xbb, 0xeb, 0x2d, 0xe7, 0x62, 0xc4, 0x05, 0x94, 0x98, 0xfe, 0x80, 0x42,
-	0xa8, 0x28, 0x40, 0x65, 0xec, 0x8e, 0x1f, 0x84, 0x22, 0xd2, 0x0f, 0xd7, 0x1f, 0xa4, 0x81, 0x38,
-	0x5b, 0x3f, 0x8d, 0x64, 0xc5, 0x6e, 0xd9, 0x3c, 0xea, 0xbb, 0x96, 0x45, 0xc2, 0x80, 0x38, 0x5b,
-	0x5f, 0x45, 0xc2, 0x57, 0x8a, 0x32, 0xc1, 0xa1, 0x2c, 0x12, 0x09, 0xc4, 0xd9, 0xfa, 0xc8, 0x82,
-	0x87, 0x7c, 0x62, 0x7a, 0xad, 0x16, 0x71, 0x2d, 0x9e, 0xaf, 0xda, 0xf0, 0x9b, 0xb6, 0x7b, 0xc3,
-	0x37, 0x58, 0x45, 0xa6, 0x8e, 0xd5, 0x58, 0x4e, 0xad, 0x87, 0x70, 0x97, 0x7a, 0xb8, 0x2b, 0x16,
-	0xd4, 0x82, 0x73, 0x3c, 0x99, 0xa5, 0x5f, 0x77, 0x43, 0xe2, 0xef, 0x1a, 0x8e, 0xd0, 0xb9, 0x1e,
-	0x77, 0xc5, 0x18, 0x63, 0xde, 0x4c, 0xa2, 0xc2, 0x69, 0xdc, 0x68, 0x9f, 0x8a, 0x63, 0xa2, 0x3b,
-	0x0a, 0xc9, 0xd1, 0xf2, 0x69, 0x62, 0x71, 0x16, 0x1d, 0xce, 0xa3, 0x81, 0xea, 0x70, 0x3e, 0x34,
-	0xfc, 0x26, 0x09, 0x6b, 0xeb, 0x9b, 0xeb, 0xc4, 0x37, 0x29, 0xff, 0x71, 0xb8, 0x74, 0xa6, 0x71,
-	0x54, 0x1b, 0x59, 0x30, 0xce, 0x6b, 0x83, 0x3e, 0x01, 0x8f, 0x25, 0x27, 0x75, 0xc5, 0xbb, 0x47,
-	0xfc, 0x45, 0xaf, 0xe3, 0x5a, 0x49, 0xe4, 0xc0, 0x90, 0x3f, 0x71, 0x78, 0x50, 0x7d, 0x0c, 0xf7,
-	0xd2, 0x00, 0xf7, 0x86, 0x37, 0xdb, 0x81, 0xcd, 0x76, 0x3b, 0xb7, 0x03, 0xe3, 0x45, 0x1d, 0x28,
-	0x68, 0x80, 0x7b, 0xc3, 0x8b, 0x30, 0x5c, 0xe2, 0x13, 0xc3, 0x33, 0xc0, 0x29, 0x14, 0x27, 0x18,
-	0x45, 0xf6, 0xfd, 0x6e, 0xe4, 0xd6, 0xc0, 0x05, 0x2d, 0xd1, 0x0f, 0x68, 0xf0, 0x78, 0xd1, 0xf0,
-	0x33, 0x64, 0x26, 0x19, 0x99, 0xf7, 0x1e, 0x1e, 0x54, 0x1f, 0xc7, 0x3d, 0xb6, 0xc1, 0x3d, 0x63,
-	0xcf, 0xe9, 0x4a, 0x3c, 0x11, 0x99, 0xae, 0x4c, 0x15, 0x75, 0xa5, 0xb8, 0x0d, 0xee, 0x19, 0xbb,
-	0xfe, 0xb6, 0x06, 0xc2, 0x51, 0x02, 0x3d, 0x94, 0x78, 0x8a, 0x1d, 0x4d, 0x3d, 0xc3, 0x46, 0x69,
-	0x80, 0x2a, 0xb9, 0x69, 0x80, 0xde, 0xa3, 0x44, 0x3e, 0x1b, 0x8b, 0x65, 0x01, 0x8e, 0x59, 0x49,
-	0x5c, 0xf9, 0x24, 0x8c, 0x49, 0x39, 0x48, 0xdc, 0x4f, 0x59, 0xc8, 0xe5, 0x58, 0x60, 0x8a, 0xe1,
-	0xfa, 0x6f, 0x6b, 0x00, 0x71, 0x4a, 0xa8, 0xde, 0xd2, 0x6d, 0x1e, 0x69, 0x79, 0xa9, 0xa4, 0x09,
-	0x1d, 0x28, 0x4c, 0x13, 0x7a, 0x4a, 0xd9, 0x33, 0x7f, 0x41, 0x83, 0x73, 0xc9, 0x50, 0x74, 0x01,
-	0x7a, 0x0c, 0x46, 0x44, 0xb0, 0x5a, 0x11, 0x6d, 0x92, 0x35, 0x15, 0xd1, 0x62, 0x70, 0x04, 0x4b,
-	0x6a, 0xec, 0xfb, 0x50, 0x18, 0xe5, 0x47, 0xc4, 0x3b, 0x42, 0x77, 0xf3, 0xf6, 0x0c, 0x0c, 0xf3,
-	0x48, 0xa7, 0xf4, 0x28, 0xce, 0xf1, 0x92, 0xbf, 0x5d, 0x3e, 0xa0, 0x6a, 0x19, 0x4f, 0x62, 0x35,
-	0xfb, 0x49, 0xa5, 0x6b, 0xf6, 0x13, 0xcc, 0xb3, 0x12, 0xf7, 0xf1, 0x3a, 0x5b, 0xc3, 0x75, 0xfe,
-	0x3a, 0x2b, 0x33, 0x12, 0x87, 0x89, 0x67, 0xcb, 0xc1, 0xf2, 0x52, 0x3b, 0x9f, 0x00, 0xe5, 0xf1,
-	0x72, 0xaa, 0xeb, 0xc3, 0x65, 0x14, 0x4a, 0x72, 0xa8, 0xbc, 0x25, 0xb4, 0x98, 0xf2, 0x1e, 0x42,
-	0x49, 0xca, 0x0f, 0x69, 0xb8, 0xf0, 0x43, 0xda, 0x86, 0x11, 0xf1, 0x29, 0x88, 0x33, 0xfd, 0xf9,
-	0x3e, 0x32, 0xd0, 0x29, 0x61, 0xda, 0x79, 0x01, 0x8e, 0x90, 0x53, 0x41, 0xb1, 0x65, 0xec, 0xd9,
-	0xad, 0x4e, 0x8b, 0x1d, 0xe4, 0x43, 0x6a, 0x55, 0x56, 0x8c, 0x23, 0x38, 0xab, 0xca, 0x0d, 0xc8,
-	0xd9, 0xc1, 0xab, 0x56, 0xe5, 0xc5, 0x38, 0x82, 0xa3, 0x57, 0x61, 0xb4, 0x65, 0xec, 0x35, 0x3a,
-	0x7e, 0x93, 0x88, 0x47, 0xcb, 0xe2, 0x3b, 0x4f, 0x27, 0xb4, 0x9d, 0x79, 0xdb, 0x0d, 0x83, 0xd0,
-	0x9f, 0xaf, 0xbb, 0xe1, 0x1d, 0xbf, 0x11, 0xfa, 0x32, 0xc7, 0xe7, 0xaa, 0xc0, 0x82, 0x25, 0x3e,
-	0xe4, 0xc0, 0x54, 0xcb, 0xd8, 0xdb, 0x74, 0x0d, 0x1e, 0x25, 0x54, 0x1c, 0x94, 0x65, 0x28, 0x30,
-	0xab, 0x95, 0xd5, 0x04, 0x2e, 0x9c, 0xc2, 0x9d, 0x63, 0x20, 0x33, 0x71, 0x5a, 0x06, 0x32, 0x0b,
-	0xd2, 0x1d, 0x90, 0x6b, 0x61, 0x1e, 0xc8, 0x0d, 0x24, 0xd2, 0xd5, 0xd5, 0xef, 0x35, 0xe9, 0xea,
-	0x37, 0x55, 0xde, 0xa2, 0xa3, 0x8b, 0x9b, 0x5f, 0x07, 0xc6, 0xe9, 0x8d, 0x93, 0x97, 0x06, 0xb3,
-	0xe7, 0xca, 0x3f, 0x28, 0x2c, 0x49, 0x34, 0x31, 0x4b, 0x8a, 0xcb, 0x02, 0xac, 0xd2, 0x41, 0x77,
-	0xe0, 0xa2, 0xc8, 0x17, 0x1e, 0x57, 0x61, 0x77, 0xcd, 0x69, 0xf6, 0xfd, 0x30, 0x93, 0xfc, 0xdb,
-	0x79, 0x15, 0x70, 0x7e, 0xbb, 0x38, 0xe8, 0xd5, 0x4c, 0x7e, 0xd0, 0x2b, 0xf4, 0x43, 0x79, 0x4f,
-	0x91, 0x88, 0xcd, 0xe9, 0x47, 0xca, 0xf3, 0x86, 0xd2, 0x0f, 0x92, 0xff, 0x4a, 0x83, 0x59, 0xb1,
-	0xcb, 0xc4, 0xf3, 0xa1, 0x43, 0xfc, 0x55, 0xc3, 0x35, 0x9a, 0xc4, 0x17, 0xaa, 0x8d, 0x8d, 0x3e,
-	0xf8, 0x43, 0x06, 0xa7, 0xf4, 0xc1, 0x7c, 0xf4, 0xf0, 0xa0, 0x7a, 0xf5, 0xa8, 0x5a, 0xb8, 0xb0,
-	0x6f, 0xc8, 0x87, 0x91, 0x60, 0x3f, 0x30, 0x43, 0x27, 0x98, 0xbd, 0xc0, 0x36, 0xcb, 0xcd, 0x3e,
-	0x38, 0x6b, 0x83, 0x63, 0xe2, 0xac, 0x35, 0x4e, 0x0e, 0xc2, 0x4b, 0x71, 0x44, 0x08, 0xfd, 0x3d,
-	0x0d, 0x66, 0x84, 0xbe, 0x53, 0xf1, 0x73, 0xbf, 0x58, 0xde, 0x70, 0xb9, 0x96, 0x46, 0x76, 0xa7,
-	0xcd, 0x33, 0x4b, 0xb0, 0x0b, 0x61, 0x06, 0x8a, 0xb3, 0xd4, 0xfb, 0x0d, 0x44, 0xd1, 0x47, 0xec,
-	0xe1, 0xb9, 0xeb, 0x30, 0xa1, 0x4e, 0xdc, 0xb1, 0xe2, 0x5f, 0xfc, 0x94, 0x06, 0xd3, 0xe9, 0x83,
-	0x14, 0xed, 0xc0, 0x88, 0xf8, 0xaa, 0x84, 0x7e, 0x66, 0xa1, 0xac, 0x59, 0x91, 0x43, 0x84, 0x63,
-	0x0e, 0x97, 0xcb, 0x44, 0x11, 0x8e, 0xd0, 0xab, 0x26, 0x83, 0x95, 0x2e, 0x26, 0x83, 0x2f, 0xc0,
-	0xa5, 0xfc, 0xef, 0x8b, 0x4a, 0xb5, 0x86, 0xe3, 0x78, 0xf7, 0x84, 0x12, 0x24, 0xce, 0xb3, 0x48,
-	0x0b, 0x31, 0x87, 0xe9, 0x1f, 0x87, 0x74, 0xa4, 0x79, 0xf4, 0x3a, 0x8c, 0x05, 0xc1, 0x0e, 0x0f,
-	0x22, 0x2c, 0x06, 0x59, 0x4e, 0xad, 0x16, 0x45, 0x22, 0xe6, 0x82, 0xb8, 0xfc, 0x89, 0x63, 0xf4,
-	0x8b, 0xaf, 0x7c, 0xf9, 0x6b, 0x57, 0xde, 0xf5, 0x3b, 0x5f, 0xbb, 0xf2, 0xae, 0xaf, 0x7e, 0xed,
-	0xca, 0xbb, 0xbe, 0xfb, 0xf0, 0x8a, 0xf6, 0xe5, 0xc3, 0x2b, 0xda, 0xef, 0x1c, 0x5e, 0xd1, 0xbe,
-	0x7a, 0x78, 0x45, 0xfb, 0xcf, 0x87, 0x57, 0xb4, 0x1f, 0xf9, 0x2f, 0x57, 0xde, 0xf5, 0xea, 0xd3,
-	0x31, 0xf5, 0x6b, 0x11, 0xd1, 0xf8, 0x9f, 0xf6, 0xdd, 0xe6, 0x35, 0x4a, 0x3d, 0xf2, 0xc6, 0x64,
-	0xd4, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xd6, 0x32, 0xc6, 0x55, 0x00, 0x01, 0x00,
+	// 14222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x24, 0xdb,
+	0x59, 0x18, 0xee, 0x9e, 0xd1, 0xf3, 0x93, 0x76, 0x57, 0x7b, 0xf6, 0x35, 0x77, 0xef, 0x43, 0xeb,
+	0xbe, 0xb6, 0x7f, 0xf7, 0x62, 0x5b, 0x8b, 0x2f, 0x7e, 0x5e, 0x3f, 0xa5, 0x91, 0x76, 0x57, 0x5e,
+	0x69, 0x57, 0xfe, 0x46, 0xba, 0xf7, 0x62, 0xf8, 0x5d, 0xd3, 0x9a, 0x39, 0x1a, 0xb5, 0xd5, 0xd3,
+	0x3d, 0xb7, 0xbb, 0x47, 0x2b, 0x5d, 0xdb, 0x98, 0xc7, 0xef, 0x47, 0xb0, 0xc1, 0x2e, 0xa0, 0x92,
+	0xb8, 0x6c, 0x43, 0x61, 0x42, 0x41, 0x48, 0x48, 0x39, 0x29, 0x52, 0xa4, 0x02, 0x54, 0xaa, 0x08,
+	0x55, 0x04, 0x43, 0x41, 0x8a, 0x02, 0x12, 0x4c, 0x19, 0x44, 0xac, 0x50, 0x90, 0x2a, 0x52, 0x54,
+	0x2a, 0x54, 0x92, 0x62, 0x93, 0x82, 0xd4, 0x79, 0x75, 0x9f, 0x7e, 0x8d, 0x46, 0x3d, 0x92, 0xec,
+	0x0b, 0xfe, 0x4b, 0x9a, 0xf3, 0xf8, 0xbe, 0x73, 0x4e, 0x9f, 0xf3, 0x9d, 0xef, 0x7c, 0x4f, 0x58,
+	0x68, 0xdb, 0xe1, 0x76, 0x6f, 0x73, 0xae, 0xe9, 0x75, 0x6e, 0xb6, 0x2d, 0xbf, 0x45, 0x5d, 0xea,
+	0xc7, 0xff, 0x74, 0x77, 0xda, 0x37, 0xad, 0xae, 0x1d, 0xdc, 0x6c, 0x7a, 0x3e, 0xbd, 0xb9, 0xfb,
+	0xa6, 0x4d, 0x1a, 0x5a, 0x6f, 0xba, 0xd9, 0x66, 0x75, 0x56, 0x48, 0x5b, 0x73, 0x5d, 0xdf, 0x0b,
+	0x3d, 0xf2, 0x4c, 0x0c, 0x63, 0x4e, 0x75, 0x8d, 0xff, 0xe9, 0xee, 0xb4, 0xe7, 0x18, 0x8c, 0x39,
+	0x06, 0x63, 0x4e, 0xc2, 0xb8, 0xfe, 0x46, 0x1d, 0xaf, 0xd7, 0xf6, 0x6e, 0x72, 0x50, 0x9b, 0xbd,
+	0x2d, 0xfe, 0x8b, 0xff, 0xe0, 0xff, 0x09, 0x14, 0xd7, 0x9f, 0xde, 0x79, 0x7b, 0x30, 0x67, 0x7b,
+	0x6c, 0x30, 0x37, 0xad, 0x5e, 0xe8, 0x05, 0x4d, 0xcb, 0xb1, 0xdd, 0xf6, 0xcd, 0xdd, 0xcc, 0x68,
+	0xae, 0x9b, 0x5a, 0x53, 0x39, 0xec, 0xbe, 0x6d, 0xfc, 0x4d, 0xab, 0x99, 0xd7, 0xe6, 0x4e, 0xdc,
+	0x86, 0xee, 0x85, 0xd4, 0x0d, 0x6c, 0xcf, 0x0d, 0xde, 0xc8, 0x66, 0x42, 0xfd, 0x5d, 0x7d, 0x6d,
+	0x12, 0x0d, 0xf2, 0x20, 0xbd, 0x39, 0x86, 0xd4, 0xb1, 0x9a, 0xdb, 0xb6, 0x4b, 0xfd, 0x7d, 0xd5,
+	0xfd, 0xa6, 0x4f, 0x03, 0xaf, 0xe7, 0x37, 0xe9, 0xb1, 0x7a, 0x05, 0x37, 0x3b, 0x34, 0xb4, 0xf2,
+	0x70, 0xdd, 0x2c, 0xea, 0xe5, 0xf7, 0xdc, 0xd0, 0xee, 0x64, 0xd1, 0xbc, 0xf5, 0xa8, 0x0e, 0x41,
+	0x73, 0x9b, 0x76, 0xac, 0x4c, 0xbf, 0x6f, 0x29, 0xea, 0xd7, 0x0b, 0x6d, 0xe7, 0xa6, 0xed, 0x86,
+	0x41, 0xe8, 0xa7, 0x3b, 0x99, 0x9f, 0x34, 0x60, 0x66, 0x7e, 0x6d, 0xb9, 0xc1, 0x57, 0x70, 0xc5,
+	0x6b, 0xb7, 0x6d, 0xb7, 0x4d, 0x5e, 0x0f, 0x93, 0xbb, 0xd4, 0xdf, 0xf4, 0x02, 0x3b, 0xdc, 0xaf,
+	0x19, 0x37, 0x8c, 0xa7, 0x46, 0x17, 0xce, 0x1d, 0x1e, 0xcc, 0x4e, 0x3e, 0xa7, 0x0a, 0x31, 0xae,
+	0x27, 0xcb, 0x70, 0x69, 0x3b, 0x0c, 0xbb, 0xf3, 0xcd, 0x26, 0x0d, 0x82, 0xa8, 0x45, 0xad, 0xc2,
+	0xbb, 0x5d, 0x3b, 0x3c, 0x98, 0xbd, 0x74, 0x67, 0x7d, 0x7d, 0x2d, 0x55, 0x8d, 0x79, 0x7d, 0xcc,
+	0x9f, 0x33, 0xe0, 0x62, 0x34, 0x18, 0xa4, 0x2f, 0xf5, 0x68, 0x10, 0x06, 0x04, 0xe1, 0x6a, 0xc7,
+	0xda, 0xbb, 0xe7, 0xb9, 0xab, 0xbd, 0xd0, 0x0a, 0x6d, 0xb7, 0xbd, 0xec, 0x6e, 0x39, 0x76, 0x7b,
+	0x3b, 0x94, 0x43, 0xbb, 0x7e, 0x78, 0x30, 0x7b, 0x75, 0x35, 0xb7, 0x05, 0x16, 0xf4, 0x64, 0x83,
+	0xee, 0x58, 0x7b, 0x19, 0x80, 0xda, 0xa0, 0x57, 0xb3, 0xd5, 0x98, 0xd7, 0xc7, 0x7c, 0x06, 0x46,
+	0xe7, 0x5b, 0x2d, 0xcf, 0x25, 0x4f, 0xc3, 0x38, 0x75, 0xad, 0x4d, 0x87, 0xb6, 0xf8, 0xc0, 0x26,
+	0x16, 0x2e, 0x7c, 0xe9, 0x60, 0xf6, 0x55, 0x87, 0x07, 0xb3, 0xe3, 0x4b, 0xa2, 0x18, 0x55, 0xbd,
+	0xf9, 0x0f, 0x2a, 0x30, 0xc6, 0x3b, 0x05, 0xe4, 0x47, 0x0c, 0xb8, 0xb4, 0xd3, 0xdb, 0xa4, 0xbe,
+	0x4b, 0x43, 0x1a, 0x2c, 0x5a, 0xc1, 0xf6, 0xa6, 0x67, 0xf9, 0x02, 0xc4, 0xd4, 0x33, 0xb7, 0xe7,
+	0x8e, 0x7f, 0x92, 0xe7, 0xee, 0x66, 0xc1, 0x89, 0x39, 0xe5, 0x54, 0x60, 0x1e, 0x72, 0xb2, 0x0b,
+	0xd3, 0x6e, 0xdb, 0x76, 0xf7, 0x96, 0xdd, 0xb6, 0x4f, 0x83, 0x80, 0xaf, 0xcb, 0xd4, 0x33, 0xef,
+	0x2b, 0x33, 0x98, 0x7b, 0x1a, 0x9c, 0x85, 0x99, 0xc3, 0x83, 0xd9, 0x69, 0xbd, 0x04, 0x13, 0x78,
+	0xcc, 0xbf, 0x36, 0xe0, 0xc2, 0x7c, 0xab, 0x63, 0x07, 0xec, 0xe4, 0xae, 0x39, 0xbd, 0xb6, 0xed,
+	0x92, 0x1b, 0x30, 0xe2, 0x5a, 0x1d, 0xca, 0x17, 0x64, 0x72, 0x61, 0x5a, 0xae, 0xe9, 0xc8, 0x3d,
+	0xab, 0x43, 0x91, 0xd7, 0x90, 0x0f, 0xc0, 0x58, 0xd3, 0x73, 0xb7, 0xec, 0xb6, 0x1c, 0xe7, 0x1b,
+	0xe7, 0xc4, 0x49, 0x98, 0xd3, 0x4f, 0x02, 0x1f, 0x9e, 0x3c, 0x41, 0x73, 0x68, 0x3d, 0x58, 0x52,
+	0x04, 0x62, 0x01, 0x0e, 0x0f, 0x66, 0xc7, 0xea, 0x1c, 0x00, 0x4a, 0x40, 0xe4, 0x29, 0x98, 0x68,
+	0xd9, 0x81, 0xf8, 0x98, 0x55, 0xfe, 0x31, 0xa7, 0x0f, 0x0f, 0x66, 0x27, 0x16, 0x65, 0x19, 0x46,
+	0xb5, 0x64, 0x05, 0x2e, 0xb3, 0x15, 0x14, 0xfd, 0x1a, 0xb4, 0xe9, 0xd3, 0x90, 0x0d, 0xad, 0x36,
+	0xc2, 0x87, 0x5b, 0x3b, 0x3c, 0x98, 0xbd, 0x7c, 0x37, 0xa7, 0x1e, 0x73, 0x7b, 0x99, 0xff, 0xb1,
+	0x02, 0x13, 0xf3, 0x0e, 0xf5, 0xd9, 0x0e, 0x23, 0xcf, 0xc2, 0x79, 0xda, 0xb1, 0x6c, 0x07, 0x69,
+	0x93, 0xda, 0xbb, 0xd4, 0x0f, 0x6a, 0xc6, 0x8d, 0xea, 0x53, 0x93, 0x0b, 0xe4, 0xf0, 0x60, 0xf6,
+	0xfc, 0x52, 0xa2, 0x06, 0x53, 0x2d, 0xc9, 0x0f, 0x1b, 0x30, 0xf3, 0x80, 0x6e, 0x6e, 0x7b, 0xde,
+	0x4e, 0xdc, 0xbd, 0x72, 0xa3, 0xfa, 0xd4, 0xd4, 0x33, 0x77, 0xcb, 0x7c, 0x46, 0x35, 0xa8, 0xe7,
+	0x93, 0x30, 0x17, 0x6a, 0xf2, 0x7b, 0xcc, 0xa4, 0x2a, 0x02, 0xcc, 0xa0, 0x27, 0x2f, 0xc3, 0x54,
+	0xb3, 0x17, 0x84, 0x5e, 0x07, 0x7b, 0x0e, 0x0d, 0x6a, 0xd5, 0xf2, 0x3b, 0x5c, 0x8d, 0xa6, 0x1e,
+	0x83, 0x5b, 0xb8, 0x70, 0x78, 0x30, 0x3b, 0xa5, 0x15, 0xa0, 0x8e, 0xcc, 0x44, 0xb8, 0x94, 0xd3,
+	0x89, 0xbc, 0x13, 0xce, 0x89, 0x6f, 0xb0, 0x6a, 0x75, 0xef, 0xc5, 0xbb, 0xec, 0x8a, 0x9c, 0xd5,
+	0xb9, 0xba, 0x5e, 0x89, 0xc9, 0xb6, 0xe6, 0x0f, 0x19, 0x70, 0xad, 0x60, 0x5d, 0x06, 0xd8, 0xb5,
+	0x8f, 0x43, 0xb5, 0xe7, 0x3b, 0x7c, 0xcb, 0x4e, 0x2e, 0x4c, 0xc9, 0x06, 0xd5, 0x0d, 0x5c, 0x41,
+	0x56, 0x4e, 0xde, 0x0c, 0xd3, 0x01, 0x75, 0x5b, 0x48, 0x03, 0xcf, 0xd9, 0x8d, 0x76, 0x21, 0x3f,
+	0x40, 0x0d, 0xad, 0x1c, 0x13, 0xad, 0xcc, 0xaf, 0x18, 0x70, 0x75, 0xde, 0x09, 0xa9, 0xef, 0x5a,
+	0xa1, 0xbd, 0x4b, 0x57, 0xc5, 0xee, 0x5f, 0xdf, 0xef, 0x52, 0x36, 0xa2, 0x70, 0xbf, 0x9b, 0x19,
+	0x11, 0xab, 0x43, 0x5e, 0xc3, 0x50, 0x5a, 0x7e, 0x73, 0xdb, 0x0e, 0x69, 0x33, 0xec, 0xf9, 0x54,
+	0x0e, 0x8d, 0xa3, 0x9c, 0xd7, 0xca, 0x31, 0xd1, 0x8a, 0xd8, 0x70, 0xbe, 0xeb, 0x7b, 0xbb, 0x76,
+	0x8b, 0xfa, 0x62, 0xb5, 0x6a, 0xd5, 0x32, 0xa7, 0x90, 0x6f, 0xea, 0xb5, 0x04, 0x20, 0x4c, 0x01,
+	0x36, 0xff, 0xd8, 0x80, 0x73, 0xf3, 0xbd, 0x96, 0x1d, 0x2e, 0x58, 0xcd, 0x1d, 0xea, 0xb6, 0x02,
+	0xf2, 0x22, 0x54, 0x1d, 0xaf, 0x2d, 0x89, 0x65, 0xbd, 0xd4, 0x56, 0x62, 0xf0, 0x56, 0xbc, 0xb6,
+	0x04, 0xb9, 0x30, 0xce, 0xbe, 0xc2, 0x8a, 0xd7, 0x46, 0x06, 0x98, 0xb8, 0x30, 0x2e, 0xb7, 0x71,
+	0xad, 0x32, 0xc4, 0x76, 0x65, 0x38, 0xe4, 0x0e, 0x51, 0x78, 0xa6, 0xd8, 0xc5, 0xa0, 0x76, 0x8d,
+	0x42, 0xc2, 0xae, 0x63, 0x90, 0x33, 0x0c, 0x9b, 0xdb, 0xe4, 0xb5, 0x30, 0xde, 0xb1, 0xf6, 0x1a,
+	0xf6, 0xcb, 0x54, 0xde, 0x75, 0xbc, 0xd7, 0xaa, 0x28, 0x42, 0x55, 0x47, 0x36, 0x78, 0xb3, 0xe7,
+	0x2d, 0x3b, 0x94, 0xa3, 0x9c, 0x2b, 0x5c, 0x7b, 0x3e, 0x38, 0xc6, 0xaa, 0xcc, 0xed, 0xbe, 0x69,
+	0x6e, 0xb1, 0xe7, 0x5b, 0x21, 0x5b, 0x7c, 0x05, 0x96, 0x81, 0x40, 0x05, 0xcb, 0xfc, 0x0b, 0x03,
+	0xa6, 0xf8, 0x60, 0xc4, 0xf2, 0x13, 0x1f, 0xa6, 0x2c, 0xf6, 0x73, 0xcd, 0x73, 0xec, 0xe6, 0xbe,
+	0x5c, 0xf4, 0xf7, 0x96, 0x5e, 0x10, 0x01, 0x46, 0x9c, 0x5b, 0xad, 0x00, 0x75, 0x24, 0x64, 0x07,
+	0x26, 0x36, 0xe5, 0xc7, 0x96, 0x73, 0x9b, 0x2f, 0x8d, 0x50, 0xed, 0x1a, 0x41, 0xcb, 0xd5, 0x2f,
+	0x8c, 0x10, 0x98, 0xbb, 0x70, 0x21, 0xb5, 0x1d, 0x48, 0x13, 0xc6, 0xba, 0x27, 0x3a, 0x5d, 0x7e,
+	0xdb, 0xc8, 0x99, 0x4a, 0xd0, 0xe6, 0x36, 0xe8, 0x0b, 0x40, 0xbe, 0x15, 0xa6, 0x23, 0x42, 0x83,
+	0x74, 0x4b, 0x62, 0x7e, 0x52, 0xfb, 0xa6, 0x0a, 0xfc, 0xdc, 0xfd, 0xcd, 0x0f, 0xd3, 0x66, 0x88,
+	0x74, 0x8b, 0xfa, 0xd4, 0x6d, 0x52, 0x71, 0x58, 0xeb, 0x5a, 0x67, 0x4c, 0x80, 0x32, 0xbf, 0xa2,
+	0x4e, 0xd0, 0xba, 0xdf, 0x73, 0x9b, 0x56, 0x48, 0x49, 0x0b, 0xa6, 0x3b, 0xd6, 0x1e, 0xdf, 0x6e,
+	0xd1, 0x3e, 0x3b, 0x62, 0x03, 0xcd, 0x29, 0x0e, 0x79, 0xee, 0x03, 0x3d, 0xcb, 0x0d, 0xed, 0x70,
+	0x5f, 0xe0, 0x5d, 0xd5, 0xe0, 0x60, 0x02, 0xaa, 0xc4, 0xb2, 0xb4, 0x4b, 0xdd, 0x90, 0x63, 0xa9,
+	0x0c, 0x85, 0x25, 0x82, 0x83, 0x09, 0xa8, 0xe6, 0xf7, 0x8e, 0xc0, 0xa5, 0x9c, 0xb3, 0xa6, 0x48,
+	0xad, 0x51, 0x40, 0x6a, 0xe7, 0x00, 0x82, 0xf8, 0xe2, 0x16, 0x54, 0xef, 0xfc, 0xe1, 0xc1, 0x2c,
+	0x68, 0xd7, 0xb5, 0xd6, 0x42, 0xdb, 0x13, 0xd5, 0x53, 0xdb, 0x13, 0xe4, 0x43, 0x30, 0xba, 0xc9,
+	0x96, 0x8f, 0x33, 0x12, 0x53, 0xcf, 0xbc, 0x67, 0x88, 0x5d, 0x1f, 0x36, 0xb7, 0x17, 0x26, 0x0f,
+	0x0f, 0x66, 0x47, 0xf9, 0xbf, 0x28, 0xe0, 0xb2, 0x93, 0x15, 0xca, 0x4d, 0x50, 0x1b, 0x1d, 0xf2,
+	0x64, 0xa9, 0xdd, 0x24, 0x4e, 0x96, 0xfa, 0x85, 0x11, 0x02, 0xf2, 0x61, 0x38, 0x6f, 0xbb, 0x76,
+	0x68, 0x5b, 0x0e, 0xfb, 0x26, 0xde, 0xd6, 0x56, 0x6d, 0xac, 0x14, 0xa1, 0xe2, 0xb7, 0xc4, 0x72,
+	0x02, 0x12, 0xa6, 0x20, 0xb3, 0x5b, 0x62, 0x66, 0x7e, 0xd7, 0xb2, 0x1d, 0x6b, 0xd3, 0x76, 0xec,
+	0x70, 0xff, 0x83, 0x9e, 0x4b, 0x07, 0xb8, 0x8f, 0x37, 0xe0, 0x5a, 0xcf, 0xb5, 0x44, 0x3f, 0x47,
+	0xbf, 0x39, 0x05, 0xdf, 0x34, 0xb9, 0xf0, 0xe8, 0xe1, 0xc1, 0xec, 0xb5, 0x8d, 0xfc, 0x26, 0x58,
+	0xd4, 0x97, 0xbd, 0x5e, 0xb4, 0xaa, 0xe7, 0x3c, 0xa7, 0xd7, 0x91, 0x50, 0xab, 0x1c, 0x2a, 0x7f,
+	0xbd, 0x6c, 0xe4, 0xb6, 0xc0, 0x82, 0x9e, 0xe6, 0x97, 0x2a, 0x30, 0xcd, 0x66, 0xdb, 0xeb, 0x2e,
+	0xf4, 0x9a, 0x3b, 0x34, 0x24, 0xdf, 0x01, 0x13, 0x6c, 0xa9, 0x5a, 0x56, 0x68, 0xc9, 0x03, 0xfc,
+	0xcd, 0x83, 0x2d, 0xac, 0xa0, 0x1f, 0xab, 0x34, 0xb4, 0x16, 0x88, 0x5c, 0x13, 0x88, 0xcb, 0x30,
+	0x82, 0x4a, 0xb6, 0x60, 0x24, 0xe8, 0xd2, 0xa6, 0x3c, 0xb8, 0x8b, 0x65, 0x76, 0x8a, 0x3e, 0xe2,
+	0x46, 0x97, 0x36, 0xe3, 0xaf, 0xc0, 0x7e, 0x21, 0x87, 0x4f, 0x5c, 0x18, 0x0b, 0x42, 0x2b, 0xec,
+	0x29, 0xf6, 0xf0, 0xd6, 0xd0, 0x98, 0x38, 0xb4, 0x85, 0xf3, 0x12, 0xd7, 0x98, 0xf8, 0x8d, 0x12,
+	0x8b, 0xf9, 0xfb, 0x06, 0xcc, 0xe8, 0xcd, 0x57, 0xec, 0x20, 0x24, 0xdf, 0x9e, 0x59, 0xce, 0x01,
+	0xf7, 0x29, 0xeb, 0xcd, 0x17, 0x73, 0x46, 0xa2, 0x9b, 0x50, 0x25, 0xda, 0x52, 0x52, 0x18, 0xb5,
+	0x43, 0xda, 0x51, 0xec, 0xf8, 0xfb, 0x86, 0x9d, 0xe1, 0xc2, 0x39, 0x89, 0x6c, 0x74, 0x99, 0x81,
+	0x45, 0x01, 0xdd, 0xfc, 0x0e, 0xb8, 0xac, 0xb7, 0x52, 0xac, 0xd5, 0x00, 0x7c, 0xe0, 0xeb, 0x60,
+	0xcc, 0xa7, 0x6d, 0xdb, 0x73, 0x15, 0x2d, 0x54, 0x6b, 0x87, 0xbc, 0x14, 0x65, 0xad, 0xf9, 0xaf,
+	0xab, 0x70, 0x4d, 0x47, 0x81, 0xb4, 0xeb, 0xd8, 0x4d, 0x7e, 0x50, 0xc9, 0x2e, 0x4c, 0x28, 0xe6,
+	0x4d, 0x2e, 0xe1, 0x9d, 0x61, 0xe7, 0xa9, 0x66, 0x10, 0x2f, 0xae, 0x2a, 0xc1, 0x08, 0x57, 0x0e,
+	0x37, 0x5a, 0x39, 0x25, 0x6e, 0x94, 0xac, 0xc3, 0xa4, 0xb8, 0x14, 0xd8, 0x1d, 0x5d, 0x2d, 0xbe,
+	0xa3, 0x1b, 0xaa, 0x91, 0xbc, 0xa3, 0x2f, 0xca, 0xe1, 0x4f, 0x46, 0x15, 0x18, 0x03, 0x62, 0x4c,
+	0x38, 0xbf, 0x39, 0xd9, 0xbc, 0xe7, 0xdb, 0xe2, 0x1d, 0x39, 0xaa, 0xdd, 0xaf, 0xb2, 0x1c, 0x13,
+	0xad, 0xd8, 0x15, 0x16, 0xfd, 0x0e, 0x38, 0x39, 0x1f, 0x15, 0x57, 0x58, 0xd4, 0x27, 0x40, 0xad,
+	0x85, 0xf9, 0x3f, 0xaa, 0xc9, 0x6d, 0xcf, 0x4e, 0xe0, 0x37, 0xbe, 0x59, 0xd9, 0x6f, 0xf6, 0x14,
+	0x4c, 0x04, 0x94, 0xb6, 0xb4, 0x77, 0x3f, 0xbf, 0x07, 0x1b, 0xb2, 0x0c, 0xa3, 0x5a, 0xf2, 0x9d,
+	0x30, 0xe5, 0xc7, 0xa7, 0x44, 0xde, 0xbb, 0x77, 0x87, 0x5d, 0x65, 0xed, 0xe0, 0x09, 0x76, 0x5a,
+	0x2b, 0x40, 0x1d, 0xa1, 0xf9, 0x85, 0x11, 0x20, 0x59, 0xea, 0xa8, 0x7f, 0x01, 0x51, 0x52, 0x33,
+	0x86, 0xfe, 0x02, 0x92, 0xd0, 0xa6, 0x00, 0x93, 0x97, 0xe1, 0x9c, 0x63, 0x05, 0xe1, 0xfd, 0x2e,
+	0x15, 0x57, 0xfa, 0x30, 0x5c, 0xfd, 0x8a, 0x0e, 0x68, 0xe1, 0x22, 0x7b, 0xb0, 0x27, 0x8a, 0x30,
+	0x89, 0x8a, 0x7c, 0x18, 0x26, 0x59, 0xc1, 0x92, 0xef, 0x7b, 0xbe, 0xfc, 0xfa, 0xef, 0x2e, 0x8b,
+	0x97, 0x03, 0x11, 0x62, 0xd1, 0xe8, 0x27, 0xc6, 0xe0, 0xc9, 0xfb, 0x81, 0x78, 0x9b, 0x5c, 0x30,
+	0xdd, 0xba, 0x4d, 0x5d, 0x35, 0x59, 0xb6, 0x3b, 0xaa, 0x0b, 0xd7, 0xe5, 0x6e, 0x22, 0xf7, 0x33,
+	0x2d, 0x30, 0xa7, 0x17, 0xd9, 0x01, 0x12, 0xc9, 0x6d, 0xa3, 0x0d, 0x58, 0x1b, 0x1d, 0x7c, 0xfb,
+	0x5e, 0x65, 0xc8, 0x6e, 0x67, 0x40, 0x60, 0x0e, 0x58, 0xf3, 0x57, 0x2b, 0x30, 0x25, 0xb6, 0xc8,
+	0x92, 0x1b, 0xfa, 0xfb, 0x67, 0xc0, 0x5b, 0xd0, 0x04, 0x6f, 0x51, 0x2f, 0x7f, 0x1a, 0xf8, 0x80,
+	0x0b, 0x59, 0x8b, 0x4e, 0x8a, 0xb5, 0x58, 0x1a, 0x16, 0x51, 0x7f, 0xce, 0xe2, 0x3f, 0x18, 0x70,
+	0x41, 0x6b, 0x7d, 0x06, 0x8c, 0x45, 0x2b, 0xc9, 0x58, 0xbc, 0x77, 0xc8, 0xf9, 0x15, 0xf0, 0x15,
+	0xbf, 0x59, 0x81, 0xc7, 0xb4, 0x56, 0x1a, 0xa9, 0x91, 0x27, 0xbc, 0x07, 0x97, 0xd8, 0x31, 0xd0,
+	0x2a, 0xd6, 0xed, 0x8e, 0x7a, 0x58, 0x7e, 0xd3, 0x60, 0xf3, 0x65, 0x3d, 0x84, 0xcc, 0x7a, 0x25,
+	0x0b, 0x0a, 0xf3, 0xe0, 0x93, 0x65, 0xa8, 0x3a, 0x56, 0xbb, 0xa4, 0x00, 0x44, 0x48, 0x7d, 0x2c,
+	0x26, 0xf5, 0xb1, 0xda, 0x67, 0x49, 0x27, 0x4c, 0x2f, 0xb1, 0x4b, 0xf8, 0x3d, 0xfc, 0x0c, 0xc0,
+	0x26, 0xa7, 0xce, 0x9a, 0x44, 0x32, 0x3a, 0x41, 0x0b, 0x51, 0x0d, 0x6a, 0xad, 0x12, 0x57, 0x50,
+	0xa5, 0xdf, 0x15, 0x64, 0xfe, 0xf9, 0x08, 0x5c, 0xcc, 0xec, 0xe2, 0x2c, 0x59, 0x36, 0xbe, 0x46,
+	0x64, 0xb9, 0xf2, 0xb5, 0x20, 0xcb, 0xd5, 0x52, 0x64, 0x79, 0xf0, 0x6b, 0xdf, 0x07, 0xd2, 0xb1,
+	0xdb, 0xbe, 0x3a, 0x25, 0x7e, 0xc8, 0x4f, 0xc4, 0xe8, 0xb1, 0x4f, 0x04, 0xa7, 0xe3, 0xab, 0x19,
+	0x48, 0x98, 0x03, 0x9d, 0x7c, 0xaf, 0x91, 0xe4, 0x35, 0xc4, 0x83, 0x7b, 0x6d, 0x48, 0xa2, 0x90,
+	0x39, 0xee, 0x47, 0x30, 0x1c, 0xdf, 0x5b, 0x81, 0xf1, 0x05, 0x2b, 0xe0, 0xeb, 0xf5, 0x31, 0x98,
+	0x96, 0x13, 0x5c, 0xee, 0x58, 0x6d, 0x3a, 0x8c, 0x8a, 0x4b, 0x82, 0x5c, 0xd5, 0xc0, 0x29, 0x1e,
+	0x39, 0x2e, 0xc1, 0x04, 0x3a, 0xb2, 0x0f, 0x53, 0x9d, 0xf8, 0x65, 0x5e, 0xab, 0x0c, 0xf3, 0xbe,
+	0xd4, 0xb1, 0x33, 0x68, 0x62, 0x15, 0xb4, 0x02, 0xd4, 0x71, 0x99, 0x2f, 0xc2, 0xa5, 0x9c, 0x11,
+	0x0f, 0x20, 0x94, 0x78, 0x2d, 0x8c, 0x33, 0xd5, 0x49, 0xfc, 0x16, 0xe3, 0x92, 0xda, 0xe7, 0x44,
+	0x11, 0xaa, 0x3a, 0xf3, 0xad, 0x40, 0x92, 0xf0, 0x95, 0xc4, 0xbf, 0x3f, 0x78, 0xf3, 0x77, 0x46,
+	0x00, 0xea, 0xf3, 0xe8, 0x85, 0x62, 0x43, 0xbf, 0x17, 0x46, 0xbb, 0xdb, 0x56, 0xa0, 0x7a, 0x3c,
+	0xad, 0xe8, 0xff, 0x1a, 0x2b, 0x7c, 0x78, 0x30, 0x5b, 0xab, 0xfb, 0xb4, 0x45, 0xdd, 0xd0, 0xb6,
+	0x9c, 0x40, 0x75, 0xe2, 0x75, 0x28, 0xfa, 0xb1, 0x7d, 0xce, 0x8e, 0x5a, 0xdd, 0xeb, 0x74, 0x1d,
+	0x1a, 0x51, 0xfe, 0x4a, 0xb9, 0x7d, 0xbe, 0x92, 0x81, 0x84, 0x39, 0xd0, 0x15, 0x4e, 0x21, 0x14,
+	0x8a, 0x70, 0x56, 0xcb, 0xe3, 0x4c, 0x42, 0xc2, 0x1c, 0xe8, 0xe4, 0x93, 0x06, 0x5c, 0x4f, 0x16,
+	0xdf, 0xb2, 0x5d, 0x3b, 0xd8, 0xa6, 0xad, 0x75, 0x5b, 0x12, 0x83, 0xe3, 0x21, 0x7f, 0xe2, 0xf0,
+	0x60, 0xf6, 0xfa, 0x4a, 0x21, 0x44, 0xec, 0x83, 0x8d, 0x7c, 0xca, 0x80, 0x47, 0x53, 0xeb, 0xe2,
+	0xdb, 0xed, 0x36, 0xf5, 0x69, 0xab, 0x24, 0x99, 0x99, 0x3d, 0x3c, 0x98, 0x7d, 0x74, 0xa5, 0x18,
+	0x24, 0xf6, 0xc3, 0x67, 0xfe, 0x62, 0x05, 0xaa, 0x75, 0x5c, 0x26, 0xaf, 0x4f, 0x6c, 0xbf, 0x6b,
+	0xfa, 0xf6, 0x7b, 0x78, 0x30, 0x3b, 0x5e, 0xc7, 0x65, 0x6d, 0xa3, 0x7f, 0xca, 0x80, 0x8b, 0x4d,
+	0xcf, 0x0d, 0x2d, 0x36, 0x2e, 0x14, 0x8f, 0x0b, 0xc5, 0xc8, 0x94, 0x92, 0x36, 0xd5, 0x53, 0xc0,
+	0x16, 0x1e, 0x91, 0x03, 0xb8, 0x98, 0xae, 0x09, 0x30, 0x8b, 0x99, 0x69, 0xc0, 0x6d, 0x76, 0x46,
+	0xd7, 0x7c, 0xba, 0xd6, 0x73, 0x1c, 0xb9, 0x9f, 0x4a, 0xc9, 0x6a, 0x96, 0x35, 0x38, 0x82, 0x48,
+	0xe9, 0x25, 0x98, 0xc0, 0x63, 0x7e, 0xd9, 0x80, 0xe9, 0xba, 0xe3, 0xf5, 0x5a, 0x6b, 0xbe, 0xb7,
+	0x65, 0x3b, 0xf4, 0x95, 0x21, 0xda, 0xd3, 0x47, 0x5c, 0xc4, 0x7f, 0x73, 0x51, 0x9b, 0xde, 0xf0,
+	0x15, 0x22, 0x6a, 0xd3, 0x87, 0x5c, 0xc0, 0x12, 0x7f, 0x1b, 0x5c, 0xd1, 0x5b, 0x45, 0xef, 0x2e,
+	0x46, 0x81, 0x77, 0x6c, 0xb7, 0x95, 0xa6, 0xc0, 0x77, 0x6d, 0xb7, 0x85, 0xbc, 0x26, 0xa2, 0xd1,
+	0x95, 0x42, 0x1a, 0xfd, 0x57, 0xe3, 0xc9, 0x65, 0xe3, 0x2c, 0xe2, 0x53, 0x30, 0xd1, 0xb4, 0x16,
+	0x7a, 0x6e, 0xcb, 0x89, 0xc8, 0x3b, 0x5b, 0x82, 0xfa, 0xbc, 0x28, 0xc3, 0xa8, 0x96, 0xbc, 0x0c,
+	0x10, 0x5b, 0x78, 0x0c, 0x73, 0xe9, 0xc5, 0xc6, 0x23, 0x0d, 0x1a, 0x86, 0xb6, 0xdb, 0x0e, 0xe2,
+	0x7d, 0x15, 0xd7, 0xa1, 0x86, 0x8d, 0x7c, 0x0c, 0xce, 0xe9, 0x37, 0xb0, 0x10, 0x79, 0x97, 0xfc,
+	0x0c, 0x89, 0xab, 0x3e, 0xd2, 0xcf, 0xeb, 0xa5, 0x01, 0x26, 0xb1, 0x91, 0xfd, 0x88, 0xdf, 0x10,
+	0x02, 0xf7, 0x91, 0xf2, 0xcf, 0x22, 0xfd, 0xaa, 0xbf, 0x2c, 0x91, 0x4f, 0x27, 0x14, 0x00, 0x09,
+	0x54, 0x39, 0x22, 0xad, 0xd1, 0xd3, 0x12, 0x69, 0x51, 0x18, 0x17, 0xf2, 0xd8, 0xa0, 0x36, 0xc6,
+	0x27, 0xf8, 0x6c, 0x99, 0x09, 0x0a, 0xd1, 0x6e, 0x6c, 0xb2, 0x24, 0x7e, 0x07, 0xa8, 0x60, 0x33,
+	0x82, 0xc8, 0xd8, 0xd9, 0x06, 0x75, 0x68, 0x33, 0xf4, 0xfc, 0xda, 0x78, 0x79, 0x82, 0xd8, 0xd0,
+	0xe0, 0x28, 0x8b, 0x86, 0xb8, 0x04, 0x13, 0x78, 0x22, 0x71, 0xf5, 0x44, 0xa1, 0xb8, 0xba, 0x07,
+	0x53, 0xbb, 0x9a, 0x5a, 0x65, 0xf2, 0x46, 0xb5, 0xac, 0xbe, 0x2c, 0xd6, 0xb1, 0x2c, 0x5c, 0x92,
+	0x88, 0xa6, 0x74, 0x7d, 0x8c, 0x8e, 0x87, 0x6c, 0xc2, 0xf8, 0xa6, 0xe0, 0xb9, 0x6a, 0xc0, 0xd7,
+	0xe2, 0x9d, 0x43, 0xb0, 0x92, 0x82, 0xaf, 0x93, 0x3f, 0x50, 0x01, 0x36, 0xbf, 0x38, 0x05, 0x17,
+	0xeb, 0x4e, 0x2f, 0x08, 0xa9, 0x3f, 0x2f, 0x6d, 0x2c, 0xa9, 0x4f, 0xbe, 0xc7, 0x80, 0xab, 0xfc,
+	0xdf, 0x45, 0xef, 0x81, 0xbb, 0x48, 0x1d, 0x6b, 0x7f, 0x7e, 0x8b, 0xb5, 0x68, 0xb5, 0x6a, 0x46,
+	0xa9, 0xd7, 0x2f, 0xd7, 0x41, 0x35, 0x72, 0x21, 0x62, 0x01, 0x26, 0xf2, 0x03, 0x06, 0x3c, 0x92,
+	0x53, 0xb5, 0x48, 0x1d, 0x1a, 0xd2, 0x92, 0xaf, 0xf0, 0xc7, 0x0f, 0x0f, 0x66, 0x1f, 0x69, 0x14,
+	0x01, 0xc5, 0x62, 0x7c, 0xe4, 0xd3, 0x06, 0x5c, 0xcf, 0xa9, 0xbd, 0x65, 0xd9, 0x0e, 0xb3, 0x64,
+	0xa9, 0x96, 0x1a, 0x0e, 0x67, 0xca, 0x1a, 0x85, 0x50, 0xb1, 0x0f, 0x46, 0xf2, 0x71, 0xb8, 0x12,
+	0xd5, 0x6e, 0xb8, 0x2e, 0xa5, 0xad, 0x04, 0x6f, 0x78, 0xdc, 0xa1, 0x3c, 0x72, 0x78, 0x30, 0x7b,
+	0xa5, 0x91, 0x07, 0x10, 0xf3, 0xf1, 0x90, 0x36, 0x3c, 0x1e, 0x57, 0x84, 0xb6, 0x63, 0xbf, 0x2c,
+	0xd8, 0xd7, 0x6d, 0x9f, 0x06, 0xdb, 0x9e, 0xd3, 0xe2, 0x04, 0xc9, 0x58, 0x78, 0xf5, 0xe1, 0xc1,
+	0xec, 0xe3, 0x8d, 0x7e, 0x0d, 0xb1, 0x3f, 0x1c, 0xa6, 0xda, 0x0f, 0x9a, 0x96, 0xbb, 0xec, 0x86,
+	0xd4, 0xdf, 0xb5, 0x9c, 0x92, 0x8a, 0x5d, 0x41, 0x06, 0x34, 0x38, 0x98, 0x80, 0x4a, 0xde, 0x0e,
+	0x13, 0x74, 0xaf, 0x6b, 0xb9, 0x2d, 0x2a, 0x48, 0xcf, 0xe4, 0xc2, 0x63, 0xec, 0xc2, 0x5b, 0x92,
+	0x65, 0x0f, 0x0f, 0x66, 0xa7, 0xd5, 0xff, 0xab, 0x5e, 0x8b, 0x62, 0xd4, 0x9a, 0x7c, 0x14, 0x2e,
+	0x73, 0x23, 0xd0, 0x16, 0xe5, 0x84, 0x34, 0x50, 0x2f, 0x84, 0x89, 0x52, 0xe3, 0xe4, 0x06, 0x7d,
+	0xab, 0x39, 0xf0, 0x30, 0x17, 0x0b, 0xfb, 0x0c, 0x1d, 0x6b, 0xef, 0xb6, 0x6f, 0x35, 0xe9, 0x56,
+	0xcf, 0x59, 0xa7, 0x7e, 0xc7, 0x76, 0xc5, 0xeb, 0x99, 0x36, 0x3d, 0x66, 0xd4, 0x32, 0xc9, 0x75,
+	0x35, 0xfc, 0x33, 0xac, 0xf6, 0x6b, 0x88, 0xfd, 0xe1, 0x30, 0xbd, 0x91, 0xdd, 0x76, 0x3d, 0x9f,
+	0xae, 0x5b, 0xb6, 0x1b, 0x06, 0x35, 0xe0, 0xda, 0x65, 0xc1, 0x6e, 0x6a, 0xe5, 0x98, 0x68, 0x45,
+	0x76, 0x81, 0xb8, 0xf4, 0xc1, 0x9a, 0xd7, 0xe2, 0x5b, 0x60, 0xa3, 0xcb, 0x37, 0x72, 0x6d, 0xaa,
+	0xd4, 0xd2, 0xf0, 0x07, 0xd4, 0xbd, 0x0c, 0x34, 0xcc, 0xc1, 0x40, 0x6e, 0x01, 0x61, 0x96, 0x1b,
+	0x9d, 0x6e, 0xb8, 0xbf, 0xd0, 0x73, 0x76, 0x24, 0xd5, 0x98, 0xe6, 0x6b, 0x21, 0x84, 0x1c, 0x99,
+	0x5a, 0xcc, 0xe9, 0x41, 0x2c, 0x78, 0x54, 0xcc, 0x67, 0xd1, 0xa2, 0x1d, 0xcf, 0x0d, 0x68, 0x18,
+	0x68, 0x9b, 0xb4, 0x76, 0x8e, 0x1b, 0xcd, 0xf1, 0xe7, 0xcc, 0x72, 0x71, 0x33, 0xec, 0x07, 0x23,
+	0x69, 0x0c, 0x7d, 0xbe, 0xbf, 0x31, 0xb4, 0xf9, 0xdf, 0x47, 0xa0, 0x96, 0x21, 0xd8, 0xf7, 0xbb,
+	0x21, 0xbf, 0x42, 0x8f, 0x3c, 0x92, 0xc6, 0x09, 0x1d, 0xc9, 0x2e, 0xdc, 0x88, 0x1a, 0xdc, 0xee,
+	0xf6, 0x72, 0x71, 0x55, 0x38, 0xae, 0xd7, 0x1c, 0x1e, 0xcc, 0xde, 0x68, 0x1c, 0xd1, 0x16, 0x8f,
+	0x84, 0x56, 0x4c, 0xee, 0xaa, 0x67, 0x44, 0xee, 0x3e, 0x0a, 0x97, 0xb5, 0x0a, 0x9f, 0x5a, 0xad,
+	0xfd, 0x21, 0xc8, 0x2d, 0x3f, 0xe5, 0x8d, 0x1c, 0x78, 0x98, 0x8b, 0xa5, 0x90, 0xc6, 0x8c, 0x9e,
+	0x05, 0x8d, 0x31, 0x0f, 0xaa, 0x30, 0x59, 0xf7, 0xdc, 0x96, 0xcd, 0xf7, 0xeb, 0x9b, 0x12, 0xfa,
+	0xfd, 0xc7, 0x75, 0x86, 0xe9, 0xa1, 0xb0, 0x68, 0x15, 0x0d, 0x35, 0x0e, 0xea, 0x1d, 0x91, 0x66,
+	0x44, 0x3c, 0x43, 0x5e, 0x9d, 0x54, 0x69, 0x3c, 0x3c, 0x98, 0xbd, 0x10, 0x75, 0x4b, 0x6a, 0x39,
+	0x18, 0x01, 0x61, 0xb2, 0x80, 0x75, 0xdf, 0x72, 0x03, 0x7b, 0x08, 0xe9, 0x4b, 0x24, 0x7b, 0x5d,
+	0xc9, 0x40, 0xc3, 0x1c, 0x0c, 0xcc, 0xa0, 0x88, 0x95, 0x6e, 0x74, 0x5b, 0x56, 0x48, 0x4b, 0x0a,
+	0x5d, 0xae, 0x4a, 0x9c, 0xe7, 0x57, 0x12, 0x90, 0x30, 0x05, 0x59, 0xd8, 0x43, 0x58, 0x81, 0xd4,
+	0xd7, 0x26, 0xec, 0x21, 0xac, 0x40, 0xd8, 0x43, 0x58, 0x81, 0x70, 0x00, 0xe8, 0xd0, 0x20, 0x60,
+	0xa2, 0xcd, 0x31, 0xde, 0x30, 0xe2, 0xa6, 0x57, 0x45, 0x31, 0xaa, 0x7a, 0xf2, 0x06, 0x18, 0x6d,
+	0x7a, 0x2d, 0x1a, 0xd4, 0xc6, 0x39, 0x99, 0x66, 0x24, 0x6f, 0xb4, 0xce, 0x0a, 0x1e, 0x1e, 0xcc,
+	0x4e, 0x72, 0x49, 0x35, 0xfb, 0x85, 0xa2, 0x91, 0xf9, 0xe3, 0xec, 0xe5, 0x9c, 0x12, 0x51, 0x0c,
+	0x60, 0xc7, 0x71, 0x76, 0x7a, 0x75, 0xf3, 0x33, 0x4c, 0x6c, 0xe1, 0xb9, 0xa1, 0xef, 0x39, 0x6b,
+	0x8e, 0xe5, 0x52, 0xf2, 0x7d, 0x06, 0xcc, 0x6c, 0xdb, 0xed, 0x6d, 0xdd, 0x10, 0xab, 0x66, 0x94,
+	0x97, 0x30, 0xdc, 0x49, 0xc1, 0x5a, 0xb8, 0xcc, 0x8c, 0xce, 0xd3, 0xa5, 0x98, 0xc1, 0x69, 0x7e,
+	0xa2, 0x02, 0x97, 0xe5, 0xc8, 0x1c, 0xc6, 0x2e, 0x76, 0x1d, 0x6f, 0xbf, 0x43, 0xdd, 0xb3, 0xb0,
+	0x99, 0x52, 0x5f, 0xa8, 0x52, 0xf8, 0x85, 0x3a, 0x27, 0x63, 0x3b, 0x1d, 0x6d, 0xe4, 0x23, 0xbe,
+	0xd2, 0x9f, 0x19, 0x50, 0xcb, 0x5b, 0x8b, 0x33, 0x90, 0xc4, 0x74, 0x92, 0x92, 0x98, 0x3b, 0x65,
+	0x45, 0x7a, 0xe9, 0xa1, 0x17, 0x48, 0x64, 0xfe, 0xb4, 0x02, 0x57, 0xe3, 0xe6, 0xcb, 0x6e, 0x10,
+	0x5a, 0x8e, 0x23, 0xee, 0xf3, 0xd3, 0xff, 0xee, 0xdd, 0x84, 0x40, 0xed, 0xde, 0x70, 0x53, 0xd5,
+	0xc7, 0x5e, 0xa8, 0xda, 0xde, 0x4b, 0xa9, 0xb6, 0xd7, 0x4e, 0x10, 0x67, 0x7f, 0x2d, 0xf7, 0x9f,
+	0x1b, 0x70, 0x3d, 0xbf, 0xe3, 0x19, 0x6c, 0x2a, 0x2f, 0xb9, 0xa9, 0xde, 0x7f, 0x72, 0xb3, 0x2e,
+	0xd8, 0x56, 0x3f, 0x57, 0x29, 0x9a, 0x2d, 0x97, 0xca, 0x6d, 0xc1, 0x05, 0x9f, 0xb6, 0xed, 0x20,
+	0x94, 0x4a, 0xc3, 0xe3, 0xd9, 0x6e, 0x2b, 0x09, 0xf9, 0x05, 0x4c, 0xc2, 0xc0, 0x34, 0x50, 0x72,
+	0x0f, 0xc6, 0x99, 0x8c, 0x84, 0xc1, 0xaf, 0x0c, 0x0e, 0x3f, 0xba, 0x8d, 0x1a, 0xa2, 0x2f, 0x2a,
+	0x20, 0xe4, 0xdb, 0xe1, 0x5c, 0x2b, 0x3a, 0x51, 0x47, 0x58, 0x46, 0xa5, 0xa1, 0x72, 0xf5, 0xee,
+	0xa2, 0xde, 0x1b, 0x93, 0xc0, 0xcc, 0xff, 0x63, 0xc0, 0x63, 0xfd, 0xf6, 0x16, 0x79, 0x09, 0xa0,
+	0xa9, 0xd8, 0x0b, 0xe1, 0xe3, 0x54, 0x52, 0x01, 0x1c, 0x31, 0x29, 0xf1, 0x01, 0x8d, 0x8a, 0x02,
+	0xd4, 0x90, 0xe4, 0x18, 0x3c, 0x55, 0x4e, 0xc9, 0xe0, 0xc9, 0xfc, 0xaf, 0x86, 0x4e, 0x8a, 0xf4,
+	0x6f, 0xfb, 0x4a, 0x23, 0x45, 0xfa, 0xd8, 0x0b, 0xa5, 0xfc, 0xbf, 0x5b, 0x81, 0x1b, 0xf9, 0x5d,
+	0xb4, 0xbb, 0xf7, 0x7d, 0x09, 0x0b, 0xfa, 0xc9, 0x85, 0xa7, 0x62, 0x03, 0xf8, 0x87, 0x07, 0xb3,
+	0xd7, 0xf3, 0x08, 0x7d, 0xca, 0x3c, 0xde, 0x4e, 0x89, 0x23, 0x05, 0xf7, 0xf7, 0x2d, 0x03, 0x12,
+	0x17, 0x6b, 0x93, 0x3a, 0x03, 0x4b, 0x20, 0xbf, 0xdb, 0x80, 0xf3, 0x89, 0x1d, 0xcd, 0x0c, 0x2c,
+	0xab, 0x65, 0x8d, 0x23, 0x12, 0x47, 0x25, 0xbe, 0xb9, 0x13, 0xc5, 0x01, 0xa6, 0x10, 0xa6, 0xc8,
+	0xac, 0xbe, 0xaa, 0xaf, 0x38, 0x32, 0xab, 0x0f, 0xbe, 0x80, 0xcc, 0xfe, 0x68, 0xa5, 0x68, 0xb6,
+	0x9c, 0xcc, 0x3e, 0x80, 0x49, 0xe5, 0x1b, 0xa2, 0xc8, 0xc5, 0xad, 0x61, 0xc7, 0x24, 0xc0, 0xc5,
+	0x76, 0x9e, 0xaa, 0x24, 0xc0, 0x18, 0x17, 0xf9, 0xff, 0x0c, 0x80, 0xf8, 0xc3, 0xc8, 0x43, 0xb5,
+	0x7e, 0x72, 0xcb, 0xa1, 0xb1, 0x35, 0xdc, 0x78, 0x37, 0xfe, 0x8d, 0x1a, 0x5e, 0xf3, 0xaf, 0xaa,
+	0x40, 0xb2, 0x63, 0x1f, 0x4c, 0xd9, 0x74, 0x04, 0x43, 0xfa, 0x6e, 0xb8, 0xd0, 0x76, 0xbc, 0x4d,
+	0xcb, 0x71, 0xf6, 0xa5, 0xd3, 0xb2, 0x74, 0x3c, 0xbc, 0xc4, 0x2e, 0xa6, 0xdb, 0xc9, 0x2a, 0x4c,
+	0xb7, 0x25, 0x5d, 0x98, 0xf1, 0x99, 0x3c, 0xaa, 0x69, 0x3b, 0xfc, 0xe9, 0xe4, 0xf5, 0xc2, 0x92,
+	0x2f, 0x70, 0xce, 0xde, 0x63, 0x0a, 0x16, 0x66, 0xa0, 0x33, 0x03, 0x89, 0xae, 0x6f, 0x77, 0x2c,
+	0x7f, 0x9f, 0x3f, 0xce, 0x26, 0x84, 0x20, 0x7d, 0x4d, 0x14, 0xa1, 0xaa, 0x23, 0x1f, 0x85, 0x49,
+	0xc7, 0xde, 0xa2, 0xcd, 0xfd, 0xa6, 0x43, 0xa5, 0x84, 0xf2, 0xfe, 0xc9, 0x6c, 0x99, 0x15, 0x05,
+	0x56, 0x1a, 0x1d, 0xa9, 0x9f, 0x18, 0x23, 0x64, 0xde, 0xe6, 0x0f, 0x3c, 0x7f, 0x87, 0xfa, 0x0e,
+	0x0d, 0x82, 0x46, 0xaf, 0xdb, 0xf5, 0xfc, 0x90, 0xb6, 0xb8, 0x1c, 0x73, 0x42, 0x58, 0xb9, 0x3d,
+	0x9f, 0xad, 0xc6, 0xbc, 0x3e, 0xe6, 0x27, 0x2b, 0xf0, 0x68, 0x9f, 0x41, 0x10, 0x84, 0xc9, 0x68,
+	0x8d, 0xe4, 0x4e, 0x78, 0xb3, 0xd8, 0xcf, 0xb2, 0xf0, 0xe1, 0xc1, 0xec, 0x93, 0x7d, 0x00, 0x34,
+	0xd8, 0x56, 0xa4, 0xed, 0x7d, 0x8c, 0xc1, 0x90, 0x65, 0x18, 0x6b, 0xc5, 0x62, 0xfd, 0xc9, 0x85,
+	0x37, 0x31, 0x6a, 0x2d, 0x04, 0x70, 0x83, 0x42, 0x93, 0x00, 0xc8, 0x0a, 0x8c, 0x0b, 0x53, 0x25,
+	0x2a, 0x29, 0xff, 0x33, 0xfc, 0x79, 0x2c, 0x8a, 0x06, 0x05, 0xa6, 0x40, 0x98, 0xff, 0xcb, 0x80,
+	0xf1, 0x3a, 0x13, 0xdc, 0xdd, 0x6b, 0x30, 0xeb, 0x1e, 0x2d, 0x0c, 0x85, 0xa4, 0x82, 0x25, 0xc9,
+	0x02, 0x87, 0x38, 0x1f, 0x43, 0x53, 0x3e, 0x8a, 0x51, 0x01, 0xea, 0xb8, 0xc8, 0x4b, 0x6c, 0xcd,
+	0x1f, 0xf8, 0x76, 0xc8, 0x10, 0x0f, 0xa3, 0x45, 0x17, 0x88, 0x51, 0xc1, 0x12, 0x3b, 0x2a, 0xfa,
+	0x89, 0x31, 0x16, 0x73, 0x0d, 0x88, 0x6c, 0xad, 0x8d, 0x8a, 0x3c, 0x0b, 0x23, 0x1d, 0xaf, 0xa5,
+	0xbe, 0xfb, 0xeb, 0xd4, 0xf9, 0x66, 0x02, 0xf1, 0x87, 0x07, 0xb3, 0x57, 0xb3, 0x3d, 0x58, 0x0d,
+	0xf2, 0x3e, 0xe6, 0x3d, 0x98, 0x91, 0xf5, 0x11, 0x42, 0xe6, 0x80, 0xde, 0xf4, 0x3a, 0x1d, 0xcf,
+	0x6d, 0xf4, 0xb6, 0xb6, 0xec, 0x3d, 0x9a, 0x70, 0x40, 0xaf, 0x27, 0x6a, 0x30, 0xd5, 0xd2, 0xfc,
+	0xbc, 0x01, 0x55, 0xf6, 0x5d, 0x4c, 0x18, 0x6b, 0x79, 0x1d, 0xcb, 0x76, 0xe5, 0xa8, 0xb8, 0xaf,
+	0xdb, 0x22, 0x2f, 0x41, 0x59, 0x43, 0xba, 0x30, 0xa9, 0x98, 0xa6, 0xa1, 0x8c, 0x57, 0x17, 0xef,
+	0x35, 0x22, 0x87, 0x83, 0x88, 0x92, 0xab, 0x92, 0x00, 0x63, 0x24, 0xa6, 0x05, 0x17, 0x17, 0xef,
+	0x35, 0x96, 0xdd, 0xa6, 0xd3, 0x6b, 0xd1, 0xa5, 0x3d, 0xfe, 0x87, 0xd1, 0x12, 0x5b, 0x94, 0xc8,
+	0x79, 0x72, 0x5a, 0x22, 0x1b, 0xa1, 0xaa, 0x63, 0xcd, 0xa8, 0xe8, 0x51, 0xab, 0xc4, 0xcd, 0x24,
+	0x10, 0x54, 0x75, 0xe6, 0x97, 0x2b, 0x30, 0xa5, 0x0d, 0x88, 0x38, 0x30, 0x2e, 0xa6, 0xab, 0x8c,
+	0xeb, 0x97, 0x4a, 0x4e, 0x31, 0x39, 0x6a, 0x81, 0x5d, 0x2c, 0x68, 0x80, 0x0a, 0x85, 0x4e, 0x17,
+	0x2b, 0x7d, 0xe8, 0x62, 0xd2, 0xf5, 0xb1, 0x7a, 0xa4, 0xeb, 0xe3, 0x63, 0xf2, 0x06, 0x11, 0xe6,
+	0x8e, 0x13, 0xa9, 0xdb, 0x63, 0x0b, 0x46, 0x5f, 0xf6, 0x5c, 0x1a, 0xd4, 0x46, 0x4f, 0x72, 0x82,
+	0xdc, 0x75, 0x91, 0x39, 0xf1, 0x05, 0x28, 0xc0, 0x9b, 0x3f, 0x61, 0x00, 0x2c, 0x5a, 0xa1, 0x25,
+	0x74, 0xb3, 0x03, 0x98, 0xd1, 0x3d, 0x96, 0xb8, 0xf8, 0x26, 0x32, 0xfe, 0x4e, 0x23, 0x81, 0xfd,
+	0xb2, 0x9a, 0x7e, 0xc4, 0x50, 0x0b, 0xe8, 0xdc, 0xcd, 0x94, 0xd7, 0x33, 0x4d, 0x00, 0x75, 0x9b,
+	0xfe, 0x7e, 0x97, 0x11, 0xef, 0x11, 0xbe, 0xaa, 0xfc, 0x84, 0x2e, 0xa9, 0x42, 0x8c, 0xeb, 0xcd,
+	0x37, 0x41, 0xf2, 0x55, 0x34, 0x80, 0x35, 0xde, 0x5f, 0x1b, 0x70, 0x6d, 0xb1, 0x67, 0x39, 0xf3,
+	0x5d, 0xb6, 0x51, 0x2d, 0xe7, 0x96, 0x27, 0xd4, 0x9b, 0xec, 0xa9, 0xf0, 0x06, 0x98, 0x50, 0x7c,
+	0x88, 0x84, 0x10, 0x71, 0x6c, 0x8a, 0x50, 0x62, 0xd4, 0x82, 0x58, 0xcc, 0x32, 0x55, 0x72, 0xc6,
+	0x95, 0x21, 0x38, 0x63, 0x85, 0x42, 0x95, 0x60, 0x04, 0x96, 0xf9, 0x35, 0xca, 0x03, 0xc1, 0xc2,
+	0xb5, 0xd8, 0x4d, 0x3a, 0xdf, 0x6c, 0x7a, 0x3d, 0xa6, 0x79, 0x12, 0x0c, 0x03, 0xd7, 0x29, 0x2f,
+	0xe7, 0xb6, 0xc0, 0x82, 0x9e, 0xe6, 0x57, 0x47, 0xe0, 0x91, 0xa5, 0xf5, 0xfa, 0xa2, 0x5c, 0x50,
+	0xdb, 0x73, 0xef, 0xd2, 0xfd, 0x6f, 0x58, 0x27, 0x7e, 0xc3, 0x3a, 0xf1, 0x04, 0xad, 0x13, 0xdf,
+	0x0b, 0x33, 0xf1, 0xf6, 0x92, 0x26, 0x34, 0xaf, 0x4f, 0x3f, 0x28, 0x26, 0xd5, 0xd5, 0x9b, 0x7d,
+	0x04, 0x98, 0x0f, 0x0d, 0x98, 0x59, 0xda, 0xeb, 0xda, 0x3e, 0xf7, 0xca, 0x15, 0x06, 0xb8, 0x4c,
+	0xf4, 0xaf, 0xec, 0x74, 0x8d, 0xa4, 0xe8, 0x3f, 0x6d, 0xab, 0x4b, 0xb6, 0xe0, 0x3c, 0xe5, 0xdd,
+	0x39, 0xc7, 0x6f, 0x85, 0x65, 0x76, 0xa0, 0x88, 0x00, 0x93, 0x80, 0x82, 0x29, 0xa8, 0xa4, 0x01,
+	0xe7, 0x9b, 0x8e, 0x15, 0x04, 0xf6, 0x96, 0xb2, 0x00, 0x17, 0xf4, 0xed, 0xf5, 0xfc, 0xf2, 0x4e,
+	0xd4, 0x3c, 0x3c, 0x98, 0xbd, 0x22, 0xc7, 0x99, 0xac, 0xc0, 0x14, 0x08, 0xf3, 0xb3, 0x15, 0x38,
+	0xb7, 0xb4, 0xd7, 0xf5, 0x82, 0x9e, 0x4f, 0x79, 0xd3, 0x33, 0x90, 0x61, 0x3c, 0x0d, 0xe3, 0xdb,
+	0x16, 0xb3, 0x65, 0xf3, 0x6b, 0x95, 0xe4, 0xda, 0xde, 0x11, 0xc5, 0xa8, 0xea, 0xc9, 0x47, 0x00,
+	0x58, 0x70, 0xac, 0x56, 0x8f, 0xf3, 0x80, 0xd5, 0xf2, 0xde, 0x75, 0x89, 0x39, 0x36, 0x22, 0x90,
+	0xf2, 0x6e, 0x8c, 0x7e, 0xa3, 0x86, 0xce, 0xfc, 0x03, 0x03, 0x2e, 0x26, 0xfa, 0x9d, 0xc1, 0xd3,
+	0x7c, 0x2b, 0xf9, 0x34, 0x9f, 0x1f, 0x7a, 0xae, 0x05, 0x2f, 0xf2, 0xef, 0xaf, 0xc0, 0xb5, 0x82,
+	0x35, 0xc9, 0x58, 0x86, 0x19, 0x67, 0x64, 0x19, 0xd6, 0x83, 0xa9, 0xd0, 0x73, 0xa4, 0x33, 0x86,
+	0x5a, 0x81, 0x52, 0x76, 0x5f, 0xeb, 0x11, 0x98, 0xd8, 0xee, 0x2b, 0x2e, 0x0b, 0x50, 0xc7, 0x63,
+	0xfe, 0x8a, 0x01, 0x93, 0x91, 0x04, 0xf0, 0xeb, 0x4a, 0x0b, 0x37, 0x78, 0xd4, 0x2a, 0xe6, 0xc4,
+	0x75, 0x35, 0x82, 0xad, 0xc8, 0x1c, 0x13, 0x58, 0x0e, 0x22, 0x46, 0x78, 0x2c, 0x61, 0xb3, 0x3a,
+	0x91, 0x75, 0x59, 0xe8, 0xf6, 0xfc, 0xae, 0x17, 0x28, 0x86, 0x4a, 0x70, 0x9e, 0xa2, 0x08, 0x55,
+	0x1d, 0xb9, 0x07, 0xa3, 0x01, 0xc3, 0x57, 0x1b, 0x29, 0xb3, 0x1a, 0x9c, 0x27, 0xe4, 0xe3, 0x45,
+	0x01, 0x86, 0x7c, 0x44, 0xa7, 0xe1, 0xa3, 0xe5, 0x05, 0x55, 0x6c, 0x26, 0xad, 0x88, 0xa5, 0xca,
+	0x3a, 0x00, 0xe7, 0xde, 0x09, 0x2b, 0x30, 0x23, 0x0d, 0xbf, 0xc4, 0xb6, 0x61, 0xb6, 0xbf, 0x6f,
+	0x4f, 0xec, 0x8c, 0xd7, 0xa4, 0xf4, 0xf0, 0x97, 0xd3, 0xed, 0xe3, 0x1d, 0x63, 0x06, 0x30, 0x71,
+	0x5b, 0x0e, 0x92, 0x5c, 0x87, 0x8a, 0xad, 0xbe, 0x05, 0x48, 0x18, 0x95, 0xe5, 0x45, 0xac, 0xd8,
+	0x03, 0xd8, 0x0e, 0xeb, 0xd7, 0x52, 0xb5, 0xff, 0xb5, 0x64, 0xfe, 0x49, 0x05, 0x2e, 0x2b, 0xac,
+	0x6a, 0x8e, 0x8b, 0x52, 0x8b, 0x79, 0x04, 0x77, 0x7d, 0xb4, 0x58, 0xe9, 0x3e, 0x8c, 0x70, 0x02,
+	0x58, 0x4a, 0xbb, 0x19, 0x01, 0x64, 0xc3, 0x41, 0x0e, 0x88, 0x7c, 0x14, 0xc6, 0x1c, 0xc6, 0xaa,
+	0x2a, 0xa3, 0xde, 0x52, 0x42, 0xb8, 0xbc, 0xe9, 0x0a, 0x0e, 0x38, 0x10, 0x0e, 0x90, 0x91, 0xd2,
+	0x4b, 0x14, 0xa2, 0xc4, 0x79, 0xfd, 0x1d, 0x30, 0xa5, 0x35, 0x23, 0x33, 0x50, 0xdd, 0xa1, 0x42,
+	0xbb, 0x3d, 0x89, 0xec, 0x5f, 0x72, 0x19, 0x46, 0x77, 0x2d, 0xa7, 0x27, 0x97, 0x04, 0xc5, 0x8f,
+	0x67, 0x2b, 0x6f, 0x37, 0xcc, 0xcf, 0x57, 0xa0, 0x76, 0x87, 0x3a, 0x9d, 0x5c, 0x95, 0xf4, 0x2c,
+	0x8c, 0x36, 0xb7, 0x2d, 0x5f, 0x04, 0x36, 0x9c, 0x16, 0x9b, 0xbc, 0xce, 0x0a, 0x50, 0x94, 0x93,
+	0x4d, 0x18, 0xe3, 0xa0, 0x94, 0xba, 0xe2, 0x3d, 0xda, 0x4a, 0xc6, 0x11, 0x2f, 0x3f, 0x14, 0x85,
+	0xc4, 0x8c, 0x27, 0x9e, 0x68, 0xc0, 0xae, 0x97, 0xf7, 0x37, 0xee, 0xdf, 0x13, 0x8f, 0xf1, 0xe7,
+	0x38, 0x44, 0x94, 0x90, 0x99, 0x27, 0xa0, 0xd7, 0xb4, 0x91, 0x76, 0xbd, 0xc0, 0x0e, 0x3d, 0x5f,
+	0x05, 0xb9, 0x29, 0x75, 0xb5, 0xdc, 0xaf, 0x2f, 0xc7, 0x80, 0x84, 0xaa, 0x28, 0x51, 0x84, 0x49,
+	0x54, 0xe6, 0x17, 0x0d, 0x98, 0xba, 0x63, 0x6f, 0x8a, 0xf0, 0x65, 0x9e, 0xcb, 0x9f, 0xda, 0x89,
+	0x90, 0x8a, 0x53, 0x79, 0xe1, 0x14, 0xc9, 0x1e, 0x4c, 0xca, 0x7b, 0x38, 0xf2, 0x19, 0xb9, 0x5d,
+	0xce, 0xc8, 0x20, 0x42, 0x2d, 0xef, 0x37, 0xdd, 0xf3, 0x5f, 0x61, 0xc0, 0x18, 0x99, 0xf9, 0x11,
+	0xb8, 0x94, 0xd3, 0x89, 0x7d, 0xc8, 0x20, 0x54, 0x1f, 0x72, 0x32, 0xa2, 0x56, 0xec, 0x43, 0xf2,
+	0x72, 0xf2, 0x08, 0x54, 0xa9, 0xdb, 0x92, 0x27, 0x86, 0x3b, 0x9f, 0x2e, 0xb9, 0x2d, 0x64, 0x65,
+	0x8c, 0x88, 0x3b, 0x5e, 0x82, 0x63, 0xe3, 0x44, 0x7c, 0x45, 0x96, 0x61, 0x54, 0xcb, 0xcd, 0x42,
+	0xd2, 0x16, 0x10, 0x8c, 0xf9, 0x9f, 0xd9, 0x4a, 0xd1, 0x96, 0x61, 0x0c, 0x2f, 0xd2, 0x74, 0x2a,
+	0x8e, 0xf8, 0x97, 0xae, 0xc1, 0x0c, 0x5e, 0xf3, 0x17, 0x47, 0xe0, 0xf1, 0x3b, 0x9e, 0x6f, 0xbf,
+	0xec, 0xb9, 0xa1, 0xe5, 0xac, 0x79, 0xad, 0xd8, 0x28, 0x4e, 0x5e, 0x59, 0xff, 0xbf, 0x01, 0xd7,
+	0x9a, 0xdd, 0x9e, 0x0c, 0xe9, 0x23, 0xed, 0xca, 0xd6, 0xa8, 0x6f, 0x7b, 0x65, 0x8d, 0x99, 0x79,
+	0x98, 0x9e, 0xfa, 0xda, 0x46, 0x1e, 0x48, 0x2c, 0xc2, 0xc5, 0x6d, 0xaa, 0x5b, 0xde, 0x03, 0x97,
+	0x0f, 0xae, 0x11, 0xf2, 0xd5, 0x7c, 0x39, 0xfe, 0x08, 0x25, 0x6d, 0xaa, 0x17, 0x73, 0x21, 0x62,
+	0x01, 0x26, 0x66, 0x45, 0x27, 0x63, 0x19, 0x21, 0xb5, 0x5a, 0xb6, 0x4b, 0x83, 0x40, 0x18, 0x64,
+	0x0e, 0x61, 0x34, 0xbc, 0x9c, 0x07, 0x10, 0xf3, 0xf1, 0x90, 0x17, 0x01, 0x82, 0x7d, 0xb7, 0x29,
+	0xd7, 0xbf, 0x9c, 0xf5, 0x9a, 0x60, 0x91, 0x23, 0x28, 0xa8, 0x41, 0x64, 0x0f, 0xad, 0x30, 0xda,
+	0x94, 0x63, 0xdc, 0x02, 0x91, 0x3f, 0xb4, 0xe2, 0x3d, 0x14, 0xd7, 0x9b, 0x9f, 0x36, 0x60, 0xf2,
+	0x4e, 0xaf, 0x4d, 0xd7, 0xb8, 0x33, 0xc7, 0xb7, 0xc3, 0x44, 0xd7, 0x6a, 0xd3, 0x61, 0x62, 0x94,
+	0x45, 0x21, 0x48, 0x24, 0x1c, 0x8c, 0x20, 0x92, 0x27, 0x99, 0x3d, 0x56, 0xcf, 0x55, 0x11, 0x60,
+	0x23, 0x26, 0xb8, 0xce, 0x0a, 0x51, 0xd4, 0x99, 0x21, 0x24, 0x3c, 0xb7, 0xc8, 0xfb, 0x60, 0x26,
+	0xd8, 0x0f, 0x42, 0xda, 0x61, 0x6f, 0x4d, 0xcf, 0xa5, 0x4c, 0xf8, 0x21, 0xc8, 0x14, 0xd7, 0x5e,
+	0x34, 0x52, 0x75, 0x98, 0x69, 0xcd, 0x84, 0xa3, 0xb6, 0xf0, 0x8c, 0x11, 0x92, 0x44, 0x4e, 0x8f,
+	0xa5, 0x33, 0x8b, 0xac, 0x31, 0xff, 0x99, 0x01, 0xe3, 0x32, 0x3e, 0x2a, 0xb3, 0x44, 0x4b, 0x08,
+	0x53, 0xa3, 0x0b, 0x2a, 0x25, 0x50, 0xdd, 0xe7, 0x1a, 0x75, 0x79, 0xc1, 0xc8, 0xbb, 0xa2, 0x94,
+	0x34, 0x4e, 0x22, 0x8e, 0x6f, 0xab, 0x84, 0x66, 0x5d, 0x96, 0xa1, 0x86, 0xcc, 0xfc, 0x82, 0x01,
+	0x17, 0x33, 0xbd, 0x06, 0x60, 0x2a, 0xcf, 0xd0, 0x58, 0xed, 0x77, 0x47, 0xe0, 0x3c, 0x37, 0x2c,
+	0x77, 0x2d, 0x47, 0xc8, 0x39, 0xcf, 0xe0, 0x15, 0xfb, 0x7a, 0x98, 0xb4, 0x3b, 0x9d, 0x5e, 0xc8,
+	0x6e, 0x2c, 0xa9, 0xaa, 0xe2, 0x5b, 0x7f, 0x59, 0x15, 0x62, 0x5c, 0x4f, 0x5c, 0xc9, 0x2f, 0x89,
+	0xbb, 0x6c, 0xa5, 0xdc, 0x97, 0xd3, 0x27, 0x38, 0xc7, 0x78, 0x1b, 0xc1, 0xd4, 0xe4, 0xb1, 0x53,
+	0xdf, 0x67, 0x00, 0x04, 0xa1, 0x6f, 0xbb, 0x6d, 0x56, 0x28, 0x79, 0x2a, 0x3c, 0x01, 0xb4, 0x8d,
+	0x08, 0xa8, 0x40, 0x1e, 0xad, 0x51, 0x5c, 0x81, 0x1a, 0x66, 0x32, 0x2f, 0x59, 0x49, 0x71, 0xf1,
+	0xbd, 0x31, 0xc5, 0x34, 0x3f, 0x9e, 0x0d, 0x24, 0x2e, 0x43, 0x9d, 0xc4, 0xbc, 0xe6, 0xf5, 0xb7,
+	0xc1, 0x64, 0x84, 0xef, 0x28, 0xd6, 0x6c, 0x5a, 0x63, 0xcd, 0xae, 0xbf, 0x1b, 0x2e, 0xa4, 0x86,
+	0x7b, 0x2c, 0xce, 0xee, 0x2b, 0x06, 0x90, 0xe4, 0xec, 0xcf, 0xe0, 0xfd, 0xdf, 0x4e, 0xbe, 0xff,
+	0x17, 0x86, 0xff, 0x64, 0x05, 0x02, 0x80, 0xff, 0x69, 0xc0, 0xf4, 0x5d, 0xea, 0xbb, 0xd4, 0x59,
+	0xf5, 0x38, 0x8b, 0x73, 0xf4, 0xb3, 0x80, 0x69, 0xcb, 0xbb, 0x96, 0x6f, 0x75, 0x68, 0x18, 0xeb,
+	0x75, 0x4a, 0x59, 0xa6, 0xe9, 0x88, 0xe7, 0xd6, 0x22, 0x90, 0xa9, 0x2d, 0x15, 0x57, 0xa0, 0x86,
+	0x97, 0x7d, 0xd6, 0x54, 0x97, 0x63, 0x7d, 0xd6, 0xef, 0x99, 0x01, 0x1e, 0x37, 0x3b, 0x8a, 0x4b,
+	0x2e, 0x19, 0x17, 0xc6, 0x67, 0xc5, 0xae, 0x8e, 0x92, 0x64, 0x0d, 0xc1, 0x67, 0xdd, 0x4d, 0xc1,
+	0x8a, 0xf9, 0xac, 0x74, 0x0d, 0x66, 0xf0, 0x92, 0x4f, 0x18, 0x30, 0x63, 0x25, 0xe3, 0x66, 0xab,
+	0x05, 0x2f, 0x17, 0x14, 0x37, 0x09, 0x2b, 0x1e, 0x4b, 0xaa, 0x22, 0xc0, 0x0c, 0x5a, 0x1e, 0x45,
+	0xb8, 0x6b, 0xb3, 0xb0, 0x90, 0xec, 0xe1, 0xac, 0xc2, 0x1c, 0x8a, 0x28, 0xc2, 0x6b, 0xcb, 0x51,
+	0x39, 0x26, 0x5a, 0x45, 0xb1, 0x65, 0xe5, 0x42, 0x8e, 0x0c, 0x19, 0x58, 0x53, 0xae, 0x61, 0x1c,
+	0x5b, 0x56, 0x2e, 0x9d, 0x8e, 0x84, 0xb8, 0x00, 0x9e, 0xdd, 0x6a, 0x4a, 0x94, 0x63, 0xe5, 0xe3,
+	0x6c, 0xde, 0x5f, 0x5e, 0xac, 0x4b, 0x8c, 0x9c, 0xfb, 0x89, 0x7f, 0xa3, 0x86, 0x81, 0x7c, 0xc6,
+	0x80, 0x73, 0xf2, 0xd2, 0x92, 0x38, 0xc7, 0xf9, 0x27, 0xfa, 0x60, 0xd9, 0xfd, 0x92, 0xda, 0x93,
+	0x73, 0xa8, 0x03, 0x17, 0xa7, 0x23, 0xf2, 0x94, 0x4d, 0xd4, 0x61, 0x72, 0x1c, 0xe4, 0x1f, 0x1a,
+	0x70, 0x39, 0x48, 0x28, 0x63, 0xe4, 0x00, 0x27, 0xca, 0x87, 0x81, 0x6b, 0xe4, 0xc0, 0x93, 0x8e,
+	0x15, 0x39, 0x35, 0x98, 0x8b, 0x9f, 0xb1, 0xe5, 0x17, 0x1e, 0x58, 0x61, 0x73, 0xbb, 0x6e, 0x35,
+	0xb7, 0x39, 0xab, 0x26, 0x3c, 0xa6, 0x4a, 0xee, 0xeb, 0xe7, 0x93, 0xa0, 0x84, 0x55, 0x4b, 0xaa,
+	0x10, 0xd3, 0x08, 0x89, 0xc7, 0x74, 0x6f, 0x22, 0x19, 0x41, 0x0d, 0xca, 0xf3, 0x52, 0x99, 0xcc,
+	0x06, 0xe2, 0x61, 0xa7, 0x7e, 0x61, 0x84, 0x84, 0x39, 0x0a, 0x89, 0xa7, 0xed, 0xbc, 0xeb, 0xb9,
+	0xfb, 0x1d, 0xaf, 0x17, 0xcc, 0xf7, 0xc2, 0x6d, 0xea, 0x86, 0x4a, 0x92, 0x3f, 0xc5, 0xf9, 0x07,
+	0xee, 0x28, 0xb4, 0xd4, 0xaf, 0x21, 0xf6, 0x87, 0x43, 0x5e, 0x80, 0x09, 0xba, 0x4b, 0xdd, 0x70,
+	0x7d, 0x7d, 0xa5, 0x36, 0x7d, 0x9c, 0xcb, 0x29, 0xe2, 0xf6, 0xf9, 0x14, 0x96, 0x24, 0x0c, 0x8c,
+	0xa0, 0x91, 0x1d, 0x18, 0x77, 0x44, 0x36, 0x89, 0xda, 0xb9, 0xf2, 0x44, 0x31, 0x9d, 0x99, 0x42,
+	0xbc, 0xff, 0xe5, 0x0f, 0x54, 0x18, 0x98, 0xbf, 0x53, 0x8b, 0x6e, 0x59, 0x3d, 0x27, 0xbc, 0xe7,
+	0x85, 0xc8, 0xbd, 0x72, 0x22, 0x81, 0xad, 0xf2, 0xb3, 0x3b, 0xcf, 0x43, 0xfc, 0x70, 0x7f, 0xa7,
+	0xc5, 0x23, 0xda, 0xe2, 0x91, 0xd0, 0xc8, 0x3e, 0x3c, 0x29, 0xdb, 0x70, 0x37, 0xa0, 0xe6, 0x36,
+	0x5b, 0xe5, 0x2c, 0xd2, 0x0b, 0x1c, 0xe9, 0xff, 0x73, 0x78, 0x30, 0xfb, 0xe4, 0xe2, 0xd1, 0xcd,
+	0x71, 0x10, 0x98, 0xdc, 0xb3, 0x82, 0xa6, 0x34, 0x58, 0xb5, 0x99, 0xf2, 0x6b, 0x9c, 0xd6, 0x86,
+	0x89, 0xc7, 0x4b, 0xba, 0x14, 0x33, 0x38, 0xc9, 0x4f, 0x1b, 0x50, 0x0b, 0x42, 0xbf, 0xc7, 0xc3,
+	0xc0, 0xb7, 0x52, 0x3b, 0xf4, 0xe2, 0x0d, 0xa3, 0x2c, 0xe7, 0xda, 0x28, 0x80, 0xc9, 0x3d, 0x3e,
+	0x6b, 0x45, 0xb5, 0x58, 0x38, 0x96, 0xeb, 0xef, 0x03, 0x92, 0xa5, 0x8c, 0x47, 0x31, 0x01, 0x13,
+	0x3a, 0x13, 0xf0, 0xb9, 0x51, 0x78, 0x94, 0x11, 0xdc, 0xf8, 0x45, 0xb3, 0x6a, 0xb9, 0x56, 0xfb,
+	0xeb, 0x93, 0x19, 0xf8, 0xa2, 0x01, 0xd7, 0xb6, 0xf3, 0x85, 0x2e, 0xf2, 0x4d, 0xf5, 0x81, 0x52,
+	0xc2, 0xb1, 0x7e, 0x72, 0x1c, 0x41, 0x8b, 0xfa, 0x36, 0xc1, 0xa2, 0x41, 0xb1, 0x77, 0xb4, 0xeb,
+	0xb5, 0x68, 0x7d, 0x79, 0x11, 0x57, 0xad, 0x60, 0xa7, 0xa1, 0x6c, 0x31, 0x46, 0xc5, 0x56, 0xbc,
+	0x97, 0xaa, 0xc3, 0x4c, 0x6b, 0xe6, 0x85, 0xd6, 0xf5, 0x5a, 0x4b, 0xbb, 0x76, 0x53, 0x29, 0xc1,
+	0xcb, 0x5b, 0x1e, 0x72, 0x4d, 0xfb, 0x5a, 0x06, 0x1a, 0xe6, 0x60, 0xe0, 0x52, 0x23, 0x36, 0x98,
+	0x55, 0xcf, 0xb5, 0x43, 0xcf, 0xe7, 0xee, 0xb9, 0x43, 0x09, 0x4f, 0xb8, 0xd4, 0xe8, 0x5e, 0x2e,
+	0x44, 0x2c, 0xc0, 0x64, 0xfe, 0x37, 0x03, 0x2e, 0xb0, 0x6d, 0xb1, 0xe6, 0x7b, 0x7b, 0xfb, 0x5f,
+	0x8f, 0x1b, 0xf2, 0x69, 0x69, 0x96, 0x26, 0xa4, 0x9d, 0x57, 0x34, 0x93, 0xb4, 0x49, 0x3e, 0xe6,
+	0xd8, 0x0a, 0x4d, 0x17, 0xf8, 0x56, 0x8b, 0x05, 0xbe, 0xe6, 0x67, 0x2a, 0x82, 0x29, 0x57, 0x02,
+	0xd7, 0xaf, 0xcb, 0x73, 0xf8, 0x36, 0x38, 0xc7, 0xca, 0x56, 0xad, 0xbd, 0xb5, 0xc5, 0xe7, 0x3c,
+	0x47, 0x39, 0x57, 0x72, 0x29, 0xf8, 0x5d, 0xbd, 0x02, 0x93, 0xed, 0xc8, 0xb3, 0xcc, 0x76, 0x8b,
+	0xc7, 0x7a, 0x91, 0xef, 0xe0, 0x1b, 0xc2, 0x76, 0x8b, 0x17, 0x3d, 0x3c, 0x98, 0xbd, 0x18, 0x2b,
+	0x5f, 0x65, 0x21, 0xaa, 0x0e, 0xe6, 0xdf, 0x5c, 0x02, 0x0e, 0xdc, 0xa1, 0xe1, 0xd7, 0xe3, 0x9a,
+	0xbc, 0x09, 0xa6, 0x9a, 0xdd, 0x5e, 0xfd, 0x56, 0xe3, 0x03, 0x3d, 0x8f, 0xcb, 0x37, 0x78, 0x9e,
+	0x24, 0x9e, 0xb9, 0x65, 0x6d, 0x43, 0x15, 0xa3, 0xde, 0x86, 0x51, 0x87, 0x66, 0xb7, 0x27, 0xe9,
+	0xed, 0x9a, 0xee, 0x35, 0xc0, 0xa9, 0x43, 0x7d, 0x6d, 0x23, 0x51, 0x87, 0x99, 0xd6, 0xe4, 0xe3,
+	0x30, 0x4d, 0xe5, 0xc1, 0xbd, 0xc3, 0x52, 0x2b, 0x09, 0xba, 0xb0, 0x5c, 0x76, 0xf2, 0xd1, 0xd2,
+	0x2a, 0x6a, 0x20, 0x1e, 0x37, 0x4b, 0x1a, 0x0a, 0x4c, 0x20, 0x24, 0xdf, 0x06, 0x8f, 0xa8, 0xdf,
+	0xec, 0x2b, 0x7b, 0xad, 0x34, 0xa1, 0x18, 0x15, 0xa1, 0x2f, 0x96, 0x8a, 0x1a, 0x61, 0x71, 0x7f,
+	0xf2, 0xb3, 0x06, 0x5c, 0x8d, 0x6a, 0x6d, 0xd7, 0xee, 0xf4, 0x3a, 0x48, 0x9b, 0x8e, 0x65, 0x77,
+	0xe4, 0x93, 0xe6, 0xf9, 0x13, 0x9b, 0x68, 0x12, 0xbc, 0x20, 0x56, 0xf9, 0x75, 0x58, 0x30, 0x24,
+	0xf2, 0x05, 0x03, 0x6e, 0xa8, 0xaa, 0x35, 0x9f, 0x06, 0x01, 0xd3, 0x17, 0x44, 0xae, 0xbd, 0x72,
+	0x49, 0xc6, 0x4b, 0xd1, 0x4e, 0xce, 0xdb, 0x2d, 0x1d, 0x01, 0x1b, 0x8f, 0xc4, 0xae, 0x6f, 0x97,
+	0x86, 0xb7, 0x15, 0xd6, 0x26, 0x4e, 0x75, 0xbb, 0x30, 0x14, 0x98, 0x40, 0x48, 0xfe, 0xb9, 0x01,
+	0xd7, 0xf4, 0x02, 0x7d, 0xb7, 0x88, 0xc7, 0xcf, 0x0b, 0x27, 0x36, 0x98, 0x14, 0x7c, 0xa1, 0x3d,
+	0x29, 0xa8, 0xc4, 0xa2, 0x51, 0xc9, 0x3c, 0x35, 0x6b, 0x5e, 0x4b, 0x3c, 0x90, 0xe2, 0x3c, 0x35,
+	0xac, 0x08, 0x55, 0x1d, 0x13, 0x0d, 0x74, 0xbd, 0xd6, 0x9a, 0xdd, 0x0a, 0x56, 0xec, 0x8e, 0x1d,
+	0xf2, 0x67, 0x4c, 0x55, 0x2c, 0xc7, 0x9a, 0xd7, 0x5a, 0x5b, 0x5e, 0x14, 0xe5, 0x98, 0x68, 0xc5,
+	0x6c, 0x54, 0x99, 0x62, 0xa9, 0xf1, 0xc0, 0xea, 0xde, 0x57, 0x21, 0x1d, 0xf8, 0x33, 0xfb, 0x56,
+	0x54, 0x8a, 0x5a, 0x0b, 0xf6, 0xfd, 0x18, 0xdd, 0x41, 0x2a, 0x02, 0x76, 0xd6, 0xce, 0x9f, 0xd0,
+	0xf7, 0x53, 0x00, 0xc5, 0x80, 0xef, 0x6a, 0x28, 0x30, 0x81, 0x90, 0xe9, 0xb4, 0xce, 0x0b, 0x51,
+	0x7f, 0x34, 0x86, 0x0b, 0x27, 0x3d, 0x06, 0x2e, 0xe7, 0x6e, 0x24, 0x90, 0x60, 0x0a, 0x29, 0x0f,
+	0x8e, 0xc1, 0x74, 0x08, 0xb7, 0xeb, 0x4c, 0x4b, 0x18, 0x05, 0x6b, 0x58, 0xa3, 0x7e, 0x93, 0xb9,
+	0xaf, 0xcc, 0xf0, 0x2f, 0x25, 0x82, 0x63, 0x14, 0x37, 0xc3, 0x7e, 0x30, 0xc8, 0x8b, 0x70, 0x5d,
+	0x56, 0xaf, 0x78, 0x0f, 0x32, 0x18, 0x2e, 0x72, 0x0c, 0xdc, 0x7a, 0x70, 0xb9, 0xb0, 0x15, 0xf6,
+	0x81, 0xc0, 0x3c, 0x27, 0x02, 0xea, 0x73, 0x6d, 0x9d, 0x88, 0xea, 0xc5, 0x14, 0x2f, 0x41, 0x8d,
+	0xc4, 0x9e, 0x13, 0x8d, 0x6c, 0x35, 0xe6, 0xf5, 0x61, 0xae, 0x2d, 0xd2, 0x8f, 0x72, 0x9f, 0x15,
+	0x7c, 0x60, 0xad, 0x51, 0xbb, 0xc4, 0xc7, 0x77, 0x49, 0xf3, 0xb9, 0x54, 0x55, 0x98, 0x6e, 0xcb,
+	0x6e, 0x73, 0x55, 0xb4, 0xd0, 0xf3, 0x83, 0xb0, 0x76, 0x99, 0x77, 0xe6, 0xb7, 0x39, 0xea, 0x15,
+	0x98, 0x6c, 0xc7, 0x8c, 0xe8, 0x03, 0xda, 0x6c, 0x7a, 0x9d, 0xae, 0x7c, 0x02, 0xd6, 0xae, 0xf0,
+	0xd1, 0x8b, 0x2f, 0x98, 0xa8, 0xc1, 0x54, 0x4b, 0xb2, 0x0f, 0x97, 0xa2, 0xd0, 0x84, 0x2b, 0x5e,
+	0x5b, 0x26, 0x7e, 0xaa, 0x5d, 0x2d, 0xa5, 0xff, 0xe2, 0xcb, 0x55, 0xcf, 0x82, 0xc3, 0x3c, 0x1c,
+	0x2c, 0xaf, 0x5d, 0xaa, 0xf8, 0x96, 0xcd, 0xd4, 0xeb, 0xd7, 0xf8, 0xb4, 0xb9, 0x1c, 0xa7, 0x9e,
+	0x53, 0x8f, 0xb9, 0xbd, 0xc8, 0x7d, 0xb8, 0xd2, 0xf5, 0xbd, 0x90, 0x36, 0x43, 0x21, 0xcb, 0x95,
+	0x13, 0x0c, 0x6a, 0x35, 0xbe, 0x16, 0x5c, 0x53, 0xb9, 0x96, 0xd7, 0x00, 0xf3, 0xfb, 0x91, 0xcf,
+	0x19, 0xf0, 0x44, 0x10, 0xfa, 0xd4, 0xea, 0xb0, 0x8c, 0x6e, 0x9e, 0xeb, 0x52, 0x4e, 0x98, 0x96,
+	0x5b, 0xb1, 0xe3, 0xd1, 0x23, 0xa5, 0x6e, 0x11, 0xf3, 0xf0, 0x60, 0xf6, 0x89, 0x46, 0x5f, 0xc8,
+	0x78, 0x04, 0x66, 0x66, 0x86, 0xd8, 0xa1, 0x1d, 0xcf, 0xdf, 0x67, 0x14, 0xa9, 0x76, 0xbd, 0xbc,
+	0x19, 0xe2, 0x6a, 0x04, 0x45, 0x1c, 0xff, 0x84, 0x8e, 0x35, 0xae, 0x44, 0x0d, 0x9d, 0x79, 0x50,
+	0x81, 0x2b, 0xb9, 0xa4, 0x9e, 0x9d, 0x00, 0xd1, 0x6e, 0x5e, 0x25, 0x95, 0x91, 0xd2, 0x7b, 0x7e,
+	0x02, 0x56, 0x93, 0x55, 0x98, 0x6e, 0xcb, 0x18, 0x31, 0x7e, 0x52, 0x6f, 0x35, 0xe2, 0xfe, 0x95,
+	0x98, 0x11, 0x5b, 0x4e, 0xd5, 0x61, 0xa6, 0x35, 0xa9, 0xc3, 0x45, 0x59, 0xb6, 0xcc, 0xde, 0x32,
+	0xc1, 0x2d, 0x9f, 0x2a, 0x16, 0x97, 0xbd, 0x0a, 0x2e, 0x2e, 0xa7, 0x2b, 0x31, 0xdb, 0x9e, 0xcd,
+	0x82, 0xfd, 0xd0, 0x47, 0x31, 0x12, 0xcf, 0xe2, 0x5e, 0xb2, 0x0a, 0xd3, 0x6d, 0xd5, 0x63, 0x33,
+	0x31, 0x84, 0xd1, 0x78, 0x16, 0xf7, 0x52, 0x75, 0x98, 0x69, 0x6d, 0xfe, 0xe1, 0x08, 0x3c, 0x39,
+	0x00, 0x7b, 0x44, 0x3a, 0xf9, 0xcb, 0x7d, 0xfc, 0x83, 0x3b, 0xd8, 0xe7, 0xe9, 0x16, 0x7c, 0x9e,
+	0xe3, 0xe3, 0x1b, 0xf4, 0x73, 0x06, 0x45, 0x9f, 0xf3, 0xf8, 0x28, 0x07, 0xff, 0xfc, 0x9d, 0xfc,
+	0xcf, 0x5f, 0x72, 0x55, 0x8f, 0xdc, 0x2e, 0xdd, 0x82, 0xed, 0x52, 0x72, 0x55, 0x07, 0xd8, 0x5e,
+	0x7f, 0x34, 0x02, 0xaf, 0x19, 0x84, 0x55, 0x2b, 0xb9, 0xbf, 0x72, 0x48, 0xde, 0xa9, 0xee, 0xaf,
+	0x22, 0xdf, 0xce, 0x53, 0xdc, 0x5f, 0x39, 0x28, 0x4f, 0x7b, 0x7f, 0x15, 0xad, 0xea, 0x69, 0xed,
+	0xaf, 0xa2, 0x55, 0x1d, 0x60, 0x7f, 0xfd, 0x65, 0xfa, 0x7e, 0x88, 0xf8, 0xc5, 0x65, 0xa8, 0x36,
+	0xbb, 0xbd, 0x92, 0x44, 0x8a, 0x1b, 0xb1, 0xd5, 0xd7, 0x36, 0x90, 0xc1, 0x20, 0x08, 0x63, 0x62,
+	0xff, 0x94, 0x24, 0x41, 0xdc, 0x10, 0x46, 0x6c, 0x49, 0x94, 0x90, 0xd8, 0x52, 0xd1, 0xee, 0x36,
+	0xed, 0x50, 0xdf, 0x72, 0x1a, 0xa1, 0xe7, 0x5b, 0xed, 0xb2, 0xd4, 0x46, 0x48, 0xb8, 0x53, 0xb0,
+	0x30, 0x03, 0x9d, 0x2d, 0x48, 0xd7, 0x6e, 0xd5, 0x46, 0xca, 0x2f, 0xc8, 0xda, 0xf2, 0x22, 0x32,
+	0x18, 0xe6, 0xaf, 0x4f, 0x80, 0x16, 0x25, 0x97, 0x09, 0x65, 0x2e, 0x36, 0xd3, 0x71, 0xe2, 0x86,
+	0x31, 0xd4, 0xc9, 0x04, 0x9d, 0x13, 0x5b, 0x3e, 0x53, 0x8c, 0x59, 0xb4, 0xe4, 0xbb, 0x0c, 0x21,
+	0xa9, 0x8a, 0xb4, 0x2d, 0xc3, 0xa4, 0xe6, 0xcd, 0xd1, 0x4b, 0xc6, 0x22, 0xaf, 0xa8, 0x02, 0x93,
+	0x08, 0x99, 0x58, 0xe0, 0xca, 0x4e, 0x9e, 0x80, 0xbd, 0x36, 0x52, 0xde, 0x59, 0xbb, 0x8f, 0xc4,
+	0x5e, 0x70, 0x9c, 0xb9, 0x0d, 0x30, 0x7f, 0x20, 0xd1, 0x2a, 0x45, 0x32, 0xc7, 0xda, 0xe8, 0x70,
+	0xab, 0x94, 0x12, 0x5e, 0xc6, 0xab, 0x14, 0x55, 0x60, 0x12, 0x21, 0xf3, 0x93, 0xdd, 0x51, 0x82,
+	0xde, 0xda, 0x58, 0x79, 0x35, 0x68, 0x4a, 0x5a, 0x2c, 0x0c, 0x91, 0xa2, 0x42, 0x8c, 0x91, 0x90,
+	0x6d, 0x18, 0xdf, 0x11, 0xb4, 0xa2, 0x36, 0x5e, 0xde, 0x0c, 0x38, 0x41, 0x6e, 0x84, 0x6c, 0x40,
+	0x16, 0xa1, 0x02, 0xaf, 0x9b, 0xaa, 0x4f, 0x1c, 0xe1, 0x41, 0xf5, 0x39, 0x03, 0xae, 0xec, 0x52,
+	0x3f, 0xb4, 0x9b, 0x69, 0xf5, 0xc6, 0x64, 0xf9, 0x67, 0xf6, 0x73, 0x79, 0x00, 0xc5, 0x36, 0xc9,
+	0xad, 0xc2, 0xfc, 0x21, 0xb0, 0x47, 0xb7, 0x90, 0x52, 0x37, 0x42, 0x2b, 0xb4, 0x9b, 0xeb, 0xde,
+	0x0e, 0x75, 0xe3, 0x04, 0xe0, 0x35, 0x88, 0x23, 0x52, 0x2e, 0x15, 0x37, 0xc3, 0x7e, 0x30, 0xcc,
+	0x3f, 0x35, 0x20, 0x23, 0x6b, 0x25, 0x3f, 0x64, 0xc0, 0xf4, 0x16, 0xb5, 0xc2, 0x9e, 0x4f, 0x6f,
+	0x5b, 0x61, 0x14, 0x18, 0xe3, 0xb9, 0x93, 0x10, 0xf1, 0xce, 0xdd, 0xd2, 0x00, 0x0b, 0xbb, 0x82,
+	0x28, 0x08, 0xb6, 0x5e, 0x85, 0x89, 0x11, 0x5c, 0x7f, 0x2f, 0x5c, 0xcc, 0x74, 0x3c, 0x96, 0xda,
+	0xed, 0xdf, 0x18, 0x90, 0x97, 0xb3, 0x9e, 0xbc, 0x08, 0xa3, 0x16, 0xcb, 0x9e, 0x2f, 0x09, 0xe6,
+	0x3b, 0xca, 0x99, 0xb8, 0xb4, 0xf4, 0xf8, 0x23, 0xfc, 0x27, 0x0a, 0xb0, 0x2c, 0x3a, 0xa9, 0x95,
+	0x50, 0x21, 0xae, 0xc6, 0x5e, 0xf5, 0x5c, 0x3d, 0x34, 0x9f, 0xa9, 0xc5, 0x9c, 0x1e, 0xe6, 0xf7,
+	0x1b, 0x40, 0xb2, 0x61, 0xd3, 0x89, 0x0f, 0x13, 0x72, 0x2b, 0xab, 0xaf, 0xb4, 0x58, 0xd2, 0x6f,
+	0x2b, 0xe1, 0x84, 0x18, 0x1b, 0x8a, 0xc9, 0x82, 0x00, 0x23, 0x3c, 0xe6, 0xef, 0x55, 0xe0, 0x02,
+	0x4f, 0x88, 0xb3, 0x5e, 0x5f, 0x44, 0x1a, 0x84, 0x9e, 0x4f, 0xc9, 0x5b, 0x60, 0xaa, 0x45, 0x83,
+	0xa6, 0x6f, 0x77, 0xc3, 0xd8, 0x71, 0x31, 0x72, 0x80, 0x5a, 0x8c, 0xab, 0x50, 0x6f, 0x47, 0xda,
+	0x70, 0x2e, 0x4c, 0xb8, 0x70, 0x1e, 0xdf, 0x7f, 0x31, 0xb2, 0x4a, 0x49, 0x3a, 0x6e, 0x26, 0xe1,
+	0x92, 0x77, 0x28, 0x17, 0x21, 0xf1, 0x44, 0x7c, 0x52, 0x7d, 0x2b, 0x76, 0x2a, 0xe8, 0x43, 0xe9,
+	0x0f, 0x1b, 0xa5, 0x11, 0x4a, 0x78, 0x03, 0x6d, 0xf1, 0xc8, 0x05, 0xba, 0x9b, 0xef, 0x48, 0x39,
+	0x27, 0xcb, 0x94, 0x8b, 0x6f, 0x0a, 0x2a, 0x8b, 0x6d, 0x15, 0xe7, 0x19, 0x2a, 0xbb, 0xa0, 0x26,
+	0x8c, 0x85, 0x56, 0xb0, 0xb3, 0xbc, 0x28, 0x9f, 0xd3, 0x9c, 0xf9, 0x59, 0xe7, 0x25, 0x28, 0x6b,
+	0xe2, 0x80, 0x91, 0xd5, 0x01, 0x02, 0x46, 0xb2, 0xe9, 0x0f, 0x1d, 0x1d, 0x93, 0x1c, 0x1d, 0x19,
+	0xd3, 0xfc, 0x29, 0xb9, 0xab, 0x56, 0x2d, 0xdb, 0x0d, 0xa9, 0xcb, 0xfd, 0x9e, 0xfe, 0x16, 0xef,
+	0xaa, 0xb7, 0xc1, 0x39, 0xe9, 0xe2, 0x20, 0x22, 0x7f, 0x4a, 0xc1, 0x03, 0xbf, 0xb8, 0x6f, 0xe9,
+	0x15, 0x98, 0x6c, 0x67, 0xfe, 0x4e, 0x05, 0x92, 0x29, 0xb0, 0xca, 0xae, 0x52, 0x36, 0xec, 0x69,
+	0xe5, 0xd4, 0xc2, 0x9e, 0xbe, 0x81, 0xa7, 0x03, 0xe5, 0x96, 0xdc, 0x52, 0x1d, 0xaf, 0x27, 0xf1,
+	0xe4, 0xe5, 0x18, 0xb5, 0x88, 0x97, 0x75, 0xe4, 0xd8, 0xcb, 0xfa, 0x16, 0x69, 0xf4, 0x3b, 0x9a,
+	0x08, 0x3e, 0xab, 0x8c, 0x7e, 0x2f, 0x26, 0x3a, 0x6a, 0x6e, 0x72, 0xf7, 0xe0, 0xd5, 0x2b, 0x9e,
+	0xd5, 0x5a, 0xb0, 0x1c, 0xb6, 0xef, 0x7c, 0x69, 0x55, 0x16, 0x70, 0xc6, 0x85, 0xc9, 0x12, 0xbd,
+	0xa6, 0xe7, 0x30, 0xb6, 0xc2, 0x72, 0x1c, 0xef, 0x41, 0xe4, 0x41, 0x14, 0xb1, 0x15, 0xf3, 0xa2,
+	0x18, 0x55, 0xbd, 0xf9, 0x00, 0x94, 0x65, 0x11, 0x8b, 0xd5, 0xe1, 0xf5, 0xc2, 0x6e, 0x2f, 0x54,
+	0x04, 0xba, 0x5c, 0x1e, 0x34, 0x01, 0xed, 0x3e, 0x87, 0x14, 0x23, 0x16, 0xbf, 0x03, 0x54, 0x28,
+	0xcc, 0xaf, 0x54, 0xe1, 0x5c, 0xa2, 0xed, 0x00, 0xc6, 0xb5, 0x6f, 0x49, 0xf8, 0xdc, 0x65, 0xd7,
+	0x4c, 0x07, 0x17, 0xaf, 0x99, 0xca, 0x84, 0x5e, 0x1d, 0x28, 0x13, 0xfa, 0xc8, 0x91, 0xe1, 0x40,
+	0xde, 0x05, 0xe3, 0xba, 0xcb, 0xe5, 0x24, 0x97, 0x9e, 0x8e, 0x37, 0x44, 0xd1, 0x43, 0x96, 0xbd,
+	0x4f, 0x1f, 0x87, 0x28, 0x47, 0xd5, 0x85, 0xc7, 0xf1, 0xb2, 0x42, 0x2a, 0x74, 0x41, 0x82, 0x9d,
+	0x7d, 0xff, 0xd0, 0xeb, 0x8c, 0x0a, 0xa2, 0x74, 0xe1, 0x57, 0x3f, 0x31, 0xc6, 0x45, 0x76, 0x60,
+	0x6c, 0xb3, 0xb7, 0xb5, 0x45, 0x55, 0x16, 0x8b, 0xdb, 0xc3, 0x7f, 0x5d, 0x0e, 0x4e, 0x50, 0x6e,
+	0xf1, 0x3f, 0x4a, 0x14, 0xe6, 0xcf, 0x18, 0x70, 0x29, 0xa7, 0x2d, 0xb1, 0x60, 0x4a, 0x3c, 0x6c,
+	0xc5, 0xfc, 0xcb, 0xbd, 0xba, 0x45, 0xda, 0xb1, 0x18, 0x0c, 0xea, 0x30, 0xd9, 0xe7, 0xf4, 0x69,
+	0xa8, 0x30, 0x54, 0xe2, 0xac, 0xc0, 0x18, 0x95, 0xa2, 0xd6, 0xc2, 0x7c, 0x11, 0xae, 0xe6, 0xaf,
+	0x25, 0x59, 0x14, 0x81, 0xbd, 0xfc, 0x56, 0xb0, 0x46, 0x7d, 0x61, 0x89, 0xc6, 0x47, 0x3c, 0x1a,
+	0x6b, 0xff, 0x31, 0x55, 0x8f, 0x99, 0x1e, 0xe6, 0x1f, 0x56, 0x60, 0x5c, 0x26, 0x4d, 0x19, 0xc0,
+	0x71, 0x9a, 0xf9, 0xb6, 0xf3, 0x3c, 0x71, 0x43, 0x3c, 0x8b, 0x1b, 0xdb, 0x9e, 0x17, 0x26, 0x52,
+	0xc7, 0x70, 0x5f, 0x3c, 0xfe, 0x2f, 0x0a, 0xf0, 0xdc, 0x60, 0xd9, 0x6f, 0x6e, 0xdb, 0x21, 0xe5,
+	0xd6, 0x63, 0xf2, 0x70, 0x08, 0x83, 0x65, 0xad, 0x1c, 0x13, 0xad, 0x18, 0xbf, 0x3d, 0x63, 0x39,
+	0xa1, 0x70, 0xfd, 0xdb, 0x4d, 0x64, 0x98, 0x29, 0xb5, 0x89, 0xe7, 0x63, 0x58, 0x7a, 0xb2, 0x99,
+	0xd8, 0xf2, 0x3a, 0x85, 0x0b, 0x33, 0xd8, 0xcd, 0xcf, 0x8f, 0xc0, 0x0d, 0xd9, 0x37, 0xf3, 0x7c,
+	0x8d, 0x98, 0xcf, 0x7d, 0xb8, 0x24, 0x37, 0xd6, 0xa2, 0x6f, 0xd9, 0x91, 0xad, 0x54, 0x39, 0xc9,
+	0x21, 0x57, 0x29, 0xad, 0x66, 0xc1, 0x61, 0x1e, 0x0e, 0x11, 0x25, 0x9d, 0x17, 0xdf, 0xa1, 0x96,
+	0x13, 0x6e, 0x2b, 0xdc, 0x95, 0x61, 0xa2, 0xa4, 0x67, 0xe1, 0x61, 0x2e, 0x16, 0x6e, 0xab, 0x25,
+	0x2b, 0xea, 0x3e, 0xb5, 0x74, 0x43, 0xb1, 0x21, 0x3c, 0xfc, 0x56, 0x73, 0x21, 0x62, 0x01, 0x26,
+	0xae, 0x82, 0xb1, 0xf6, 0xb8, 0x44, 0x97, 0x9d, 0x41, 0x9b, 0xef, 0x99, 0x48, 0x09, 0xb9, 0x9a,
+	0xac, 0xc2, 0x74, 0x5b, 0xa6, 0x4b, 0xe4, 0xb6, 0x6f, 0x71, 0xb4, 0xd4, 0xd1, 0x38, 0x20, 0xd7,
+	0xbd, 0x44, 0x0d, 0xa6, 0x5a, 0x9a, 0xdf, 0x5d, 0x81, 0xe9, 0x63, 0x66, 0x1f, 0xec, 0x69, 0x0f,
+	0x95, 0x21, 0xdc, 0x6a, 0x75, 0xac, 0x03, 0xbc, 0x55, 0xc8, 0x0b, 0x70, 0xbe, 0xc7, 0xd9, 0x10,
+	0x15, 0xf1, 0x4d, 0x1e, 0xc9, 0x6f, 0x66, 0xb3, 0xdc, 0x48, 0xd4, 0xb0, 0x68, 0xa1, 0x3a, 0xf8,
+	0x64, 0x2d, 0xa6, 0xe0, 0x98, 0x9f, 0xaa, 0xc2, 0xa5, 0x9c, 0xd1, 0x70, 0x1b, 0x29, 0x9a, 0x7a,
+	0x4e, 0x0d, 0x63, 0x23, 0x95, 0x79, 0x9a, 0x45, 0xc7, 0x38, 0x5d, 0x83, 0x19, 0xbc, 0xe4, 0x39,
+	0xa8, 0x36, 0x7d, 0x5b, 0x2e, 0xf8, 0xdb, 0x4a, 0x09, 0x03, 0x71, 0x39, 0xbe, 0xdc, 0xeb, 0xb8,
+	0x8c, 0x0c, 0x20, 0xe3, 0x5e, 0x75, 0x0a, 0xa6, 0x9e, 0x12, 0x9c, 0x7b, 0xd5, 0x09, 0x5d, 0x80,
+	0xc9, 0x76, 0xe4, 0x05, 0xa8, 0x49, 0x29, 0x8d, 0x1c, 0x62, 0xdd, 0x73, 0x83, 0x90, 0x9d, 0xec,
+	0x50, 0xf2, 0x08, 0xdc, 0x32, 0xf7, 0x6e, 0x41, 0x1b, 0x2c, 0xec, 0x6d, 0xfe, 0x45, 0x15, 0xf4,
+	0xa4, 0x99, 0x64, 0x75, 0x18, 0x09, 0x74, 0x3c, 0x63, 0x25, 0x85, 0x5e, 0x85, 0x6a, 0xbb, 0xdb,
+	0xab, 0x55, 0x86, 0x03, 0x77, 0x9b, 0x81, 0x6b, 0x77, 0x7b, 0xe4, 0xb9, 0x48, 0xa8, 0x5d, 0x4e,
+	0xec, 0x1c, 0x79, 0x6b, 0xa6, 0x04, 0xdb, 0xea, 0x20, 0x8e, 0x14, 0x1e, 0xc4, 0x0e, 0x8c, 0x07,
+	0x52, 0xe2, 0x3d, 0x5a, 0x3e, 0xb0, 0xa1, 0xb6, 0xd2, 0x52, 0xc2, 0x2d, 0x64, 0x71, 0xf2, 0x07,
+	0x2a, 0x1c, 0xec, 0x41, 0xda, 0xe3, 0x81, 0x42, 0x38, 0x57, 0x36, 0x21, 0xd8, 0x9a, 0x0d, 0x5e,
+	0x82, 0xb2, 0x26, 0x73, 0x6b, 0x8e, 0x0f, 0x72, 0x6b, 0x9a, 0x7f, 0xaf, 0x02, 0x24, 0x3b, 0x0c,
+	0xee, 0x7e, 0xeb, 0x58, 0x41, 0x20, 0x69, 0x51, 0xec, 0x7e, 0xcb, 0x0a, 0x51, 0xd4, 0x91, 0x86,
+	0x0c, 0xd3, 0x56, 0x29, 0xcf, 0x29, 0x49, 0x7c, 0x5a, 0x4c, 0xb7, 0x1b, 0x09, 0x87, 0xc3, 0x3c,
+	0x36, 0x64, 0x83, 0x85, 0xac, 0x74, 0x59, 0x97, 0x92, 0x8a, 0x00, 0x61, 0x0b, 0x25, 0x40, 0xa0,
+	0x82, 0x65, 0xfe, 0x51, 0x05, 0xa6, 0xf4, 0x67, 0xf3, 0x3e, 0x80, 0xd5, 0x0b, 0x3d, 0x41, 0xc0,
+	0x6a, 0x46, 0x79, 0x41, 0xa6, 0x06, 0x74, 0x3e, 0x02, 0x28, 0xd8, 0xbe, 0xf8, 0x37, 0x6a, 0xc8,
+	0x18, 0xea, 0xd0, 0xee, 0xd0, 0xe7, 0x6d, 0xb7, 0xe5, 0x3d, 0xa8, 0x55, 0x4e, 0x04, 0xf5, 0x7a,
+	0x04, 0x50, 0xa0, 0x8e, 0x7f, 0xa3, 0x86, 0x8c, 0x91, 0x16, 0x2e, 0xd4, 0x74, 0x79, 0x5a, 0x43,
+	0x39, 0x36, 0xcf, 0x71, 0xd4, 0xad, 0x3c, 0x21, 0x48, 0x4b, 0xbd, 0xa0, 0x0d, 0x16, 0xf6, 0x36,
+	0x7f, 0xd6, 0x80, 0x2b, 0xb9, 0x4b, 0x41, 0x6e, 0xc3, 0xc5, 0xd8, 0x2e, 0x55, 0x27, 0xf6, 0x13,
+	0x71, 0x8e, 0xd0, 0xbb, 0xe9, 0x06, 0x98, 0xed, 0xc3, 0x8c, 0x93, 0x3a, 0xd9, 0xcb, 0x44, 0x1a,
+	0xb5, 0xea, 0xac, 0x91, 0x5e, 0x8d, 0x79, 0x7d, 0xcc, 0x6f, 0x4b, 0x0c, 0x36, 0x5e, 0x2c, 0x76,
+	0x32, 0x36, 0x69, 0xdb, 0x76, 0xd3, 0x27, 0x63, 0x81, 0x15, 0xa2, 0xa8, 0x63, 0xaf, 0xba, 0x38,
+	0x9a, 0x44, 0x44, 0xb7, 0x54, 0x44, 0x09, 0xf3, 0x43, 0x70, 0xad, 0xc0, 0x90, 0x84, 0x2c, 0xc2,
+	0x74, 0xf0, 0xc0, 0xea, 0x2e, 0xd0, 0x6d, 0x6b, 0xd7, 0x96, 0xb1, 0x9b, 0x84, 0xbd, 0xf1, 0x74,
+	0x43, 0x2b, 0x7f, 0x98, 0xfa, 0x8d, 0x89, 0x5e, 0x2c, 0x0e, 0x2b, 0x48, 0xc3, 0x74, 0xf6, 0x7a,
+	0xde, 0x82, 0x09, 0xcb, 0xa1, 0x7e, 0x18, 0xc7, 0x61, 0x7d, 0x57, 0x39, 0x8e, 0x58, 0xc0, 0x10,
+	0x3e, 0x46, 0xea, 0x17, 0x46, 0xb0, 0x99, 0x46, 0x66, 0xca, 0xa7, 0x1d, 0x2f, 0xa4, 0xcf, 0xfb,
+	0x76, 0x48, 0xe5, 0x8d, 0x59, 0x6e, 0xe7, 0x46, 0xa3, 0xc7, 0x18, 0x60, 0x2c, 0x8f, 0xd1, 0x0a,
+	0x51, 0x47, 0x69, 0xfe, 0x34, 0xdb, 0x65, 0x79, 0x7d, 0x07, 0xe0, 0xae, 0xe4, 0x5b, 0xbc, 0x32,
+	0xd0, 0x5b, 0x7c, 0xa0, 0xd0, 0x9c, 0x3b, 0x94, 0x76, 0xf9, 0x1b, 0x44, 0x46, 0x65, 0xba, 0x4b,
+	0x69, 0x17, 0x79, 0xa9, 0xf9, 0x4f, 0x0c, 0xb8, 0x9a, 0x1f, 0xda, 0x68, 0x80, 0x91, 0x76, 0xd8,
+	0x3a, 0x47, 0xdd, 0x24, 0x85, 0x78, 0xab, 0x46, 0x06, 0xe7, 0xb4, 0x70, 0xb8, 0x8c, 0x47, 0xae,
+	0xfb, 0x5e, 0xa0, 0x8e, 0x49, 0x3a, 0x61, 0x80, 0xb6, 0xa8, 0x11, 0x48, 0xd4, 0xe1, 0xf3, 0xe4,
+	0x1d, 0x0c, 0x7b, 0xd0, 0xb5, 0x9a, 0xb4, 0x75, 0xc6, 0xd9, 0x70, 0x4f, 0x20, 0x62, 0x7e, 0xfe,
+	0xd8, 0x4f, 0x37, 0x79, 0x47, 0x01, 0xce, 0xa3, 0x93, 0x77, 0xe4, 0x77, 0x7c, 0x85, 0x44, 0x95,
+	0xcf, 0x1f, 0x7c, 0x81, 0x0b, 0xfb, 0x3f, 0x1a, 0x2d, 0x9a, 0xed, 0x31, 0x53, 0xea, 0xee, 0x9e,
+	0x62, 0x4a, 0xdd, 0xf3, 0xdf, 0x48, 0xa7, 0x9b, 0x93, 0x4e, 0x37, 0x95, 0xe2, 0x75, 0xec, 0x8c,
+	0x52, 0xbc, 0xbe, 0x04, 0x63, 0x5d, 0xcb, 0x67, 0x96, 0xcc, 0xe3, 0xe5, 0x99, 0xa2, 0xdc, 0xcc,
+	0xd0, 0xf1, 0x91, 0x5c, 0xe3, 0x08, 0x50, 0x22, 0x62, 0x57, 0xe9, 0x63, 0xfd, 0xce, 0x32, 0x7f,
+	0xaa, 0x36, 0x53, 0x7b, 0x77, 0x98, 0xa7, 0x6a, 0x86, 0x44, 0x45, 0x4f, 0xd5, 0x74, 0x0d, 0x66,
+	0xf0, 0x92, 0xf7, 0x03, 0xf1, 0x36, 0x85, 0xf5, 0xd0, 0x6d, 0x86, 0x43, 0xf8, 0x7e, 0x56, 0xb8,
+	0x59, 0x7f, 0x94, 0xd1, 0xed, 0x7e, 0xa6, 0x05, 0xe6, 0xf4, 0x62, 0x69, 0xe3, 0xe1, 0x1e, 0x0d,
+	0x59, 0xd0, 0x7b, 0x76, 0xb9, 0x3f, 0x96, 0x90, 0x0f, 0x4e, 0x7c, 0xed, 0x82, 0x2a, 0x3e, 0x06,
+	0x23, 0x5d, 0xaf, 0x25, 0x88, 0xb3, 0x1c, 0x08, 0xf7, 0x6a, 0xe0, 0xa5, 0x2c, 0xd2, 0x17, 0x37,
+	0xad, 0x92, 0x8f, 0x37, 0x2e, 0x5d, 0x64, 0x82, 0x98, 0x00, 0x45, 0x39, 0x23, 0x2b, 0xd2, 0xb3,
+	0x3d, 0xa8, 0x8d, 0xc6, 0x64, 0x45, 0x69, 0x2b, 0x30, 0xaa, 0x25, 0xcf, 0x02, 0xd8, 0xdd, 0x5b,
+	0x56, 0xc7, 0x76, 0x6c, 0xb9, 0xc7, 0x27, 0xb9, 0x8c, 0x09, 0x96, 0xd7, 0x54, 0xe9, 0xc3, 0x83,
+	0xd9, 0x09, 0xf9, 0x6b, 0x1f, 0xb5, 0xd6, 0xe6, 0x47, 0x60, 0x26, 0x5e, 0x3b, 0xb9, 0x53, 0xd4,
+	0xc0, 0x8d, 0x1b, 0xd5, 0x7e, 0x03, 0x17, 0x91, 0x87, 0xfa, 0x0f, 0x5c, 0x48, 0x0a, 0x0a, 0x06,
+	0x6e, 0xfe, 0x75, 0x15, 0xa6, 0xef, 0xb5, 0x6d, 0x77, 0x4f, 0x85, 0x29, 0x8a, 0xf4, 0xf3, 0xc6,
+	0xe9, 0xe8, 0xe7, 0x5f, 0x80, 0x9a, 0xa3, 0x6b, 0x7e, 0x04, 0xe7, 0x60, 0xb9, 0x71, 0x20, 0x25,
+	0xfe, 0x6a, 0x58, 0x29, 0x68, 0x83, 0x85, 0xbd, 0x49, 0x08, 0x63, 0x4d, 0x95, 0x88, 0xad, 0x74,
+	0xe8, 0x1d, 0x7d, 0x2d, 0xe6, 0xf4, 0x60, 0x0c, 0xd1, 0xa1, 0x97, 0x5b, 0x4d, 0xe2, 0x62, 0xa2,
+	0xc9, 0x2b, 0x74, 0x4f, 0x44, 0x61, 0x59, 0xf7, 0xad, 0xad, 0x2d, 0xbb, 0x29, 0x1d, 0xdd, 0xc4,
+	0xae, 0x5a, 0x61, 0x56, 0x28, 0x4b, 0x79, 0x0d, 0x1e, 0x1e, 0xcc, 0xde, 0xcc, 0x0d, 0x8a, 0xc3,
+	0x3f, 0x4d, 0x6e, 0x17, 0xcc, 0x47, 0xc5, 0x82, 0x1a, 0x1e, 0xc3, 0x3d, 0x3a, 0x11, 0x23, 0xe5,
+	0x97, 0x2a, 0x30, 0xcd, 0xf6, 0x0e, 0x8b, 0x51, 0xe7, 0xb0, 0xa0, 0xff, 0x4f, 0xa7, 0xe3, 0xf6,
+	0x45, 0xca, 0xaf, 0x4c, 0xec, 0xbe, 0x15, 0xb8, 0xbc, 0xe5, 0xf9, 0x4d, 0xba, 0x5e, 0x5f, 0x5b,
+	0xf7, 0xa4, 0xb9, 0xda, 0xe2, 0xbd, 0x86, 0x7c, 0x45, 0x71, 0x21, 0xef, 0xad, 0x9c, 0x7a, 0xcc,
+	0xed, 0xc5, 0xfc, 0x0c, 0xe2, 0xf2, 0x8d, 0xae, 0xb0, 0xd3, 0x67, 0xe0, 0xaa, 0xb1, 0x9f, 0xc1,
+	0xad, 0xbc, 0x06, 0x98, 0xdf, 0x8f, 0x99, 0xf3, 0xc8, 0xa0, 0xa9, 0xb7, 0x3c, 0xff, 0x81, 0xe5,
+	0xb7, 0x92, 0x60, 0x47, 0x62, 0x73, 0x9e, 0xc5, 0xe2, 0x66, 0xd8, 0x0f, 0x86, 0xf9, 0x59, 0x03,
+	0x92, 0x51, 0x11, 0x59, 0x74, 0x40, 0x5f, 0xe6, 0x0e, 0x93, 0xd1, 0x01, 0x19, 0x8f, 0xcc, 0xca,
+	0x84, 0x4a, 0x47, 0x35, 0x94, 0x6f, 0x07, 0xa9, 0xd2, 0x51, 0xa5, 0x08, 0x7e, 0x02, 0x54, 0x68,
+	0xb5, 0x6b, 0xd5, 0x18, 0xd4, 0xba, 0xd5, 0x46, 0x56, 0xc6, 0x33, 0x33, 0xd8, 0x6d, 0x1a, 0x28,
+	0x21, 0x9e, 0xc8, 0xcc, 0xc0, 0x4b, 0x50, 0xd6, 0x98, 0x3f, 0x3a, 0x06, 0x5a, 0x34, 0x93, 0x63,
+	0xf0, 0x48, 0x3f, 0x69, 0xc0, 0xe5, 0xa6, 0x63, 0x53, 0x37, 0x4c, 0x05, 0x06, 0x10, 0x74, 0x7a,
+	0xa3, 0x54, 0x98, 0x95, 0x2e, 0x75, 0x97, 0x17, 0xa5, 0xcb, 0x45, 0x3d, 0x07, 0xb8, 0x74, 0x4b,
+	0xc9, 0xa9, 0xc1, 0xdc, 0xc1, 0xf0, 0xf9, 0xf0, 0xf2, 0xe5, 0x45, 0x3d, 0xd6, 0x62, 0x5d, 0x96,
+	0x61, 0x54, 0xcb, 0x1c, 0x57, 0xdb, 0xbe, 0xd7, 0xeb, 0x06, 0x75, 0xee, 0x59, 0x29, 0x56, 0x8c,
+	0xcb, 0x94, 0x6e, 0xc7, 0xc5, 0xa8, 0xb7, 0x61, 0x12, 0x32, 0xf1, 0x73, 0xcd, 0xa7, 0x5b, 0xf6,
+	0x5e, 0x6d, 0x34, 0x96, 0x90, 0xdd, 0xd6, 0xca, 0x31, 0xd1, 0x8a, 0xc7, 0x09, 0x0b, 0x82, 0x1e,
+	0xf5, 0x37, 0x70, 0x45, 0xa6, 0x11, 0x15, 0x71, 0xc2, 0x54, 0x21, 0xc6, 0xf5, 0xe4, 0x47, 0x0c,
+	0x38, 0xcf, 0xa2, 0x86, 0xd8, 0x3e, 0xe3, 0x15, 0x2c, 0xbb, 0x13, 0xd4, 0xc6, 0xcb, 0xc7, 0xee,
+	0x8a, 0x3f, 0xf4, 0x1c, 0x26, 0x80, 0x0a, 0xea, 0x15, 0xd9, 0x0d, 0x24, 0x2b, 0x31, 0x35, 0x02,
+	0xb6, 0x54, 0x81, 0xdd, 0x76, 0x6d, 0xb7, 0x3d, 0xef, 0xb4, 0x83, 0xda, 0xc4, 0x8d, 0xaa, 0x5a,
+	0xaa, 0x46, 0x5c, 0x8c, 0x7a, 0x1b, 0x26, 0x9a, 0xee, 0x05, 0x8c, 0x26, 0x75, 0xa8, 0x58, 0xdf,
+	0xc9, 0xd8, 0xb0, 0x62, 0x43, 0xaf, 0xc0, 0x64, 0x3b, 0xa6, 0x10, 0x51, 0x05, 0x72, 0x95, 0x81,
+	0xf7, 0xe4, 0x17, 0xfb, 0x46, 0xa2, 0x06, 0x53, 0x2d, 0xaf, 0xcf, 0xc3, 0xa5, 0x9c, 0x69, 0x1e,
+	0x8b, 0xf0, 0xfd, 0x8d, 0x01, 0x57, 0x04, 0x7b, 0xa3, 0x12, 0x90, 0xaa, 0x64, 0x05, 0xf9, 0x71,
+	0xff, 0x8d, 0x53, 0x8d, 0xfb, 0xff, 0x35, 0xc8, 0x6f, 0x60, 0xfe, 0xe3, 0x0a, 0xbc, 0xfa, 0xc8,
+	0x73, 0x49, 0x7e, 0xcc, 0x80, 0x29, 0xba, 0x17, 0xfa, 0x56, 0xe4, 0x7e, 0xce, 0x36, 0xe9, 0xd6,
+	0xa9, 0x10, 0x81, 0xb9, 0xa5, 0x18, 0x91, 0xd8, 0xb8, 0x11, 0xa3, 0xaf, 0xd5, 0xa0, 0x3e, 0x1e,
+	0x46, 0x0a, 0x85, 0x24, 0x45, 0xb7, 0xc0, 0x12, 0x72, 0x16, 0x94, 0x35, 0xd7, 0xdf, 0xc3, 0xc2,
+	0xfe, 0x27, 0x21, 0x1f, 0x6b, 0xaf, 0xfc, 0x74, 0x05, 0xa6, 0xd7, 0x7c, 0xa1, 0x11, 0xbd, 0xe3,
+	0x79, 0x3b, 0xc3, 0x4b, 0x88, 0x74, 0x6a, 0x5c, 0xed, 0x4b, 0x8d, 0x37, 0x60, 0x3c, 0x1c, 0x2a,
+	0x28, 0x06, 0x97, 0x61, 0x2b, 0xe5, 0xa6, 0x82, 0x45, 0xd6, 0x23, 0x7b, 0x28, 0xc9, 0xae, 0x08,
+	0x12, 0x37, 0xa7, 0xd9, 0x43, 0x45, 0x6c, 0xca, 0x23, 0xfa, 0xdc, 0x13, 0x95, 0x98, 0x04, 0x62,
+	0xfe, 0x42, 0x05, 0x58, 0xb0, 0x03, 0x26, 0x9b, 0x39, 0x03, 0x79, 0x8f, 0x95, 0x90, 0xf7, 0x94,
+	0x7a, 0xcd, 0xca, 0xc1, 0x16, 0x0a, 0x78, 0xec, 0x94, 0x80, 0x67, 0x7e, 0x18, 0x24, 0xfd, 0x25,
+	0x3a, 0xbf, 0x65, 0xc0, 0x94, 0x6c, 0x79, 0x06, 0x22, 0x9c, 0xef, 0x48, 0x8a, 0x70, 0xde, 0x39,
+	0xc4, 0xbc, 0x0a, 0x64, 0x36, 0x9f, 0x33, 0xe0, 0x9c, 0x6c, 0xb1, 0x4a, 0x3b, 0x9b, 0xd4, 0x27,
+	0xb7, 0x60, 0x3c, 0xe8, 0xf1, 0x0f, 0x29, 0x27, 0xf4, 0xa8, 0x36, 0xa1, 0x39, 0x7f, 0xd3, 0x6a,
+	0xb2, 0xe1, 0x37, 0x44, 0x13, 0x2d, 0xe7, 0xa9, 0x28, 0x40, 0xd5, 0x99, 0x9d, 0x3e, 0xdf, 0x73,
+	0x32, 0x41, 0xcb, 0xd1, 0x73, 0x28, 0xf2, 0x1a, 0xf6, 0x42, 0x62, 0x7f, 0xd5, 0xeb, 0x87, 0xbf,
+	0x90, 0x58, 0x75, 0x80, 0xa2, 0xdc, 0xfc, 0xe2, 0x68, 0xb4, 0xd8, 0xfc, 0x35, 0x7c, 0x07, 0x26,
+	0x9b, 0x3e, 0xb5, 0x42, 0xda, 0x5a, 0xd8, 0x1f, 0x64, 0x70, 0xfc, 0x5e, 0xaf, 0xab, 0x1e, 0x18,
+	0x77, 0x66, 0x57, 0xa8, 0x6e, 0x1d, 0x58, 0x89, 0xb9, 0x8d, 0x42, 0xcb, 0xc0, 0x77, 0xc1, 0xa8,
+	0xf7, 0xc0, 0x8d, 0x7c, 0x37, 0xfa, 0x22, 0xe6, 0x53, 0xb9, 0xcf, 0x5a, 0xa3, 0xe8, 0xa4, 0x07,
+	0xed, 0x1f, 0xe9, 0x13, 0xb4, 0xdf, 0x61, 0x19, 0xce, 0xd9, 0x67, 0x18, 0x2a, 0x05, 0x66, 0xe2,
+	0x83, 0xea, 0x49, 0xd2, 0x39, 0x64, 0x54, 0x28, 0x18, 0x2b, 0xe4, 0x2a, 0x51, 0x88, 0xce, 0x0a,
+	0x45, 0xf2, 0x11, 0x8c, 0xeb, 0x59, 0xfe, 0x37, 0x3d, 0x1b, 0xc4, 0x78, 0x79, 0xa9, 0x9c, 0x1c,
+	0x9e, 0x96, 0x00, 0x42, 0x2c, 0x7d, 0x51, 0x46, 0x08, 0x16, 0x08, 0xeb, 0x5a, 0x2b, 0x3f, 0x6f,
+	0x13, 0xe7, 0x7e, 0x4a, 0x3a, 0xff, 0x16, 0xa4, 0x82, 0x5a, 0x98, 0x95, 0x0b, 0x56, 0x94, 0x2b,
+	0x0a, 0x8b, 0x06, 0x63, 0xfe, 0xc0, 0x48, 0x74, 0x9a, 0xa4, 0x8c, 0x20, 0x5f, 0x80, 0x63, 0x94,
+	0x11, 0xe0, 0x90, 0x6f, 0x51, 0xf9, 0x99, 0xc4, 0x76, 0x7d, 0x3c, 0x9d, 0x9f, 0x69, 0x5a, 0xa2,
+	0x4e, 0xe4, 0x64, 0xea, 0xc1, 0xa5, 0x20, 0x64, 0x81, 0xb0, 0x6d, 0xa9, 0xf7, 0x0a, 0x42, 0xab,
+	0xd3, 0x2d, 0x91, 0x20, 0x49, 0x04, 0x03, 0xc8, 0x82, 0xc2, 0x3c, 0xf8, 0x2c, 0x36, 0x69, 0x8d,
+	0x97, 0x33, 0xbd, 0x20, 0x5f, 0x1f, 0x0d, 0xf9, 0xf1, 0x6d, 0xa5, 0x65, 0x64, 0xb2, 0x7c, 0x78,
+	0x58, 0x88, 0x89, 0x7c, 0x04, 0xae, 0x30, 0x9e, 0x6a, 0xbe, 0x19, 0xda, 0xbb, 0x76, 0xb8, 0x1f,
+	0x0f, 0xe1, 0xf8, 0x59, 0x91, 0xf8, 0xd3, 0x76, 0x25, 0x0f, 0x18, 0xe6, 0xe3, 0x30, 0xff, 0xd2,
+	0x00, 0x92, 0xdd, 0xeb, 0xc4, 0x81, 0x89, 0x96, 0xf2, 0xce, 0x37, 0x4e, 0x24, 0xa7, 0x4a, 0x74,
+	0x85, 0x44, 0x4e, 0xfd, 0x11, 0x06, 0xe2, 0xc1, 0xe4, 0x83, 0x6d, 0x3b, 0xa4, 0x8e, 0x1d, 0x84,
+	0x27, 0x94, 0xc2, 0x25, 0x8a, 0xd8, 0xff, 0xbc, 0x02, 0x8c, 0x31, 0x0e, 0xf3, 0x07, 0x47, 0x60,
+	0x22, 0xca, 0xc9, 0x77, 0xb4, 0x11, 0x62, 0x0f, 0x88, 0x0c, 0x6e, 0xbd, 0xe6, 0x58, 0x2e, 0x1d,
+	0x46, 0xd8, 0xc8, 0xd9, 0xea, 0x7a, 0x06, 0x18, 0xe6, 0x20, 0x20, 0x1f, 0x81, 0xcb, 0xb6, 0xbb,
+	0xe5, 0x5b, 0x51, 0x4c, 0xbb, 0xba, 0x92, 0x4a, 0x95, 0x40, 0xcc, 0x5f, 0xc5, 0xcb, 0x39, 0xe0,
+	0x30, 0x17, 0x09, 0xa1, 0x30, 0x2e, 0x52, 0x8f, 0x2a, 0x19, 0xff, 0xb3, 0xa5, 0x62, 0x6d, 0x72,
+	0x10, 0x31, 0x79, 0x17, 0xbf, 0x03, 0x54, 0xb0, 0x45, 0x6c, 0x4f, 0xf1, 0xbf, 0x52, 0x7f, 0xd4,
+	0x46, 0xcb, 0x3b, 0xb5, 0x3d, 0x9f, 0x04, 0x25, 0x63, 0x7b, 0x26, 0x0b, 0x31, 0x8d, 0xd0, 0xfc,
+	0x0d, 0x03, 0x46, 0x45, 0x9c, 0xa9, 0xd3, 0x67, 0x35, 0x3f, 0x94, 0x60, 0x35, 0x4b, 0x65, 0x38,
+	0xe7, 0x43, 0x2d, 0xcc, 0xbd, 0xfd, 0xeb, 0x06, 0x4c, 0xf2, 0x16, 0x67, 0xc0, 0xfb, 0xbd, 0x98,
+	0xe4, 0xfd, 0xde, 0x51, 0x7a, 0x36, 0x05, 0x9c, 0xdf, 0x6f, 0x54, 0xe5, 0x5c, 0x38, 0x6b, 0xb5,
+	0x0c, 0x97, 0xa4, 0xdf, 0x2a, 0x4b, 0x07, 0xcb, 0xb6, 0xf8, 0xa2, 0xb5, 0x1f, 0x48, 0x13, 0x64,
+	0x11, 0xd8, 0x24, 0x5b, 0x8d, 0x79, 0x7d, 0xc8, 0x2f, 0x19, 0x8c, 0x89, 0x09, 0x7d, 0xbb, 0x39,
+	0x94, 0xea, 0x31, 0x1a, 0xdb, 0xdc, 0xaa, 0x00, 0x26, 0xde, 0x9a, 0x1b, 0x31, 0x37, 0xc3, 0x4b,
+	0x1f, 0x1e, 0xcc, 0xce, 0xe6, 0x08, 0x68, 0xe3, 0xe4, 0xb6, 0x41, 0xf8, 0x3d, 0x7f, 0xdc, 0xb7,
+	0x09, 0x7f, 0x0f, 0xaa, 0x11, 0x93, 0x3b, 0x30, 0x1a, 0x34, 0xbd, 0x2e, 0x3d, 0x4e, 0x8a, 0xfe,
+	0x68, 0x81, 0x1b, 0xac, 0x27, 0x0a, 0x00, 0xd7, 0x3f, 0x0c, 0xd3, 0xfa, 0xc8, 0x73, 0xde, 0xb2,
+	0x8b, 0xfa, 0x5b, 0xf6, 0xd8, 0x76, 0x4f, 0xfa, 0xdb, 0xf7, 0x97, 0x2b, 0x30, 0x86, 0xb4, 0x2d,
+	0x13, 0x66, 0x1d, 0xf1, 0xea, 0xb5, 0x55, 0x16, 0xd1, 0x4a, 0x79, 0xdf, 0x38, 0x3d, 0x25, 0x0a,
+	0x4b, 0x1d, 0x1a, 0xaf, 0x81, 0x9e, 0x48, 0x94, 0xb8, 0x51, 0x1a, 0xa1, 0x6a, 0xf9, 0x34, 0xe2,
+	0x62, 0x62, 0xa7, 0x9d, 0x38, 0xe8, 0xdf, 0x1b, 0x30, 0x9d, 0xc8, 0xcb, 0xd4, 0x89, 0x85, 0xc4,
+	0xe5, 0x8d, 0x31, 0x94, 0x9b, 0xce, 0xa3, 0x7d, 0x1a, 0x09, 0xc1, 0xf3, 0xfd, 0x28, 0x25, 0xc1,
+	0xc9, 0xa4, 0x70, 0x32, 0x3f, 0x63, 0xc0, 0x55, 0x35, 0xa1, 0x64, 0x08, 0x66, 0x26, 0xd8, 0xb0,
+	0xba, 0x36, 0x17, 0x92, 0xea, 0x62, 0xe6, 0xf9, 0xb5, 0x65, 0x5e, 0x86, 0x51, 0x6d, 0x22, 0x2d,
+	0x6a, 0xe5, 0xc8, 0xb4, 0xa8, 0xaf, 0xd5, 0x12, 0xbd, 0x8e, 0xc6, 0x7c, 0x42, 0x84, 0x58, 0xd8,
+	0x04, 0x9a, 0x6f, 0x85, 0xc9, 0x46, 0xe3, 0xce, 0x7c, 0xb3, 0xc9, 0x74, 0x59, 0x83, 0xab, 0x32,
+	0xcc, 0x4f, 0x54, 0xe1, 0x9c, 0x0c, 0xa2, 0x6f, 0xbb, 0x2d, 0xa6, 0xc4, 0x3c, 0xfd, 0x3b, 0x65,
+	0x1d, 0x26, 0x85, 0x7c, 0x2a, 0x36, 0xcc, 0xc9, 0xa5, 0x09, 0x0d, 0xd5, 0x28, 0x9d, 0xcf, 0x2c,
+	0xaa, 0xc0, 0x18, 0x10, 0xb9, 0x0b, 0x63, 0x2f, 0x31, 0xfa, 0xa6, 0xce, 0xc5, 0x40, 0x64, 0x26,
+	0xda, 0xf4, 0x9c, 0x34, 0x06, 0x28, 0x41, 0x90, 0x80, 0xfb, 0x91, 0x71, 0x86, 0x6b, 0x98, 0xd0,
+	0x8b, 0x89, 0x95, 0x8d, 0xd2, 0x3c, 0x4f, 0x4b, 0x77, 0x34, 0xfe, 0x0b, 0x23, 0x44, 0x3c, 0x19,
+	0x63, 0xa2, 0xc7, 0x2b, 0x24, 0x19, 0x63, 0x62, 0xcc, 0x05, 0x57, 0xe3, 0x3b, 0xe0, 0x4a, 0xee,
+	0x62, 0x1c, 0xcd, 0xce, 0x9a, 0xff, 0xa2, 0x02, 0x23, 0x2c, 0xa5, 0xe2, 0x19, 0xec, 0xcc, 0x17,
+	0x13, 0xdc, 0xce, 0xbb, 0x4a, 0xa7, 0x83, 0x2c, 0x92, 0xaa, 0x6d, 0xa5, 0xa4, 0x6a, 0xef, 0x29,
+	0x8d, 0xa1, 0xbf, 0x48, 0xed, 0xc7, 0xab, 0x00, 0xac, 0xd9, 0x82, 0xd5, 0xdc, 0x11, 0x14, 0x27,
+	0xda, 0xcd, 0xa9, 0x44, 0xcc, 0xd9, 0x6d, 0x78, 0x96, 0x76, 0x0a, 0x26, 0x8c, 0xf9, 0xfc, 0x26,
+	0xaa, 0x55, 0x63, 0x19, 0xb6, 0xb8, 0x9b, 0x50, 0xd6, 0x24, 0xa9, 0xc5, 0xc8, 0x49, 0x51, 0x8b,
+	0xef, 0x64, 0xe6, 0x81, 0x5d, 0x47, 0x69, 0xf8, 0x46, 0xcb, 0xc7, 0x3b, 0x13, 0x6b, 0xbc, 0xd0,
+	0x6b, 0xee, 0x30, 0xc8, 0x11, 0x48, 0x21, 0x7f, 0xd1, 0x0a, 0x50, 0x47, 0x68, 0xee, 0xc1, 0x38,
+	0xfb, 0x40, 0x4c, 0x5d, 0xdb, 0xd1, 0xbe, 0x4e, 0xa5, 0xfc, 0x5b, 0x42, 0x82, 0x3b, 0x92, 0xca,
+	0x7c, 0xc2, 0x80, 0x0b, 0xa9, 0xb6, 0x03, 0xbc, 0x29, 0x4f, 0x85, 0x66, 0x9b, 0xbf, 0x66, 0xc0,
+	0x04, 0x1b, 0xcb, 0x19, 0x10, 0xba, 0xff, 0x37, 0x49, 0xe8, 0xde, 0x5e, 0x76, 0x89, 0x0b, 0xe8,
+	0xdb, 0x9f, 0x55, 0x80, 0xe7, 0x7d, 0x95, 0x16, 0x2d, 0x9a, 0xad, 0x8a, 0x51, 0x60, 0x64, 0x73,
+	0x43, 0x9a, 0xba, 0xa4, 0x84, 0xb9, 0x9a, 0xb9, 0xcb, 0x1b, 0x12, 0xd6, 0x2c, 0x89, 0x63, 0x9b,
+	0x63, 0x8a, 0xf3, 0x32, 0x9c, 0x0b, 0x98, 0xe7, 0x60, 0x14, 0xa6, 0x70, 0xa4, 0xbc, 0xe0, 0x9e,
+	0xbb, 0x20, 0xaa, 0xa9, 0x08, 0x95, 0x66, 0x43, 0x87, 0x8d, 0x49, 0x54, 0x4c, 0xc3, 0xbf, 0xe9,
+	0x78, 0xcd, 0x1d, 0x16, 0xe0, 0x5c, 0xf9, 0x77, 0x71, 0x0d, 0xff, 0x42, 0x54, 0x8a, 0x5a, 0x8b,
+	0xa1, 0xcc, 0x86, 0xfe, 0xc4, 0x10, 0x2b, 0x7d, 0x8c, 0xcd, 0x7b, 0x86, 0x14, 0xed, 0x75, 0x29,
+	0x8a, 0x16, 0x51, 0xe8, 0x14, 0x55, 0x9b, 0x55, 0x0f, 0x86, 0x91, 0x58, 0x50, 0xaf, 0xb3, 0xf9,
+	0xe6, 0x2f, 0xc8, 0x69, 0x46, 0xa9, 0x83, 0xbb, 0x70, 0xce, 0xd1, 0x33, 0xdd, 0xd7, 0x8c, 0xf2,
+	0x49, 0xf2, 0x23, 0xe3, 0xc9, 0x44, 0x31, 0x26, 0x11, 0x30, 0x0d, 0xb7, 0x9a, 0x9d, 0xb0, 0x61,
+	0xac, 0xc4, 0xce, 0x57, 0x6b, 0x7a, 0x05, 0x26, 0xdb, 0xb1, 0x8c, 0xdb, 0x8f, 0x8b, 0xb1, 0x73,
+	0x89, 0xc5, 0x22, 0xed, 0x52, 0xb7, 0x45, 0xdd, 0xe6, 0x3e, 0xe7, 0x99, 0x5b, 0x1e, 0x93, 0x15,
+	0x8d, 0x3d, 0xa0, 0xb4, 0x15, 0x89, 0xfe, 0x9f, 0x2f, 0x7d, 0x11, 0x16, 0xa1, 0x78, 0x9e, 0x83,
+	0x17, 0x37, 0x8a, 0xf8, 0x1f, 0x25, 0x4a, 0x86, 0xbc, 0xeb, 0x7b, 0x9b, 0x11, 0x6b, 0x77, 0xf2,
+	0xc8, 0xd7, 0x38, 0x78, 0x81, 0x5c, 0xfc, 0x8f, 0x12, 0xa5, 0xb9, 0x06, 0x4f, 0x0e, 0xd0, 0xf5,
+	0x38, 0x2c, 0xfc, 0x51, 0x10, 0xc5, 0xec, 0x8f, 0x03, 0xf1, 0x0f, 0x0c, 0x78, 0x8d, 0x06, 0x72,
+	0x69, 0x8f, 0xbd, 0x2a, 0xea, 0x56, 0xd7, 0x6a, 0xb2, 0x37, 0x32, 0x0f, 0xbd, 0x76, 0xac, 0x5c,
+	0xa7, 0x9f, 0x30, 0x60, 0x5c, 0x98, 0x8d, 0x29, 0xf2, 0xfb, 0xe2, 0x90, 0x4b, 0x5e, 0x38, 0x24,
+	0x95, 0x44, 0x49, 0xcd, 0x4d, 0xfc, 0x0e, 0x50, 0xe1, 0x37, 0xff, 0xdd, 0x28, 0x7c, 0xd3, 0xe0,
+	0x80, 0xc8, 0x9f, 0x18, 0xe9, 0x3c, 0xfb, 0x53, 0xcf, 0x74, 0x4e, 0x77, 0xf0, 0x91, 0x14, 0x45,
+	0x3e, 0xcc, 0x9f, 0xcf, 0xa4, 0x71, 0x3e, 0x21, 0x01, 0x4d, 0x3c, 0x31, 0xf2, 0x4f, 0x0d, 0x98,
+	0x66, 0xd7, 0x52, 0x44, 0x5c, 0xc4, 0x67, 0xea, 0x9e, 0xf2, 0x4c, 0xef, 0x69, 0x28, 0x53, 0x31,
+	0x9a, 0xf4, 0x2a, 0x4c, 0x8c, 0x8d, 0x6c, 0x24, 0xd5, 0x66, 0xe2, 0xb9, 0xf7, 0x44, 0x1e, 0x37,
+	0x72, 0x9c, 0x24, 0xe9, 0xd7, 0x1d, 0x38, 0x9f, 0x5c, 0xf9, 0xd3, 0x14, 0x2f, 0xb1, 0x40, 0x53,
+	0x99, 0xd9, 0x1f, 0x4b, 0xb8, 0xf2, 0xf7, 0x47, 0x61, 0x56, 0x5b, 0xea, 0xbc, 0xb0, 0x22, 0xe4,
+	0xf3, 0x06, 0x4c, 0x59, 0xae, 0x2b, 0x0d, 0x7c, 0xd4, 0xfe, 0x6d, 0x0d, 0xf9, 0x55, 0xf3, 0x50,
+	0xcd, 0xcd, 0xc7, 0x68, 0x52, 0x16, 0x2c, 0x5a, 0x0d, 0xea, 0xa3, 0xe9, 0x63, 0x42, 0x5a, 0x39,
+	0x33, 0x13, 0x52, 0xf2, 0x31, 0x75, 0x11, 0x8b, 0x6d, 0xf4, 0xc2, 0x29, 0xac, 0x0d, 0xbf, 0xd7,
+	0x0b, 0xa4, 0x79, 0x9f, 0x36, 0xf8, 0x25, 0x1b, 0x47, 0x7f, 0xa9, 0x8d, 0x94, 0x37, 0x36, 0x3c,
+	0x32, 0xb4, 0x4c, 0x74, 0x77, 0xc7, 0x45, 0x98, 0x44, 0xcf, 0x4c, 0x86, 0xd2, 0x9f, 0xf2, 0x58,
+	0xdb, 0xf2, 0xdf, 0x8e, 0x24, 0xee, 0x8e, 0xc2, 0xf5, 0x18, 0x40, 0xa8, 0xfa, 0x85, 0xd4, 0xee,
+	0x15, 0x34, 0xc9, 0x3e, 0xad, 0x2f, 0x74, 0xb2, 0x5b, 0xb8, 0x7a, 0x76, 0x5b, 0xf8, 0x6f, 0xdd,
+	0x1e, 0x5a, 0x80, 0x2b, 0xda, 0x07, 0x8b, 0x33, 0xc7, 0xf0, 0x80, 0x8b, 0x76, 0x60, 0xab, 0xb0,
+	0xc1, 0x1a, 0x0f, 0xf3, 0x9c, 0x28, 0x46, 0x55, 0x6f, 0xae, 0x24, 0xa8, 0xe3, 0xba, 0xd7, 0xf5,
+	0x1c, 0xaf, 0xbd, 0x3f, 0xff, 0xc0, 0xf2, 0x29, 0x7a, 0xbd, 0x50, 0x42, 0x1b, 0x94, 0x23, 0x5a,
+	0x85, 0x1b, 0x1a, 0xb4, 0xdc, 0xe0, 0x8a, 0xc7, 0x01, 0xf7, 0x5b, 0xe3, 0x30, 0xad, 0xc1, 0x0b,
+	0xc8, 0xcf, 0x1b, 0xf0, 0x08, 0x2d, 0xba, 0x2c, 0x25, 0xa7, 0xff, 0xc2, 0x69, 0x5d, 0xc6, 0x32,
+	0x91, 0x4b, 0x51, 0x35, 0x16, 0x8f, 0x8c, 0xb9, 0x61, 0x07, 0xd1, 0xe7, 0x19, 0xc6, 0x0d, 0x3b,
+	0xf7, 0x7b, 0x4b, 0xdf, 0xd1, 0xe8, 0x37, 0x6a, 0xc8, 0xc8, 0x4f, 0x18, 0x70, 0xd9, 0xc9, 0xd9,
+	0xac, 0x72, 0xf3, 0x37, 0x4e, 0x81, 0x4c, 0x08, 0xad, 0x74, 0x5e, 0x0d, 0xe6, 0x0e, 0x85, 0xfc,
+	0x54, 0x61, 0xd4, 0x4f, 0x21, 0x70, 0x5a, 0x1f, 0x72, 0x90, 0x27, 0x15, 0x00, 0xf4, 0xb3, 0x06,
+	0x90, 0x56, 0xe6, 0xe1, 0x50, 0x1b, 0x2f, 0x9f, 0x79, 0xad, 0xef, 0x8b, 0x44, 0x98, 0x15, 0x64,
+	0xcb, 0x31, 0x67, 0x10, 0xfc, 0x3b, 0x87, 0x39, 0xc7, 0xb7, 0x36, 0x71, 0x22, 0xdf, 0x39, 0x8f,
+	0x32, 0x88, 0xef, 0x9c, 0x57, 0x83, 0xb9, 0x43, 0x31, 0x7f, 0x75, 0x4c, 0xc8, 0xb1, 0xb8, 0xde,
+	0x77, 0x13, 0xc6, 0x36, 0xb9, 0x4c, 0xb0, 0x66, 0x0c, 0x27, 0xe4, 0x95, 0x92, 0x45, 0x11, 0xa0,
+	0x8b, 0xff, 0x8f, 0x12, 0x32, 0xf9, 0x20, 0x54, 0x5b, 0xae, 0xf2, 0xe3, 0x7c, 0xe7, 0x10, 0xe2,
+	0xc2, 0xd8, 0x44, 0x97, 0xf9, 0x7c, 0x30, 0xa0, 0xc4, 0x85, 0x09, 0x57, 0x8a, 0x7e, 0xe4, 0xeb,
+	0xfc, 0x7d, 0x65, 0x11, 0x44, 0x22, 0xa4, 0x48, 0x70, 0xa5, 0x4a, 0x30, 0xc2, 0xc1, 0xf0, 0xa5,
+	0x74, 0x2d, 0xa5, 0xf1, 0x45, 0xc2, 0xcf, 0x7e, 0xf2, 0x6d, 0xca, 0x42, 0x57, 0xda, 0x6e, 0x28,
+	0x04, 0x4f, 0x25, 0x8d, 0x1a, 0x18, 0xb6, 0x75, 0x06, 0x25, 0x96, 0xf0, 0xf0, 0x9f, 0x01, 0x4a,
+	0xe0, 0x6c, 0x1b, 0x08, 0xbf, 0xcc, 0xda, 0xf8, 0x70, 0xdb, 0x40, 0xb8, 0x7a, 0x8a, 0x6d, 0x20,
+	0xfe, 0x47, 0x09, 0x99, 0x7c, 0x98, 0x49, 0x08, 0xa5, 0x19, 0xca, 0xc4, 0x70, 0x4b, 0x17, 0xd9,
+	0xa0, 0x48, 0x8f, 0x39, 0xf1, 0x0b, 0x23, 0xf8, 0x64, 0x13, 0xc6, 0x6d, 0xe1, 0x1f, 0x56, 0x9b,
+	0x2c, 0xbf, 0xed, 0xa4, 0x8b, 0x99, 0x10, 0x14, 0xc8, 0x1f, 0xa8, 0x00, 0x9b, 0xbf, 0x05, 0x42,
+	0x6f, 0x21, 0x2d, 0xfd, 0xb6, 0x60, 0x42, 0x81, 0x1b, 0x26, 0x28, 0xc3, 0x6d, 0x59, 0x2d, 0xa6,
+	0xa6, 0x7e, 0x61, 0x04, 0x9b, 0xe5, 0xf8, 0xc8, 0x46, 0xd7, 0x88, 0x33, 0xff, 0x0d, 0x16, 0x59,
+	0xe3, 0x25, 0x80, 0x66, 0x1c, 0xe3, 0xaa, 0x5a, 0x7e, 0x6b, 0x45, 0xf1, 0xaf, 0x62, 0x65, 0x55,
+	0x54, 0x14, 0xa0, 0x86, 0xa4, 0xc0, 0x12, 0x72, 0xa4, 0x94, 0x25, 0xe4, 0xbb, 0xe1, 0x82, 0xb4,
+	0x3c, 0x59, 0x6e, 0x51, 0xfe, 0x5a, 0x95, 0x96, 0xf1, 0xdc, 0x26, 0xa9, 0x9e, 0xac, 0xc2, 0x74,
+	0x5b, 0xf2, 0xcb, 0x06, 0x33, 0xec, 0x17, 0x0c, 0x42, 0x6d, 0xac, 0xbc, 0x1f, 0x62, 0xfc, 0xf5,
+	0xe7, 0x14, 0xbf, 0x21, 0x78, 0xf1, 0xe7, 0xd4, 0x89, 0x56, 0xc5, 0x27, 0x24, 0x04, 0x89, 0x46,
+	0x4d, 0x7e, 0x93, 0x3d, 0x37, 0x1c, 0xc7, 0x6b, 0x5a, 0x21, 0x8f, 0x23, 0x24, 0xbc, 0x92, 0xee,
+	0x0f, 0x39, 0x8b, 0xf9, 0x18, 0xa2, 0x98, 0xc8, 0xb7, 0x46, 0x8f, 0x8a, 0xb8, 0xe6, 0x84, 0xe6,
+	0xa2, 0x0f, 0x9f, 0xfc, 0x8c, 0x01, 0xaf, 0x11, 0xae, 0x60, 0x75, 0xea, 0x87, 0xf6, 0x96, 0xdd,
+	0xb4, 0x42, 0x2a, 0x42, 0x79, 0x29, 0x4f, 0x18, 0x61, 0xb7, 0x39, 0x71, 0x6c, 0xbb, 0xcd, 0xa7,
+	0x0e, 0x0f, 0x66, 0x5f, 0x53, 0x1f, 0x00, 0x36, 0x0e, 0x34, 0x02, 0xa6, 0xba, 0x70, 0xf4, 0x80,
+	0xa9, 0xb5, 0xc9, 0xf2, 0xaa, 0x8b, 0x44, 0xe4, 0x55, 0xf1, 0x56, 0x49, 0x14, 0x61, 0x12, 0xd5,
+	0xf5, 0x1d, 0x38, 0x97, 0xd8, 0x68, 0xa7, 0x2a, 0xf4, 0x71, 0x61, 0x26, 0xbd, 0x1f, 0x4e, 0xd5,
+	0x86, 0xe9, 0x2e, 0x4c, 0x46, 0x17, 0x15, 0x79, 0x5c, 0x43, 0x14, 0x5f, 0xfb, 0x77, 0xe9, 0xbe,
+	0xc0, 0x3a, 0x9b, 0x78, 0x8e, 0x09, 0x8d, 0xc4, 0x73, 0xac, 0x40, 0x02, 0x34, 0x7f, 0x5b, 0x6a,
+	0x24, 0xd6, 0x69, 0xa7, 0xeb, 0x58, 0x21, 0x7d, 0xe5, 0xeb, 0xe3, 0xcd, 0xff, 0x62, 0x88, 0xfb,
+	0x46, 0x5c, 0xab, 0x3c, 0xbc, 0xa9, 0xc8, 0x87, 0xc4, 0x43, 0x67, 0x0d, 0x13, 0xde, 0x34, 0x06,
+	0x83, 0x3a, 0x4c, 0x16, 0x3f, 0x56, 0x31, 0x22, 0x4a, 0xa0, 0x71, 0x6b, 0x38, 0xc6, 0x20, 0xe2,
+	0x79, 0x22, 0x55, 0xab, 0x2a, 0x09, 0x30, 0xc6, 0x65, 0x5a, 0x40, 0xb2, 0x7d, 0xd8, 0x9b, 0x55,
+	0xf9, 0x50, 0x18, 0xc9, 0x0c, 0x06, 0x19, 0x3f, 0x0a, 0x25, 0xaf, 0xa9, 0x14, 0xc9, 0x6b, 0xcc,
+	0x5f, 0xa9, 0x40, 0x6e, 0x9e, 0x7c, 0xa6, 0xe6, 0x17, 0xfe, 0x9f, 0x12, 0x09, 0x67, 0x65, 0x84,
+	0x73, 0x28, 0xca, 0x1a, 0xe6, 0x05, 0xcd, 0xa4, 0x1b, 0x6e, 0x8b, 0x67, 0x0e, 0x88, 0xa9, 0x84,
+	0xee, 0x05, 0xbd, 0x94, 0xd7, 0x00, 0xf3, 0xfb, 0xb1, 0xfc, 0xca, 0x1d, 0x6b, 0x2f, 0x0d, 0x6d,
+	0x88, 0xfc, 0xca, 0xab, 0x19, 0x68, 0x98, 0x83, 0x81, 0x5d, 0xa4, 0x56, 0xb3, 0x49, 0xbb, 0x21,
+	0x6d, 0x89, 0x29, 0x2a, 0x85, 0x28, 0xbf, 0x48, 0xe7, 0x93, 0x55, 0x98, 0x6e, 0x6b, 0x7e, 0x75,
+	0x04, 0x1e, 0x49, 0x2e, 0x22, 0x3b, 0xa1, 0xca, 0x45, 0xf3, 0xbd, 0xca, 0x5f, 0x41, 0x2c, 0xe4,
+	0xd3, 0x69, 0x7f, 0x85, 0x5a, 0xdd, 0xa7, 0xfc, 0x4a, 0xb6, 0x9c, 0x40, 0x75, 0x4a, 0xf8, 0x2e,
+	0x7c, 0x0d, 0xfc, 0x2d, 0x0b, 0xfc, 0x4a, 0xab, 0xa7, 0xea, 0x57, 0xfa, 0x49, 0x03, 0xae, 0x27,
+	0x8b, 0x6f, 0xd9, 0xae, 0x1d, 0x6c, 0xcb, 0x40, 0xed, 0xc7, 0x77, 0x97, 0xe0, 0x19, 0x21, 0x57,
+	0x0a, 0x21, 0x62, 0x1f, 0x6c, 0xe4, 0x53, 0x06, 0x3c, 0x9a, 0x5a, 0x97, 0x44, 0xd8, 0xf8, 0xe3,
+	0x7b, 0x4e, 0x70, 0xef, 0xfd, 0x95, 0x62, 0x90, 0xd8, 0x0f, 0x9f, 0xf9, 0x2f, 0x2b, 0x30, 0xca,
+	0xf5, 0xf9, 0xaf, 0x0c, 0x03, 0x72, 0x3e, 0xd4, 0x42, 0x9b, 0xaa, 0x76, 0xca, 0xa6, 0xea, 0xbd,
+	0xe5, 0x51, 0xf4, 0x37, 0xaa, 0xfa, 0x56, 0xb8, 0xca, 0x9b, 0xcd, 0xb7, 0xb8, 0x10, 0x25, 0xa0,
+	0xad, 0xf9, 0x56, 0x8b, 0xc7, 0x0e, 0x19, 0xd6, 0x2b, 0xd6, 0x64, 0x01, 0x72, 0x38, 0x6c, 0xed,
+	0xf8, 0x92, 0x5d, 0x98, 0xf0, 0xe5, 0x11, 0x96, 0xdf, 0x66, 0xa5, 0xf4, 0xd4, 0x72, 0xc8, 0x82,
+	0x78, 0x0d, 0xa9, 0x5f, 0x18, 0xe1, 0x32, 0xbf, 0x3c, 0x06, 0xb5, 0xa2, 0x4e, 0xcc, 0x7b, 0xff,
+	0x6a, 0x33, 0xe6, 0xe6, 0x98, 0x1b, 0xb3, 0xe7, 0xdb, 0xa1, 0x2d, 0x0d, 0x5d, 0x4a, 0x3e, 0x73,
+	0xeb, 0xf3, 0xd1, 0xa8, 0x78, 0x84, 0xe2, 0x7a, 0x2e, 0x06, 0x2c, 0xc0, 0xcc, 0x72, 0x57, 0xee,
+	0xc4, 0xe9, 0x6a, 0x2a, 0xe5, 0x6d, 0xb9, 0xf8, 0xb4, 0xb5, 0x94, 0x36, 0x6a, 0x50, 0x51, 0x84,
+	0x2b, 0x59, 0xae, 0xa1, 0x63, 0xc8, 0x83, 0x60, 0xfb, 0x2e, 0xdd, 0xef, 0x5a, 0xb6, 0x32, 0x67,
+	0x28, 0x8f, 0xbc, 0xd1, 0xb8, 0x23, 0x41, 0x25, 0x91, 0x6b, 0xe5, 0x1a, 0x3a, 0xa6, 0x7f, 0x38,
+	0xe7, 0xe9, 0xce, 0xfc, 0xc3, 0x58, 0xab, 0xe6, 0x46, 0x05, 0x10, 0x2c, 0x74, 0xb2, 0x2a, 0x89,
+	0x92, 0xed, 0x89, 0x8b, 0x41, 0xfa, 0xca, 0x92, 0x44, 0x6d, 0xb5, 0x1c, 0x73, 0x53, 0x70, 0xff,
+	0x89, 0xe7, 0x78, 0xb6, 0x3a, 0x8b, 0x9e, 0x0f, 0x8a, 0x86, 0xcd, 0xd6, 0x92, 0xdb, 0xf4, 0xf7,
+	0xb9, 0xbb, 0x29, 0x1b, 0xd4, 0x58, 0xf9, 0x41, 0xb1, 0x2c, 0x34, 0x09, 0x60, 0xc9, 0x41, 0x65,
+	0xab, 0xb3, 0xe8, 0x59, 0x3c, 0xeb, 0x6b, 0x05, 0x7b, 0xec, 0xef, 0x4c, 0xf4, 0x05, 0xe6, 0xf0,
+	0xc3, 0xd7, 0xe0, 0x15, 0xe2, 0xf0, 0xc3, 0xc7, 0x5a, 0x60, 0xf5, 0xf7, 0x6b, 0xcc, 0x62, 0x3b,
+	0x1d, 0xae, 0x7f, 0x20, 0x77, 0x91, 0x33, 0x33, 0x48, 0x7b, 0x6d, 0x9c, 0xa3, 0xac, 0x1a, 0x7b,
+	0x49, 0xa7, 0xf3, 0x93, 0x99, 0xcf, 0xc3, 0xb9, 0x84, 0xd1, 0x9f, 0x16, 0x89, 0x2b, 0x2f, 0x84,
+	0x98, 0x1e, 0x68, 0xab, 0xd2, 0x2f, 0x42, 0x58, 0xbc, 0xe5, 0xb3, 0x94, 0xed, 0xef, 0xcc, 0x96,
+	0xff, 0x34, 0x91, 0x5b, 0x9e, 0xeb, 0x07, 0x5e, 0x84, 0x31, 0x1e, 0x12, 0x4c, 0xdd, 0x98, 0xcf,
+	0x96, 0x0e, 0x35, 0x16, 0x88, 0x97, 0x94, 0xf8, 0x1f, 0x25, 0x54, 0x96, 0x2d, 0x58, 0x0f, 0x7a,
+	0x77, 0x2f, 0x7e, 0xb4, 0x5d, 0x4e, 0x87, 0xc8, 0xe3, 0x5b, 0x32, 0xd3, 0x9a, 0xa0, 0xd0, 0x2e,
+	0x88, 0xbb, 0xac, 0x54, 0x34, 0x77, 0xa6, 0x59, 0x18, 0x4f, 0x68, 0x15, 0x5e, 0x02, 0xa0, 0x6a,
+	0xe3, 0x2a, 0x1f, 0xcd, 0x77, 0x97, 0x8b, 0x53, 0x1f, 0x6d, 0x7f, 0xc5, 0x78, 0x46, 0x45, 0x01,
+	0x6a, 0x48, 0x88, 0x0f, 0x53, 0xdb, 0xf6, 0xa6, 0xc8, 0x37, 0x11, 0xd9, 0x78, 0x97, 0x62, 0x0f,
+	0xef, 0xc4, 0x60, 0xc4, 0xfb, 0x5e, 0x2b, 0x40, 0x1d, 0x09, 0xf1, 0x13, 0x71, 0x36, 0xc7, 0xca,
+	0xb3, 0x44, 0xb1, 0xcc, 0x39, 0x9e, 0x67, 0x41, 0x8c, 0x4d, 0x17, 0xc0, 0x8d, 0x02, 0xe9, 0x0d,
+	0xa3, 0x6d, 0x88, 0xc3, 0xf1, 0x09, 0xa6, 0x23, 0xfe, 0x8d, 0x1a, 0x06, 0xb6, 0xae, 0x9d, 0x38,
+	0xf0, 0x73, 0x6d, 0xa2, 0xfc, 0xba, 0x6a, 0xf1, 0xa3, 0xa5, 0xdc, 0x24, 0x2e, 0x40, 0x1d, 0x09,
+	0x9b, 0x63, 0x27, 0x8a, 0x59, 0x5c, 0x9b, 0x2c, 0x3f, 0xc7, 0x38, 0xf2, 0xb1, 0xcc, 0x48, 0x1e,
+	0xfd, 0x46, 0x0d, 0x03, 0xd3, 0xac, 0x44, 0x4a, 0x29, 0x28, 0x2f, 0x7d, 0x1a, 0x48, 0x21, 0xf5,
+	0x96, 0x58, 0x08, 0x33, 0xc5, 0xcf, 0xe9, 0xa3, 0x9a, 0x00, 0x86, 0x87, 0xb1, 0x66, 0xb4, 0x23,
+	0x23, 0x90, 0x89, 0x4d, 0x8d, 0xa7, 0xfb, 0x9a, 0x1a, 0xd7, 0xe1, 0xa2, 0xb0, 0xb8, 0x97, 0xae,
+	0x37, 0x9c, 0x20, 0x9c, 0x8b, 0xb5, 0x1b, 0x8d, 0x74, 0x25, 0x66, 0xdb, 0x0b, 0x82, 0x4f, 0x5b,
+	0xbc, 0xef, 0x79, 0x9d, 0xe0, 0x8b, 0x32, 0x8c, 0x6a, 0xc9, 0x2e, 0x4c, 0x07, 0x9a, 0xdd, 0x72,
+	0xed, 0xc2, 0xb0, 0x7a, 0x29, 0x01, 0x47, 0x04, 0x21, 0xd3, 0x4b, 0x30, 0x81, 0x87, 0x7c, 0x44,
+	0x37, 0xd4, 0x9c, 0x19, 0x2e, 0x3e, 0x6f, 0x36, 0xe2, 0x74, 0x2c, 0x5d, 0x53, 0x55, 0x81, 0x6e,
+	0x3f, 0xd9, 0x4b, 0x9a, 0x24, 0x5e, 0x3c, 0x91, 0xa0, 0x00, 0x47, 0x9a, 0x2c, 0xb2, 0x4f, 0x4b,
+	0xf7, 0xba, 0x5e, 0xc0, 0xfc, 0xe0, 0x1d, 0x2b, 0x08, 0xf8, 0xe7, 0x21, 0xf1, 0xa7, 0x5d, 0x4a,
+	0x57, 0x62, 0xb6, 0x3d, 0xf9, 0x3e, 0x03, 0x66, 0x82, 0xfd, 0x20, 0xa4, 0x1d, 0x76, 0x6d, 0x79,
+	0x2e, 0x65, 0xaa, 0xd1, 0x4b, 0xe5, 0xa3, 0xb3, 0x36, 0x52, 0xb0, 0xc4, 0xb5, 0x93, 0x2e, 0xc5,
+	0x0c, 0x4e, 0xb6, 0x73, 0xf4, 0xb0, 0x02, 0xb5, 0xcb, 0xe5, 0x77, 0x8e, 0x1e, 0xb2, 0x40, 0xec,
+	0x1c, 0xbd, 0x04, 0x13, 0x78, 0x98, 0x9d, 0x7b, 0xa0, 0xf2, 0xd5, 0xf2, 0x15, 0xbc, 0x12, 0x47,
+	0x72, 0x6b, 0xe8, 0x15, 0x98, 0x6c, 0x47, 0x3e, 0x0e, 0xd3, 0xfa, 0xdd, 0x59, 0xbb, 0x7a, 0xd2,
+	0x11, 0x77, 0xc5, 0xc8, 0xf5, 0xaa, 0x04, 0x42, 0x82, 0x70, 0xb5, 0x19, 0x3f, 0xd2, 0xf5, 0xf3,
+	0x7d, 0x8d, 0x4f, 0x41, 0x3c, 0xa6, 0x73, 0x5b, 0x60, 0x41, 0x4f, 0xa6, 0xe7, 0x75, 0x44, 0x42,
+	0xad, 0x5a, 0xad, 0xbc, 0x9e, 0x57, 0xe6, 0xe4, 0x12, 0xdc, 0xa6, 0xfc, 0x81, 0x0a, 0xb0, 0xf9,
+	0x7b, 0x4c, 0xee, 0xae, 0x44, 0x2e, 0x67, 0xa1, 0x48, 0x68, 0x25, 0xa4, 0x50, 0x0b, 0x43, 0x89,
+	0x88, 0x0a, 0xa3, 0xa2, 0x9b, 0xbf, 0x6b, 0xc0, 0xf9, 0xb8, 0xd9, 0x19, 0xbc, 0x6f, 0x9a, 0xc9,
+	0xf7, 0xcd, 0x7b, 0x86, 0x9b, 0x57, 0xc1, 0x23, 0xe7, 0x7f, 0x57, 0xf4, 0x59, 0x71, 0x16, 0x76,
+	0x37, 0xa1, 0x98, 0x67, 0xa8, 0xef, 0x0c, 0xa3, 0x98, 0xd7, 0x7d, 0xc4, 0xe3, 0xf9, 0xe6, 0x28,
+	0xea, 0xbf, 0x33, 0xc1, 0x44, 0x0e, 0x11, 0x09, 0x21, 0xe2, 0x18, 0x15, 0x6a, 0xb1, 0x00, 0x47,
+	0x71, 0x94, 0x2f, 0xe9, 0x77, 0xcc, 0x10, 0x91, 0xcc, 0x13, 0x13, 0xee, 0x7b, 0xb3, 0x98, 0x3f,
+	0x38, 0x03, 0x53, 0x9a, 0x74, 0x32, 0x65, 0x66, 0x60, 0x9c, 0x85, 0x99, 0x41, 0x08, 0x53, 0xcd,
+	0x28, 0xff, 0x91, 0x5a, 0xf6, 0x21, 0x71, 0x46, 0x77, 0x5b, 0x9c, 0x59, 0x29, 0x40, 0x1d, 0x0d,
+	0xe3, 0xc0, 0xa2, 0x3d, 0x56, 0x3d, 0x01, 0xe3, 0x8f, 0x7e, 0xfb, 0xea, 0xcd, 0x00, 0x8a, 0x89,
+	0xa7, 0x2d, 0x19, 0x20, 0x37, 0xf2, 0x44, 0x58, 0x0e, 0xee, 0x44, 0x75, 0xa8, 0xb5, 0xcb, 0xaa,
+	0xad, 0x47, 0xcf, 0x4c, 0x6d, 0xcd, 0xb6, 0x81, 0xa3, 0x72, 0xf8, 0x0e, 0x65, 0xc8, 0x14, 0x65,
+	0x02, 0x8e, 0xb7, 0x41, 0x54, 0x14, 0xa0, 0x86, 0xa4, 0xc0, 0xda, 0x64, 0xbc, 0x94, 0xb5, 0x49,
+	0x0f, 0x2e, 0xf1, 0x1c, 0x8e, 0xf5, 0xfd, 0x26, 0x8f, 0x12, 0xef, 0x87, 0xfc, 0x19, 0x3e, 0x51,
+	0x2e, 0x84, 0x16, 0x66, 0x41, 0x61, 0x1e, 0xfc, 0x04, 0x17, 0x3b, 0xd9, 0x97, 0x8b, 0x7d, 0x0b,
+	0x4c, 0x85, 0xb4, 0xb9, 0xed, 0xda, 0x4d, 0xcb, 0x59, 0x5e, 0x94, 0x11, 0x5a, 0x63, 0x86, 0x2c,
+	0xae, 0x42, 0xbd, 0x1d, 0x59, 0x80, 0x6a, 0xcf, 0x6e, 0x49, 0x36, 0xfe, 0x9b, 0x23, 0x39, 0xff,
+	0xf2, 0xe2, 0xc3, 0x83, 0xd9, 0x57, 0xc7, 0xe6, 0x1b, 0xd1, 0xac, 0x6e, 0x76, 0x77, 0xda, 0x37,
+	0x99, 0x8f, 0x62, 0x30, 0xb7, 0xb1, 0xbc, 0x88, 0xac, 0x73, 0x9e, 0x25, 0xce, 0xf4, 0x31, 0x2c,
+	0x71, 0x3e, 0x6b, 0xc0, 0x25, 0x2b, 0xad, 0xa2, 0xa0, 0x41, 0xed, 0x5c, 0x79, 0x6a, 0x99, 0xaf,
+	0xf6, 0x58, 0x78, 0x54, 0xce, 0xef, 0xd2, 0x7c, 0x16, 0x1d, 0xe6, 0x8d, 0x81, 0x09, 0x5f, 0x3a,
+	0x76, 0x3b, 0x4a, 0xa7, 0x2b, 0xbf, 0xfa, 0xf9, 0x72, 0xc2, 0x97, 0xd5, 0x0c, 0x24, 0xcc, 0x81,
+	0x4e, 0x1e, 0xc0, 0x94, 0xc6, 0xe9, 0xd4, 0x2e, 0x0c, 0xc1, 0xd8, 0xa6, 0x94, 0x22, 0xe2, 0xc9,
+	0xaa, 0x15, 0xa0, 0x8e, 0x29, 0x52, 0x41, 0x6a, 0xb2, 0x02, 0xa9, 0x86, 0xe3, 0xb3, 0x9e, 0x29,
+	0xaf, 0x82, 0xcc, 0x87, 0x88, 0x7d, 0xb0, 0xf1, 0xc0, 0x55, 0x4e, 0x32, 0xeb, 0x75, 0xed, 0x62,
+	0x79, 0x67, 0xf3, 0x54, 0x02, 0x6d, 0xb1, 0x35, 0x53, 0x85, 0x98, 0x46, 0xc8, 0x72, 0xd4, 0x53,
+	0x21, 0x0f, 0x8f, 0x5f, 0x58, 0x41, 0x8d, 0x44, 0xd9, 0xc1, 0xc9, 0x52, 0xa6, 0x16, 0x73, 0x7a,
+	0x90, 0x30, 0x21, 0xf0, 0x18, 0xe2, 0xa9, 0x92, 0xce, 0x3f, 0xd0, 0x57, 0xec, 0xa1, 0x96, 0x50,
+	0x4b, 0x47, 0x5f, 0xbb, 0x3c, 0xdc, 0x12, 0x6a, 0xa0, 0xe2, 0x25, 0xd4, 0x0a, 0x31, 0x8d, 0xd0,
+	0xfc, 0x1d, 0x43, 0x0a, 0x6a, 0xcf, 0xd0, 0x0a, 0xe7, 0xb4, 0x55, 0xb8, 0xe6, 0xf3, 0x50, 0x6b,
+	0xa8, 0x78, 0x6e, 0xad, 0x54, 0x18, 0xe6, 0x77, 0xc2, 0x39, 0xa1, 0x28, 0x59, 0xb5, 0xba, 0xf7,
+	0x62, 0xa9, 0x7a, 0xe4, 0xc1, 0x5c, 0xd7, 0x2b, 0x31, 0xd9, 0xd6, 0xfc, 0x0b, 0xa6, 0x57, 0x4d,
+	0x3f, 0x33, 0x37, 0x99, 0x33, 0xaa, 0x4f, 0x59, 0x28, 0x7c, 0xa3, 0xfc, 0x03, 0xa7, 0x2e, 0x40,
+	0x88, 0x07, 0x8e, 0xfc, 0x81, 0x0a, 0x30, 0x7b, 0xca, 0xba, 0x5a, 0x72, 0x01, 0xb9, 0x74, 0xa5,
+	0x78, 0x45, 0x3d, 0x49, 0x81, 0x78, 0x10, 0xea, 0x25, 0x98, 0xc0, 0x63, 0xae, 0x00, 0xc4, 0xc2,
+	0x82, 0xa1, 0x2d, 0xbe, 0x7e, 0x6c, 0x0a, 0xae, 0x0c, 0xeb, 0xeb, 0xc2, 0x73, 0xd8, 0x52, 0x96,
+	0x10, 0x76, 0x7e, 0x2b, 0xa4, 0xfe, 0xfd, 0xfb, 0xab, 0xeb, 0xdb, 0x3e, 0x0d, 0xb6, 0x3d, 0xa7,
+	0x55, 0x32, 0x89, 0x2e, 0x7f, 0xd4, 0x2e, 0xe5, 0x42, 0xc4, 0x02, 0x4c, 0x5c, 0x50, 0xc2, 0x6a,
+	0x18, 0x3f, 0x62, 0x85, 0x74, 0xa1, 0xe7, 0x07, 0xa1, 0x0c, 0xa9, 0x24, 0x04, 0x25, 0xe9, 0x4a,
+	0xcc, 0xb6, 0x4f, 0x03, 0x11, 0x19, 0xaa, 0x19, 0xb3, 0x68, 0x64, 0x81, 0xf0, 0x4a, 0xcc, 0xb6,
+	0xd7, 0x81, 0x88, 0x2f, 0xc5, 0x28, 0xf1, 0x68, 0x16, 0x48, 0x54, 0x89, 0xd9, 0xf6, 0xa4, 0x05,
+	0x8f, 0xf9, 0xb4, 0xe9, 0x75, 0x3a, 0xd4, 0x6d, 0xf1, 0x45, 0x59, 0xb5, 0xfc, 0xb6, 0xed, 0xde,
+	0xf2, 0x2d, 0xde, 0x90, 0xcb, 0x9d, 0x0d, 0x9e, 0x12, 0xef, 0x31, 0xec, 0xd3, 0x0e, 0xfb, 0x42,
+	0x21, 0x1d, 0xb8, 0x20, 0x72, 0xd1, 0xfa, 0xcb, 0x6e, 0x48, 0xfd, 0x5d, 0xcb, 0xa9, 0x8d, 0x97,
+	0xfa, 0x62, 0x9c, 0xb4, 0x6d, 0x24, 0x41, 0x61, 0x1a, 0x36, 0xcb, 0xf2, 0x1c, 0x0d, 0x47, 0x43,
+	0x39, 0x51, 0x3e, 0xcb, 0x33, 0x66, 0xc1, 0x61, 0x1e, 0x0e, 0x16, 0xaa, 0x2f, 0xb4, 0xfc, 0x36,
+	0x0d, 0xeb, 0x6b, 0x1b, 0x6b, 0xd4, 0x6f, 0x32, 0xfa, 0xe3, 0x08, 0x16, 0xd1, 0x10, 0xa0, 0xd6,
+	0xb3, 0xd5, 0x98, 0xd7, 0x87, 0x7c, 0x1c, 0x5e, 0x9b, 0x5c, 0xd4, 0x15, 0xef, 0x01, 0xf5, 0x17,
+	0xbc, 0x9e, 0xdb, 0x4a, 0x02, 0x07, 0x0e, 0xfc, 0xe9, 0xc3, 0x83, 0xd9, 0xd7, 0xe2, 0x20, 0x1d,
+	0x70, 0x30, 0xb8, 0xd9, 0x01, 0x6c, 0x74, 0xbb, 0xb9, 0x03, 0x98, 0x2a, 0x1a, 0x40, 0x41, 0x07,
+	0x1c, 0x0c, 0x2e, 0x13, 0x4a, 0x89, 0x85, 0x11, 0x09, 0x1c, 0x35, 0x8c, 0xd3, 0x1c, 0x23, 0x3f,
+	0xbf, 0xeb, 0xb9, 0x2d, 0xb0, 0xa0, 0x27, 0xf9, 0x7e, 0x03, 0x9e, 0x2a, 0x9a, 0x7e, 0x06, 0xcd,
+	0x39, 0x8e, 0xe6, 0x0d, 0x87, 0x07, 0xb3, 0x4f, 0xe1, 0x80, 0x7d, 0x70, 0x60, 0xe8, 0x39, 0x43,
+	0x89, 0x17, 0x22, 0x33, 0x94, 0xf3, 0x45, 0x43, 0x29, 0xee, 0x83, 0x03, 0x43, 0x67, 0x59, 0x58,
+	0xa4, 0x47, 0x08, 0x53, 0xd7, 0x6a, 0x3a, 0xe7, 0x89, 0x94, 0xbe, 0x59, 0x25, 0xa6, 0xaa, 0xe4,
+	0x26, 0xa6, 0x7a, 0x9d, 0x16, 0x62, 0x6e, 0x32, 0xe6, 0x05, 0x04, 0x64, 0x2d, 0xef, 0xec, 0xeb,
+	0x61, 0x32, 0x62, 0xc6, 0xe4, 0x23, 0x99, 0xc7, 0xb6, 0x8e, 0xb9, 0xb6, 0xb8, 0x9e, 0xc5, 0xfe,
+	0x83, 0x38, 0x49, 0xd9, 0x60, 0xd9, 0x72, 0x8f, 0x34, 0x31, 0xd5, 0xb2, 0xfc, 0x56, 0x0b, 0xb3,
+	0xfc, 0x9e, 0x52, 0xf2, 0xdb, 0x9f, 0x37, 0xe0, 0x42, 0x32, 0xe6, 0x5f, 0xc0, 0x94, 0xeb, 0x32,
+	0x2a, 0xb0, 0x0c, 0xeb, 0xc9, 0xbb, 0xca, 0xb0, 0x38, 0xa8, 0xea, 0x92, 0xaa, 0x89, 0x21, 0xa4,
+	0x56, 0xf9, 0xa1, 0x07, 0x8f, 0x10, 0x20, 0xfd, 0xfe, 0x65, 0x18, 0x13, 0x21, 0x65, 0xd9, 0x55,
+	0x9c, 0x13, 0x0e, 0xe0, 0x6e, 0xf9, 0xc8, 0xb5, 0x65, 0x5c, 0xa6, 0xf5, 0x0c, 0x10, 0x95, 0xbe,
+	0x19, 0x20, 0x50, 0x24, 0x15, 0x1f, 0x42, 0x0d, 0xcd, 0x92, 0x8a, 0x8f, 0x27, 0x12, 0x8a, 0x87,
+	0x09, 0xfd, 0xec, 0x48, 0xf9, 0xa7, 0x83, 0x58, 0x00, 0x4d, 0x4b, 0x7b, 0xbe, 0xaf, 0x86, 0x56,
+	0xc5, 0xec, 0x1c, 0x2d, 0x6f, 0xf2, 0x2d, 0x97, 0x7c, 0x80, 0x98, 0x9d, 0xd1, 0x41, 0x1a, 0x2b,
+	0x3c, 0x48, 0x5b, 0x30, 0x2e, 0x8f, 0x42, 0x6d, 0xbc, 0x3c, 0x13, 0x2c, 0xcd, 0x5e, 0xb4, 0x78,
+	0xf8, 0xa2, 0x00, 0x15, 0x70, 0xc6, 0x28, 0x76, 0xac, 0x3d, 0x66, 0xfe, 0xce, 0x2f, 0xf2, 0x51,
+	0xbd, 0x29, 0x2f, 0x46, 0x55, 0xcf, 0x9b, 0x0a, 0x4b, 0xf9, 0xda, 0x64, 0xaa, 0xa9, 0x28, 0x46,
+	0x55, 0x4f, 0x3e, 0x08, 0x13, 0x1d, 0x6b, 0xaf, 0xd1, 0xf3, 0xdb, 0xb4, 0x06, 0x47, 0xbc, 0x79,
+	0x7a, 0xa1, 0xed, 0xcc, 0x31, 0x89, 0x62, 0xe8, 0xcf, 0x2d, 0xbb, 0xe1, 0x7d, 0xbf, 0x11, 0xfa,
+	0x51, 0x86, 0xde, 0x55, 0x09, 0x05, 0x23, 0x78, 0xc4, 0x81, 0xf3, 0x1d, 0x6b, 0x6f, 0xc3, 0xb5,
+	0x44, 0x38, 0x56, 0x79, 0x51, 0x96, 0xc1, 0xc0, 0xcd, 0x73, 0x56, 0x13, 0xb0, 0x30, 0x05, 0x3b,
+	0xc7, 0x12, 0x68, 0xfa, 0xb4, 0x2c, 0x81, 0xe6, 0x23, 0xbf, 0x47, 0x21, 0x0a, 0x7a, 0x24, 0x37,
+	0x62, 0x4a, 0x5f, 0x9f, 0xc6, 0x17, 0x23, 0x9f, 0xc6, 0xf3, 0xe5, 0x4d, 0x57, 0xfa, 0xf8, 0x33,
+	0xf6, 0x60, 0x8a, 0xbd, 0x38, 0x45, 0x29, 0x93, 0xd5, 0x94, 0xd6, 0x6a, 0x2c, 0x46, 0x60, 0x62,
+	0x92, 0x14, 0x97, 0x05, 0xa8, 0xe3, 0x61, 0xbe, 0x07, 0x32, 0xdd, 0x7f, 0xdc, 0xe4, 0x9e, 0x25,
+	0x65, 0x34, 0x93, 0xc2, 0xf7, 0xe0, 0x6e, 0x5e, 0x03, 0xcc, 0xef, 0x17, 0x47, 0xf7, 0xba, 0x98,
+	0x1f, 0xdd, 0x8b, 0xfc, 0x60, 0x9e, 0xce, 0x95, 0xdc, 0x30, 0xca, 0xde, 0x0c, 0x82, 0x36, 0x94,
+	0xd6, 0xbc, 0xfe, 0x2b, 0x03, 0x6a, 0x72, 0x97, 0x49, 0x3d, 0xa9, 0x43, 0xfd, 0x55, 0xcb, 0xb5,
+	0xda, 0xd4, 0xaf, 0x5d, 0x2a, 0xef, 0xaa, 0xbe, 0x5a, 0x00, 0x33, 0x72, 0x36, 0x7d, 0xcd, 0xe1,
+	0xc1, 0xec, 0x8d, 0xa3, 0x5a, 0x61, 0xe1, 0xd8, 0x88, 0x0f, 0xe3, 0xc1, 0x7e, 0xd0, 0x0c, 0x9d,
+	0xa0, 0x76, 0x99, 0x6f, 0x96, 0xdb, 0x43, 0x50, 0xd6, 0x86, 0x80, 0x24, 0x48, 0x6b, 0x9c, 0x85,
+	0x45, 0x94, 0xa2, 0x42, 0x44, 0x7e, 0xd8, 0x80, 0x8b, 0x52, 0xe8, 0xaa, 0x39, 0xf4, 0x5f, 0x29,
+	0x6f, 0xa1, 0x5d, 0x4f, 0x03, 0xbb, 0xdf, 0x15, 0x29, 0x3c, 0xf8, 0x83, 0x30, 0x53, 0x8b, 0x59,
+	0xec, 0x2c, 0xcd, 0xee, 0x0e, 0xf5, 0x5d, 0xea, 0xac, 0x7a, 0x4c, 0x3d, 0x1d, 0xd4, 0xae, 0x96,
+	0x57, 0x4e, 0xdd, 0xd5, 0x00, 0xc5, 0x72, 0x16, 0xbd, 0x34, 0xc0, 0x24, 0x36, 0xe2, 0xc2, 0xe4,
+	0x76, 0xaf, 0x4d, 0xd7, 0x78, 0x86, 0xdf, 0x6b, 0xe5, 0x95, 0x11, 0x77, 0x14, 0x90, 0x98, 0xa7,
+	0x89, 0x8a, 0x30, 0x46, 0x41, 0x1a, 0x70, 0x5e, 0xbc, 0x1e, 0x1b, 0xa1, 0x6f, 0x85, 0xb4, 0xbd,
+	0xcf, 0x55, 0xd5, 0x93, 0x0b, 0xaf, 0xe7, 0x29, 0xd4, 0x12, 0x35, 0x0f, 0x0f, 0x66, 0xaf, 0xc8,
+	0x0d, 0x96, 0xac, 0xc0, 0x14, 0x08, 0xb2, 0x07, 0x53, 0x2d, 0xdf, 0xb2, 0x5d, 0x19, 0xc0, 0xe5,
+	0x11, 0xfe, 0x41, 0x97, 0xca, 0xef, 0xa7, 0xc5, 0x18, 0x98, 0xcc, 0x83, 0x13, 0x17, 0xa0, 0x8e,
+	0x6a, 0xd8, 0x78, 0x29, 0x43, 0x84, 0xe8, 0xbe, 0xfe, 0x2c, 0x4c, 0xeb, 0xdb, 0xfe, 0x38, 0x7d,
+	0xcd, 0x3f, 0x36, 0xe0, 0x62, 0x66, 0xaa, 0xe4, 0x2e, 0x9c, 0x53, 0x02, 0x8b, 0xfb, 0xbe, 0x8a,
+	0xf0, 0x37, 0xb9, 0xf0, 0x5a, 0xb6, 0x89, 0x96, 0xf4, 0x8a, 0x87, 0x07, 0xb3, 0x33, 0x6b, 0x5e,
+	0x2b, 0x51, 0x86, 0xc9, 0xbe, 0x6c, 0x5f, 0x77, 0xb5, 0x1c, 0x5c, 0xca, 0x70, 0xf0, 0x7d, 0xe5,
+	0x6c, 0xb1, 0x62, 0x40, 0xf1, 0xbe, 0xd6, 0x4b, 0x79, 0x20, 0x43, 0xed, 0xa7, 0xf9, 0x93, 0x06,
+	0xcc, 0xa4, 0x19, 0x3d, 0xb2, 0x0d, 0xe3, 0x92, 0xea, 0xd7, 0x8c, 0xf2, 0x0a, 0x3f, 0x79, 0x9f,
+	0xc8, 0x78, 0x75, 0xfc, 0xdd, 0x20, 0x8b, 0x50, 0x81, 0xd7, 0x6d, 0x77, 0x2b, 0x7d, 0x6c, 0x77,
+	0xdf, 0x0d, 0x57, 0xf3, 0xe9, 0x3f, 0x7b, 0x75, 0x31, 0x97, 0xe0, 0x07, 0x52, 0x48, 0x17, 0x67,
+	0xa6, 0x65, 0x85, 0x28, 0xea, 0xcc, 0x8f, 0x41, 0x3a, 0xe5, 0x04, 0xf9, 0x30, 0x4c, 0x06, 0xc1,
+	0xb6, 0x88, 0x26, 0x2e, 0x27, 0x59, 0x4e, 0xec, 0xab, 0x42, 0x92, 0x8b, 0x87, 0x62, 0xf4, 0x13,
+	0x63, 0xf0, 0x0b, 0x2f, 0x7c, 0xe9, 0xab, 0x4f, 0xbc, 0xea, 0xb7, 0xbf, 0xfa, 0xc4, 0xab, 0xbe,
+	0xfc, 0xd5, 0x27, 0x5e, 0xf5, 0x5d, 0x87, 0x4f, 0x18, 0x5f, 0x3a, 0x7c, 0xc2, 0xf8, 0xed, 0xc3,
+	0x27, 0x8c, 0x2f, 0x1f, 0x3e, 0x61, 0xfc, 0xa7, 0xc3, 0x27, 0x8c, 0x1f, 0xfa, 0xcf, 0x4f, 0xbc,
+	0xea, 0x83, 0xcf, 0xc4, 0xd8, 0x6f, 0x2a, 0xa4, 0xf1, 0x3f, 0x4c, 0x8b, 0xc6, 0xb0, 0x2b, 0xb7,
+	0x68, 0x8e, 0xfd, 0xff, 0x0e, 0x00, 0x89, 0x7e, 0x36, 0xe3, 0x96, 0x1a, 0x01, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -6440,6 +7130,32 @@ func (m *Alerting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CustomRules != nil {
+		{
+			size, err := m.CustomRules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WebhookReceivers) > 0 {
+		for iNdEx := len(m.WebhookReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WebhookReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.EmailReceivers) > 0 {
 		for iNdEx := len(m.EmailReceivers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EmailReceivers[iNdEx])
@@ -6452,6 +7168,211 @@ func (m *Alerting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlertingCustomRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertingCustomRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingCustomRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ConfigMapName)
+	copy(dAtA[i:], m.ConfigMapName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMapName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AlertingWebhookReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertingWebhookReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingWebhookReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SendResolved != nil {
+		i--
+		if *m.SendResolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AlternativeMachineType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlternativeMachineType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlternativeMachineType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderConfig != nil {
+		{
+			size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Architecture != nil {
+		i -= len(*m.Architecture)
+		copy(dAtA[i:], *m.Architecture)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Architecture)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditBackends) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditBackends) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditBackends) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxWait != nil {
+		{
+			size, err := m.MaxWait.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AuditConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6472,6 +7393,18 @@ func (m *AuditConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Backends != nil {
+		{
+			size, err := m.Backends.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.AuditPolicy != nil {
 		{
 			size, err := m.AuditPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -6487,6 +7420,41 @@ func (m *AuditConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuditLogBackend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLogBackend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLogBackend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AuditPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6522,6 +7490,136 @@ func (m *AuditPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuditTruncate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditTruncate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditTruncate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEventSize != nil {
+		{
+			size, err := m.MaxEventSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxBatchSize != nil {
+		{
+			size, err := m.MaxBatchSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditWebhookBackend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditWebhookBackend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditWebhookBackend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InitialBackoff != nil {
+		{
+			size, err := m.InitialBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Truncate != nil {
+		{
+			size, err := m.Truncate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SecretName != nil {
+		i -= len(*m.SecretName)
+		copy(dAtA[i:], *m.SecretName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SecretName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AvailabilityZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6701,6 +7799,71 @@ func (m *BackupBucketProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BackupBucketReplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupBucketReplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupBucketReplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBackups != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxBackups))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBackupAge != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxBackupAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ProviderConfig != nil {
+		{
+			size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BackupBucketSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6721,6 +7884,18 @@ func (m *BackupBucketSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replication != nil {
+		{
+			size, err := m.Replication.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.SeedName != nil {
 		i -= len(*m.SeedName)
 		copy(dAtA[i:], *m.SeedName)
@@ -6937,6 +8112,65 @@ func (m *BackupEntryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BackupEntryReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupEntryReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupEntryReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastError != nil {
+		{
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Lag != nil {
+		{
+			size, err := m.Lag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LastReplicationTime != nil {
+		{
+			size, err := m.LastReplicationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BackupEntrySpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6992,6 +8226,18 @@ func (m *BackupEntryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replication != nil {
+		{
+			size, err := m.Replication.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.MigrationStartTime != nil {
 		{
 			size, err := m.MigrationStartTime.MarshalToSizedBuffer(dAtA[:i])
@@ -7247,6 +8493,18 @@ func (m *CRI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ImagePrePull != nil {
+		{
+			size, err := m.ImagePrePull.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ContainerRuntimes) > 0 {
 		for iNdEx := len(m.ContainerRuntimes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9722,6 +10980,84 @@ func (m *HorizontalPodAutoscalerConfig) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *HugePages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HugePages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HugePages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.PageSize.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ImagePrePull) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePrePull) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePrePull) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Images[iNdEx])
+			copy(dAtA[i:], m.Images[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Images[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SystemComponents != nil {
+		i--
+		if *m.SystemComponents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Ingress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9945,6 +11281,58 @@ func (m *InternalSecretList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KernelModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KernelModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KernelModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		keysForParameters := make([]string, 0, len(m.Parameters))
+		for k := range m.Parameters {
+			keysForParameters = append(keysForParameters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForParameters)
+		for iNdEx := len(keysForParameters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Parameters[string(keysForParameters[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForParameters[iNdEx])
+			copy(dAtA[i:], keysForParameters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForParameters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KubeAPIServerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11147,6 +12535,61 @@ func (m *KubernetesSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LastETCDRestore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastETCDRestore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastETCDRestore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionTime != nil {
+		{
+			size, err := m.CompletionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.State)
+	copy(dAtA[i:], m.State)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.State)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TriggeredTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Description)
+	copy(dAtA[i:], m.Description)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *LastError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11335,6 +12778,187 @@ func (m *LoadBalancerServicesProxyProtocol) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *Logging) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Logging) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Logging) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggingOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Buffer != nil {
+		{
+			size, err := m.Buffer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SecretName != nil {
+		i -= len(*m.SecretName)
+		copy(dAtA[i:], *m.SecretName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SecretName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggingOutputBuffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingOutputBuffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingOutputBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RetryLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MemoryLimit != nil {
+		{
+			size, err := m.MemoryLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggingOutputRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingOutputRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingOutputRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RecordsPerSecond))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Machine) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11355,6 +12979,20 @@ func (m *Machine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AlternativeTypes) > 0 {
+		for iNdEx := len(m.AlternativeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlternativeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Architecture != nil {
 		i -= len(*m.Architecture)
 		copy(dAtA[i:], *m.Architecture)
@@ -11892,6 +13530,20 @@ func (m *Monitoring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemoteWrite) > 0 {
+		for iNdEx := len(m.RemoteWrite) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteWrite[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Alerting != nil {
 		{
 			size, err := m.Alerting.MarshalToSizedBuffer(dAtA[:i])
@@ -11907,6 +13559,55 @@ func (m *Monitoring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MonitoringRemoteWrite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonitoringRemoteWrite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonitoringRemoteWrite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keep) > 0 {
+		for iNdEx := len(m.Keep) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keep[iNdEx])
+			copy(dAtA[i:], m.Keep[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Keep[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SecretName != nil {
+		i -= len(*m.SecretName)
+		copy(dAtA[i:], *m.SecretName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SecretName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NamedResourceReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12700,6 +14401,65 @@ func (m *OpenIDConnectClientAuthentication) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *PreDrainHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreDrainHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreDrainHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailurePolicy != nil {
+		i -= len(*m.FailurePolicy)
+		copy(dAtA[i:], *m.FailurePolicy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailurePolicy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CABundle != nil {
+		i -= len(*m.CABundle)
+		copy(dAtA[i:], *m.CABundle)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.CABundle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Project) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13703,6 +15463,18 @@ func (m *SeedBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replication != nil {
+		{
+			size, err := m.Replication.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15632,6 +17404,20 @@ func (m *ShootSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Logging != nil {
+		{
+			size, err := m.Logging.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.CredentialsBindingName != nil {
 		i -= len(*m.CredentialsBindingName)
 		copy(dAtA[i:], *m.CredentialsBindingName)
@@ -16062,6 +17848,20 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastETCDRestore != nil {
+		{
+			size, err := m.LastETCDRestore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.Networking != nil {
 		{
 			size, err := m.Networking.MarshalToSizedBuffer(dAtA[:i])
@@ -16715,6 +18515,61 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DrainPolicy != nil {
+		{
+			size, err := m.DrainPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.UpdateStrategy != nil {
+		i -= len(*m.UpdateStrategy)
+		copy(dAtA[i:], *m.UpdateStrategy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.UpdateStrategy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.HugePages) > 0 {
+		for iNdEx := len(m.HugePages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HugePages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.KernelModules) > 0 {
+		for iNdEx := len(m.KernelModules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KernelModules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if m.ClusterAutoscaler != nil {
 		{
 			size, err := m.ClusterAutoscaler.MarshalToSizedBuffer(dAtA[:i])
@@ -16982,6 +18837,50 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerDrainPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerDrainPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerDrainPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreDrainHooks) > 0 {
+		for iNdEx := len(m.PreDrainHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreDrainHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EvictionOrder != nil {
+		i -= len(*m.EvictionOrder)
+		copy(dAtA[i:], *m.EvictionOrder)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EvictionOrder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkerKubernetes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17192,6 +19091,95 @@ func (m *Alerting) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.WebhookReceivers) > 0 {
+		for _, e := range m.WebhookReceivers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.CustomRules != nil {
+		l = m.CustomRules.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AlertingCustomRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigMapName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AlertingWebhookReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SendResolved != nil {
+		n += 2
+	}
+	return n
+}
+
+func (m *AlternativeMachineType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Architecture != nil {
+		l = len(*m.Architecture)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ProviderConfig != nil {
+		l = m.ProviderConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditBackends) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxSize))
+	}
+	if m.MaxWait != nil {
+		l = m.MaxWait.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17205,6 +19193,23 @@ func (m *AuditConfig) Size() (n int) {
 		l = m.AuditPolicy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Backends != nil {
+		l = m.Backends.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditLogBackend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17221,6 +19226,54 @@ func (m *AuditPolicy) Size() (n int) {
 	return n
 }
 
+func (m *AuditTruncate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBatchSize != nil {
+		l = m.MaxBatchSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxEventSize != nil {
+		l = m.MaxEventSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditWebhookBackend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecretName != nil {
+		l = len(*m.SecretName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Truncate != nil {
+		l = m.Truncate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.InitialBackoff != nil {
+		l = m.InitialBackoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *AvailabilityZone) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17289,6 +19342,29 @@ func (m *BackupBucketProvider) Size() (n int) {
 	return n
 }
 
+func (m *BackupBucketReplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provider.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProviderConfig != nil {
+		l = m.ProviderConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.SecretRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxBackupAge != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxBackupAge))
+	}
+	if m.MaxBackups != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxBackups))
+	}
+	return n
+}
+
 func (m *BackupBucketSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17307,6 +19383,10 @@ func (m *BackupBucketSpec) Size() (n int) {
 		l = len(*m.SeedName)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17368,6 +19448,27 @@ func (m *BackupEntryList) Size() (n int) {
 	return n
 }
 
+func (m *BackupEntryReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastReplicationTime != nil {
+		l = m.LastReplicationTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Lag != nil {
+		l = m.Lag.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *BackupEntrySpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17406,6 +19507,10 @@ func (m *BackupEntryStatus) Size() (n int) {
 		l = m.MigrationStartTime.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17493,6 +19598,10 @@ func (m *CRI) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.ImagePrePull != nil {
+		l = m.ImagePrePull.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18426,6 +20535,36 @@ func (m *HorizontalPodAutoscalerConfig) Size() (n int) {
 	return n
 }
 
+func (m *HugePages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PageSize.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Count))
+	return n
+}
+
+func (m *ImagePrePull) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SystemComponents != nil {
+		n += 2
+	}
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Ingress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18507,6 +20646,25 @@ func (m *InternalSecretList) Size() (n int) {
 	return n
 }
 
+func (m *KernelModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for k, v := range m.Parameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *KubeAPIServerConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18941,6 +21099,25 @@ func (m *KubernetesSettings) Size() (n int) {
 	return n
 }
 
+func (m *LastETCDRestore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TriggeredTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.State)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CompletionTime != nil {
+		l = m.CompletionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *LastError) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19013,6 +21190,80 @@ func (m *LoadBalancerServicesProxyProtocol) Size() (n int) {
 	return n
 }
 
+func (m *Logging) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LoggingOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecretName != nil {
+		l = len(*m.SecretName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Buffer != nil {
+		l = m.Buffer.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *LoggingOutputBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemoryLimit != nil {
+		l = m.MemoryLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RetryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RetryLimit))
+	}
+	return n
+}
+
+func (m *LoggingOutputRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.RecordsPerSecond))
+	return n
+}
+
 func (m *Machine) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19029,6 +21280,12 @@ func (m *Machine) Size() (n int) {
 		l = len(*m.Architecture)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.AlternativeTypes) > 0 {
+		for _, e := range m.AlternativeTypes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19228,6 +21485,35 @@ func (m *Monitoring) Size() (n int) {
 		l = m.Alerting.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.RemoteWrite) > 0 {
+		for _, e := range m.RemoteWrite {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MonitoringRemoteWrite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecretName != nil {
+		l = len(*m.SecretName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Keep) > 0 {
+		for _, s := range m.Keep {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19553,6 +21839,31 @@ func (m *OpenIDConnectClientAuthentication) Size() (n int) {
 	return n
 }
 
+func (m *PreDrainHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CABundle != nil {
+		l = len(*m.CABundle)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FailurePolicy != nil {
+		l = len(*m.FailurePolicy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Project) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19924,6 +22235,10 @@ func (m *SeedBackup) Size() (n int) {
 	}
 	l = m.SecretRef.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20714,6 +23029,10 @@ func (m *ShootSpec) Size() (n int) {
 		l = len(*m.CredentialsBindingName)
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.Logging != nil {
+		l = m.Logging.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20854,6 +23173,10 @@ func (m *ShootStatus) Size() (n int) {
 		l = m.Networking.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.LastETCDRestore != nil {
+		l = m.LastETCDRestore.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -21123,6 +23446,45 @@ func (m *Worker) Size() (n int) {
 		l = m.ClusterAutoscaler.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if len(m.KernelModules) > 0 {
+		for _, e := range m.KernelModules {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.HugePages) > 0 {
+		for _, e := range m.HugePages {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.UpdateStrategy != nil {
+		l = len(*m.UpdateStrategy)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.DrainPolicy != nil {
+		l = m.DrainPolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkerDrainPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvictionOrder != nil {
+		l = len(*m.EvictionOrder)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.PreDrainHooks) > 0 {
+		for _, e := range m.PreDrainHooks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -21232,8 +23594,71 @@ func (this *Alerting) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForWebhookReceivers := "[]AlertingWebhookReceiver{"
+	for _, f := range this.WebhookReceivers {
+		repeatedStringForWebhookReceivers += strings.Replace(strings.Replace(f.String(), "AlertingWebhookReceiver", "AlertingWebhookReceiver", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWebhookReceivers += "}"
 	s := strings.Join([]string{`&Alerting{`,
 		`EmailReceivers:` + fmt.Sprintf("%v", this.EmailReceivers) + `,`,
+		`WebhookReceivers:` + repeatedStringForWebhookReceivers + `,`,
+		`CustomRules:` + strings.Replace(this.CustomRules.String(), "AlertingCustomRules", "AlertingCustomRules", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingCustomRules) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingCustomRules{`,
+		`ConfigMapName:` + fmt.Sprintf("%v", this.ConfigMapName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingWebhookReceiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingWebhookReceiver{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`SendResolved:` + valueToStringGenerated(this.SendResolved) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlternativeMachineType) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlternativeMachineType{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Architecture:` + valueToStringGenerated(this.Architecture) + `,`,
+		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditBackends) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditBackends{`,
+		`Log:` + strings.Replace(this.Log.String(), "AuditLogBackend", "AuditLogBackend", 1) + `,`,
+		`Webhook:` + strings.Replace(this.Webhook.String(), "AuditWebhookBackend", "AuditWebhookBackend", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditBatch{`,
+		`MaxSize:` + valueToStringGenerated(this.MaxSize) + `,`,
+		`MaxWait:` + strings.Replace(fmt.Sprintf("%v", this.MaxWait), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21244,6 +23669,17 @@ func (this *AuditConfig) String() string {
 	}
 	s := strings.Join([]string{`&AuditConfig{`,
 		`AuditPolicy:` + strings.Replace(this.AuditPolicy.String(), "AuditPolicy", "AuditPolicy", 1) + `,`,
+		`Backends:` + strings.Replace(this.Backends.String(), "AuditBackends", "AuditBackends", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditLogBackend) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditLogBackend{`,
+		`Policy:` + strings.Replace(this.Policy.String(), "AuditPolicy", "AuditPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21253,7 +23689,33 @@ func (this *AuditPolicy) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&AuditPolicy{`,
-		`ConfigMapRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
+		`ConfigMapRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapRef), "ObjectReference", "v11.ObjectReference", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditTruncate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditTruncate{`,
+		`MaxBatchSize:` + strings.Replace(fmt.Sprintf("%v", this.MaxBatchSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`MaxEventSize:` + strings.Replace(fmt.Sprintf("%v", this.MaxEventSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditWebhookBackend) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditWebhookBackend{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`SecretName:` + valueToStringGenerated(this.SecretName) + `,`,
+		`Policy:` + strings.Replace(this.Policy.String(), "AuditPolicy", "AuditPolicy", 1) + `,`,
+		`Batch:` + strings.Replace(this.Batch.String(), "AuditBatch", "AuditBatch", 1) + `,`,
+		`Truncate:` + strings.Replace(this.Truncate.String(), "AuditTruncate", "AuditTruncate", 1) + `,`,
+		`InitialBackoff:` + strings.Replace(fmt.Sprintf("%v", this.InitialBackoff), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21275,7 +23737,7 @@ func (this *BackupBucket) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&BackupBucket{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "BackupBucketSpec", "BackupBucketSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "BackupBucketStatus", "BackupBucketStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -21292,7 +23754,7 @@ func (this *BackupBucketList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&BackupBucketList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -21309,6 +23771,20 @@ func (this *BackupBucketProvider) String() string {
 	}, "")
 	return s
 }
+func (this *BackupBucketReplication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BackupBucketReplication{`,
+		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "BackupBucketProvider", "BackupBucketProvider", 1), `&`, ``, 1) + `,`,
+		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v11.SecretReference", 1), `&`, ``, 1) + `,`,
+		`MaxBackupAge:` + valueToStringGenerated(this.MaxBackupAge) + `,`,
+		`MaxBackups:` + valueToStringGenerated(this.MaxBackups) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BackupBucketSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -21316,8 +23792,9 @@ func (this *BackupBucketSpec) String() string {
 	s := strings.Join([]string{`&BackupBucketSpec{`,
 		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "BackupBucketProvider", "BackupBucketProvider", 1), `&`, ``, 1) + `,`,
 		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
-		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v1.SecretReference", 1), `&`, ``, 1) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v11.SecretReference", 1), `&`, ``, 1) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
+		`Replication:` + strings.Replace(this.Replication.String(), "BackupBucketReplication", "BackupBucketReplication", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21331,7 +23808,7 @@ func (this *BackupBucketStatus) String() string {
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
 		`LastError:` + strings.Replace(this.LastError.String(), "LastError", "LastError", 1) + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`GeneratedSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.GeneratedSecretRef), "SecretReference", "v1.SecretReference", 1) + `,`,
+		`GeneratedSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.GeneratedSecretRef), "SecretReference", "v11.SecretReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21341,7 +23818,7 @@ func (this *BackupEntry) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&BackupEntry{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "BackupEntrySpec", "BackupEntrySpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "BackupEntryStatus", "BackupEntryStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -21358,12 +23835,24 @@ func (this *BackupEntryList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&BackupEntryList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *BackupEntryReplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BackupEntryReplicationStatus{`,
+		`LastReplicationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastReplicationTime), "Time", "v1.Time", 1) + `,`,
+		`Lag:` + strings.Replace(fmt.Sprintf("%v", this.Lag), "Duration", "v1.Duration", 1) + `,`,
+		`LastError:` + strings.Replace(this.LastError.String(), "LastError", "LastError", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BackupEntrySpec) String() string {
 	if this == nil {
 		return "nil"
@@ -21384,7 +23873,8 @@ func (this *BackupEntryStatus) String() string {
 		`LastError:` + strings.Replace(this.LastError.String(), "LastError", "LastError", 1) + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
-		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v11.Time", 1) + `,`,
+		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v1.Time", 1) + `,`,
+		`Replication:` + strings.Replace(this.Replication.String(), "BackupEntryReplicationStatus", "BackupEntryReplicationStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21427,10 +23917,10 @@ func (this *CARotation) String() string {
 	}
 	s := strings.Join([]string{`&CARotation{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v11.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21447,6 +23937,7 @@ func (this *CRI) String() string {
 	s := strings.Join([]string{`&CRI{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`ContainerRuntimes:` + repeatedStringForContainerRuntimes + `,`,
+		`ImagePrePull:` + strings.Replace(this.ImagePrePull.String(), "ImagePrePull", "ImagePrePull", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21456,7 +23947,7 @@ func (this *CloudProfile) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&CloudProfile{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CloudProfileSpec", "CloudProfileSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -21472,7 +23963,7 @@ func (this *CloudProfileList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&CloudProfileList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -21533,17 +24024,17 @@ func (this *ClusterAutoscaler) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ClusterAutoscaler{`,
-		`ScaleDownDelayAfterAdd:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterAdd), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownDelayAfterDelete:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterDelete), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownDelayAfterFailure:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterFailure), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v11.Duration", 1) + `,`,
+		`ScaleDownDelayAfterAdd:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterAdd), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownDelayAfterDelete:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterDelete), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownDelayAfterFailure:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterFailure), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v1.Duration", 1) + `,`,
 		`ScaleDownUtilizationThreshold:` + valueToStringGenerated(this.ScaleDownUtilizationThreshold) + `,`,
-		`ScanInterval:` + strings.Replace(fmt.Sprintf("%v", this.ScanInterval), "Duration", "v11.Duration", 1) + `,`,
+		`ScanInterval:` + strings.Replace(fmt.Sprintf("%v", this.ScanInterval), "Duration", "v1.Duration", 1) + `,`,
 		`Expander:` + valueToStringGenerated(this.Expander) + `,`,
-		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v11.Duration", 1) + `,`,
+		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v1.Duration", 1) + `,`,
 		`MaxGracefulTerminationSeconds:` + valueToStringGenerated(this.MaxGracefulTerminationSeconds) + `,`,
 		`IgnoreTaints:` + fmt.Sprintf("%v", this.IgnoreTaints) + `,`,
-		`NewPodScaleUpDelay:` + strings.Replace(fmt.Sprintf("%v", this.NewPodScaleUpDelay), "Duration", "v11.Duration", 1) + `,`,
+		`NewPodScaleUpDelay:` + strings.Replace(fmt.Sprintf("%v", this.NewPodScaleUpDelay), "Duration", "v1.Duration", 1) + `,`,
 		`MaxEmptyBulkDelete:` + valueToStringGenerated(this.MaxEmptyBulkDelete) + `,`,
 		`IgnoreDaemonsetsUtilization:` + valueToStringGenerated(this.IgnoreDaemonsetsUtilization) + `,`,
 		`Verbosity:` + valueToStringGenerated(this.Verbosity) + `,`,
@@ -21558,9 +24049,9 @@ func (this *ClusterAutoscalerOptions) String() string {
 	s := strings.Join([]string{`&ClusterAutoscalerOptions{`,
 		`ScaleDownUtilizationThreshold:` + valueToStringGenerated(this.ScaleDownUtilizationThreshold) + `,`,
 		`ScaleDownGpuUtilizationThreshold:` + valueToStringGenerated(this.ScaleDownGpuUtilizationThreshold) + `,`,
-		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownUnreadyTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnreadyTime), "Duration", "v11.Duration", 1) + `,`,
-		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v11.Duration", 1) + `,`,
+		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownUnreadyTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnreadyTime), "Duration", "v1.Duration", 1) + `,`,
+		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21572,8 +24063,8 @@ func (this *Condition) String() string {
 	s := strings.Join([]string{`&Condition{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Codes:` + fmt.Sprintf("%v", this.Codes) + `,`,
@@ -21607,7 +24098,7 @@ func (this *ControllerDeployment) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerDeployment{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`ProviderConfig:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -21624,7 +24115,7 @@ func (this *ControllerDeploymentList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ControllerDeploymentList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -21635,7 +24126,7 @@ func (this *ControllerInstallation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerInstallation{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ControllerInstallationSpec", "ControllerInstallationSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ControllerInstallationStatus", "ControllerInstallationStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -21652,7 +24143,7 @@ func (this *ControllerInstallationList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ControllerInstallationList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -21663,9 +24154,9 @@ func (this *ControllerInstallationSpec) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerInstallationSpec{`,
-		`RegistrationRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RegistrationRef), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
-		`SeedRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SeedRef), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
-		`DeploymentRef:` + strings.Replace(fmt.Sprintf("%v", this.DeploymentRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
+		`RegistrationRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RegistrationRef), "ObjectReference", "v11.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`SeedRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SeedRef), "ObjectReference", "v11.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`DeploymentRef:` + strings.Replace(fmt.Sprintf("%v", this.DeploymentRef), "ObjectReference", "v11.ObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21691,7 +24182,7 @@ func (this *ControllerRegistration) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerRegistration{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ControllerRegistrationSpec", "ControllerRegistrationSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -21708,7 +24199,7 @@ func (this *ControllerRegistrationDeployment) String() string {
 	repeatedStringForDeploymentRefs += "}"
 	s := strings.Join([]string{`&ControllerRegistrationDeployment{`,
 		`Policy:` + valueToStringGenerated(this.Policy) + `,`,
-		`SeedSelector:` + strings.Replace(fmt.Sprintf("%v", this.SeedSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`SeedSelector:` + strings.Replace(fmt.Sprintf("%v", this.SeedSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`DeploymentRefs:` + repeatedStringForDeploymentRefs + `,`,
 		`}`,
 	}, "")
@@ -21724,7 +24215,7 @@ func (this *ControllerRegistrationList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ControllerRegistrationList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -21754,7 +24245,7 @@ func (this *ControllerResource) String() string {
 		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`GloballyEnabled:` + valueToStringGenerated(this.GloballyEnabled) + `,`,
-		`ReconcileTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReconcileTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`ReconcileTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReconcileTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`Primary:` + valueToStringGenerated(this.Primary) + `,`,
 		`Lifecycle:` + strings.Replace(this.Lifecycle.String(), "ControllerResourceLifecycle", "ControllerResourceLifecycle", 1) + `,`,
 		`WorkerlessSupported:` + valueToStringGenerated(this.WorkerlessSupported) + `,`,
@@ -21875,7 +24366,7 @@ func (this *DualApprovalForDeletion) String() string {
 	}
 	s := strings.Join([]string{`&DualApprovalForDeletion{`,
 		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
-		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v11.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
 		`IncludeServiceAccounts:` + valueToStringGenerated(this.IncludeServiceAccounts) + `,`,
 		`}`,
 	}, "")
@@ -21887,10 +24378,10 @@ func (this *ETCDEncryptionKeyRotation) String() string {
 	}
 	s := strings.Join([]string{`&ETCDEncryptionKeyRotation{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v11.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21911,7 +24402,7 @@ func (this *ExpirableVersion) String() string {
 	}
 	s := strings.Join([]string{`&ExpirableVersion{`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v11.Time", 1) + `,`,
+		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v1.Time", 1) + `,`,
 		`Classification:` + valueToStringGenerated(this.Classification) + `,`,
 		`}`,
 	}, "")
@@ -21922,7 +24413,7 @@ func (this *ExposureClass) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ExposureClass{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Handler:` + fmt.Sprintf("%v", this.Handler) + `,`,
 		`Scheduling:` + strings.Replace(this.Scheduling.String(), "ExposureClassScheduling", "ExposureClassScheduling", 1) + `,`,
 		`}`,
@@ -21939,7 +24430,7 @@ func (this *ExposureClassList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ExposureClassList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -22092,15 +24583,37 @@ func (this *HorizontalPodAutoscalerConfig) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HorizontalPodAutoscalerConfig{`,
-		`CPUInitializationPeriod:` + strings.Replace(fmt.Sprintf("%v", this.CPUInitializationPeriod), "Duration", "v11.Duration", 1) + `,`,
-		`DownscaleStabilization:` + strings.Replace(fmt.Sprintf("%v", this.DownscaleStabilization), "Duration", "v11.Duration", 1) + `,`,
-		`InitialReadinessDelay:` + strings.Replace(fmt.Sprintf("%v", this.InitialReadinessDelay), "Duration", "v11.Duration", 1) + `,`,
-		`SyncPeriod:` + strings.Replace(fmt.Sprintf("%v", this.SyncPeriod), "Duration", "v11.Duration", 1) + `,`,
+		`CPUInitializationPeriod:` + strings.Replace(fmt.Sprintf("%v", this.CPUInitializationPeriod), "Duration", "v1.Duration", 1) + `,`,
+		`DownscaleStabilization:` + strings.Replace(fmt.Sprintf("%v", this.DownscaleStabilization), "Duration", "v1.Duration", 1) + `,`,
+		`InitialReadinessDelay:` + strings.Replace(fmt.Sprintf("%v", this.InitialReadinessDelay), "Duration", "v1.Duration", 1) + `,`,
+		`SyncPeriod:` + strings.Replace(fmt.Sprintf("%v", this.SyncPeriod), "Duration", "v1.Duration", 1) + `,`,
 		`Tolerance:` + valueToStringGenerated(this.Tolerance) + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *HugePages) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HugePages{`,
+		`PageSize:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PageSize), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePrePull) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePrePull{`,
+		`SystemComponents:` + valueToStringGenerated(this.SystemComponents) + `,`,
+		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Ingress) String() string {
 	if this == nil {
 		return "nil"
@@ -22148,7 +24661,7 @@ func (this *InternalSecret) String() string {
 	}
 	mapStringForStringData += "}"
 	s := strings.Join([]string{`&InternalSecret{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Data:` + mapStringForData + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`StringData:` + mapStringForStringData + `,`,
@@ -22167,12 +24680,33 @@ func (this *InternalSecretList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&InternalSecretList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *KernelModule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForParameters := make([]string, 0, len(this.Parameters))
+	for k := range this.Parameters {
+		keysForParameters = append(keysForParameters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForParameters)
+	mapStringForParameters := "map[string]string{"
+	for _, k := range keysForParameters {
+		mapStringForParameters += fmt.Sprintf("%v: %v,", k, this.Parameters[k])
+	}
+	mapStringForParameters += "}"
+	s := strings.Join([]string{`&KernelModule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Parameters:` + mapStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KubeAPIServerConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -22203,7 +24737,7 @@ func (this *KubeAPIServerConfig) String() string {
 		`WatchCacheSizes:` + strings.Replace(this.WatchCacheSizes.String(), "WatchCacheSizes", "WatchCacheSizes", 1) + `,`,
 		`Requests:` + strings.Replace(this.Requests.String(), "APIServerRequests", "APIServerRequests", 1) + `,`,
 		`EnableAnonymousAuthentication:` + valueToStringGenerated(this.EnableAnonymousAuthentication) + `,`,
-		`EventTTL:` + strings.Replace(fmt.Sprintf("%v", this.EventTTL), "Duration", "v11.Duration", 1) + `,`,
+		`EventTTL:` + strings.Replace(fmt.Sprintf("%v", this.EventTTL), "Duration", "v1.Duration", 1) + `,`,
 		`Logging:` + strings.Replace(this.Logging.String(), "APIServerLogging", "APIServerLogging", 1) + `,`,
 		`DefaultNotReadyTolerationSeconds:` + valueToStringGenerated(this.DefaultNotReadyTolerationSeconds) + `,`,
 		`DefaultUnreachableTolerationSeconds:` + valueToStringGenerated(this.DefaultUnreachableTolerationSeconds) + `,`,
@@ -22221,8 +24755,8 @@ func (this *KubeControllerManagerConfig) String() string {
 		`KubernetesConfig:` + strings.Replace(strings.Replace(this.KubernetesConfig.String(), "KubernetesConfig", "KubernetesConfig", 1), `&`, ``, 1) + `,`,
 		`HorizontalPodAutoscalerConfig:` + strings.Replace(this.HorizontalPodAutoscalerConfig.String(), "HorizontalPodAutoscalerConfig", "HorizontalPodAutoscalerConfig", 1) + `,`,
 		`NodeCIDRMaskSize:` + valueToStringGenerated(this.NodeCIDRMaskSize) + `,`,
-		`PodEvictionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PodEvictionTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`NodeMonitorGracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.NodeMonitorGracePeriod), "Duration", "v11.Duration", 1) + `,`,
+		`PodEvictionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PodEvictionTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`NodeMonitorGracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.NodeMonitorGracePeriod), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22262,7 +24796,7 @@ func (this *KubeletConfig) String() string {
 		`EvictionHard:` + strings.Replace(this.EvictionHard.String(), "KubeletConfigEviction", "KubeletConfigEviction", 1) + `,`,
 		`EvictionMaxPodGracePeriod:` + valueToStringGenerated(this.EvictionMaxPodGracePeriod) + `,`,
 		`EvictionMinimumReclaim:` + strings.Replace(this.EvictionMinimumReclaim.String(), "KubeletConfigEvictionMinimumReclaim", "KubeletConfigEvictionMinimumReclaim", 1) + `,`,
-		`EvictionPressureTransitionPeriod:` + strings.Replace(fmt.Sprintf("%v", this.EvictionPressureTransitionPeriod), "Duration", "v11.Duration", 1) + `,`,
+		`EvictionPressureTransitionPeriod:` + strings.Replace(fmt.Sprintf("%v", this.EvictionPressureTransitionPeriod), "Duration", "v1.Duration", 1) + `,`,
 		`EvictionSoft:` + strings.Replace(this.EvictionSoft.String(), "KubeletConfigEviction", "KubeletConfigEviction", 1) + `,`,
 		`EvictionSoftGracePeriod:` + strings.Replace(this.EvictionSoftGracePeriod.String(), "KubeletConfigEvictionSoftGracePeriod", "KubeletConfigEvictionSoftGracePeriod", 1) + `,`,
 		`MaxPods:` + valueToStringGenerated(this.MaxPods) + `,`,
@@ -22279,7 +24813,7 @@ func (this *KubeletConfig) String() string {
 		`ContainerLogMaxSize:` + strings.Replace(fmt.Sprintf("%v", this.ContainerLogMaxSize), "Quantity", "resource.Quantity", 1) + `,`,
 		`ContainerLogMaxFiles:` + valueToStringGenerated(this.ContainerLogMaxFiles) + `,`,
 		`ProtectKernelDefaults:` + valueToStringGenerated(this.ProtectKernelDefaults) + `,`,
-		`StreamingConnectionIdleTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StreamingConnectionIdleTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`StreamingConnectionIdleTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StreamingConnectionIdleTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`MemorySwap:` + strings.Replace(this.MemorySwap.String(), "MemorySwapConfiguration", "MemorySwapConfiguration", 1) + `,`,
 		`}`,
 	}, "")
@@ -22318,11 +24852,11 @@ func (this *KubeletConfigEvictionSoftGracePeriod) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&KubeletConfigEvictionSoftGracePeriod{`,
-		`MemoryAvailable:` + strings.Replace(fmt.Sprintf("%v", this.MemoryAvailable), "Duration", "v11.Duration", 1) + `,`,
-		`ImageFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSAvailable), "Duration", "v11.Duration", 1) + `,`,
-		`ImageFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSInodesFree), "Duration", "v11.Duration", 1) + `,`,
-		`NodeFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSAvailable), "Duration", "v11.Duration", 1) + `,`,
-		`NodeFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSInodesFree), "Duration", "v11.Duration", 1) + `,`,
+		`MemoryAvailable:` + strings.Replace(fmt.Sprintf("%v", this.MemoryAvailable), "Duration", "v1.Duration", 1) + `,`,
+		`ImageFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSAvailable), "Duration", "v1.Duration", 1) + `,`,
+		`ImageFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSInodesFree), "Duration", "v1.Duration", 1) + `,`,
+		`NodeFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSAvailable), "Duration", "v1.Duration", 1) + `,`,
+		`NodeFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSInodesFree), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22404,6 +24938,19 @@ func (this *KubernetesSettings) String() string {
 	}, "")
 	return s
 }
+func (this *LastETCDRestore) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LastETCDRestore{`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`TriggeredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.CompletionTime), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *LastError) String() string {
 	if this == nil {
 		return "nil"
@@ -22412,7 +24959,7 @@ func (this *LastError) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`TaskID:` + valueToStringGenerated(this.TaskID) + `,`,
 		`Codes:` + fmt.Sprintf("%v", this.Codes) + `,`,
-		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22423,7 +24970,7 @@ func (this *LastMaintenance) String() string {
 	}
 	s := strings.Join([]string{`&LastMaintenance{`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`TriggeredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`TriggeredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`FailureReason:` + valueToStringGenerated(this.FailureReason) + `,`,
 		`}`,
@@ -22436,7 +24983,7 @@ func (this *LastOperation) String() string {
 	}
 	s := strings.Join([]string{`&LastOperation{`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
@@ -22454,14 +25001,72 @@ func (this *LoadBalancerServicesProxyProtocol) String() string {
 	}, "")
 	return s
 }
+func (this *Logging) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutputs := "[]LoggingOutput{"
+	for _, f := range this.Outputs {
+		repeatedStringForOutputs += strings.Replace(strings.Replace(f.String(), "LoggingOutput", "LoggingOutput", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOutputs += "}"
+	s := strings.Join([]string{`&Logging{`,
+		`Outputs:` + repeatedStringForOutputs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoggingOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoggingOutput{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`SecretName:` + valueToStringGenerated(this.SecretName) + `,`,
+		`Sources:` + fmt.Sprintf("%v", this.Sources) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "LoggingOutputRateLimit", "LoggingOutputRateLimit", 1) + `,`,
+		`Buffer:` + strings.Replace(this.Buffer.String(), "LoggingOutputBuffer", "LoggingOutputBuffer", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoggingOutputBuffer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoggingOutputBuffer{`,
+		`MemoryLimit:` + strings.Replace(fmt.Sprintf("%v", this.MemoryLimit), "Quantity", "resource.Quantity", 1) + `,`,
+		`RetryLimit:` + valueToStringGenerated(this.RetryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoggingOutputRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoggingOutputRateLimit{`,
+		`RecordsPerSecond:` + fmt.Sprintf("%v", this.RecordsPerSecond) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Machine) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAlternativeTypes := "[]AlternativeMachineType{"
+	for _, f := range this.AlternativeTypes {
+		repeatedStringForAlternativeTypes += strings.Replace(strings.Replace(f.String(), "AlternativeMachineType", "AlternativeMachineType", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAlternativeTypes += "}"
 	s := strings.Join([]string{`&Machine{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Image:` + strings.Replace(this.Image.String(), "ShootMachineImage", "ShootMachineImage", 1) + `,`,
 		`Architecture:` + valueToStringGenerated(this.Architecture) + `,`,
+		`AlternativeTypes:` + repeatedStringForAlternativeTypes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22471,9 +25076,9 @@ func (this *MachineControllerManagerSettings) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&MachineControllerManagerSettings{`,
-		`MachineDrainTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineDrainTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`MachineHealthTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineHealthTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`MachineCreationTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineCreationTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`MachineDrainTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineDrainTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`MachineHealthTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineHealthTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`MachineCreationTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineCreationTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`MaxEvictRetries:` + valueToStringGenerated(this.MaxEvictRetries) + `,`,
 		`NodeConditions:` + fmt.Sprintf("%v", this.NodeConditions) + `,`,
 		`}`,
@@ -22592,8 +25197,27 @@ func (this *Monitoring) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRemoteWrite := "[]MonitoringRemoteWrite{"
+	for _, f := range this.RemoteWrite {
+		repeatedStringForRemoteWrite += strings.Replace(strings.Replace(f.String(), "MonitoringRemoteWrite", "MonitoringRemoteWrite", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRemoteWrite += "}"
 	s := strings.Join([]string{`&Monitoring{`,
 		`Alerting:` + strings.Replace(this.Alerting.String(), "Alerting", "Alerting", 1) + `,`,
+		`RemoteWrite:` + repeatedStringForRemoteWrite + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MonitoringRemoteWrite) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MonitoringRemoteWrite{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`SecretName:` + valueToStringGenerated(this.SecretName) + `,`,
+		`Keep:` + fmt.Sprintf("%v", this.Keep) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22614,7 +25238,7 @@ func (this *NamespacedCloudProfile) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&NamespacedCloudProfile{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NamespacedCloudProfileSpec", "NamespacedCloudProfileSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NamespacedCloudProfileStatus", "NamespacedCloudProfileStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -22631,7 +25255,7 @@ func (this *NamespacedCloudProfileList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&NamespacedCloudProfileList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -22788,8 +25412,8 @@ func (this *ObservabilityRotation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ObservabilityRotation{`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22815,12 +25439,26 @@ func (this *OpenIDConnectClientAuthentication) String() string {
 	}, "")
 	return s
 }
+func (this *PreDrainHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreDrainHook{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`CABundle:` + valueToStringGenerated(this.CABundle) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
+		`FailurePolicy:` + valueToStringGenerated(this.FailurePolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Project) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Project{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -22837,7 +25475,7 @@ func (this *ProjectList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ProjectList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -22889,9 +25527,9 @@ func (this *ProjectStatus) String() string {
 	s := strings.Join([]string{`&ProjectStatus{`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v11.Time", 1) + `,`,
-		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
-		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v1.Time", 1) + `,`,
+		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v1.Time", 1) + `,`,
+		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22941,7 +25579,7 @@ func (this *Quota) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Quota{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -22957,7 +25595,7 @@ func (this *QuotaList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&QuotaList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -22980,7 +25618,7 @@ func (this *QuotaSpec) String() string {
 	s := strings.Join([]string{`&QuotaSpec{`,
 		`ClusterLifetimeDays:` + valueToStringGenerated(this.ClusterLifetimeDays) + `,`,
 		`Metrics:` + mapStringForMetrics + `,`,
-		`Scope:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Scope), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`Scope:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Scope), "ObjectReference", "v11.ObjectReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23055,8 +25693,8 @@ func (this *SecretBinding) String() string {
 	}
 	repeatedStringForQuotas += "}"
 	s := strings.Join([]string{`&SecretBinding{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v1.SecretReference", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v11.SecretReference", 1), `&`, ``, 1) + `,`,
 		`Quotas:` + repeatedStringForQuotas + `,`,
 		`Provider:` + strings.Replace(this.Provider.String(), "SecretBindingProvider", "SecretBindingProvider", 1) + `,`,
 		`}`,
@@ -23073,7 +25711,7 @@ func (this *SecretBindingList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&SecretBindingList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -23094,7 +25732,7 @@ func (this *Seed) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Seed{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SeedSpec", "SeedSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SeedStatus", "SeedStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -23109,7 +25747,8 @@ func (this *SeedBackup) String() string {
 		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
 		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`Region:` + valueToStringGenerated(this.Region) + `,`,
-		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v1.SecretReference", 1), `&`, ``, 1) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v11.SecretReference", 1), `&`, ``, 1) + `,`,
+		`Replication:` + strings.Replace(this.Replication.String(), "BackupBucketReplication", "BackupBucketReplication", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23130,7 +25769,7 @@ func (this *SeedDNSProvider) String() string {
 	}
 	s := strings.Join([]string{`&SeedDNSProvider{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v1.SecretReference", 1), `&`, ``, 1) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v11.SecretReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23145,7 +25784,7 @@ func (this *SeedList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&SeedList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -23184,7 +25823,7 @@ func (this *SeedSelector) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SeedSelector{`,
-		`LabelSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v11.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`LabelSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
 		`ProviderTypes:` + fmt.Sprintf("%v", this.ProviderTypes) + `,`,
 		`}`,
 	}, "")
@@ -23429,7 +26068,7 @@ func (this *SeedStatus) String() string {
 		`ClusterIdentity:` + valueToStringGenerated(this.ClusterIdentity) + `,`,
 		`Capacity:` + mapStringForCapacity + `,`,
 		`Allocatable:` + mapStringForAllocatable + `,`,
-		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
+		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
 		`}`,
 	}, "")
@@ -23451,7 +26090,7 @@ func (this *SeedTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SeedTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SeedSpec", "SeedSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -23491,7 +26130,7 @@ func (this *ServiceAccountConfig) String() string {
 	s := strings.Join([]string{`&ServiceAccountConfig{`,
 		`Issuer:` + valueToStringGenerated(this.Issuer) + `,`,
 		`ExtendTokenExpiration:` + valueToStringGenerated(this.ExtendTokenExpiration) + `,`,
-		`MaxTokenExpiration:` + strings.Replace(fmt.Sprintf("%v", this.MaxTokenExpiration), "Duration", "v11.Duration", 1) + `,`,
+		`MaxTokenExpiration:` + strings.Replace(fmt.Sprintf("%v", this.MaxTokenExpiration), "Duration", "v1.Duration", 1) + `,`,
 		`AcceptedIssuers:` + fmt.Sprintf("%v", this.AcceptedIssuers) + `,`,
 		`}`,
 	}, "")
@@ -23503,10 +26142,10 @@ func (this *ServiceAccountKeyRotation) String() string {
 	}
 	s := strings.Join([]string{`&ServiceAccountKeyRotation{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v11.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23516,7 +26155,7 @@ func (this *Shoot) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Shoot{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootSpec", "ShootSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ShootStatus", "ShootStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -23564,8 +26203,8 @@ func (this *ShootKubeconfigRotation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootKubeconfigRotation{`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23580,7 +26219,7 @@ func (this *ShootList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ShootList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -23614,8 +26253,8 @@ func (this *ShootSSHKeypairRotation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootSSHKeypairRotation{`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23663,6 +26302,7 @@ func (this *ShootSpec) String() string {
 		`SchedulerName:` + valueToStringGenerated(this.SchedulerName) + `,`,
 		`CloudProfile:` + strings.Replace(this.CloudProfile.String(), "CloudProfileReference", "CloudProfileReference", 1) + `,`,
 		`CredentialsBindingName:` + valueToStringGenerated(this.CredentialsBindingName) + `,`,
+		`Logging:` + strings.Replace(this.Logging.String(), "Logging", "Logging", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23672,7 +26312,7 @@ func (this *ShootState) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootState{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootStateSpec", "ShootStateSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -23688,7 +26328,7 @@ func (this *ShootStateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ShootStateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -23753,18 +26393,19 @@ func (this *ShootStatus) String() string {
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
 		`LastErrors:` + repeatedStringForLastErrors + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`RetryCycleStartTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryCycleStartTime), "Time", "v11.Time", 1) + `,`,
+		`RetryCycleStartTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryCycleStartTime), "Time", "v1.Time", 1) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
 		`TechnicalID:` + fmt.Sprintf("%v", this.TechnicalID) + `,`,
 		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
 		`ClusterIdentity:` + valueToStringGenerated(this.ClusterIdentity) + `,`,
 		`AdvertisedAddresses:` + repeatedStringForAdvertisedAddresses + `,`,
-		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v11.Time", 1) + `,`,
+		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v1.Time", 1) + `,`,
 		`Credentials:` + strings.Replace(this.Credentials.String(), "ShootCredentials", "ShootCredentials", 1) + `,`,
-		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v11.Time", 1) + `,`,
+		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v1.Time", 1) + `,`,
 		`LastMaintenance:` + strings.Replace(this.LastMaintenance.String(), "LastMaintenance", "LastMaintenance", 1) + `,`,
 		`EncryptedResources:` + fmt.Sprintf("%v", this.EncryptedResources) + `,`,
 		`Networking:` + strings.Replace(this.Networking.String(), "NetworkingStatus", "NetworkingStatus", 1) + `,`,
+		`LastETCDRestore:` + strings.Replace(this.LastETCDRestore.String(), "LastETCDRestore", "LastETCDRestore", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23774,7 +26415,7 @@ func (this *ShootTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootSpec", "ShootSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -23818,13 +26459,13 @@ func (this *VerticalPodAutoscaler) String() string {
 	}
 	s := strings.Join([]string{`&VerticalPodAutoscaler{`,
 		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
-		`EvictAfterOOMThreshold:` + strings.Replace(fmt.Sprintf("%v", this.EvictAfterOOMThreshold), "Duration", "v11.Duration", 1) + `,`,
+		`EvictAfterOOMThreshold:` + strings.Replace(fmt.Sprintf("%v", this.EvictAfterOOMThreshold), "Duration", "v1.Duration", 1) + `,`,
 		`EvictionRateBurst:` + valueToStringGenerated(this.EvictionRateBurst) + `,`,
 		`EvictionRateLimit:` + valueToStringGenerated(this.EvictionRateLimit) + `,`,
 		`EvictionTolerance:` + valueToStringGenerated(this.EvictionTolerance) + `,`,
 		`RecommendationMarginFraction:` + valueToStringGenerated(this.RecommendationMarginFraction) + `,`,
-		`UpdaterInterval:` + strings.Replace(fmt.Sprintf("%v", this.UpdaterInterval), "Duration", "v11.Duration", 1) + `,`,
-		`RecommenderInterval:` + strings.Replace(fmt.Sprintf("%v", this.RecommenderInterval), "Duration", "v11.Duration", 1) + `,`,
+		`UpdaterInterval:` + strings.Replace(fmt.Sprintf("%v", this.UpdaterInterval), "Duration", "v1.Duration", 1) + `,`,
+		`RecommenderInterval:` + strings.Replace(fmt.Sprintf("%v", this.RecommenderInterval), "Duration", "v1.Duration", 1) + `,`,
 		`TargetCPUPercentile:` + valueToStringGenerated(this.TargetCPUPercentile) + `,`,
 		`RecommendationLowerBoundCPUPercentile:` + valueToStringGenerated(this.RecommendationLowerBoundCPUPercentile) + `,`,
 		`RecommendationUpperBoundCPUPercentile:` + valueToStringGenerated(this.RecommendationUpperBoundCPUPercentile) + `,`,
@@ -23891,6 +26532,16 @@ func (this *Worker) String() string {
 		repeatedStringForDataVolumes += strings.Replace(strings.Replace(f.String(), "DataVolume", "DataVolume", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForDataVolumes += "}"
+	repeatedStringForKernelModules := "[]KernelModule{"
+	for _, f := range this.KernelModules {
+		repeatedStringForKernelModules += strings.Replace(strings.Replace(f.String(), "KernelModule", "KernelModule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForKernelModules += "}"
+	repeatedStringForHugePages := "[]HugePages{"
+	for _, f := range this.HugePages {
+		repeatedStringForHugePages += strings.Replace(strings.Replace(f.String(), "HugePages", "HugePages", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHugePages += "}"
 	keysForAnnotations := make([]string, 0, len(this.Annotations))
 	for k := range this.Annotations {
 		keysForAnnotations = append(keysForAnnotations, k)
@@ -23943,6 +26594,26 @@ func (this *Worker) String() string {
 		`MachineControllerManagerSettings:` + strings.Replace(this.MachineControllerManagerSettings.String(), "MachineControllerManagerSettings", "MachineControllerManagerSettings", 1) + `,`,
 		`Sysctls:` + mapStringForSysctls + `,`,
 		`ClusterAutoscaler:` + strings.Replace(this.ClusterAutoscaler.String(), "ClusterAutoscalerOptions", "ClusterAutoscalerOptions", 1) + `,`,
+		`KernelModules:` + repeatedStringForKernelModules + `,`,
+		`HugePages:` + repeatedStringForHugePages + `,`,
+		`UpdateStrategy:` + valueToStringGenerated(this.UpdateStrategy) + `,`,
+		`DrainPolicy:` + strings.Replace(this.DrainPolicy.String(), "WorkerDrainPolicy", "WorkerDrainPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkerDrainPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPreDrainHooks := "[]PreDrainHook{"
+	for _, f := range this.PreDrainHooks {
+		repeatedStringForPreDrainHooks += strings.Replace(strings.Replace(f.String(), "PreDrainHook", "PreDrainHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPreDrainHooks += "}"
+	s := strings.Join([]string{`&WorkerDrainPolicy{`,
+		`EvictionOrder:` + valueToStringGenerated(this.EvictionOrder) + `,`,
+		`PreDrainHooks:` + repeatedStringForPreDrainHooks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24591,6 +27262,76 @@ func (m *Alerting) Unmarshal(dAtA []byte) error {
 			}
 			m.EmailReceivers = append(m.EmailReceivers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookReceivers = append(m.WebhookReceivers, AlertingWebhookReceiver{})
+			if err := m.WebhookReceivers[len(m.WebhookReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomRules == nil {
+				m.CustomRules = &AlertingCustomRules{}
+			}
+			if err := m.CustomRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24612,7 +27353,7 @@ func (m *Alerting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuditConfig) Unmarshal(dAtA []byte) error {
+func (m *AlertingCustomRules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24635,17 +27376,17 @@ func (m *AuditConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuditConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlertingCustomRules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlertingCustomRules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuditPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24655,27 +27396,23 @@ func (m *AuditConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuditPolicy == nil {
-				m.AuditPolicy = &AuditPolicy{}
-			}
-			if err := m.AuditPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24698,7 +27435,7 @@ func (m *AuditConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
+func (m *AlertingWebhookReceiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24721,17 +27458,17 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuditPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlertingWebhookReceiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlertingWebhookReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24741,28 +27478,77 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapRef == nil {
-				m.ConfigMapRef = &v1.ObjectReference{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendResolved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SendResolved = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24784,7 +27570,7 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
+func (m *AlternativeMachineType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24807,15 +27593,15 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlternativeMachineType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlternativeMachineType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24843,11 +27629,11 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24875,13 +27661,14 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Architecture = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24891,23 +27678,27 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24930,7 +27721,7 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucket) Unmarshal(dAtA []byte) error {
+func (m *AuditBackends) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24953,15 +27744,15 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditBackends: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditBackends: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24988,13 +27779,16 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Log == nil {
+				m.Log = &AuditLogBackend{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25021,40 +27815,10 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Webhook == nil {
+				m.Webhook = &AuditWebhookBackend{}
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25079,7 +27843,7 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
+func (m *AuditBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25102,17 +27866,17 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25122,28 +27886,15 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxSize = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWait", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25170,8 +27921,10 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupBucket{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxWait == nil {
+				m.MaxWait = &v1.Duration{}
+			}
+			if err := m.MaxWait.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25196,7 +27949,7 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
+func (m *AuditConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25219,17 +27972,17 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25239,29 +27992,33 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.AuditPolicy == nil {
+				m.AuditPolicy = &AuditPolicy{}
+			}
+			if err := m.AuditPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backends", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25271,23 +28028,27 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.Backends == nil {
+				m.Backends = &AuditBackends{}
+			}
+			if err := m.Backends.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25310,7 +28071,7 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
+func (m *AuditLogBackend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25333,15 +28094,15 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditLogBackend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditLogBackend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25368,49 +28129,66 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &AuditPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25437,42 +28215,12 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.ConfigMapRef == nil {
+				m.ConfigMapRef = &v11.ObjectReference{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25495,7 +28243,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
+func (m *AuditTruncate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25518,15 +28266,15 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditTruncate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditTruncate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25553,107 +28301,16 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderStatus == nil {
-				m.ProviderStatus = &runtime.RawExtension{}
+			if m.MaxBatchSize == nil {
+				m.MaxBatchSize = &resource.Quantity{}
 			}
-			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxBatchSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
-			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastError == nil {
-				m.LastError = &LastError{}
-			}
-			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedSecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25680,10 +28337,10 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GeneratedSecretRef == nil {
-				m.GeneratedSecretRef = &v1.SecretReference{}
+			if m.MaxEventSize == nil {
+				m.MaxEventSize = &resource.Quantity{}
 			}
-			if err := m.GeneratedSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxEventSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25708,7 +28365,7 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntry) Unmarshal(dAtA []byte) error {
+func (m *AuditWebhookBackend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25731,17 +28388,17 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditWebhookBackend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditWebhookBackend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25751,30 +28408,29 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25784,28 +28440,28 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecretName = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25832,63 +28488,52 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &AuditPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Batch == nil {
+				m.Batch = &AuditBatch{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntryList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25915,13 +28560,16 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Truncate == nil {
+				m.Truncate = &AuditTruncate{}
+			}
+			if err := m.Truncate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25948,8 +28596,10 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupEntry{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InitialBackoff == nil {
+				m.InitialBackoff = &v1.Duration{}
+			}
+			if err := m.InitialBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25974,7 +28624,7 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
+func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25997,15 +28647,15 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntrySpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntrySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26033,11 +28683,11 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26065,8 +28715,39 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
+			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26089,7 +28770,7 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
+func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26112,15 +28793,15 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntryStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26147,16 +28828,13 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
-			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26183,68 +28861,13 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastError == nil {
-				m.LastError = &LastError{}
-			}
-			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26271,10 +28894,7 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
-			}
-			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26299,7 +28919,7 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bastion) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26322,15 +28942,15 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26357,16 +28977,13 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineImage == nil {
-				m.MachineImage = &BastionMachineImage{}
-			}
-			if err := m.MachineImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26393,10 +29010,8 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineType == nil {
-				m.MachineType = &BastionMachineType{}
-			}
-			if err := m.MachineType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, BackupBucket{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26421,7 +29036,7 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26444,15 +29059,15 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BastionMachineImage: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26480,11 +29095,11 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26512,8 +29127,7 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26536,7 +29150,7 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketReplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26559,17 +29173,17 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BastionMachineType: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketReplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionMachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketReplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26579,24 +29193,134 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackupAge", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxBackupAge = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackups", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxBackups = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26618,7 +29342,7 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CARotation) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26641,47 +29365,15 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CARotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CARotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26708,16 +29400,13 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26744,16 +29433,16 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
 			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26780,16 +29469,46 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
-			}
-			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26816,10 +29535,10 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+			if m.Replication == nil {
+				m.Replication = &BackupBucketReplication{}
 			}
-			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26844,7 +29563,7 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CRI) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26867,17 +29586,17 @@ func (m *CRI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CRI: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CRI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26887,27 +29606,31 @@ func (m *CRI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = CRIName(dAtA[iNdEx:postIndex])
+			if m.ProviderStatus == nil {
+				m.ProviderStatus = &runtime.RawExtension{}
+			}
+			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRuntimes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26934,64 +29657,16 @@ func (m *CRI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerRuntimes = append(m.ContainerRuntimes, ContainerRuntime{})
-			if err := m.ContainerRuntimes[len(m.ContainerRuntimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudProfile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27018,13 +29693,35 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastError == nil {
+				m.LastError = &LastError{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedSecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27051,7 +29748,10 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GeneratedSecretRef == nil {
+				m.GeneratedSecretRef = &v11.SecretReference{}
+			}
+			if err := m.GeneratedSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27076,7 +29776,7 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
+func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27099,15 +29799,15 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileList: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27134,13 +29834,13 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27167,8 +29867,40 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CloudProfile{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27193,7 +29925,7 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
+func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27216,17 +29948,17 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27236,29 +29968,30 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27268,23 +30001,25 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, BackupEntry{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27307,7 +30042,7 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
+func (m *BackupEntryReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27330,17 +30065,17 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntryReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntryReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27350,28 +30085,1591 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			if m.LastReplicationTime == nil {
+				m.LastReplicationTime = &v1.Time{}
+			}
+			if err := m.LastReplicationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lag == nil {
+				m.Lag = &v1.Duration{}
+			}
+			if err := m.Lag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastError == nil {
+				m.LastError = &LastError{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupEntrySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupEntrySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupEntryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupEntryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
+			}
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastError == nil {
+				m.LastError = &LastError{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationStartTime == nil {
+				m.MigrationStartTime = &v1.Time{}
+			}
+			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Replication == nil {
+				m.Replication = &BackupEntryReplicationStatus{}
+			}
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bastion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MachineImage == nil {
+				m.MachineImage = &BastionMachineImage{}
+			}
+			if err := m.MachineImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MachineType == nil {
+				m.MachineType = &BastionMachineType{}
+			}
+			if err := m.MachineType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionMachineImage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionMachineType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionMachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CARotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CARotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CARotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v1.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v1.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationFinishedTime == nil {
+				m.LastInitiationFinishedTime = &v1.Time{}
+			}
+			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTriggeredTime == nil {
+				m.LastCompletionTriggeredTime = &v1.Time{}
+			}
+			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CRI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CRI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CRI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = CRIName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRuntimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerRuntimes = append(m.ContainerRuntimes, ContainerRuntime{})
+			if err := m.ContainerRuntimes[len(m.ContainerRuntimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePrePull", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImagePrePull == nil {
+				m.ImagePrePull = &ImagePrePull{}
+			}
+			if err := m.ImagePrePull.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, CloudProfile{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27758,7 +32056,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownDelayAfterAdd == nil {
-				m.ScaleDownDelayAfterAdd = &v11.Duration{}
+				m.ScaleDownDelayAfterAdd = &v1.Duration{}
 			}
 			if err := m.ScaleDownDelayAfterAdd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27794,7 +32092,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownDelayAfterDelete == nil {
-				m.ScaleDownDelayAfterDelete = &v11.Duration{}
+				m.ScaleDownDelayAfterDelete = &v1.Duration{}
 			}
 			if err := m.ScaleDownDelayAfterDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27830,7 +32128,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownDelayAfterFailure == nil {
-				m.ScaleDownDelayAfterFailure = &v11.Duration{}
+				m.ScaleDownDelayAfterFailure = &v1.Duration{}
 			}
 			if err := m.ScaleDownDelayAfterFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27866,7 +32164,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownUnneededTime == nil {
-				m.ScaleDownUnneededTime = &v11.Duration{}
+				m.ScaleDownUnneededTime = &v1.Duration{}
 			}
 			if err := m.ScaleDownUnneededTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27914,7 +32212,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScanInterval == nil {
-				m.ScanInterval = &v11.Duration{}
+				m.ScanInterval = &v1.Duration{}
 			}
 			if err := m.ScanInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27983,7 +32281,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MaxNodeProvisionTime == nil {
-				m.MaxNodeProvisionTime = &v11.Duration{}
+				m.MaxNodeProvisionTime = &v1.Duration{}
 			}
 			if err := m.MaxNodeProvisionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -28071,7 +32369,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewPodScaleUpDelay == nil {
-				m.NewPodScaleUpDelay = &v11.Duration{}
+				m.NewPodScaleUpDelay = &v1.Duration{}
 			}
 			if err := m.NewPodScaleUpDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -28242,7 +32540,7 @@ func (m *ClusterAutoscalerOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownUnneededTime == nil {
-				m.ScaleDownUnneededTime = &v11.Duration{}
+				m.ScaleDownUnneededTime = &v1.Duration{}
 			}
 			if err := m.ScaleDownUnneededTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -28278,7 +32576,7 @@ func (m *ClusterAutoscalerOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownUnreadyTime == nil {
-				m.ScaleDownUnreadyTime = &v11.Duration{}
+				m.ScaleDownUnreadyTime = &v1.Duration{}
 			}
 			if err := m.ScaleDownUnreadyTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -28314,7 +32612,7 @@ func (m *ClusterAutoscalerOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MaxNodeProvisionTime == nil {
-				m.MaxNodeProvisionTime = &v11.Duration{}
+				m.MaxNodeProvisionTime = &v1.Duration{}
 			}
 			if err := m.MaxNodeProvisionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29477,7 +33775,7 @@ func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DeploymentRef == nil {
-				m.DeploymentRef = &v1.ObjectReference{}
+				m.DeploymentRef = &v11.ObjectReference{}
 			}
 			if err := m.DeploymentRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29832,7 +34130,7 @@ func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SeedSelector == nil {
-				m.SeedSelector = &v11.LabelSelector{}
+				m.SeedSelector = &v1.LabelSelector{}
 			}
 			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30274,7 +34572,7 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ReconcileTimeout == nil {
-				m.ReconcileTimeout = &v11.Duration{}
+				m.ReconcileTimeout = &v1.Duration{}
 			}
 			if err := m.ReconcileTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -31731,7 +36029,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -31767,7 +36065,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -31803,7 +36101,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
+				m.LastInitiationFinishedTime = &v1.Time{}
 			}
 			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -31839,7 +36137,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+				m.LastCompletionTriggeredTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32039,7 +36337,7 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ExpirationDate == nil {
-				m.ExpirationDate = &v11.Time{}
+				m.ExpirationDate = &v1.Time{}
 			}
 			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -33898,7 +38196,7 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CPUInitializationPeriod == nil {
-				m.CPUInitializationPeriod = &v11.Duration{}
+				m.CPUInitializationPeriod = &v1.Duration{}
 			}
 			if err := m.CPUInitializationPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -33933,18 +38231,185 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownscaleStabilization == nil {
-				m.DownscaleStabilization = &v11.Duration{}
-			}
-			if err := m.DownscaleStabilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DownscaleStabilization == nil {
+				m.DownscaleStabilization = &v1.Duration{}
+			}
+			if err := m.DownscaleStabilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialReadinessDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitialReadinessDelay == nil {
+				m.InitialReadinessDelay = &v1.Duration{}
+			}
+			if err := m.InitialReadinessDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPeriod == nil {
+				m.SyncPeriod = &v1.Duration{}
+			}
+			if err := m.SyncPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Tolerance = &v2
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HugePages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HugePages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HugePages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PageSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialReadinessDelay", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33954,33 +38419,87 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitialReadinessDelay == nil {
-				m.InitialReadinessDelay = &v11.Duration{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePrePull) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.InitialReadinessDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePrePull: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePrePull: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemComponents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SystemComponents = &b
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33990,40 +38509,24 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPeriod == nil {
-				m.SyncPeriod = &v11.Duration{}
-			}
-			if err := m.SyncPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerance", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.Tolerance = &v2
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34786,6 +39289,215 @@ func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *KernelModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KernelModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KernelModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parameters == nil {
+				m.Parameters = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Parameters[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35260,7 +39972,7 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EventTTL == nil {
-				m.EventTTL = &v11.Duration{}
+				m.EventTTL = &v1.Duration{}
 			}
 			if err := m.EventTTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -35583,7 +40295,7 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PodEvictionTimeout == nil {
-				m.PodEvictionTimeout = &v11.Duration{}
+				m.PodEvictionTimeout = &v1.Duration{}
 			}
 			if err := m.PodEvictionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -35619,7 +40331,7 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NodeMonitorGracePeriod == nil {
-				m.NodeMonitorGracePeriod = &v11.Duration{}
+				m.NodeMonitorGracePeriod = &v1.Duration{}
 			}
 			if err := m.NodeMonitorGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36170,7 +40882,7 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EvictionPressureTransitionPeriod == nil {
-				m.EvictionPressureTransitionPeriod = &v11.Duration{}
+				m.EvictionPressureTransitionPeriod = &v1.Duration{}
 			}
 			if err := m.EvictionPressureTransitionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36610,7 +41322,7 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StreamingConnectionIdleTimeout == nil {
-				m.StreamingConnectionIdleTimeout = &v11.Duration{}
+				m.StreamingConnectionIdleTimeout = &v1.Duration{}
 			}
 			if err := m.StreamingConnectionIdleTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37177,7 +41889,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MemoryAvailable == nil {
-				m.MemoryAvailable = &v11.Duration{}
+				m.MemoryAvailable = &v1.Duration{}
 			}
 			if err := m.MemoryAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37213,7 +41925,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ImageFSAvailable == nil {
-				m.ImageFSAvailable = &v11.Duration{}
+				m.ImageFSAvailable = &v1.Duration{}
 			}
 			if err := m.ImageFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37249,7 +41961,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ImageFSInodesFree == nil {
-				m.ImageFSInodesFree = &v11.Duration{}
+				m.ImageFSInodesFree = &v1.Duration{}
 			}
 			if err := m.ImageFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37285,7 +41997,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NodeFSAvailable == nil {
-				m.NodeFSAvailable = &v11.Duration{}
+				m.NodeFSAvailable = &v1.Duration{}
 			}
 			if err := m.NodeFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37321,7 +42033,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NodeFSInodesFree == nil {
-				m.NodeFSInodesFree = &v11.Duration{}
+				m.NodeFSInodesFree = &v1.Duration{}
 			}
 			if err := m.NodeFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38039,7 +42751,390 @@ func (m *KubernetesConfig) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.FeatureGates[mapkey] = mapvalue
+			m.FeatureGates[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubernetesDashboard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubernetesDashboard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AuthenticationMode = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubernetesSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubernetesSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, ExpirableVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastETCDRestore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastETCDRestore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastETCDRestore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = LastOperationState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionTime == nil {
+				m.CompletionTime = &v1.Time{}
+			}
+			if err := m.CompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38062,7 +43157,7 @@ func (m *KubernetesConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
+func (m *LastError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38085,15 +43180,15 @@ func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesDashboard: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesDashboard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38121,14 +43216,13 @@ func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.AuthenticationMode = &s
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38138,78 +43232,60 @@ func (m *KubernetesDashboard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Addon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TaskID = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Codes = append(m.Codes, ErrorCode(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38236,8 +43312,10 @@ func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, ExpirableVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = &v1.Time{}
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -38262,7 +43340,7 @@ func (m *KubernetesSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastError) Unmarshal(dAtA []byte) error {
+func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38285,10 +43363,10 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastError: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -38325,9 +43403,9 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38337,28 +43415,28 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TaskID = &s
+			if err := m.TriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38386,13 +43464,13 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codes = append(m.Codes, ErrorCode(dAtA[iNdEx:postIndex]))
+			m.State = LastOperationState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38402,27 +43480,24 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpdateTime == nil {
-				m.LastUpdateTime = &v11.Time{}
-			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.FailureReason = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38445,7 +43520,7 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
+func (m *LastOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38468,10 +43543,10 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastMaintenance: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -38508,7 +43583,7 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38535,11 +43610,30 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			m.Progress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Progress |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
@@ -38571,9 +43665,9 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 			}
 			m.State = LastOperationState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38601,8 +43695,7 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.FailureReason = &s
+			m.Type = LastOperationType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38625,7 +43718,7 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastOperation) Unmarshal(dAtA []byte) error {
+func (m *LoadBalancerServicesProxyProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38648,15 +43741,169 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoadBalancerServicesProxyProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoadBalancerServicesProxyProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Logging) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Logging: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Logging: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, LoggingOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggingOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggingOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggingOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38684,13 +43931,13 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38700,30 +43947,29 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = LoggingOutputType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			m.Progress = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38733,14 +43979,27 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Progress |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38768,11 +44027,12 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = LastOperationState(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecretName = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38800,7 +44060,79 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = LastOperationType(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, LoggingOutputSource(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &LoggingOutputRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Buffer == nil {
+				m.Buffer = &LoggingOutputBuffer{}
+			}
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38823,7 +44155,7 @@ func (m *LastOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadBalancerServicesProxyProtocol) Unmarshal(dAtA []byte) error {
+func (m *LoggingOutputBuffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38846,17 +44178,53 @@ func (m *LoadBalancerServicesProxyProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadBalancerServicesProxyProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoggingOutputBuffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadBalancerServicesProxyProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoggingOutputBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoryLimit == nil {
+				m.MemoryLimit = &resource.Quantity{}
+			}
+			if err := m.MemoryLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryLimit", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38866,12 +44234,81 @@ func (m *LoadBalancerServicesProxyProtocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryLimit = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggingOutputRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggingOutputRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggingOutputRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsPerSecond", wireType)
+			}
+			m.RecordsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsPerSecond |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Allowed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39023,6 +44460,40 @@ func (m *Machine) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Architecture = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternativeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternativeTypes = append(m.AlternativeTypes, AlternativeMachineType{})
+			if err := m.AlternativeTypes[len(m.AlternativeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39103,7 +44574,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MachineDrainTimeout == nil {
-				m.MachineDrainTimeout = &v11.Duration{}
+				m.MachineDrainTimeout = &v1.Duration{}
 			}
 			if err := m.MachineDrainTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -39139,7 +44610,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MachineHealthTimeout == nil {
-				m.MachineHealthTimeout = &v11.Duration{}
+				m.MachineHealthTimeout = &v1.Duration{}
 			}
 			if err := m.MachineHealthTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -39175,7 +44646,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MachineCreationTimeout == nil {
-				m.MachineCreationTimeout = &v11.Duration{}
+				m.MachineCreationTimeout = &v1.Duration{}
 			}
 			if err := m.MachineCreationTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -40449,64 +45920,248 @@ func (m *MemorySwapConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := SwapBehavior(dAtA[iNdEx:postIndex])
-			m.SwapBehavior = &s
+			s := SwapBehavior(dAtA[iNdEx:postIndex])
+			m.SwapBehavior = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Monitoring) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Alerting == nil {
+				m.Alerting = &Alerting{}
+			}
+			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteWrite", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteWrite = append(m.RemoteWrite, MonitoringRemoteWrite{})
+			if err := m.RemoteWrite[len(m.RemoteWrite)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonitoringRemoteWrite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonitoringRemoteWrite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonitoringRemoteWrite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Monitoring) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Monitoring: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Monitoring: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alerting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40516,27 +46171,56 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Alerting == nil {
-				m.Alerting = &Alerting{}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecretName = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keep", wireType)
 			}
-			if err := m.Alerting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keep = append(m.Keep, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42817,7 +48501,7 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -42853,7 +48537,7 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -43067,7 +48751,223 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Secret = &s
+			m.Secret = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreDrainHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreDrainHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreDrainHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := PreDrainHookFailurePolicy(dAtA[iNdEx:postIndex])
+			m.FailurePolicy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43938,7 +49838,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StaleSinceTimestamp == nil {
-				m.StaleSinceTimestamp = &v11.Time{}
+				m.StaleSinceTimestamp = &v1.Time{}
 			}
 			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -43974,7 +49874,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StaleAutoDeleteTimestamp == nil {
-				m.StaleAutoDeleteTimestamp = &v11.Time{}
+				m.StaleAutoDeleteTimestamp = &v1.Time{}
 			}
 			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44010,7 +49910,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastActivityTimestamp == nil {
-				m.LastActivityTimestamp = &v11.Time{}
+				m.LastActivityTimestamp = &v1.Time{}
 			}
 			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -45531,7 +51431,7 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quotas = append(m.Quotas, v1.ObjectReference{})
+			m.Quotas = append(m.Quotas, v11.ObjectReference{})
 			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -45769,94 +51669,242 @@ func (m *SecretBindingProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Seed) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Seed: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Seed: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Seed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Seed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Seed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedBackup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedBackup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45883,96 +51931,16 @@ func (m *Seed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SeedBackup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SeedBackup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedBackup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46000,11 +51968,12 @@ func (m *SeedBackup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Region = &s
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46031,49 +52000,13 @@ func (m *SeedBackup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Region = &s
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46100,7 +52033,10 @@ func (m *SeedBackup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Replication == nil {
+				m.Replication = &BackupBucketReplication{}
+			}
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -47668,7 +53604,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -49527,7 +55463,7 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientCertificateExpirationTimestamp == nil {
-				m.ClientCertificateExpirationTimestamp = &v11.Time{}
+				m.ClientCertificateExpirationTimestamp = &v1.Time{}
 			}
 			if err := m.ClientCertificateExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50168,7 +56104,7 @@ func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MaxTokenExpiration == nil {
-				m.MaxTokenExpiration = &v11.Duration{}
+				m.MaxTokenExpiration = &v1.Duration{}
 			}
 			if err := m.MaxTokenExpiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50318,7 +56254,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50354,7 +56290,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50390,7 +56326,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
+				m.LastInitiationFinishedTime = &v1.Time{}
 			}
 			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50426,7 +56362,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+				m.LastCompletionTriggeredTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -51127,7 +57063,7 @@ func (m *ShootKubeconfigRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -51163,7 +57099,7 @@ func (m *ShootKubeconfigRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -51633,7 +57569,7 @@ func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -51669,7 +57605,7 @@ func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -52516,6 +58452,42 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.CredentialsBindingName = &s
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logging", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Logging == nil {
+				m.Logging = &Logging{}
+			}
+			if err := m.Logging.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53191,7 +59163,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RetryCycleStartTime == nil {
-				m.RetryCycleStartTime = &v11.Time{}
+				m.RetryCycleStartTime = &v1.Time{}
 			}
 			if err := m.RetryCycleStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -53391,7 +59363,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
+				m.MigrationStartTime = &v1.Time{}
 			}
 			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -53463,7 +59435,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastHibernationTriggerTime == nil {
-				m.LastHibernationTriggerTime = &v11.Time{}
+				m.LastHibernationTriggerTime = &v1.Time{}
 			}
 			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -53573,6 +59545,42 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastETCDRestore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastETCDRestore == nil {
+				m.LastETCDRestore = &LastETCDRestore{}
+			}
+			if err := m.LastETCDRestore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54108,7 +60116,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EvictAfterOOMThreshold == nil {
-				m.EvictAfterOOMThreshold = &v11.Duration{}
+				m.EvictAfterOOMThreshold = &v1.Duration{}
 			}
 			if err := m.EvictAfterOOMThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -54200,7 +60208,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UpdaterInterval == nil {
-				m.UpdaterInterval = &v11.Duration{}
+				m.UpdaterInterval = &v1.Duration{}
 			}
 			if err := m.UpdaterInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -54236,7 +60244,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RecommenderInterval == nil {
-				m.RecommenderInterval = &v11.Duration{}
+				m.RecommenderInterval = &v1.Duration{}
 			}
 			if err := m.RecommenderInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -55407,7 +61415,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Taints = append(m.Taints, v1.Taint{})
+			m.Taints = append(m.Taints, v11.Taint{})
 			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -55782,6 +61790,260 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelModules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KernelModules = append(m.KernelModules, KernelModule{})
+			if err := m.KernelModules[len(m.KernelModules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HugePages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HugePages = append(m.HugePages, HugePages{})
+			if err := m.HugePages[len(m.HugePages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := MachineUpdateStrategy(dAtA[iNdEx:postIndex])
+			m.UpdateStrategy = &s
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DrainPolicy == nil {
+				m.DrainPolicy = &WorkerDrainPolicy{}
+			}
+			if err := m.DrainPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerDrainPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerDrainPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerDrainPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionOrder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := PodEvictionOrder(dAtA[iNdEx:postIndex])
+			m.EvictionOrder = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDrainHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreDrainHooks = append(m.PreDrainHooks, PreDrainHook{})
+			if err := m.PreDrainHooks[len(m.PreDrainHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -343,7 +343,8 @@ func ShootIgnoresAlerts(shoot *gardencorev1beta1.Shoot) bool {
 
 // ShootWantsAlertManager checks if the given shoot specification requires an alert manager.
 func ShootWantsAlertManager(shoot *gardencorev1beta1.Shoot) bool {
-	return !ShootIgnoresAlerts(shoot) && shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil && len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0
+	return !ShootIgnoresAlerts(shoot) && shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil &&
+		(len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0 || len(shoot.Spec.Monitoring.Alerting.WebhookReceivers) > 0)
 }
 
 // ShootUsesUnmanagedDNS returns true if the shoot's DNS section is marked as 'unmanaged'.
@@ -1079,6 +1080,49 @@ func ShootDNSProviderSecretNamesEqual(oldDNS, newDNS *gardencorev1beta1.DNS) boo
 	return oldNames.Equal(newNames)
 }
 
+// ShootMonitoringRemoteWriteSecretNamesEqual returns true when all the secretNames of the remote write endpoints are
+// equal.
+func ShootMonitoringRemoteWriteSecretNamesEqual(oldMonitoring, newMonitoring *gardencorev1beta1.Monitoring) bool {
+	return GetShootMonitoringRemoteWriteSecretNames(oldMonitoring).Equal(GetShootMonitoringRemoteWriteSecretNames(newMonitoring))
+}
+
+// GetShootMonitoringRemoteWriteSecretNames returns the names of the secrets referenced by the remote write endpoints of
+// the Shoot's monitoring configuration.
+func GetShootMonitoringRemoteWriteSecretNames(monitoring *gardencorev1beta1.Monitoring) sets.Set[string] {
+	names := sets.New[string]()
+
+	if monitoring != nil {
+		for _, remoteWrite := range monitoring.RemoteWrite {
+			if remoteWrite.SecretName != nil {
+				names.Insert(*remoteWrite.SecretName)
+			}
+		}
+	}
+
+	return names
+}
+
+// ShootLoggingOutputSecretNamesEqual returns true when all the secretNames of the logging outputs are equal.
+func ShootLoggingOutputSecretNamesEqual(oldLogging, newLogging *gardencorev1beta1.Logging) bool {
+	return GetShootLoggingOutputSecretNames(oldLogging).Equal(GetShootLoggingOutputSecretNames(newLogging))
+}
+
+// GetShootLoggingOutputSecretNames returns the names of the secrets referenced by the logging outputs of the Shoot's
+// logging configuration.
+func GetShootLoggingOutputSecretNames(logging *gardencorev1beta1.Logging) sets.Set[string] {
+	names := sets.New[string]()
+
+	if logging != nil {
+		for _, output := range logging.Outputs {
+			if output.SecretName != nil {
+				names.Insert(*output.SecretName)
+			}
+		}
+	}
+
+	return names
+}
+
 // ShootResourceReferencesEqual returns true when at least one of the Secret/ConfigMap resource references inside a Shoot
 // has been changed.
 func ShootResourceReferencesEqual(oldResources, newResources []gardencorev1beta1.NamedResourceReference) bool {
@@ -1120,6 +1164,47 @@ func GetShootAuditPolicyConfigMapRef(apiServerConfig *gardencorev1beta1.KubeAPIS
 	return nil
 }
 
+// GetShootAuditWebhookSecretName returns the name of the secret referenced by the Shoot's audit webhook backend.
+func GetShootAuditWebhookSecretName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
+	if apiServerConfig != nil &&
+		apiServerConfig.AuditConfig != nil &&
+		apiServerConfig.AuditConfig.Backends != nil &&
+		apiServerConfig.AuditConfig.Backends.Webhook != nil &&
+		apiServerConfig.AuditConfig.Backends.Webhook.SecretName != nil {
+		return *apiServerConfig.AuditConfig.Backends.Webhook.SecretName
+	}
+	return ""
+}
+
+// GetShootAuditBackendPolicyConfigMapNames returns the names of the ConfigMaps containing the audit policies of the
+// Shoot's audit backends.
+func GetShootAuditBackendPolicyConfigMapNames(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) []string {
+	if apiServerConfig == nil || apiServerConfig.AuditConfig == nil || apiServerConfig.AuditConfig.Backends == nil {
+		return nil
+	}
+
+	var (
+		backends = apiServerConfig.AuditConfig.Backends
+		policies []*gardencorev1beta1.AuditPolicy
+		names    []string
+	)
+
+	if backends.Log != nil {
+		policies = append(policies, backends.Log.Policy)
+	}
+	if backends.Webhook != nil {
+		policies = append(policies, backends.Webhook.Policy)
+	}
+
+	for _, policy := range policies {
+		if policy != nil && policy.ConfigMapRef != nil && !slices.Contains(names, policy.ConfigMapRef.Name) {
+			names = append(names, policy.ConfigMapRef.Name)
+		}
+	}
+
+	return names
+}
+
 // GetShootAuthenticationConfigurationConfigMapName returns the Shoot's ConfigMap reference name for the aithentication configuration.
 func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
 	if apiServerConfig != nil &&
@@ -1129,6 +1214,16 @@ func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *gardencor
 	return ""
 }
 
+// GetShootAlertingCustomRulesConfigMapName returns the Shoot's ConfigMap reference name for the custom alerting rules.
+func GetShootAlertingCustomRulesConfigMapName(monitoring *gardencorev1beta1.Monitoring) string {
+	if monitoring != nil &&
+		monitoring.Alerting != nil &&
+		monitoring.Alerting.CustomRules != nil {
+		return monitoring.Alerting.CustomRules.ConfigMapName
+	}
+	return ""
+}
+
 // AnonymousAuthenticationEnabled returns true if anonymous authentication is set explicitly to 'true' and false otherwise.
 func AnonymousAuthenticationEnabled(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig) bool {
 	if kubeAPIServerConfig == nil {
@@ -1471,6 +1566,11 @@ func IsWorkerless(shoot *gardencorev1beta1.Shoot) bool {
 	return len(shoot.Spec.Provider.Workers) == 0
 }
 
+// IsUpdateStrategyInPlace returns true if the given machine update strategy updates the machines in-place.
+func IsUpdateStrategyInPlace(updateStrategy *gardencorev1beta1.MachineUpdateStrategy) bool {
+	return updateStrategy != nil && *updateStrategy == gardencorev1beta1.AutoInPlaceUpdate
+}
+
 // ShootEnablesSSHAccess returns true if ssh access to worker nodes should be allowed for the given shoot.
 func ShootEnablesSSHAccess(shoot *gardencorev1beta1.Shoot) bool {
 	return !IsWorkerless(shoot) &&
@@ -1551,3 +1651,20 @@ func ConvertShootList(list []gardencorev1beta1.Shoot) []*gardencorev1beta1.Shoot
 func HasManagedIssuer(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.GetAnnotations()[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged
 }
+
+// GetMachineArchitectures returns the distinct CPU architectures of the machine type and the alternative machine types
+// of the given machine. Alternative machine types without an architecture use the one of the machine type.
+func GetMachineArchitectures(machine gardencorev1beta1.Machine) []string {
+	var architectures []string
+	if machine.Architecture != nil {
+		architectures = append(architectures, *machine.Architecture)
+	}
+
+	for _, alternativeType := range machine.AlternativeTypes {
+		if alternativeType.Architecture != nil && !slices.Contains(architectures, *alternativeType.Architecture) {
+			architectures = append(architectures, *alternativeType.Architecture)
+		}
+	}
+
+	return architectures
+}
@@ -2385,6 +2385,16 @@ var _ = Describe("Helper", func() {
 				}
 				Expect(ShootWantsAlertManager(shoot)).To(BeTrue())
 			})
+			It("should want alert manager because of webhook receivers", func() {
+				shoot.Spec = gardencorev1beta1.ShootSpec{
+					Monitoring: &gardencorev1beta1.Monitoring{
+						Alerting: &gardencorev1beta1.Alerting{
+							WebhookReceivers: []gardencorev1beta1.AlertingWebhookReceiver{{Name: "foo", URL: "https://alerts.example.com"}},
+						},
+					},
+				}
+				Expect(ShootWantsAlertManager(shoot)).To(BeTrue())
+			})
 		})
 	})
 
@@ -2463,6 +2473,32 @@ var _ = Describe("Helper", func() {
 		Entry("equality", &gardencorev1beta1.DNS{Providers: []gardencorev1beta1.DNSProvider{{SecretName: ptr.To("foo")}}}, &gardencorev1beta1.DNS{Providers: []gardencorev1beta1.DNSProvider{{SecretName: ptr.To("foo")}}}, BeTrue()),
 	)
 
+	DescribeTable("#ShootMonitoringRemoteWriteSecretNamesEqual",
+		func(oldMonitoring, newMonitoring *gardencorev1beta1.Monitoring, matcher gomegatypes.GomegaMatcher) {
+			Expect(ShootMonitoringRemoteWriteSecretNamesEqual(oldMonitoring, newMonitoring)).To(matcher)
+		},
+
+		Entry("both nil", nil, nil, BeTrue()),
+		Entry("old nil, new w/o secret names", nil, &gardencorev1beta1.Monitoring{RemoteWrite: []gardencorev1beta1.MonitoringRemoteWrite{{Name: "foo"}}}, BeTrue()),
+		Entry("old w/o secret names, new nil", &gardencorev1beta1.Monitoring{}, nil, BeTrue()),
+		Entry("difference due to old", &gardencorev1beta1.Monitoring{}, &gardencorev1beta1.Monitoring{RemoteWrite: []gardencorev1beta1.MonitoringRemoteWrite{{SecretName: ptr.To("foo")}}}, BeFalse()),
+		Entry("difference due to new", &gardencorev1beta1.Monitoring{RemoteWrite: []gardencorev1beta1.MonitoringRemoteWrite{{SecretName: ptr.To("foo")}}}, &gardencorev1beta1.Monitoring{}, BeFalse()),
+		Entry("equality", &gardencorev1beta1.Monitoring{RemoteWrite: []gardencorev1beta1.MonitoringRemoteWrite{{SecretName: ptr.To("foo")}}}, &gardencorev1beta1.Monitoring{RemoteWrite: []gardencorev1beta1.MonitoringRemoteWrite{{Name: "bar", SecretName: ptr.To("foo")}}}, BeTrue()),
+	)
+
+	DescribeTable("#ShootLoggingOutputSecretNamesEqual",
+		func(oldLogging, newLogging *gardencorev1beta1.Logging, matcher gomegatypes.GomegaMatcher) {
+			Expect(ShootLoggingOutputSecretNamesEqual(oldLogging, newLogging)).To(matcher)
+		},
+
+		Entry("both nil", nil, nil, BeTrue()),
+		Entry("old nil, new w/o secret names", nil, &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{Name: "foo"}}}, BeTrue()),
+		Entry("old w/o secret names, new nil", &gardencorev1beta1.Logging{}, nil, BeTrue()),
+		Entry("difference due to old", &gardencorev1beta1.Logging{}, &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{SecretName: ptr.To("foo")}}}, BeFalse()),
+		Entry("difference due to new", &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{SecretName: ptr.To("foo")}}}, &gardencorev1beta1.Logging{}, BeFalse()),
+		Entry("equality", &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{SecretName: ptr.To("foo")}}}, &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{Name: "bar", SecretName: ptr.To("foo")}}}, BeTrue()),
+	)
+
 	DescribeTable("#ShootResourceReferencesEqual",
 		func(oldResources, newResources []gardencorev1beta1.NamedResourceReference, matcher gomegatypes.GomegaMatcher) {
 			Expect(ShootResourceReferencesEqual(oldResources, newResources)).To(matcher)
@@ -2544,6 +2580,53 @@ var _ = Describe("Helper", func() {
 		}, &corev1.ObjectReference{Name: "foo"})
 	})
 
+	DescribeTable("#GetShootAuditWebhookSecretName",
+		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
+			Expect(GetShootAuditWebhookSecretName(kubeAPIServerConfig)).To(Equal(expectedName))
+		},
+
+		Entry("KubeAPIServerConfig = nil", nil, ""),
+		Entry("AuditConfig = nil", &gardencorev1beta1.KubeAPIServerConfig{}, ""),
+		Entry("Backends = nil", &gardencorev1beta1.KubeAPIServerConfig{AuditConfig: &gardencorev1beta1.AuditConfig{}}, ""),
+		Entry("Webhook = nil", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{}},
+		}, ""),
+		Entry("SecretName not set", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{Webhook: &gardencorev1beta1.AuditWebhookBackend{}}},
+		}, ""),
+		Entry("SecretName set", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{Webhook: &gardencorev1beta1.AuditWebhookBackend{SecretName: ptr.To("foo")}}},
+		}, "foo"),
+	)
+
+	DescribeTable("#GetShootAuditBackendPolicyConfigMapNames",
+		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedNames []string) {
+			Expect(GetShootAuditBackendPolicyConfigMapNames(kubeAPIServerConfig)).To(Equal(expectedNames))
+		},
+
+		Entry("KubeAPIServerConfig = nil", nil, nil),
+		Entry("AuditConfig = nil", &gardencorev1beta1.KubeAPIServerConfig{}, nil),
+		Entry("Backends = nil", &gardencorev1beta1.KubeAPIServerConfig{AuditConfig: &gardencorev1beta1.AuditConfig{}}, nil),
+		Entry("policies not set", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{
+				Log:     &gardencorev1beta1.AuditLogBackend{},
+				Webhook: &gardencorev1beta1.AuditWebhookBackend{Policy: &gardencorev1beta1.AuditPolicy{}},
+			}},
+		}, nil),
+		Entry("policies set", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{
+				Log:     &gardencorev1beta1.AuditLogBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "foo"}}},
+				Webhook: &gardencorev1beta1.AuditWebhookBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "bar"}}},
+			}},
+		}, []string{"foo", "bar"}),
+		Entry("same policy for both backends", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{Backends: &gardencorev1beta1.AuditBackends{
+				Log:     &gardencorev1beta1.AuditLogBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "foo"}}},
+				Webhook: &gardencorev1beta1.AuditWebhookBackend{Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "foo"}}},
+			}},
+		}, []string{"foo"}),
+	)
+
 	DescribeTable("#GetShootAuthenticationConfigurationConfigMapName",
 		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
 			authConfigName := GetShootAuthenticationConfigurationConfigMapName(kubeAPIServerConfig)
@@ -2562,6 +2645,23 @@ var _ = Describe("Helper", func() {
 		}, "foo"),
 	)
 
+	DescribeTable("#GetShootAlertingCustomRulesConfigMapName",
+		func(monitoring *gardencorev1beta1.Monitoring, expectedName string) {
+			Expect(GetShootAlertingCustomRulesConfigMapName(monitoring)).To(Equal(expectedName))
+		},
+
+		Entry("Monitoring = nil", nil, ""),
+		Entry("Alerting = nil", &gardencorev1beta1.Monitoring{}, ""),
+		Entry("CustomRules = nil", &gardencorev1beta1.Monitoring{Alerting: &gardencorev1beta1.Alerting{}}, ""),
+		Entry("ConfigMapName set", &gardencorev1beta1.Monitoring{
+			Alerting: &gardencorev1beta1.Alerting{
+				CustomRules: &gardencorev1beta1.AlertingCustomRules{
+					ConfigMapName: "foo",
+				},
+			},
+		}, "foo"),
+	)
+
 	Describe("#CalculateSeedUsage", func() {
 		type shootCase struct {
 			specSeedName, statusSeedName string
@@ -3440,4 +3540,21 @@ var _ = Describe("Helper", func() {
 			Expect(converted[1].Name).To(Equal("shoot2"))
 		})
 	})
+
+	DescribeTable("#GetMachineArchitectures",
+		func(machine gardencorev1beta1.Machine, expected []string) {
+			Expect(GetMachineArchitectures(machine)).To(Equal(expected))
+		},
+
+		Entry("no architecture", gardencorev1beta1.Machine{}, nil),
+		Entry("only machine architecture", gardencorev1beta1.Machine{Architecture: ptr.To("amd64")}, []string{"amd64"}),
+		Entry("alternative types with same or without architecture", gardencorev1beta1.Machine{
+			Architecture:     ptr.To("amd64"),
+			AlternativeTypes: []gardencorev1beta1.AlternativeMachineType{{Type: "foo", Architecture: ptr.To("amd64")}, {Type: "bar"}},
+		}, []string{"amd64"}),
+		Entry("alternative types with different architectures", gardencorev1beta1.Machine{
+			Architecture:     ptr.To("amd64"),
+			AlternativeTypes: []gardencorev1beta1.AlternativeMachineType{{Type: "foo", Architecture: ptr.To("arm64")}, {Type: "bar", Architecture: ptr.To("amd64")}},
+		}, []string{"amd64", "arm64"}),
+	)
 })
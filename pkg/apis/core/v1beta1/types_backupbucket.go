@@ -50,6 +50,10 @@ type BackupBucketSpec struct {
 	// This field is immutable.
 	// +optional
 	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,4,opt,name=seedName"`
+	// Replication configures the continuous replication of the backups stored in this bucket to a secondary object
+	// store, e.g., in a different region or of a different provider.
+	// +optional
+	Replication *BackupBucketReplication `json:"replication,omitempty" protobuf:"bytes,5,opt,name=replication"`
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -80,3 +84,23 @@ type BackupBucketProvider struct {
 	// Region is the region of the bucket.
 	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
 }
+
+// BackupBucketReplication contains the configuration for replicating the backups of a BackupBucket to a secondary
+// object store.
+type BackupBucketReplication struct {
+	// Provider holds the details of cloud provider of the secondary object store.
+	Provider BackupBucketProvider `json:"provider" protobuf:"bytes,1,opt,name=provider"`
+	// ProviderConfig is the configuration passed to the BackupBucket resource of the secondary object store.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,2,opt,name=providerConfig"`
+	// SecretRef is a reference to a secret that contains the credentials to access the secondary object store.
+	SecretRef corev1.SecretReference `json:"secretRef" protobuf:"bytes,3,opt,name=secretRef"`
+	// MaxBackupAge is the maximum age in days of the backups which are copied to the secondary object store.
+	// Older backups are not replicated. If not set, all backups are replicated.
+	// +optional
+	MaxBackupAge *int32 `json:"maxBackupAge,omitempty" protobuf:"varint,4,opt,name=maxBackupAge"`
+	// MaxBackups is the maximum number of the most recent full backups which are copied to the secondary object
+	// store. If not set, all backups are replicated.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty" protobuf:"varint,5,opt,name=maxBackups"`
+}
@@ -69,4 +69,22 @@ type BackupEntryStatus struct {
 	// MigrationStartTime is the time when a migration to a different seed was initiated.
 	// +optional
 	MigrationStartTime *metav1.Time `json:"migrationStartTime,omitempty" protobuf:"bytes,5,opt,name=migrationStartTime"`
+	// Replication contains information about the replication of this BackupEntry to the secondary object store.
+	// +optional
+	Replication *BackupEntryReplicationStatus `json:"replication,omitempty" protobuf:"bytes,6,opt,name=replication"`
+}
+
+// BackupEntryReplicationStatus contains information about the replication of a BackupEntry to the secondary object
+// store of its BackupBucket.
+type BackupEntryReplicationStatus struct {
+	// LastReplicationTime is the time when the last successful replication was started. All backups taken before this
+	// time are available in the secondary object store.
+	// +optional
+	LastReplicationTime *metav1.Time `json:"lastReplicationTime,omitempty" protobuf:"bytes,1,opt,name=lastReplicationTime"`
+	// Lag is the duration between now and the last successful replication at the time the status was updated.
+	// +optional
+	Lag *metav1.Duration `json:"lag,omitempty" protobuf:"bytes,2,opt,name=lag"`
+	// LastError holds information about the last occurred error during the replication.
+	// +optional
+	LastError *LastError `json:"lastError,omitempty" protobuf:"bytes,3,opt,name=lastError"`
 }
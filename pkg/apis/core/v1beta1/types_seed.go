@@ -129,6 +129,10 @@ type SeedBackup struct {
 	// the object store where backups should be stored. It should have enough privileges to manipulate
 	// the objects as well as buckets.
 	SecretRef corev1.SecretReference `json:"secretRef" protobuf:"bytes,4,opt,name=secretRef"`
+	// Replication configures the continuous replication of the backups to a secondary object store, e.g., in a
+	// different region or of a different provider.
+	// +optional
+	Replication *BackupBucketReplication `json:"replication,omitempty" protobuf:"bytes,5,opt,name=replication"`
 }
 
 // SeedDNS contains DNS-relevant information about this seed cluster.
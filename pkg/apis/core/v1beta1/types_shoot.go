@@ -138,6 +138,9 @@ type ShootSpec struct {
 	// The credentials will be used to create the shoot in the respective account. The field is mutually exclusive with SecretBindingName.
 	// +optional
 	CredentialsBindingName *string `json:"credentialsBindingName,omitempty" protobuf:"bytes,23,opt,name=credentialsBindingName"`
+	// Logging contains information about custom logging configurations for the shoot.
+	// +optional
+	Logging *Logging `json:"logging,omitempty" protobuf:"bytes,24,opt,name=logging"`
 }
 
 var _ gardencore.Object = (*Shoot)(nil)
@@ -217,6 +220,9 @@ type ShootStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *NetworkingStatus `json:"networking,omitempty" protobuf:"bytes,19,opt,name=networking"`
+	// LastETCDRestore holds information about the last restore of the main etcd from its backup.
+	// +optional
+	LastETCDRestore *LastETCDRestore `json:"lastETCDRestore,omitempty" protobuf:"bytes,20,opt,name=lastETCDRestore"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -232,6 +238,19 @@ type LastMaintenance struct {
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
 }
 
+// LastETCDRestore holds information about a restore of the main etcd of the Shoot from the latest snapshot in its backup. Restoring to an arbitrary point in time is not supported.
+type LastETCDRestore struct {
+	// A human-readable message containing details about the restore.
+	Description string `json:"description" protobuf:"bytes,1,opt,name=description"`
+	// TriggeredTime is the time when the restore was triggered.
+	TriggeredTime metav1.Time `json:"triggeredTime" protobuf:"bytes,2,opt,name=triggeredTime"`
+	// Status of the restore, one of Processing, Succeeded.
+	State LastOperationState `json:"state" protobuf:"bytes,3,opt,name=state,casttype=LastOperationState"`
+	// CompletionTime is the time when the restore was completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,4,opt,name=completionTime"`
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -903,6 +922,81 @@ type AuditConfig struct {
 	// AuditPolicy contains configuration settings for audit policy of the kube-apiserver.
 	// +optional
 	AuditPolicy *AuditPolicy `json:"auditPolicy,omitempty" protobuf:"bytes,1,opt,name=auditPolicy"`
+	// Backends contains the configuration of the backends to which the audit events are forwarded. If set, an audit
+	// forwarder running next to the kube-apiserver stores the events in a persistent queue and delivers them to each
+	// backend. If not set, the audit events are written to a log file inside the kube-apiserver container which is
+	// neither persisted nor shipped anywhere.
+	// This field is only available if the `ShootAuditBackends` feature gate is enabled.
+	// +optional
+	Backends *AuditBackends `json:"backends,omitempty" protobuf:"bytes,2,opt,name=backends"`
+}
+
+// AuditBackends contains the configuration of the backends to which the audit events are forwarded.
+type AuditBackends struct {
+	// Log ships the audit events into the logging stack of the shoot control plane.
+	// +optional
+	Log *AuditLogBackend `json:"log,omitempty" protobuf:"bytes,1,opt,name=log"`
+	// Webhook sends the audit events to an external HTTPS endpoint.
+	// +optional
+	Webhook *AuditWebhookBackend `json:"webhook,omitempty" protobuf:"bytes,2,opt,name=webhook"`
+}
+
+// AuditLogBackend contains the configuration of the audit log backend.
+type AuditLogBackend struct {
+	// Policy contains the audit policy for this backend. It can only reduce the events and their level selected by the
+	// audit policy of the kube-apiserver. If not set, all events selected by the audit policy of the kube-apiserver are
+	// shipped.
+	// +optional
+	Policy *AuditPolicy `json:"policy,omitempty" protobuf:"bytes,1,opt,name=policy"`
+}
+
+// AuditWebhookBackend contains the configuration of the audit webhook backend.
+type AuditWebhookBackend struct {
+	// URL is the HTTPS endpoint to which the audit events are sent.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// SecretName is the name of a secret in the project namespace containing the credentials for the endpoint and the
+	// key for signing the batches. The secret may contain a bearer token in the `token` key, a CA bundle in the `ca.crt`
+	// key and a signing key in the `signing.key` key.
+	// +optional
+	SecretName *string `json:"secretName,omitempty" protobuf:"bytes,2,opt,name=secretName"`
+	// Policy contains the audit policy for this backend. It can only reduce the events and their level selected by the
+	// audit policy of the kube-apiserver. If not set, all events selected by the audit policy of the kube-apiserver are
+	// sent.
+	// +optional
+	Policy *AuditPolicy `json:"policy,omitempty" protobuf:"bytes,3,opt,name=policy"`
+	// Batch contains the settings for batching audit events.
+	// +optional
+	Batch *AuditBatch `json:"batch,omitempty" protobuf:"bytes,4,opt,name=batch"`
+	// Truncate contains the settings for truncating audit events and batches.
+	// +optional
+	Truncate *AuditTruncate `json:"truncate,omitempty" protobuf:"bytes,5,opt,name=truncate"`
+	// InitialBackoff is the amount of time to wait before retrying the first failed request. Consecutive failures
+	// are retried with an exponential backoff of up to five minutes until the request succeeds. If not set, the first
+	// retry happens after 10s.
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty" protobuf:"bytes,6,opt,name=initialBackoff"`
+}
+
+// AuditBatch contains the settings for batching audit events.
+type AuditBatch struct {
+	// MaxSize is the maximum number of events in a batch. If not set, batches contain up to 400 events.
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty" protobuf:"varint,1,opt,name=maxSize"`
+	// MaxWait is the amount of time to wait before force sending a batch that has not reached the maximum size. If not
+	// set, batches are sent after 30s at the latest.
+	// +optional
+	MaxWait *metav1.Duration `json:"maxWait,omitempty" protobuf:"bytes,2,opt,name=maxWait"`
+}
+
+// AuditTruncate contains the settings for truncating audit events and batches.
+type AuditTruncate struct {
+	// MaxBatchSize is the maximum size of a batch sent to the backend. Larger batches are split.
+	// +optional
+	MaxBatchSize *resource.Quantity `json:"maxBatchSize,omitempty" protobuf:"bytes,1,opt,name=maxBatchSize"`
+	// MaxEventSize is the maximum size of an audit event sent to the backend. Larger events are truncated by removing
+	// the request and response objects, or dropped if this is not sufficient.
+	// +optional
+	MaxEventSize *resource.Quantity `json:"maxEventSize,omitempty" protobuf:"bytes,2,opt,name=maxEventSize"`
 }
 
 // AuditPolicy contains audit policy for kube-apiserver
@@ -1433,6 +1527,95 @@ type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
 	// +optional
 	Alerting *Alerting `json:"alerting,omitempty" protobuf:"bytes,1,opt,name=alerting"`
+	// RemoteWrite is a list of endpoints to which metrics of the shoot control plane are exported via the Prometheus
+	// remote write protocol.
+	// +optional
+	RemoteWrite []MonitoringRemoteWrite `json:"remoteWrite,omitempty" protobuf:"bytes,2,rep,name=remoteWrite"`
+}
+
+// MonitoringRemoteWrite contains information about an endpoint to which metrics of the shoot control plane are
+// exported.
+type MonitoringRemoteWrite struct {
+	// Name is the name of the remote write endpoint.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the HTTPS endpoint to which metrics are sent.
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// SecretName is the name of a secret in the project namespace which contains basic auth credentials (`username`
+	// and `password`) for the remote write endpoint.
+	// +optional
+	SecretName *string `json:"secretName,omitempty" protobuf:"bytes,3,opt,name=secretName"`
+	// Keep is the list of names of metrics which are exported to the remote write endpoint. All other metrics are
+	// dropped.
+	Keep []string `json:"keep" protobuf:"bytes,4,rep,name=keep"`
+}
+
+// Logging contains information about the logging configuration for the shoot.
+type Logging struct {
+	// Outputs is a list of additional endpoints to which logs of the shoot control plane and/or the shoot events are
+	// exported. The logs are still sent to the Gardener-managed logging stack.
+	// +optional
+	Outputs []LoggingOutput `json:"outputs,omitempty" protobuf:"bytes,1,rep,name=outputs"`
+}
+
+// LoggingOutput contains information about an endpoint to which logs are exported.
+type LoggingOutput struct {
+	// Name is the name of the output.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the protocol used to send the logs to the endpoint. Supported values are `OTLP` (OpenTelemetry protocol
+	// over HTTP) and `HTTP` (JSON lines over HTTP).
+	Type LoggingOutputType `json:"type" protobuf:"bytes,2,opt,name=type,casttype=LoggingOutputType"`
+	// URL is the HTTPS endpoint to which logs are sent.
+	URL string `json:"url" protobuf:"bytes,3,opt,name=url"`
+	// SecretName is the name of a secret in the project namespace which contains basic auth credentials (`username`
+	// and `password`) for the endpoint.
+	// +optional
+	SecretName *string `json:"secretName,omitempty" protobuf:"bytes,4,opt,name=secretName"`
+	// Sources is the list of log streams which are exported to the endpoint. Supported values are `ControlPlane` (logs
+	// of the shoot control plane components) and `Events` (events of the shoot cluster collected by the event logger).
+	Sources []LoggingOutputSource `json:"sources" protobuf:"bytes,5,rep,name=sources,casttype=LoggingOutputSource"`
+	// RateLimit limits the rate with which log records are sent to the endpoint. Records exceeding the limit are
+	// dropped.
+	// +optional
+	RateLimit *LoggingOutputRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,6,opt,name=rateLimit"`
+	// Buffer contains settings for buffering log records which could not be sent to the endpoint yet.
+	// +optional
+	Buffer *LoggingOutputBuffer `json:"buffer,omitempty" protobuf:"bytes,7,opt,name=buffer"`
+}
+
+// LoggingOutputType is a type alias for the protocol of a logging output.
+type LoggingOutputType string
+
+const (
+	// LoggingOutputTypeOTLP is a constant for the OpenTelemetry protocol (OTLP/HTTP).
+	LoggingOutputTypeOTLP LoggingOutputType = "OTLP"
+	// LoggingOutputTypeHTTP is a constant for a generic HTTP endpoint receiving JSON lines.
+	LoggingOutputTypeHTTP LoggingOutputType = "HTTP"
+)
+
+// LoggingOutputSource is a type alias for a log stream which can be exported.
+type LoggingOutputSource string
+
+const (
+	// LoggingOutputSourceControlPlane is a constant for the logs of the shoot control plane components.
+	LoggingOutputSourceControlPlane LoggingOutputSource = "ControlPlane"
+	// LoggingOutputSourceEvents is a constant for the events of the shoot cluster collected by the event logger.
+	LoggingOutputSourceEvents LoggingOutputSource = "Events"
+)
+
+// LoggingOutputRateLimit contains settings for limiting the rate of exported log records.
+type LoggingOutputRateLimit struct {
+	// RecordsPerSecond is the maximum number of log records per second which are sent to the endpoint.
+	RecordsPerSecond int32 `json:"recordsPerSecond" protobuf:"varint,1,opt,name=recordsPerSecond"`
+}
+
+// LoggingOutputBuffer contains settings for buffering log records.
+type LoggingOutputBuffer struct {
+	// MemoryLimit is the maximum amount of memory used for buffering log records of this output.
+	// +optional
+	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty" protobuf:"bytes,1,opt,name=memoryLimit"`
+	// RetryLimit is the maximum number of retries for sending a chunk of log records before it is discarded.
+	// +optional
+	RetryLimit *int32 `json:"retryLimit,omitempty" protobuf:"varint,2,opt,name=retryLimit"`
 }
 
 // Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).
@@ -1440,6 +1623,31 @@ type Alerting struct {
 	// MonitoringEmailReceivers is a list of recipients for alerts
 	// +optional
 	EmailReceivers []string `json:"emailReceivers,omitempty" protobuf:"bytes,1,rep,name=emailReceivers"`
+	// WebhookReceivers is a list of generic HTTP endpoints to which alerts are sent.
+	// +optional
+	WebhookReceivers []AlertingWebhookReceiver `json:"webhookReceivers,omitempty" protobuf:"bytes,2,rep,name=webhookReceivers"`
+	// CustomRules references user-defined Prometheus alerting rules which are evaluated by the monitoring stack of the
+	// shoot cluster.
+	// +optional
+	CustomRules *AlertingCustomRules `json:"customRules,omitempty" protobuf:"bytes,3,opt,name=customRules"`
+}
+
+// AlertingWebhookReceiver contains information about a generic HTTP endpoint to which alerts are sent.
+type AlertingWebhookReceiver struct {
+	// Name is the name of the receiver.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the HTTPS endpoint to which alerts are sent in the Alertmanager webhook format. It must not point to loopback, private, link-local or cluster-internal addresses.
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// SendResolved controls whether notifications about resolved alerts are sent (default: true).
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty" protobuf:"varint,3,opt,name=sendResolved"`
+}
+
+// AlertingCustomRules references user-defined Prometheus alerting rules.
+type AlertingCustomRules struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains the alerting rules under the
+	// 'rules.yaml' key. The rules must be given in the format of the `spec` of a PrometheusRule resource.
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,opt,name=configMapName"`
 }
 
 // Provider contains provider-specific information that are handed-over to the provider-specific
@@ -1532,6 +1740,103 @@ type Worker struct {
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty" protobuf:"bytes,21,opt,name=clusterAutoscaler"`
+	// KernelModules is a list of kernel modules to load on all machines in this worker pool.
+	// +optional
+	KernelModules []KernelModule `json:"kernelModules,omitempty" protobuf:"bytes,22,rep,name=kernelModules"`
+	// HugePages is a list of huge pages configurations to apply on all machines in this worker pool.
+	// +optional
+	HugePages []HugePages `json:"hugePages,omitempty" protobuf:"bytes,23,rep,name=hugePages"`
+	// UpdateStrategy specifies how the machines of this worker pool are updated when their configuration changes.
+	// Possible values are `AutoRollingUpdate` (default) and `AutoInPlaceUpdate`. The strategy cannot be changed once the
+	// worker pool was created.
+	// +optional
+	UpdateStrategy *MachineUpdateStrategy `json:"updateStrategy,omitempty" protobuf:"bytes,24,opt,name=updateStrategy,casttype=MachineUpdateStrategy"`
+	// DrainPolicy contains the policy for draining the nodes of this worker pool before their machines are replaced or
+	// updated in-place. The drain timeout and the maximum number of eviction retries are configured in the
+	// `machineControllerManager` settings.
+	// +optional
+	DrainPolicy *WorkerDrainPolicy `json:"drainPolicy,omitempty" protobuf:"bytes,25,opt,name=drainPolicy"`
+}
+
+// MachineUpdateStrategy is the update strategy for the machines of a worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// AutoRollingUpdate is the update strategy which replaces the machines of a worker pool with new machines.
+	AutoRollingUpdate MachineUpdateStrategy = "AutoRollingUpdate"
+	// AutoInPlaceUpdate is the update strategy which updates the operating system and the kubelet on the existing
+	// machines of a worker pool. Changes which cannot be applied in-place (e.g., the machine type) still replace the
+	// machines.
+	AutoInPlaceUpdate MachineUpdateStrategy = "AutoInPlaceUpdate"
+)
+
+// WorkerDrainPolicy contains the policy for draining the nodes of a worker pool.
+type WorkerDrainPolicy struct {
+	// EvictionOrder specifies the order in which the pods of a node are evicted. Possible values are `Parallel`
+	// (default) and `PriorityAscending`.
+	// +optional
+	EvictionOrder *PodEvictionOrder `json:"evictionOrder,omitempty" protobuf:"bytes,3,opt,name=evictionOrder,casttype=PodEvictionOrder"`
+	// PreDrainHooks is a list of webhooks which are called one after another before the pods of a node are evicted.
+	// +optional
+	PreDrainHooks []PreDrainHook `json:"preDrainHooks,omitempty" protobuf:"bytes,4,rep,name=preDrainHooks"`
+}
+
+// PodEvictionOrder is the order in which the pods of a node are evicted.
+type PodEvictionOrder string
+
+const (
+	// PodEvictionOrderParallel evicts all pods of a node at the same time.
+	PodEvictionOrderParallel PodEvictionOrder = "Parallel"
+	// PodEvictionOrderPriorityAscending evicts the pods of a node in ascending order of their priority. Pods with a
+	// higher priority are only evicted once all pods with a lower priority are gone.
+	PodEvictionOrderPriorityAscending PodEvictionOrder = "PriorityAscending"
+)
+
+// PreDrainHook is a webhook which is called before the pods of a node are evicted. The webhook receives a POST request
+// with the name of the node and of its worker pool and must respond with a 2xx status code once it is fine to drain the
+// node. Hooks might be called multiple times for the same node and must therefore be idempotent.
+type PreDrainHook struct {
+	// Name is the name of the hook.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the HTTPS URL of the webhook.
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// CABundle is a PEM encoded CA bundle which is used to verify the serving certificate of the webhook. If it is not
+	// set, the system trust roots of the machine are used.
+	// +optional
+	CABundle *string `json:"caBundle,omitempty" protobuf:"bytes,3,opt,name=caBundle"`
+	// Timeout is the maximum duration for the webhook call. Defaults to 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
+	// FailurePolicy defines how a failed webhook call is handled. Possible values are `Fail` (default) and `Ignore`.
+	// +optional
+	FailurePolicy *PreDrainHookFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,5,opt,name=failurePolicy,casttype=PreDrainHookFailurePolicy"`
+}
+
+// PreDrainHookFailurePolicy defines how a failed pre-drain hook call is handled.
+type PreDrainHookFailurePolicy string
+
+const (
+	// PreDrainHookFailurePolicyFail aborts the drain if the hook call fails. The drain is retried later.
+	PreDrainHookFailurePolicyFail PreDrainHookFailurePolicy = "Fail"
+	// PreDrainHookFailurePolicyIgnore continues the drain if the hook call fails.
+	PreDrainHookFailurePolicyIgnore PreDrainHookFailurePolicy = "Ignore"
+)
+
+// KernelModule contains configuration for a kernel module.
+type KernelModule struct {
+	// Name is the name of the kernel module.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Parameters is a map of parameters passed to the kernel module when it is loaded.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty" protobuf:"bytes,2,rep,name=parameters"`
+}
+
+// HugePages contains configuration for huge pages of a certain size.
+type HugePages struct {
+	// PageSize is the size of the huge pages, e.g., 2Mi or 1Gi.
+	PageSize resource.Quantity `json:"pageSize" protobuf:"bytes,1,opt,name=pageSize"`
+	// Count is the number of huge pages of the given size which are allocated.
+	Count int32 `json:"count" protobuf:"varint,2,opt,name=count"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -1603,6 +1908,28 @@ type Machine struct {
 	// Architecture is CPU architecture of machines in this worker pool.
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,3,opt,name=architecture"`
+	// AlternativeTypes is a prioritized list of further machine types of the worker pool. They are used if no capacity
+	// is available for machines of a type with a higher priority, i.e., `type` has the highest priority. The provider
+	// extension creates separate machine deployments for each machine type, and cluster-autoscaler scales them up
+	// according to their priority. Hence, the `minimum` of the worker pool only applies to the machines of `type`, while
+	// the `maximum` applies to the machines of each type individually. The worker pool can thus grow to `maximum` times
+	// the number of machine types, which is accounted for in quotas and must fit into the nodes network.
+	// +optional
+	AlternativeTypes []AlternativeMachineType `json:"alternativeTypes,omitempty" protobuf:"bytes,4,rep,name=alternativeTypes"`
+}
+
+// AlternativeMachineType contains information about an alternative machine type of a worker pool.
+type AlternativeMachineType struct {
+	// Type is the machine type.
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// Architecture is the CPU architecture of the machines of this type. Defaults to the architecture of the worker
+	// pool. The machine image of the worker pool must support this architecture.
+	// +optional
+	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,2,opt,name=architecture"`
+	// ProviderConfig is the provider-specific configuration for the machines of this type, e.g., whether spot
+	// instances shall be used. If it is not set, the provider config of the worker pool is used.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,3,opt,name=providerConfig"`
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1655,6 +1982,21 @@ type CRI struct {
 	// ContainerRuntimes is the list of the required container runtimes supported for a worker pool.
 	// +optional
 	ContainerRuntimes []ContainerRuntime `json:"containerRuntimes,omitempty" protobuf:"bytes,2,rep,name=containerRuntimes"`
+	// ImagePrePull configures images which are pre-pulled on the nodes of the worker pool and pinned against garbage
+	// collection.
+	// +optional
+	ImagePrePull *ImagePrePull `json:"imagePrePull,omitempty" protobuf:"bytes,3,opt,name=imagePrePull"`
+}
+
+// ImagePrePull contains configuration for pre-pulling images on the nodes of a worker pool.
+type ImagePrePull struct {
+	// SystemComponents states whether the images of the system components running on every node of the worker pool
+	// (e.g., kube-proxy, node-local-dns) are pre-pulled. Defaults to false.
+	// +optional
+	SystemComponents *bool `json:"systemComponents,omitempty" protobuf:"varint,1,opt,name=systemComponents"`
+	// Images is a list of additional image references which are pre-pulled.
+	// +optional
+	Images []string `json:"images,omitempty" protobuf:"bytes,2,rep,name=images"`
 }
 
 // CRIName is a type alias for the CRI name string.
@@ -1694,6 +2036,11 @@ var (
 	DefaultWorkerMaxSurge = intstr.FromInt32(1)
 	// DefaultWorkerMaxUnavailable is the default value for Worker MaxUnavailable.
 	DefaultWorkerMaxUnavailable = intstr.FromInt32(0)
+	// DefaultInPlaceWorkerMaxSurge is the default value for Worker MaxSurge if the worker pool is updated in-place.
+	DefaultInPlaceWorkerMaxSurge = intstr.FromInt32(0)
+	// DefaultInPlaceWorkerMaxUnavailable is the default value for Worker MaxUnavailable if the worker pool is updated
+	// in-place.
+	DefaultInPlaceWorkerMaxUnavailable = intstr.FromInt32(1)
 	// DefaultWorkerSystemComponentsAllow is the default value for Worker AllowSystemComponents
 	DefaultWorkerSystemComponentsAllow = true
 )
@@ -1767,6 +2114,8 @@ const (
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
 	ShootEventK8sVersionMaintenance = "KubernetesVersionMaintenance"
+	// ShootEventMaintenanceRolloutDeferred indicates that an automatic update has been deferred by the rollout policy.
+	ShootEventMaintenanceRolloutDeferred = "MaintenanceRolloutDeferred"
 	// ShootEventHibernationEnabled indicates that hibernation started.
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
@@ -1799,6 +2148,9 @@ const (
 	// ShootCRDsWithProblematicConversionWebhooks is a constant for a condition type indicating that the Shoot cluster has
 	// CRDs with conversion webhooks and multiple stored versions which can break the reconciliation flow of the cluster.
 	ShootCRDsWithProblematicConversionWebhooks ConditionType = "CRDsWithProblematicConversionWebhooks"
+	// ShootNoRemovedAPIsInUse is a constant for a condition type indicating whether the Shoot cluster does not use any
+	// deprecated APIs which are removed in the next minor Kubernetes version.
+	ShootNoRemovedAPIsInUse ConditionType = "NoRemovedAPIsInUse"
 )
 
 // ShootPurpose is a type alias for string.
@@ -13,11 +13,11 @@ import (
 	unsafe "unsafe"
 
 	core "github.com/gardener/gardener/pkg/apis/core"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
@@ -91,6 +91,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AlertingCustomRules)(nil), (*core.AlertingCustomRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertingCustomRules_To_core_AlertingCustomRules(a.(*AlertingCustomRules), b.(*core.AlertingCustomRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertingCustomRules)(nil), (*AlertingCustomRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertingCustomRules_To_v1beta1_AlertingCustomRules(a.(*core.AlertingCustomRules), b.(*AlertingCustomRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertingWebhookReceiver)(nil), (*core.AlertingWebhookReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertingWebhookReceiver_To_core_AlertingWebhookReceiver(a.(*AlertingWebhookReceiver), b.(*core.AlertingWebhookReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertingWebhookReceiver)(nil), (*AlertingWebhookReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertingWebhookReceiver_To_v1beta1_AlertingWebhookReceiver(a.(*core.AlertingWebhookReceiver), b.(*AlertingWebhookReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlternativeMachineType)(nil), (*core.AlternativeMachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlternativeMachineType_To_core_AlternativeMachineType(a.(*AlternativeMachineType), b.(*core.AlternativeMachineType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlternativeMachineType)(nil), (*AlternativeMachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlternativeMachineType_To_v1beta1_AlternativeMachineType(a.(*core.AlternativeMachineType), b.(*AlternativeMachineType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditBackends)(nil), (*core.AuditBackends)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditBackends_To_core_AuditBackends(a.(*AuditBackends), b.(*core.AuditBackends), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditBackends)(nil), (*AuditBackends)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditBackends_To_v1beta1_AuditBackends(a.(*core.AuditBackends), b.(*AuditBackends), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditBatch)(nil), (*core.AuditBatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditBatch_To_core_AuditBatch(a.(*AuditBatch), b.(*core.AuditBatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditBatch)(nil), (*AuditBatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditBatch_To_v1beta1_AuditBatch(a.(*core.AuditBatch), b.(*AuditBatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditConfig)(nil), (*core.AuditConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuditConfig_To_core_AuditConfig(a.(*AuditConfig), b.(*core.AuditConfig), scope)
 	}); err != nil {
@@ -101,6 +151,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditLogBackend)(nil), (*core.AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(a.(*AuditLogBackend), b.(*core.AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditLogBackend)(nil), (*AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(a.(*core.AuditLogBackend), b.(*AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditPolicy)(nil), (*core.AuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuditPolicy_To_core_AuditPolicy(a.(*AuditPolicy), b.(*core.AuditPolicy), scope)
 	}); err != nil {
@@ -111,6 +171,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditTruncate)(nil), (*core.AuditTruncate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditTruncate_To_core_AuditTruncate(a.(*AuditTruncate), b.(*core.AuditTruncate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditTruncate)(nil), (*AuditTruncate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditTruncate_To_v1beta1_AuditTruncate(a.(*core.AuditTruncate), b.(*AuditTruncate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditWebhookBackend)(nil), (*core.AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(a.(*AuditWebhookBackend), b.(*core.AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditWebhookBackend)(nil), (*AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(a.(*core.AuditWebhookBackend), b.(*AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AvailabilityZone)(nil), (*core.AvailabilityZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(a.(*AvailabilityZone), b.(*core.AvailabilityZone), scope)
 	}); err != nil {
@@ -151,6 +231,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketReplication)(nil), (*core.BackupBucketReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupBucketReplication_To_core_BackupBucketReplication(a.(*BackupBucketReplication), b.(*core.BackupBucketReplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupBucketReplication)(nil), (*BackupBucketReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupBucketReplication_To_v1beta1_BackupBucketReplication(a.(*core.BackupBucketReplication), b.(*BackupBucketReplication), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupBucketSpec)(nil), (*core.BackupBucketSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BackupBucketSpec_To_core_BackupBucketSpec(a.(*BackupBucketSpec), b.(*core.BackupBucketSpec), scope)
 	}); err != nil {
@@ -191,6 +281,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupEntryReplicationStatus)(nil), (*core.BackupEntryReplicationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupEntryReplicationStatus_To_core_BackupEntryReplicationStatus(a.(*BackupEntryReplicationStatus), b.(*core.BackupEntryReplicationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupEntryReplicationStatus)(nil), (*BackupEntryReplicationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupEntryReplicationStatus_To_v1beta1_BackupEntryReplicationStatus(a.(*core.BackupEntryReplicationStatus), b.(*BackupEntryReplicationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupEntrySpec)(nil), (*core.BackupEntrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(a.(*BackupEntrySpec), b.(*core.BackupEntrySpec), scope)
 	}); err != nil {
@@ -701,6 +801,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HugePages)(nil), (*core.HugePages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HugePages_To_core_HugePages(a.(*HugePages), b.(*core.HugePages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HugePages)(nil), (*HugePages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HugePages_To_v1beta1_HugePages(a.(*core.HugePages), b.(*HugePages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImagePrePull)(nil), (*core.ImagePrePull)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ImagePrePull_To_core_ImagePrePull(a.(*ImagePrePull), b.(*core.ImagePrePull), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImagePrePull)(nil), (*ImagePrePull)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImagePrePull_To_v1beta1_ImagePrePull(a.(*core.ImagePrePull), b.(*ImagePrePull), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Ingress)(nil), (*core.Ingress)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Ingress_To_core_Ingress(a.(*Ingress), b.(*core.Ingress), scope)
 	}); err != nil {
@@ -736,6 +856,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KernelModule)(nil), (*core.KernelModule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KernelModule_To_core_KernelModule(a.(*KernelModule), b.(*core.KernelModule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KernelModule)(nil), (*KernelModule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KernelModule_To_v1beta1_KernelModule(a.(*core.KernelModule), b.(*KernelModule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerConfig)(nil), (*core.KubeAPIServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(a.(*KubeAPIServerConfig), b.(*core.KubeAPIServerConfig), scope)
 	}); err != nil {
@@ -866,6 +996,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LastETCDRestore)(nil), (*core.LastETCDRestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LastETCDRestore_To_core_LastETCDRestore(a.(*LastETCDRestore), b.(*core.LastETCDRestore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LastETCDRestore)(nil), (*LastETCDRestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LastETCDRestore_To_v1beta1_LastETCDRestore(a.(*core.LastETCDRestore), b.(*LastETCDRestore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LastError)(nil), (*core.LastError)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_LastError_To_core_LastError(a.(*LastError), b.(*core.LastError), scope)
 	}); err != nil {
@@ -906,6 +1046,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Logging)(nil), (*core.Logging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Logging_To_core_Logging(a.(*Logging), b.(*core.Logging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Logging)(nil), (*Logging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Logging_To_v1beta1_Logging(a.(*core.Logging), b.(*Logging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoggingOutput)(nil), (*core.LoggingOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoggingOutput_To_core_LoggingOutput(a.(*LoggingOutput), b.(*core.LoggingOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LoggingOutput)(nil), (*LoggingOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LoggingOutput_To_v1beta1_LoggingOutput(a.(*core.LoggingOutput), b.(*LoggingOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoggingOutputBuffer)(nil), (*core.LoggingOutputBuffer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoggingOutputBuffer_To_core_LoggingOutputBuffer(a.(*LoggingOutputBuffer), b.(*core.LoggingOutputBuffer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LoggingOutputBuffer)(nil), (*LoggingOutputBuffer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LoggingOutputBuffer_To_v1beta1_LoggingOutputBuffer(a.(*core.LoggingOutputBuffer), b.(*LoggingOutputBuffer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoggingOutputRateLimit)(nil), (*core.LoggingOutputRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoggingOutputRateLimit_To_core_LoggingOutputRateLimit(a.(*LoggingOutputRateLimit), b.(*core.LoggingOutputRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LoggingOutputRateLimit)(nil), (*LoggingOutputRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LoggingOutputRateLimit_To_v1beta1_LoggingOutputRateLimit(a.(*core.LoggingOutputRateLimit), b.(*LoggingOutputRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*core.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Machine_To_core_Machine(a.(*Machine), b.(*core.Machine), scope)
 	}); err != nil {
@@ -1016,6 +1196,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringRemoteWrite)(nil), (*core.MonitoringRemoteWrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(a.(*MonitoringRemoteWrite), b.(*core.MonitoringRemoteWrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MonitoringRemoteWrite)(nil), (*MonitoringRemoteWrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(a.(*core.MonitoringRemoteWrite), b.(*MonitoringRemoteWrite), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedResourceReference)(nil), (*core.NamedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_NamedResourceReference_To_core_NamedResourceReference(a.(*NamedResourceReference), b.(*core.NamedResourceReference), scope)
 	}); err != nil {
@@ -1146,6 +1336,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PreDrainHook)(nil), (*core.PreDrainHook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PreDrainHook_To_core_PreDrainHook(a.(*PreDrainHook), b.(*core.PreDrainHook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PreDrainHook)(nil), (*PreDrainHook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PreDrainHook_To_v1beta1_PreDrainHook(a.(*core.PreDrainHook), b.(*PreDrainHook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -1796,6 +1996,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerDrainPolicy)(nil), (*core.WorkerDrainPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerDrainPolicy_To_core_WorkerDrainPolicy(a.(*WorkerDrainPolicy), b.(*core.WorkerDrainPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerDrainPolicy)(nil), (*WorkerDrainPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerDrainPolicy_To_v1beta1_WorkerDrainPolicy(a.(*core.WorkerDrainPolicy), b.(*WorkerDrainPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerKubernetes)(nil), (*core.WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(a.(*WorkerKubernetes), b.(*core.WorkerKubernetes), scope)
 	}); err != nil {
@@ -1988,6 +2198,8 @@ func Convert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *core.AdmissionP
 
 func autoConvert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
+	out.WebhookReceivers = *(*[]core.AlertingWebhookReceiver)(unsafe.Pointer(&in.WebhookReceivers))
+	out.CustomRules = (*core.AlertingCustomRules)(unsafe.Pointer(in.CustomRules))
 	return nil
 }
 
@@ -1998,6 +2210,8 @@ func Convert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting,
 
 func autoConvert_core_Alerting_To_v1beta1_Alerting(in *core.Alerting, out *Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
+	out.WebhookReceivers = *(*[]AlertingWebhookReceiver)(unsafe.Pointer(&in.WebhookReceivers))
+	out.CustomRules = (*AlertingCustomRules)(unsafe.Pointer(in.CustomRules))
 	return nil
 }
 
@@ -2006,8 +2220,121 @@ func Convert_core_Alerting_To_v1beta1_Alerting(in *core.Alerting, out *Alerting,
 	return autoConvert_core_Alerting_To_v1beta1_Alerting(in, out, s)
 }
 
+func autoConvert_v1beta1_AlertingCustomRules_To_core_AlertingCustomRules(in *AlertingCustomRules, out *core.AlertingCustomRules, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_v1beta1_AlertingCustomRules_To_core_AlertingCustomRules is an autogenerated conversion function.
+func Convert_v1beta1_AlertingCustomRules_To_core_AlertingCustomRules(in *AlertingCustomRules, out *core.AlertingCustomRules, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertingCustomRules_To_core_AlertingCustomRules(in, out, s)
+}
+
+func autoConvert_core_AlertingCustomRules_To_v1beta1_AlertingCustomRules(in *core.AlertingCustomRules, out *AlertingCustomRules, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_core_AlertingCustomRules_To_v1beta1_AlertingCustomRules is an autogenerated conversion function.
+func Convert_core_AlertingCustomRules_To_v1beta1_AlertingCustomRules(in *core.AlertingCustomRules, out *AlertingCustomRules, s conversion.Scope) error {
+	return autoConvert_core_AlertingCustomRules_To_v1beta1_AlertingCustomRules(in, out, s)
+}
+
+func autoConvert_v1beta1_AlertingWebhookReceiver_To_core_AlertingWebhookReceiver(in *AlertingWebhookReceiver, out *core.AlertingWebhookReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.SendResolved = (*bool)(unsafe.Pointer(in.SendResolved))
+	return nil
+}
+
+// Convert_v1beta1_AlertingWebhookReceiver_To_core_AlertingWebhookReceiver is an autogenerated conversion function.
+func Convert_v1beta1_AlertingWebhookReceiver_To_core_AlertingWebhookReceiver(in *AlertingWebhookReceiver, out *core.AlertingWebhookReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertingWebhookReceiver_To_core_AlertingWebhookReceiver(in, out, s)
+}
+
+func autoConvert_core_AlertingWebhookReceiver_To_v1beta1_AlertingWebhookReceiver(in *core.AlertingWebhookReceiver, out *AlertingWebhookReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.SendResolved = (*bool)(unsafe.Pointer(in.SendResolved))
+	return nil
+}
+
+// Convert_core_AlertingWebhookReceiver_To_v1beta1_AlertingWebhookReceiver is an autogenerated conversion function.
+func Convert_core_AlertingWebhookReceiver_To_v1beta1_AlertingWebhookReceiver(in *core.AlertingWebhookReceiver, out *AlertingWebhookReceiver, s conversion.Scope) error {
+	return autoConvert_core_AlertingWebhookReceiver_To_v1beta1_AlertingWebhookReceiver(in, out, s)
+}
+
+func autoConvert_v1beta1_AlternativeMachineType_To_core_AlternativeMachineType(in *AlternativeMachineType, out *core.AlternativeMachineType, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1beta1_AlternativeMachineType_To_core_AlternativeMachineType is an autogenerated conversion function.
+func Convert_v1beta1_AlternativeMachineType_To_core_AlternativeMachineType(in *AlternativeMachineType, out *core.AlternativeMachineType, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlternativeMachineType_To_core_AlternativeMachineType(in, out, s)
+}
+
+func autoConvert_core_AlternativeMachineType_To_v1beta1_AlternativeMachineType(in *core.AlternativeMachineType, out *AlternativeMachineType, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_core_AlternativeMachineType_To_v1beta1_AlternativeMachineType is an autogenerated conversion function.
+func Convert_core_AlternativeMachineType_To_v1beta1_AlternativeMachineType(in *core.AlternativeMachineType, out *AlternativeMachineType, s conversion.Scope) error {
+	return autoConvert_core_AlternativeMachineType_To_v1beta1_AlternativeMachineType(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditBackends_To_core_AuditBackends(in *AuditBackends, out *core.AuditBackends, s conversion.Scope) error {
+	out.Log = (*core.AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*core.AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1beta1_AuditBackends_To_core_AuditBackends is an autogenerated conversion function.
+func Convert_v1beta1_AuditBackends_To_core_AuditBackends(in *AuditBackends, out *core.AuditBackends, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditBackends_To_core_AuditBackends(in, out, s)
+}
+
+func autoConvert_core_AuditBackends_To_v1beta1_AuditBackends(in *core.AuditBackends, out *AuditBackends, s conversion.Scope) error {
+	out.Log = (*AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_core_AuditBackends_To_v1beta1_AuditBackends is an autogenerated conversion function.
+func Convert_core_AuditBackends_To_v1beta1_AuditBackends(in *core.AuditBackends, out *AuditBackends, s conversion.Scope) error {
+	return autoConvert_core_AuditBackends_To_v1beta1_AuditBackends(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditBatch_To_core_AuditBatch(in *AuditBatch, out *core.AuditBatch, s conversion.Scope) error {
+	out.MaxSize = (*int32)(unsafe.Pointer(in.MaxSize))
+	out.MaxWait = (*v1.Duration)(unsafe.Pointer(in.MaxWait))
+	return nil
+}
+
+// Convert_v1beta1_AuditBatch_To_core_AuditBatch is an autogenerated conversion function.
+func Convert_v1beta1_AuditBatch_To_core_AuditBatch(in *AuditBatch, out *core.AuditBatch, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditBatch_To_core_AuditBatch(in, out, s)
+}
+
+func autoConvert_core_AuditBatch_To_v1beta1_AuditBatch(in *core.AuditBatch, out *AuditBatch, s conversion.Scope) error {
+	out.MaxSize = (*int32)(unsafe.Pointer(in.MaxSize))
+	out.MaxWait = (*v1.Duration)(unsafe.Pointer(in.MaxWait))
+	return nil
+}
+
+// Convert_core_AuditBatch_To_v1beta1_AuditBatch is an autogenerated conversion function.
+func Convert_core_AuditBatch_To_v1beta1_AuditBatch(in *core.AuditBatch, out *AuditBatch, s conversion.Scope) error {
+	return autoConvert_core_AuditBatch_To_v1beta1_AuditBatch(in, out, s)
+}
+
 func autoConvert_v1beta1_AuditConfig_To_core_AuditConfig(in *AuditConfig, out *core.AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*core.AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Backends = (*core.AuditBackends)(unsafe.Pointer(in.Backends))
 	return nil
 }
 
@@ -2018,6 +2345,7 @@ func Convert_v1beta1_AuditConfig_To_core_AuditConfig(in *AuditConfig, out *core.
 
 func autoConvert_core_AuditConfig_To_v1beta1_AuditConfig(in *core.AuditConfig, out *AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Backends = (*AuditBackends)(unsafe.Pointer(in.Backends))
 	return nil
 }
 
@@ -2026,8 +2354,28 @@ func Convert_core_AuditConfig_To_v1beta1_AuditConfig(in *core.AuditConfig, out *
 	return autoConvert_core_AuditConfig_To_v1beta1_AuditConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(in *AuditLogBackend, out *core.AuditLogBackend, s conversion.Scope) error {
+	out.Policy = (*core.AuditPolicy)(unsafe.Pointer(in.Policy))
+	return nil
+}
+
+// Convert_v1beta1_AuditLogBackend_To_core_AuditLogBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(in *AuditLogBackend, out *core.AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(in *core.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	out.Policy = (*AuditPolicy)(unsafe.Pointer(in.Policy))
+	return nil
+}
+
+// Convert_core_AuditLogBackend_To_v1beta1_AuditLogBackend is an autogenerated conversion function.
+func Convert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(in *core.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(in, out, s)
+}
+
 func autoConvert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -2037,7 +2385,7 @@ func Convert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.
 }
 
 func autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -2046,6 +2394,58 @@ func Convert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *
 	return autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditTruncate_To_core_AuditTruncate(in *AuditTruncate, out *core.AuditTruncate, s conversion.Scope) error {
+	out.MaxBatchSize = (*resource.Quantity)(unsafe.Pointer(in.MaxBatchSize))
+	out.MaxEventSize = (*resource.Quantity)(unsafe.Pointer(in.MaxEventSize))
+	return nil
+}
+
+// Convert_v1beta1_AuditTruncate_To_core_AuditTruncate is an autogenerated conversion function.
+func Convert_v1beta1_AuditTruncate_To_core_AuditTruncate(in *AuditTruncate, out *core.AuditTruncate, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditTruncate_To_core_AuditTruncate(in, out, s)
+}
+
+func autoConvert_core_AuditTruncate_To_v1beta1_AuditTruncate(in *core.AuditTruncate, out *AuditTruncate, s conversion.Scope) error {
+	out.MaxBatchSize = (*resource.Quantity)(unsafe.Pointer(in.MaxBatchSize))
+	out.MaxEventSize = (*resource.Quantity)(unsafe.Pointer(in.MaxEventSize))
+	return nil
+}
+
+// Convert_core_AuditTruncate_To_v1beta1_AuditTruncate is an autogenerated conversion function.
+func Convert_core_AuditTruncate_To_v1beta1_AuditTruncate(in *core.AuditTruncate, out *AuditTruncate, s conversion.Scope) error {
+	return autoConvert_core_AuditTruncate_To_v1beta1_AuditTruncate(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(in *AuditWebhookBackend, out *core.AuditWebhookBackend, s conversion.Scope) error {
+	out.URL = in.URL
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Policy = (*core.AuditPolicy)(unsafe.Pointer(in.Policy))
+	out.Batch = (*core.AuditBatch)(unsafe.Pointer(in.Batch))
+	out.Truncate = (*core.AuditTruncate)(unsafe.Pointer(in.Truncate))
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	return nil
+}
+
+// Convert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(in *AuditWebhookBackend, out *core.AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *core.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	out.URL = in.URL
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Policy = (*AuditPolicy)(unsafe.Pointer(in.Policy))
+	out.Batch = (*AuditBatch)(unsafe.Pointer(in.Batch))
+	out.Truncate = (*AuditTruncate)(unsafe.Pointer(in.Truncate))
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	return nil
+}
+
+// Convert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *core.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in, out, s)
+}
+
 func autoConvert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(in *AvailabilityZone, out *core.AvailabilityZone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.UnavailableMachineTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableMachineTypes))
@@ -2146,6 +2546,38 @@ func Convert_core_BackupBucketProvider_To_v1beta1_BackupBucketProvider(in *core.
 	return autoConvert_core_BackupBucketProvider_To_v1beta1_BackupBucketProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_BackupBucketReplication_To_core_BackupBucketReplication(in *BackupBucketReplication, out *core.BackupBucketReplication, s conversion.Scope) error {
+	if err := Convert_v1beta1_BackupBucketProvider_To_core_BackupBucketProvider(&in.Provider, &out.Provider, s); err != nil {
+		return err
+	}
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	out.SecretRef = in.SecretRef
+	out.MaxBackupAge = (*int32)(unsafe.Pointer(in.MaxBackupAge))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_v1beta1_BackupBucketReplication_To_core_BackupBucketReplication is an autogenerated conversion function.
+func Convert_v1beta1_BackupBucketReplication_To_core_BackupBucketReplication(in *BackupBucketReplication, out *core.BackupBucketReplication, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupBucketReplication_To_core_BackupBucketReplication(in, out, s)
+}
+
+func autoConvert_core_BackupBucketReplication_To_v1beta1_BackupBucketReplication(in *core.BackupBucketReplication, out *BackupBucketReplication, s conversion.Scope) error {
+	if err := Convert_core_BackupBucketProvider_To_v1beta1_BackupBucketProvider(&in.Provider, &out.Provider, s); err != nil {
+		return err
+	}
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	out.SecretRef = in.SecretRef
+	out.MaxBackupAge = (*int32)(unsafe.Pointer(in.MaxBackupAge))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_core_BackupBucketReplication_To_v1beta1_BackupBucketReplication is an autogenerated conversion function.
+func Convert_core_BackupBucketReplication_To_v1beta1_BackupBucketReplication(in *core.BackupBucketReplication, out *BackupBucketReplication, s conversion.Scope) error {
+	return autoConvert_core_BackupBucketReplication_To_v1beta1_BackupBucketReplication(in, out, s)
+}
+
 func autoConvert_v1beta1_BackupBucketSpec_To_core_BackupBucketSpec(in *BackupBucketSpec, out *core.BackupBucketSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_BackupBucketProvider_To_core_BackupBucketProvider(&in.Provider, &out.Provider, s); err != nil {
 		return err
@@ -2153,6 +2585,7 @@ func autoConvert_v1beta1_BackupBucketSpec_To_core_BackupBucketSpec(in *BackupBuc
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SecretRef = in.SecretRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.Replication = (*core.BackupBucketReplication)(unsafe.Pointer(in.Replication))
 	return nil
 }
 
@@ -2168,6 +2601,7 @@ func autoConvert_core_BackupBucketSpec_To_v1beta1_BackupBucketSpec(in *core.Back
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SecretRef = in.SecretRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.Replication = (*BackupBucketReplication)(unsafe.Pointer(in.Replication))
 	return nil
 }
 
@@ -2181,7 +2615,7 @@ func autoConvert_v1beta1_BackupBucketStatus_To_core_BackupBucketStatus(in *Backu
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.GeneratedSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
+	out.GeneratedSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
 	return nil
 }
 
@@ -2195,7 +2629,7 @@ func autoConvert_core_BackupBucketStatus_To_v1beta1_BackupBucketStatus(in *core.
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.GeneratedSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
+	out.GeneratedSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
 	return nil
 }
 
@@ -2258,6 +2692,30 @@ func Convert_core_BackupEntryList_To_v1beta1_BackupEntryList(in *core.BackupEntr
 	return autoConvert_core_BackupEntryList_To_v1beta1_BackupEntryList(in, out, s)
 }
 
+func autoConvert_v1beta1_BackupEntryReplicationStatus_To_core_BackupEntryReplicationStatus(in *BackupEntryReplicationStatus, out *core.BackupEntryReplicationStatus, s conversion.Scope) error {
+	out.LastReplicationTime = (*v1.Time)(unsafe.Pointer(in.LastReplicationTime))
+	out.Lag = (*v1.Duration)(unsafe.Pointer(in.Lag))
+	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1beta1_BackupEntryReplicationStatus_To_core_BackupEntryReplicationStatus is an autogenerated conversion function.
+func Convert_v1beta1_BackupEntryReplicationStatus_To_core_BackupEntryReplicationStatus(in *BackupEntryReplicationStatus, out *core.BackupEntryReplicationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupEntryReplicationStatus_To_core_BackupEntryReplicationStatus(in, out, s)
+}
+
+func autoConvert_core_BackupEntryReplicationStatus_To_v1beta1_BackupEntryReplicationStatus(in *core.BackupEntryReplicationStatus, out *BackupEntryReplicationStatus, s conversion.Scope) error {
+	out.LastReplicationTime = (*v1.Time)(unsafe.Pointer(in.LastReplicationTime))
+	out.Lag = (*v1.Duration)(unsafe.Pointer(in.Lag))
+	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_core_BackupEntryReplicationStatus_To_v1beta1_BackupEntryReplicationStatus is an autogenerated conversion function.
+func Convert_core_BackupEntryReplicationStatus_To_v1beta1_BackupEntryReplicationStatus(in *core.BackupEntryReplicationStatus, out *BackupEntryReplicationStatus, s conversion.Scope) error {
+	return autoConvert_core_BackupEntryReplicationStatus_To_v1beta1_BackupEntryReplicationStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec, out *core.BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
@@ -2285,7 +2743,8 @@ func autoConvert_v1beta1_BackupEntryStatus_To_core_BackupEntryStatus(in *BackupE
 	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.Replication = (*core.BackupEntryReplicationStatus)(unsafe.Pointer(in.Replication))
 	return nil
 }
 
@@ -2299,7 +2758,8 @@ func autoConvert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in *core.Ba
 	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.Replication = (*BackupEntryReplicationStatus)(unsafe.Pointer(in.Replication))
 	return nil
 }
 
@@ -2374,10 +2834,10 @@ func Convert_core_BastionMachineType_To_v1beta1_BastionMachineType(in *core.Bast
 
 func autoConvert_v1beta1_CARotation_To_core_CARotation(in *CARotation, out *core.CARotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	return nil
 }
 
@@ -2388,10 +2848,10 @@ func Convert_v1beta1_CARotation_To_core_CARotation(in *CARotation, out *core.CAR
 
 func autoConvert_core_CARotation_To_v1beta1_CARotation(in *core.CARotation, out *CARotation, s conversion.Scope) error {
 	out.Phase = CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	return nil
 }
 
@@ -2403,6 +2863,7 @@ func Convert_core_CARotation_To_v1beta1_CARotation(in *core.CARotation, out *CAR
 func autoConvert_v1beta1_CRI_To_core_CRI(in *CRI, out *core.CRI, s conversion.Scope) error {
 	out.Name = core.CRIName(in.Name)
 	out.ContainerRuntimes = *(*[]core.ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	out.ImagePrePull = (*core.ImagePrePull)(unsafe.Pointer(in.ImagePrePull))
 	return nil
 }
 
@@ -2414,6 +2875,7 @@ func Convert_v1beta1_CRI_To_core_CRI(in *CRI, out *core.CRI, s conversion.Scope)
 func autoConvert_core_CRI_To_v1beta1_CRI(in *core.CRI, out *CRI, s conversion.Scope) error {
 	out.Name = CRIName(in.Name)
 	out.ContainerRuntimes = *(*[]ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	out.ImagePrePull = (*ImagePrePull)(unsafe.Pointer(in.ImagePrePull))
 	return nil
 }
 
@@ -2535,17 +2997,17 @@ func Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.CloudPro
 }
 
 func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *ClusterAutoscaler, out *core.ClusterAutoscaler, s conversion.Scope) error {
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	out.Expander = (*core.ExpanderMode)(unsafe.Pointer(in.Expander))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
 	out.MaxGracefulTerminationSeconds = (*int32)(unsafe.Pointer(in.MaxGracefulTerminationSeconds))
 	out.IgnoreTaints = *(*[]string)(unsafe.Pointer(&in.IgnoreTaints))
-	out.NewPodScaleUpDelay = (*metav1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
+	out.NewPodScaleUpDelay = (*v1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
 	out.IgnoreDaemonsetsUtilization = (*bool)(unsafe.Pointer(in.IgnoreDaemonsetsUtilization))
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
@@ -2558,17 +3020,17 @@ func Convert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *ClusterAuto
 }
 
 func autoConvert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *core.ClusterAutoscaler, out *ClusterAutoscaler, s conversion.Scope) error {
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	out.Expander = (*ExpanderMode)(unsafe.Pointer(in.Expander))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
 	out.MaxGracefulTerminationSeconds = (*int32)(unsafe.Pointer(in.MaxGracefulTerminationSeconds))
 	out.IgnoreTaints = *(*[]string)(unsafe.Pointer(&in.IgnoreTaints))
-	out.NewPodScaleUpDelay = (*metav1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
+	out.NewPodScaleUpDelay = (*v1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
 	out.IgnoreDaemonsetsUtilization = (*bool)(unsafe.Pointer(in.IgnoreDaemonsetsUtilization))
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
@@ -2583,9 +3045,9 @@ func Convert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *core.Cluste
 func autoConvert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(in *ClusterAutoscalerOptions, out *core.ClusterAutoscalerOptions, s conversion.Scope) error {
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
 	out.ScaleDownGpuUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownGpuUtilizationThreshold))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
-	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownUnreadyTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
 	return nil
 }
 
@@ -2597,9 +3059,9 @@ func Convert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(i
 func autoConvert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(in *core.ClusterAutoscalerOptions, out *ClusterAutoscalerOptions, s conversion.Scope) error {
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
 	out.ScaleDownGpuUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownGpuUtilizationThreshold))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
-	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownUnreadyTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
 	return nil
 }
 
@@ -2800,7 +3262,7 @@ func Convert_core_ControllerInstallationList_To_v1beta1_ControllerInstallationLi
 func autoConvert_v1beta1_ControllerInstallationSpec_To_core_ControllerInstallationSpec(in *ControllerInstallationSpec, out *core.ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
-	out.DeploymentRef = (*v1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
+	out.DeploymentRef = (*corev1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
 	return nil
 }
 
@@ -2812,7 +3274,7 @@ func Convert_v1beta1_ControllerInstallationSpec_To_core_ControllerInstallationSp
 func autoConvert_core_ControllerInstallationSpec_To_v1beta1_ControllerInstallationSpec(in *core.ControllerInstallationSpec, out *ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
-	out.DeploymentRef = (*v1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
+	out.DeploymentRef = (*corev1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
 	return nil
 }
 
@@ -2871,7 +3333,7 @@ func Convert_core_ControllerRegistration_To_v1beta1_ControllerRegistration(in *c
 
 func autoConvert_v1beta1_ControllerRegistrationDeployment_To_core_ControllerRegistrationDeployment(in *ControllerRegistrationDeployment, out *core.ControllerRegistrationDeployment, s conversion.Scope) error {
 	out.Policy = (*core.ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
-	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]core.DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
 	return nil
 }
@@ -2883,7 +3345,7 @@ func Convert_v1beta1_ControllerRegistrationDeployment_To_core_ControllerRegistra
 
 func autoConvert_core_ControllerRegistrationDeployment_To_v1beta1_ControllerRegistrationDeployment(in *core.ControllerRegistrationDeployment, out *ControllerRegistrationDeployment, s conversion.Scope) error {
 	out.Policy = (*ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
-	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
 	return nil
 }
@@ -2941,7 +3403,7 @@ func autoConvert_v1beta1_ControllerResource_To_core_ControllerResource(in *Contr
 	out.Kind = in.Kind
 	out.Type = in.Type
 	out.GloballyEnabled = (*bool)(unsafe.Pointer(in.GloballyEnabled))
-	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.ReconcileTimeout = (*v1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
 	out.Primary = (*bool)(unsafe.Pointer(in.Primary))
 	out.Lifecycle = (*core.ControllerResourceLifecycle)(unsafe.Pointer(in.Lifecycle))
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
@@ -2957,7 +3419,7 @@ func autoConvert_core_ControllerResource_To_v1beta1_ControllerResource(in *core.
 	out.Kind = in.Kind
 	out.Type = in.Type
 	out.GloballyEnabled = (*bool)(unsafe.Pointer(in.GloballyEnabled))
-	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.ReconcileTimeout = (*v1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
 	out.Primary = (*bool)(unsafe.Pointer(in.Primary))
 	out.Lifecycle = (*ControllerResourceLifecycle)(unsafe.Pointer(in.Lifecycle))
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
@@ -3199,10 +3661,10 @@ func Convert_core_DualApprovalForDeletion_To_v1beta1_DualApprovalForDeletion(in
 
 func autoConvert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *core.ETCDEncryptionKeyRotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	return nil
 }
 
@@ -3213,10 +3675,10 @@ func Convert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation
 
 func autoConvert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(in *core.ETCDEncryptionKeyRotation, out *ETCDEncryptionKeyRotation, s conversion.Scope) error {
 	out.Phase = CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	return nil
 }
 
@@ -3247,7 +3709,7 @@ func Convert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.Encrypti
 
 func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVersion, out *core.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	out.Classification = (*core.VersionClassification)(unsafe.Pointer(in.Classification))
 	return nil
 }
@@ -3259,7 +3721,7 @@ func Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVers
 
 func autoConvert_core_ExpirableVersion_To_v1beta1_ExpirableVersion(in *core.ExpirableVersion, out *ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	out.Classification = (*VersionClassification)(unsafe.Pointer(in.Classification))
 	return nil
 }
@@ -3544,10 +4006,10 @@ func Convert_core_HighAvailability_To_v1beta1_HighAvailability(in *core.HighAvai
 }
 
 func autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_core_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *core.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
-	out.CPUInitializationPeriod = (*metav1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
-	out.DownscaleStabilization = (*metav1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
-	out.InitialReadinessDelay = (*metav1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
-	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CPUInitializationPeriod = (*v1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
+	out.DownscaleStabilization = (*v1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
+	out.InitialReadinessDelay = (*v1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.Tolerance = (*float64)(unsafe.Pointer(in.Tolerance))
 	return nil
 }
@@ -3558,10 +4020,10 @@ func Convert_v1beta1_HorizontalPodAutoscalerConfig_To_core_HorizontalPodAutoscal
 }
 
 func autoConvert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in *core.HorizontalPodAutoscalerConfig, out *HorizontalPodAutoscalerConfig, s conversion.Scope) error {
-	out.CPUInitializationPeriod = (*metav1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
-	out.DownscaleStabilization = (*metav1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
-	out.InitialReadinessDelay = (*metav1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
-	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CPUInitializationPeriod = (*v1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
+	out.DownscaleStabilization = (*v1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
+	out.InitialReadinessDelay = (*v1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.Tolerance = (*float64)(unsafe.Pointer(in.Tolerance))
 	return nil
 }
@@ -3571,6 +4033,50 @@ func Convert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscal
 	return autoConvert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_HugePages_To_core_HugePages(in *HugePages, out *core.HugePages, s conversion.Scope) error {
+	out.PageSize = in.PageSize
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1beta1_HugePages_To_core_HugePages is an autogenerated conversion function.
+func Convert_v1beta1_HugePages_To_core_HugePages(in *HugePages, out *core.HugePages, s conversion.Scope) error {
+	return autoConvert_v1beta1_HugePages_To_core_HugePages(in, out, s)
+}
+
+func autoConvert_core_HugePages_To_v1beta1_HugePages(in *core.HugePages, out *HugePages, s conversion.Scope) error {
+	out.PageSize = in.PageSize
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_core_HugePages_To_v1beta1_HugePages is an autogenerated conversion function.
+func Convert_core_HugePages_To_v1beta1_HugePages(in *core.HugePages, out *HugePages, s conversion.Scope) error {
+	return autoConvert_core_HugePages_To_v1beta1_HugePages(in, out, s)
+}
+
+func autoConvert_v1beta1_ImagePrePull_To_core_ImagePrePull(in *ImagePrePull, out *core.ImagePrePull, s conversion.Scope) error {
+	out.SystemComponents = (*bool)(unsafe.Pointer(in.SystemComponents))
+	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
+	return nil
+}
+
+// Convert_v1beta1_ImagePrePull_To_core_ImagePrePull is an autogenerated conversion function.
+func Convert_v1beta1_ImagePrePull_To_core_ImagePrePull(in *ImagePrePull, out *core.ImagePrePull, s conversion.Scope) error {
+	return autoConvert_v1beta1_ImagePrePull_To_core_ImagePrePull(in, out, s)
+}
+
+func autoConvert_core_ImagePrePull_To_v1beta1_ImagePrePull(in *core.ImagePrePull, out *ImagePrePull, s conversion.Scope) error {
+	out.SystemComponents = (*bool)(unsafe.Pointer(in.SystemComponents))
+	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
+	return nil
+}
+
+// Convert_core_ImagePrePull_To_v1beta1_ImagePrePull is an autogenerated conversion function.
+func Convert_core_ImagePrePull_To_v1beta1_ImagePrePull(in *core.ImagePrePull, out *ImagePrePull, s conversion.Scope) error {
+	return autoConvert_core_ImagePrePull_To_v1beta1_ImagePrePull(in, out, s)
+}
+
 func autoConvert_v1beta1_Ingress_To_core_Ingress(in *Ingress, out *core.Ingress, s conversion.Scope) error {
 	out.Domain = in.Domain
 	if err := Convert_v1beta1_IngressController_To_core_IngressController(&in.Controller, &out.Controller, s); err != nil {
@@ -3624,7 +4130,7 @@ func autoConvert_v1beta1_InternalSecret_To_core_InternalSecret(in *InternalSecre
 	out.Immutable = (*bool)(unsafe.Pointer(in.Immutable))
 	out.Data = *(*map[string][]byte)(unsafe.Pointer(&in.Data))
 	// INFO: in.StringData opted out of conversion generation
-	out.Type = v1.SecretType(in.Type)
+	out.Type = corev1.SecretType(in.Type)
 	return nil
 }
 
@@ -3632,7 +4138,7 @@ func autoConvert_core_InternalSecret_To_v1beta1_InternalSecret(in *core.Internal
 	out.ObjectMeta = in.ObjectMeta
 	out.Immutable = (*bool)(unsafe.Pointer(in.Immutable))
 	out.Data = *(*map[string][]byte)(unsafe.Pointer(&in.Data))
-	out.Type = v1.SecretType(in.Type)
+	out.Type = corev1.SecretType(in.Type)
 	return nil
 }
 
@@ -3683,6 +4189,28 @@ func Convert_core_InternalSecretList_To_v1beta1_InternalSecretList(in *core.Inte
 	return autoConvert_core_InternalSecretList_To_v1beta1_InternalSecretList(in, out, s)
 }
 
+func autoConvert_v1beta1_KernelModule_To_core_KernelModule(in *KernelModule, out *core.KernelModule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1beta1_KernelModule_To_core_KernelModule is an autogenerated conversion function.
+func Convert_v1beta1_KernelModule_To_core_KernelModule(in *KernelModule, out *core.KernelModule, s conversion.Scope) error {
+	return autoConvert_v1beta1_KernelModule_To_core_KernelModule(in, out, s)
+}
+
+func autoConvert_core_KernelModule_To_v1beta1_KernelModule(in *core.KernelModule, out *KernelModule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_core_KernelModule_To_v1beta1_KernelModule is an autogenerated conversion function.
+func Convert_core_KernelModule_To_v1beta1_KernelModule(in *core.KernelModule, out *KernelModule, s conversion.Scope) error {
+	return autoConvert_core_KernelModule_To_v1beta1_KernelModule(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *KubeAPIServerConfig, out *core.KubeAPIServerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -3706,7 +4234,7 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.WatchCacheSizes = (*core.WatchCacheSizes)(unsafe.Pointer(in.WatchCacheSizes))
 	out.Requests = (*core.APIServerRequests)(unsafe.Pointer(in.Requests))
 	out.EnableAnonymousAuthentication = (*bool)(unsafe.Pointer(in.EnableAnonymousAuthentication))
-	out.EventTTL = (*metav1.Duration)(unsafe.Pointer(in.EventTTL))
+	out.EventTTL = (*v1.Duration)(unsafe.Pointer(in.EventTTL))
 	out.Logging = (*core.APIServerLogging)(unsafe.Pointer(in.Logging))
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
@@ -3744,7 +4272,7 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.WatchCacheSizes = (*WatchCacheSizes)(unsafe.Pointer(in.WatchCacheSizes))
 	out.Requests = (*APIServerRequests)(unsafe.Pointer(in.Requests))
 	out.EnableAnonymousAuthentication = (*bool)(unsafe.Pointer(in.EnableAnonymousAuthentication))
-	out.EventTTL = (*metav1.Duration)(unsafe.Pointer(in.EventTTL))
+	out.EventTTL = (*v1.Duration)(unsafe.Pointer(in.EventTTL))
 	out.Logging = (*APIServerLogging)(unsafe.Pointer(in.Logging))
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
@@ -3763,8 +4291,8 @@ func autoConvert_v1beta1_KubeControllerManagerConfig_To_core_KubeControllerManag
 	}
 	out.HorizontalPodAutoscalerConfig = (*core.HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
-	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
-	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
 	return nil
 }
 
@@ -3779,8 +4307,8 @@ func autoConvert_core_KubeControllerManagerConfig_To_v1beta1_KubeControllerManag
 	}
 	out.HorizontalPodAutoscalerConfig = (*HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
-	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
-	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
 	return nil
 }
 
@@ -3854,7 +4382,7 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.EvictionHard = (*core.KubeletConfigEviction)(unsafe.Pointer(in.EvictionHard))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	out.EvictionMinimumReclaim = (*core.KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionSoft = (*core.KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*core.KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
@@ -3871,7 +4399,7 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.ContainerLogMaxSize = (*resource.Quantity)(unsafe.Pointer(in.ContainerLogMaxSize))
 	out.ContainerLogMaxFiles = (*int32)(unsafe.Pointer(in.ContainerLogMaxFiles))
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
-	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
+	out.StreamingConnectionIdleTimeout = (*v1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*core.MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
 	return nil
 }
@@ -3892,7 +4420,7 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.EvictionHard = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionHard))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	out.EvictionMinimumReclaim = (*KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionSoft = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
@@ -3907,7 +4435,7 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.RegistryBurst = (*int32)(unsafe.Pointer(in.RegistryBurst))
 	out.SeccompDefault = (*bool)(unsafe.Pointer(in.SeccompDefault))
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
-	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
+	out.StreamingConnectionIdleTimeout = (*v1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
 	return nil
 }
@@ -3974,11 +4502,11 @@ func Convert_core_KubeletConfigEvictionMinimumReclaim_To_v1beta1_KubeletConfigEv
 }
 
 func autoConvert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_core_KubeletConfigEvictionSoftGracePeriod(in *KubeletConfigEvictionSoftGracePeriod, out *core.KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -3988,11 +4516,11 @@ func Convert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_core_KubeletConfigE
 }
 
 func autoConvert_core_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod(in *core.KubeletConfigEvictionSoftGracePeriod, out *KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -4161,11 +4689,37 @@ func Convert_core_KubernetesSettings_To_v1beta1_KubernetesSettings(in *core.Kube
 	return autoConvert_core_KubernetesSettings_To_v1beta1_KubernetesSettings(in, out, s)
 }
 
+func autoConvert_v1beta1_LastETCDRestore_To_core_LastETCDRestore(in *LastETCDRestore, out *core.LastETCDRestore, s conversion.Scope) error {
+	out.Description = in.Description
+	out.TriggeredTime = in.TriggeredTime
+	out.State = core.LastOperationState(in.State)
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_LastETCDRestore_To_core_LastETCDRestore is an autogenerated conversion function.
+func Convert_v1beta1_LastETCDRestore_To_core_LastETCDRestore(in *LastETCDRestore, out *core.LastETCDRestore, s conversion.Scope) error {
+	return autoConvert_v1beta1_LastETCDRestore_To_core_LastETCDRestore(in, out, s)
+}
+
+func autoConvert_core_LastETCDRestore_To_v1beta1_LastETCDRestore(in *core.LastETCDRestore, out *LastETCDRestore, s conversion.Scope) error {
+	out.Description = in.Description
+	out.TriggeredTime = in.TriggeredTime
+	out.State = LastOperationState(in.State)
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_core_LastETCDRestore_To_v1beta1_LastETCDRestore is an autogenerated conversion function.
+func Convert_core_LastETCDRestore_To_v1beta1_LastETCDRestore(in *core.LastETCDRestore, out *LastETCDRestore, s conversion.Scope) error {
+	return autoConvert_core_LastETCDRestore_To_v1beta1_LastETCDRestore(in, out, s)
+}
+
 func autoConvert_v1beta1_LastError_To_core_LastError(in *LastError, out *core.LastError, s conversion.Scope) error {
 	out.Description = in.Description
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
@@ -4178,7 +4732,7 @@ func autoConvert_core_LastError_To_v1beta1_LastError(in *core.LastError, out *La
 	out.Description = in.Description
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
@@ -4261,6 +4815,100 @@ func Convert_core_LoadBalancerServicesProxyProtocol_To_v1beta1_LoadBalancerServi
 	return autoConvert_core_LoadBalancerServicesProxyProtocol_To_v1beta1_LoadBalancerServicesProxyProtocol(in, out, s)
 }
 
+func autoConvert_v1beta1_Logging_To_core_Logging(in *Logging, out *core.Logging, s conversion.Scope) error {
+	out.Outputs = *(*[]core.LoggingOutput)(unsafe.Pointer(&in.Outputs))
+	return nil
+}
+
+// Convert_v1beta1_Logging_To_core_Logging is an autogenerated conversion function.
+func Convert_v1beta1_Logging_To_core_Logging(in *Logging, out *core.Logging, s conversion.Scope) error {
+	return autoConvert_v1beta1_Logging_To_core_Logging(in, out, s)
+}
+
+func autoConvert_core_Logging_To_v1beta1_Logging(in *core.Logging, out *Logging, s conversion.Scope) error {
+	out.Outputs = *(*[]LoggingOutput)(unsafe.Pointer(&in.Outputs))
+	return nil
+}
+
+// Convert_core_Logging_To_v1beta1_Logging is an autogenerated conversion function.
+func Convert_core_Logging_To_v1beta1_Logging(in *core.Logging, out *Logging, s conversion.Scope) error {
+	return autoConvert_core_Logging_To_v1beta1_Logging(in, out, s)
+}
+
+func autoConvert_v1beta1_LoggingOutput_To_core_LoggingOutput(in *LoggingOutput, out *core.LoggingOutput, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = core.LoggingOutputType(in.Type)
+	out.URL = in.URL
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Sources = *(*[]core.LoggingOutputSource)(unsafe.Pointer(&in.Sources))
+	out.RateLimit = (*core.LoggingOutputRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Buffer = (*core.LoggingOutputBuffer)(unsafe.Pointer(in.Buffer))
+	return nil
+}
+
+// Convert_v1beta1_LoggingOutput_To_core_LoggingOutput is an autogenerated conversion function.
+func Convert_v1beta1_LoggingOutput_To_core_LoggingOutput(in *LoggingOutput, out *core.LoggingOutput, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoggingOutput_To_core_LoggingOutput(in, out, s)
+}
+
+func autoConvert_core_LoggingOutput_To_v1beta1_LoggingOutput(in *core.LoggingOutput, out *LoggingOutput, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = LoggingOutputType(in.Type)
+	out.URL = in.URL
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Sources = *(*[]LoggingOutputSource)(unsafe.Pointer(&in.Sources))
+	out.RateLimit = (*LoggingOutputRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Buffer = (*LoggingOutputBuffer)(unsafe.Pointer(in.Buffer))
+	return nil
+}
+
+// Convert_core_LoggingOutput_To_v1beta1_LoggingOutput is an autogenerated conversion function.
+func Convert_core_LoggingOutput_To_v1beta1_LoggingOutput(in *core.LoggingOutput, out *LoggingOutput, s conversion.Scope) error {
+	return autoConvert_core_LoggingOutput_To_v1beta1_LoggingOutput(in, out, s)
+}
+
+func autoConvert_v1beta1_LoggingOutputBuffer_To_core_LoggingOutputBuffer(in *LoggingOutputBuffer, out *core.LoggingOutputBuffer, s conversion.Scope) error {
+	out.MemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.MemoryLimit))
+	out.RetryLimit = (*int32)(unsafe.Pointer(in.RetryLimit))
+	return nil
+}
+
+// Convert_v1beta1_LoggingOutputBuffer_To_core_LoggingOutputBuffer is an autogenerated conversion function.
+func Convert_v1beta1_LoggingOutputBuffer_To_core_LoggingOutputBuffer(in *LoggingOutputBuffer, out *core.LoggingOutputBuffer, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoggingOutputBuffer_To_core_LoggingOutputBuffer(in, out, s)
+}
+
+func autoConvert_core_LoggingOutputBuffer_To_v1beta1_LoggingOutputBuffer(in *core.LoggingOutputBuffer, out *LoggingOutputBuffer, s conversion.Scope) error {
+	out.MemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.MemoryLimit))
+	out.RetryLimit = (*int32)(unsafe.Pointer(in.RetryLimit))
+	return nil
+}
+
+// Convert_core_LoggingOutputBuffer_To_v1beta1_LoggingOutputBuffer is an autogenerated conversion function.
+func Convert_core_LoggingOutputBuffer_To_v1beta1_LoggingOutputBuffer(in *core.LoggingOutputBuffer, out *LoggingOutputBuffer, s conversion.Scope) error {
+	return autoConvert_core_LoggingOutputBuffer_To_v1beta1_LoggingOutputBuffer(in, out, s)
+}
+
+func autoConvert_v1beta1_LoggingOutputRateLimit_To_core_LoggingOutputRateLimit(in *LoggingOutputRateLimit, out *core.LoggingOutputRateLimit, s conversion.Scope) error {
+	out.RecordsPerSecond = in.RecordsPerSecond
+	return nil
+}
+
+// Convert_v1beta1_LoggingOutputRateLimit_To_core_LoggingOutputRateLimit is an autogenerated conversion function.
+func Convert_v1beta1_LoggingOutputRateLimit_To_core_LoggingOutputRateLimit(in *LoggingOutputRateLimit, out *core.LoggingOutputRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoggingOutputRateLimit_To_core_LoggingOutputRateLimit(in, out, s)
+}
+
+func autoConvert_core_LoggingOutputRateLimit_To_v1beta1_LoggingOutputRateLimit(in *core.LoggingOutputRateLimit, out *LoggingOutputRateLimit, s conversion.Scope) error {
+	out.RecordsPerSecond = in.RecordsPerSecond
+	return nil
+}
+
+// Convert_core_LoggingOutputRateLimit_To_v1beta1_LoggingOutputRateLimit is an autogenerated conversion function.
+func Convert_core_LoggingOutputRateLimit_To_v1beta1_LoggingOutputRateLimit(in *core.LoggingOutputRateLimit, out *LoggingOutputRateLimit, s conversion.Scope) error {
+	return autoConvert_core_LoggingOutputRateLimit_To_v1beta1_LoggingOutputRateLimit(in, out, s)
+}
+
 func autoConvert_v1beta1_Machine_To_core_Machine(in *Machine, out *core.Machine, s conversion.Scope) error {
 	out.Type = in.Type
 	if in.Image != nil {
@@ -4273,6 +4921,7 @@ func autoConvert_v1beta1_Machine_To_core_Machine(in *Machine, out *core.Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.AlternativeTypes = *(*[]core.AlternativeMachineType)(unsafe.Pointer(&in.AlternativeTypes))
 	return nil
 }
 
@@ -4293,6 +4942,7 @@ func autoConvert_core_Machine_To_v1beta1_Machine(in *core.Machine, out *Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.AlternativeTypes = *(*[]AlternativeMachineType)(unsafe.Pointer(&in.AlternativeTypes))
 	return nil
 }
 
@@ -4302,9 +4952,9 @@ func Convert_core_Machine_To_v1beta1_Machine(in *core.Machine, out *Machine, s c
 }
 
 func autoConvert_v1beta1_MachineControllerManagerSettings_To_core_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *core.MachineControllerManagerSettings, s conversion.Scope) error {
-	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
-	out.MachineHealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
-	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
 	return nil
@@ -4316,9 +4966,9 @@ func Convert_v1beta1_MachineControllerManagerSettings_To_core_MachineControllerM
 }
 
 func autoConvert_core_MachineControllerManagerSettings_To_v1beta1_MachineControllerManagerSettings(in *core.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
-	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
-	out.MachineHealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
-	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
 	return nil
@@ -4531,6 +5181,7 @@ func Convert_core_MemorySwapConfiguration_To_v1beta1_MemorySwapConfiguration(in
 
 func autoConvert_v1beta1_Monitoring_To_core_Monitoring(in *Monitoring, out *core.Monitoring, s conversion.Scope) error {
 	out.Alerting = (*core.Alerting)(unsafe.Pointer(in.Alerting))
+	out.RemoteWrite = *(*[]core.MonitoringRemoteWrite)(unsafe.Pointer(&in.RemoteWrite))
 	return nil
 }
 
@@ -4541,6 +5192,7 @@ func Convert_v1beta1_Monitoring_To_core_Monitoring(in *Monitoring, out *core.Mon
 
 func autoConvert_core_Monitoring_To_v1beta1_Monitoring(in *core.Monitoring, out *Monitoring, s conversion.Scope) error {
 	out.Alerting = (*Alerting)(unsafe.Pointer(in.Alerting))
+	out.RemoteWrite = *(*[]MonitoringRemoteWrite)(unsafe.Pointer(&in.RemoteWrite))
 	return nil
 }
 
@@ -4549,6 +5201,32 @@ func Convert_core_Monitoring_To_v1beta1_Monitoring(in *core.Monitoring, out *Mon
 	return autoConvert_core_Monitoring_To_v1beta1_Monitoring(in, out, s)
 }
 
+func autoConvert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(in *MonitoringRemoteWrite, out *core.MonitoringRemoteWrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Keep = *(*[]string)(unsafe.Pointer(&in.Keep))
+	return nil
+}
+
+// Convert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite is an autogenerated conversion function.
+func Convert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(in *MonitoringRemoteWrite, out *core.MonitoringRemoteWrite, s conversion.Scope) error {
+	return autoConvert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(in, out, s)
+}
+
+func autoConvert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(in *core.MonitoringRemoteWrite, out *MonitoringRemoteWrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Keep = *(*[]string)(unsafe.Pointer(&in.Keep))
+	return nil
+}
+
+// Convert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite is an autogenerated conversion function.
+func Convert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(in *core.MonitoringRemoteWrite, out *MonitoringRemoteWrite, s conversion.Scope) error {
+	return autoConvert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(in, out, s)
+}
+
 func autoConvert_v1beta1_NamedResourceReference_To_core_NamedResourceReference(in *NamedResourceReference, out *core.NamedResourceReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceRef = in.ResourceRef
@@ -4745,7 +5423,7 @@ func autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -4760,7 +5438,7 @@ func autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -4860,8 +5538,8 @@ func Convert_core_OIDCConfig_To_v1beta1_OIDCConfig(in *core.OIDCConfig, out *OID
 }
 
 func autoConvert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(in *ObservabilityRotation, out *core.ObservabilityRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -4871,8 +5549,8 @@ func Convert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(in *Obs
 }
 
 func autoConvert_core_ObservabilityRotation_To_v1beta1_ObservabilityRotation(in *core.ObservabilityRotation, out *ObservabilityRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -4903,6 +5581,34 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_PreDrainHook_To_core_PreDrainHook(in *PreDrainHook, out *core.PreDrainHook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.FailurePolicy = (*core.PreDrainHookFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_v1beta1_PreDrainHook_To_core_PreDrainHook is an autogenerated conversion function.
+func Convert_v1beta1_PreDrainHook_To_core_PreDrainHook(in *PreDrainHook, out *core.PreDrainHook, s conversion.Scope) error {
+	return autoConvert_v1beta1_PreDrainHook_To_core_PreDrainHook(in, out, s)
+}
+
+func autoConvert_core_PreDrainHook_To_v1beta1_PreDrainHook(in *core.PreDrainHook, out *PreDrainHook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.FailurePolicy = (*PreDrainHookFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_core_PreDrainHook_To_v1beta1_PreDrainHook is an autogenerated conversion function.
+func Convert_core_PreDrainHook_To_v1beta1_PreDrainHook(in *core.PreDrainHook, out *PreDrainHook, s conversion.Scope) error {
+	return autoConvert_core_PreDrainHook_To_v1beta1_PreDrainHook(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -5037,9 +5743,9 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus, out *core.ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = core.ProjectPhase(in.Phase)
-	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
-	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
-	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
+	out.LastActivityTimestamp = (*v1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
 	return nil
 }
 
@@ -5051,9 +5757,9 @@ func Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus, out
 func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = ProjectPhase(in.Phase)
-	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
-	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
-	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
+	out.LastActivityTimestamp = (*v1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
 	return nil
 }
 
@@ -5182,7 +5888,7 @@ func Convert_core_QuotaList_To_v1beta1_QuotaList(in *core.QuotaList, out *QuotaL
 
 func autoConvert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
 	return nil
 }
@@ -5194,7 +5900,7 @@ func Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaS
 
 func autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
 	return nil
 }
@@ -5297,7 +6003,7 @@ func Convert_core_SSHAccess_To_v1beta1_SSHAccess(in *core.SSHAccess, out *SSHAcc
 func autoConvert_v1beta1_SecretBinding_To_core_SecretBinding(in *SecretBinding, out *core.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	out.Provider = (*core.SecretBindingProvider)(unsafe.Pointer(in.Provider))
 	return nil
 }
@@ -5310,7 +6016,7 @@ func Convert_v1beta1_SecretBinding_To_core_SecretBinding(in *SecretBinding, out
 func autoConvert_core_SecretBinding_To_v1beta1_SecretBinding(in *core.SecretBinding, out *SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	out.Provider = (*SecretBindingProvider)(unsafe.Pointer(in.Provider))
 	return nil
 }
@@ -5399,6 +6105,7 @@ func autoConvert_v1beta1_SeedBackup_To_core_SeedBackup(in *SeedBackup, out *core
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.SecretRef = in.SecretRef
+	out.Replication = (*core.BackupBucketReplication)(unsafe.Pointer(in.Replication))
 	return nil
 }
 
@@ -5412,6 +6119,7 @@ func autoConvert_core_SeedBackup_To_v1beta1_SeedBackup(in *core.SeedBackup, out
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.SecretRef = in.SecretRef
+	out.Replication = (*BackupBucketReplication)(unsafe.Pointer(in.Replication))
 	return nil
 }
 
@@ -5667,9 +6375,9 @@ func Convert_core_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExc
 }
 
 func autoConvert_v1beta1_SeedSettingExcessCapacityReservationConfig_To_core_SeedSettingExcessCapacityReservationConfig(in *SeedSettingExcessCapacityReservationConfig, out *core.SeedSettingExcessCapacityReservationConfig, s conversion.Scope) error {
-	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.Resources = *(*corev1.ResourceList)(unsafe.Pointer(&in.Resources))
 	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
-	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	return nil
 }
 
@@ -5679,9 +6387,9 @@ func Convert_v1beta1_SeedSettingExcessCapacityReservationConfig_To_core_SeedSett
 }
 
 func autoConvert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSettingExcessCapacityReservationConfig(in *core.SeedSettingExcessCapacityReservationConfig, out *SeedSettingExcessCapacityReservationConfig, s conversion.Scope) error {
-	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.Resources = *(*corev1.ResourceList)(unsafe.Pointer(&in.Resources))
 	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
-	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	return nil
 }
 
@@ -5692,7 +6400,7 @@ func Convert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSett
 
 func autoConvert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBalancerServices(in *SeedSettingLoadBalancerServices, out *core.SeedSettingLoadBalancerServices, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.Zones = *(*[]core.SeedSettingLoadBalancerServicesZones)(unsafe.Pointer(&in.Zones))
 	out.ProxyProtocol = (*core.LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	return nil
@@ -5705,7 +6413,7 @@ func Convert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBala
 
 func autoConvert_core_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(in *core.SeedSettingLoadBalancerServices, out *SeedSettingLoadBalancerServices, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.Zones = *(*[]SeedSettingLoadBalancerServicesZones)(unsafe.Pointer(&in.Zones))
 	out.ProxyProtocol = (*LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	return nil
@@ -5719,7 +6427,7 @@ func Convert_core_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBala
 func autoConvert_v1beta1_SeedSettingLoadBalancerServicesZones_To_core_SeedSettingLoadBalancerServicesZones(in *SeedSettingLoadBalancerServicesZones, out *core.SeedSettingLoadBalancerServicesZones, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.ProxyProtocol = (*core.LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	return nil
 }
@@ -5732,7 +6440,7 @@ func Convert_v1beta1_SeedSettingLoadBalancerServicesZones_To_core_SeedSettingLoa
 func autoConvert_core_SeedSettingLoadBalancerServicesZones_To_v1beta1_SeedSettingLoadBalancerServicesZones(in *core.SeedSettingLoadBalancerServicesZones, out *SeedSettingLoadBalancerServicesZones, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.ProxyProtocol = (*LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	return nil
 }
@@ -5884,9 +6592,9 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
-	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.ClientCertificateExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	return nil
 }
@@ -5902,9 +6610,9 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
-	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.ClientCertificateExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	return nil
 }
@@ -6009,7 +6717,7 @@ func Convert_core_SeedVolumeProvider_To_v1beta1_SeedVolumeProvider(in *core.Seed
 func autoConvert_v1beta1_ServiceAccountConfig_To_core_ServiceAccountConfig(in *ServiceAccountConfig, out *core.ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
-	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
+	out.MaxTokenExpiration = (*v1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
 	return nil
 }
@@ -6022,7 +6730,7 @@ func Convert_v1beta1_ServiceAccountConfig_To_core_ServiceAccountConfig(in *Servi
 func autoConvert_core_ServiceAccountConfig_To_v1beta1_ServiceAccountConfig(in *core.ServiceAccountConfig, out *ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
-	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
+	out.MaxTokenExpiration = (*v1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
 	return nil
 }
@@ -6034,10 +6742,10 @@ func Convert_core_ServiceAccountConfig_To_v1beta1_ServiceAccountConfig(in *core.
 
 func autoConvert_v1beta1_ServiceAccountKeyRotation_To_core_ServiceAccountKeyRotation(in *ServiceAccountKeyRotation, out *core.ServiceAccountKeyRotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	return nil
 }
 
@@ -6048,10 +6756,10 @@ func Convert_v1beta1_ServiceAccountKeyRotation_To_core_ServiceAccountKeyRotation
 
 func autoConvert_core_ServiceAccountKeyRotation_To_v1beta1_ServiceAccountKeyRotation(in *core.ServiceAccountKeyRotation, out *ServiceAccountKeyRotation, s conversion.Scope) error {
 	out.Phase = CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	return nil
 }
 
@@ -6165,8 +6873,8 @@ func Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(i
 }
 
 func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -6176,8 +6884,8 @@ func Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in
 }
 
 func autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -6231,7 +6939,7 @@ func Convert_core_ShootList_To_v1beta1_ShootList(in *core.ShootList, out *ShootL
 func autoConvert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachineImage, out *core.ShootMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	if err := metav1.Convert_Pointer_string_To_string(&in.Version, &out.Version, s); err != nil {
+	if err := v1.Convert_Pointer_string_To_string(&in.Version, &out.Version, s); err != nil {
 		return err
 	}
 	return nil
@@ -6245,7 +6953,7 @@ func Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachin
 func autoConvert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *core.ShootMachineImage, out *ShootMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	if err := metav1.Convert_string_To_Pointer_string(&in.Version, &out.Version, s); err != nil {
+	if err := v1.Convert_string_To_Pointer_string(&in.Version, &out.Version, s); err != nil {
 		return err
 	}
 	return nil
@@ -6279,8 +6987,8 @@ func Convert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks,
 }
 
 func autoConvert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in *ShootSSHKeypairRotation, out *core.ShootSSHKeypairRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -6290,8 +6998,8 @@ func Convert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in
 }
 
 func autoConvert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in *core.ShootSSHKeypairRotation, out *ShootSSHKeypairRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -6328,6 +7036,7 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
+	out.Logging = (*core.Logging)(unsafe.Pointer(in.Logging))
 	return nil
 }
 
@@ -6364,6 +7073,7 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
+	out.Logging = (*Logging)(unsafe.Pointer(in.Logging))
 	return nil
 }
 
@@ -6454,18 +7164,19 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastErrors = *(*[]core.LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
 	out.AdvertisedAddresses = *(*[]core.ShootAdvertisedAddress)(unsafe.Pointer(&in.AdvertisedAddresses))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
 	out.Credentials = (*core.ShootCredentials)(unsafe.Pointer(in.Credentials))
-	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
+	out.LastHibernationTriggerTime = (*v1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.LastETCDRestore = (*core.LastETCDRestore)(unsafe.Pointer(in.LastETCDRestore))
 	return nil
 }
 
@@ -6481,21 +7192,22 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 		return err
 	}
 	out.IsHibernated = in.IsHibernated
-	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
+	out.LastHibernationTriggerTime = (*v1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastErrors = *(*[]LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
 	out.AdvertisedAddresses = *(*[]ShootAdvertisedAddress)(unsafe.Pointer(&in.AdvertisedAddresses))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.LastETCDRestore = (*LastETCDRestore)(unsafe.Pointer(in.LastETCDRestore))
 	return nil
 }
 
@@ -6596,13 +7308,13 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
+	out.EvictAfterOOMThreshold = (*v1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
 	out.EvictionRateBurst = (*int32)(unsafe.Pointer(in.EvictionRateBurst))
 	out.EvictionRateLimit = (*float64)(unsafe.Pointer(in.EvictionRateLimit))
 	out.EvictionTolerance = (*float64)(unsafe.Pointer(in.EvictionTolerance))
 	out.RecommendationMarginFraction = (*float64)(unsafe.Pointer(in.RecommendationMarginFraction))
-	out.UpdaterInterval = (*metav1.Duration)(unsafe.Pointer(in.UpdaterInterval))
-	out.RecommenderInterval = (*metav1.Duration)(unsafe.Pointer(in.RecommenderInterval))
+	out.UpdaterInterval = (*v1.Duration)(unsafe.Pointer(in.UpdaterInterval))
+	out.RecommenderInterval = (*v1.Duration)(unsafe.Pointer(in.RecommenderInterval))
 	out.TargetCPUPercentile = (*float64)(unsafe.Pointer(in.TargetCPUPercentile))
 	out.RecommendationLowerBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationLowerBoundCPUPercentile))
 	out.RecommendationUpperBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationUpperBoundCPUPercentile))
@@ -6619,13 +7331,13 @@ func Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *Ver
 
 func autoConvert_core_VerticalPodAutoscaler_To_v1beta1_VerticalPodAutoscaler(in *core.VerticalPodAutoscaler, out *VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
+	out.EvictAfterOOMThreshold = (*v1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
 	out.EvictionRateBurst = (*int32)(unsafe.Pointer(in.EvictionRateBurst))
 	out.EvictionRateLimit = (*float64)(unsafe.Pointer(in.EvictionRateLimit))
 	out.EvictionTolerance = (*float64)(unsafe.Pointer(in.EvictionTolerance))
 	out.RecommendationMarginFraction = (*float64)(unsafe.Pointer(in.RecommendationMarginFraction))
-	out.UpdaterInterval = (*metav1.Duration)(unsafe.Pointer(in.UpdaterInterval))
-	out.RecommenderInterval = (*metav1.Duration)(unsafe.Pointer(in.RecommenderInterval))
+	out.UpdaterInterval = (*v1.Duration)(unsafe.Pointer(in.UpdaterInterval))
+	out.RecommenderInterval = (*v1.Duration)(unsafe.Pointer(in.RecommenderInterval))
 	out.TargetCPUPercentile = (*float64)(unsafe.Pointer(in.TargetCPUPercentile))
 	out.RecommendationLowerBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationLowerBoundCPUPercentile))
 	out.RecommendationUpperBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationUpperBoundCPUPercentile))
@@ -6737,7 +7449,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*core.Volume)(unsafe.Pointer(in.Volume))
 	out.DataVolumes = *(*[]core.DataVolume)(unsafe.Pointer(&in.DataVolumes))
 	out.KubeletDataVolumeName = (*string)(unsafe.Pointer(in.KubeletDataVolumeName))
@@ -6746,6 +7458,10 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.MachineControllerManagerSettings = (*core.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*core.ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.KernelModules = *(*[]core.KernelModule)(unsafe.Pointer(&in.KernelModules))
+	out.HugePages = *(*[]core.HugePages)(unsafe.Pointer(&in.HugePages))
+	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
+	out.DrainPolicy = (*core.WorkerDrainPolicy)(unsafe.Pointer(in.DrainPolicy))
 	return nil
 }
 
@@ -6778,7 +7494,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SystemComponents = (*WorkerSystemComponents)(unsafe.Pointer(in.SystemComponents))
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
 	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
 	out.KubeletDataVolumeName = (*string)(unsafe.Pointer(in.KubeletDataVolumeName))
@@ -6786,6 +7502,10 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.KernelModules = *(*[]KernelModule)(unsafe.Pointer(&in.KernelModules))
+	out.HugePages = *(*[]HugePages)(unsafe.Pointer(&in.HugePages))
+	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
+	out.DrainPolicy = (*WorkerDrainPolicy)(unsafe.Pointer(in.DrainPolicy))
 	return nil
 }
 
@@ -6794,6 +7514,28 @@ func Convert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s conve
 	return autoConvert_core_Worker_To_v1beta1_Worker(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerDrainPolicy_To_core_WorkerDrainPolicy(in *WorkerDrainPolicy, out *core.WorkerDrainPolicy, s conversion.Scope) error {
+	out.EvictionOrder = (*core.PodEvictionOrder)(unsafe.Pointer(in.EvictionOrder))
+	out.PreDrainHooks = *(*[]core.PreDrainHook)(unsafe.Pointer(&in.PreDrainHooks))
+	return nil
+}
+
+// Convert_v1beta1_WorkerDrainPolicy_To_core_WorkerDrainPolicy is an autogenerated conversion function.
+func Convert_v1beta1_WorkerDrainPolicy_To_core_WorkerDrainPolicy(in *WorkerDrainPolicy, out *core.WorkerDrainPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerDrainPolicy_To_core_WorkerDrainPolicy(in, out, s)
+}
+
+func autoConvert_core_WorkerDrainPolicy_To_v1beta1_WorkerDrainPolicy(in *core.WorkerDrainPolicy, out *WorkerDrainPolicy, s conversion.Scope) error {
+	out.EvictionOrder = (*PodEvictionOrder)(unsafe.Pointer(in.EvictionOrder))
+	out.PreDrainHooks = *(*[]PreDrainHook)(unsafe.Pointer(&in.PreDrainHooks))
+	return nil
+}
+
+// Convert_core_WorkerDrainPolicy_To_v1beta1_WorkerDrainPolicy is an autogenerated conversion function.
+func Convert_core_WorkerDrainPolicy_To_v1beta1_WorkerDrainPolicy(in *core.WorkerDrainPolicy, out *WorkerDrainPolicy, s conversion.Scope) error {
+	return autoConvert_core_WorkerDrainPolicy_To_v1beta1_WorkerDrainPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(in *WorkerKubernetes, out *core.WorkerKubernetes, s conversion.Scope) error {
 	if in.Kubelet != nil {
 		in, out := &in.Kubelet, &out.Kubelet
@@ -10,10 +10,10 @@
 package v1beta1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -151,6 +151,18 @@ func (in *Alerting) DeepCopyInto(out *Alerting) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WebhookReceivers != nil {
+		in, out := &in.WebhookReceivers, &out.WebhookReceivers
+		*out = make([]AlertingWebhookReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomRules != nil {
+		in, out := &in.CustomRules, &out.CustomRules
+		*out = new(AlertingCustomRules)
+		**out = **in
+	}
 	return
 }
 
@@ -164,6 +176,121 @@ func (in *Alerting) DeepCopy() *Alerting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertingCustomRules) DeepCopyInto(out *AlertingCustomRules) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingCustomRules.
+func (in *AlertingCustomRules) DeepCopy() *AlertingCustomRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertingCustomRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertingWebhookReceiver) DeepCopyInto(out *AlertingWebhookReceiver) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingWebhookReceiver.
+func (in *AlertingWebhookReceiver) DeepCopy() *AlertingWebhookReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertingWebhookReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternativeMachineType) DeepCopyInto(out *AlternativeMachineType) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternativeMachineType.
+func (in *AlternativeMachineType) DeepCopy() *AlternativeMachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternativeMachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditBackends) DeepCopyInto(out *AuditBackends) {
+	*out = *in
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(AuditLogBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuditWebhookBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditBackends.
+func (in *AuditBackends) DeepCopy() *AuditBackends {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditBackends)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditBatch) DeepCopyInto(out *AuditBatch) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxWait != nil {
+		in, out := &in.MaxWait, &out.MaxWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditBatch.
+func (in *AuditBatch) DeepCopy() *AuditBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 	*out = *in
@@ -172,6 +299,11 @@ func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 		*out = new(AuditPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = new(AuditBackends)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -185,12 +317,33 @@ func (in *AuditConfig) DeepCopy() *AuditConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogBackend) DeepCopyInto(out *AuditLogBackend) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(AuditPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogBackend.
+func (in *AuditLogBackend) DeepCopy() *AuditLogBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditPolicy) DeepCopyInto(out *AuditPolicy) {
 	*out = *in
 	if in.ConfigMapRef != nil {
 		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(v1.ObjectReference)
+		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
 	return
@@ -206,6 +359,73 @@ func (in *AuditPolicy) DeepCopy() *AuditPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditTruncate) DeepCopyInto(out *AuditTruncate) {
+	*out = *in
+	if in.MaxBatchSize != nil {
+		in, out := &in.MaxBatchSize, &out.MaxBatchSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxEventSize != nil {
+		in, out := &in.MaxEventSize, &out.MaxEventSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditTruncate.
+func (in *AuditTruncate) DeepCopy() *AuditTruncate {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditTruncate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBackend) DeepCopyInto(out *AuditWebhookBackend) {
+	*out = *in
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(AuditPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(AuditBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Truncate != nil {
+		in, out := &in.Truncate, &out.Truncate
+		*out = new(AuditTruncate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBackend.
+func (in *AuditWebhookBackend) DeepCopy() *AuditWebhookBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
 	*out = *in
@@ -309,6 +529,39 @@ func (in *BackupBucketProvider) DeepCopy() *BackupBucketProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketReplication) DeepCopyInto(out *BackupBucketReplication) {
+	*out = *in
+	out.Provider = in.Provider
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	out.SecretRef = in.SecretRef
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketReplication.
+func (in *BackupBucketReplication) DeepCopy() *BackupBucketReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 	*out = *in
@@ -324,6 +577,11 @@ func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(BackupBucketReplication)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -357,7 +615,7 @@ func (in *BackupBucketStatus) DeepCopyInto(out *BackupBucketStatus) {
 	}
 	if in.GeneratedSecretRef != nil {
 		in, out := &in.GeneratedSecretRef, &out.GeneratedSecretRef
-		*out = new(v1.SecretReference)
+		*out = new(corev1.SecretReference)
 		**out = **in
 	}
 	return
@@ -434,6 +692,36 @@ func (in *BackupEntryList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEntryReplicationStatus) DeepCopyInto(out *BackupEntryReplicationStatus) {
+	*out = *in
+	if in.LastReplicationTime != nil {
+		in, out := &in.LastReplicationTime, &out.LastReplicationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(LastError)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEntryReplicationStatus.
+func (in *BackupEntryReplicationStatus) DeepCopy() *BackupEntryReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEntryReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupEntrySpec) DeepCopyInto(out *BackupEntrySpec) {
 	*out = *in
@@ -477,6 +765,11 @@ func (in *BackupEntryStatus) DeepCopyInto(out *BackupEntryStatus) {
 		in, out := &in.MigrationStartTime, &out.MigrationStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(BackupEntryReplicationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -595,6 +888,11 @@ func (in *CRI) DeepCopyInto(out *CRI) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImagePrePull != nil {
+		in, out := &in.ImagePrePull, &out.ImagePrePull
+		*out = new(ImagePrePull)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -754,22 +1052,22 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
 	if in.ScaleDownDelayAfterAdd != nil {
 		in, out := &in.ScaleDownDelayAfterAdd, &out.ScaleDownDelayAfterAdd
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownDelayAfterDelete != nil {
 		in, out := &in.ScaleDownDelayAfterDelete, &out.ScaleDownDelayAfterDelete
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownDelayAfterFailure != nil {
 		in, out := &in.ScaleDownDelayAfterFailure, &out.ScaleDownDelayAfterFailure
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownUnneededTime != nil {
 		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownUtilizationThreshold != nil {
@@ -779,7 +1077,7 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	}
 	if in.ScanInterval != nil {
 		in, out := &in.ScanInterval, &out.ScanInterval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Expander != nil {
@@ -789,7 +1087,7 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	}
 	if in.MaxNodeProvisionTime != nil {
 		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxGracefulTerminationSeconds != nil {
@@ -804,7 +1102,7 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	}
 	if in.NewPodScaleUpDelay != nil {
 		in, out := &in.NewPodScaleUpDelay, &out.NewPodScaleUpDelay
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxEmptyBulkDelete != nil {
@@ -850,17 +1148,17 @@ func (in *ClusterAutoscalerOptions) DeepCopyInto(out *ClusterAutoscalerOptions)
 	}
 	if in.ScaleDownUnneededTime != nil {
 		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScaleDownUnreadyTime != nil {
 		in, out := &in.ScaleDownUnreadyTime, &out.ScaleDownUnreadyTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxNodeProvisionTime != nil {
 		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	return
@@ -1069,7 +1367,7 @@ func (in *ControllerInstallationSpec) DeepCopyInto(out *ControllerInstallationSp
 	out.SeedRef = in.SeedRef
 	if in.DeploymentRef != nil {
 		in, out := &in.DeploymentRef, &out.DeploymentRef
-		*out = new(v1.ObjectReference)
+		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
 	return
@@ -1150,7 +1448,7 @@ func (in *ControllerRegistrationDeployment) DeepCopyInto(out *ControllerRegistra
 	}
 	if in.SeedSelector != nil {
 		in, out := &in.SeedSelector, &out.SeedSelector
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.DeploymentRefs != nil {
@@ -1242,7 +1540,7 @@ func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 	}
 	if in.ReconcileTimeout != nil {
 		in, out := &in.ReconcileTimeout, &out.ReconcileTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Primary != nil {
@@ -1926,22 +2224,22 @@ func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscal
 	*out = *in
 	if in.CPUInitializationPeriod != nil {
 		in, out := &in.CPUInitializationPeriod, &out.CPUInitializationPeriod
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.DownscaleStabilization != nil {
 		in, out := &in.DownscaleStabilization, &out.DownscaleStabilization
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.InitialReadinessDelay != nil {
 		in, out := &in.InitialReadinessDelay, &out.InitialReadinessDelay
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.SyncPeriod != nil {
 		in, out := &in.SyncPeriod, &out.SyncPeriod
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Tolerance != nil {
@@ -1962,6 +2260,49 @@ func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugePages) DeepCopyInto(out *HugePages) {
+	*out = *in
+	out.PageSize = in.PageSize.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugePages.
+func (in *HugePages) DeepCopy() *HugePages {
+	if in == nil {
+		return nil
+	}
+	out := new(HugePages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePull) DeepCopyInto(out *ImagePrePull) {
+	*out = *in
+	if in.SystemComponents != nil {
+		in, out := &in.SystemComponents, &out.SystemComponents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePull.
+func (in *ImagePrePull) DeepCopy() *ImagePrePull {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePull)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ingress) DeepCopyInto(out *Ingress) {
 	*out = *in
@@ -2086,6 +2427,29 @@ func (in *InternalSecretList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelModule) DeepCopyInto(out *KernelModule) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelModule.
+func (in *KernelModule) DeepCopy() *KernelModule {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -2141,7 +2505,7 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	}
 	if in.EventTTL != nil {
 		in, out := &in.EventTTL, &out.EventTTL
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Logging != nil {
@@ -2198,12 +2562,12 @@ func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerCo
 	}
 	if in.PodEvictionTimeout != nil {
 		in, out := &in.PodEvictionTimeout, &out.PodEvictionTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.NodeMonitorGracePeriod != nil {
 		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	return
@@ -2304,7 +2668,7 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	}
 	if in.EvictionPressureTransitionPeriod != nil {
 		in, out := &in.EvictionPressureTransitionPeriod, &out.EvictionPressureTransitionPeriod
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.EvictionSoft != nil {
@@ -2389,7 +2753,7 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	}
 	if in.StreamingConnectionIdleTimeout != nil {
 		in, out := &in.StreamingConnectionIdleTimeout, &out.StreamingConnectionIdleTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MemorySwap != nil {
@@ -2497,27 +2861,27 @@ func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopyInto(out *KubeletConfigE
 	*out = *in
 	if in.MemoryAvailable != nil {
 		in, out := &in.MemoryAvailable, &out.MemoryAvailable
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ImageFSAvailable != nil {
 		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ImageFSInodesFree != nil {
 		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.NodeFSAvailable != nil {
 		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.NodeFSInodesFree != nil {
 		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	return
@@ -2693,6 +3057,27 @@ func (in *KubernetesSettings) DeepCopy() *KubernetesSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastETCDRestore) DeepCopyInto(out *LastETCDRestore) {
+	*out = *in
+	in.TriggeredTime.DeepCopyInto(&out.TriggeredTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastETCDRestore.
+func (in *LastETCDRestore) DeepCopy() *LastETCDRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(LastETCDRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LastError) DeepCopyInto(out *LastError) {
 	*out = *in
@@ -2778,6 +3163,107 @@ func (in *LoadBalancerServicesProxyProtocol) DeepCopy() *LoadBalancerServicesPro
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]LoggingOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingOutput) DeepCopyInto(out *LoggingOutput) {
+	*out = *in
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]LoggingOutputSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(LoggingOutputRateLimit)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(LoggingOutputBuffer)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingOutput.
+func (in *LoggingOutput) DeepCopy() *LoggingOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingOutputBuffer) DeepCopyInto(out *LoggingOutputBuffer) {
+	*out = *in
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RetryLimit != nil {
+		in, out := &in.RetryLimit, &out.RetryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingOutputBuffer.
+func (in *LoggingOutputBuffer) DeepCopy() *LoggingOutputBuffer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingOutputBuffer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingOutputRateLimit) DeepCopyInto(out *LoggingOutputRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingOutputRateLimit.
+func (in *LoggingOutputRateLimit) DeepCopy() *LoggingOutputRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingOutputRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Machine) DeepCopyInto(out *Machine) {
 	*out = *in
@@ -2791,6 +3277,13 @@ func (in *Machine) DeepCopyInto(out *Machine) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AlternativeTypes != nil {
+		in, out := &in.AlternativeTypes, &out.AlternativeTypes
+		*out = make([]AlternativeMachineType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2809,17 +3302,17 @@ func (in *MachineControllerManagerSettings) DeepCopyInto(out *MachineControllerM
 	*out = *in
 	if in.MachineDrainTimeout != nil {
 		in, out := &in.MachineDrainTimeout, &out.MachineDrainTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MachineHealthTimeout != nil {
 		in, out := &in.MachineHealthTimeout, &out.MachineHealthTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MachineCreationTimeout != nil {
 		in, out := &in.MachineCreationTimeout, &out.MachineCreationTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxEvictRetries != nil {
@@ -3064,6 +3557,13 @@ func (in *Monitoring) DeepCopyInto(out *Monitoring) {
 		*out = new(Alerting)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = make([]MonitoringRemoteWrite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3077,6 +3577,32 @@ func (in *Monitoring) DeepCopy() *Monitoring {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringRemoteWrite) DeepCopyInto(out *MonitoringRemoteWrite) {
+	*out = *in
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Keep != nil {
+		in, out := &in.Keep, &out.Keep
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringRemoteWrite.
+func (in *MonitoringRemoteWrite) DeepCopy() *MonitoringRemoteWrite {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedResourceReference) DeepCopyInto(out *NamedResourceReference) {
 	*out = *in
@@ -3315,7 +3841,7 @@ func (in *NginxIngress) DeepCopyInto(out *NginxIngress) {
 	}
 	if in.ExternalTrafficPolicy != nil {
 		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
+		*out = new(corev1.ServiceExternalTrafficPolicy)
 		**out = **in
 	}
 	return
@@ -3518,6 +4044,37 @@ func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuth
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreDrainHook) DeepCopyInto(out *PreDrainHook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(PreDrainHookFailurePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreDrainHook.
+func (in *PreDrainHook) DeepCopy() *PreDrainHook {
+	if in == nil {
+		return nil
+	}
+	out := new(PreDrainHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -3827,7 +4384,7 @@ func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 	}
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
@@ -3939,7 +4496,7 @@ func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	out.SecretRef = in.SecretRef
 	if in.Quotas != nil {
 		in, out := &in.Quotas, &out.Quotas
-		*out = make([]v1.ObjectReference, len(*in))
+		*out = make([]corev1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Provider != nil {
@@ -4059,6 +4616,11 @@ func (in *SeedBackup) DeepCopyInto(out *SeedBackup) {
 		**out = **in
 	}
 	out.SecretRef = in.SecretRef
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(BackupBucketReplication)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4318,7 +4880,7 @@ func (in *SeedSettingExcessCapacityReservationConfig) DeepCopyInto(out *SeedSett
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
@@ -4332,7 +4894,7 @@ func (in *SeedSettingExcessCapacityReservationConfig) DeepCopyInto(out *SeedSett
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -4362,7 +4924,7 @@ func (in *SeedSettingLoadBalancerServices) DeepCopyInto(out *SeedSettingLoadBala
 	}
 	if in.ExternalTrafficPolicy != nil {
 		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
+		*out = new(corev1.ServiceExternalTrafficPolicy)
 		**out = **in
 	}
 	if in.Zones != nil {
@@ -4402,7 +4964,7 @@ func (in *SeedSettingLoadBalancerServicesZones) DeepCopyInto(out *SeedSettingLoa
 	}
 	if in.ExternalTrafficPolicy != nil {
 		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
+		*out = new(corev1.ServiceExternalTrafficPolicy)
 		**out = **in
 	}
 	if in.ProxyProtocol != nil {
@@ -4590,14 +5152,14 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 	}
 	if in.Capacity != nil {
 		in, out := &in.Capacity, &out.Capacity
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
 	if in.Allocatable != nil {
 		in, out := &in.Allocatable, &out.Allocatable
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
@@ -4720,7 +5282,7 @@ func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
 	}
 	if in.MaxTokenExpiration != nil {
 		in, out := &in.MaxTokenExpiration, &out.MaxTokenExpiration
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.AcceptedIssuers != nil {
@@ -5126,6 +5688,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5313,6 +5880,11 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(NetworkingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastETCDRestore != nil {
+		in, out := &in.LastETCDRestore, &out.LastETCDRestore
+		*out = new(LastETCDRestore)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5412,7 +5984,7 @@ func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
 	if in.EvictAfterOOMThreshold != nil {
 		in, out := &in.EvictAfterOOMThreshold, &out.EvictAfterOOMThreshold
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.EvictionRateBurst != nil {
@@ -5437,12 +6009,12 @@ func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	}
 	if in.UpdaterInterval != nil {
 		in, out := &in.UpdaterInterval, &out.UpdaterInterval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.RecommenderInterval != nil {
 		in, out := &in.RecommenderInterval, &out.RecommenderInterval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.TargetCPUPercentile != nil {
@@ -5623,7 +6195,7 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
-		*out = make([]v1.Taint, len(*in))
+		*out = make([]corev1.Taint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -5672,6 +6244,30 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(ClusterAutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KernelModules != nil {
+		in, out := &in.KernelModules, &out.KernelModules
+		*out = make([]KernelModule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HugePages != nil {
+		in, out := &in.HugePages, &out.HugePages
+		*out = make([]HugePages, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(MachineUpdateStrategy)
+		**out = **in
+	}
+	if in.DrainPolicy != nil {
+		in, out := &in.DrainPolicy, &out.DrainPolicy
+		*out = new(WorkerDrainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5685,6 +6281,34 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerDrainPolicy) DeepCopyInto(out *WorkerDrainPolicy) {
+	*out = *in
+	if in.EvictionOrder != nil {
+		in, out := &in.EvictionOrder, &out.EvictionOrder
+		*out = new(PodEvictionOrder)
+		**out = **in
+	}
+	if in.PreDrainHooks != nil {
+		in, out := &in.PreDrainHooks, &out.PreDrainHooks
+		*out = make([]PreDrainHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerDrainPolicy.
+func (in *WorkerDrainPolicy) DeepCopy() *WorkerDrainPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerDrainPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerKubernetes) DeepCopyInto(out *WorkerKubernetes) {
 	*out = *in
@@ -49,6 +49,49 @@ func ValidateBackupBucketSpec(spec *core.BackupBucketSpec, fldPath *field.Path)
 
 	allErrs = append(allErrs, validateSecretReference(spec.SecretRef, fldPath.Child("secretRef"))...)
 
+	if spec.Replication != nil {
+		allErrs = append(allErrs, ValidateBackupBucketReplication(spec.Replication, spec.Provider, fldPath.Child("replication"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketReplication validates the replication configuration of a BackupBucket or a Seed's backup. The
+// secondary object store must not be the same as the primary one.
+func ValidateBackupBucketReplication(replication *core.BackupBucketReplication, primary core.BackupBucketProvider, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(replication.Provider.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("provider", "type"), "must provide a provider type for the secondary object store"))
+	}
+	if len(replication.Provider.Region) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("provider", "region"), "must provide a region for the secondary object store"))
+	}
+	if replication.Provider == primary {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("provider"), replication.Provider, "secondary object store must differ in provider type or region from the primary object store"))
+	}
+
+	allErrs = append(allErrs, validateSecretReference(replication.SecretRef, fldPath.Child("secretRef"))...)
+
+	if replication.MaxBackupAge != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*replication.MaxBackupAge), fldPath.Child("maxBackupAge"))...)
+	}
+	if replication.MaxBackups != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*replication.MaxBackups), fldPath.Child("maxBackups"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketReplicationUpdate validates the replication configuration of a BackupBucket or a Seed's backup
+// before an update. The secondary provider cannot be changed while the replication is configured.
+func ValidateBackupBucketReplicationUpdate(newReplication, oldReplication *core.BackupBucketReplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if newReplication != nil && oldReplication != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newReplication.Provider, oldReplication.Provider, fldPath.Child("provider"))...)
+	}
+
 	return allErrs
 }
 
@@ -58,6 +101,7 @@ func ValidateBackupBucketSpecUpdate(newSpec, oldSpec *core.BackupBucketSpec, fld
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Provider, oldSpec.Provider, fldPath.Child("provider"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SeedName, oldSpec.SeedName, fldPath.Child("seedName"))...)
+	allErrs = append(allErrs, ValidateBackupBucketReplicationUpdate(newSpec.Replication, oldSpec.Replication, fldPath.Child("replication"))...)
 
 	return allErrs
 }
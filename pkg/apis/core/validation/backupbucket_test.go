@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/pkg/apis/core/validation"
@@ -134,6 +135,87 @@ var _ = Describe("validation", func() {
 					"Field": Equal("spec.seedName"),
 				}))))
 		})
+
+		Context("replication", func() {
+			BeforeEach(func() {
+				backupBucket.Spec.Replication = &core.BackupBucketReplication{
+					Provider: core.BackupBucketProvider{
+						Type:   "some-provider",
+						Region: "other-region",
+					},
+					SecretRef: corev1.SecretReference{
+						Name:      "backup-secret-replica",
+						Namespace: "garden",
+					},
+					MaxBackupAge: ptr.To[int32](7),
+					MaxBackups:   ptr.To[int32](3),
+				}
+			})
+
+			It("should allow a valid replication configuration", func() {
+				Expect(ValidateBackupBucket(backupBucket)).To(BeEmpty())
+			})
+
+			It("should forbid replication configuration with empty or invalid keys", func() {
+				backupBucket.Spec.Replication.Provider = core.BackupBucketProvider{}
+				backupBucket.Spec.Replication.SecretRef = corev1.SecretReference{}
+				backupBucket.Spec.Replication.MaxBackupAge = ptr.To[int32](-1)
+				backupBucket.Spec.Replication.MaxBackups = ptr.To[int32](-1)
+
+				Expect(ValidateBackupBucket(backupBucket)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.replication.provider.type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.replication.provider.region"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.replication.secretRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.replication.secretRef.namespace"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.replication.maxBackupAge"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.replication.maxBackups"),
+					})),
+				))
+			})
+
+			It("should forbid replicating to the primary object store", func() {
+				backupBucket.Spec.Replication.Provider = backupBucket.Spec.Provider
+
+				Expect(ValidateBackupBucket(backupBucket)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.replication.provider"),
+				}))))
+			})
+
+			It("should forbid changing the secondary provider", func() {
+				newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+				newBackupBucket.Spec.Replication.Provider.Region = "another-region"
+
+				Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.replication.provider"),
+				}))))
+			})
+
+			It("should allow removing the replication", func() {
+				newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+				newBackupBucket.Spec.Replication = nil
+
+				Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(BeEmpty())
+			})
+		})
 	})
 
 })
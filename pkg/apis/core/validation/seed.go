@@ -13,6 +13,7 @@ import (
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/helper"
@@ -114,6 +115,11 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		}
 
 		allErrs = append(allErrs, validateSecretReference(seedSpec.Backup.SecretRef, fldPath.Child("backup", "secretRef"))...)
+
+		if seedSpec.Backup.Replication != nil {
+			primary := core.BackupBucketProvider{Type: seedSpec.Backup.Provider, Region: ptr.Deref(seedSpec.Backup.Region, seedSpec.Provider.Region)}
+			allErrs = append(allErrs, ValidateBackupBucketReplication(seedSpec.Backup.Replication, primary, fldPath.Child("backup", "replication"))...)
+		}
 	}
 
 	var keyValues = sets.New[string]()
@@ -299,6 +305,7 @@ func ValidateSeedSpecUpdate(newSeedSpec, oldSeedSpec *core.SeedSpec, fldPath *fi
 		if newSeedSpec.Backup != nil {
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup.Provider, oldSeedSpec.Backup.Provider, fldPath.Child("backup", "provider"))...)
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup.Region, oldSeedSpec.Backup.Region, fldPath.Child("backup", "region"))...)
+			allErrs = append(allErrs, ValidateBackupBucketReplicationUpdate(newSeedSpec.Backup.Replication, oldSeedSpec.Backup.Replication, fldPath.Child("backup", "replication"))...)
 		} else {
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup, oldSeedSpec.Backup, fldPath.Child("backup"))...)
 		}
@@ -1105,6 +1105,36 @@ var _ = Describe("Seed Validation Tests", func() {
 					"Detail": Equal(`field is immutable`),
 				}))
 			})
+
+			It("should forbid replicating backups to the primary object store", func() {
+				seed.Spec.Backup.Replication = &core.BackupBucketReplication{
+					Provider:  core.BackupBucketProvider{Type: seed.Spec.Backup.Provider, Region: *seed.Spec.Backup.Region},
+					SecretRef: corev1.SecretReference{Name: "backup-replica", Namespace: "garden"},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backup.replication.provider"),
+				}))
+			})
+
+			It("should allow adding and forbid changing the secondary provider", func() {
+				newSeed := prepareSeedForUpdate(seed)
+				newSeed.Spec.Backup.Replication = &core.BackupBucketReplication{
+					Provider:  core.BackupBucketProvider{Type: seed.Spec.Backup.Provider, Region: "replica-region"},
+					SecretRef: corev1.SecretReference{Name: "backup-replica", Namespace: "garden"},
+				}
+
+				Expect(ValidateSeedUpdate(newSeed, seed)).To(BeEmpty())
+
+				newerSeed := prepareSeedForUpdate(newSeed)
+				newerSeed.Spec.Backup.Replication.Provider.Region = "other-replica-region"
+
+				Expect(ValidateSeedUpdate(newerSeed, newSeed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backup.replication.provider"),
+				}))
+			})
 		})
 
 		Context("ingress config", func() {
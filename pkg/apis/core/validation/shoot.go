@@ -16,6 +16,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/distribution/reference"
+
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-test/deep"
 	"github.com/robfig/cron"
@@ -55,6 +57,14 @@ var (
 	availableKubernetesDashboardAuthenticationModes = sets.New(
 		core.KubernetesDashboardAuthModeToken,
 	)
+	availableLoggingOutputTypes = sets.New(
+		string(core.LoggingOutputTypeOTLP),
+		string(core.LoggingOutputTypeHTTP),
+	)
+	availableLoggingOutputSources = sets.New(
+		string(core.LoggingOutputSourceControlPlane),
+		string(core.LoggingOutputSourceEvents),
+	)
 	availableNginxIngressExternalTrafficPolicies = sets.New(
 		string(corev1.ServiceExternalTrafficPolicyCluster),
 		string(corev1.ServiceExternalTrafficPolicyLocal),
@@ -78,6 +88,7 @@ var (
 		v1beta1constants.OperationRotateETCDEncryptionKeyComplete,
 		v1beta1constants.OperationRotateServiceAccountKeyStart,
 		v1beta1constants.OperationRotateServiceAccountKeyComplete,
+		v1beta1constants.ShootOperationRestoreETCD,
 	)
 	forbiddenShootOperationsWhenEncryptionChangeIsRollingOut = sets.New(
 		v1beta1constants.OperationRotateCredentialsStart,
@@ -92,6 +103,18 @@ var (
 	availableWorkerCRINames = sets.New(
 		string(core.CRINameContainerD),
 	)
+	availableMachineUpdateStrategies = sets.New(
+		string(core.AutoRollingUpdate),
+		string(core.AutoInPlaceUpdate),
+	)
+	availablePodEvictionOrders = sets.New(
+		string(core.PodEvictionOrderParallel),
+		string(core.PodEvictionOrderPriorityAscending),
+	)
+	availablePreDrainHookFailurePolicies = sets.New(
+		string(core.PreDrainHookFailurePolicyFail),
+		string(core.PreDrainHookFailurePolicyIgnore),
+	)
 	availableClusterAutoscalerExpanderModes = sets.New(
 		string(core.ClusterAutoscalerExpanderLeastWaste),
 		string(core.ClusterAutoscalerExpanderMostPods),
@@ -267,6 +290,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
 	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, validateLogging(spec.Logging, fldPath.Child("logging"))...)
 	allErrs = append(allErrs, ValidateHibernation(meta.Annotations, spec.Hibernation, fldPath.Child("hibernation"))...)
 
 	if len(spec.Region) == 0 {
@@ -377,6 +401,11 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 
 		// worker kubernetes versions must not be downgraded and but can skip minor versions
 		allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newKubernetesVersion, oldKubernetesVersion, true, idxPath.Child("kubernetes", "version"))...)
+
+		// the machines of a worker pool cannot be switched between being replaced and being updated in-place
+		if isUpdateStrategyInPlace(newWorker.UpdateStrategy) != isUpdateStrategyInPlace(oldWorker.UpdateStrategy) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("updateStrategy"), newWorker.UpdateStrategy, "cannot switch between in-place and rolling updates of an existing worker pool"))
+		}
 	}
 
 	allErrs = append(allErrs, validateNetworkingUpdate(newSpec.Networking, oldSpec.Networking, fldPath.Child("networking"))...)
@@ -391,6 +420,10 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	return allErrs
 }
 
+func isUpdateStrategyInPlace(updateStrategy *core.MachineUpdateStrategy) bool {
+	return updateStrategy != nil && *updateStrategy == core.AutoInPlaceUpdate
+}
+
 func validateWorkerUpdate(newHasWorkers, oldHasWorkers bool, fldPath *field.Path) *field.Error {
 	if oldHasWorkers && !newHasWorkers {
 		return field.Forbidden(fldPath, "cannot switch from a Shoot with workers to a workerless Shoot")
@@ -1357,6 +1390,7 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
 			allErrs = append(allErrs, ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
 		}
+		allErrs = append(allErrs, validateAuditBackends(auditConfig, auditPath.Child("backends"))...)
 	}
 
 	k8sLess130, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.30")
@@ -1537,6 +1571,257 @@ func validateMonitoring(monitoring *core.Monitoring, fldPath *field.Path) field.
 	if monitoring != nil && monitoring.Alerting != nil {
 		allErrs = append(allErrs, validateAlerting(monitoring.Alerting, fldPath.Child("alerting"))...)
 	}
+	if monitoring != nil {
+		allErrs = append(allErrs, validateMonitoringRemoteWrite(monitoring.RemoteWrite, fldPath.Child("remoteWrite"))...)
+	}
+	return allErrs
+}
+
+func validateMonitoringRemoteWrite(remoteWrites []core.MonitoringRemoteWrite, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, remoteWrite := range remoteWrites {
+		idxPath := fldPath.Index(i)
+
+		if len(remoteWrite.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(remoteWrite.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), remoteWrite.Name, msg))
+			}
+			if names.Has(remoteWrite.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), remoteWrite.Name))
+			}
+			names.Insert(remoteWrite.Name)
+		}
+
+		allErrs = append(allErrs, validateExternalHTTPSURL(remoteWrite.URL, idxPath.Child("url"))...)
+
+		if remoteWrite.SecretName != nil {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(*remoteWrite.SecretName, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("secretName"), *remoteWrite.SecretName, msg))
+			}
+		}
+
+		if len(remoteWrite.Keep) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("keep"), "must provide at least one metric name to export"))
+		}
+
+		keptMetrics := sets.New[string]()
+		for j, metric := range remoteWrite.Keep {
+			keepPath := idxPath.Child("keep").Index(j)
+
+			if !metricNameRegex.MatchString(metric) {
+				allErrs = append(allErrs, field.Invalid(keepPath, metric, "must be a valid metric name (regular expressions are not supported)"))
+				continue
+			}
+			if !slices.ContainsFunc(allowedRemoteWriteMetricPrefixes, func(prefix string) bool { return strings.HasPrefix(metric, prefix) }) {
+				allErrs = append(allErrs, field.Forbidden(keepPath, fmt.Sprintf("metric %q must not be exported, only metrics with one of the following prefixes are allowed: %s", metric, strings.Join(allowedRemoteWriteMetricPrefixes, ", "))))
+			}
+			if keptMetrics.Has(metric) {
+				allErrs = append(allErrs, field.Duplicate(keepPath, metric))
+			}
+			keptMetrics.Insert(metric)
+		}
+	}
+
+	return allErrs
+}
+
+// validateExternalHTTPSURL validates URLs of external endpoints which are called by components running in the seed
+// cluster, e.g. for shipping metrics, logs, audit events or alerts. Hence, they must not point to internal endpoints.
+func validateExternalHTTPSURL(URL string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(URL) == 0 {
+		return append(allErrs, field.Required(fldPath, "must provide a URL"))
+	}
+
+	u, err := url.Parse(URL)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, URL, "must be a valid URL: "+err.Error()))
+	}
+
+	if u.Scheme != "https" {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scheme"), u.Scheme, []string{"https"}))
+	}
+	if len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, URL, "host must be provided"))
+	} else if isInternalHost(u.Hostname()) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not point to a loopback, private, link-local or cluster-internal address"))
+	}
+	if u.User != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, URL, "user information is not permitted in the URL"))
+	}
+
+	return allErrs
+}
+
+func validateAuditBackends(auditConfig *core.AuditConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	backends := auditConfig.Backends
+	if backends == nil {
+		return allErrs
+	}
+
+	if auditConfig.AuditPolicy == nil || auditConfig.AuditPolicy.ConfigMapRef == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "audit backends can only be configured if an audit policy is referenced"))
+	}
+
+	if log := backends.Log; log != nil {
+		logPath := fldPath.Child("log")
+		allErrs = append(allErrs, validateAuditBackendPolicy(log.Policy, logPath.Child("policy"))...)
+	}
+
+	if webhook := backends.Webhook; webhook != nil {
+		webhookPath := fldPath.Child("webhook")
+		allErrs = append(allErrs, validateExternalHTTPSURL(webhook.URL, webhookPath.Child("url"))...)
+		if webhook.SecretName != nil {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(*webhook.SecretName, false) {
+				allErrs = append(allErrs, field.Invalid(webhookPath.Child("secretName"), *webhook.SecretName, msg))
+			}
+		}
+		allErrs = append(allErrs, validateAuditBackendPolicy(webhook.Policy, webhookPath.Child("policy"))...)
+		allErrs = append(allErrs, validateAuditBatch(webhook.Batch, webhookPath.Child("batch"))...)
+		allErrs = append(allErrs, validateAuditTruncate(webhook.Truncate, webhookPath.Child("truncate"))...)
+		if webhook.InitialBackoff != nil && webhook.InitialBackoff.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("initialBackoff"), webhook.InitialBackoff.Duration.String(), "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAuditBackendPolicy(policy *core.AuditPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	if policy.ConfigMapRef == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("configMapRef"), "must reference a ConfigMap containing the audit policy"))
+		return allErrs
+	}
+
+	allErrs = append(allErrs, ValidateAuditPolicyConfigMapReference(policy.ConfigMapRef, fldPath.Child("configMapRef"))...)
+
+	return allErrs
+}
+
+func validateAuditBatch(batch *core.AuditBatch, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if batch == nil {
+		return allErrs
+	}
+
+	if batch.MaxSize != nil && *batch.MaxSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), *batch.MaxSize, "must be greater than 0"))
+	}
+	if batch.MaxWait != nil && batch.MaxWait.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxWait"), batch.MaxWait.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateAuditTruncate(truncate *core.AuditTruncate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if truncate == nil {
+		return allErrs
+	}
+
+	if truncate.MaxBatchSize != nil && truncate.MaxBatchSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBatchSize"), truncate.MaxBatchSize.String(), "must be greater than 0"))
+	}
+	if truncate.MaxEventSize != nil && truncate.MaxEventSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxEventSize"), truncate.MaxEventSize.String(), "must be greater than 0"))
+	}
+	if truncate.MaxBatchSize != nil && truncate.MaxEventSize != nil && truncate.MaxEventSize.Cmp(*truncate.MaxBatchSize) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxEventSize"), truncate.MaxEventSize.String(), "must not be greater than maxBatchSize"))
+	}
+
+	return allErrs
+}
+
+func validateLogging(logging *core.Logging, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if logging == nil {
+		return allErrs
+	}
+
+	names := sets.New[string]()
+	for i, output := range logging.Outputs {
+		idxPath := fldPath.Child("outputs").Index(i)
+
+		if len(output.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(output.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), output.Name, msg))
+			}
+			if names.Has(output.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), output.Name))
+			}
+			names.Insert(output.Name)
+		}
+
+		if !availableLoggingOutputTypes.Has(string(output.Type)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), output.Type, sets.List(availableLoggingOutputTypes)))
+		}
+
+		allErrs = append(allErrs, validateExternalHTTPSURL(output.URL, idxPath.Child("url"))...)
+
+		if output.SecretName != nil {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(*output.SecretName, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("secretName"), *output.SecretName, msg))
+			}
+		}
+
+		if len(output.Sources) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("sources"), "must provide at least one source"))
+		}
+
+		sources := sets.New[core.LoggingOutputSource]()
+		for j, source := range output.Sources {
+			sourcePath := idxPath.Child("sources").Index(j)
+
+			if !availableLoggingOutputSources.Has(string(source)) {
+				allErrs = append(allErrs, field.NotSupported(sourcePath, source, sets.List(availableLoggingOutputSources)))
+			}
+			if sources.Has(source) {
+				allErrs = append(allErrs, field.Duplicate(sourcePath, source))
+			}
+			sources.Insert(source)
+		}
+
+		if output.RateLimit != nil {
+			if output.RateLimit.RecordsPerSecond <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("rateLimit", "recordsPerSecond"), output.RateLimit.RecordsPerSecond, "must be greater than 0"))
+			}
+		}
+
+		if output.Buffer != nil {
+			bufferPath := idxPath.Child("buffer")
+
+			if output.Buffer.MemoryLimit != nil {
+				if output.Buffer.MemoryLimit.Sign() <= 0 {
+					allErrs = append(allErrs, field.Invalid(bufferPath.Child("memoryLimit"), output.Buffer.MemoryLimit.String(), "must be greater than 0"))
+				} else if output.Buffer.MemoryLimit.Cmp(maxLoggingOutputBufferMemoryLimit) > 0 {
+					allErrs = append(allErrs, field.Invalid(bufferPath.Child("memoryLimit"), output.Buffer.MemoryLimit.String(), fmt.Sprintf("must not be greater than %s", maxLoggingOutputBufferMemoryLimit.String())))
+				}
+			}
+			if output.Buffer.RetryLimit != nil && *output.Buffer.RetryLimit <= 0 {
+				allErrs = append(allErrs, field.Invalid(bufferPath.Child("retryLimit"), *output.Buffer.RetryLimit, "must be greater than 0"))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -1554,9 +1839,56 @@ func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorL
 			emails[email] = struct{}{}
 		}
 	}
+
+	webhookReceiverNames := sets.New[string]()
+	for i, receiver := range alerting.WebhookReceivers {
+		idxPath := fldPath.Child("webhookReceivers").Index(i)
+
+		if len(receiver.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(receiver.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), receiver.Name, msg))
+			}
+			if webhookReceiverNames.Has(receiver.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), receiver.Name))
+			}
+			webhookReceiverNames.Insert(receiver.Name)
+		}
+
+		allErrs = append(allErrs, validateExternalHTTPSURL(receiver.URL, idxPath.Child("url"))...)
+	}
+
+	if alerting.CustomRules != nil {
+		if len(alerting.CustomRules.ConfigMapName) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("customRules", "configMapName"), "must provide the name of the ConfigMap containing the alerting rules"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(alerting.CustomRules.ConfigMapName, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("customRules", "configMapName"), alerting.CustomRules.ConfigMapName, msg))
+			}
+		}
+	}
+
 	return allErrs
 }
 
+func isInternalHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+			ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+	}
+
+	for _, suffix := range []string{"localhost", ".local", ".internal", ".svc"} {
+		if host == strings.TrimPrefix(suffix, ".") || strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+
+	return !strings.Contains(host, ".")
+}
+
 func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, workerless bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1624,6 +1956,7 @@ func validateProvider(provider core.Provider, kubernetes core.Kubernetes, networ
 		}
 
 		allErrs = append(allErrs, ValidateWorkers(provider.Workers, fldPath.Child("workers"))...)
+		allErrs = append(allErrs, validateAlternativeMachineTypesNodesNetwork(provider.Workers, networking, fldPath.Child("workers"))...)
 		allErrs = append(allErrs, ValidateSystemComponentWorkers(provider.Workers, kubernetes.Version, fldPath.Child("workers"))...)
 	}
 
@@ -1649,6 +1982,27 @@ const (
 // volumeSizeRegex is used for volume size validation.
 var volumeSizeRegex = regexp.MustCompile(`^(\d)+Gi$`)
 
+var (
+	// maxLoggingOutputBufferMemoryLimit is the maximum memory which may be used for buffering the records of a single
+	// logging output. The buffers are held by the fluent-bit instances of the seed which are shared by all shoots.
+	maxLoggingOutputBufferMemoryLimit = resource.MustParse("64Mi")
+
+	metricNameRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	// allowedRemoteWriteMetricPrefixes is the list of metric name prefixes which may be exported to user-defined remote
+	// write endpoints. It covers the metrics of the shoot control plane components while keeping metrics about the seed
+	// infrastructure (e.g. cAdvisor or node-exporter metrics of seed nodes) and internal gardener components private.
+	allowedRemoteWriteMetricPrefixes = []string{
+		"apiserver_",
+		"authentication_",
+		"etcd_",
+		"kube_",
+		"kubelet_",
+		"rest_client_",
+		"scheduler_",
+		"workqueue_",
+	}
+)
+
 // ValidateWorker validates the worker object.
 func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *field.Path, inTemplate bool) field.ErrorList {
 	kubernetesVersion := kubernetes.Version
@@ -1688,6 +2042,17 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), worker.MaxUnavailable, "may not be 0 when `maxSurge` is 0"))
 	}
 
+	if worker.UpdateStrategy != nil {
+		if !availableMachineUpdateStrategies.Has(string(*worker.UpdateStrategy)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("updateStrategy"), *worker.UpdateStrategy, sets.List(availableMachineUpdateStrategies)))
+		}
+
+		// Machines which are updated in-place are not surged.
+		if isUpdateStrategyInPlace(worker.UpdateStrategy) && worker.MaxSurge != nil && getIntOrPercentValue(*worker.MaxSurge) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxSurge"), fmt.Sprintf("must be 0 when `updateStrategy` is %q", core.AutoInPlaceUpdate)))
+		}
+	}
+
 	allErrs = append(allErrs, metav1validation.ValidateLabels(worker.Labels, fldPath.Child("labels"))...)
 	allErrs = append(allErrs, apivalidation.ValidateAnnotations(worker.Annotations, fldPath.Child("annotations"))...)
 	if len(worker.Taints) > 0 {
@@ -1765,11 +2130,167 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 	if worker.Machine.Architecture != nil {
 		allErrs = append(allErrs, ValidateArchitecture(worker.Machine.Architecture, fldPath.Child("machine", "architecture"))...)
 	}
+	allErrs = append(allErrs, validateAlternativeMachineTypes(worker.Machine, fldPath.Child("machine", "alternativeTypes"))...)
 
 	if worker.ClusterAutoscaler != nil {
 		allErrs = append(allErrs, ValidateClusterAutoscalerOptions(worker.ClusterAutoscaler, fldPath.Child("autoscaler"))...)
 	}
 
+	allErrs = append(allErrs, ValidateSysctls(worker.Sysctls, fldPath.Child("sysctls"))...)
+	allErrs = append(allErrs, ValidateKernelModules(worker.KernelModules, fldPath.Child("kernelModules"))...)
+	allErrs = append(allErrs, ValidateHugePages(worker.HugePages, fldPath.Child("hugePages"))...)
+
+	if worker.DrainPolicy != nil {
+		allErrs = append(allErrs, validateWorkerDrainPolicy(worker.DrainPolicy, fldPath.Child("drainPolicy"))...)
+	}
+
+	return allErrs
+}
+
+func validateAlternativeMachineTypes(machine core.Machine, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		machineTypes = sets.New(machine.Type)
+	)
+
+	for i, alternativeType := range machine.AlternativeTypes {
+		idxPath := fldPath.Index(i)
+
+		if len(alternativeType.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must specify a machine type"))
+		} else if machineTypes.Has(alternativeType.Type) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("type"), alternativeType.Type))
+		}
+		machineTypes.Insert(alternativeType.Type)
+
+		if alternativeType.Architecture != nil {
+			allErrs = append(allErrs, ValidateArchitecture(alternativeType.Architecture, idxPath.Child("architecture"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerDrainPolicy(drainPolicy *core.WorkerDrainPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if drainPolicy.EvictionOrder != nil && !availablePodEvictionOrders.Has(string(*drainPolicy.EvictionOrder)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("evictionOrder"), *drainPolicy.EvictionOrder, sets.List(availablePodEvictionOrders)))
+	}
+
+	names := sets.New[string]()
+	for i, hook := range drainPolicy.PreDrainHooks {
+		idxPath := fldPath.Child("preDrainHooks").Index(i)
+
+		if len(hook.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify a hook name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(hook.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), hook.Name, msg))
+			}
+		}
+		if names.Has(hook.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), hook.Name))
+		}
+		names.Insert(hook.Name)
+
+		if u, err := url.Parse(hook.URL); err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), hook.URL, "must be a valid HTTPS URL"))
+		}
+
+		if hook.CABundle != nil {
+			if _, err := utils.DecodeCertificate([]byte(*hook.CABundle)); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("caBundle"), *hook.CABundle, "caBundle is not a valid PEM-encoded certificate"))
+			}
+		}
+
+		if hook.Timeout != nil && hook.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("timeout"), hook.Timeout.Duration.String(), "must be greater than 0"))
+		}
+
+		if hook.FailurePolicy != nil && !availablePreDrainHookFailurePolicies.Has(string(*hook.FailurePolicy)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("failurePolicy"), *hook.FailurePolicy, sets.List(availablePreDrainHookFailurePolicies)))
+		}
+	}
+
+	return allErrs
+}
+
+var (
+	sysctlNameRegex                = regexp.MustCompile(`^[a-zA-Z0-9_-]+([./][a-zA-Z0-9_-]+)*$`)
+	kernelModuleNameRegex          = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	kernelModuleParameterNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+)
+
+// ValidateSysctls validates the given kernel settings of a worker pool.
+func ValidateSysctls(sysctls map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, value := range sysctls {
+		if !sysctlNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), name, fmt.Sprintf("sysctl name must match the regex %s", sysctlNameRegex)))
+		}
+		if strings.ContainsAny(value, "\n\r") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), value, "sysctl value must not contain line breaks"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateKernelModules validates the given kernel modules of a worker pool.
+func ValidateKernelModules(modules []core.KernelModule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, module := range modules {
+		idxPath := fldPath.Index(i)
+
+		if len(module.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify a kernel module name"))
+		} else if !kernelModuleNameRegex.MatchString(module.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), module.Name, fmt.Sprintf("kernel module name must match the regex %s", kernelModuleNameRegex)))
+		}
+		if names.Has(module.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), module.Name))
+		}
+		names.Insert(module.Name)
+
+		for name, value := range module.Parameters {
+			if !kernelModuleParameterNameRegex.MatchString(name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("parameters").Key(name), name, fmt.Sprintf("kernel module parameter name must match the regex %s", kernelModuleParameterNameRegex)))
+			}
+			if len(value) == 0 || strings.ContainsAny(value, " \t\n\r") {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("parameters").Key(name), value, "kernel module parameter value must not be empty or contain whitespaces"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateHugePages validates the given huge pages configurations of a worker pool.
+func ValidateHugePages(hugePages []core.HugePages, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	pageSizes := sets.New[int64]()
+
+	for i, hp := range hugePages {
+		idxPath := fldPath.Index(i)
+
+		pageSize := hp.PageSize.Value()
+		if pageSize < 4096 || pageSize&(pageSize-1) != 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("pageSize"), hp.PageSize.String(), "page size must be a power of two and at least 4Ki"))
+		}
+		if pageSizes.Has(pageSize) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("pageSize"), hp.PageSize.String()))
+		}
+		pageSizes.Insert(pageSize)
+
+		if hp.Count < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("count"), hp.Count, "count must not be negative"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -2068,6 +2589,57 @@ func ValidateWorkers(workers []core.Worker, fldPath *field.Path) field.ErrorList
 		workerNames.Insert(worker.Name)
 	}
 
+	for i, worker := range workers {
+		for j := range worker.Machine.AlternativeTypes {
+			// Each alternative machine type is backed by a separate worker pool in the Worker extension resource, see
+			// `worker.PrioritizedWorkerPools` in the extensions library.
+			poolName := alternativeMachineTypeWorkerPoolName(worker.Name, j)
+			if workerNames.Has(poolName) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("machine", "alternativeTypes").Index(j), poolName, fmt.Sprintf("worker pool name %q of alternative machine type collides with the name of another worker pool", poolName)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func alternativeMachineTypeWorkerPoolName(workerName string, index int) string {
+	return fmt.Sprintf("%s-alt%d", workerName, index+1)
+}
+
+// validateAlternativeMachineTypesNodesNetwork validates that the nodes network has enough addresses for worker pools
+// with alternative machine types. The maximum of such a pool applies to each of its machine types individually, i.e.,
+// the pool can grow to `maximum` nodes per machine type.
+func validateAlternativeMachineTypesNodesNetwork(workers []core.Worker, networking *core.Networking, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if networking == nil || networking.Nodes == nil {
+		return allErrs
+	}
+
+	_, nodesNetwork, err := net.ParseCIDR(*networking.Nodes)
+	if err != nil {
+		// invalid CIDRs are reported by the networking validation
+		return allErrs
+	}
+
+	ones, bits := nodesNetwork.Mask.Size()
+	ipAddressesAvailable := &big.Int{}
+	ipAddressesAvailable.Exp(big.NewInt(2), big.NewInt(int64(bits-ones)), nil)
+	// first and last ips are reserved, subtract 2
+	ipAddressesAvailable.Sub(ipAddressesAvailable, big.NewInt(2))
+
+	for i, worker := range workers {
+		if len(worker.Machine.AlternativeTypes) == 0 {
+			continue
+		}
+
+		maxNodes := int64(worker.Maximum) * int64(1+len(worker.Machine.AlternativeTypes))
+		if ipAddressesAvailable.Cmp(big.NewInt(maxNodes)) < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("maximum"), worker.Maximum, fmt.Sprintf("the maximum applies to each of the %d machine types of the worker pool, but the nodes network %s only supports %d nodes instead of %d", 1+len(worker.Machine.AlternativeTypes), *networking.Nodes, ipAddressesAvailable, maxNodes)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -2300,6 +2872,34 @@ func ValidateCRI(CRI *core.CRI, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, ValidateContainerRuntimes(CRI.ContainerRuntimes, fldPath.Child("containerruntimes"))...)
 	}
 
+	if CRI.ImagePrePull != nil {
+		allErrs = append(allErrs, ValidateImagePrePull(CRI.ImagePrePull, fldPath.Child("imagePrePull"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateImagePrePull validates the given image pre-pull configuration.
+func ValidateImagePrePull(imagePrePull *core.ImagePrePull, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	images := sets.New[string]()
+
+	for i, image := range imagePrePull.Images {
+		idxPath := fldPath.Child("images").Index(i)
+
+		if len(image) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify an image reference"))
+			continue
+		}
+		if _, err := reference.ParseNormalizedNamed(image); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, image, fmt.Sprintf("must be a valid image reference: %v", err)))
+		}
+		if images.Has(image) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, image))
+		}
+		images.Insert(image)
+	}
+
 	return allErrs
 }
 
@@ -2418,7 +3018,7 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 
 	if operation != "" {
 		if !availableShootOperations.Has(operation) {
-			allErrs = append(allErrs, field.NotSupported(fldPathOp, operation, sets.List(availableShootOperations)))
+			allErrs = append(allErrs, unsupportedShootOperationError(operation, availableShootOperations, fldPathOp))
 		}
 		if helper.IsShootInHibernation(shoot) && forbiddenShootOperationsWhenHibernated.Has(operation) {
 			allErrs = append(allErrs, field.Forbidden(fldPathOp, "operation is not permitted when shoot is hibernated or is waking up"))
@@ -2431,7 +3031,7 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 
 	if maintenanceOperation != "" {
 		if !availableShootMaintenanceOperations.Has(maintenanceOperation) {
-			allErrs = append(allErrs, field.NotSupported(fldPathMaintOp, maintenanceOperation, sets.List(availableShootMaintenanceOperations)))
+			allErrs = append(allErrs, unsupportedShootOperationError(maintenanceOperation, availableShootMaintenanceOperations, fldPathMaintOp))
 		}
 		if helper.IsShootInHibernation(shoot) && forbiddenShootOperationsWhenHibernated.Has(maintenanceOperation) {
 			allErrs = append(allErrs, field.Forbidden(fldPathMaintOp, "operation is not permitted when shoot is hibernated or is waking up"))
@@ -2453,6 +3053,15 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 	return allErrs
 }
 
+// unsupportedShootOperationError returns the error for an operation which is not available. Requests to restore the main
+// etcd to a point in time are rejected explicitly because only the restore from the latest snapshot is supported.
+func unsupportedShootOperationError(operation string, availableOperations sets.Set[string], fldPath *field.Path) *field.Error {
+	if strings.HasPrefix(operation, v1beta1constants.ShootOperationRestoreETCD+"=") {
+		return field.Forbidden(fldPath, fmt.Sprintf("restoring the main etcd to a point in time is not supported, use %q to restore it from the latest snapshot in its backup", v1beta1constants.ShootOperationRestoreETCD))
+	}
+	return field.NotSupported(fldPath, operation, sets.List(availableOperations))
+}
+
 func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -2516,6 +3125,14 @@ func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath
 		if helper.GetShootETCDEncryptionKeyRotationPhase(shoot.Status.Credentials) != core.RotationPrepared {
 			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot complete ETCD encryption key rotation if .status.credentials.rotation.etcdEncryptionKey.phase is not 'Prepared'"))
 		}
+
+	case v1beta1constants.ShootOperationRestoreETCD:
+		if !isShootReadyForRotationStart(shoot.Status.LastOperation) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot restore ETCD if shoot was not yet created successfully or is not ready for reconciliation"))
+		}
+		if shoot.Status.LastETCDRestore != nil && shoot.Status.LastETCDRestore.State == core.LastOperationStateProcessing {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot restore ETCD if .status.lastETCDRestore.state is 'Processing'"))
+		}
 	}
 	return allErrs
 }
@@ -57,6 +57,16 @@ type BastionSpec struct {
 	UserData []byte `json:"userData"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress"`
+	// SessionRecording contains information about the recording of shell sessions on the bastion host. If set, the
+	// shell sessions recorded by the bastion host must be uploaded to the referenced BackupEntry.
+	// +optional
+	SessionRecording *BastionSessionRecording `json:"sessionRecording,omitempty"`
+}
+
+// BastionSessionRecording contains information about the recording of shell sessions on a bastion host.
+type BastionSessionRecording struct {
+	// BackupEntryName is the name of the BackupEntry to which the session recordings must be uploaded.
+	BackupEntryName string `json:"backupEntryName"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
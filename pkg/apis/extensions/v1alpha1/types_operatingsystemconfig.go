@@ -7,7 +7,11 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ Object = (*OperatingSystemConfig)(nil)
@@ -85,6 +89,67 @@ type OperatingSystemConfigSpec struct {
 	// +patchStrategy=merge
 	// +optional
 	Files []File `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"path"`
+	// KernelConfig contains configuration for the kernel which is applied and verified by gardener-node-agent.
+	// +optional
+	KernelConfig *KernelConfig `json:"kernelConfig,omitempty"`
+	// InPlaceUpdates contains the configuration for updating the machines in-place. It is only set for the `reconcile`
+	// purpose of worker pools whose machines are updated in-place.
+	// +optional
+	InPlaceUpdates *InPlaceUpdates `json:"inPlaceUpdates,omitempty"`
+	// DrainPolicy contains the policy which is used to drain the nodes of the worker pool, both before they are updated
+	// in-place and before their machines are replaced by machine-controller-manager. It is only set for the `reconcile`
+	// purpose.
+	// +optional
+	DrainPolicy *gardencorev1beta1.WorkerDrainPolicy `json:"drainPolicy,omitempty"`
+}
+
+// InPlaceUpdates contains the configuration for updating the machines in-place.
+type InPlaceUpdates struct {
+	// OperatingSystemVersion is the desired version of the operating system.
+	OperatingSystemVersion string `json:"operatingSystemVersion"`
+	// KubeletVersion is the desired version of the kubelet.
+	KubeletVersion string `json:"kubeletVersion"`
+	// MaxUnavailable is the maximum number of machines of the worker pool which are updated at the same time. It is used
+	// by gardener-node-agent to coordinate the updates of the machines.
+	MaxUnavailable intstr.IntOrString `json:"maxUnavailable"`
+	// MachineDrainTimeout is the maximum duration for draining the node before it is updated. Afterwards, the remaining
+	// pods are deleted forcefully. It is taken from the machine-controller-manager settings of the worker pool.
+	// +optional
+	MachineDrainTimeout *metav1.Duration `json:"machineDrainTimeout,omitempty"`
+	// MaxEvictRetries is the number of times the eviction of a pod is retried before it is deleted forcefully. It is
+	// taken from the machine-controller-manager settings of the worker pool.
+	// +optional
+	MaxEvictRetries *int32 `json:"maxEvictRetries,omitempty"`
+}
+
+// KernelConfig contains configuration for the kernel.
+type KernelConfig struct {
+	// Sysctls is a map of kernel parameters (sysctls) which are applied via the /proc/sys file system.
+	// +optional
+	Sysctls map[string]string `json:"sysctls,omitempty"`
+	// Modules is a list of kernel modules which are loaded.
+	// +optional
+	Modules []KernelModule `json:"modules,omitempty"`
+	// HugePages is a list of huge pages configurations.
+	// +optional
+	HugePages []HugePages `json:"hugePages,omitempty"`
+}
+
+// KernelModule contains configuration for a kernel module.
+type KernelModule struct {
+	// Name is the name of the kernel module.
+	Name string `json:"name"`
+	// Parameters is a map of parameters passed to the kernel module when it is loaded.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// HugePages contains configuration for huge pages of a certain size.
+type HugePages struct {
+	// PageSize is the size of the huge pages, e.g., 2Mi or 1Gi.
+	PageSize resource.Quantity `json:"pageSize"`
+	// Count is the number of huge pages of the given size which are allocated.
+	Count int32 `json:"count"`
 }
 
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
@@ -202,6 +267,26 @@ type OperatingSystemConfigStatus struct {
 	// config spec. It contains a reference to a secret as the result may contain confidential data.
 	// +optional
 	CloudConfig *CloudConfig `json:"cloudConfig,omitempty"`
+	// InPlaceUpdates contains the information required for updating the machines in-place.
+	// +optional
+	InPlaceUpdates *InPlaceUpdatesStatus `json:"inPlaceUpdates,omitempty"`
+}
+
+// InPlaceUpdatesStatus contains the information required for updating the machines in-place.
+type InPlaceUpdatesStatus struct {
+	// OSUpdate contains the command for updating the operating system to the version given in
+	// `.spec.inPlaceUpdates.operatingSystemVersion`. gardener-node-agent reboots the machine after the command succeeded.
+	// +optional
+	OSUpdate *OSUpdate `json:"osUpdate,omitempty"`
+}
+
+// OSUpdate contains the command for updating the operating system.
+type OSUpdate struct {
+	// Command is the command which updates the operating system.
+	Command string `json:"command"`
+	// Args are the arguments passed to the command.
+	// +optional
+	Args []string `json:"args,omitempty"`
 }
 
 // CloudConfig contains the generated output for the given operating system
@@ -262,6 +347,10 @@ type ContainerdConfig struct {
 	// Plugins configures the plugins section in containerd's config.toml.
 	// +optional
 	Plugins []PluginConfig `json:"plugins,omitempty"`
+	// PrePullImages is a list of image references which are pre-pulled by gardener-node-agent and pinned so that they
+	// are not removed by the image garbage collection of the kubelet.
+	// +optional
+	PrePullImages []string `json:"prePullImages,omitempty"`
 }
 
 // PluginPathOperation is a type alias for operations at containerd's plugin configuration.
@@ -327,8 +416,28 @@ type RegistryHost struct {
 	Capabilities []RegistryCapability `json:"capabilities,omitempty"`
 	// CACerts are paths to public key certificates used for TLS.
 	CACerts []string `json:"caCerts,omitempty"`
+	// CredentialsSecretName is the name of a secret in the shoot's kube-system namespace containing the credentials for
+	// this registry host. gardener-node-agent syncs the credentials to the node and configures containerd to use them.
+	// The secret may contain the keys `username` and `password` (basic authentication) or `token` (bearer token
+	// authentication), and `tls.crt` and `tls.key` (client certificate for mutual TLS).
+	// +optional
+	CredentialsSecretName *string `json:"credentialsSecretName,omitempty"`
 }
 
+const (
+	// RegistryCredentialsDataKeyUsername is the key in a registry credentials secret holding the username.
+	RegistryCredentialsDataKeyUsername = "username"
+	// RegistryCredentialsDataKeyPassword is the key in a registry credentials secret holding the password.
+	RegistryCredentialsDataKeyPassword = "password"
+	// RegistryCredentialsDataKeyToken is the key in a registry credentials secret holding the bearer token.
+	RegistryCredentialsDataKeyToken = "token"
+	// RegistryCredentialsDataKeyClientCertificate is the key in a registry credentials secret holding the client
+	// certificate.
+	RegistryCredentialsDataKeyClientCertificate = "tls.crt"
+	// RegistryCredentialsDataKeyClientKey is the key in a registry credentials secret holding the client key.
+	RegistryCredentialsDataKeyClientKey = "tls.key"
+)
+
 // CRIName is a type alias for the CRI name string.
 type CRIName string
 
@@ -164,6 +164,36 @@ type WorkerPool struct {
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty"`
+	// UpdateStrategy specifies how the machines of this worker pool are updated. If it is `AutoInPlaceUpdate`, changes
+	// of the machine image version and of the Kubernetes version must not lead to new machines since they are applied
+	// in-place by gardener-node-agent.
+	// +optional
+	UpdateStrategy *gardencorev1beta1.MachineUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// AlternativeMachineTypes is a prioritized list of machine types which are used in addition to `machineType` if
+	// there is no capacity for a machine type with a higher priority. Providers are expected to create a separate
+	// machine deployment for each alternative machine type, see `worker.PrioritizedWorkerPools`.
+	// +optional
+	AlternativeMachineTypes []AlternativeMachineType `json:"alternativeMachineTypes,omitempty"`
+}
+
+// AlternativeMachineType contains information about an alternative machine type of a worker pool.
+type AlternativeMachineType struct {
+	// MachineType contains information about the machine type that should be used.
+	MachineType string `json:"machineType"`
+	// Architecture is the CPU architecture of the machine type and machine image.
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
+	// ProviderConfig is a provider specific configuration for this machine type. If it is not set, the provider config
+	// of the worker pool is used.
+	// +kubebuilder:validation:XPreserveUnknownFields
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty"`
+	// NodeTemplate contains resource information of the machine type which is used by Cluster Autoscaler to generate
+	// nodeTemplate during scaling a nodeGroup from zero.
+	// +optional
+	NodeTemplate *NodeTemplate `json:"nodeTemplate,omitempty"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -252,4 +282,9 @@ type MachineDeployment struct {
 	Minimum int32 `json:"minimum"`
 	// Maximum is the maximum number for this machine deployment.
 	Maximum int32 `json:"maximum"`
+	// Priority is the priority of this machine deployment for the priority expander of the cluster-autoscaler. Machine
+	// deployments with higher values are preferred during scale-up. It is only set for worker pools with alternative
+	// machine types.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
 }
@@ -17,6 +17,37 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlternativeMachineType) DeepCopyInto(out *AlternativeMachineType) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeTemplate != nil {
+		in, out := &in.NodeTemplate, &out.NodeTemplate
+		*out = new(NodeTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlternativeMachineType.
+func (in *AlternativeMachineType) DeepCopy() *AlternativeMachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(AlternativeMachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucket) DeepCopyInto(out *BackupBucket) {
 	*out = *in
@@ -297,6 +328,22 @@ func (in *BastionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSessionRecording) DeepCopyInto(out *BastionSessionRecording) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSessionRecording.
+func (in *BastionSessionRecording) DeepCopy() *BastionSessionRecording {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSessionRecording)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 	*out = *in
@@ -313,6 +360,11 @@ func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SessionRecording != nil {
+		in, out := &in.SessionRecording, &out.SessionRecording
+		*out = new(BastionSessionRecording)
+		**out = **in
+	}
 	return
 }
 
@@ -641,6 +693,11 @@ func (in *ContainerdConfig) DeepCopyInto(out *ContainerdConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PrePullImages != nil {
+		in, out := &in.PrePullImages, &out.PrePullImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1198,6 +1255,71 @@ func (in *FileContentSecretRef) DeepCopy() *FileContentSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugePages) DeepCopyInto(out *HugePages) {
+	*out = *in
+	out.PageSize = in.PageSize.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugePages.
+func (in *HugePages) DeepCopy() *HugePages {
+	if in == nil {
+		return nil
+	}
+	out := new(HugePages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceUpdates) DeepCopyInto(out *InPlaceUpdates) {
+	*out = *in
+	out.MaxUnavailable = in.MaxUnavailable
+	if in.MachineDrainTimeout != nil {
+		in, out := &in.MachineDrainTimeout, &out.MachineDrainTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEvictRetries != nil {
+		in, out := &in.MaxEvictRetries, &out.MaxEvictRetries
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceUpdates.
+func (in *InPlaceUpdates) DeepCopy() *InPlaceUpdates {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceUpdates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceUpdatesStatus) DeepCopyInto(out *InPlaceUpdatesStatus) {
+	*out = *in
+	if in.OSUpdate != nil {
+		in, out := &in.OSUpdate, &out.OSUpdate
+		*out = new(OSUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceUpdatesStatus.
+func (in *InPlaceUpdatesStatus) DeepCopy() *InPlaceUpdatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceUpdatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 	*out = *in
@@ -1345,9 +1467,74 @@ func (in *InfrastructureStatusNetworking) DeepCopy() *InfrastructureStatusNetwor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelConfig) DeepCopyInto(out *KernelConfig) {
+	*out = *in
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Modules != nil {
+		in, out := &in.Modules, &out.Modules
+		*out = make([]KernelModule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HugePages != nil {
+		in, out := &in.HugePages, &out.HugePages
+		*out = make([]HugePages, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelConfig.
+func (in *KernelConfig) DeepCopy() *KernelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelModule) DeepCopyInto(out *KernelModule) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelModule.
+func (in *KernelModule) DeepCopy() *KernelModule {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeployment) DeepCopyInto(out *MachineDeployment) {
 	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1500,6 +1687,27 @@ func (in *NodeTemplate) DeepCopy() *NodeTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSUpdate) DeepCopyInto(out *OSUpdate) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSUpdate.
+func (in *OSUpdate) DeepCopy() *OSUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(OSUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatingSystemConfig) DeepCopyInto(out *OperatingSystemConfig) {
 	*out = *in
@@ -1584,6 +1792,21 @@ func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.KernelConfig != nil {
+		in, out := &in.KernelConfig, &out.KernelConfig
+		*out = new(KernelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InPlaceUpdates != nil {
+		in, out := &in.InPlaceUpdates, &out.InPlaceUpdates
+		*out = new(InPlaceUpdates)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DrainPolicy != nil {
+		in, out := &in.DrainPolicy, &out.DrainPolicy
+		*out = new(v1beta1.WorkerDrainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1620,6 +1843,11 @@ func (in *OperatingSystemConfigStatus) DeepCopyInto(out *OperatingSystemConfigSt
 		*out = new(CloudConfig)
 		**out = **in
 	}
+	if in.InPlaceUpdates != nil {
+		in, out := &in.InPlaceUpdates, &out.InPlaceUpdates
+		*out = new(InPlaceUpdatesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1710,6 +1938,11 @@ func (in *RegistryHost) DeepCopyInto(out *RegistryHost) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CredentialsSecretName != nil {
+		in, out := &in.CredentialsSecretName, &out.CredentialsSecretName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1941,6 +2174,18 @@ func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 		*out = new(ClusterAutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(v1beta1.MachineUpdateStrategy)
+		**out = **in
+	}
+	if in.AlternativeMachineTypes != nil {
+		in, out := &in.AlternativeMachineTypes, &out.AlternativeMachineTypes
+		*out = make([]AlternativeMachineType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1996,7 +2241,9 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	if in.MachineDeployments != nil {
 		in, out := &in.MachineDeployments, &out.MachineDeployments
 		*out = make([]MachineDeployment, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.MachineDeploymentsLastUpdateTime != nil {
 		in, out := &in.MachineDeploymentsLastUpdateTime, &out.MachineDeploymentsLastUpdateTime
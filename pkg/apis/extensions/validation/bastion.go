@@ -51,6 +51,10 @@ func ValidateBastionSpec(spec *extensionsv1alpha1.BastionSpec, fldPath *field.Pa
 		allErrs = append(allErrs, field.Required(fldPath.Child("ingress"), "field is required"))
 	}
 
+	if spec.SessionRecording != nil && len(spec.SessionRecording.BackupEntryName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sessionRecording", "backupEntryName"), "field is required"))
+	}
+
 	return allErrs
 }
 
@@ -67,6 +71,7 @@ func ValidateBastionSpecUpdate(new, old *extensionsv1alpha1.BastionSpec, deletio
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Type, old.Type, fldPath.Child("type"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.UserData, old.UserData, fldPath.Child("userData"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.SessionRecording, old.SessionRecording, fldPath.Child("sessionRecording"))...)
 
 	return allErrs
 }
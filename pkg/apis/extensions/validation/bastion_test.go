@@ -68,6 +68,17 @@ var _ = Describe("Bastion validation tests", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid session recordings without BackupEntry name", func() {
+			bastion.Spec.SessionRecording = &extensionsv1alpha1.BastionSessionRecording{}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.sessionRecording.backupEntryName"),
+			}))))
+		})
 	})
 
 	Describe("#ValidBastionUpdate", func() {
@@ -104,6 +115,18 @@ var _ = Describe("Bastion validation tests", func() {
 			}))))
 		})
 
+		It("should prevent updating the session recording", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.SessionRecording = &extensionsv1alpha1.BastionSessionRecording{BackupEntryName: "foo"}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.sessionRecording"),
+			}))))
+		})
+
 		It("should allow updating the ingress", func() {
 			newBastion := prepareBastionForUpdate(bastion)
 			newBastion.Spec.Ingress[0].IPBlock.CIDR = "8.8.8.8/8"
@@ -15,6 +15,7 @@ import (
 	"github.com/go-test/deep"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -70,6 +71,108 @@ func ValidateOperatingSystemConfigSpec(spec *extensionsv1alpha1.OperatingSystemC
 	allErrs = append(allErrs, ValidateCRIConfig(spec.CRIConfig, spec.Purpose, fldPath.Child("criConfig"))...)
 	allErrs = append(allErrs, ValidateUnits(spec.Units, pathsFromFiles, fldPath.Child("units"))...)
 	allErrs = append(allErrs, ValidateFiles(spec.Files, fldPath.Child("files"))...)
+	allErrs = append(allErrs, ValidateKernelConfig(spec.KernelConfig, spec.Purpose, fldPath.Child("kernelConfig"))...)
+	allErrs = append(allErrs, ValidateInPlaceUpdates(spec.InPlaceUpdates, spec.Purpose, fldPath.Child("inPlaceUpdates"))...)
+
+	return allErrs
+}
+
+// ValidateInPlaceUpdates validates the spec of an InPlaceUpdates object.
+func ValidateInPlaceUpdates(inPlaceUpdates *extensionsv1alpha1.InPlaceUpdates, purpose extensionsv1alpha1.OperatingSystemConfigPurpose, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if inPlaceUpdates == nil {
+		return allErrs
+	}
+
+	if purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "in-place updates are not allowed for OperatingSystemConfig with purpose 'provision'"))
+		return allErrs
+	}
+
+	if len(inPlaceUpdates.OperatingSystemVersion) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("operatingSystemVersion"), "field is required"))
+	}
+	if len(inPlaceUpdates.KubeletVersion) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kubeletVersion"), "field is required"))
+	}
+
+	if maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(&inPlaceUpdates.MaxUnavailable, 100, true); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), inPlaceUpdates.MaxUnavailable.String(), err.Error()))
+	} else if maxUnavailable <= 0 || (inPlaceUpdates.MaxUnavailable.Type == intstr.String && maxUnavailable > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), inPlaceUpdates.MaxUnavailable.String(), "must be greater than 0 and not more than 100%"))
+	}
+
+	return allErrs
+}
+
+var (
+	sysctlNameRegex                = regexp.MustCompile(`^[a-zA-Z0-9_-]+([./][a-zA-Z0-9_-]+)*$`)
+	kernelModuleNameRegex          = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	kernelModuleParameterNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+)
+
+// ValidateKernelConfig validates the spec of a KernelConfig object.
+func ValidateKernelConfig(config *extensionsv1alpha1.KernelConfig, purpose extensionsv1alpha1.OperatingSystemConfigPurpose, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "kernel config is not allowed for OperatingSystemConfig with purpose 'provision'"))
+		return allErrs
+	}
+
+	for name, value := range config.Sysctls {
+		if !sysctlNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(name), name, fmt.Sprintf("must match the regex %s", sysctlNameRegex)))
+		}
+		if strings.ContainsAny(value, "\n\r") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(name), value, "must not contain line breaks"))
+		}
+	}
+
+	moduleNames := sets.New[string]()
+	for i, module := range config.Modules {
+		idxPath := fldPath.Child("modules").Index(i)
+
+		if !kernelModuleNameRegex.MatchString(module.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), module.Name, fmt.Sprintf("must match the regex %s", kernelModuleNameRegex)))
+		}
+		if moduleNames.Has(module.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), module.Name))
+		}
+		moduleNames.Insert(module.Name)
+
+		for name, value := range module.Parameters {
+			if !kernelModuleParameterNameRegex.MatchString(name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("parameters").Key(name), name, fmt.Sprintf("must match the regex %s", kernelModuleParameterNameRegex)))
+			}
+			if len(value) == 0 || strings.ContainsAny(value, " \t\n\r") {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("parameters").Key(name), value, "must not be empty or contain whitespaces"))
+			}
+		}
+	}
+
+	pageSizes := sets.New[int64]()
+	for i, hp := range config.HugePages {
+		idxPath := fldPath.Child("hugePages").Index(i)
+
+		pageSize := hp.PageSize.Value()
+		if pageSize < 4096 || pageSize&(pageSize-1) != 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("pageSize"), hp.PageSize.String(), "must be a power of two and at least 4Ki"))
+		}
+		if pageSizes.Has(pageSize) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("pageSize"), hp.PageSize.String()))
+		}
+		pageSizes.Insert(pageSize)
+
+		if hp.Count < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("count"), hp.Count, "must not be negative"))
+		}
+	}
 
 	return allErrs
 }
@@ -81,6 +184,10 @@ func ValidateOperatingSystemConfigStatus(status *extensionsv1alpha1.OperatingSys
 	allErrs = append(allErrs, ValidateUnits(status.ExtensionUnits, pathsFromFiles, fldPath.Child("extensionUnits"))...)
 	allErrs = append(allErrs, ValidateFiles(status.ExtensionFiles, fldPath.Child("extensionFiles"))...)
 
+	if status.InPlaceUpdates != nil && status.InPlaceUpdates.OSUpdate != nil && len(status.InPlaceUpdates.OSUpdate.Command) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("inPlaceUpdates", "osUpdate", "command"), "field is required"))
+	}
+
 	return allErrs
 }
 
@@ -122,6 +229,7 @@ func ValidateContainerdConfig(config *extensionsv1alpha1.ContainerdConfig, purpo
 
 	allErrs = append(allErrs, validateContainerdRegistryConfigs(config.Registries, fldPath.Child("registries"))...)
 	allErrs = append(allErrs, validateContainerdPluginConfigs(config, fldPath.Child("plugins"))...)
+	allErrs = append(allErrs, validateContainerdPrePullImages(config.PrePullImages, fldPath.Child("prePullImages"))...)
 
 	return allErrs
 }
@@ -202,6 +310,12 @@ func validateContainerdRegistryConfigs(registries []extensionsv1alpha1.RegistryC
 					allErrs = append(allErrs, field.NotSupported(fldHost.Child("capabilities").Index(k), capability, []string{"push", "pull", "resolve"}))
 				}
 			}
+
+			if host.CredentialsSecretName != nil {
+				for _, msg := range apivalidation.NameIsDNSSubdomain(*host.CredentialsSecretName, false) {
+					allErrs = append(allErrs, field.Invalid(fldHost.Child("credentialsSecretName"), *host.CredentialsSecretName, msg))
+				}
+			}
 		}
 	}
 
@@ -243,6 +357,26 @@ func validateContainerdPluginConfigs(config *extensionsv1alpha1.ContainerdConfig
 	return allErrs
 }
 
+func validateContainerdPrePullImages(images []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[string]()
+
+	for i, image := range images {
+		idxPath := fldPath.Index(i)
+
+		if len(image) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide an image reference"))
+			continue
+		}
+		if seen.Has(image) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, image))
+		}
+		seen.Insert(image)
+	}
+
+	return allErrs
+}
+
 // ValidateUnits validates operating system config units.
 func ValidateUnits(units []extensionsv1alpha1.Unit, pathsFromFiles sets.Set[string], fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -9,8 +9,10 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -473,6 +475,30 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should forbid containerd with invalid hosts credentials secret name", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.CRIConfig.Containerd.Registries = []extensionsv1alpha1.RegistryConfig{
+				{
+					Upstream: "foo.bar",
+					Hosts: []extensionsv1alpha1.RegistryHost{
+						{
+							URL:                   "http://foo.bar/us",
+							CredentialsSecretName: ptr.To("Invalid_Name"),
+						},
+						{
+							URL:                   "http://bar.foo/us",
+							CredentialsSecretName: ptr.To("registry-credentials"),
+						},
+					},
+				},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.criConfig.containerd.registries[0].hosts[0].credentialsSecretName"),
+			}))))
+		})
+
 		It("should forbid containerd with empty sandbox image", func() {
 			oscCopy := osc.DeepCopy()
 			oscCopy.Spec.CRIConfig.Containerd.SandboxImage = ""
@@ -561,6 +587,128 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should forbid OperatingSystemConfig with empty or duplicate images to pre-pull", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.CRIConfig.Containerd.PrePullImages = []string{"foo:v1", "", "foo:v1"}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.criConfig.containerd.prePullImages[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.criConfig.containerd.prePullImages[2]"),
+				})),
+			))
+		})
+
+		It("should allow a valid kernel config", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.KernelConfig = &extensionsv1alpha1.KernelConfig{
+				Sysctls:   map[string]string{"net.ipv4.ip_forward": "1"},
+				Modules:   []extensionsv1alpha1.KernelModule{{Name: "nf_conntrack", Parameters: map[string]string{"hashsize": "131072"}}},
+				HugePages: []extensionsv1alpha1.HugePages{{PageSize: resource.MustParse("2Mi"), Count: 512}},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid kernel config", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.KernelConfig = &extensionsv1alpha1.KernelConfig{
+				Sysctls:   map[string]string{"../foo": "1"},
+				Modules:   []extensionsv1alpha1.KernelModule{{Name: "foo", Parameters: map[string]string{"bar": "a b"}}, {Name: "foo"}},
+				HugePages: []extensionsv1alpha1.HugePages{{PageSize: resource.MustParse("1000"), Count: -1}},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kernelConfig.sysctls[../foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kernelConfig.modules[0].parameters[bar]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.kernelConfig.modules[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kernelConfig.hugePages[0].pageSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kernelConfig.hugePages[0].count"),
+				})),
+			))
+		})
+
+		It("should forbid a kernel config for purpose 'provision'", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.CRIConfig.Containerd = nil
+			oscCopy.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeProvision
+			oscCopy.Spec.KernelConfig = &extensionsv1alpha1.KernelConfig{}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kernelConfig"),
+			}))))
+		})
+
+		It("should allow valid in-place updates", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+				OperatingSystemVersion: "1.2.3",
+				KubeletVersion:         "1.31.1",
+				MaxUnavailable:         intstr.FromString("25%"),
+			}
+			oscCopy.Status.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdatesStatus{
+				OSUpdate: &extensionsv1alpha1.OSUpdate{Command: "/usr/bin/os-update", Args: []string{"--version", "1.2.3"}},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
+		It("should forbid invalid in-place updates", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{MaxUnavailable: intstr.FromInt32(0)}
+			oscCopy.Status.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdatesStatus{OSUpdate: &extensionsv1alpha1.OSUpdate{}}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.inPlaceUpdates.operatingSystemVersion"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.inPlaceUpdates.kubeletVersion"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.inPlaceUpdates.maxUnavailable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.inPlaceUpdates.osUpdate.command"),
+				})),
+			))
+		})
+
+		It("should forbid in-place updates for purpose 'provision'", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.CRIConfig.Containerd = nil
+			oscCopy.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeProvision
+			oscCopy.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.inPlaceUpdates"),
+			}))))
+		})
+
 		It("should allow valid osc resources", func() {
 			errorList := ValidateOperatingSystemConfig(osc)
 
@@ -50,6 +50,28 @@ type BastionSpec struct {
 	SSHPublicKey string
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy
+	// MaxDuration is the maximum duration after which the Bastion is deleted, independent of its heartbeats. It cannot
+	// extend the maximum lifetime of Bastions configured by the Gardener operator.
+	MaxDuration *metav1.Duration
+	// SessionRecording configures the recording of shell sessions on the bastion host. This field is immutable.
+	SessionRecording *BastionSessionRecording
+	// Approval contains information about the approval of the Bastion. If set, the bastion host is only created after
+	// the Bastion has been approved.
+	Approval *BastionApproval
+}
+
+// BastionSessionRecording configures the recording of shell sessions on a bastion host.
+type BastionSessionRecording struct {
+	// Enabled specifies whether shell sessions on the bastion host are recorded. Recordings are stored in the backup
+	// bucket of the seed the Bastion is scheduled to.
+	Enabled bool
+}
+
+// BastionApproval contains information about the approval of a Bastion.
+type BastionApproval struct {
+	// ApprovedBy is the name of the user who approved the Bastion. It must not be the user who created the Bastion.
+	// This field is immutable once set.
+	ApprovedBy *string
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
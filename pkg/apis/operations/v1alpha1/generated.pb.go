@@ -62,10 +62,38 @@ func (m *Bastion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Bastion proto.InternalMessageInfo
 
+func (m *BastionApproval) Reset()      { *m = BastionApproval{} }
+func (*BastionApproval) ProtoMessage() {}
+func (*BastionApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{1}
+}
+func (m *BastionApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionApproval.Merge(m, src)
+}
+func (m *BastionApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionApproval proto.InternalMessageInfo
+
 func (m *BastionIngressPolicy) Reset()      { *m = BastionIngressPolicy{} }
 func (*BastionIngressPolicy) ProtoMessage() {}
 func (*BastionIngressPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{1}
+	return fileDescriptor_a8b335fad1255a79, []int{2}
 }
 func (m *BastionIngressPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -93,7 +121,7 @@ var xxx_messageInfo_BastionIngressPolicy proto.InternalMessageInfo
 func (m *BastionList) Reset()      { *m = BastionList{} }
 func (*BastionList) ProtoMessage() {}
 func (*BastionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{2}
+	return fileDescriptor_a8b335fad1255a79, []int{3}
 }
 func (m *BastionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -118,10 +146,38 @@ func (m *BastionList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BastionList proto.InternalMessageInfo
 
+func (m *BastionSessionRecording) Reset()      { *m = BastionSessionRecording{} }
+func (*BastionSessionRecording) ProtoMessage() {}
+func (*BastionSessionRecording) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{4}
+}
+func (m *BastionSessionRecording) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionSessionRecording) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionSessionRecording) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionSessionRecording.Merge(m, src)
+}
+func (m *BastionSessionRecording) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionSessionRecording) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionSessionRecording.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionSessionRecording proto.InternalMessageInfo
+
 func (m *BastionSpec) Reset()      { *m = BastionSpec{} }
 func (*BastionSpec) ProtoMessage() {}
 func (*BastionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{3}
+	return fileDescriptor_a8b335fad1255a79, []int{5}
 }
 func (m *BastionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +205,7 @@ var xxx_messageInfo_BastionSpec proto.InternalMessageInfo
 func (m *BastionStatus) Reset()      { *m = BastionStatus{} }
 func (*BastionStatus) ProtoMessage() {}
 func (*BastionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{4}
+	return fileDescriptor_a8b335fad1255a79, []int{6}
 }
 func (m *BastionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,8 +232,10 @@ var xxx_messageInfo_BastionStatus proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Bastion)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.Bastion")
+	proto.RegisterType((*BastionApproval)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionApproval")
 	proto.RegisterType((*BastionIngressPolicy)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionIngressPolicy")
 	proto.RegisterType((*BastionList)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionList")
+	proto.RegisterType((*BastionSessionRecording)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSessionRecording")
 	proto.RegisterType((*BastionSpec)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSpec")
 	proto.RegisterType((*BastionStatus)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionStatus")
 }
@@ -187,57 +245,66 @@ func init() {
 }
 
 var fileDescriptor_a8b335fad1255a79 = []byte{
-	// 799 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xe3, 0xdd, 0x6c, 0x77, 0x3b, 0x9b, 0x42, 0x35, 0xad, 0x96, 0x68, 0x0f, 0xce, 0x92,
-	0x0b, 0x11, 0x12, 0x63, 0x52, 0x55, 0xa8, 0x3d, 0x70, 0x19, 0x44, 0xd9, 0x88, 0xa5, 0x1b, 0x4d,
-	0x2a, 0x0e, 0x08, 0x09, 0x26, 0xf6, 0x5b, 0x67, 0x48, 0xec, 0x31, 0x9e, 0x49, 0x20, 0x1c, 0x10,
-	0x1f, 0x01, 0x3e, 0x15, 0x7b, 0xec, 0x81, 0x43, 0x4f, 0x11, 0x6b, 0x3e, 0x06, 0x17, 0xe4, 0xf1,
-	0x24, 0x76, 0x48, 0x2a, 0x42, 0xdb, 0xdb, 0xcc, 0x9b, 0xf7, 0x7e, 0xff, 0x37, 0xef, 0x3d, 0x8f,
-	0x8c, 0x7a, 0xa1, 0xd0, 0xa3, 0xe9, 0x90, 0xf8, 0x32, 0xf2, 0x42, 0x9e, 0x06, 0x10, 0x43, 0x5a,
-	0x2e, 0x92, 0x71, 0xe8, 0xf1, 0x44, 0x28, 0x4f, 0x26, 0x90, 0x72, 0x2d, 0x64, 0xac, 0xbc, 0x59,
-	0x97, 0x4f, 0x92, 0x11, 0xef, 0x7a, 0x61, 0xee, 0xc2, 0x35, 0x04, 0x24, 0x49, 0xa5, 0x96, 0xf8,
-	0x71, 0x89, 0x22, 0x4b, 0x42, 0xb9, 0x48, 0xc6, 0x21, 0xc9, 0x51, 0xa4, 0x44, 0x91, 0x25, 0xea,
-	0x94, 0xee, 0x96, 0x85, 0x2f, 0x53, 0xf0, 0x66, 0xdd, 0x21, 0xe8, 0x4d, 0xf9, 0xd3, 0x0f, 0xaa,
-	0x0c, 0x19, 0x4a, 0xcf, 0x98, 0x87, 0xd3, 0x2b, 0xb3, 0x33, 0x1b, 0xb3, 0xb2, 0xee, 0xed, 0xf1,
-	0x23, 0x45, 0x84, 0xcc, 0xc1, 0x4b, 0xee, 0x06, 0xb2, 0x53, 0xf1, 0x89, 0x41, 0xff, 0x20, 0xd3,
-	0xb1, 0x88, 0xc3, 0x6d, 0x9e, 0x0f, 0x4b, 0xcf, 0x88, 0xfb, 0x23, 0x11, 0x43, 0x3a, 0x2f, 0xf3,
-	0x8e, 0x40, 0xf3, 0x6d, 0x51, 0xde, 0xcb, 0xa2, 0xd2, 0x69, 0xac, 0x45, 0x04, 0x1b, 0x01, 0x1f,
-	0xfd, 0x57, 0x80, 0xf2, 0x47, 0x10, 0xf1, 0x7f, 0xc7, 0xb5, 0x7f, 0xdf, 0x43, 0x87, 0x94, 0xab,
-	0xbc, 0xea, 0xf8, 0x5b, 0x74, 0x94, 0xe7, 0x13, 0x70, 0xcd, 0x9b, 0xce, 0x99, 0xd3, 0x39, 0x7e,
-	0xf0, 0x21, 0x29, 0xb0, 0xa4, 0x8a, 0x2d, 0x1b, 0x96, 0x7b, 0x93, 0x59, 0x97, 0x5c, 0x0e, 0xbf,
-	0x03, 0x5f, 0x7f, 0x01, 0x9a, 0x53, 0x7c, 0xbd, 0x68, 0xd5, 0xb2, 0x45, 0x0b, 0x95, 0x36, 0xb6,
-	0xa2, 0xe2, 0x11, 0xaa, 0xab, 0x04, 0xfc, 0xe6, 0x9e, 0xa1, 0x3f, 0x21, 0xaf, 0x3c, 0x17, 0xc4,
-	0xe6, 0x3c, 0x48, 0xc0, 0xa7, 0x0d, 0xab, 0x59, 0xcf, 0x77, 0xcc, 0x28, 0xe0, 0x04, 0xdd, 0x52,
-	0x9a, 0xeb, 0xa9, 0x6a, 0xee, 0x1b, 0xad, 0xf3, 0x37, 0xa0, 0x65, 0x78, 0xf4, 0x2d, 0xab, 0x76,
-	0xab, 0xd8, 0x33, 0xab, 0xd3, 0x0e, 0xd0, 0x7d, 0xeb, 0xd8, 0x8b, 0xc3, 0x14, 0x94, 0xea, 0xcb,
-	0x89, 0xf0, 0xe7, 0xf8, 0x02, 0x1d, 0x8a, 0x84, 0x4e, 0xa4, 0x3f, 0xb6, 0x45, 0x7d, 0xb7, 0x52,
-	0x54, 0x52, 0x0e, 0x4f, 0x5e, 0xc8, 0x5e, 0xdf, 0x38, 0xd2, 0xb7, 0xad, 0xc6, 0xa1, 0x35, 0xb0,
-	0x25, 0xa2, 0xfd, 0x87, 0x83, 0x8e, 0xad, 0xcc, 0x85, 0x50, 0x1a, 0x7f, 0xbd, 0xd1, 0x33, 0xb2,
-	0x5b, 0xcf, 0xf2, 0x68, 0xd3, 0xb1, 0xbb, 0x56, 0xeb, 0x68, 0x69, 0xa9, 0xf4, 0x2b, 0x44, 0x07,
-	0x42, 0x43, 0xa4, 0x9a, 0x7b, 0x67, 0xfb, 0x9d, 0xe3, 0x07, 0xf4, 0xf5, 0x8b, 0x48, 0xef, 0x58,
-	0xb9, 0x83, 0x5e, 0x0e, 0x66, 0x05, 0xbf, 0xfd, 0xf7, 0xde, 0xea, 0x5a, 0x79, 0x13, 0xf1, 0x97,
-	0xe8, 0x48, 0x8d, 0xa4, 0xd4, 0x0c, 0xae, 0xec, 0xb5, 0x3a, 0xd5, 0xaa, 0xe5, 0x9f, 0xa5, 0xb9,
-	0x84, 0xf4, 0xf9, 0xa4, 0x98, 0x34, 0x06, 0x57, 0x90, 0x42, 0xec, 0x43, 0x79, 0xa1, 0x81, 0x25,
-	0xb0, 0x15, 0x0b, 0x77, 0xd0, 0x91, 0x02, 0x08, 0x9e, 0xf2, 0x08, 0xcc, 0x10, 0xde, 0xa6, 0x0d,
-	0xe3, 0x69, 0x6d, 0x6c, 0x75, 0x8a, 0x1f, 0xa2, 0x46, 0x92, 0xca, 0x99, 0x08, 0x20, 0x7d, 0x36,
-	0x4f, 0xc0, 0x8c, 0xd1, 0x6d, 0x7a, 0x37, 0x5b, 0xb4, 0x1a, 0xfd, 0x8a, 0x9d, 0xad, 0x79, 0xe1,
-	0x47, 0xa8, 0xa1, 0xd4, 0xa8, 0x3f, 0x1d, 0x4e, 0x84, 0xff, 0x39, 0xcc, 0x9b, 0x75, 0x13, 0x75,
-	0xdf, 0x66, 0xd4, 0x18, 0x0c, 0xce, 0x57, 0x67, 0x6c, 0xcd, 0x13, 0xff, 0x84, 0x0e, 0x45, 0x31,
-	0x37, 0xcd, 0x03, 0x53, 0xec, 0xcb, 0xd7, 0x2f, 0xf6, 0xda, 0x20, 0x56, 0x86, 0xaa, 0x30, 0xb3,
-	0xa5, 0x60, 0xfb, 0xb7, 0x3a, 0xba, 0xb3, 0x36, 0xe4, 0xf8, 0x69, 0x99, 0x4d, 0x51, 0xfe, 0xf7,
-	0xb6, 0x97, 0x9f, 0x07, 0x94, 0x4f, 0x78, 0xec, 0x43, 0x6a, 0xa1, 0xf4, 0x78, 0x9b, 0x02, 0xfe,
-	0x1e, 0x21, 0x5f, 0xc6, 0x81, 0x30, 0x79, 0xda, 0x69, 0xfa, 0x78, 0xc7, 0x0b, 0x5a, 0x35, 0xf3,
-	0xb6, 0x93, 0x4f, 0x96, 0x94, 0xf2, 0xa5, 0x59, 0x99, 0x14, 0xab, 0x88, 0xe0, 0x9f, 0xd1, 0xc9,
-	0x84, 0x2b, 0x7d, 0x0e, 0x3c, 0xd5, 0x43, 0xe0, 0xfa, 0x99, 0x88, 0x40, 0x69, 0x1e, 0x25, 0xf6,
-	0x45, 0x78, 0x7f, 0xb7, 0xef, 0x24, 0x0f, 0xa3, 0xa7, 0xd9, 0xa2, 0x75, 0x72, 0xb1, 0x95, 0xc6,
-	0x5e, 0xa2, 0x82, 0xa7, 0xe8, 0x1e, 0xfc, 0x98, 0x88, 0xa2, 0x37, 0xa5, 0x78, 0xfd, 0x7f, 0x8b,
-	0xbf, 0x93, 0x2d, 0x5a, 0xf7, 0x3e, 0xdd, 0x44, 0xb1, 0x6d, 0x7c, 0xfc, 0x04, 0x61, 0x39, 0x54,
-	0x90, 0xce, 0x20, 0xf8, 0xac, 0x78, 0xeb, 0x85, 0x8c, 0x9b, 0x07, 0x67, 0x4e, 0x67, 0x9f, 0x9e,
-	0x64, 0x8b, 0x16, 0xbe, 0xdc, 0x38, 0x65, 0x5b, 0x22, 0xe8, 0x37, 0xd7, 0x37, 0x6e, 0xed, 0xf9,
-	0x8d, 0x5b, 0x7b, 0x71, 0xe3, 0xd6, 0x7e, 0xc9, 0x5c, 0xe7, 0x3a, 0x73, 0x9d, 0xe7, 0x99, 0xeb,
-	0xbc, 0xc8, 0x5c, 0xe7, 0xcf, 0xcc, 0x75, 0x7e, 0xfd, 0xcb, 0xad, 0x7d, 0xf5, 0xf8, 0x95, 0x7f,
-	0x12, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xea, 0x1f, 0x49, 0x60, 0x08, 0x00, 0x00,
+	// 942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x76, 0xec, 0x8c, 0xdd, 0x26, 0x9a, 0x46, 0xa9, 0x95, 0x83, 0x1d, 0x7c, 0xc1,
+	0x20, 0xb1, 0x26, 0x55, 0x85, 0xda, 0x03, 0x87, 0x0c, 0x6d, 0x49, 0x20, 0x6d, 0xa2, 0x49, 0xc5,
+	0x01, 0x21, 0xc1, 0x78, 0xf7, 0x65, 0x3d, 0xd8, 0xbb, 0xb3, 0xec, 0x8c, 0x4d, 0xcd, 0x01, 0xf1,
+	0x13, 0x40, 0xe2, 0x3f, 0x91, 0x63, 0x0f, 0x1c, 0x7a, 0xb2, 0xc8, 0x72, 0xe4, 0x4f, 0xa0, 0x9d,
+	0x9d, 0xf5, 0x6e, 0x62, 0x57, 0x4d, 0x9b, 0xde, 0x66, 0xde, 0xbc, 0xf7, 0x7d, 0xcf, 0xef, 0xfb,
+	0x76, 0xc6, 0xe8, 0xd0, 0xe3, 0x6a, 0x30, 0xee, 0xdb, 0x8e, 0xf0, 0x7b, 0x1e, 0x8b, 0x5c, 0x08,
+	0x20, 0xca, 0x17, 0xe1, 0xd0, 0xeb, 0xb1, 0x90, 0xcb, 0x9e, 0x08, 0x21, 0x62, 0x8a, 0x8b, 0x40,
+	0xf6, 0x26, 0x7b, 0x6c, 0x14, 0x0e, 0xd8, 0x5e, 0xcf, 0x4b, 0x52, 0x98, 0x02, 0xd7, 0x0e, 0x23,
+	0xa1, 0x04, 0x7e, 0x98, 0x43, 0xd9, 0x19, 0x42, 0xbe, 0x08, 0x87, 0x9e, 0x9d, 0x40, 0xd9, 0x39,
+	0x94, 0x9d, 0x41, 0xed, 0x90, 0xeb, 0x75, 0xe1, 0x88, 0x08, 0x7a, 0x93, 0xbd, 0x3e, 0xa8, 0x45,
+	0xfa, 0x9d, 0x4f, 0x8a, 0x18, 0xc2, 0x13, 0x3d, 0x1d, 0xee, 0x8f, 0xcf, 0xf4, 0x4e, 0x6f, 0xf4,
+	0xca, 0xa4, 0x77, 0x86, 0x0f, 0xa4, 0xcd, 0x45, 0x02, 0x9c, 0xe1, 0x2e, 0x40, 0x76, 0x0b, 0x39,
+	0x01, 0xa8, 0x9f, 0x45, 0x34, 0xe4, 0x81, 0xb7, 0x2c, 0xf3, 0x7e, 0x9e, 0xe9, 0x33, 0x67, 0xc0,
+	0x03, 0x88, 0xa6, 0x79, 0xdf, 0x3e, 0x28, 0xb6, 0xac, 0xaa, 0xf7, 0xba, 0xaa, 0x68, 0x1c, 0x28,
+	0xee, 0xc3, 0x42, 0xc1, 0x67, 0x6f, 0x2a, 0x90, 0xce, 0x00, 0x7c, 0x76, 0xb5, 0xae, 0xf3, 0xd7,
+	0x0a, 0xaa, 0x12, 0x26, 0x93, 0xa9, 0xe3, 0x1f, 0x50, 0x2d, 0xe9, 0xc7, 0x65, 0x8a, 0x35, 0xad,
+	0x5d, 0xab, 0x5b, 0xbf, 0xf7, 0xa9, 0x9d, 0xc2, 0xda, 0x45, 0xd8, 0x5c, 0xb0, 0x24, 0xdb, 0x9e,
+	0xec, 0xd9, 0xc7, 0xfd, 0x1f, 0xc1, 0x51, 0x4f, 0x41, 0x31, 0x82, 0xcf, 0x67, 0xed, 0x52, 0x3c,
+	0x6b, 0xa3, 0x3c, 0x46, 0xe7, 0xa8, 0x78, 0x80, 0xca, 0x32, 0x04, 0xa7, 0xb9, 0xa2, 0xd1, 0x9f,
+	0xd8, 0xef, 0xec, 0x0b, 0xdb, 0xf4, 0x7c, 0x1a, 0x82, 0x43, 0x1a, 0x86, 0xb3, 0x9c, 0xec, 0xa8,
+	0x66, 0xc0, 0x21, 0x5a, 0x93, 0x8a, 0xa9, 0xb1, 0x6c, 0xae, 0x6a, 0xae, 0x83, 0xf7, 0xc0, 0xa5,
+	0xf1, 0xc8, 0x6d, 0xc3, 0xb6, 0x96, 0xee, 0xa9, 0xe1, 0xe9, 0xec, 0xa3, 0x0d, 0x93, 0xb8, 0x1f,
+	0x86, 0x91, 0x98, 0xb0, 0x11, 0xb6, 0x11, 0x62, 0x7a, 0x0d, 0x2e, 0x99, 0xea, 0x91, 0xae, 0x93,
+	0xdb, 0xc9, 0x70, 0xf6, 0xe7, 0x51, 0x5a, 0xc8, 0xe8, 0xb8, 0x68, 0xcb, 0x40, 0x1c, 0x06, 0x5e,
+	0x04, 0x52, 0x9e, 0x88, 0x11, 0x77, 0xa6, 0xf8, 0x08, 0x55, 0x79, 0x48, 0x46, 0xc2, 0x19, 0x1a,
+	0x5d, 0x3e, 0x28, 0xe8, 0x62, 0xe7, 0xfe, 0x4b, 0xb4, 0x38, 0x3c, 0xd1, 0x89, 0x64, 0xc3, 0xb4,
+	0x59, 0x35, 0x01, 0x9a, 0x41, 0x74, 0xfe, 0xb6, 0x50, 0xdd, 0xd0, 0x1c, 0x71, 0xa9, 0xf0, 0x77,
+	0x0b, 0xb2, 0xdb, 0xd7, 0x93, 0x3d, 0xa9, 0xd6, 0xa2, 0x6f, 0x1a, 0xae, 0x5a, 0x16, 0x29, 0x48,
+	0xee, 0xa1, 0x0a, 0x57, 0xe0, 0xcb, 0xe6, 0xca, 0xee, 0x6a, 0xb7, 0x7e, 0x8f, 0xdc, 0x5c, 0x07,
+	0x72, 0xcb, 0xd0, 0x55, 0x0e, 0x13, 0x60, 0x9a, 0xe2, 0x77, 0x1e, 0xa1, 0xbb, 0x99, 0x50, 0x20,
+	0x25, 0x17, 0x01, 0x05, 0x47, 0x44, 0x2e, 0x0f, 0x3c, 0xfc, 0x11, 0xaa, 0x42, 0xc0, 0xfa, 0x23,
+	0x70, 0xf5, 0x0f, 0xac, 0xe5, 0xc3, 0x79, 0x9c, 0x86, 0x69, 0x76, 0xde, 0xf9, 0xaf, 0x32, 0x1f,
+	0x4e, 0xe2, 0x26, 0xfc, 0x0d, 0xaa, 0xc9, 0x81, 0x10, 0x8a, 0xc2, 0x99, 0x19, 0x4e, 0xb7, 0x38,
+	0xfb, 0xe4, 0x7e, 0xd0, 0xa3, 0x10, 0x0e, 0x1b, 0xa5, 0x96, 0xa7, 0x70, 0x06, 0x11, 0x04, 0x0e,
+	0xe4, 0x63, 0x39, 0x35, 0x08, 0x74, 0x8e, 0x85, 0xbb, 0xa8, 0x26, 0x01, 0xdc, 0x67, 0xcc, 0x07,
+	0xfd, 0x35, 0xac, 0x93, 0x86, 0xce, 0x34, 0x31, 0x3a, 0x3f, 0xc5, 0xf7, 0x51, 0x23, 0x31, 0x08,
+	0x77, 0x21, 0x7a, 0x3e, 0x0d, 0x41, 0xfb, 0x79, 0x9d, 0x6c, 0xc6, 0xb3, 0x76, 0xe3, 0xa4, 0x10,
+	0xa7, 0x97, 0xb2, 0xf0, 0x03, 0xd4, 0x90, 0x72, 0x70, 0x32, 0xee, 0x8f, 0xb8, 0xf3, 0x35, 0x4c,
+	0x9b, 0x65, 0x5d, 0xb5, 0x65, 0x3a, 0x6a, 0x9c, 0x9e, 0x1e, 0xcc, 0xcf, 0xe8, 0xa5, 0x4c, 0xfc,
+	0x0b, 0xaa, 0xf2, 0xd4, 0x7d, 0xcd, 0x8a, 0x96, 0xec, 0xf8, 0xe6, 0x92, 0x5d, 0xb2, 0x73, 0xc1,
+	0x9a, 0x69, 0x98, 0x66, 0x84, 0x98, 0xa1, 0xba, 0xcf, 0x5e, 0x3c, 0x1a, 0xa7, 0x38, 0xcd, 0xb5,
+	0xb7, 0x71, 0x63, 0x56, 0x45, 0x36, 0xe2, 0x59, 0xbb, 0xfe, 0x34, 0x87, 0xa1, 0x45, 0x4c, 0xfc,
+	0xa7, 0x85, 0x36, 0xe5, 0x15, 0x83, 0x34, 0xab, 0x9a, 0x88, 0xbe, 0x87, 0x3b, 0xe2, 0x0a, 0x32,
+	0xd9, 0x8a, 0x67, 0xed, 0xcd, 0xab, 0x51, 0xba, 0xd0, 0x01, 0x56, 0xa8, 0xc6, 0xcc, 0xb5, 0xd1,
+	0xac, 0xe9, 0x6e, 0xbe, 0xba, 0x79, 0x37, 0xd9, 0x45, 0x94, 0x7a, 0x2b, 0xdb, 0xd1, 0x39, 0x53,
+	0xe7, 0x8f, 0x32, 0xba, 0x75, 0xe9, 0x76, 0xc3, 0xcf, 0x72, 0xf5, 0x53, 0xbb, 0x7f, 0xb8, 0xdc,
+	0xee, 0xcc, 0x25, 0x6c, 0xc4, 0x02, 0x07, 0x22, 0x23, 0x22, 0xa9, 0x2f, 0x55, 0xf4, 0x27, 0x84,
+	0x1c, 0x11, 0xb8, 0x5c, 0x37, 0x68, 0xee, 0x80, 0xcf, 0xaf, 0xf9, 0xcb, 0x0c, 0x9b, 0x7e, 0xd4,
+	0xed, 0x2f, 0x32, 0x94, 0xfc, 0x89, 0x99, 0x87, 0x24, 0x2d, 0x90, 0xe0, 0x5f, 0xd1, 0xf6, 0x88,
+	0x49, 0x75, 0x00, 0x2c, 0x52, 0x7d, 0x60, 0xea, 0x39, 0xf7, 0x41, 0x2a, 0xe6, 0x87, 0xe6, 0x29,
+	0xf8, 0xf8, 0x7a, 0x7e, 0x4a, 0xca, 0xc8, 0x4e, 0x3c, 0x6b, 0x6f, 0x1f, 0x2d, 0x45, 0xa3, 0xaf,
+	0x61, 0xc1, 0x63, 0x74, 0x07, 0x5e, 0x84, 0x3c, 0x15, 0x25, 0x27, 0x2f, 0xbf, 0x35, 0xf9, 0xdd,
+	0x78, 0xd6, 0xbe, 0xf3, 0x78, 0x11, 0x8a, 0x2e, 0xc3, 0xc7, 0x4f, 0x10, 0x16, 0x7d, 0x09, 0xd1,
+	0x04, 0xdc, 0x2f, 0xd3, 0x47, 0x3e, 0xf9, 0x84, 0x2a, 0xbb, 0x56, 0x77, 0x95, 0x6c, 0xc7, 0xb3,
+	0x36, 0x3e, 0x5e, 0x38, 0xa5, 0x4b, 0x2a, 0xc8, 0xf7, 0xe7, 0x17, 0xad, 0xd2, 0xcb, 0x8b, 0x56,
+	0xe9, 0xd5, 0x45, 0xab, 0xf4, 0x5b, 0xdc, 0xb2, 0xce, 0xe3, 0x96, 0xf5, 0x32, 0x6e, 0x59, 0xaf,
+	0xe2, 0x96, 0xf5, 0x4f, 0xdc, 0xb2, 0x7e, 0xff, 0xb7, 0x55, 0xfa, 0xf6, 0xe1, 0x3b, 0xff, 0x3b,
+	0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xce, 0x84, 0x30, 0x59, 0x0a, 0x00, 0x00,
 }
 
 func (m *Bastion) Marshal() (dAtA []byte, err error) {
@@ -293,6 +360,36 @@ func (m *Bastion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BastionApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedBy != nil {
+		i -= len(*m.ApprovedBy)
+		copy(dAtA[i:], *m.ApprovedBy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ApprovedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BastionIngressPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -373,6 +470,37 @@ func (m *BastionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BastionSessionRecording) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionSessionRecording) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionSessionRecording) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Enabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *BastionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -393,6 +521,42 @@ func (m *BastionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SessionRecording != nil {
+		{
+			size, err := m.SessionRecording.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxDuration != nil {
+		{
+			size, err := m.MaxDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Ingress) > 0 {
 		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -543,6 +707,19 @@ func (m *Bastion) Size() (n int) {
 	return n
 }
 
+func (m *BastionApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApprovedBy != nil {
+		l = len(*m.ApprovedBy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *BastionIngressPolicy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -571,6 +748,16 @@ func (m *BastionList) Size() (n int) {
 	return n
 }
 
+func (m *BastionSessionRecording) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
 func (m *BastionSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -595,6 +782,18 @@ func (m *BastionSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.MaxDuration != nil {
+		l = m.MaxDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SessionRecording != nil {
+		l = m.SessionRecording.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -646,6 +845,16 @@ func (this *Bastion) String() string {
 	}, "")
 	return s
 }
+func (this *BastionApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionApproval{`,
+		`ApprovedBy:` + valueToStringGenerated(this.ApprovedBy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BastionIngressPolicy) String() string {
 	if this == nil {
 		return "nil"
@@ -672,6 +881,16 @@ func (this *BastionList) String() string {
 	}, "")
 	return s
 }
+func (this *BastionSessionRecording) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BastionSessionRecording{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BastionSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -687,6 +906,9 @@ func (this *BastionSpec) String() string {
 		`ProviderType:` + valueToStringGenerated(this.ProviderType) + `,`,
 		`SSHPublicKey:` + fmt.Sprintf("%v", this.SSHPublicKey) + `,`,
 		`Ingress:` + repeatedStringForIngress + `,`,
+		`MaxDuration:` + strings.Replace(fmt.Sprintf("%v", this.MaxDuration), "Duration", "v1.Duration", 1) + `,`,
+		`SessionRecording:` + strings.Replace(this.SessionRecording.String(), "BastionSessionRecording", "BastionSessionRecording", 1) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "BastionApproval", "BastionApproval", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -867,6 +1089,89 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BastionApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ApprovedBy = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BastionIngressPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1067,6 +1372,76 @@ func (m *BastionList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BastionSessionRecording) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionSessionRecording: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionSessionRecording: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BastionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1261,6 +1636,114 @@ func (m *BastionSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDuration == nil {
+				m.MaxDuration = &v1.Duration{}
+			}
+			if err := m.MaxDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionRecording", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionRecording == nil {
+				m.SessionRecording = &BastionSessionRecording{}
+			}
+			if err := m.SessionRecording.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &BastionApproval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
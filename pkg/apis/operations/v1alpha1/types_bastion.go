@@ -60,6 +60,32 @@ type BastionSpec struct {
 	SSHPublicKey string `json:"sshPublicKey" protobuf:"bytes,4,opt,name=sshPublicKey"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress" protobuf:"bytes,5,opt,name=ingress"`
+	// MaxDuration is the maximum duration after which the Bastion is deleted, independent of its heartbeats. It cannot
+	// extend the maximum lifetime of Bastions configured by the Gardener operator.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty" protobuf:"bytes,6,opt,name=maxDuration"`
+	// SessionRecording configures the recording of shell sessions on the bastion host. This field is immutable.
+	// +optional
+	SessionRecording *BastionSessionRecording `json:"sessionRecording,omitempty" protobuf:"bytes,7,opt,name=sessionRecording"`
+	// Approval contains information about the approval of the Bastion. If set, the bastion host is only created after
+	// the Bastion has been approved.
+	// +optional
+	Approval *BastionApproval `json:"approval,omitempty" protobuf:"bytes,8,opt,name=approval"`
+}
+
+// BastionSessionRecording configures the recording of shell sessions on a bastion host.
+type BastionSessionRecording struct {
+	// Enabled specifies whether shell sessions on the bastion host are recorded. Recordings are stored in the backup
+	// bucket of the seed the Bastion is scheduled to.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// BastionApproval contains information about the approval of a Bastion.
+type BastionApproval struct {
+	// ApprovedBy is the name of the user who approved the Bastion. It must not be the user who created the Bastion.
+	// This field is immutable once set.
+	// +optional
+	ApprovedBy *string `json:"approvedBy,omitempty" protobuf:"bytes,1,opt,name=approvedBy"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -65,6 +65,14 @@ func ValidateBastionSpec(spec *operations.BastionSpec, fldPath *field.Path) fiel
 		}
 	}
 
+	if spec.MaxDuration != nil && spec.MaxDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDuration"), spec.MaxDuration.Duration.String(), "maxDuration must be positive"))
+	}
+
+	if spec.Approval != nil && spec.Approval.ApprovedBy != nil && len(*spec.Approval.ApprovedBy) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("approval", "approvedBy"), *spec.Approval.ApprovedBy, "approvedBy must not be empty"))
+	}
+
 	return allErrs
 }
 
@@ -74,6 +82,15 @@ func ValidateBastionSpecUpdate(newSpec, oldSpec *operations.BastionSpec, fldPath
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootRef.Name, oldSpec.ShootRef.Name, fldPath.Child("shootRef.name"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SSHPublicKey, oldSpec.SSHPublicKey, fldPath.Child("sshPublicKey"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SessionRecording, oldSpec.SessionRecording, fldPath.Child("sessionRecording"))...)
+
+	if oldSpec.Approval != nil {
+		if newSpec.Approval == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("approval"), "approval must not be removed"))
+		} else if oldSpec.Approval.ApprovedBy != nil {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Approval.ApprovedBy, oldSpec.Approval.ApprovedBy, fldPath.Child("approval", "approvedBy"))...)
+		}
+	}
 
 	return allErrs
 }
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -12,6 +14,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/operations"
 	. "github.com/gardener/gardener/pkg/apis/operations/validation"
@@ -72,6 +75,52 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should forbid changing the session recording", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.SessionRecording = &operations.BastionSessionRecording{Enabled: true}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.sessionRecording"),
+			}))))
+		})
+
+		It("should allow approving the Bastion", func() {
+			bastion.Spec.Approval = &operations.BastionApproval{}
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.Approval.ApprovedBy = ptr.To("approver")
+
+			Expect(ValidateBastionUpdate(newBastion, bastion)).To(BeEmpty())
+		})
+
+		It("should forbid removing the approval", func() {
+			bastion.Spec.Approval = &operations.BastionApproval{}
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.Approval = nil
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.approval"),
+			}))))
+		})
+
+		It("should forbid changing the approver", func() {
+			bastion.Spec.Approval = &operations.BastionApproval{ApprovedBy: ptr.To("approver")}
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.Approval.ApprovedBy = ptr.To("another-approver")
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.approval.approvedBy"),
+			}))))
+		})
+
 		It("should forbid Bastion specification with invalid SSH key", func() {
 			bastion.Spec.SSHPublicKey = "i-am-not-a-valid-ssh-key"
 
@@ -116,6 +165,28 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should forbid Bastion specification with non-positive max duration", func() {
+			bastion.Spec.MaxDuration = &metav1.Duration{Duration: -time.Hour}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxDuration"),
+			}))))
+		})
+
+		It("should forbid Bastion specification with empty approver", func() {
+			bastion.Spec.Approval = &operations.BastionApproval{ApprovedBy: ptr.To("")}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.approval.approvedBy"),
+			}))))
+		})
+
 		It("should forbid changing Shoot ref", func() {
 			newBastion := prepareBastionForUpdate(bastion)
 			newBastion.Spec.ShootRef.Name = "another-shoot"
@@ -189,6 +189,10 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		}
 
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeAPIServer(coreKubeAPIServerConfig, virtualCluster.Kubernetes.Version, true, gardenerutils.DefaultResourcesForEncryption(), path)...)
+
+		if auditConfig := kubeAPIServer.KubeAPIServerConfig.AuditConfig; auditConfig != nil && auditConfig.Backends != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("auditConfig", "backends"), "audit backends are not supported for the virtual garden, use auditWebhook instead"))
+		}
 	}
 
 	if kubeControllerManager := virtualCluster.Kubernetes.KubeControllerManager; kubeControllerManager != nil && kubeControllerManager.KubeControllerManagerConfig != nil {
@@ -284,6 +288,9 @@ func validateGardenerAPIServerConfig(config *operatorv1alpha1.GardenerAPIServerC
 		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
 			allErrs = append(allErrs, gardencorevalidation.ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
 		}
+		if auditConfig.Backends != nil {
+			allErrs = append(allErrs, field.Forbidden(auditPath.Child("backends"), "audit backends are not supported for the gardener-apiserver, use auditWebhook instead"))
+		}
 	}
 
 	if config.WatchCacheSizes != nil {
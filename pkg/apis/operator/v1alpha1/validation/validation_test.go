@@ -1205,6 +1205,24 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("KubeAPIServer", func() {
+				It("should forbid audit backends", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{
+						AuditConfig: &gardencorev1beta1.AuditConfig{
+							AuditPolicy: &gardencorev1beta1.AuditPolicy{
+								ConfigMapRef: &corev1.ObjectReference{Name: "audit-policy"},
+							},
+							Backends: &gardencorev1beta1.AuditBackends{Log: &gardencorev1beta1.AuditLogBackend{}},
+						},
+					}}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.auditConfig.backends"),
+					}))))
+				})
+			})
+
 			Context("Gardener", func() {
 				Context("APIServer", func() {
 					BeforeEach(func() {
@@ -1283,6 +1301,20 @@ var _ = Describe("Validation Tests", func() {
 								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.auditConfig.auditPolicy.configMapRef.name"),
 							}))))
 						})
+
+						It("should forbid audit backends", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.AuditConfig = &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									ConfigMapRef: &corev1.ObjectReference{Name: "audit-policy"},
+								},
+								Backends: &gardencorev1beta1.AuditBackends{Log: &gardencorev1beta1.AuditLogBackend{}},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.auditConfig.backends"),
+							}))))
+						})
 					})
 
 					Context("EncryptionConfig", func() {
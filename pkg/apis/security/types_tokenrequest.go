@@ -28,6 +28,8 @@ type TokenRequestSpec struct {
 	ContextObject *ContextObject
 	// ExpirationSeconds specifies for how long the requested token should be valid.
 	ExpirationSeconds int64
+	// SubjectToken is a service account token of a shoot cluster which is exchanged for a workload identity token.
+	SubjectToken string
 }
 
 // ContextObject identifies the object the token is requested for.
@@ -34,6 +34,27 @@ type WorkloadIdentitySpec struct {
 	Audiences []string
 	// TargetSystem represents specific configurations for the system that will accept the JWTs.
 	TargetSystem TargetSystem
+	// TokenExchange configures which workloads running in shoot clusters are allowed to obtain tokens for this
+	// WorkloadIdentity by exchanging a token of a service account of the shoot cluster.
+	TokenExchange *TokenExchange
+}
+
+// TokenExchange configures the exchange of shoot service account tokens for workload identity tokens.
+type TokenExchange struct {
+	// Subjects is the list of shoot service accounts which are allowed to exchange their tokens.
+	Subjects []TokenExchangeSubject
+	// Claims are additional claims which are set in the tokens issued via token exchange.
+	Claims map[string]string
+}
+
+// TokenExchangeSubject identifies service accounts of a shoot cluster.
+type TokenExchangeSubject struct {
+	// ShootName is the name of the shoot in the namespace of the WorkloadIdentity.
+	ShootName string
+	// Namespace is the namespace of the service account in the shoot cluster.
+	Namespace string
+	// ServiceAccountName is the name of the service account in the shoot cluster.
+	ServiceAccountName string
 }
 
 // TargetSystem represents specific configurations for the system that will accept the JWTs.
@@ -20,6 +20,10 @@ const (
 	// the timestamp after which the workload identity token has to be renewed.
 	AnnotationWorkloadIdentityTokenRenewTimestamp = WorkloadIdentityPrefix + "token-renew-timestamp"
 
+	// TokenExchangeAudience is the audience shoot service account tokens must be issued for in order to be exchanged
+	// for workload identity tokens.
+	TokenExchangeAudience = WorkloadIdentityPrefix + "token-exchange"
+
 	// DataKeyToken is the data key of a secret whose value contains a workload identity token.
 	DataKeyToken = "token"
 	// DataKeyConfig is the data key of a secret whose value contains a workload identity provider configuration.
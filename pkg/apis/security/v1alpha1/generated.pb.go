@@ -12,6 +12,7 @@ import (
 	io "io"
 
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	v11 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
@@ -174,10 +175,66 @@ func (m *TargetSystem) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TargetSystem proto.InternalMessageInfo
 
+func (m *TokenExchange) Reset()      { *m = TokenExchange{} }
+func (*TokenExchange) ProtoMessage() {}
+func (*TokenExchange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32adcae6cdc9d73e, []int{5}
+}
+func (m *TokenExchange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenExchange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenExchange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenExchange.Merge(m, src)
+}
+func (m *TokenExchange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenExchange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenExchange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenExchange proto.InternalMessageInfo
+
+func (m *TokenExchangeSubject) Reset()      { *m = TokenExchangeSubject{} }
+func (*TokenExchangeSubject) ProtoMessage() {}
+func (*TokenExchangeSubject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32adcae6cdc9d73e, []int{6}
+}
+func (m *TokenExchangeSubject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenExchangeSubject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenExchangeSubject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenExchangeSubject.Merge(m, src)
+}
+func (m *TokenExchangeSubject) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenExchangeSubject) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenExchangeSubject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenExchangeSubject proto.InternalMessageInfo
+
 func (m *TokenRequest) Reset()      { *m = TokenRequest{} }
 func (*TokenRequest) ProtoMessage() {}
 func (*TokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{5}
+	return fileDescriptor_32adcae6cdc9d73e, []int{7}
 }
 func (m *TokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,7 +262,7 @@ var xxx_messageInfo_TokenRequest proto.InternalMessageInfo
 func (m *TokenRequestSpec) Reset()      { *m = TokenRequestSpec{} }
 func (*TokenRequestSpec) ProtoMessage() {}
 func (*TokenRequestSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{6}
+	return fileDescriptor_32adcae6cdc9d73e, []int{8}
 }
 func (m *TokenRequestSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,7 +290,7 @@ var xxx_messageInfo_TokenRequestSpec proto.InternalMessageInfo
 func (m *TokenRequestStatus) Reset()      { *m = TokenRequestStatus{} }
 func (*TokenRequestStatus) ProtoMessage() {}
 func (*TokenRequestStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{7}
+	return fileDescriptor_32adcae6cdc9d73e, []int{9}
 }
 func (m *TokenRequestStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +318,7 @@ var xxx_messageInfo_TokenRequestStatus proto.InternalMessageInfo
 func (m *WorkloadIdentity) Reset()      { *m = WorkloadIdentity{} }
 func (*WorkloadIdentity) ProtoMessage() {}
 func (*WorkloadIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{8}
+	return fileDescriptor_32adcae6cdc9d73e, []int{10}
 }
 func (m *WorkloadIdentity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +346,7 @@ var xxx_messageInfo_WorkloadIdentity proto.InternalMessageInfo
 func (m *WorkloadIdentityList) Reset()      { *m = WorkloadIdentityList{} }
 func (*WorkloadIdentityList) ProtoMessage() {}
 func (*WorkloadIdentityList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{9}
+	return fileDescriptor_32adcae6cdc9d73e, []int{11}
 }
 func (m *WorkloadIdentityList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +374,7 @@ var xxx_messageInfo_WorkloadIdentityList proto.InternalMessageInfo
 func (m *WorkloadIdentitySpec) Reset()      { *m = WorkloadIdentitySpec{} }
 func (*WorkloadIdentitySpec) ProtoMessage() {}
 func (*WorkloadIdentitySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{10}
+	return fileDescriptor_32adcae6cdc9d73e, []int{12}
 }
 func (m *WorkloadIdentitySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +402,7 @@ var xxx_messageInfo_WorkloadIdentitySpec proto.InternalMessageInfo
 func (m *WorkloadIdentityStatus) Reset()      { *m = WorkloadIdentityStatus{} }
 func (*WorkloadIdentityStatus) ProtoMessage() {}
 func (*WorkloadIdentityStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32adcae6cdc9d73e, []int{11}
+	return fileDescriptor_32adcae6cdc9d73e, []int{13}
 }
 func (m *WorkloadIdentityStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,6 +433,9 @@ func init() {
 	proto.RegisterType((*CredentialsBindingList)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.CredentialsBindingList")
 	proto.RegisterType((*CredentialsBindingProvider)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.CredentialsBindingProvider")
 	proto.RegisterType((*TargetSystem)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TargetSystem")
+	proto.RegisterType((*TokenExchange)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenExchange")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenExchange.ClaimsEntry")
+	proto.RegisterType((*TokenExchangeSubject)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenExchangeSubject")
 	proto.RegisterType((*TokenRequest)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenRequest")
 	proto.RegisterType((*TokenRequestSpec)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenRequestSpec")
 	proto.RegisterType((*TokenRequestStatus)(nil), "github.com.gardener.gardener.pkg.apis.security.v1alpha1.TokenRequestStatus")
@@ -390,69 +450,81 @@ func init() {
 }
 
 var fileDescriptor_32adcae6cdc9d73e = []byte{
-	// 987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc6, 0x4e, 0x94, 0x4c, 0xfe, 0x28, 0x99, 0x96, 0xc8, 0x0a, 0xc2, 0x0e, 0xdb, 0x0b,
-	0xa2, 0xea, 0xba, 0x89, 0x10, 0xe1, 0x84, 0xd4, 0x75, 0x03, 0xb2, 0x42, 0xdb, 0x30, 0x4e, 0x41,
-	0x42, 0x20, 0x31, 0xde, 0x7d, 0x71, 0x06, 0x67, 0x77, 0xb6, 0x3b, 0xb3, 0x69, 0x7c, 0x2a, 0x7c,
-	0x03, 0xee, 0x7c, 0x05, 0x8e, 0x7c, 0x04, 0x0e, 0x39, 0x56, 0x9c, 0x72, 0xb2, 0x88, 0xe1, 0xc0,
-	0x47, 0x40, 0x9c, 0xd0, 0x8c, 0x67, 0xb3, 0x6b, 0x3b, 0x6e, 0x23, 0x2b, 0xca, 0xc9, 0x3b, 0xf3,
-	0xde, 0xfb, 0xbd, 0x7f, 0xbf, 0xb7, 0x6f, 0x8d, 0x3e, 0x6f, 0x33, 0x79, 0x94, 0xb4, 0x1c, 0x8f,
-	0x07, 0xb5, 0x36, 0x8d, 0x7d, 0x08, 0x21, 0xce, 0x1e, 0xa2, 0x4e, 0xbb, 0x46, 0x23, 0x26, 0x6a,
-	0x02, 0xbc, 0x24, 0x66, 0xb2, 0x5b, 0x3b, 0xd9, 0xa2, 0xc7, 0xd1, 0x11, 0xdd, 0xaa, 0xb5, 0x95,
-	0x02, 0x95, 0xe0, 0x3b, 0x51, 0xcc, 0x25, 0xc7, 0x3b, 0x19, 0x90, 0x93, 0xda, 0x67, 0x0f, 0x51,
-	0xa7, 0xed, 0x28, 0x20, 0x27, 0x05, 0x72, 0x52, 0xa0, 0x8d, 0x07, 0xf9, 0x08, 0x78, 0x9b, 0xd7,
-	0x34, 0x5e, 0x2b, 0x39, 0xd4, 0x27, 0x7d, 0xd0, 0x4f, 0x03, 0x3f, 0x1b, 0x76, 0xe7, 0x13, 0xe1,
-	0x30, 0xae, 0xc2, 0xaa, 0x79, 0x3c, 0x86, 0xda, 0xc9, 0x58, 0x2c, 0x1b, 0x1f, 0x65, 0x3a, 0x01,
-	0xf5, 0x8e, 0x58, 0x08, 0x71, 0x37, 0xcb, 0x25, 0x00, 0x49, 0xaf, 0xb2, 0xaa, 0x4d, 0xb2, 0x8a,
-	0x93, 0x50, 0xb2, 0x00, 0xc6, 0x0c, 0x3e, 0x7e, 0x9b, 0x81, 0xf0, 0x8e, 0x20, 0xa0, 0xa3, 0x76,
-	0xf6, 0xbf, 0x16, 0x5a, 0xae, 0xf3, 0x50, 0xc2, 0xa9, 0x7c, 0xd6, 0xfa, 0x01, 0x3c, 0x89, 0x37,
-	0x51, 0xa9, 0xc3, 0x42, 0xbf, 0x6c, 0x6d, 0x5a, 0x1f, 0x2c, 0xb8, 0x4b, 0x67, 0xbd, 0x6a, 0xa1,
-	0xdf, 0xab, 0x96, 0xf6, 0x58, 0xe8, 0x13, 0x2d, 0xc1, 0xdb, 0x08, 0xd1, 0x88, 0x7d, 0x05, 0xb1,
-	0x60, 0x3c, 0x2c, 0xcf, 0x68, 0x3d, 0x6c, 0xf4, 0xd0, 0xa3, 0xfd, 0x86, 0x91, 0x90, 0x9c, 0x96,
-	0x42, 0x0d, 0x69, 0x00, 0xe5, 0xe2, 0x30, 0xea, 0x53, 0x1a, 0x00, 0xd1, 0x12, 0x7c, 0x1f, 0x2d,
-	0xa8, 0x5f, 0x11, 0x51, 0x0f, 0xca, 0x25, 0xad, 0xb6, 0xdc, 0xef, 0x55, 0x17, 0x9e, 0xa6, 0x97,
-	0x24, 0x93, 0x63, 0x17, 0x15, 0x13, 0xe6, 0x97, 0x67, 0xb5, 0xda, 0x43, 0x83, 0x56, 0x7c, 0xde,
-	0x78, 0xfc, 0x5f, 0xaf, 0xfa, 0xfe, 0xa4, 0x52, 0xc8, 0x6e, 0x04, 0xc2, 0x79, 0xde, 0x78, 0x4c,
-	0x94, 0xb1, 0xfd, 0x6b, 0x11, 0xe1, 0x7a, 0x0c, 0x3e, 0x84, 0x92, 0xd1, 0x63, 0xe1, 0xb2, 0xd0,
-	0x67, 0x61, 0x1b, 0x7f, 0x8f, 0xe6, 0x55, 0x57, 0x7c, 0x2a, 0xa9, 0xae, 0xc1, 0xe2, 0xf6, 0x43,
-	0x67, 0x80, 0xe8, 0xe4, 0x11, 0x33, 0x1a, 0x29, 0x6d, 0xe7, 0x64, 0xcb, 0x19, 0xd4, 0xef, 0x09,
-	0x48, 0x9a, 0x55, 0x23, 0xbb, 0x23, 0x97, 0xa8, 0xf8, 0x27, 0x0b, 0xcd, 0x47, 0x31, 0x3f, 0x61,
-	0x3e, 0xc4, 0xba, 0x7c, 0x8b, 0xdb, 0x4d, 0x67, 0x4a, 0xca, 0x3a, 0xe3, 0x19, 0xec, 0x1b, 0x68,
-	0x77, 0xd5, 0x44, 0x31, 0x9f, 0xde, 0x90, 0x4b, 0xb7, 0xd8, 0x43, 0x2b, 0x5e, 0x66, 0x49, 0xe0,
-	0x50, 0x77, 0x66, 0x71, 0xfb, 0x5e, 0x2e, 0x57, 0x47, 0x71, 0x3a, 0xcb, 0x8c, 0xc0, 0x21, 0xc4,
-	0x10, 0x7a, 0xe0, 0xae, 0x1b, 0xe0, 0x95, 0xfa, 0x10, 0x04, 0x19, 0x81, 0xc4, 0x7b, 0x68, 0xee,
-	0x45, 0xc2, 0x25, 0x15, 0xe5, 0xd2, 0x66, 0xf1, 0xba, 0xe0, 0x2b, 0x06, 0x7c, 0xee, 0x4b, 0x6d,
-	0x4a, 0x0c, 0x84, 0xfd, 0x8f, 0x85, 0xd6, 0xc7, 0x93, 0xfd, 0x82, 0x09, 0x89, 0xbf, 0x1d, 0x6b,
-	0x99, 0x73, 0xbd, 0x96, 0x29, 0x6b, 0xdd, 0xb0, 0xcb, 0x52, 0xa5, 0x37, 0xb9, 0x76, 0x45, 0x68,
-	0x96, 0x49, 0x08, 0x44, 0x79, 0x46, 0x27, 0xb1, 0x77, 0x83, 0xad, 0x72, 0x97, 0x8d, 0xdf, 0xd9,
-	0x86, 0xf2, 0x40, 0x06, 0x8e, 0xec, 0x4f, 0xd1, 0xc6, 0xe4, 0xb6, 0xaa, 0x51, 0x52, 0x4c, 0x1e,
-	0x1d, 0xd0, 0x83, 0x6e, 0x04, 0x44, 0x4b, 0xec, 0x5f, 0x2c, 0xb4, 0x74, 0x40, 0xe3, 0x36, 0xc8,
-	0x66, 0x57, 0x48, 0x08, 0xde, 0x6e, 0x82, 0x19, 0x5a, 0x49, 0xb9, 0x51, 0xe7, 0xe1, 0x21, 0x6b,
-	0x1b, 0x62, 0x3e, 0x98, 0x58, 0x48, 0xf3, 0x62, 0x71, 0x08, 0x7d, 0xb9, 0x7b, 0x2a, 0x21, 0x54,
-	0x63, 0xee, 0x62, 0xc5, 0x8a, 0xfd, 0x21, 0x20, 0x32, 0x02, 0x6c, 0xff, 0x31, 0x83, 0x96, 0x0e,
-	0x78, 0x07, 0x42, 0x02, 0x2f, 0x12, 0x10, 0xf2, 0x16, 0x26, 0xae, 0x83, 0x4a, 0x22, 0x02, 0xcf,
-	0xe4, 0xd4, 0x98, 0xba, 0x83, 0xf9, 0xb0, 0x9b, 0x11, 0x78, 0x59, 0x29, 0xd5, 0x89, 0x68, 0x27,
-	0x58, 0xa0, 0x39, 0x21, 0xa9, 0x4c, 0x84, 0x19, 0xa9, 0xbd, 0x9b, 0x71, 0xa7, 0x21, 0xb3, 0xe9,
-	0x18, 0x9c, 0x89, 0x71, 0x65, 0x9f, 0x5b, 0x68, 0x75, 0x34, 0x3a, 0xfc, 0x0a, 0x2d, 0x7b, 0xf9,
-	0x77, 0xbb, 0xa9, 0xee, 0x67, 0xd3, 0x33, 0x38, 0x8f, 0xe6, 0xae, 0xf5, 0x7b, 0xd5, 0xe1, 0xe5,
-	0x41, 0x86, 0xfd, 0xe1, 0x3a, 0x5a, 0x83, 0xd3, 0x88, 0xc5, 0x54, 0x32, 0x1e, 0x36, 0xc1, 0xe3,
-	0xa1, 0x2f, 0x74, 0x13, 0x8a, 0xee, 0x3b, 0xfd, 0x5e, 0x75, 0x6d, 0x77, 0x54, 0x48, 0xc6, 0xf5,
-	0xed, 0xdf, 0x2c, 0x84, 0xc7, 0x2b, 0x81, 0xef, 0xa1, 0x59, 0xa9, 0x6e, 0x0d, 0xa9, 0x2f, 0x27,
-	0x69, 0xa0, 0x3a, 0x90, 0xe1, 0x2e, 0xba, 0x93, 0x01, 0x1e, 0xb0, 0x00, 0x84, 0xa4, 0x41, 0x64,
-	0x78, 0xf0, 0xe1, 0xf5, 0x58, 0xa6, 0xcc, 0xdc, 0x77, 0x0d, 0xfc, 0x9d, 0xdd, 0x71, 0x38, 0x72,
-	0x95, 0x0f, 0xbb, 0x37, 0x83, 0x56, 0xbf, 0xe6, 0x71, 0xe7, 0x98, 0x53, 0xbf, 0xa1, 0x67, 0x59,
-	0x76, 0x6f, 0x81, 0xea, 0x7c, 0x88, 0xea, 0x4f, 0xa6, 0x6e, 0xf5, 0x68, 0xe8, 0x13, 0xe9, 0xfe,
-	0x72, 0x84, 0xee, 0xcf, 0x6e, 0xce, 0xe5, 0x9b, 0x29, 0xff, 0xb7, 0x85, 0xee, 0x8e, 0x9a, 0xdc,
-	0xc2, 0x3a, 0x08, 0x87, 0xd7, 0x41, 0xe3, 0xc6, 0xd2, 0x9d, 0xb0, 0x0c, 0x7e, 0xbf, 0x22, 0x4d,
-	0x3d, 0xdd, 0xf7, 0xd1, 0x02, 0x4d, 0x7c, 0xa6, 0x96, 0xa6, 0x28, 0x5b, 0x9b, 0xc5, 0xf4, 0x83,
-	0xe9, 0x51, 0x7a, 0x49, 0x32, 0x39, 0x7e, 0x85, 0x96, 0x64, 0x6e, 0x23, 0x18, 0x7a, 0xec, 0x4e,
-	0xff, 0x6a, 0xca, 0x81, 0xb9, 0x77, 0x4d, 0xe0, 0x43, 0x4b, 0x87, 0x0c, 0x39, 0xb4, 0x77, 0xd0,
-	0xfa, 0xd5, 0xfd, 0xc5, 0xef, 0xa1, 0xa2, 0x48, 0x5a, 0x66, 0x8c, 0x17, 0xd3, 0x6f, 0xb9, 0x66,
-	0xd2, 0x22, 0xea, 0xde, 0xfd, 0xee, 0xec, 0xa2, 0x52, 0x78, 0x7d, 0x51, 0x29, 0x9c, 0x5f, 0x54,
-	0x0a, 0x3f, 0xf6, 0x2b, 0xd6, 0x59, 0xbf, 0x62, 0xbd, 0xee, 0x57, 0xac, 0xf3, 0x7e, 0xc5, 0xfa,
-	0xb3, 0x5f, 0xb1, 0x7e, 0xfe, 0xab, 0x52, 0xf8, 0x66, 0x67, 0xca, 0xff, 0x0e, 0xff, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x5e, 0x79, 0x81, 0x2e, 0x75, 0x0c, 0x00, 0x00,
+	// 1183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0x1b, 0x45,
+	0x1c, 0xcf, 0xda, 0x49, 0x14, 0x4f, 0x9c, 0x28, 0x99, 0x86, 0xca, 0x32, 0xc2, 0x0e, 0xdb, 0x0b,
+	0xa2, 0xea, 0xba, 0x89, 0x10, 0x09, 0x1c, 0x90, 0xb2, 0xae, 0x8b, 0x4c, 0x68, 0x1b, 0xc6, 0x29,
+	0x48, 0x08, 0x24, 0x26, 0xbb, 0x13, 0x7b, 0x70, 0xf6, 0xa3, 0x3b, 0xb3, 0x6e, 0x0c, 0x87, 0xc2,
+	0x1b, 0x20, 0xae, 0xbc, 0x02, 0x47, 0xde, 0x00, 0x90, 0x72, 0xac, 0x38, 0xf5, 0x64, 0x11, 0xc3,
+	0x81, 0x47, 0x40, 0x9c, 0xd0, 0xcc, 0x8e, 0xbd, 0xbb, 0xfe, 0xa0, 0x91, 0xb1, 0x72, 0xf2, 0xee,
+	0xff, 0xe3, 0xf7, 0x9b, 0xff, 0xe7, 0x8e, 0xc1, 0xfb, 0x4d, 0xca, 0x5b, 0xe1, 0x89, 0x61, 0x79,
+	0x4e, 0xa5, 0x89, 0x03, 0x9b, 0xb8, 0x24, 0x88, 0x1f, 0xfc, 0x76, 0xb3, 0x82, 0x7d, 0xca, 0x2a,
+	0x8c, 0x58, 0x61, 0x40, 0x79, 0xb7, 0xd2, 0xd9, 0xc1, 0x67, 0x7e, 0x0b, 0xef, 0x54, 0x9a, 0xc2,
+	0x00, 0x73, 0x62, 0x1b, 0x7e, 0xe0, 0x71, 0x0f, 0xee, 0xc5, 0x40, 0xc6, 0xc0, 0x3f, 0x7e, 0xf0,
+	0xdb, 0x4d, 0x43, 0x00, 0x19, 0x03, 0x20, 0x63, 0x00, 0x54, 0xbc, 0x93, 0x3c, 0x81, 0xd7, 0xf4,
+	0x2a, 0x12, 0xef, 0x24, 0x3c, 0x95, 0x6f, 0xf2, 0x45, 0x3e, 0x45, 0x3c, 0x45, 0xbd, 0xbd, 0xcf,
+	0x0c, 0xea, 0x89, 0x63, 0x55, 0x2c, 0x2f, 0x20, 0x95, 0xce, 0xd8, 0x59, 0x8a, 0x6f, 0xc5, 0x36,
+	0x0e, 0xb6, 0x5a, 0xd4, 0x25, 0x41, 0x37, 0x8e, 0xc5, 0x21, 0x1c, 0x4f, 0xf2, 0xaa, 0x4c, 0xf3,
+	0x0a, 0x42, 0x97, 0x53, 0x87, 0x8c, 0x39, 0xbc, 0xfd, 0x32, 0x07, 0x66, 0xb5, 0x88, 0x83, 0x47,
+	0xfd, 0xf4, 0xbf, 0x35, 0xb0, 0x56, 0xf5, 0x5c, 0x4e, 0xce, 0xf9, 0xa3, 0x93, 0x2f, 0x89, 0xc5,
+	0xe1, 0x36, 0x58, 0x6c, 0x53, 0xd7, 0x2e, 0x68, 0xdb, 0xda, 0x1b, 0x39, 0x33, 0x7f, 0xd1, 0x2b,
+	0x2f, 0xf4, 0x7b, 0xe5, 0xc5, 0x43, 0xea, 0xda, 0x48, 0x6a, 0xe0, 0x2e, 0x00, 0xd8, 0xa7, 0x1f,
+	0x93, 0x80, 0x51, 0xcf, 0x2d, 0x64, 0xa4, 0x1d, 0x54, 0x76, 0xe0, 0xe0, 0xa8, 0xae, 0x34, 0x28,
+	0x61, 0x25, 0x50, 0x5d, 0xec, 0x90, 0x42, 0x36, 0x8d, 0xfa, 0x10, 0x3b, 0x04, 0x49, 0x0d, 0xbc,
+	0x0d, 0x72, 0xe2, 0x97, 0xf9, 0xd8, 0x22, 0x85, 0x45, 0x69, 0xb6, 0xd6, 0xef, 0x95, 0x73, 0x0f,
+	0x07, 0x42, 0x14, 0xeb, 0xa1, 0x09, 0xb2, 0x21, 0xb5, 0x0b, 0x4b, 0xd2, 0xec, 0xae, 0x42, 0xcb,
+	0x3e, 0xae, 0xdf, 0xfb, 0xa7, 0x57, 0x7e, 0x7d, 0x5a, 0x2a, 0x78, 0xd7, 0x27, 0xcc, 0x78, 0x5c,
+	0xbf, 0x87, 0x84, 0xb3, 0xfe, 0x63, 0x16, 0xc0, 0x6a, 0x40, 0x6c, 0xe2, 0x72, 0x8a, 0xcf, 0x98,
+	0x49, 0x5d, 0x9b, 0xba, 0x4d, 0xf8, 0x05, 0x58, 0x11, 0x55, 0xb1, 0x31, 0xc7, 0x32, 0x07, 0xab,
+	0xbb, 0x77, 0x8d, 0x08, 0xd1, 0x48, 0x22, 0xc6, 0x6d, 0x24, 0xac, 0x8d, 0xce, 0x8e, 0x11, 0xe5,
+	0xef, 0x01, 0xe1, 0x38, 0xce, 0x46, 0x2c, 0x43, 0x43, 0x54, 0xf8, 0xad, 0x06, 0x56, 0xfc, 0xc0,
+	0xeb, 0x50, 0x9b, 0x04, 0x32, 0x7d, 0xab, 0xbb, 0x0d, 0x63, 0xc6, 0x96, 0x35, 0xc6, 0x23, 0x38,
+	0x52, 0xd0, 0xe6, 0x86, 0x3a, 0xc5, 0xca, 0x40, 0x82, 0x86, 0xb4, 0xd0, 0x02, 0xeb, 0x56, 0xec,
+	0x89, 0xc8, 0xa9, 0xac, 0xcc, 0xea, 0xee, 0xad, 0x44, 0xac, 0x86, 0xe8, 0xe9, 0x38, 0x32, 0x44,
+	0x4e, 0x49, 0x40, 0x5c, 0x8b, 0x98, 0x37, 0x15, 0xf0, 0x7a, 0x35, 0x05, 0x81, 0x46, 0x20, 0xe1,
+	0x21, 0x58, 0x7e, 0x12, 0x7a, 0x1c, 0xb3, 0xc2, 0xe2, 0x76, 0xf6, 0xaa, 0xe0, 0xeb, 0x0a, 0x7c,
+	0xf9, 0x23, 0xe9, 0x8a, 0x14, 0x84, 0xfe, 0x97, 0x06, 0x6e, 0x8e, 0x07, 0xfb, 0x21, 0x65, 0x1c,
+	0x7e, 0x36, 0x56, 0x32, 0xe3, 0x6a, 0x25, 0x13, 0xde, 0xb2, 0x60, 0xc3, 0x54, 0x0d, 0x24, 0x89,
+	0x72, 0xf9, 0x60, 0x89, 0x72, 0xe2, 0xb0, 0x42, 0x46, 0x06, 0x71, 0x38, 0xc7, 0x52, 0x99, 0x6b,
+	0x8a, 0x77, 0xa9, 0x2e, 0x18, 0x50, 0x44, 0xa4, 0xbf, 0x07, 0x8a, 0xd3, 0xcb, 0x2a, 0x46, 0x49,
+	0x74, 0xf2, 0xe8, 0x80, 0x1e, 0x77, 0x7d, 0x82, 0xa4, 0x46, 0xff, 0x41, 0x03, 0xf9, 0x63, 0x1c,
+	0x34, 0x09, 0x6f, 0x74, 0x19, 0x27, 0xce, 0xcb, 0x5d, 0x20, 0x05, 0xeb, 0x83, 0xde, 0xa8, 0x7a,
+	0xee, 0x29, 0x6d, 0xaa, 0xc6, 0xbc, 0x33, 0x35, 0x91, 0x6a, 0xb1, 0x18, 0x08, 0x3f, 0xad, 0x9d,
+	0x73, 0xe2, 0x8a, 0x31, 0x37, 0xa1, 0xe8, 0x8a, 0xa3, 0x14, 0x10, 0x1a, 0x01, 0xd6, 0x7f, 0xce,
+	0x80, 0xb5, 0x63, 0xaf, 0x4d, 0xdc, 0xda, 0xb9, 0xd5, 0xc2, 0x6e, 0x93, 0xc0, 0xaf, 0xc1, 0x0a,
+	0x0b, 0x65, 0x1b, 0xb0, 0x82, 0x26, 0x93, 0xfc, 0x60, 0xe6, 0x24, 0xa7, 0x90, 0x1b, 0x11, 0x6a,
+	0x5c, 0x5e, 0x25, 0x60, 0x68, 0x48, 0x08, 0xbf, 0x02, 0xcb, 0xd6, 0x19, 0xa6, 0xc3, 0xfa, 0xa2,
+	0xf9, 0x50, 0x1b, 0x55, 0x09, 0x5a, 0x73, 0x79, 0xd0, 0x8d, 0x7b, 0x3a, 0x12, 0x22, 0xc5, 0x58,
+	0x7c, 0x07, 0xac, 0x26, 0xcc, 0xe0, 0x06, 0xc8, 0xb6, 0x49, 0x37, 0xaa, 0x12, 0x12, 0x8f, 0x70,
+	0x0b, 0x2c, 0x75, 0xf0, 0x59, 0x48, 0xa2, 0x2d, 0x8b, 0xa2, 0x97, 0x77, 0x33, 0xfb, 0x9a, 0xfe,
+	0x8b, 0x06, 0xb6, 0x26, 0xc5, 0x0a, 0x2b, 0x20, 0xc7, 0x5a, 0x9e, 0xc7, 0xc5, 0xde, 0x54, 0x05,
+	0xdf, 0x54, 0xf4, 0xb9, 0xc6, 0x40, 0x81, 0x62, 0x1b, 0xe1, 0x10, 0x2f, 0xde, 0x4c, 0xda, 0x61,
+	0xe2, 0xf2, 0xfd, 0x00, 0x40, 0x46, 0x82, 0x0e, 0xb5, 0xc8, 0x81, 0x65, 0x79, 0xa1, 0x1b, 0x51,
+	0x45, 0x9b, 0xbd, 0xa8, 0x3c, 0x61, 0x63, 0xcc, 0x02, 0x4d, 0xf0, 0xd2, 0x7f, 0xcb, 0x80, 0xbc,
+	0x0c, 0x03, 0x91, 0x27, 0x21, 0x61, 0xfc, 0x1a, 0xd6, 0x6f, 0x1b, 0x2c, 0x32, 0x9f, 0x58, 0xaa,
+	0xc1, 0xeb, 0xff, 0xaf, 0xdc, 0xea, 0xd8, 0x0d, 0x9f, 0x58, 0xf1, 0x5c, 0x89, 0x37, 0x24, 0x49,
+	0x20, 0x03, 0xcb, 0x8c, 0x63, 0x1e, 0x32, 0xb5, 0x5f, 0x0f, 0xe7, 0x43, 0x27, 0x21, 0xe3, 0xb6,
+	0x8a, 0xde, 0x91, 0xa2, 0xd2, 0xbf, 0xcf, 0x80, 0x8d, 0xd1, 0xd3, 0xc1, 0x67, 0x60, 0xcd, 0x4a,
+	0x7e, 0xe8, 0x55, 0x76, 0xef, 0xcf, 0xbe, 0xce, 0x92, 0x68, 0xe6, 0x66, 0xbf, 0x57, 0x4e, 0xdf,
+	0x24, 0x50, 0x9a, 0x0f, 0x56, 0xc1, 0x26, 0x39, 0xf7, 0x69, 0x80, 0x39, 0xf5, 0xdc, 0x06, 0xb1,
+	0x3c, 0xd7, 0x66, 0xb2, 0x08, 0x59, 0xf3, 0x95, 0x7e, 0xaf, 0xbc, 0x59, 0x1b, 0x55, 0xa2, 0x71,
+	0x7b, 0xb8, 0x0f, 0xf2, 0x6a, 0x72, 0x65, 0x80, 0xaa, 0xeb, 0xb6, 0x54, 0x22, 0xf2, 0x8d, 0x84,
+	0x0e, 0xa5, 0x2c, 0xf5, 0x9f, 0x34, 0x00, 0xc7, 0x73, 0x08, 0x6f, 0x81, 0x25, 0x2e, 0x91, 0xa2,
+	0x51, 0x19, 0x2e, 0xe4, 0xc8, 0x34, 0xd2, 0xc1, 0x2e, 0xb8, 0x11, 0x1f, 0xe5, 0x98, 0x3a, 0x84,
+	0x71, 0xec, 0xf8, 0xaa, 0x83, 0xde, 0xbc, 0x5a, 0x7f, 0x0a, 0x37, 0xf3, 0x55, 0x05, 0x7f, 0xa3,
+	0x36, 0x0e, 0x87, 0x26, 0x71, 0xe8, 0xbd, 0x0c, 0xd8, 0xf8, 0xc4, 0x0b, 0xda, 0x67, 0x1e, 0xb6,
+	0xeb, 0xf2, 0x93, 0xc0, 0xbb, 0xd7, 0x30, 0x24, 0x5e, 0x6a, 0x48, 0x66, 0x5f, 0xc7, 0xa3, 0x47,
+	0x9f, 0x3a, 0x28, 0x4f, 0x47, 0x06, 0xe5, 0xd1, 0xfc, 0x28, 0xff, 0x7b, 0x58, 0xfe, 0xd4, 0xc0,
+	0xd6, 0xa8, 0xcb, 0x35, 0xdc, 0x2a, 0xdc, 0xf4, 0xad, 0xa2, 0x3e, 0xb7, 0x70, 0xa7, 0xdc, 0x29,
+	0x7e, 0xcd, 0x8c, 0x87, 0x29, 0xf7, 0xc2, 0x6d, 0x90, 0xc3, 0xa1, 0x4d, 0xc5, 0xdd, 0x2b, 0xfa,
+	0xfa, 0xaa, 0x7b, 0xf7, 0xc1, 0x40, 0x88, 0x62, 0x3d, 0x7c, 0x06, 0xf2, 0x3c, 0x71, 0xb1, 0x50,
+	0xed, 0x51, 0x9b, 0x7d, 0xa9, 0x25, 0xc0, 0xe2, 0x29, 0x4e, 0x4a, 0x51, 0x8a, 0x50, 0x6c, 0x31,
+	0x9e, 0xfc, 0xea, 0xa9, 0x6e, 0xb9, 0x3f, 0x9f, 0x8f, 0x76, 0xb4, 0xc5, 0x52, 0x22, 0x94, 0xe6,
+	0xd3, 0xf7, 0xc0, 0xcd, 0xc9, 0x0d, 0x06, 0x5f, 0x03, 0x59, 0x16, 0x9e, 0xa8, 0x3d, 0xb2, 0x3a,
+	0xf8, 0x4f, 0xd2, 0x08, 0x4f, 0x90, 0x90, 0x9b, 0x9f, 0x5f, 0x5c, 0x96, 0x16, 0x9e, 0x5f, 0x96,
+	0x16, 0x5e, 0x5c, 0x96, 0x16, 0xbe, 0xe9, 0x97, 0xb4, 0x8b, 0x7e, 0x49, 0x7b, 0xde, 0x2f, 0x69,
+	0x2f, 0xfa, 0x25, 0xed, 0xf7, 0x7e, 0x49, 0xfb, 0xee, 0x8f, 0xd2, 0xc2, 0xa7, 0x7b, 0x33, 0xfe,
+	0x07, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xce, 0x79, 0x56, 0x3d, 0x0f, 0x00, 0x00,
 }
 
 func (m *ContextObject) Marshal() (dAtA []byte, err error) {
@@ -687,6 +759,105 @@ func (m *TargetSystem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenExchange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenExchange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenExchange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		keysForClaims := make([]string, 0, len(m.Claims))
+		for k := range m.Claims {
+			keysForClaims = append(keysForClaims, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForClaims)
+		for iNdEx := len(keysForClaims) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Claims[string(keysForClaims[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForClaims[iNdEx])
+			copy(dAtA[i:], keysForClaims[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForClaims[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenExchangeSubject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenExchangeSubject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenExchangeSubject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ShootName)
+	copy(dAtA[i:], m.ShootName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ShootName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -760,6 +931,11 @@ func (m *TokenRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SubjectToken)
+	copy(dAtA[i:], m.SubjectToken)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SubjectToken)))
+	i--
+	dAtA[i] = 0x1a
 	if m.ExpirationSeconds != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ExpirationSeconds))
 		i--
@@ -938,6 +1114,18 @@ func (m *WorkloadIdentitySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TokenExchange != nil {
+		{
+			size, err := m.TokenExchange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.TargetSystem.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1084,6 +1272,44 @@ func (m *TargetSystem) Size() (n int) {
 	return n
 }
 
+func (m *TokenExchange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Claims) > 0 {
+		for k, v := range m.Claims {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *TokenExchangeSubject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ShootName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *TokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1112,6 +1338,8 @@ func (m *TokenRequestSpec) Size() (n int) {
 	if m.ExpirationSeconds != nil {
 		n += 1 + sovGenerated(uint64(*m.ExpirationSeconds))
 	}
+	l = len(m.SubjectToken)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1174,6 +1402,10 @@ func (m *WorkloadIdentitySpec) Size() (n int) {
 	}
 	l = m.TargetSystem.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.TokenExchange != nil {
+		l = m.TokenExchange.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1263,6 +1495,44 @@ func (this *TargetSystem) String() string {
 	}, "")
 	return s
 }
+func (this *TokenExchange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubjects := "[]TokenExchangeSubject{"
+	for _, f := range this.Subjects {
+		repeatedStringForSubjects += strings.Replace(strings.Replace(f.String(), "TokenExchangeSubject", "TokenExchangeSubject", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSubjects += "}"
+	keysForClaims := make([]string, 0, len(this.Claims))
+	for k := range this.Claims {
+		keysForClaims = append(keysForClaims, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClaims)
+	mapStringForClaims := "map[string]string{"
+	for _, k := range keysForClaims {
+		mapStringForClaims += fmt.Sprintf("%v: %v,", k, this.Claims[k])
+	}
+	mapStringForClaims += "}"
+	s := strings.Join([]string{`&TokenExchange{`,
+		`Subjects:` + repeatedStringForSubjects + `,`,
+		`Claims:` + mapStringForClaims + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenExchangeSubject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenExchangeSubject{`,
+		`ShootName:` + fmt.Sprintf("%v", this.ShootName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TokenRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -1282,6 +1552,7 @@ func (this *TokenRequestSpec) String() string {
 	s := strings.Join([]string{`&TokenRequestSpec{`,
 		`ContextObject:` + strings.Replace(this.ContextObject.String(), "ContextObject", "ContextObject", 1) + `,`,
 		`ExpirationSeconds:` + valueToStringGenerated(this.ExpirationSeconds) + `,`,
+		`SubjectToken:` + fmt.Sprintf("%v", this.SubjectToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1332,6 +1603,7 @@ func (this *WorkloadIdentitySpec) String() string {
 	s := strings.Join([]string{`&WorkloadIdentitySpec{`,
 		`Audiences:` + fmt.Sprintf("%v", this.Audiences) + `,`,
 		`TargetSystem:` + strings.Replace(strings.Replace(this.TargetSystem.String(), "TargetSystem", "TargetSystem", 1), `&`, ``, 1) + `,`,
+		`TokenExchange:` + strings.Replace(this.TokenExchange.String(), "TokenExchange", "TokenExchange", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2065,6 +2337,363 @@ func (m *TargetSystem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenExchange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenExchange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenExchange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, TokenExchangeSubject{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claims == nil {
+				m.Claims = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Claims[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenExchangeSubject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenExchangeSubject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenExchangeSubject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShootName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2299,6 +2928,38 @@ func (m *TokenRequestSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ExpirationSeconds = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2795,6 +3456,42 @@ func (m *WorkloadIdentitySpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenExchange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenExchange == nil {
+				m.TokenExchange = &TokenExchange{}
+			}
+			if err := m.TokenExchange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
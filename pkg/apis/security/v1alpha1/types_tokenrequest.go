@@ -30,6 +30,10 @@ type TokenRequestSpec struct {
 	// ExpirationSeconds specifies for how long the requested token should be valid.
 	// +optional
 	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" protobuf:"bytes,2,opt,name=expirationSeconds"`
+	// SubjectToken is a service account token of a shoot cluster which is exchanged for a workload identity token.
+	// It must only be set when creating the 'exchange' subresource of a WorkloadIdentity.
+	// +optional
+	SubjectToken string `json:"subjectToken,omitempty" protobuf:"bytes,3,opt,name=subjectToken"`
 }
 
 // ContextObject identifies the object the token is requested for.
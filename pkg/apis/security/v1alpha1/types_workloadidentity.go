@@ -11,6 +11,7 @@ import (
 
 // +genclient
 // +genclient:method=CreateToken,verb=create,subresource=token,input=github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequest,result=github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequest
+// +genclient:method=ExchangeToken,verb=create,subresource=exchange,input=github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequest,result=github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequest
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // WorkloadIdentity is resource that allows workloads to be presented before external systems
@@ -36,6 +37,33 @@ type WorkloadIdentitySpec struct {
 	Audiences []string `json:"audiences" protobuf:"bytes,1,opt,name=audiences"`
 	// TargetSystem represents specific configurations for the system that will accept the JWTs.
 	TargetSystem TargetSystem `json:"targetSystem" protobuf:"bytes,2,opt,name=targetSystem"`
+	// TokenExchange configures which workloads running in shoot clusters are allowed to obtain tokens for this
+	// WorkloadIdentity by exchanging a token of a service account of the shoot cluster.
+	// +optional
+	TokenExchange *TokenExchange `json:"tokenExchange,omitempty" protobuf:"bytes,3,opt,name=tokenExchange"`
+}
+
+// TokenExchange configures the exchange of shoot service account tokens for workload identity tokens.
+type TokenExchange struct {
+	// Subjects is the list of shoot service accounts which are allowed to exchange their tokens.
+	Subjects []TokenExchangeSubject `json:"subjects" protobuf:"bytes,1,rep,name=subjects"`
+	// Claims are additional claims which are set in the tokens issued via token exchange.
+	// Registered JWT claims and the 'gardener.cloud' claim cannot be overwritten.
+	// +optional
+	Claims map[string]string `json:"claims,omitempty" protobuf:"bytes,2,rep,name=claims"`
+}
+
+// TokenExchangeSubject identifies service accounts of a shoot cluster.
+type TokenExchangeSubject struct {
+	// ShootName is the name of the shoot in the namespace of the WorkloadIdentity.
+	// The shoot must use a managed service account issuer.
+	ShootName string `json:"shootName" protobuf:"bytes,1,opt,name=shootName"`
+	// Namespace is the namespace of the service account in the shoot cluster.
+	// The value '*' matches all namespaces.
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// ServiceAccountName is the name of the service account in the shoot cluster.
+	// The value '*' matches all service accounts of the namespace.
+	ServiceAccountName string `json:"serviceAccountName" protobuf:"bytes,3,opt,name=serviceAccountName"`
 }
 
 // TargetSystem represents specific configurations for the system that will accept the JWTs.
@@ -67,6 +67,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TokenExchange)(nil), (*security.TokenExchange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TokenExchange_To_security_TokenExchange(a.(*TokenExchange), b.(*security.TokenExchange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*security.TokenExchange)(nil), (*TokenExchange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_security_TokenExchange_To_v1alpha1_TokenExchange(a.(*security.TokenExchange), b.(*TokenExchange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TokenExchangeSubject)(nil), (*security.TokenExchangeSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TokenExchangeSubject_To_security_TokenExchangeSubject(a.(*TokenExchangeSubject), b.(*security.TokenExchangeSubject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*security.TokenExchangeSubject)(nil), (*TokenExchangeSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_security_TokenExchangeSubject_To_v1alpha1_TokenExchangeSubject(a.(*security.TokenExchangeSubject), b.(*TokenExchangeSubject), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TokenRequest)(nil), (*security.TokenRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TokenRequest_To_security_TokenRequest(a.(*TokenRequest), b.(*security.TokenRequest), scope)
 	}); err != nil {
@@ -262,6 +282,52 @@ func autoConvert_security_TargetSystem_To_v1alpha1_TargetSystem(in *security.Tar
 	return nil
 }
 
+func autoConvert_v1alpha1_TokenExchange_To_security_TokenExchange(in *TokenExchange, out *security.TokenExchange, s conversion.Scope) error {
+	out.Subjects = *(*[]security.TokenExchangeSubject)(unsafe.Pointer(&in.Subjects))
+	out.Claims = *(*map[string]string)(unsafe.Pointer(&in.Claims))
+	return nil
+}
+
+// Convert_v1alpha1_TokenExchange_To_security_TokenExchange is an autogenerated conversion function.
+func Convert_v1alpha1_TokenExchange_To_security_TokenExchange(in *TokenExchange, out *security.TokenExchange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TokenExchange_To_security_TokenExchange(in, out, s)
+}
+
+func autoConvert_security_TokenExchange_To_v1alpha1_TokenExchange(in *security.TokenExchange, out *TokenExchange, s conversion.Scope) error {
+	out.Subjects = *(*[]TokenExchangeSubject)(unsafe.Pointer(&in.Subjects))
+	out.Claims = *(*map[string]string)(unsafe.Pointer(&in.Claims))
+	return nil
+}
+
+// Convert_security_TokenExchange_To_v1alpha1_TokenExchange is an autogenerated conversion function.
+func Convert_security_TokenExchange_To_v1alpha1_TokenExchange(in *security.TokenExchange, out *TokenExchange, s conversion.Scope) error {
+	return autoConvert_security_TokenExchange_To_v1alpha1_TokenExchange(in, out, s)
+}
+
+func autoConvert_v1alpha1_TokenExchangeSubject_To_security_TokenExchangeSubject(in *TokenExchangeSubject, out *security.TokenExchangeSubject, s conversion.Scope) error {
+	out.ShootName = in.ShootName
+	out.Namespace = in.Namespace
+	out.ServiceAccountName = in.ServiceAccountName
+	return nil
+}
+
+// Convert_v1alpha1_TokenExchangeSubject_To_security_TokenExchangeSubject is an autogenerated conversion function.
+func Convert_v1alpha1_TokenExchangeSubject_To_security_TokenExchangeSubject(in *TokenExchangeSubject, out *security.TokenExchangeSubject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TokenExchangeSubject_To_security_TokenExchangeSubject(in, out, s)
+}
+
+func autoConvert_security_TokenExchangeSubject_To_v1alpha1_TokenExchangeSubject(in *security.TokenExchangeSubject, out *TokenExchangeSubject, s conversion.Scope) error {
+	out.ShootName = in.ShootName
+	out.Namespace = in.Namespace
+	out.ServiceAccountName = in.ServiceAccountName
+	return nil
+}
+
+// Convert_security_TokenExchangeSubject_To_v1alpha1_TokenExchangeSubject is an autogenerated conversion function.
+func Convert_security_TokenExchangeSubject_To_v1alpha1_TokenExchangeSubject(in *security.TokenExchangeSubject, out *TokenExchangeSubject, s conversion.Scope) error {
+	return autoConvert_security_TokenExchangeSubject_To_v1alpha1_TokenExchangeSubject(in, out, s)
+}
+
 func autoConvert_v1alpha1_TokenRequest_To_security_TokenRequest(in *TokenRequest, out *security.TokenRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TokenRequestSpec_To_security_TokenRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -299,6 +365,7 @@ func autoConvert_v1alpha1_TokenRequestSpec_To_security_TokenRequestSpec(in *Toke
 	if err := metav1.Convert_Pointer_int64_To_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
 		return err
 	}
+	out.SubjectToken = in.SubjectToken
 	return nil
 }
 
@@ -312,6 +379,7 @@ func autoConvert_security_TokenRequestSpec_To_v1alpha1_TokenRequestSpec(in *secu
 	if err := metav1.Convert_int64_To_Pointer_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
 		return err
 	}
+	out.SubjectToken = in.SubjectToken
 	return nil
 }
 
@@ -421,6 +489,7 @@ func autoConvert_v1alpha1_WorkloadIdentitySpec_To_security_WorkloadIdentitySpec(
 	if err := Convert_v1alpha1_TargetSystem_To_security_TargetSystem(&in.TargetSystem, &out.TargetSystem, s); err != nil {
 		return err
 	}
+	out.TokenExchange = (*security.TokenExchange)(unsafe.Pointer(in.TokenExchange))
 	return nil
 }
 
@@ -434,6 +503,7 @@ func autoConvert_security_WorkloadIdentitySpec_To_v1alpha1_WorkloadIdentitySpec(
 	if err := Convert_security_TargetSystem_To_v1alpha1_TargetSystem(&in.TargetSystem, &out.TargetSystem, s); err != nil {
 		return err
 	}
+	out.TokenExchange = (*TokenExchange)(unsafe.Pointer(in.TokenExchange))
 	return nil
 }
 
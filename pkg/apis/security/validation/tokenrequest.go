@@ -26,6 +26,30 @@ func ValidateTokenRequest(request *security.TokenRequest) field.ErrorList {
 		allErrs = append(allErrs, validateContextObject(*request.Spec.ContextObject, specPath.Child("contextObject"))...)
 	}
 
+	if len(request.Spec.SubjectToken) != 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("subjectToken"), "subject token can only be set when exchanging tokens"))
+	}
+
+	return allErrs
+}
+
+// ValidateTokenExchangeRequest validates a TokenRequest which is used to exchange a shoot service account token.
+func ValidateTokenExchangeRequest(request *security.TokenRequest) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		specPath = field.NewPath("spec")
+	)
+
+	allErrs = append(allErrs, validateExpirationSeconds(request.Spec.ExpirationSeconds, specPath.Child("expirationSeconds"))...)
+
+	if request.Spec.ContextObject != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("contextObject"), "context object cannot be set when exchanging tokens since it is derived from the subject token"))
+	}
+
+	if len(request.Spec.SubjectToken) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("subjectToken"), "must provide the token to exchange"))
+	}
+
 	return allErrs
 }
 
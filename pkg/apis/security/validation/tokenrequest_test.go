@@ -165,5 +165,54 @@ var _ = Describe("TokenRequest Validation Tests", func() {
 				),
 			),
 		)
+
+		It("should forbid setting a subject token", func() {
+			tokenRequest.Spec.SubjectToken = "token"
+
+			Expect(ValidateTokenRequest(tokenRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.subjectToken"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateTokenExchangeRequest", func() {
+		var tokenRequest *security.TokenRequest
+
+		BeforeEach(func() {
+			tokenRequest = &security.TokenRequest{
+				Spec: security.TokenRequestSpec{
+					ExpirationSeconds: 3600,
+					SubjectToken:      "token",
+				},
+			}
+		})
+
+		It("should allow valid token exchange requests", func() {
+			Expect(ValidateTokenExchangeRequest(tokenRequest)).To(BeEmpty())
+		})
+
+		It("should forbid invalid token exchange requests", func() {
+			tokenRequest.Spec.ExpirationSeconds = 10
+			tokenRequest.Spec.SubjectToken = ""
+			tokenRequest.Spec.ContextObject = &security.ContextObject{APIVersion: "foo.bar/v1", Kind: "Baz", Name: "foo-bar"}
+
+			Expect(ValidateTokenExchangeRequest(tokenRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.expirationSeconds"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.contextObject"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.subjectToken"),
+				})),
+			))
+		})
 	})
 })
@@ -11,6 +11,7 @@ import (
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
@@ -41,6 +42,67 @@ func validateSpec(spec security.WorkloadIdentitySpec, path *field.Path) field.Er
 	allErrs = append(allErrs, validateAudiences(spec.Audiences, path.Child("audiences"))...)
 	allErrs = append(allErrs, validateTargetSystem(spec.TargetSystem, path.Child("targetSystem"))...)
 
+	if spec.TokenExchange != nil {
+		allErrs = append(allErrs, validateTokenExchange(*spec.TokenExchange, path.Child("tokenExchange"))...)
+	}
+
+	return allErrs
+}
+
+// reservedTokenExchangeClaims are the claims which are set by the Gardener API server and hence cannot be configured.
+var reservedTokenExchangeClaims = sets.New("iss", "sub", "aud", "exp", "nbf", "iat", "jti", "gardener.cloud")
+
+// validateTokenExchange validates a WorkloadIdentity TokenExchange object.
+func validateTokenExchange(tokenExchange security.TokenExchange, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(tokenExchange.Subjects) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("subjects"), "must provide at least one subject"))
+	}
+
+	subjects := sets.New[security.TokenExchangeSubject]()
+	for idx, subject := range tokenExchange.Subjects {
+		idxPath := fldPath.Child("subjects").Index(idx)
+
+		if len(subject.ShootName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("shootName"), "must provide a shoot name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(subject.ShootName) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("shootName"), subject.ShootName, msg))
+			}
+		}
+
+		if len(subject.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide a namespace or '*'"))
+		} else if subject.Namespace != "*" {
+			for _, msg := range validation.IsDNS1123Label(subject.Namespace) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("namespace"), subject.Namespace, msg))
+			}
+		}
+
+		if len(subject.ServiceAccountName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("serviceAccountName"), "must provide a service account name or '*'"))
+		} else if subject.ServiceAccountName != "*" {
+			for _, msg := range validation.IsDNS1123Subdomain(subject.ServiceAccountName) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("serviceAccountName"), subject.ServiceAccountName, msg))
+			}
+		}
+
+		if subjects.Has(subject) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, subject))
+		}
+		subjects.Insert(subject)
+	}
+
+	for claim := range tokenExchange.Claims {
+		if len(claim) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("claims"), "claim names must not be empty"))
+		}
+		if reservedTokenExchangeClaims.Has(claim) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("claims").Key(claim), "claim is reserved and cannot be configured"))
+		}
+	}
+
 	return allErrs
 }
 
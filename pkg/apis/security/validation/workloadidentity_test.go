@@ -243,6 +243,88 @@ var _ = Describe("WorkloadIdentity Validation Tests", func() {
 			),
 		)
 
+		DescribeTable("TokenExchange",
+			func(tokenExchange *security.TokenExchange, matcher gomegatypes.GomegaMatcher) {
+				workloadIdentity.Spec.TokenExchange = tokenExchange
+				errList := ValidateWorkloadIdentity(workloadIdentity)
+				Expect(errList).To(matcher)
+			},
+			Entry("should allow unset token exchange",
+				nil,
+				BeEmpty(),
+			),
+			Entry("should allow valid token exchange",
+				&security.TokenExchange{
+					Subjects: []security.TokenExchangeSubject{
+						{ShootName: "foo", Namespace: "default", ServiceAccountName: "app"},
+						{ShootName: "bar", Namespace: "*", ServiceAccountName: "*"},
+					},
+					Claims: map[string]string{"team": "foo"},
+				},
+				BeEmpty(),
+			),
+			Entry("should forbid token exchange without subjects",
+				&security.TokenExchange{},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.tokenExchange.subjects"),
+					})),
+				),
+			),
+			Entry("should forbid invalid and duplicate subjects",
+				&security.TokenExchange{
+					Subjects: []security.TokenExchangeSubject{
+						{ShootName: "Foo", Namespace: "", ServiceAccountName: "app_1"},
+						{ShootName: "", Namespace: "default", ServiceAccountName: "app"},
+						{ShootName: "", Namespace: "default", ServiceAccountName: "app"},
+					},
+				},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.tokenExchange.subjects[0].shootName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.tokenExchange.subjects[0].namespace"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.tokenExchange.subjects[0].serviceAccountName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.tokenExchange.subjects[1].shootName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.tokenExchange.subjects[2].shootName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.tokenExchange.subjects[2]"),
+					})),
+				),
+			),
+			Entry("should forbid reserved claims",
+				&security.TokenExchange{
+					Subjects: []security.TokenExchangeSubject{{ShootName: "foo", Namespace: "*", ServiceAccountName: "*"}},
+					Claims:   map[string]string{"sub": "foo", "gardener.cloud": "bar"},
+				},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.tokenExchange.claims[sub]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.tokenExchange.claims[gardener.cloud]"),
+					})),
+				),
+			),
+		)
+
 		DescribeTable("Sub claim",
 			func(name string, f func() (string, string), matcher gomegatypes.GomegaMatcher) {
 				workloadIdentity.Name = name
@@ -136,6 +136,50 @@ func (in *TargetSystem) DeepCopy() *TargetSystem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenExchange) DeepCopyInto(out *TokenExchange) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]TokenExchangeSubject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenExchange.
+func (in *TokenExchange) DeepCopy() *TokenExchange {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenExchange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenExchangeSubject) DeepCopyInto(out *TokenExchangeSubject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenExchangeSubject.
+func (in *TokenExchangeSubject) DeepCopy() *TokenExchangeSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenExchangeSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenRequest) DeepCopyInto(out *TokenRequest) {
 	*out = *in
@@ -272,6 +316,11 @@ func (in *WorkloadIdentitySpec) DeepCopyInto(out *WorkloadIdentitySpec) {
 		copy(*out, *in)
 	}
 	in.TargetSystem.DeepCopyInto(&out.TargetSystem)
+	if in.TokenExchange != nil {
+		in, out := &in.TokenExchange, &out.TokenExchange
+		*out = new(TokenExchange)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
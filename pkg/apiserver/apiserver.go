@@ -5,6 +5,7 @@
 package apiserver
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -13,6 +14,7 @@ import (
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/server/healthz"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/util/keyutil"
 
@@ -35,6 +37,7 @@ type ExtraConfig struct {
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
 	WorkloadIdentitySigningKey         any
+	WorkloadIdentitySignerRefresh      time.Duration
 }
 
 // Config contains Gardener API server configuration.
@@ -93,6 +96,14 @@ func (c completedConfig) New() (*GardenerServer, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to create workload identity token issuer: %w", err)
 		}
+
+		// Remote signers are external dependencies, hence the API server should only be considered ready if the signer
+		// is reachable.
+		if checker, ok := c.ExtraConfig.WorkloadIdentitySigningKey.(healthz.HealthChecker); ok {
+			if err := genericServer.AddReadyzChecks(checker); err != nil {
+				return nil, fmt.Errorf("failed to add readiness check for workload identity signer: %w", err)
+			}
+		}
 	}
 
 	var (
@@ -110,6 +121,7 @@ func (c completedConfig) New() (*GardenerServer, error) {
 		securityAPIGroupInfo       = (securityrest.StorageProvider{
 			TokenIssuer:         tokenIssuer,
 			CoreInformerFactory: c.coreInformerFactory,
+			KubeInformerFactory: c.kubeInformerFactory,
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 	)
 
@@ -130,6 +142,9 @@ type ExtraOptions struct {
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
 	WorkloadIdentitySigningKeyFile     string
+	WorkloadIdentitySignerEndpoint     string
+	WorkloadIdentitySignerTimeout      time.Duration
+	WorkloadIdentitySignerRefresh      time.Duration
 
 	LogLevel  string
 	LogFormat string
@@ -178,6 +193,24 @@ func (o *ExtraOptions) Validate() []error {
 		}
 	}
 
+	if len(o.WorkloadIdentitySignerEndpoint) != 0 {
+		if len(o.WorkloadIdentitySigningKeyFile) != 0 {
+			allErrors = append(allErrors, errors.New("--workload-identity-signer-endpoint and --workload-identity-signing-key-file are mutually exclusive"))
+		}
+
+		if err := workloadidentity.ValidateRemoteSignerEndpoint(o.WorkloadIdentitySignerEndpoint); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("--workload-identity-signer-endpoint is invalid, err: %w", err))
+		}
+
+		if o.WorkloadIdentitySignerTimeout <= 0 {
+			allErrors = append(allErrors, errors.New("--workload-identity-signer-timeout must be greater than 0"))
+		}
+
+		if o.WorkloadIdentitySignerRefresh <= 0 {
+			allErrors = append(allErrors, errors.New("--workload-identity-signer-refresh-interval must be greater than 0"))
+		}
+	}
+
 	if !sets.New(logger.AllLogLevels...).Has(o.LogLevel) {
 		allErrors = append(allErrors, fmt.Errorf("invalid --log-level: %s", o.LogLevel))
 	}
@@ -199,6 +232,9 @@ func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&o.WorkloadIdentityTokenMinExpiration, "workload-identity-token-min-expiration", time.Hour, "The minimum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration less than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.WorkloadIdentityTokenMaxExpiration, "workload-identity-token-max-expiration", time.Hour*48, "The maximum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration greater than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.StringVar(&o.WorkloadIdentitySigningKeyFile, "workload-identity-signing-key-file", o.WorkloadIdentitySigningKeyFile, "Path to the file that contains the current private key of the workload identity token issuer. The issuer will sign issued ID tokens with this private key.")
+	fs.StringVar(&o.WorkloadIdentitySignerEndpoint, "workload-identity-signer-endpoint", o.WorkloadIdentitySignerEndpoint, "The endpoint of an external signing service (e.g., backed by an HSM or KMS) which signs the workload identity tokens, e.g. 'unix:///var/run/signer.sock'. Mutually exclusive with --workload-identity-signing-key-file.")
+	fs.DurationVar(&o.WorkloadIdentitySignerTimeout, "workload-identity-signer-timeout", 3*time.Second, "The timeout for requests to the external signing service of workload identity tokens.")
+	fs.DurationVar(&o.WorkloadIdentitySignerRefresh, "workload-identity-signer-refresh-interval", time.Minute, "The interval in which the public keys of the external signing service of workload identity tokens are refreshed.")
 
 	fs.StringVar(&o.LogLevel, "log-level", "info", "The level/severity for the logs. Must be one of [info,debug,error]")
 	fs.StringVar(&o.LogFormat, "log-format", "json", "The format for the logs. Must be one of [json,text]")
@@ -212,6 +248,7 @@ func (o *ExtraOptions) ApplyTo(c *Config) error {
 	c.ExtraConfig.WorkloadIdentityTokenIssuer = o.WorkloadIdentityTokenIssuer
 	c.ExtraConfig.WorkloadIdentityTokenMinExpiration = o.WorkloadIdentityTokenMinExpiration
 	c.ExtraConfig.WorkloadIdentityTokenMaxExpiration = o.WorkloadIdentityTokenMaxExpiration
+	c.ExtraConfig.WorkloadIdentitySignerRefresh = o.WorkloadIdentitySignerRefresh
 
 	if len(o.WorkloadIdentitySigningKeyFile) != 0 {
 		signingKey, err := keyutil.PrivateKeyFromFile(o.WorkloadIdentitySigningKeyFile)
@@ -221,5 +258,13 @@ func (o *ExtraOptions) ApplyTo(c *Config) error {
 		c.ExtraConfig.WorkloadIdentitySigningKey = signingKey
 	}
 
+	if len(o.WorkloadIdentitySignerEndpoint) != 0 {
+		signer, err := workloadidentity.NewRemoteSigner(context.Background(), o.WorkloadIdentitySignerEndpoint, o.WorkloadIdentitySignerTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to create workload identity remote signer for endpoint %q: %w", o.WorkloadIdentitySignerEndpoint, err)
+		}
+		c.ExtraConfig.WorkloadIdentitySigningKey = signer
+	}
+
 	return nil
 }
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/apiserver"
+	"github.com/gardener/gardener/pkg/utils/workloadidentity"
+)
+
+var _ = Describe("ExtraOptions", func() {
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		options *ExtraOptions
+
+		dir        string
+		keyFile    string
+		privateKey *rsa.PrivateKey
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		// Unix socket paths are limited in length, hence the shorter temporary directory.
+		var err error
+		dir, err = os.MkdirTemp("", "apiserver")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { Expect(os.RemoveAll(dir)).To(Succeed()) })
+
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		keyFile = filepath.Join(dir, "key.pem")
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}), 0600)).To(Succeed())
+
+		options = &ExtraOptions{
+			ClusterIdentity:                    "garden",
+			AdminKubeconfigMaxExpiration:       24 * time.Hour,
+			ViewerKubeconfigMaxExpiration:      24 * time.Hour,
+			CredentialsRotationInterval:        90 * 24 * time.Hour,
+			WorkloadIdentityTokenIssuer:        "https://issuer.gardener.cloud",
+			WorkloadIdentityTokenMinExpiration: time.Hour,
+			WorkloadIdentityTokenMaxExpiration: 48 * time.Hour,
+			WorkloadIdentitySignerTimeout:      3 * time.Second,
+			WorkloadIdentitySignerRefresh:      time.Minute,
+			LogLevel:                           "info",
+			LogFormat:                          "json",
+		}
+	})
+
+	serveRemoteSigner := func() string {
+		localSigner, err := workloadidentity.NewLocalSigner(privateKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		socketPath := filepath.Join(dir, "signer.sock")
+		go func() {
+			defer GinkgoRecover()
+			Expect(localSigner.Serve(ctx, socketPath)).To(Succeed())
+		}()
+
+		Eventually(func() error {
+			_, err := os.Stat(socketPath)
+			return err
+		}).Should(Succeed())
+
+		return "unix://" + socketPath
+	}
+
+	Describe("#Validate", func() {
+		It("should succeed for valid options", func() {
+			Expect(options.Validate()).To(BeEmpty())
+		})
+
+		It("should succeed for a valid signing key file", func() {
+			options.WorkloadIdentitySigningKeyFile = keyFile
+
+			Expect(options.Validate()).To(BeEmpty())
+		})
+
+		It("should fail for an invalid signing key file", func() {
+			options.WorkloadIdentitySigningKeyFile = filepath.Join(dir, "missing.pem")
+
+			Expect(options.Validate()).To(ConsistOf(MatchError(ContainSubstring("--workload-identity-signing-key-file does not contain valid key"))))
+		})
+
+		It("should succeed for a valid signer endpoint", func() {
+			options.WorkloadIdentitySignerEndpoint = "unix:///var/run/signer.sock"
+
+			Expect(options.Validate()).To(BeEmpty())
+		})
+
+		It("should fail if signer endpoint and signing key file are configured", func() {
+			options.WorkloadIdentitySignerEndpoint = "unix:///var/run/signer.sock"
+			options.WorkloadIdentitySigningKeyFile = keyFile
+
+			Expect(options.Validate()).To(ConsistOf(MatchError("--workload-identity-signer-endpoint and --workload-identity-signing-key-file are mutually exclusive")))
+		})
+
+		It("should fail for invalid signer options", func() {
+			options.WorkloadIdentitySignerEndpoint = "https://signer.local"
+			options.WorkloadIdentitySignerTimeout = 0
+			options.WorkloadIdentitySignerRefresh = 0
+
+			Expect(options.Validate()).To(ConsistOf(
+				MatchError(ContainSubstring("--workload-identity-signer-endpoint is invalid")),
+				MatchError("--workload-identity-signer-timeout must be greater than 0"),
+				MatchError("--workload-identity-signer-refresh-interval must be greater than 0"),
+			))
+		})
+
+		It("should not validate the signer options if no signer endpoint is configured", func() {
+			options.WorkloadIdentitySignerTimeout = 0
+			options.WorkloadIdentitySignerRefresh = 0
+
+			Expect(options.Validate()).To(BeEmpty())
+		})
+	})
+
+	Describe("#ApplyTo", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			config = &Config{}
+		})
+
+		It("should apply the options", func() {
+			Expect(options.ApplyTo(config)).To(Succeed())
+
+			Expect(config.ExtraConfig).To(Equal(ExtraConfig{
+				AdminKubeconfigMaxExpiration:       24 * time.Hour,
+				ViewerKubeconfigMaxExpiration:      24 * time.Hour,
+				CredentialsRotationInterval:        90 * 24 * time.Hour,
+				WorkloadIdentityTokenIssuer:        "https://issuer.gardener.cloud",
+				WorkloadIdentityTokenMinExpiration: time.Hour,
+				WorkloadIdentityTokenMaxExpiration: 48 * time.Hour,
+				WorkloadIdentitySignerRefresh:      time.Minute,
+			}))
+		})
+
+		It("should load the signing key from the file", func() {
+			options.WorkloadIdentitySigningKeyFile = keyFile
+
+			Expect(options.ApplyTo(config)).To(Succeed())
+			Expect(config.ExtraConfig.WorkloadIdentitySigningKey).To(Equal(privateKey))
+		})
+
+		It("should create a remote signer for the signer endpoint", func() {
+			options.WorkloadIdentitySignerEndpoint = serveRemoteSigner()
+
+			Expect(options.ApplyTo(config)).To(Succeed())
+			Expect(config.ExtraConfig.WorkloadIdentitySigningKey).To(BeAssignableToTypeOf(&workloadidentity.RemoteSigner{}))
+
+			signer := config.ExtraConfig.WorkloadIdentitySigningKey.(*workloadidentity.RemoteSigner)
+			Expect(signer.PublicKeys()).To(ConsistOf(privateKey.Public()))
+		})
+
+		It("should fail if the remote signer is not reachable", func() {
+			options.WorkloadIdentitySignerEndpoint = "unix://" + filepath.Join(dir, "missing.sock")
+
+			Expect(options.ApplyTo(config)).To(MatchError(ContainSubstring("failed to create workload identity remote signer")))
+		})
+	})
+})
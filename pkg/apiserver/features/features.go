@@ -17,5 +17,6 @@ func RegisterFeatureGates() {
 		features.ShootForceDeletion,
 		features.UseNamespacedCloudProfile,
 		features.ShootCredentialsBinding,
+		features.ShootAuditBackends,
 	)))
 }
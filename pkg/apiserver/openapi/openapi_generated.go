@@ -38,16 +38,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons":                                     schema_pkg_apis_core_v1beta1_Addons(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin":                            schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting":                                   schema_pkg_apis_core_v1beta1_Alerting(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AlertingCustomRules":                        schema_pkg_apis_core_v1beta1_AlertingCustomRules(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AlertingWebhookReceiver":                    schema_pkg_apis_core_v1beta1_AlertingWebhookReceiver(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AlternativeMachineType":                     schema_pkg_apis_core_v1beta1_AlternativeMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBackends":                              schema_pkg_apis_core_v1beta1_AuditBackends(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBatch":                                 schema_pkg_apis_core_v1beta1_AuditBatch(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig":                                schema_pkg_apis_core_v1beta1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditLogBackend":                            schema_pkg_apis_core_v1beta1_AuditLogBackend(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy":                                schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditTruncate":                              schema_pkg_apis_core_v1beta1_AuditTruncate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhookBackend":                        schema_pkg_apis_core_v1beta1_AuditWebhookBackend(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone":                           schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucket":                               schema_pkg_apis_core_v1beta1_BackupBucket(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketList":                           schema_pkg_apis_core_v1beta1_BackupBucketList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider":                       schema_pkg_apis_core_v1beta1_BackupBucketProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketReplication":                    schema_pkg_apis_core_v1beta1_BackupBucketReplication(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketSpec":                           schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketStatus":                         schema_pkg_apis_core_v1beta1_BackupBucketStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntry":                                schema_pkg_apis_core_v1beta1_BackupEntry(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryList":                            schema_pkg_apis_core_v1beta1_BackupEntryList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryReplicationStatus":               schema_pkg_apis_core_v1beta1_BackupEntryReplicationStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntrySpec":                            schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryStatus":                          schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion":                                    schema_pkg_apis_core_v1beta1_Bastion(ref),
@@ -101,10 +111,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                        schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                           schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":              schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HugePages":                                  schema_pkg_apis_core_v1beta1_HugePages(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ImagePrePull":                               schema_pkg_apis_core_v1beta1_ImagePrePull(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress":                                    schema_pkg_apis_core_v1beta1_Ingress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.IngressController":                          schema_pkg_apis_core_v1beta1_IngressController(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecret":                             schema_pkg_apis_core_v1beta1_InternalSecret(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecretList":                         schema_pkg_apis_core_v1beta1_InternalSecretList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KernelModule":                               schema_pkg_apis_core_v1beta1_KernelModule(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig":                        schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig":                schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig":                            schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
@@ -118,10 +131,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesConfig":                           schema_pkg_apis_core_v1beta1_KubernetesConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard":                        schema_pkg_apis_core_v1beta1_KubernetesDashboard(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings":                         schema_pkg_apis_core_v1beta1_KubernetesSettings(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastETCDRestore":                            schema_pkg_apis_core_v1beta1_LastETCDRestore(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError":                                  schema_pkg_apis_core_v1beta1_LastError(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance":                            schema_pkg_apis_core_v1beta1_LastMaintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation":                              schema_pkg_apis_core_v1beta1_LastOperation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoadBalancerServicesProxyProtocol":          schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Logging":                                    schema_pkg_apis_core_v1beta1_Logging(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutput":                              schema_pkg_apis_core_v1beta1_LoggingOutput(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutputBuffer":                        schema_pkg_apis_core_v1beta1_LoggingOutputBuffer(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutputRateLimit":                     schema_pkg_apis_core_v1beta1_LoggingOutputRateLimit(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine":                                    schema_pkg_apis_core_v1beta1_Machine(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings":           schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage":                               schema_pkg_apis_core_v1beta1_MachineImage(ref),
@@ -133,6 +151,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                      schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                    schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                 schema_pkg_apis_core_v1beta1_Monitoring(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MonitoringRemoteWrite":                      schema_pkg_apis_core_v1beta1_MonitoringRemoteWrite(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference":                     schema_pkg_apis_core_v1beta1_NamedResourceReference(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfile":                     schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileList":                 schema_pkg_apis_core_v1beta1_NamespacedCloudProfileList(ref),
@@ -146,6 +165,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                                 schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                      schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":          schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PreDrainHook":                               schema_pkg_apis_core_v1beta1_PreDrainHook(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                    schema_pkg_apis_core_v1beta1_Project(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                              schema_pkg_apis_core_v1beta1_ProjectMember(ref),
@@ -213,12 +233,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                 schema_pkg_apis_core_v1beta1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                            schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                     schema_pkg_apis_core_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerDrainPolicy":                          schema_pkg_apis_core_v1beta1_WorkerDrainPolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                           schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionApproval":                     schema_pkg_apis_operations_v1alpha1_BastionApproval(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy":                schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionList":                         schema_pkg_apis_operations_v1alpha1_BastionList(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSessionRecording":             schema_pkg_apis_operations_v1alpha1_BastionSessionRecording(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                         schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                       schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.ContextObject":                         schema_pkg_apis_security_v1alpha1_ContextObject(ref),
@@ -226,6 +249,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingList":                schema_pkg_apis_security_v1alpha1_CredentialsBindingList(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingProvider":            schema_pkg_apis_security_v1alpha1_CredentialsBindingProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem":                          schema_pkg_apis_security_v1alpha1_TargetSystem(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenExchange":                         schema_pkg_apis_security_v1alpha1_TokenExchange(ref),
+		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenExchangeSubject":                  schema_pkg_apis_security_v1alpha1_TokenExchangeSubject(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequest":                          schema_pkg_apis_security_v1alpha1_TokenRequest(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequestSpec":                      schema_pkg_apis_security_v1alpha1_TokenRequestSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenRequestStatus":                    schema_pkg_apis_security_v1alpha1_TokenRequestStatus(ref),
@@ -1165,12 +1190,185 @@ func schema_pkg_apis_core_v1beta1_Alerting(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"webhookReceivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookReceivers is a list of generic HTTP endpoints to which alerts are sent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AlertingWebhookReceiver"),
+									},
+								},
+							},
+						},
+					},
+					"customRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomRules references user-defined Prometheus alerting rules which are evaluated by the monitoring stack of the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AlertingCustomRules"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AlertingCustomRules", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AlertingWebhookReceiver"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AlertingCustomRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertingCustomRules references user-defined Prometheus alerting rules.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName is the name of the ConfigMap in the project namespace which contains the alerting rules under the 'rules.yaml' key. The rules must be given in the format of the `spec` of a PrometheusRule resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"configMapName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AlertingWebhookReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertingWebhookReceiver contains information about a generic HTTP endpoint to which alerts are sent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the receiver.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTPS endpoint to which alerts are sent in the Alertmanager webhook format. It must not point to loopback, private, link-local or cluster-internal addresses.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sendResolved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SendResolved controls whether notifications about resolved alerts are sent (default: true).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name", "url"},
 			},
 		},
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AlternativeMachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlternativeMachineType contains information about an alternative machine type of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the machine type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this type. Defaults to the architecture of the worker pool. The machine image of the worker pool must support this architecture.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the provider-specific configuration for the machines of this type, e.g., whether spot instances shall be used. If it is not set, the provider config of the worker pool is used.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditBackends(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBackends contains the configuration of the backends to which the audit events are forwarded.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"log": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log ships the audit events into the logging stack of the shoot control plane.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditLogBackend"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook sends the audit events to an external HTTPS endpoint.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhookBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditLogBackend", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhookBackend"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBatch contains the settings for batching audit events.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum number of events in a batch. If not set, batches contain up to 400 events.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxWait is the amount of time to wait before force sending a batch that has not reached the maximum size. If not set, batches are sent after 30s at the latest.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AuditConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1184,6 +1382,33 @@ func schema_pkg_apis_core_v1beta1_AuditConfig(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"),
 						},
 					},
+					"backends": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backends contains the configuration of the backends to which the audit events are forwarded. If set, an audit forwarder running next to the kube-apiserver stores the events in a persistent queue and delivers them to each backend. If not set, the audit events are written to a log file inside the kube-apiserver container which is neither persisted nor shipped anywhere. This field is only available if the `ShootAuditBackends` feature gate is enabled.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBackends"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBackends", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditLogBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogBackend contains the configuration of the audit log backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy contains the audit policy for this backend. It can only reduce the events and their level selected by the audit policy of the kube-apiserver. If not set, all events selected by the audit policy of the kube-apiserver are shipped.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"),
+						},
+					},
 				},
 			},
 		},
@@ -1213,6 +1438,88 @@ func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AuditTruncate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditTruncate contains the settings for truncating audit events and batches.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchSize is the maximum size of a batch sent to the backend. Larger batches are split.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxEventSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEventSize is the maximum size of an audit event sent to the backend. Larger events are truncated by removing the request and response objects, or dropped if this is not sufficient.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditWebhookBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditWebhookBackend contains the configuration of the audit webhook backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTPS endpoint to which the audit events are sent.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the project namespace containing the credentials for the endpoint and the key for signing the batches. The secret may contain a bearer token in the `token` key, a CA bundle in the `ca.crt` key and a signing key in the `signing.key` key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy contains the audit policy for this backend. It can only reduce the events and their level selected by the audit policy of the kube-apiserver. If not set, all events selected by the audit policy of the kube-apiserver are sent.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"),
+						},
+					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch contains the settings for batching audit events.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBatch"),
+						},
+					},
+					"truncate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Truncate contains the settings for truncating audit events and batches.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditTruncate"),
+						},
+					},
+					"initialBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialBackoff is the amount of time to wait before retrying the first failed request. Consecutive failures are retried with an exponential backoff of up to five minutes until the request succeeds. If not set, the first retry happens after 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditBatch", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditTruncate", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AvailabilityZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1397,6 +1704,56 @@ func schema_pkg_apis_core_v1beta1_BackupBucketProvider(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_BackupBucketReplication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupBucketReplication contains the configuration for replicating the backups of a BackupBucket to a secondary object store.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider holds the details of cloud provider of the secondary object store.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider"),
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to the BackupBucket resource of the secondary object store.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret that contains the credentials to access the secondary object store.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"maxBackupAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackupAge is the maximum age in days of the backups which are copied to the secondary object store. Older backups are not replicated. If not set, all backups are replicated.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the maximum number of the most recent full backups which are copied to the secondary object store. If not set, all backups are replicated.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"provider", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1431,12 +1788,18 @@ func schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"replication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replication configures the continuous replication of the backups stored in this bucket to a secondary object store, e.g., in a different region or of a different provider.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketReplication"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketReplication", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -1588,6 +1951,39 @@ func schema_pkg_apis_core_v1beta1_BackupEntryList(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_BackupEntryReplicationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupEntryReplicationStatus contains information about the replication of a BackupEntry to the secondary object store of its BackupBucket.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastReplicationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReplicationTime is the time when the last successful replication was started. All backups taken before this time are available in the secondary object store.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Lag is the duration between now and the last successful replication at the time the status was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError holds information about the last occurred error during the replication.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_BackupEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1656,11 +2052,17 @@ func schema_pkg_apis_core_v1beta1_BackupEntryStatus(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"replication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replication contains information about the replication of this BackupEntry to the secondary object store.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryReplicationStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupEntryReplicationStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1819,12 +2221,18 @@ func schema_pkg_apis_core_v1beta1_CRI(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"imagePrePull": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePrePull configures images which are pre-pulled on the nodes of the worker pool and pinned against garbage collection.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ImagePrePull"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ImagePrePull"},
 	}
 }
 
@@ -3873,8 +4281,73 @@ func schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref common.Refer
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HugePages(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HugePages contains configuration for huge pages of a certain size.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pageSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PageSize is the size of the huge pages, e.g., 2Mi or 1Gi.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of huge pages of the given size which are allocated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"pageSize", "count"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ImagePrePull(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImagePrePull contains configuration for pre-pulling images on the nodes of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"systemComponents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SystemComponents states whether the images of the system components running on every node of the worker pool (e.g., kube-proxy, node-local-dns) are pre-pulled. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"images": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Images is a list of additional image references which are pre-pulled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -4071,6 +4544,44 @@ func schema_pkg_apis_core_v1beta1_InternalSecretList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_KernelModule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KernelModule contains configuration for a kernel module.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kernel module.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is a map of parameters passed to the kernel module when it is loaded.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4900,6 +5411,50 @@ func schema_pkg_apis_core_v1beta1_KubernetesSettings(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_LastETCDRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LastETCDRestore holds information about a restore of the main etcd of the Shoot from the latest snapshot in its backup. Restoring to an arbitrary point in time is not supported.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human-readable message containing details about the restore.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggeredTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredTime is the time when the restore was triggered.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the restore, one of Processing, Succeeded.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the restore was completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"description", "triggeredTime", "state"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_LastError(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5073,6 +5628,159 @@ func schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref common.R
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_Logging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Logging contains information about the logging configuration for the shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"outputs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outputs is a list of additional endpoints to which logs of the shoot control plane and/or the shoot events are exported. The logs are still sent to the Gardener-managed logging stack.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutput"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutput"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_LoggingOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoggingOutput contains information about an endpoint to which logs are exported.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the output.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the protocol used to send the logs to the endpoint. Supported values are `OTLP` (OpenTelemetry protocol over HTTP) and `HTTP` (JSON lines over HTTP).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTPS endpoint to which logs are sent.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the project namespace which contains basic auth credentials (`username` and `password`) for the endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources is the list of log streams which are exported to the endpoint. Supported values are `ControlPlane` (logs of the shoot control plane components) and `Events` (events of the shoot cluster collected by the event logger).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate with which log records are sent to the endpoint. Records exceeding the limit are dropped.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutputRateLimit"),
+						},
+					},
+					"buffer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Buffer contains settings for buffering log records which could not be sent to the endpoint yet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutputBuffer"),
+						},
+					},
+				},
+				Required: []string{"name", "type", "url", "sources"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutputBuffer", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LoggingOutputRateLimit"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_LoggingOutputBuffer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoggingOutputBuffer contains settings for buffering log records.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"memoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryLimit is the maximum amount of memory used for buffering log records of this output.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"retryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryLimit is the maximum number of retries for sending a chunk of log records before it is discarded.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_LoggingOutputRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoggingOutputRateLimit contains settings for limiting the rate of exported log records.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recordsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordsPerSecond is the maximum number of log records per second which are sent to the endpoint.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"recordsPerSecond"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5101,12 +5809,26 @@ func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.O
 							Format:      "",
 						},
 					},
+					"alternativeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlternativeTypes is a prioritized list of further machine types of the worker pool. They are used if no capacity is available for machines of a type with a higher priority, i.e., `type` has the highest priority. The provider extension creates separate machine deployments for each machine type, and cluster-autoscaler scales them up according to their priority. Hence, the `minimum` of the worker pool only applies to the machines of `type`, while the `maximum` applies to the machines of each type individually. The worker pool can thus grow to `maximum` times the number of machine types, which is accounted for in quotas and must fit into the nodes network.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AlternativeMachineType"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AlternativeMachineType", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage"},
 	}
 }
 
@@ -5517,15 +6239,81 @@ func schema_pkg_apis_core_v1beta1_Monitoring(ref common.ReferenceCallback) commo
 				Properties: map[string]spec.Schema{
 					"alerting": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Alerting contains information about the alerting configuration for the shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting"),
+							Description: "Alerting contains information about the alerting configuration for the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting"),
+						},
+					},
+					"remoteWrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteWrite is a list of endpoints to which metrics of the shoot control plane are exported via the Prometheus remote write protocol.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MonitoringRemoteWrite"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MonitoringRemoteWrite"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MonitoringRemoteWrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MonitoringRemoteWrite contains information about an endpoint to which metrics of the shoot control plane are exported.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the remote write endpoint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTPS endpoint to which metrics are sent.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the project namespace which contains basic auth credentials (`username` and `password`) for the remote write endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Keep is the list of names of metrics which are exported to the remote write endpoint. All other metrics are dropped.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name", "url", "keep"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting"},
 	}
 }
 
@@ -6217,6 +7005,58 @@ func schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref common.R
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_PreDrainHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PreDrainHook is a webhook which is called before the pods of a node are evicted. The webhook receives a POST request with the name of the node and of its worker pool and must respond with a 2xx status code once it is fine to drain the node. Hooks might be called multiple times for the same node and must therefore be idempotent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the hook.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTPS URL of the webhook.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is a PEM encoded CA bundle which is used to verify the serving certificate of the webhook. If it is not set, the system trust roots of the machine are used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximum duration for the webhook call. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"failurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy defines how a failed webhook call is handled. Possible values are `Fail` (default) and `Ignore`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "url"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7155,12 +7995,18 @@ func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) commo
 							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
+					"replication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replication configures the continuous replication of the backups to a secondary object store, e.g., in a different region or of a different provider.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketReplication"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketReplication", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -8763,12 +9609,18 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logging contains information about custom logging configurations for the shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Logging"),
+						},
+					},
 				},
 				Required: []string{"kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Logging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
 	}
 }
 
@@ -9119,12 +9971,18 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus"),
 						},
 					},
+					"lastETCDRestore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastETCDRestore holds information about the last restore of the main etcd from its backup.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastETCDRestore"),
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastETCDRestore", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9668,12 +10526,89 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions"),
 						},
 					},
+					"kernelModules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KernelModules is a list of kernel modules to load on all machines in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KernelModule"),
+									},
+								},
+							},
+						},
+					},
+					"hugePages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HugePages is a list of huge pages configurations to apply on all machines in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HugePages"),
+									},
+								},
+							},
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy specifies how the machines of this worker pool are updated when their configuration changes. Possible values are `AutoRollingUpdate` (default) and `AutoInPlaceUpdate`. The strategy cannot be changed once the worker pool was created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"drainPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrainPolicy contains the policy for draining the nodes of this worker pool before their machines are replaced or updated in-place. The drain timeout and the maximum number of eviction retries are configured in the `machineControllerManager` settings.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerDrainPolicy"),
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HugePages", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KernelModule", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerDrainPolicy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerDrainPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerDrainPolicy contains the policy for draining the nodes of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"evictionOrder": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionOrder specifies the order in which the pods of a node are evicted. Possible values are `Parallel` (default) and `PriorityAscending`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"preDrainHooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreDrainHooks is a list of webhooks which are called one after another before the pods of a node are evicted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.PreDrainHook"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.PreDrainHook"},
 	}
 }
 
@@ -9799,6 +10734,26 @@ func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_operations_v1alpha1_BastionApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionApproval contains information about the approval of a Bastion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the name of the user who approved the Bastion. It must not be the user who created the Bastion. This field is immutable once set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9873,6 +10828,28 @@ func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_operations_v1alpha1_BastionSessionRecording(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionSessionRecording configures the recording of shell sessions on a bastion host.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled specifies whether shell sessions on the bastion host are recorded. Recordings are stored in the backup bucket of the seed the Bastion is scheduled to.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9923,12 +10900,30 @@ func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"maxDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDuration is the maximum duration after which the Bastion is deleted, independent of its heartbeats. It cannot extend the maximum lifetime of Bastions configured by the Gardener operator.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"sessionRecording": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionRecording configures the recording of shell sessions on the bastion host. This field is immutable.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSessionRecording"),
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval contains information about the approval of the Bastion. If set, the bastion host is only created after the Bastion has been approved.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionApproval"),
+						},
+					},
 				},
 				Required: []string{"shootRef", "sshPublicKey", "ingress"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionApproval", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSessionRecording", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -10213,6 +11208,90 @@ func schema_pkg_apis_security_v1alpha1_TargetSystem(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_security_v1alpha1_TokenExchange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenExchange configures the exchange of shoot service account tokens for workload identity tokens.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subjects is the list of shoot service accounts which are allowed to exchange their tokens.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenExchangeSubject"),
+									},
+								},
+							},
+						},
+					},
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims are additional claims which are set in the tokens issued via token exchange. Registered JWT claims and the 'gardener.cloud' claim cannot be overwritten.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"subjects"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenExchangeSubject"},
+	}
+}
+
+func schema_pkg_apis_security_v1alpha1_TokenExchangeSubject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenExchangeSubject identifies service accounts of a shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shootName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootName is the name of the shoot in the namespace of the WorkloadIdentity. The shoot must use a managed service account issuer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the service account in the shoot cluster. The value '*' matches all namespaces.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName is the name of the service account in the shoot cluster. The value '*' matches all service accounts of the namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"shootName", "namespace", "serviceAccountName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_security_v1alpha1_TokenRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10284,6 +11363,13 @@ func schema_pkg_apis_security_v1alpha1_TokenRequestSpec(ref common.ReferenceCall
 							Format:      "int64",
 						},
 					},
+					"subjectToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubjectToken is a service account token of a shoot cluster which is exchanged for a workload identity token. It must only be set when creating the 'exchange' subresource of a WorkloadIdentity.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -10453,12 +11539,18 @@ func schema_pkg_apis_security_v1alpha1_WorkloadIdentitySpec(ref common.Reference
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem"),
 						},
 					},
+					"tokenExchange": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenExchange configures which workloads running in shoot clusters are allowed to obtain tokens for this WorkloadIdentity by exchanging a token of a service account of the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenExchange"),
+						},
+					},
 				},
 				Required: []string{"audiences", "targetSystem"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem"},
+			"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem", "github.com/gardener/gardener/pkg/apis/security/v1alpha1.TokenExchange"},
 	}
 }
 
@@ -61,6 +61,10 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	if !utilfeature.DefaultFeatureGate.Enabled(features.ShootCredentialsBinding) {
 		newShoot.Spec.CredentialsBindingName = nil
 	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(features.ShootAuditBackends) {
+		dropAuditBackends(newShoot)
+	}
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
@@ -79,6 +83,23 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 	if oldShoot.Spec.CredentialsBindingName == nil && !utilfeature.DefaultFeatureGate.Enabled(features.ShootCredentialsBinding) {
 		newShoot.Spec.CredentialsBindingName = nil
 	}
+
+	if getAuditBackends(oldShoot) == nil && !utilfeature.DefaultFeatureGate.Enabled(features.ShootAuditBackends) {
+		dropAuditBackends(newShoot)
+	}
+}
+
+func getAuditBackends(shoot *core.Shoot) *core.AuditBackends {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil || shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig == nil {
+		return nil
+	}
+	return shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends
+}
+
+func dropAuditBackends(shoot *core.Shoot) {
+	if getAuditBackends(shoot) != nil {
+		shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends = nil
+	}
 }
 
 func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
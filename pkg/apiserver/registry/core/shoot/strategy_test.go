@@ -156,6 +156,24 @@ var _ = Describe("Strategy", func() {
 
 				Expect(shoot.Spec.CredentialsBindingName).To(Equal(ptr.To("binding")))
 			})
+
+			It("should remove the audit backends if ShootAuditBackends feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootAuditBackends, false))
+
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{Log: &core.AuditLogBackend{}}}}
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends).To(BeNil())
+			})
+
+			It("should not remove the audit backends if ShootAuditBackends feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootAuditBackends, true))
+
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{Log: &core.AuditLogBackend{}}}}
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends).To(Equal(&core.AuditBackends{Log: &core.AuditLogBackend{}}))
+			})
 		})
 	})
 
@@ -291,6 +309,25 @@ var _ = Describe("Strategy", func() {
 				Expect(newShoot.Spec.CredentialsBindingName).To(Equal(ptr.To("binding")))
 			})
 
+			It("should remove the audit backends if ShootAuditBackends feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootAuditBackends, false))
+
+				newShoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{Log: &core.AuditLogBackend{}}}}
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends).To(BeNil())
+			})
+
+			It("should not remove the audit backends if ShootAuditBackends feature gate is disabled but they are present in the old Shoot", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootAuditBackends, false))
+
+				oldShoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{Log: &core.AuditLogBackend{}}}}
+				newShoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{AuditConfig: &core.AuditConfig{Backends: &core.AuditBackends{Log: &core.AuditLogBackend{}}}}
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Backends).To(Equal(&core.AuditBackends{Log: &core.AuditLogBackend{}}))
+			})
+
 			It("should not mutate shoots being deleted (cloud profile sync)", func() {
 				oldShoot.Spec.CloudProfileName = ptr.To("profile")
 				oldShoot.DeletionTimestamp = ptr.To(metav1.Now())
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	kubeinformers "k8s.io/client-go/informers"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/security"
@@ -23,6 +24,7 @@ import (
 type StorageProvider struct {
 	TokenIssuer         workloadidentity.TokenIssuer
 	CoreInformerFactory gardencoreinformers.SharedInformerFactory
+	KubeInformerFactory kubeinformers.SharedInformerFactory
 }
 
 // NewRESTStorage creates a new API group info object and registers the v1alpha1 Garden storage.
@@ -47,9 +49,11 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 		restOptionsGetter,
 		p.TokenIssuer,
 		p.CoreInformerFactory,
+		p.KubeInformerFactory,
 	)
 	storage["workloadidentities"] = workloadIdentityStorage.WorkloadIdentity
 	storage["workloadidentities/token"] = workloadIdentityStorage.TokenRequest
+	storage["workloadidentities/exchange"] = workloadIdentityStorage.TokenExchange
 
 	return storage
 }
@@ -29,6 +29,8 @@ type gardener struct {
 	Shoot            *ref `json:"shoot,omitempty"`
 	Project          *ref `json:"project,omitempty"`
 	Seed             *ref `json:"seed,omitempty"`
+	// ShootServiceAccount is only set for tokens issued in exchange for a shoot service account token.
+	ShootServiceAccount *ref `json:"shootServiceAccount,omitempty"`
 }
 
 type ref struct {
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
+	kubeinformers "k8s.io/client-go/informers"
 
 	"github.com/gardener/gardener/pkg/apis/security"
 	"github.com/gardener/gardener/pkg/apiserver/registry/security/workloadidentity"
@@ -25,6 +26,7 @@ type REST struct {
 type WorkloadIdentityStorage struct {
 	WorkloadIdentity *REST
 	TokenRequest     *TokenRequestREST
+	TokenExchange    *TokenExchangeREST
 }
 
 // NewStorage creates a new WorkloadIdentityStorage object.
@@ -32,12 +34,15 @@ func NewStorage(
 	optsGetter generic.RESTOptionsGetter,
 	tokenIssuer workloadidentityutils.TokenIssuer,
 	coreInformerFactory gardencoreinformers.SharedInformerFactory,
+	kubeInformerFactory kubeinformers.SharedInformerFactory,
 ) WorkloadIdentityStorage {
 	workloadIdentityRest := NewREST(optsGetter)
+	tokenRequestRest := NewTokenRequestREST(workloadIdentityRest, tokenIssuer, coreInformerFactory)
 
 	return WorkloadIdentityStorage{
 		WorkloadIdentity: workloadIdentityRest,
-		TokenRequest:     NewTokenRequestREST(workloadIdentityRest, tokenIssuer, coreInformerFactory),
+		TokenRequest:     tokenRequestRest,
+		TokenExchange:    NewTokenExchangeREST(tokenRequestRest, kubeInformerFactory.Core().V1().Secrets().Lister()),
 	}
 }
 
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/api"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityapi "github.com/gardener/gardener/pkg/apis/security"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	securityv1alpha1constants "github.com/gardener/gardener/pkg/apis/security/v1alpha1/constants"
+	securityvalidation "github.com/gardener/gardener/pkg/apis/security/validation"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+const (
+	// serviceAccountSubjectPrefix is the prefix of the 'sub' claim of Kubernetes service account tokens.
+	serviceAccountSubjectPrefix = "system:serviceaccount:"
+	// subjectTokenLeeway is the tolerated clock skew when validating the time claims of subject tokens.
+	subjectTokenLeeway = time.Minute
+)
+
+var subjectTokenSignatureAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.RS384, jose.RS512, jose.ES256, jose.ES384, jose.ES512, jose.PS256, jose.PS384, jose.PS512}
+
+// TokenExchangeREST implements a RESTStorage for exchanging shoot service account tokens for workload identity tokens.
+type TokenExchangeREST struct {
+	*TokenRequestREST
+
+	secretLister kubecorev1listers.SecretLister
+}
+
+var _ = rest.NamedCreater(&TokenExchangeREST{})
+
+// shootServiceAccount is a service account of a shoot cluster whose token was successfully verified.
+type shootServiceAccount struct {
+	shoot     *gardencorev1beta1.Shoot
+	project   *gardencorev1beta1.Project
+	namespace string
+	name      string
+	uid       string
+}
+
+type serviceAccountClaims struct {
+	Kubernetes struct {
+		Namespace      string `json:"namespace"`
+		ServiceAccount struct {
+			Name string `json:"name"`
+			UID  string `json:"uid"`
+		} `json:"serviceaccount"`
+	} `json:"kubernetes.io"`
+}
+
+// Create returns a TokenRequest with a workload identity token in exchange for the service account token of a shoot
+// cluster given in the spec of the TokenRequest. The token is only issued if the WorkloadIdentity allows the exchange
+// for the respective shoot service account.
+func (r *TokenExchangeREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if r.tokenIssuer == nil {
+		return nil, errors.New("TokenIssuer is not set, workload identity tokens cannot be issued")
+	}
+
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	tokenRequest, workloadIdentity, err := r.prepareTokenRequest(ctx, name, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if errs := securityvalidation.ValidateTokenExchangeRequest(tokenRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(gvk.GroupKind(), "", errs)
+	}
+
+	if workloadIdentity.Spec.TokenExchange == nil {
+		return nil, apierrors.NewForbidden(securityapi.Resource("workloadidentities/exchange"), name, errors.New("token exchange is not enabled for this WorkloadIdentity"))
+	}
+
+	serviceAccount, err := r.verifySubjectToken(tokenRequest.Spec.SubjectToken, workloadIdentity)
+	if err != nil {
+		return nil, apierrors.NewForbidden(securityapi.Resource("workloadidentities/exchange"), name, err)
+	}
+
+	claims := []any{r.getTokenExchangeClaims(workloadIdentity, serviceAccount)}
+	if len(workloadIdentity.Spec.TokenExchange.Claims) > 0 {
+		customClaims := make(map[string]any, len(workloadIdentity.Spec.TokenExchange.Claims))
+		for k, v := range workloadIdentity.Spec.TokenExchange.Claims {
+			customClaims[k] = v
+		}
+		claims = append(claims, customClaims)
+	}
+
+	token, exp, err := r.tokenIssuer.IssueToken(
+		tokenExchangeSubject(workloadIdentity, serviceAccount),
+		workloadIdentity.Spec.Audiences,
+		tokenRequest.Spec.ExpirationSeconds,
+		claims...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue JSON Web Token: %w", err)
+	}
+
+	tokenRequest.Spec.SubjectToken = ""
+	tokenRequest.Status = securityapi.TokenRequestStatus{
+		Token:               token,
+		ExpirationTimestamp: metav1.Time{Time: *exp},
+	}
+
+	var out = &securityv1alpha1.TokenRequest{}
+	if err = api.Scheme.Convert(tokenRequest, out, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", tokenRequest, out, err)
+	}
+
+	return out, nil
+}
+
+// verifySubjectToken verifies the given service account token against the public keys of the service account issuers
+// of all shoots allowed by the WorkloadIdentity. Only shoots with a managed service account issuer are considered
+// since only their public keys are available in the garden cluster.
+func (r *TokenExchangeREST) verifySubjectToken(subjectToken string, workloadIdentity *securityapi.WorkloadIdentity) (*shootServiceAccount, error) {
+	parsed, err := jwt.ParseSigned(subjectToken, subjectTokenSignatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing subject token: %w", err)
+	}
+
+	var (
+		coreInformers = r.coreInformerFactory.Core().V1beta1()
+		shootNames    = sets.New[string]()
+	)
+
+	for _, subject := range workloadIdentity.Spec.TokenExchange.Subjects {
+		if shootNames.Has(subject.ShootName) {
+			continue
+		}
+		shootNames.Insert(subject.ShootName)
+
+		shoot, err := coreInformers.Shoots().Lister().Shoots(workloadIdentity.Namespace).Get(subject.ShootName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		project, err := admissionutils.ProjectForNamespaceFromLister(coreInformers.Projects().Lister(), shoot.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceAccount, err := r.verifyShootServiceAccountToken(parsed, shoot, project)
+		if err != nil {
+			// The token was not issued by this shoot, try the next one.
+			continue
+		}
+
+		if isTokenExchangeAllowed(workloadIdentity.Spec.TokenExchange.Subjects, serviceAccount) {
+			return serviceAccount, nil
+		}
+
+		return nil, fmt.Errorf("service account %s/%s of shoot %q is not allowed to exchange tokens", serviceAccount.namespace, serviceAccount.name, shoot.Name)
+	}
+
+	return nil, errors.New("subject token could not be verified for any of the allowed shoots")
+}
+
+func (r *TokenExchangeREST) verifyShootServiceAccountToken(parsed *jwt.JSONWebToken, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) (*shootServiceAccount, error) {
+	secret, err := r.secretLister.Secrets(gardencorev1beta1.GardenerShootIssuerNamespace).Get(gardenerutils.ComputeManagedShootIssuerSecretName(project.Name, shoot.UID))
+	if err != nil {
+		return nil, fmt.Errorf("failed getting public service account keys of shoot: %w", err)
+	}
+
+	openIDConfig := struct {
+		Issuer string `json:"issuer"`
+	}{}
+	if err := json.Unmarshal(secret.Data["openid-config"], &openIDConfig); err != nil {
+		return nil, fmt.Errorf("failed parsing openid configuration of shoot: %w", err)
+	}
+
+	jwks := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(secret.Data["jwks"], jwks); err != nil {
+		return nil, fmt.Errorf("failed parsing public service account keys of shoot: %w", err)
+	}
+
+	var (
+		standardClaims = &jwt.Claims{}
+		privateClaims  = &serviceAccountClaims{}
+	)
+
+	if err := parsed.Claims(jwks, standardClaims, privateClaims); err != nil {
+		return nil, fmt.Errorf("failed verifying subject token: %w", err)
+	}
+
+	if err := standardClaims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      openIDConfig.Issuer,
+		AnyAudience: jwt.Audience{securityv1alpha1constants.TokenExchangeAudience},
+		Time:        time.Now(),
+	}, subjectTokenLeeway); err != nil {
+		return nil, fmt.Errorf("subject token is invalid: %w", err)
+	}
+
+	// Tokens without expiration are legacy service account tokens which should not be accepted.
+	if standardClaims.Expiry == nil {
+		return nil, errors.New("subject token must expire")
+	}
+
+	var (
+		namespace = privateClaims.Kubernetes.Namespace
+		name      = privateClaims.Kubernetes.ServiceAccount.Name
+	)
+
+	if namespace == "" || name == "" || standardClaims.Subject != serviceAccountSubjectPrefix+namespace+":"+name {
+		return nil, errors.New("subject token is not a service account token")
+	}
+
+	return &shootServiceAccount{
+		shoot:     shoot,
+		project:   project,
+		namespace: namespace,
+		name:      name,
+		uid:       privateClaims.Kubernetes.ServiceAccount.UID,
+	}, nil
+}
+
+func isTokenExchangeAllowed(subjects []securityapi.TokenExchangeSubject, serviceAccount *shootServiceAccount) bool {
+	for _, subject := range subjects {
+		if subject.ShootName == serviceAccount.shoot.Name &&
+			matchesOrWildcard(subject.Namespace, serviceAccount.namespace) &&
+			matchesOrWildcard(subject.ServiceAccountName, serviceAccount.name) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesOrWildcard(pattern, value string) bool {
+	return pattern == "*" || pattern == value
+}
+
+// tokenExchangeSubject returns the 'sub' claim of tokens issued via token exchange. It is derived from the 'sub' claim
+// of the WorkloadIdentity, the shoot and the shoot service account, so that external systems can distinguish the tokens
+// of different shoot workloads from each other and from the tokens issued via the token subresource.
+func tokenExchangeSubject(workloadIdentity *securityapi.WorkloadIdentity, serviceAccount *shootServiceAccount) string {
+	_, delimiter := securityvalidation.GetSubClaimPrefixAndDelimiterFunc()
+	return strings.Join([]string{workloadIdentity.Status.Sub, "shoot", serviceAccount.shoot.Name, "serviceaccount", serviceAccount.namespace, serviceAccount.name}, delimiter)
+}
+
+func (r *TokenExchangeREST) getTokenExchangeClaims(workloadIdentity *securityapi.WorkloadIdentity, serviceAccount *shootServiceAccount) *gardenerClaims {
+	claims := r.getGardenerClaims(workloadIdentity, serviceAccount.shoot.GetObjectMeta(), nil, serviceAccount.project.GetObjectMeta())
+	claims.Gardener.ShootServiceAccount = &ref{
+		Name:      serviceAccount.name,
+		Namespace: ptr.To(serviceAccount.namespace),
+		UID:       serviceAccount.uid,
+	}
+	return claims
+}
+
+// NewTokenExchangeREST returns a new TokenExchangeREST for exchanging shoot service account tokens.
+func NewTokenExchangeREST(tokenRequestREST *TokenRequestREST, secretLister kubecorev1listers.SecretLister) *TokenExchangeREST {
+	return &TokenExchangeREST{
+		TokenRequestREST: tokenRequestREST,
+		secretLister:     secretLister,
+	}
+}
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityapi "github.com/gardener/gardener/pkg/apis/security"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	securityv1alpha1constants "github.com/gardener/gardener/pkg/apis/security/v1alpha1/constants"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	"github.com/gardener/gardener/pkg/utils/workloadidentity"
+)
+
+type fakeWorkloadIdentityGetter struct {
+	workloadIdentity *securityapi.WorkloadIdentity
+}
+
+func (f *fakeWorkloadIdentityGetter) Get(_ context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	if f.workloadIdentity == nil || f.workloadIdentity.Name != name {
+		return nil, apierrors.NewNotFound(securityapi.Resource("workloadidentities"), name)
+	}
+	return f.workloadIdentity.DeepCopy(), nil
+}
+
+var _ = Describe("#TokenExchange", func() {
+	const (
+		issuer            = "https://test.local.gardener.cloud"
+		shootIssuer       = "https://discovery.local.gardener.cloud/projects/test-project/shoots/9a134d22-dd61-4845-951e-9a20bde1648a/issuer"
+		workloadName      = "identity"
+		workloadUID       = "ab920696-dd12-4723-9bc1-204cfe9edd40"
+		shootName         = "test-shoot"
+		shootUID          = types.UID("9a134d22-dd61-4845-951e-9a20bde1648a")
+		projectName       = "test-project"
+		projectUID        = types.UID("01c9c6fa-2b8b-496f-8edf-e382f4d61905")
+		namespaceName     = "garden-" + projectName
+		sub               = "gardener.cloud:workloadidentity:" + namespaceName + ":" + workloadName + ":" + workloadUID
+		serviceAccountUID = "5a2b3f57-4f3b-4e0b-9d3e-0d1c4d0f7c9a"
+	)
+
+	var (
+		ctx context.Context
+
+		shootKey         *ecdsa.PrivateKey
+		shootSigner      jose.Signer
+		workloadIdentity *securityapi.WorkloadIdentity
+		r                *TokenExchangeREST
+
+		serviceAccountToken = func(namespace, name string, audiences []string, expiry time.Time) string {
+			claims := map[string]any{
+				"iss": shootIssuer,
+				"sub": "system:serviceaccount:" + namespace + ":" + name,
+				"aud": audiences,
+				"iat": time.Now().Unix(),
+				"exp": expiry.Unix(),
+				"kubernetes.io": map[string]any{
+					"namespace": namespace,
+					"serviceaccount": map[string]any{
+						"name": name,
+						"uid":  serviceAccountUID,
+					},
+				},
+			}
+
+			token, err := jwt.Signed(shootSigner).Claims(claims).Serialize()
+			Expect(err).NotTo(HaveOccurred())
+			return token
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = genericapirequest.WithNamespace(context.Background(), namespaceName)
+
+		var err error
+		shootKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		shootSigner, err = jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: shootKey, KeyID: "shoot-key"}}, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: shootKey.Public(), KeyID: "shoot-key", Algorithm: string(jose.ES256), Use: "sig"}}})
+		Expect(err).NotTo(HaveOccurred())
+
+		workloadIdentity = &securityapi.WorkloadIdentity{
+			ObjectMeta: metav1.ObjectMeta{Name: workloadName, Namespace: namespaceName, UID: workloadUID},
+			Spec: securityapi.WorkloadIdentitySpec{
+				Audiences: []string{"sts.example.com"},
+				TokenExchange: &securityapi.TokenExchange{
+					Subjects: []securityapi.TokenExchangeSubject{
+						{ShootName: "other-shoot", Namespace: "*", ServiceAccountName: "*"},
+						{ShootName: shootName, Namespace: "default", ServiceAccountName: "app"},
+					},
+					Claims: map[string]string{"team": "foo"},
+				},
+			},
+			Status: securityapi.WorkloadIdentityStatus{Sub: sub},
+		}
+
+		coreInformerFactory := gardencoreinformers.NewSharedInformerFactory(nil, 0)
+		Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespaceName, Name: shootName, UID: shootUID},
+		})).To(Succeed())
+		Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: projectName, UID: projectUID},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To(namespaceName)},
+		})).To(Succeed())
+
+		kubeInformerFactory := kubeinformers.NewSharedInformerFactory(nil, 0)
+		Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gardencorev1beta1.GardenerShootIssuerNamespace, Name: projectName + "--" + string(shootUID)},
+			Data: map[string][]byte{
+				"openid-config": []byte(`{"issuer":"` + shootIssuer + `"}`),
+				"jwks":          jwks,
+			},
+		})).To(Succeed())
+
+		tokenIssuer, err := workloadidentity.NewTokenIssuer(rsaPrivateKey, issuer, 600, 3600*48)
+		Expect(err).NotTo(HaveOccurred())
+
+		r = NewTokenExchangeREST(
+			NewTokenRequestREST(&fakeWorkloadIdentityGetter{workloadIdentity: workloadIdentity}, tokenIssuer, coreInformerFactory),
+			kubeInformerFactory.Core().V1().Secrets().Lister(),
+		)
+	})
+
+	newTokenRequest := func(subjectToken string) *securityv1alpha1.TokenRequest {
+		return &securityv1alpha1.TokenRequest{
+			Spec: securityv1alpha1.TokenRequestSpec{
+				ExpirationSeconds: ptr.To[int64](3600),
+				SubjectToken:      subjectToken,
+			},
+		}
+	}
+
+	It("should issue a token bound to the shoot service account", func() {
+		subjectToken := serviceAccountToken("default", "app", []string{securityv1alpha1constants.TokenExchangeAudience}, time.Now().Add(time.Hour))
+
+		obj, err := r.Create(ctx, workloadName, newTokenRequest(subjectToken), nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		tokenRequest, ok := obj.(*securityv1alpha1.TokenRequest)
+		Expect(ok).To(BeTrue())
+		Expect(tokenRequest.Spec.SubjectToken).To(BeEmpty())
+		Expect(tokenRequest.Status.Token).NotTo(BeEmpty())
+
+		parsed, err := jwt.ParseSigned(tokenRequest.Status.Token, []jose.SignatureAlgorithm{jose.RS256})
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			standardClaims = &jwt.Claims{}
+			customClaims   = map[string]any{}
+			gardener       = &gardenerClaims{}
+		)
+		Expect(parsed.Claims(rsaPrivateKey.Public(), standardClaims, &customClaims, gardener)).To(Succeed())
+
+		Expect(standardClaims.Subject).To(Equal(sub + ":shoot:" + shootName + ":serviceaccount:default:app"))
+		Expect(standardClaims.Audience).To(ConsistOf("sts.example.com"))
+		Expect(customClaims).To(HaveKeyWithValue("team", "foo"))
+		Expect(gardener.Gardener.WorkloadIdentity.Name).To(Equal(workloadName))
+		Expect(gardener.Gardener.Shoot).To(Equal(&ref{Name: shootName, Namespace: ptr.To(namespaceName), UID: string(shootUID)}))
+		Expect(gardener.Gardener.Project).To(Equal(&ref{Name: projectName, UID: string(projectUID)}))
+		Expect(gardener.Gardener.ShootServiceAccount).To(Equal(&ref{Name: "app", Namespace: ptr.To("default"), UID: serviceAccountUID}))
+	})
+
+	It("should forbid the exchange if it is not enabled", func() {
+		workloadIdentity.Spec.TokenExchange = nil
+		subjectToken := serviceAccountToken("default", "app", []string{securityv1alpha1constants.TokenExchangeAudience}, time.Now().Add(time.Hour))
+
+		_, err := r.Create(ctx, workloadName, newTokenRequest(subjectToken), nil, nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("token exchange is not enabled")))
+	})
+
+	It("should reject requests without subject token", func() {
+		_, err := r.Create(ctx, workloadName, newTokenRequest(""), nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	})
+
+	DescribeTable("should forbid the exchange of invalid subject tokens",
+		func(subjectToken func() string, errMessage string) {
+			_, err := r.Create(ctx, workloadName, newTokenRequest(subjectToken()), nil, nil)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(errMessage)))
+		},
+
+		Entry("malformed token", func() string { return "foo" }, "failed parsing subject token"),
+		Entry("service account not allowed", func() string {
+			return serviceAccountToken("kube-system", "app", []string{securityv1alpha1constants.TokenExchangeAudience}, time.Now().Add(time.Hour))
+		}, `service account kube-system/app of shoot "test-shoot" is not allowed to exchange tokens`),
+		Entry("wrong audience", func() string {
+			return serviceAccountToken("default", "app", []string{"https://kubernetes.default.svc"}, time.Now().Add(time.Hour))
+		}, "subject token could not be verified"),
+		Entry("expired token", func() string {
+			return serviceAccountToken("default", "app", []string{securityv1alpha1constants.TokenExchangeAudience}, time.Now().Add(-time.Hour))
+		}, "subject token could not be verified"),
+		Entry("token signed by unknown key", func() string {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			shootSigner, err = jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: otherKey, KeyID: "shoot-key"}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			return serviceAccountToken("default", "app", []string{securityv1alpha1constants.TokenExchangeAudience}, time.Now().Add(time.Hour))
+		}, "subject token could not be verified"),
+	)
+})
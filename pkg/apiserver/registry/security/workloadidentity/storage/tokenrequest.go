@@ -75,13 +75,43 @@ func (r *TokenRequestREST) Create(ctx context.Context, name string, obj runtime.
 		}
 	}
 
+	tokenRequest, workloadIdentity, err := r.prepareTokenRequest(ctx, name, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if errs := securityvalidation.ValidateTokenRequest(tokenRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(gvk.GroupKind(), "", errs)
+	}
+
+	token, exp, err := r.issueToken(user, tokenRequest, workloadIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenRequest.Status = securityapi.TokenRequestStatus{
+		Token:               token,
+		ExpirationTimestamp: metav1.Time{Time: *exp},
+	}
+
+	var out = &securityv1alpha1.TokenRequest{}
+	if err = api.Scheme.Convert(tokenRequest, out, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", tokenRequest, out, err)
+	}
+
+	return out, nil
+}
+
+// prepareTokenRequest converts the given object to a TokenRequest, checks that it matches the WorkloadIdentity with the
+// given name and returns both objects.
+func (r *TokenRequestREST) prepareTokenRequest(ctx context.Context, name string, obj runtime.Object) (*securityapi.TokenRequest, *securityapi.WorkloadIdentity, error) {
 	tokenRequest := &securityapi.TokenRequest{}
 	if err := api.Scheme.Convert(obj, tokenRequest, nil); err != nil {
-		return nil, fmt.Errorf("failed converting %T to %T: %w", obj, tokenRequest, err)
+		return nil, nil, fmt.Errorf("failed converting %T to %T: %w", obj, tokenRequest, err)
 	}
 
 	if len(tokenRequest.Name) != 0 && tokenRequest.Name != name {
-		return nil, apierrors.NewInvalid(
+		return nil, nil, apierrors.NewInvalid(
 			tokenRequest.GroupVersionKind().GroupKind(),
 			tokenRequest.Name,
 			field.ErrorList{
@@ -96,11 +126,11 @@ func (r *TokenRequestREST) Create(ctx context.Context, name string, obj runtime.
 
 	namespace, ok := genericapirequest.NamespaceFrom(ctx)
 	if !ok {
-		return nil, apierrors.NewBadRequest("must specify namespace")
+		return nil, nil, apierrors.NewBadRequest("must specify namespace")
 	}
 
 	if len(tokenRequest.Namespace) != 0 && tokenRequest.Namespace != namespace {
-		return nil, apierrors.NewInvalid(
+		return nil, nil, apierrors.NewInvalid(
 			tokenRequest.GroupVersionKind().GroupKind(),
 			tokenRequest.Namespace,
 			field.ErrorList{
@@ -115,12 +145,12 @@ func (r *TokenRequestREST) Create(ctx context.Context, name string, obj runtime.
 
 	workloadIdentityObj, err := r.workloadIdentityGetter.Get(ctx, name, &metav1.GetOptions{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	workloadIdentity, ok := workloadIdentityObj.(*securityapi.WorkloadIdentity)
 	if !ok {
-		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *security.WorkloadIdentity object - got type %T", workloadIdentityObj))
+		return nil, nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *security.WorkloadIdentity object - got type %T", workloadIdentityObj))
 	}
 
 	if len(tokenRequest.Name) == 0 {
@@ -135,26 +165,7 @@ func (r *TokenRequestREST) Create(ctx context.Context, name string, obj runtime.
 	tokenRequest.ManagedFields = nil
 	tokenRequest.Status = securityapi.TokenRequestStatus{}
 
-	if errs := securityvalidation.ValidateTokenRequest(tokenRequest); len(errs) != 0 {
-		return nil, apierrors.NewInvalid(gvk.GroupKind(), "", errs)
-	}
-
-	token, exp, err := r.issueToken(user, tokenRequest, workloadIdentity)
-	if err != nil {
-		return nil, err
-	}
-
-	tokenRequest.Status = securityapi.TokenRequestStatus{
-		Token:               token,
-		ExpirationTimestamp: metav1.Time{Time: *exp},
-	}
-
-	var out = &securityv1alpha1.TokenRequest{}
-	if err = api.Scheme.Convert(tokenRequest, out, nil); err != nil {
-		return nil, fmt.Errorf("failed converting %T to %T: %w", tokenRequest, out, err)
-	}
-
-	return out, nil
+	return tokenRequest, workloadIdentity, nil
 }
 
 // GroupVersionKind returns the GVK for the workload identity request type.
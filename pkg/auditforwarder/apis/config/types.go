@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditForwarderConfiguration defines the configuration for the gardener-audit-forwarder.
+type AuditForwarderConfiguration struct {
+	metav1.TypeMeta
+	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
+	LogLevel string
+	// LogFormat is the output format for the logs. Must be one of [text,json].
+	LogFormat string
+	// Server defines the configuration of the HTTP servers.
+	Server ServerConfiguration
+	// Queue defines the configuration of the persistent queue.
+	Queue QueueConfiguration
+	// Backends defines the configuration of the backends to which the audit events are forwarded.
+	Backends BackendConfiguration
+}
+
+// ServerConfiguration contains details for the HTTP servers.
+type ServerConfiguration struct {
+	// Events is the configuration for serving the endpoint to which the kube-apiserver sends the audit events.
+	Events Server
+	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
+	HealthProbes *Server
+}
+
+// Server contains information for HTTP(S) server configuration.
+type Server struct {
+	// BindAddress is the IP address on which to listen for the specified port.
+	BindAddress string
+	// Port is the port on which to serve requests.
+	Port int
+}
+
+// QueueConfiguration contains the configuration of the persistent queue.
+type QueueConfiguration struct {
+	// Directory is the path to the directory in which the queue is stored.
+	Directory string
+	// MaxSize is the maximum size of the queue. Audit events are rejected while the queue is full.
+	MaxSize *resource.Quantity
+}
+
+// BackendConfiguration contains the configuration of the backends.
+type BackendConfiguration struct {
+	// Log is the configuration of the backend writing the audit events to the standard output.
+	Log *LogBackendConfiguration
+	// Webhook is the configuration of the backend sending the audit events to an HTTPS endpoint.
+	Webhook *WebhookBackendConfiguration
+}
+
+// LogBackendConfiguration contains the configuration of the log backend.
+type LogBackendConfiguration struct {
+	// PolicyFile is the path to the audit policy of this backend. If not set, all events are written.
+	PolicyFile *string
+}
+
+// WebhookBackendConfiguration contains the configuration of the webhook backend.
+type WebhookBackendConfiguration struct {
+	// URL is the HTTPS endpoint to which the audit events are sent.
+	URL string
+	// CAFile is the path to the CA bundle used to verify the certificate of the endpoint.
+	CAFile *string
+	// TokenFile is the path to the bearer token used to authenticate against the endpoint.
+	TokenFile *string
+	// SigningKeyFile is the path to the key used to sign the batches. If not set, the batches are not signed.
+	SigningKeyFile *string
+	// PolicyFile is the path to the audit policy of this backend. If not set, all events are sent.
+	PolicyFile *string
+	// Timeout is the timeout for a single request to the endpoint.
+	Timeout *metav1.Duration
+	// Batch contains the settings for batching audit events.
+	Batch BatchConfiguration
+	// Truncate contains the settings for truncating audit events and batches.
+	Truncate *TruncateConfiguration
+	// Retry contains the settings for retrying failed requests.
+	Retry RetryConfiguration
+}
+
+// BatchConfiguration contains the settings for batching audit events.
+type BatchConfiguration struct {
+	// MaxSize is the maximum number of events in a batch.
+	MaxSize *int32
+	// MaxWait is the amount of time to wait before force sending a batch that has not reached the maximum size.
+	MaxWait *metav1.Duration
+}
+
+// TruncateConfiguration contains the settings for truncating audit events and batches.
+type TruncateConfiguration struct {
+	// MaxBatchSize is the maximum size of a batch. Larger batches are split.
+	MaxBatchSize *resource.Quantity
+	// MaxEventSize is the maximum size of an audit event. Larger events are truncated by removing the request and
+	// response objects, or dropped if this is not sufficient.
+	MaxEventSize *resource.Quantity
+}
+
+// RetryConfiguration contains the settings for retrying failed requests.
+type RetryConfiguration struct {
+	// InitialBackoff is the amount of time to wait before retrying the first failed request.
+	InitialBackoff *metav1.Duration
+	// MaxBackoff is the maximum amount of time to wait between retries.
+	MaxBackoff *metav1.Duration
+}
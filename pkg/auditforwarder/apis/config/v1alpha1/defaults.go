@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_AuditForwarderConfiguration sets defaults for the configuration of the Gardener Audit Forwarder.
+func SetDefaults_AuditForwarderConfiguration(obj *AuditForwarderConfiguration) {
+	if obj.LogLevel == "" {
+		obj.LogLevel = logger.InfoLevel
+	}
+	if obj.LogFormat == "" {
+		obj.LogFormat = logger.FormatJSON
+	}
+}
+
+// SetDefaults_ServerConfiguration sets defaults for the server configuration.
+func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
+	// The kube-apiserver sends the audit events via the loopback interface, hence the endpoint must not be exposed.
+	if obj.Events.BindAddress == "" {
+		obj.Events.BindAddress = "127.0.0.1"
+	}
+	if obj.Events.Port == 0 {
+		obj.Events.Port = 2761
+	}
+
+	if obj.HealthProbes == nil {
+		obj.HealthProbes = &Server{}
+	}
+	if obj.HealthProbes.Port == 0 {
+		obj.HealthProbes.Port = 2762
+	}
+}
+
+// SetDefaults_QueueConfiguration sets defaults for the queue configuration.
+func SetDefaults_QueueConfiguration(obj *QueueConfiguration) {
+	if obj.MaxSize == nil {
+		obj.MaxSize = ptr.To(resource.MustParse("1Gi"))
+	}
+}
+
+// SetDefaults_WebhookBackendConfiguration sets defaults for the webhook backend configuration.
+func SetDefaults_WebhookBackendConfiguration(obj *WebhookBackendConfiguration) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// SetDefaults_BatchConfiguration sets defaults for the batch configuration.
+func SetDefaults_BatchConfiguration(obj *BatchConfiguration) {
+	if obj.MaxSize == nil {
+		obj.MaxSize = ptr.To[int32](400)
+	}
+	if obj.MaxWait == nil {
+		obj.MaxWait = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// SetDefaults_RetryConfiguration sets defaults for the retry configuration.
+func SetDefaults_RetryConfiguration(obj *RetryConfiguration) {
+	if obj.InitialBackoff == nil {
+		obj.InitialBackoff = &metav1.Duration{Duration: 10 * time.Second}
+	}
+	if obj.MaxBackoff == nil {
+		obj.MaxBackoff = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
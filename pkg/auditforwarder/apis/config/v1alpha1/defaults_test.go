@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/auditforwarder/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("AuditForwarderConfiguration", func() {
+		var obj *AuditForwarderConfiguration
+
+		BeforeEach(func() {
+			obj = &AuditForwarderConfiguration{}
+		})
+
+		It("should correctly default the configuration", func() {
+			SetObjectDefaults_AuditForwarderConfiguration(obj)
+
+			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
+			Expect(obj.LogFormat).To(Equal(logger.FormatJSON))
+			Expect(obj.Server.Events).To(Equal(Server{BindAddress: "127.0.0.1", Port: 2761}))
+			Expect(obj.Server.HealthProbes).To(PointTo(Equal(Server{Port: 2762})))
+			Expect(obj.Queue.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+			Expect(obj.Backends.Webhook).To(BeNil())
+		})
+
+		It("should not overwrite custom settings", func() {
+			obj.LogLevel = "foo"
+			obj.LogFormat = "bar"
+			obj.Server.Events = Server{BindAddress: "::1", Port: 1234}
+			obj.Server.HealthProbes = &Server{Port: 5678}
+			obj.Queue.MaxSize = ptr.To(resource.MustParse("2Gi"))
+
+			SetObjectDefaults_AuditForwarderConfiguration(obj)
+
+			Expect(obj.LogLevel).To(Equal("foo"))
+			Expect(obj.LogFormat).To(Equal("bar"))
+			Expect(obj.Server.Events).To(Equal(Server{BindAddress: "::1", Port: 1234}))
+			Expect(obj.Server.HealthProbes).To(PointTo(Equal(Server{Port: 5678})))
+			Expect(obj.Queue.MaxSize).To(PointTo(Equal(resource.MustParse("2Gi"))))
+		})
+
+		Describe("Webhook backend", func() {
+			It("should default the webhook backend", func() {
+				obj.Backends.Webhook = &WebhookBackendConfiguration{}
+
+				SetObjectDefaults_AuditForwarderConfiguration(obj)
+
+				Expect(obj.Backends.Webhook.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+				Expect(obj.Backends.Webhook.Batch.MaxSize).To(PointTo(Equal(int32(400))))
+				Expect(obj.Backends.Webhook.Batch.MaxWait).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+				Expect(obj.Backends.Webhook.Retry.InitialBackoff).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+				Expect(obj.Backends.Webhook.Retry.MaxBackoff).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			})
+
+			It("should not overwrite existing values", func() {
+				obj.Backends.Webhook = &WebhookBackendConfiguration{
+					Timeout: &metav1.Duration{Duration: time.Second},
+					Batch:   BatchConfiguration{MaxSize: ptr.To[int32](1), MaxWait: &metav1.Duration{Duration: time.Second}},
+					Retry:   RetryConfiguration{InitialBackoff: &metav1.Duration{Duration: time.Second}, MaxBackoff: &metav1.Duration{Duration: time.Minute}},
+				}
+
+				SetObjectDefaults_AuditForwarderConfiguration(obj)
+
+				Expect(obj.Backends.Webhook.Timeout).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+				Expect(obj.Backends.Webhook.Batch.MaxSize).To(PointTo(Equal(int32(1))))
+				Expect(obj.Backends.Webhook.Batch.MaxWait).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+				Expect(obj.Backends.Webhook.Retry.InitialBackoff).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+				Expect(obj.Backends.Webhook.Retry.MaxBackoff).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			})
+		})
+	})
+})
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditForwarderConfiguration defines the configuration for the gardener-audit-forwarder.
+type AuditForwarderConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+	// LogFormat is the output format for the logs. Must be one of [text,json].
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+	// Server defines the configuration of the HTTP servers.
+	// +optional
+	Server ServerConfiguration `json:"server"`
+	// Queue defines the configuration of the persistent queue.
+	Queue QueueConfiguration `json:"queue"`
+	// Backends defines the configuration of the backends to which the audit events are forwarded.
+	Backends BackendConfiguration `json:"backends"`
+}
+
+// ServerConfiguration contains details for the HTTP servers.
+type ServerConfiguration struct {
+	// Events is the configuration for serving the endpoint to which the kube-apiserver sends the audit events.
+	// +optional
+	Events Server `json:"events"`
+	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
+	// +optional
+	HealthProbes *Server `json:"healthProbes,omitempty"`
+}
+
+// Server contains information for HTTP(S) server configuration.
+type Server struct {
+	// BindAddress is the IP address on which to listen for the specified port.
+	BindAddress string `json:"bindAddress"`
+	// Port is the port on which to serve requests.
+	Port int `json:"port"`
+}
+
+// QueueConfiguration contains the configuration of the persistent queue.
+type QueueConfiguration struct {
+	// Directory is the path to the directory in which the queue is stored.
+	Directory string `json:"directory"`
+	// MaxSize is the maximum size of the queue. Audit events are rejected while the queue is full.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// BackendConfiguration contains the configuration of the backends.
+type BackendConfiguration struct {
+	// Log is the configuration of the backend writing the audit events to the standard output.
+	// +optional
+	Log *LogBackendConfiguration `json:"log,omitempty"`
+	// Webhook is the configuration of the backend sending the audit events to an HTTPS endpoint.
+	// +optional
+	Webhook *WebhookBackendConfiguration `json:"webhook,omitempty"`
+}
+
+// LogBackendConfiguration contains the configuration of the log backend.
+type LogBackendConfiguration struct {
+	// PolicyFile is the path to the audit policy of this backend. If not set, all events are written.
+	// +optional
+	PolicyFile *string `json:"policyFile,omitempty"`
+}
+
+// WebhookBackendConfiguration contains the configuration of the webhook backend.
+type WebhookBackendConfiguration struct {
+	// URL is the HTTPS endpoint to which the audit events are sent.
+	URL string `json:"url"`
+	// CAFile is the path to the CA bundle used to verify the certificate of the endpoint.
+	// +optional
+	CAFile *string `json:"caFile,omitempty"`
+	// TokenFile is the path to the bearer token used to authenticate against the endpoint.
+	// +optional
+	TokenFile *string `json:"tokenFile,omitempty"`
+	// SigningKeyFile is the path to the key used to sign the batches. If not set, the batches are not signed.
+	// +optional
+	SigningKeyFile *string `json:"signingKeyFile,omitempty"`
+	// PolicyFile is the path to the audit policy of this backend. If not set, all events are sent.
+	// +optional
+	PolicyFile *string `json:"policyFile,omitempty"`
+	// Timeout is the timeout for a single request to the endpoint.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Batch contains the settings for batching audit events.
+	// +optional
+	Batch BatchConfiguration `json:"batch"`
+	// Truncate contains the settings for truncating audit events and batches.
+	// +optional
+	Truncate *TruncateConfiguration `json:"truncate,omitempty"`
+	// Retry contains the settings for retrying failed requests.
+	// +optional
+	Retry RetryConfiguration `json:"retry"`
+}
+
+// BatchConfiguration contains the settings for batching audit events.
+type BatchConfiguration struct {
+	// MaxSize is the maximum number of events in a batch.
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+	// MaxWait is the amount of time to wait before force sending a batch that has not reached the maximum size.
+	// +optional
+	MaxWait *metav1.Duration `json:"maxWait,omitempty"`
+}
+
+// TruncateConfiguration contains the settings for truncating audit events and batches.
+type TruncateConfiguration struct {
+	// MaxBatchSize is the maximum size of a batch. Larger batches are split.
+	// +optional
+	MaxBatchSize *resource.Quantity `json:"maxBatchSize,omitempty"`
+	// MaxEventSize is the maximum size of an audit event. Larger events are truncated by removing the request and
+	// response objects, or dropped if this is not sufficient.
+	// +optional
+	MaxEventSize *resource.Quantity `json:"maxEventSize,omitempty"`
+}
+
+// RetryConfiguration contains the settings for retrying failed requests.
+type RetryConfiguration struct {
+	// InitialBackoff is the amount of time to wait before retrying the first failed request.
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff is the maximum amount of time to wait between retries.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
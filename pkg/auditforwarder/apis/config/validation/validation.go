@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/auditforwarder/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+// ValidateAuditForwarderConfiguration validates the given `AuditForwarderConfiguration`.
+func ValidateAuditForwarderConfiguration(conf *config.AuditForwarderConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.LogLevel != "" && !sets.New(logger.AllLogLevels...).Has(conf.LogLevel) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("logLevel"), conf.LogLevel, logger.AllLogLevels))
+	}
+
+	if conf.LogFormat != "" && !sets.New(logger.AllLogFormats...).Has(conf.LogFormat) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), conf.LogFormat, logger.AllLogFormats))
+	}
+
+	allErrs = append(allErrs, validateServer(conf.Server.Events, field.NewPath("server", "events"))...)
+	if conf.Server.HealthProbes != nil {
+		allErrs = append(allErrs, validateServer(*conf.Server.HealthProbes, field.NewPath("server", "healthProbes"))...)
+	}
+
+	allErrs = append(allErrs, validateQueueConfiguration(conf.Queue, field.NewPath("queue"))...)
+	allErrs = append(allErrs, validateBackendConfiguration(conf.Backends, field.NewPath("backends"))...)
+
+	return allErrs
+}
+
+func validateServer(conf config.Server, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.Port <= 0 || conf.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), conf.Port, "must be a valid port number"))
+	}
+
+	return allErrs
+}
+
+func validateQueueConfiguration(conf config.QueueConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.Directory == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("directory"), "must provide the directory of the queue"))
+	}
+
+	allErrs = append(allErrs, validatePositiveQuantity(conf.MaxSize, fldPath.Child("maxSize"))...)
+
+	return allErrs
+}
+
+func validateBackendConfiguration(conf config.BackendConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.Log == nil && conf.Webhook == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "must configure at least one backend"))
+	}
+
+	if webhook := conf.Webhook; webhook != nil {
+		webhookPath := fldPath.Child("webhook")
+
+		if webhook.URL == "" {
+			allErrs = append(allErrs, field.Required(webhookPath.Child("url"), "must provide the URL of the endpoint"))
+		} else if u, err := url.Parse(webhook.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("url"), webhook.URL, err.Error()))
+		} else if u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("url"), webhook.URL, "must be an absolute https URL"))
+		}
+
+		allErrs = append(allErrs, validatePositiveDuration(webhook.Timeout, webhookPath.Child("timeout"))...)
+
+		if webhook.Batch.MaxSize != nil && *webhook.Batch.MaxSize <= 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("batch", "maxSize"), *webhook.Batch.MaxSize, "must be greater than 0"))
+		}
+		allErrs = append(allErrs, validatePositiveDuration(webhook.Batch.MaxWait, webhookPath.Child("batch", "maxWait"))...)
+
+		if truncate := webhook.Truncate; truncate != nil {
+			truncatePath := webhookPath.Child("truncate")
+
+			allErrs = append(allErrs, validatePositiveQuantity(truncate.MaxBatchSize, truncatePath.Child("maxBatchSize"))...)
+			allErrs = append(allErrs, validatePositiveQuantity(truncate.MaxEventSize, truncatePath.Child("maxEventSize"))...)
+			if truncate.MaxBatchSize != nil && truncate.MaxEventSize != nil && truncate.MaxEventSize.Cmp(*truncate.MaxBatchSize) > 0 {
+				allErrs = append(allErrs, field.Invalid(truncatePath.Child("maxEventSize"), truncate.MaxEventSize.String(), "must not be greater than maxBatchSize"))
+			}
+		}
+
+		allErrs = append(allErrs, validatePositiveDuration(webhook.Retry.InitialBackoff, webhookPath.Child("retry", "initialBackoff"))...)
+		allErrs = append(allErrs, validatePositiveDuration(webhook.Retry.MaxBackoff, webhookPath.Child("retry", "maxBackoff"))...)
+		if webhook.Retry.InitialBackoff != nil && webhook.Retry.MaxBackoff != nil && webhook.Retry.InitialBackoff.Duration > webhook.Retry.MaxBackoff.Duration {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("retry", "initialBackoff"), webhook.Retry.InitialBackoff.Duration.String(), "must not be greater than maxBackoff"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePositiveDuration(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && val.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, val.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validatePositiveQuantity(val *resource.Quantity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && val.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, val.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
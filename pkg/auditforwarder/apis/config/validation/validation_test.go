@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/auditforwarder/apis/config"
+	. "github.com/gardener/gardener/pkg/auditforwarder/apis/config/validation"
+)
+
+var _ = Describe("#ValidateAuditForwarderConfiguration", func() {
+	var config *AuditForwarderConfiguration
+
+	BeforeEach(func() {
+		config = &AuditForwarderConfiguration{
+			Server: ServerConfiguration{
+				Events:       Server{BindAddress: "127.0.0.1", Port: 2761},
+				HealthProbes: &Server{Port: 2762},
+			},
+			Queue: QueueConfiguration{
+				Directory: "/var/lib/audit-forwarder/queue",
+				MaxSize:   ptr.To(resource.MustParse("1Gi")),
+			},
+			Backends: BackendConfiguration{
+				Log: &LogBackendConfiguration{},
+				Webhook: &WebhookBackendConfiguration{
+					URL:     "https://audit.example.com/events",
+					Timeout: &metav1.Duration{Duration: 30 * time.Second},
+					Batch:   BatchConfiguration{MaxSize: ptr.To[int32](400), MaxWait: &metav1.Duration{Duration: 30 * time.Second}},
+					Truncate: &TruncateConfiguration{
+						MaxBatchSize: ptr.To(resource.MustParse("10Mi")),
+						MaxEventSize: ptr.To(resource.MustParse("100Ki")),
+					},
+					Retry: RetryConfiguration{
+						InitialBackoff: &metav1.Duration{Duration: 10 * time.Second},
+						MaxBackoff:     &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			},
+		}
+	})
+
+	It("should pass because all necessary fields are specified", func() {
+		Expect(ValidateAuditForwarderConfiguration(config)).To(BeEmpty())
+	})
+
+	It("should fail because the log settings are invalid", func() {
+		config.LogLevel = "foo"
+		config.LogFormat = "bar"
+
+		Expect(ValidateAuditForwarderConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("logLevel"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("logFormat"),
+			})),
+		))
+	})
+
+	It("should fail because the server ports are invalid", func() {
+		config.Server.Events.Port = 0
+		config.Server.HealthProbes.Port = 70000
+
+		Expect(ValidateAuditForwarderConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("server.events.port"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("server.healthProbes.port"),
+			})),
+		))
+	})
+
+	It("should fail because the queue configuration is invalid", func() {
+		config.Queue.Directory = ""
+		config.Queue.MaxSize = ptr.To(resource.MustParse("0"))
+
+		Expect(ValidateAuditForwarderConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("queue.directory"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("queue.maxSize"),
+			})),
+		))
+	})
+
+	It("should fail because no backend is configured", func() {
+		config.Backends = BackendConfiguration{}
+
+		Expect(ValidateAuditForwarderConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("backends"),
+			})),
+		))
+	})
+
+	DescribeTable("should validate the webhook URL",
+		func(url string, matcher types.GomegaMatcher) {
+			config.Backends.Webhook.URL = url
+
+			Expect(ValidateAuditForwarderConfiguration(config)).To(matcher)
+		},
+
+		Entry("empty", "", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("backends.webhook.url"),
+		})))),
+		Entry("http scheme", "http://audit.example.com", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("backends.webhook.url"),
+		})))),
+		Entry("relative", "/events", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("backends.webhook.url"),
+		})))),
+		Entry("valid", "https://audit.example.com:8443/events", BeEmpty()),
+	)
+
+	It("should fail because the webhook settings are invalid", func() {
+		config.Backends.Webhook.Timeout = &metav1.Duration{}
+		config.Backends.Webhook.Batch = BatchConfiguration{MaxSize: ptr.To[int32](0), MaxWait: &metav1.Duration{Duration: -time.Second}}
+		config.Backends.Webhook.Truncate = &TruncateConfiguration{
+			MaxBatchSize: ptr.To(resource.MustParse("1Ki")),
+			MaxEventSize: ptr.To(resource.MustParse("1Mi")),
+		}
+		config.Backends.Webhook.Retry = RetryConfiguration{
+			InitialBackoff: &metav1.Duration{Duration: 10 * time.Minute},
+			MaxBackoff:     &metav1.Duration{Duration: 5 * time.Minute},
+		}
+
+		Expect(ValidateAuditForwarderConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("backends.webhook.timeout"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("backends.webhook.batch.maxSize"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("backends.webhook.batch.maxWait"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("backends.webhook.truncate.maxEventSize"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("backends.webhook.retry.initialBackoff"),
+			})),
+		))
+	})
+})
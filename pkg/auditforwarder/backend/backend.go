@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener/pkg/auditforwarder/filter"
+	"github.com/gardener/gardener/pkg/auditforwarder/queue"
+)
+
+// AnnotationTruncated is the annotation added to audit events whose request and response objects were removed because
+// the event exceeded the maximum event size. It is the same annotation the truncate backend of the kube-apiserver uses.
+const AnnotationTruncated = "audit.k8s.io/truncated"
+
+// Sink delivers batches of audit events to their destination.
+type Sink interface {
+	// Send delivers the given events. The given state is the state returned by the last successful call, the returned
+	// state is committed to the queue together with the position after the events. If Send fails, it is called again
+	// with the same state.
+	Send(ctx context.Context, events []auditv1.Event, state json.RawMessage) (json.RawMessage, error)
+}
+
+// Backend reads the audit events from the queue, applies the audit policy of the backend, and delivers the remaining
+// events in batches to the sink. Failed batches are retried with an exponential backoff until they are delivered, only
+// then the position of the reader is committed. Hence, the events are delivered at least once.
+type Backend struct {
+	// Name is the name of the backend.
+	Name string
+	// Reader is the queue reader of the backend.
+	Reader *queue.Reader
+	// Filter is the audit policy of the backend. If nil, all events are delivered.
+	Filter *filter.Filter
+	// Sink is the destination of the audit events.
+	Sink Sink
+	// Clock is the clock.
+	Clock clock.WithDelayedExecution
+	// Log is the logger.
+	Log logr.Logger
+
+	// MaxBatchEvents is the maximum number of events in a batch.
+	MaxBatchEvents int
+	// MaxBatchBytes is the maximum size of the encoded events in a batch. Zero means unlimited.
+	MaxBatchBytes int64
+	// MaxEventBytes is the maximum size of an encoded event. Zero means unlimited.
+	MaxEventBytes int64
+	// MaxWait is the maximum amount of time to wait for further events before sending a batch which has not reached
+	// its maximum size.
+	MaxWait time.Duration
+	// InitialBackoff is the amount of time to wait before retrying a failed batch for the first time.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum amount of time to wait between retries.
+	MaxBackoff time.Duration
+
+	// pending is a record which was read from the queue but did not fit into the previous batch anymore.
+	pending *entry
+}
+
+type entry struct {
+	event auditv1.Event
+	size  int64
+	next  queue.Position
+}
+
+// Run delivers audit events until the context is cancelled.
+func (b *Backend) Run(ctx context.Context) error {
+	state := b.Reader.State()
+
+	for {
+		events, position, err := b.nextBatch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if len(events) > 0 {
+			if state, err = b.send(ctx, events, state); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+
+		if position != nil {
+			if err := b.Reader.Commit(*position, state); err != nil {
+				return fmt.Errorf("failed committing position of backend %s: %w", b.Name, err)
+			}
+		}
+	}
+}
+
+// nextBatch collects the next batch of events. It returns the position after the last record consumed for the batch,
+// which is nil if no record was consumed.
+func (b *Backend) nextBatch(ctx context.Context) ([]auditv1.Event, *queue.Position, error) {
+	var (
+		events   []auditv1.Event
+		size     int64
+		position *queue.Position
+	)
+
+	// The wait time starts with the first consumed record, i.e., dropped records are committed after the maximum wait
+	// time at the latest as well.
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var timer clock.Timer
+	startTimer := func() {
+		if timer == nil {
+			timer = b.Clock.AfterFunc(b.MaxWait, cancel)
+		}
+	}
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for len(events) < b.MaxBatchEvents {
+		e := b.pending
+		b.pending = nil
+
+		if e == nil {
+			var (
+				deliver bool
+				err     error
+			)
+
+			if e, deliver, err = b.next(waitCtx); err != nil {
+				if ctx.Err() == nil && waitCtx.Err() != nil {
+					// The maximum wait time for the batch has passed.
+					break
+				}
+				return nil, nil, err
+			}
+
+			startTimer()
+			if !deliver {
+				position = &e.next
+				continue
+			}
+		}
+
+		if len(events) > 0 && b.MaxBatchBytes > 0 && size+e.size > b.MaxBatchBytes {
+			b.pending = e
+			break
+		}
+
+		startTimer()
+		events = append(events, e.event)
+		size += e.size
+		position = &e.next
+	}
+
+	return events, position, nil
+}
+
+// next reads the next record from the queue. It returns false if the record must not be delivered.
+func (b *Backend) next(ctx context.Context) (*entry, bool, error) {
+	for {
+		record, err := b.Reader.Next(ctx)
+		if err != nil {
+			if errors.Is(err, queue.ErrCorrupted) {
+				b.Log.Error(err, "Skipping corrupted audit events", "backend", b.Name)
+				continue
+			}
+			return nil, false, err
+		}
+
+		e := &entry{next: record.Next}
+
+		if err := json.Unmarshal(record.Data, &e.event); err != nil {
+			b.Log.Error(err, "Dropping audit event which cannot be decoded", "backend", b.Name)
+			return e, false, nil
+		}
+
+		ok, err := b.Filter.Apply(&e.event)
+		if err != nil {
+			b.Log.Error(err, "Dropping audit event which cannot be filtered", "backend", b.Name, "auditID", e.event.AuditID)
+			return e, false, nil
+		}
+		if !ok {
+			return e, false, nil
+		}
+
+		if e.size, ok = b.truncate(&e.event); !ok {
+			return e, false, nil
+		}
+
+		return e, true, nil
+	}
+}
+
+// truncate removes the request and response objects of the event if it exceeds the maximum event size. It returns the
+// size of the encoded event and false if the event is still too large.
+func (b *Backend) truncate(event *auditv1.Event) (int64, bool) {
+	raw, err := json.Marshal(event)
+	if err != nil {
+		b.Log.Error(err, "Dropping audit event which cannot be encoded", "backend", b.Name, "auditID", event.AuditID)
+		return 0, false
+	}
+
+	if b.MaxEventBytes <= 0 || int64(len(raw)) <= b.MaxEventBytes {
+		return int64(len(raw)), true
+	}
+
+	event.RequestObject = nil
+	event.ResponseObject = nil
+	if event.Annotations == nil {
+		event.Annotations = map[string]string{}
+	}
+	event.Annotations[AnnotationTruncated] = "true"
+
+	if raw, err = json.Marshal(event); err != nil || int64(len(raw)) > b.MaxEventBytes {
+		b.Log.Info("Dropping audit event which exceeds the maximum event size", "backend", b.Name, "auditID", event.AuditID, "maxEventBytes", b.MaxEventBytes)
+		return 0, false
+	}
+
+	return int64(len(raw)), true
+}
+
+// send delivers the batch to the sink and retries until it succeeds or the context is cancelled.
+func (b *Backend) send(ctx context.Context, events []auditv1.Event, state json.RawMessage) (json.RawMessage, error) {
+	backoff := b.InitialBackoff
+
+	for {
+		newState, err := b.Sink.Send(ctx, events, state)
+		if err == nil {
+			return newState, nil
+		}
+
+		b.Log.Error(err, "Failed sending audit events, retrying", "backend", b.Name, "events", len(events), "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-b.Clock.After(backoff):
+		}
+
+		backoff = min(2*backoff, b.MaxBackoff)
+	}
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/apiserver/pkg/audit/policy"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/auditforwarder/backend"
+	"github.com/gardener/gardener/pkg/auditforwarder/filter"
+	"github.com/gardener/gardener/pkg/auditforwarder/queue"
+)
+
+var _ = Describe("Backend", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		dir       string
+		q         *queue.Queue
+		fakeClock *testclock.FakeClock
+		sink      *fakeSink
+		backend   *Backend
+		done      chan error
+	)
+
+	event := func(id string, level auditv1.Level, object string) []byte {
+		e := auditv1.Event{
+			AuditID:   types.UID(id),
+			Level:     level,
+			Stage:     auditv1.StageResponseComplete,
+			Verb:      "get",
+			ObjectRef: &auditv1.ObjectReference{Resource: "configmaps", Namespace: "default", Name: id},
+		}
+		if object != "" {
+			e.ResponseObject = &runtime.Unknown{Raw: []byte(object)}
+		}
+
+		raw, err := json.Marshal(e)
+		Expect(err).NotTo(HaveOccurred())
+		return raw
+	}
+
+	openQueue := func() {
+		var err error
+		q, err = queue.Open(dir, 1<<20, 1<<10, "test")
+		Expect(err).NotTo(HaveOccurred())
+
+		reader, err := q.Reader("test")
+		Expect(err).NotTo(HaveOccurred())
+		backend.Reader = reader
+	}
+
+	start := func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan error, 1)
+		go func() { done <- backend.Run(ctx) }()
+	}
+
+	stop := func() {
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(backend.Reader.Close()).To(Succeed())
+		Expect(q.Close()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		sink = &fakeSink{}
+
+		backend = &Backend{
+			Name:           "test",
+			Sink:           sink,
+			Clock:          fakeClock,
+			Log:            logr.Discard(),
+			MaxBatchEvents: 2,
+			MaxWait:        time.Minute,
+			InitialBackoff: time.Second,
+			MaxBackoff:     4 * time.Second,
+		}
+
+		openQueue()
+	})
+
+	It("should send full batches without waiting", func() {
+		start()
+		DeferCleanup(stop)
+
+		Expect(q.Append(event("1", auditv1.LevelMetadata, ""), event("2", auditv1.LevelMetadata, ""), event("3", auditv1.LevelMetadata, ""))).To(Succeed())
+
+		Eventually(sink.auditIDs).Should(Equal([][]string{{"1", "2"}}))
+		Consistently(sink.auditIDs).Should(HaveLen(1))
+	})
+
+	It("should send incomplete batches after the maximum wait time", func() {
+		start()
+		DeferCleanup(stop)
+
+		Expect(q.Append(event("1", auditv1.LevelMetadata, ""))).To(Succeed())
+
+		Eventually(fakeClock.HasWaiters).Should(BeTrue())
+		Consistently(sink.auditIDs).Should(BeEmpty())
+
+		fakeClock.Step(time.Minute)
+		Eventually(sink.auditIDs).Should(Equal([][]string{{"1"}}))
+	})
+
+	It("should apply the audit policy of the backend", func() {
+		p, err := policy.LoadPolicyFromBytes([]byte(`apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: None
+  resources:
+  - group: ""
+    resources: ["configmaps"]
+    resourceNames: ["2"]
+- level: Metadata
+`))
+		Expect(err).NotTo(HaveOccurred())
+		backend.Filter = filter.New(p)
+
+		start()
+		DeferCleanup(stop)
+
+		Expect(q.Append(event("1", auditv1.LevelRequestResponse, `{"foo":"bar"}`), event("2", auditv1.LevelMetadata, ""), event("3", auditv1.LevelMetadata, ""))).To(Succeed())
+
+		Eventually(sink.auditIDs).Should(Equal([][]string{{"1", "3"}}))
+		Expect(sink.batches[0][0].Level).To(Equal(auditv1.LevelMetadata))
+		Expect(sink.batches[0][0].ResponseObject).To(BeNil())
+	})
+
+	It("should truncate and drop events exceeding the maximum event size", func() {
+		backend.MaxEventBytes = 300
+
+		start()
+		DeferCleanup(stop)
+
+		Expect(q.Append(
+			event("1", auditv1.LevelRequestResponse, fmt.Sprintf(`{"data":%q}`, strings.Repeat("a", 300))),
+			event("2"+strings.Repeat("b", 300), auditv1.LevelMetadata, ""),
+			event("3", auditv1.LevelMetadata, ""),
+		)).To(Succeed())
+
+		Eventually(sink.auditIDs).Should(Equal([][]string{{"1", "3"}}))
+		Expect(sink.batches[0][0].ResponseObject).To(BeNil())
+		Expect(sink.batches[0][0].Annotations).To(HaveKeyWithValue(AnnotationTruncated, "true"))
+	})
+
+	It("should split batches exceeding the maximum batch size", func() {
+		backend.MaxBatchBytes = 200
+
+		start()
+		DeferCleanup(stop)
+
+		Expect(q.Append(event("1", auditv1.LevelMetadata, ""), event("2", auditv1.LevelMetadata, ""), event("3", auditv1.LevelMetadata, ""))).To(Succeed())
+
+		Eventually(sink.auditIDs).Should(HaveLen(2))
+		Eventually(fakeClock.HasWaiters).Should(BeTrue())
+		fakeClock.Step(time.Minute)
+		Eventually(sink.auditIDs).Should(Equal([][]string{{"1"}, {"2"}, {"3"}}))
+	})
+
+	It("should retry failed batches with an exponential backoff", func() {
+		sink.failures = 3
+
+		start()
+		DeferCleanup(stop)
+
+		Expect(q.Append(event("1", auditv1.LevelMetadata, ""), event("2", auditv1.LevelMetadata, ""))).To(Succeed())
+
+		for _, backoff := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+			Consistently(sink.auditIDs).Should(BeEmpty())
+			fakeClock.Step(backoff - time.Millisecond)
+			Consistently(sink.auditIDs).Should(BeEmpty())
+			fakeClock.Step(time.Millisecond)
+		}
+
+		Eventually(sink.auditIDs).Should(Equal([][]string{{"1", "2"}}))
+		Expect(sink.attempts()).To(Equal(4))
+	})
+
+	It("should resume after the last delivered batch with the committed state", func() {
+		start()
+		Expect(q.Append(event("1", auditv1.LevelMetadata, ""), event("2", auditv1.LevelMetadata, ""))).To(Succeed())
+		Eventually(sink.auditIDs).Should(HaveLen(1))
+		stop()
+
+		openQueue()
+		start()
+		DeferCleanup(stop)
+
+		Expect(q.Append(event("3", auditv1.LevelMetadata, ""), event("4", auditv1.LevelMetadata, ""))).To(Succeed())
+		Eventually(sink.auditIDs).Should(Equal([][]string{{"1", "2"}, {"3", "4"}}))
+		Expect(sink.states).To(Equal([]string{"", "1"}))
+	})
+})
+
+// fakeSink records the sent batches. The state is the number of delivered batches.
+type fakeSink struct {
+	lock     sync.Mutex
+	failures int
+	tries    int
+	batches  [][]auditv1.Event
+	states   []string
+}
+
+func (f *fakeSink) Send(_ context.Context, events []auditv1.Event, state json.RawMessage) (json.RawMessage, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.tries++
+	if f.failures > 0 {
+		f.failures--
+		return nil, fmt.Errorf("fake error")
+	}
+
+	f.batches = append(f.batches, events)
+	f.states = append(f.states, string(state))
+	return json.RawMessage(fmt.Sprintf("%d", len(f.batches))), nil
+}
+
+func (f *fakeSink) auditIDs() [][]string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var out [][]string
+	for _, batch := range f.batches {
+		var ids []string
+		for _, event := range batch {
+			ids = append(ids, string(event.AuditID))
+		}
+		out = append(out, ids)
+	}
+	return out
+}
+
+func (f *fakeSink) attempts() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.tries
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// LogSink writes the audit events as JSON lines, the same format the log backend of the kube-apiserver uses.
+type LogSink struct {
+	// Writer is the destination of the events, usually the standard output.
+	Writer io.Writer
+}
+
+// Send implements Sink.
+func (l *LogSink) Send(_ context.Context, events []auditv1.Event, state json.RawMessage) (json.RawMessage, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return nil, fmt.Errorf("failed encoding audit event %s: %w", event.AuditID, err)
+		}
+	}
+
+	if _, err := l.Writer.Write(buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("failed writing audit events: %w", err)
+	}
+
+	return state, nil
+}
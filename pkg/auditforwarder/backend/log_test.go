@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	. "github.com/gardener/gardener/pkg/auditforwarder/backend"
+)
+
+var _ = Describe("LogSink", func() {
+	It("should write the events as JSON lines", func() {
+		var buf bytes.Buffer
+		sink := &LogSink{Writer: &buf}
+
+		state, err := sink.Send(context.Background(), []auditv1.Event{{AuditID: "1"}, {AuditID: "2"}}, json.RawMessage(`{}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal(json.RawMessage(`{}`)))
+
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(ContainSubstring(`"auditID":"1"`))
+		Expect(lines[1]).To(ContainSubstring(`"auditID":"2"`))
+	})
+})
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// HeaderChain is the header containing the ID of the signature chain. A new chain is started whenever the queue of
+	// the forwarder is lost, e.g., when the kube-apiserver pod is replaced.
+	HeaderChain = "X-Gardener-Audit-Chain"
+	// HeaderSequence is the header containing the sequence number of the batch within the chain, starting with 1.
+	HeaderSequence = "X-Gardener-Audit-Sequence"
+	// HeaderPreviousSignature is the header containing the signature of the previous batch of the chain. It is empty
+	// for the first batch.
+	HeaderPreviousSignature = "X-Gardener-Audit-Previous-Signature"
+	// HeaderSignature is the header containing the hex encoded HMAC-SHA256 signature of the batch.
+	HeaderSignature = "X-Gardener-Audit-Signature"
+)
+
+// ChainState is the state of a signature chain.
+type ChainState struct {
+	// ID is the ID of the chain.
+	ID string `json:"id"`
+	// Sequence is the sequence number of the last delivered batch.
+	Sequence uint64 `json:"sequence"`
+	// Signature is the signature of the last delivered batch.
+	Signature string `json:"signature,omitempty"`
+}
+
+// Signer signs batches with an HMAC-SHA256 signature which includes the signature of the previous batch. Receivers
+// which know the key can verify that batches were neither modified nor removed or reordered in between.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner returns a new signer for the given key.
+func NewSigner(key []byte) (*Signer, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("signing key must not be empty")
+	}
+	return &Signer{key: key}, nil
+}
+
+// Sign signs the given body as successor of the given chain state. It returns the headers which must be sent together
+// with the body and the chain state after the body was delivered.
+func (s *Signer) Sign(state ChainState, body []byte) (http.Header, ChainState, error) {
+	if state.ID == "" {
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return nil, ChainState{}, fmt.Errorf("failed generating signature chain ID: %w", err)
+		}
+		state = ChainState{ID: hex.EncodeToString(id)}
+	}
+
+	next := ChainState{
+		ID:        state.ID,
+		Sequence:  state.Sequence + 1,
+		Signature: ComputeSignature(s.key, state.ID, state.Sequence+1, state.Signature, body),
+	}
+
+	header := http.Header{}
+	header.Set(HeaderChain, next.ID)
+	header.Set(HeaderSequence, strconv.FormatUint(next.Sequence, 10))
+	header.Set(HeaderPreviousSignature, state.Signature)
+	header.Set(HeaderSignature, next.Signature)
+
+	return header, next, nil
+}
+
+// ComputeSignature computes the hex encoded signature of a batch. The signed message consists of the chain ID, the
+// sequence number, the signature of the previous batch, and the hex encoded SHA-256 hash of the body, separated by
+// newlines.
+func ComputeSignature(key []byte, chainID string, sequence uint64, previousSignature string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s\n%d\n%s\n%s", chainID, sequence, previousSignature, hex.EncodeToString(bodyHash[:]))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature verifies the signature headers of a batch. It does not check that the batch continues the chain, this
+// is up to the receiver which needs to remember the last sequence number and signature of each chain.
+func VerifySignature(key []byte, header http.Header, body []byte) error {
+	sequence, err := strconv.ParseUint(header.Get(HeaderSequence), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid sequence number: %w", err)
+	}
+
+	expected := ComputeSignature(key, header.Get(HeaderChain), sequence, header.Get(HeaderPreviousSignature), body)
+	if !hmac.Equal([]byte(expected), []byte(header.Get(HeaderSignature))) {
+		return fmt.Errorf("signature mismatch")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// WebhookSink sends the audit events as `EventList` to an HTTPS endpoint, the same format the webhook backend of the
+// kube-apiserver uses.
+type WebhookSink struct {
+	// URL is the URL of the endpoint.
+	URL string
+	// Client is the HTTP client used for sending the requests.
+	Client *http.Client
+	// TokenFile is the path to the bearer token. It is read for every request so that a rotated token is picked up.
+	TokenFile string
+	// Signer signs the batches. If nil, the batches are not signed.
+	Signer *Signer
+}
+
+// Send implements Sink. If a signer is configured, the state contains the signature chain.
+func (w *WebhookSink) Send(ctx context.Context, events []auditv1.Event, state json.RawMessage) (json.RawMessage, error) {
+	body, err := json.Marshal(&auditv1.EventList{
+		TypeMeta: metav1.TypeMeta{APIVersion: auditv1.SchemeGroupVersion.String(), Kind: "EventList"},
+		Items:    events,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding audit events: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	if w.TokenFile != "" {
+		token, err := os.ReadFile(w.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading token file: %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	if w.Signer != nil {
+		var chain ChainState
+		if len(state) > 0 {
+			if err := json.Unmarshal(state, &chain); err != nil {
+				return nil, fmt.Errorf("failed decoding signature chain: %w", err)
+			}
+		}
+
+		header, next, err := w.Signer.Sign(chain, body)
+		if err != nil {
+			return nil, err
+		}
+		for key := range header {
+			request.Header.Set(key, header.Get(key))
+		}
+
+		if state, err = json.Marshal(next); err != nil {
+			return nil, fmt.Errorf("failed encoding signature chain: %w", err)
+		}
+	}
+
+	response, err := w.Client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed sending audit events: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("unexpected status code %d when sending audit events: %s", response.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	return state, nil
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	. "github.com/gardener/gardener/pkg/auditforwarder/backend"
+)
+
+var _ = Describe("WebhookSink", func() {
+	var (
+		ctx    = context.Background()
+		key    = []byte("secret")
+		events = []auditv1.Event{{AuditID: "1"}, {AuditID: "2"}}
+
+		server   *httptest.Server
+		status   int
+		requests []*http.Request
+		bodies   [][]byte
+		sink     *WebhookSink
+	)
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		requests, bodies = nil, nil
+
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			requests = append(requests, r)
+			bodies = append(bodies, body)
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(server.Close)
+
+		tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("foo\n"), 0600)).To(Succeed())
+
+		sink = &WebhookSink{
+			URL:       server.URL,
+			Client:    server.Client(),
+			TokenFile: tokenFile,
+		}
+	})
+
+	It("should send the events as event list", func() {
+		state, err := sink.Send(ctx, events, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(BeNil())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer foo"))
+		Expect(requests[0].Header.Get(HeaderSignature)).To(BeEmpty())
+
+		list := &auditv1.EventList{}
+		Expect(json.Unmarshal(bodies[0], list)).To(Succeed())
+		Expect(list.APIVersion).To(Equal("audit.k8s.io/v1"))
+		Expect(list.Kind).To(Equal("EventList"))
+		Expect(list.Items).To(Equal(events))
+	})
+
+	It("should fail for unsuccessful responses", func() {
+		status = http.StatusServiceUnavailable
+
+		_, err := sink.Send(ctx, events, nil)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 503")))
+	})
+
+	It("should sign the batches as chain", func() {
+		var err error
+		sink.Signer, err = NewSigner(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		state, err := sink.Send(ctx, events, nil)
+		Expect(err).NotTo(HaveOccurred())
+		state, err = sink.Send(ctx, events[:1], state)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requests).To(HaveLen(2))
+		for i, request := range requests {
+			Expect(VerifySignature(key, request.Header, bodies[i])).To(Succeed())
+		}
+
+		Expect(requests[0].Header.Get(HeaderSequence)).To(Equal("1"))
+		Expect(requests[0].Header.Get(HeaderPreviousSignature)).To(BeEmpty())
+		Expect(requests[1].Header.Get(HeaderChain)).To(Equal(requests[0].Header.Get(HeaderChain)))
+		Expect(requests[1].Header.Get(HeaderSequence)).To(Equal("2"))
+		Expect(requests[1].Header.Get(HeaderPreviousSignature)).To(Equal(requests[0].Header.Get(HeaderSignature)))
+
+		chain := ChainState{}
+		Expect(json.Unmarshal(state, &chain)).To(Succeed())
+		Expect(chain).To(Equal(ChainState{
+			ID:        requests[0].Header.Get(HeaderChain),
+			Sequence:  2,
+			Signature: requests[1].Header.Get(HeaderSignature),
+		}))
+	})
+
+	It("should detect modified batches", func() {
+		var err error
+		sink.Signer, err = NewSigner(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = sink.Send(ctx, events, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(VerifySignature([]byte("other"), requests[0].Header, bodies[0])).To(MatchError("signature mismatch"))
+		Expect(VerifySignature(key, requests[0].Header, append(bodies[0], ' '))).To(MatchError("signature mismatch"))
+
+		requests[0].Header.Set(HeaderSequence, "2")
+		Expect(VerifySignature(key, requests[0].Header, bodies[0])).To(MatchError("signature mismatch"))
+	})
+})
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/audit/policy"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+// Filter applies the audit policy of a backend to audit events. The events were already processed by the audit policy
+// of the kube-apiserver, hence a filter can only drop events or lower their level.
+type Filter struct {
+	evaluator audit.PolicyRuleEvaluator
+}
+
+// New returns a filter for the given audit policy.
+func New(p *auditinternal.Policy) *Filter {
+	return &Filter{evaluator: policy.NewPolicyRuleEvaluator(p)}
+}
+
+// NewFromFile returns a filter for the audit policy stored in the given file.
+func NewFromFile(path string) (*Filter, error) {
+	p, err := policy.LoadPolicyFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading audit policy: %w", err)
+	}
+
+	return New(p), nil
+}
+
+// Apply applies the audit policy to the given event. It returns false if the event must be dropped. Otherwise, the event
+// is modified according to the level selected by the policy.
+func (f *Filter) Apply(event *auditv1.Event) (bool, error) {
+	if f == nil {
+		return true, nil
+	}
+
+	config := f.evaluator.EvaluatePolicyRule(attributesForEvent(event))
+
+	level := auditinternal.Level(event.Level)
+	if config.Level.Less(level) {
+		level = config.Level
+	}
+
+	if level == auditinternal.LevelNone {
+		return false, nil
+	}
+	if slices.Contains(config.OmitStages, auditinternal.Stage(event.Stage)) {
+		return false, nil
+	}
+
+	if level.Less(auditinternal.LevelRequestResponse) {
+		event.ResponseObject = nil
+	}
+	if level.Less(auditinternal.LevelRequest) {
+		event.RequestObject = nil
+	}
+	event.Level = auditv1.Level(level)
+
+	if config.OmitManagedFields {
+		for _, object := range []*runtime.Unknown{event.RequestObject, event.ResponseObject} {
+			if err := removeManagedFields(object); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// attributesForEvent reconstructs the attributes of the request the audit policy rules are matched against. Like for
+// the kube-apiserver, the rules match the authenticated user and not the impersonated user.
+func attributesForEvent(event *auditv1.Event) authorizer.Attributes {
+	attributes := authorizer.AttributesRecord{
+		User: &user.DefaultInfo{
+			Name:   event.User.Username,
+			UID:    event.User.UID,
+			Groups: event.User.Groups,
+		},
+		Verb: event.Verb,
+	}
+
+	if ref := event.ObjectRef; ref != nil {
+		attributes.ResourceRequest = true
+		attributes.Namespace = ref.Namespace
+		attributes.APIGroup = ref.APIGroup
+		attributes.APIVersion = ref.APIVersion
+		attributes.Resource = ref.Resource
+		attributes.Subresource = ref.Subresource
+		attributes.Name = ref.Name
+	} else if u, err := url.ParseRequestURI(event.RequestURI); err == nil {
+		attributes.Path = u.Path
+	} else {
+		attributes.Path = event.RequestURI
+	}
+
+	return attributes
+}
+
+// removeManagedFields removes the managed fields from the given object or from the items of the given list.
+func removeManagedFields(object *runtime.Unknown) error {
+	if object == nil || len(object.Raw) == 0 {
+		return nil
+	}
+
+	var content map[string]any
+	if err := json.Unmarshal(object.Raw, &content); err != nil {
+		// Objects which are not JSON objects, e.g., requests in protobuf format, cannot contain managed fields.
+		return nil
+	}
+
+	removed := removeManagedFieldsFromObject(content)
+	if items, ok := content["items"].([]any); ok {
+		for _, item := range items {
+			if itemContent, ok := item.(map[string]any); ok {
+				removed = removeManagedFieldsFromObject(itemContent) || removed
+			}
+		}
+	}
+
+	if !removed {
+		return nil
+	}
+
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("failed encoding object without managed fields: %w", err)
+	}
+	object.Raw = raw
+	return nil
+}
+
+func removeManagedFieldsFromObject(content map[string]any) bool {
+	metadata, ok := content["metadata"].(map[string]any)
+	if !ok {
+		return false
+	}
+	if _, ok := metadata["managedFields"]; !ok {
+		return false
+	}
+
+	delete(metadata, "managedFields")
+	return true
+}
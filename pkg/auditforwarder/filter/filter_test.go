@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filter_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/apiserver/pkg/audit/policy"
+
+	. "github.com/gardener/gardener/pkg/auditforwarder/filter"
+)
+
+var _ = Describe("Filter", func() {
+	var (
+		filter *Filter
+		event  *auditv1.Event
+	)
+
+	BeforeEach(func() {
+		p, err := policy.LoadPolicyFromBytes([]byte(`apiVersion: audit.k8s.io/v1
+kind: Policy
+omitStages:
+- RequestReceived
+rules:
+- level: None
+  users: ["system:kube-proxy"]
+- level: None
+  nonResourceURLs: ["/healthz*"]
+- level: Metadata
+  resources:
+  - group: ""
+    resources: ["secrets"]
+- level: Request
+  resources:
+  - group: ""
+    resources: ["configmaps"]
+  omitManagedFields: true
+- level: RequestResponse
+`))
+		Expect(err).NotTo(HaveOccurred())
+		filter = New(p)
+
+		event = &auditv1.Event{
+			Level:          auditv1.LevelRequestResponse,
+			Stage:          auditv1.StageResponseComplete,
+			Verb:           "update",
+			RequestURI:     "/api/v1/namespaces/default/configmaps/foo",
+			User:           authenticationv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated"}},
+			ObjectRef:      &auditv1.ObjectReference{Namespace: "default", APIVersion: "v1", Resource: "configmaps", Name: "foo"},
+			RequestObject:  &runtime.Unknown{Raw: []byte(`{"kind":"ConfigMap","metadata":{"name":"foo","managedFields":[{"manager":"kubectl"}]}}`)},
+			ResponseObject: &runtime.Unknown{Raw: []byte(`{"kind":"ConfigMap","metadata":{"name":"foo"}}`)},
+		}
+	})
+
+	It("should keep all events if no policy is configured", func() {
+		filter = nil
+
+		Expect(filter.Apply(event)).To(BeTrue())
+		Expect(event.Level).To(Equal(auditv1.LevelRequestResponse))
+		Expect(event.ResponseObject).NotTo(BeNil())
+	})
+
+	It("should lower the level and remove the managed fields", func() {
+		Expect(filter.Apply(event)).To(BeTrue())
+		Expect(event.Level).To(Equal(auditv1.LevelRequest))
+		Expect(event.RequestObject.Raw).To(MatchJSON(`{"kind":"ConfigMap","metadata":{"name":"foo"}}`))
+		Expect(event.ResponseObject).To(BeNil())
+	})
+
+	It("should remove the request and response objects for the metadata level", func() {
+		event.ObjectRef.Resource = "secrets"
+
+		Expect(filter.Apply(event)).To(BeTrue())
+		Expect(event.Level).To(Equal(auditv1.LevelMetadata))
+		Expect(event.RequestObject).To(BeNil())
+		Expect(event.ResponseObject).To(BeNil())
+	})
+
+	It("should not raise the level of the event", func() {
+		event.ObjectRef.Resource = "pods"
+		event.Level = auditv1.LevelMetadata
+		event.RequestObject, event.ResponseObject = nil, nil
+
+		Expect(filter.Apply(event)).To(BeTrue())
+		Expect(event.Level).To(Equal(auditv1.LevelMetadata))
+	})
+
+	It("should drop events of users with level None", func() {
+		event.User.Username = "system:kube-proxy"
+
+		Expect(filter.Apply(event)).To(BeFalse())
+	})
+
+	It("should drop events of non-resource requests with level None", func() {
+		event.ObjectRef = nil
+		event.Verb = "get"
+		event.RequestURI = "/healthz?verbose=true"
+
+		Expect(filter.Apply(event)).To(BeFalse())
+	})
+
+	It("should drop events of omitted stages", func() {
+		event.Stage = auditv1.StageRequestReceived
+
+		Expect(filter.Apply(event)).To(BeFalse())
+	})
+
+	It("should load the policy from a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "policy.yaml")
+		Expect(os.WriteFile(path, []byte(`apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: None
+`), 0600)).To(Succeed())
+
+		filter, err := NewFromFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filter.Apply(event)).To(BeFalse())
+	})
+})
@@ -0,0 +1,570 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	segmentFileSuffix = ".log"
+	cursorFileSuffix  = ".cursor"
+	// recordHeaderSize is the size of the header preceding each record. It contains the length of the record and its
+	// CRC-32C checksum.
+	recordHeaderSize = 8
+
+	// DefaultSegmentSize is the default size of a segment file after which a new segment is started.
+	DefaultSegmentSize = 16 << 20
+)
+
+var (
+	// ErrFull is returned by Append if the records do not fit into the queue anymore.
+	ErrFull = errors.New("queue is full")
+	// ErrCorrupted is returned by Next if a record could not be read because it is corrupted. The reader continues with
+	// the next segment.
+	ErrCorrupted = errors.New("queue segment is corrupted")
+
+	crcTable        = crc32.MakeTable(crc32.Castagnoli)
+	readerNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+)
+
+// Position is a position in the queue.
+type Position struct {
+	// Segment is the index of the segment.
+	Segment uint64 `json:"segment"`
+	// Offset is the offset in bytes within the segment.
+	Offset int64 `json:"offset"`
+}
+
+// Record is a record read from the queue.
+type Record struct {
+	// Data is the payload of the record.
+	Data []byte
+	// Next is the position of the record following this record. It is committed once the record was processed.
+	Next Position
+}
+
+type segment struct {
+	index uint64
+	size  int64
+}
+
+type cursor struct {
+	Position Position        `json:"position"`
+	State    json.RawMessage `json:"state,omitempty"`
+}
+
+// Queue is a persistent queue which stores records in segment files on disk. Records are only removed once all readers
+// have committed a position after them, i.e., each reader receives every record at least once even if the process is
+// restarted in between.
+type Queue struct {
+	dir         string
+	maxSize     int64
+	segmentSize int64
+
+	lock     sync.Mutex
+	segments []segment
+	head     *os.File
+	size     int64
+	cursors  map[string]cursor
+	appended chan struct{}
+}
+
+// Open opens the queue stored in the given directory and creates it if it does not exist yet. The names of all readers
+// of the queue must be passed, the positions of other readers stored in the directory are ignored.
+func Open(dir string, maxSize, segmentSize int64, readerNames ...string) (*Queue, error) {
+	if len(readerNames) == 0 {
+		return nil, fmt.Errorf("at least one reader is required")
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed creating queue directory %s: %w", dir, err)
+	}
+
+	q := &Queue{
+		dir:         dir,
+		maxSize:     maxSize,
+		segmentSize: segmentSize,
+		cursors:     make(map[string]cursor, len(readerNames)),
+		appended:    make(chan struct{}),
+	}
+
+	if err := q.loadSegments(); err != nil {
+		return nil, err
+	}
+
+	for _, name := range readerNames {
+		if !readerNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid reader name %q", name)
+		}
+
+		c, err := q.loadCursor(name)
+		if err != nil {
+			return nil, err
+		}
+		q.cursors[name] = c
+	}
+
+	if err := q.removeConsumedSegments(); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (q *Queue) loadSegments() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return fmt.Errorf("failed reading queue directory %s: %w", q.dir, err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentFileSuffix) {
+			continue
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSuffix(name, segmentFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed reading segment %s: %w", name, err)
+		}
+
+		q.segments = append(q.segments, segment{index: index, size: info.Size()})
+		q.size += info.Size()
+	}
+
+	slices.SortFunc(q.segments, func(a, b segment) int {
+		switch {
+		case a.index < b.index:
+			return -1
+		case a.index > b.index:
+			return 1
+		}
+		return 0
+	})
+
+	if len(q.segments) == 0 {
+		return q.createSegment(0)
+	}
+
+	// Only the last segment might contain an incomplete record because the previous segments were synced before the
+	// next segment was created.
+	last := &q.segments[len(q.segments)-1]
+	validSize, err := recoverSegment(q.segmentPath(last.index), last.size)
+	if err != nil {
+		return err
+	}
+	q.size -= last.size - validSize
+	last.size = validSize
+
+	q.head, err = os.OpenFile(q.segmentPath(last.index), os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed opening segment %d: %w", last.index, err)
+	}
+
+	return nil
+}
+
+// recoverSegment truncates the segment after the last complete and valid record and returns its new size.
+func recoverSegment(path string, size int64) (int64, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0600)
+	if err != nil {
+		return 0, fmt.Errorf("failed opening segment %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var offset int64
+	for offset < size {
+		_, next, err := readRecord(file, offset, size)
+		if err != nil {
+			break
+		}
+		offset = next
+	}
+
+	if offset == size {
+		return size, nil
+	}
+
+	if err := file.Truncate(offset); err != nil {
+		return 0, fmt.Errorf("failed truncating segment %s: %w", path, err)
+	}
+	if err := file.Sync(); err != nil {
+		return 0, fmt.Errorf("failed syncing segment %s: %w", path, err)
+	}
+
+	return offset, nil
+}
+
+func (q *Queue) loadCursor(name string) (cursor, error) {
+	var (
+		c     cursor
+		first = q.segments[0]
+		last  = q.segments[len(q.segments)-1]
+	)
+
+	data, err := os.ReadFile(q.cursorPath(name))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return c, fmt.Errorf("failed reading cursor of reader %s: %w", name, err)
+		}
+		c.Position = Position{Segment: first.index}
+		return c, nil
+	}
+
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, fmt.Errorf("failed decoding cursor of reader %s: %w", name, err)
+	}
+
+	// The position might point to removed records if the queue directory was modified externally or to a truncated
+	// record of the last segment.
+	switch {
+	case c.Position.Segment < first.index:
+		c.Position = Position{Segment: first.index}
+	case c.Position.Segment > last.index, c.Position.Segment == last.index && c.Position.Offset > last.size:
+		c.Position = Position{Segment: last.index, Offset: last.size}
+	}
+
+	return c, nil
+}
+
+// Append appends the given records to the queue. It only returns after the records were synced to disk.
+func (q *Queue) Append(records ...[]byte) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var size int64
+	for _, record := range records {
+		size += recordHeaderSize + int64(len(record))
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.size+size > q.maxSize {
+		return ErrFull
+	}
+
+	head := &q.segments[len(q.segments)-1]
+	if head.size > 0 && head.size+size > q.segmentSize {
+		if err := q.createSegment(head.index + 1); err != nil {
+			return err
+		}
+		head = &q.segments[len(q.segments)-1]
+	}
+
+	buf := make([]byte, 0, size)
+	for _, record := range records {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(record)))
+		buf = binary.BigEndian.AppendUint32(buf, crc32.Checksum(record, crcTable))
+		buf = append(buf, record...)
+	}
+
+	if _, err := q.head.Write(buf); err != nil {
+		// Remove a partially written record so that subsequent records can be read again.
+		_ = q.head.Truncate(head.size)
+		return fmt.Errorf("failed writing to segment %d: %w", head.index, err)
+	}
+	if err := q.head.Sync(); err != nil {
+		return fmt.Errorf("failed syncing segment %d: %w", head.index, err)
+	}
+
+	head.size += size
+	q.size += size
+
+	close(q.appended)
+	q.appended = make(chan struct{})
+
+	return nil
+}
+
+// createSegment creates the segment with the given index and syncs and closes the previous head segment. The caller
+// must hold the lock unless the queue is being opened.
+func (q *Queue) createSegment(index uint64) error {
+	head, err := os.OpenFile(q.segmentPath(index), os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("failed creating segment %d: %w", index, err)
+	}
+	if err := syncDir(q.dir); err != nil {
+		_ = head.Close()
+		_ = os.Remove(q.segmentPath(index))
+		return err
+	}
+
+	if q.head != nil {
+		// The previous head segment was already synced after the last append, hence closing it cannot lose records.
+		_ = q.head.Close()
+	}
+
+	q.head = head
+	q.segments = append(q.segments, segment{index: index})
+	return nil
+}
+
+// removeConsumedSegments removes all segments which were completely consumed by all readers. The caller must hold the
+// lock unless the queue is being opened.
+func (q *Queue) removeConsumedSegments() error {
+	minSegment := q.segments[len(q.segments)-1].index
+	for _, c := range q.cursors {
+		minSegment = min(minSegment, c.Position.Segment)
+	}
+
+	for len(q.segments) > 1 && q.segments[0].index < minSegment {
+		if err := os.Remove(q.segmentPath(q.segments[0].index)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed removing segment %d: %w", q.segments[0].index, err)
+		}
+		q.size -= q.segments[0].size
+		q.segments = q.segments[1:]
+	}
+
+	return nil
+}
+
+// Size returns the size of the queue in bytes.
+func (q *Queue) Size() int64 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.size
+}
+
+// Close closes the queue. Records which were appended before are not lost.
+func (q *Queue) Close() error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.head == nil {
+		return nil
+	}
+
+	err := q.head.Close()
+	q.head = nil
+	return err
+}
+
+// Reader returns a reader for the queue which starts at the last position committed by the reader with the given name.
+// Only one reader per name must be used at the same time.
+func (q *Queue) Reader(name string) (*Reader, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	c, ok := q.cursors[name]
+	if !ok {
+		return nil, fmt.Errorf("reader %s was not passed when opening the queue", name)
+	}
+
+	return &Reader{queue: q, name: name, position: c.Position, state: c.State}, nil
+}
+
+func (q *Queue) commit(name string, c cursor) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed encoding cursor of reader %s: %w", name, err)
+	}
+
+	if err := writeFileAtomically(q.dir, q.cursorPath(name), data); err != nil {
+		return fmt.Errorf("failed writing cursor of reader %s: %w", name, err)
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.cursors[name] = c
+	return q.removeConsumedSegments()
+}
+
+// segmentEnd returns the size of the given segment, whether it is the head segment and a channel which is closed once
+// records are appended.
+func (q *Queue) segmentEnd(index uint64) (int64, bool, <-chan struct{}, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for _, s := range q.segments {
+		if s.index == index {
+			return s.size, s.index == q.segments[len(q.segments)-1].index, q.appended, nil
+		}
+	}
+
+	return 0, false, nil, fmt.Errorf("segment %d does not exist", index)
+}
+
+func (q *Queue) segmentPath(index uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", index, segmentFileSuffix))
+}
+
+func (q *Queue) cursorPath(name string) string {
+	return filepath.Join(q.dir, name+cursorFileSuffix)
+}
+
+// Reader reads the records of a queue.
+type Reader struct {
+	queue    *Queue
+	name     string
+	position Position
+	state    json.RawMessage
+	file     *os.File
+}
+
+// State returns the state which was committed together with the last position.
+func (r *Reader) State() json.RawMessage {
+	return r.state
+}
+
+// Next returns the next record. It blocks until a record is available or the context is cancelled.
+func (r *Reader) Next(ctx context.Context) (Record, error) {
+	for {
+		size, isHead, appended, err := r.queue.segmentEnd(r.position.Segment)
+		if err != nil {
+			return Record{}, err
+		}
+
+		if r.position.Offset >= size {
+			if !isHead {
+				r.moveToSegment(r.position.Segment + 1)
+				continue
+			}
+
+			select {
+			case <-appended:
+				continue
+			case <-ctx.Done():
+				return Record{}, ctx.Err()
+			}
+		}
+
+		if r.file == nil {
+			if r.file, err = os.Open(r.queue.segmentPath(r.position.Segment)); err != nil {
+				return Record{}, fmt.Errorf("failed opening segment %d: %w", r.position.Segment, err)
+			}
+		}
+
+		data, next, err := readRecord(r.file, r.position.Offset, size)
+		if err != nil {
+			// The remaining records of the segment cannot be located anymore, hence they are skipped.
+			position := r.position
+			if isHead {
+				r.position.Offset = size
+			} else {
+				r.moveToSegment(position.Segment + 1)
+			}
+			return Record{}, fmt.Errorf("%w: failed reading record at offset %d of segment %d: %w", ErrCorrupted, position.Offset, position.Segment, err)
+		}
+
+		r.position.Offset = next
+		return Record{Data: data, Next: r.position}, nil
+	}
+}
+
+// Commit stores the given position and state of the reader. Records before the position are not returned to this
+// reader anymore after the queue was reopened and are removed once all readers have committed a later position.
+func (r *Reader) Commit(position Position, state json.RawMessage) error {
+	if err := r.queue.commit(r.name, cursor{Position: position, State: state}); err != nil {
+		return err
+	}
+
+	r.state = state
+	return nil
+}
+
+// Close closes the reader.
+func (r *Reader) Close() error {
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+func (r *Reader) moveToSegment(index uint64) {
+	_ = r.Close()
+	r.position = Position{Segment: index}
+}
+
+func readRecord(file io.ReaderAt, offset, size int64) ([]byte, int64, error) {
+	if offset+recordHeaderSize > size {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	header := make([]byte, recordHeaderSize)
+	if _, err := file.ReadAt(header, offset); err != nil {
+		return nil, 0, err
+	}
+
+	length := int64(binary.BigEndian.Uint32(header[0:4]))
+	if offset+recordHeaderSize+length > size {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	data := make([]byte, length)
+	if _, err := file.ReadAt(data, offset+recordHeaderSize); err != nil {
+		return nil, 0, err
+	}
+
+	if crc32.Checksum(data, crcTable) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, 0, fmt.Errorf("checksum mismatch")
+	}
+
+	return data, offset + recordHeaderSize + length, nil
+}
+
+func writeFileAtomically(dir, path string, data []byte) error {
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed opening directory %s: %w", dir, err)
+	}
+	defer d.Close()
+
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("failed syncing directory %s: %w", dir, err)
+	}
+	return nil
+}
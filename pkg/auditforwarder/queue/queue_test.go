@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/auditforwarder/queue"
+)
+
+var _ = Describe("Queue", func() {
+	var (
+		ctx = context.Background()
+		dir string
+		q   *Queue
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+
+		var err error
+		q, err = Open(dir, 1<<20, 64, "log", "webhook")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { Expect(q.Close()).To(Succeed()) })
+	})
+
+	readAll := func(r *Reader, n int) []Record {
+		var records []Record
+		for i := 0; i < n; i++ {
+			record, err := r.Next(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			records = append(records, record)
+		}
+		return records
+	}
+
+	data := func(records []Record) []string {
+		var out []string
+		for _, record := range records {
+			out = append(out, string(record.Data))
+		}
+		return out
+	}
+
+	reopen := func() {
+		Expect(q.Close()).To(Succeed())
+
+		var err error
+		q, err = Open(dir, 1<<20, 64, "log", "webhook")
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should return the records to each reader", func() {
+		Expect(q.Append([]byte("foo"), []byte("bar"))).To(Succeed())
+		Expect(q.Append([]byte("baz"))).To(Succeed())
+
+		logReader, err := q.Reader("log")
+		Expect(err).NotTo(HaveOccurred())
+		webhookReader, err := q.Reader("webhook")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(data(readAll(logReader, 3))).To(Equal([]string{"foo", "bar", "baz"}))
+		Expect(data(readAll(webhookReader, 3))).To(Equal([]string{"foo", "bar", "baz"}))
+	})
+
+	It("should fail for unknown readers", func() {
+		_, err := q.Reader("foo")
+		Expect(err).To(MatchError(ContainSubstring("was not passed when opening the queue")))
+	})
+
+	It("should block until a record is appended", func() {
+		r, err := q.Reader("log")
+		Expect(err).NotTo(HaveOccurred())
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err = r.Next(timeoutCtx)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(10 * time.Millisecond)
+			Expect(q.Append([]byte("foo"))).To(Succeed())
+		}()
+
+		record, err := r.Next(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(record.Data)).To(Equal("foo"))
+	})
+
+	It("should resume from the committed position and state after reopening", func() {
+		Expect(q.Append([]byte("foo"), []byte("bar"), []byte("baz"))).To(Succeed())
+
+		r, err := q.Reader("webhook")
+		Expect(err).NotTo(HaveOccurred())
+		records := readAll(r, 2)
+		Expect(r.Commit(records[0].Next, json.RawMessage(`{"sequence":1}`))).To(Succeed())
+		Expect(r.Close()).To(Succeed())
+
+		reopen()
+
+		r, err = q.Reader("webhook")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.State()).To(MatchJSON(`{"sequence":1}`))
+		Expect(data(readAll(r, 2))).To(Equal([]string{"bar", "baz"}))
+	})
+
+	It("should span multiple segments and remove them once all readers consumed them", func() {
+		for _, record := range []string{"record-0000000000000001", "record-0000000000000002", "record-0000000000000003", "record-0000000000000004"} {
+			Expect(q.Append([]byte(record))).To(Succeed())
+		}
+		Expect(segmentFiles(dir)).To(HaveLen(2))
+
+		logReader, err := q.Reader("log")
+		Expect(err).NotTo(HaveOccurred())
+		webhookReader, err := q.Reader("webhook")
+		Expect(err).NotTo(HaveOccurred())
+
+		logRecords := readAll(logReader, 4)
+		Expect(data(logRecords)).To(ConsistOf("record-0000000000000001", "record-0000000000000002", "record-0000000000000003", "record-0000000000000004"))
+		Expect(logReader.Commit(logRecords[3].Next, nil)).To(Succeed())
+		Expect(segmentFiles(dir)).To(HaveLen(2))
+
+		webhookRecords := readAll(webhookReader, 3)
+		Expect(webhookReader.Commit(webhookRecords[2].Next, nil)).To(Succeed())
+		Expect(segmentFiles(dir)).To(HaveLen(1))
+		Expect(q.Size()).To(Equal(int64(2 * (8 + 23))))
+	})
+
+	It("should reject records if the queue is full", func() {
+		Expect(q.Close()).To(Succeed())
+
+		var err error
+		q, err = Open(dir, 20, 64, "log")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(q.Append([]byte("0123456789"))).To(Succeed())
+		Expect(q.Append([]byte("0123456789"))).To(MatchError(ErrFull))
+
+		r, err := q.Reader("log")
+		Expect(err).NotTo(HaveOccurred())
+		record, err := r.Next(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Commit(record.Next, nil)).To(Succeed())
+
+		// The consumed record is only removed together with its segment.
+		Expect(q.Append([]byte("0123456789"))).To(MatchError(ErrFull))
+	})
+
+	It("should truncate an incomplete record when reopening", func() {
+		Expect(q.Append([]byte("foo"))).To(Succeed())
+		Expect(q.Append([]byte("bar"))).To(Succeed())
+
+		files := segmentFiles(dir)
+		Expect(files).To(HaveLen(1))
+		info, err := os.Stat(files[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Truncate(files[0], info.Size()-1)).To(Succeed())
+
+		reopen()
+		Expect(q.Size()).To(Equal(int64(8 + 3)))
+		Expect(q.Append([]byte("baz"))).To(Succeed())
+
+		r, err := q.Reader("log")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data(readAll(r, 2))).To(Equal([]string{"foo", "baz"}))
+	})
+
+	It("should skip the rest of a corrupted segment", func() {
+		for _, record := range []string{"record-0000000000000001", "record-0000000000000002", "record-0000000000000003"} {
+			Expect(q.Append([]byte(record))).To(Succeed())
+		}
+
+		files := segmentFiles(dir)
+		Expect(files).To(HaveLen(2))
+		content, err := os.ReadFile(files[0])
+		Expect(err).NotTo(HaveOccurred())
+		content[8] = 'x'
+		Expect(os.WriteFile(files[0], content, 0600)).To(Succeed())
+
+		r, err := q.Reader("log")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = r.Next(ctx)
+		Expect(err).To(MatchError(ErrCorrupted))
+
+		record, err := r.Next(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(record.Data)).To(Equal("record-0000000000000003"))
+	})
+})
+
+func segmentFiles(dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*.log"))
+	Expect(err).NotTo(HaveOccurred())
+	return files
+}
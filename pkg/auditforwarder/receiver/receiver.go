@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package receiver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	"github.com/gardener/gardener/pkg/auditforwarder/queue"
+)
+
+// Path is the path of the endpoint to which the kube-apiserver sends the audit events.
+const Path = "/events"
+
+// Handler receives the audit events sent by the webhook backend of the kube-apiserver and appends them to the queue.
+// The request is only answered successfully once the events were persisted, i.e., the kube-apiserver retries sending
+// them otherwise.
+type Handler struct {
+	// Queue is the queue to which the events are appended.
+	Queue *queue.Queue
+	// Log is the logger.
+	Log logr.Logger
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	list := &auditv1.EventList{}
+	if err := json.NewDecoder(r.Body).Decode(list); err != nil {
+		http.Error(w, "failed decoding event list: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	records := make([][]byte, 0, len(list.Items))
+	for _, event := range list.Items {
+		record, err := json.Marshal(event)
+		if err != nil {
+			http.Error(w, "failed encoding event: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		records = append(records, record)
+	}
+
+	if err := h.Queue.Append(records...); err != nil {
+		if errors.Is(err, queue.ErrFull) {
+			h.Log.Info("Rejecting audit events because the queue is full", "events", len(records))
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		h.Log.Error(err, "Failed appending audit events to queue", "events", len(records))
+		http.Error(w, "failed persisting events", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package receiver_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/auditforwarder/queue"
+	. "github.com/gardener/gardener/pkg/auditforwarder/receiver"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		q       *queue.Queue
+		handler *Handler
+	)
+
+	openQueue := func(maxSize int64) {
+		var err error
+		q, err = queue.Open(GinkgoT().TempDir(), maxSize, queue.DefaultSegmentSize, "test")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(q.Close)
+
+		handler = &Handler{Queue: q, Log: logr.Discard()}
+	}
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, Path, strings.NewReader(body)))
+		return recorder
+	}
+
+	It("should append the events to the queue", func() {
+		openQueue(1 << 20)
+
+		Expect(serve(http.MethodPost, `{"apiVersion":"audit.k8s.io/v1","kind":"EventList","items":[{"auditID":"1"},{"auditID":"2"}]}`).Code).To(Equal(http.StatusOK))
+
+		reader, err := q.Reader("test")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(reader.Close)
+
+		for _, id := range []string{"1", "2"} {
+			record, err := reader.Next(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(record.Data)).To(ContainSubstring(`"auditID":"` + id + `"`))
+		}
+	})
+
+	It("should reject other methods", func() {
+		openQueue(1 << 20)
+
+		Expect(serve(http.MethodGet, "").Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should reject invalid event lists", func() {
+		openQueue(1 << 20)
+
+		Expect(serve(http.MethodPost, "foo").Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject the events if the queue is full", func() {
+		openQueue(64)
+
+		Expect(serve(http.MethodPost, `{"items":[{"auditID":"1","requestURI":"`+strings.Repeat("a", 100)+`"}]}`).Code).To(Equal(http.StatusServiceUnavailable))
+	})
+})
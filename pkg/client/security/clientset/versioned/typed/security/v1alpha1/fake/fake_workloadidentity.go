@@ -138,3 +138,14 @@ func (c *FakeWorkloadIdentities) CreateToken(ctx context.Context, workloadIdenti
 	}
 	return obj.(*v1alpha1.TokenRequest), err
 }
+
+// ExchangeToken takes the representation of a tokenRequest and creates it.  Returns the server's representation of the tokenRequest, and an error, if there is any.
+func (c *FakeWorkloadIdentities) ExchangeToken(ctx context.Context, workloadIdentityName string, tokenRequest *v1alpha1.TokenRequest, opts v1.CreateOptions) (result *v1alpha1.TokenRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(workloadidentitiesResource, workloadIdentityName, "exchange", c.ns, tokenRequest), &v1alpha1.TokenRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TokenRequest), err
+}
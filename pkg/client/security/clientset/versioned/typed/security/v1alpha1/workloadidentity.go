@@ -36,6 +36,7 @@ type WorkloadIdentityInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkloadIdentity, err error)
 	CreateToken(ctx context.Context, workloadIdentityName string, tokenRequest *v1alpha1.TokenRequest, opts v1.CreateOptions) (*v1alpha1.TokenRequest, error)
+	ExchangeToken(ctx context.Context, workloadIdentityName string, tokenRequest *v1alpha1.TokenRequest, opts v1.CreateOptions) (*v1alpha1.TokenRequest, error)
 
 	WorkloadIdentityExpansion
 }
@@ -198,3 +199,18 @@ func (c *workloadIdentities) CreateToken(ctx context.Context, workloadIdentityNa
 		Into(result)
 	return
 }
+
+// ExchangeToken takes the representation of a tokenRequest and creates it.  Returns the server's representation of the tokenRequest, and an error, if there is any.
+func (c *workloadIdentities) ExchangeToken(ctx context.Context, workloadIdentityName string, tokenRequest *v1alpha1.TokenRequest, opts v1.CreateOptions) (result *v1alpha1.TokenRequest, err error) {
+	result = &v1alpha1.TokenRequest{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("workloadidentities").
+		Name(workloadIdentityName).
+		SubResource("exchange").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(tokenRequest).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -7,6 +7,9 @@ package clusterautoscaler
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -24,6 +27,7 @@ import (
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -47,6 +51,9 @@ const (
 	managedResourceTargetName = "shoot-core-cluster-autoscaler"
 	containerName             = v1beta1constants.DeploymentNameClusterAutoscaler
 
+	priorityExpanderConfigMapName = "cluster-autoscaler-priority-expander"
+	priorityExpander              = "priority"
+
 	portNameMetrics       = "metrics"
 	portMetrics     int32 = 8085
 )
@@ -449,8 +456,15 @@ func (c *clusterAutoscaler) computeCommand() []string {
 	}
 	gardencorev1beta1.SetDefaults_ClusterAutoscaler(c.config)
 
+	expander := string(*c.config.Expander)
+	if c.hasMachineDeploymentPriorities() && !slices.Contains(strings.Split(expander, ","), priorityExpander) {
+		// The priority expander prefers the machine deployments of the machine types with higher priority, see
+		// computePriorityExpanderConfigMap. The configured expander is used for machine deployments of equal priority.
+		expander = priorityExpander + "," + expander
+	}
+
 	command = append(command,
-		fmt.Sprintf("--expander=%s", *c.config.Expander),
+		fmt.Sprintf("--expander=%s", expander),
 		fmt.Sprintf("--max-graceful-termination-sec=%d", *c.config.MaxGracefulTerminationSeconds),
 		fmt.Sprintf("--max-node-provision-time=%s", c.config.MaxNodeProvisionTime.Duration),
 		fmt.Sprintf("--scale-down-utilization-threshold=%f", *c.config.ScaleDownUtilizationThreshold),
@@ -610,10 +624,49 @@ func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string)
 		}
 	)
 
-	return registry.AddAllAndSerialize(
+	objects := []client.Object{
 		clusterRole,
 		clusterRoleBinding,
 		role,
 		rolebinding,
-	)
+	}
+
+	if c.hasMachineDeploymentPriorities() {
+		configMap, err := c.computePriorityExpanderConfigMap()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, configMap)
+	}
+
+	return registry.AddAllAndSerialize(objects...)
+}
+
+func (c *clusterAutoscaler) hasMachineDeploymentPriorities() bool {
+	return slices.ContainsFunc(c.machineDeployments, func(machineDeployment extensionsv1alpha1.MachineDeployment) bool {
+		return machineDeployment.Priority != nil
+	})
+}
+
+// computePriorityExpanderConfigMap computes the configuration of the priority expander. Machine deployments without a
+// priority get the lowest priority 0, i.e., the same priority as the last alternative machine type of a worker pool.
+func (c *clusterAutoscaler) computePriorityExpanderConfigMap() (*corev1.ConfigMap, error) {
+	priorities := map[int32][]string{}
+	for _, machineDeployment := range c.machineDeployments {
+		priority := ptr.Deref(machineDeployment.Priority, 0)
+		priorities[priority] = append(priorities[priority], "^"+regexp.QuoteMeta(c.namespace+"."+machineDeployment.Name)+"$")
+	}
+
+	data, err := yaml.Marshal(priorities)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling priority expander configuration: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      priorityExpanderConfigMapName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{"priorities": string(data)},
+	}, nil
 }
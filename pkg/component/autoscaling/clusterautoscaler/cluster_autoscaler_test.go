@@ -655,6 +655,40 @@ var _ = Describe("ClusterAutoscaler", func() {
 			It("w/ config, kubernetes version < 1.26", func() { test(true, false) })
 			It("w/ config, kubernetes version >= 1.26", func() { test(true, true) })
 		})
+
+		It("should configure the priority expander if machine deployments have priorities", func() {
+			clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, 0, semver.MustParse("1.26.1"))
+			clusterAutoscaler.SetNamespaceUID(namespaceUID)
+			clusterAutoscaler.SetMachineDeployments([]extensionsv1alpha1.MachineDeployment{
+				{Name: machineDeployment1Name, Minimum: machineDeployment1Min, Maximum: machineDeployment1Max, Priority: ptr.To[int32](1)},
+				{Name: machineDeployment1Name + "-alt1", Minimum: 0, Maximum: machineDeployment1Max, Priority: ptr.To[int32](0)},
+				{Name: machineDeployment2Name, Minimum: machineDeployment2Min, Maximum: machineDeployment2Max},
+			})
+
+			Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+			actualMr := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), actualMr)).To(Succeed())
+			Expect(actualMr).To(consistOf(clusterRoleShoot, clusterRoleBindingShoot, roleShoot, roleBindingShoot, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-autoscaler-priority-expander",
+					Namespace: "kube-system",
+				},
+				Data: map[string]string{"priorities": `"0":
+- ^shoot--foo--bar\.pool1-alt1$
+- ^shoot--foo--bar\.pool2$
+"1":
+- ^shoot--foo--bar\.pool1$
+`},
+			}))
+
+			actualDeployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: deploymentName, Namespace: namespace}, actualDeployment)).To(Succeed())
+			Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+				"--expander=priority,least-waste",
+				fmt.Sprintf("--nodes=0:%d:%s.%s-alt1", machineDeployment1Max, namespace, machineDeployment1Name),
+			))
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
 const (
@@ -138,6 +139,7 @@ func (i *infrastructure) deploy(ctx context.Context, operation string) (extensio
 			// If that is the case health checks for the infrastructure will fail so we request a reconciliation to correct the current state.
 			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+			logger.AnnotateOperationID(ctx, &i.infrastructure.ObjectMeta)
 		}
 
 		i.infrastructure.Spec = extensionsv1alpha1.InfrastructureSpec{
@@ -159,6 +159,18 @@ func (mr *MockInterfaceMockRecorder) SetCredentialsRotationStatus(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentialsRotationStatus", reflect.TypeOf((*MockInterface)(nil).SetCredentialsRotationStatus), arg0)
 }
 
+// SetNodeAgentAttestationProviderType mocks base method.
+func (m *MockInterface) SetNodeAgentAttestationProviderType(arg0 *string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNodeAgentAttestationProviderType", arg0)
+}
+
+// SetNodeAgentAttestationProviderType indicates an expected call of SetNodeAgentAttestationProviderType.
+func (mr *MockInterfaceMockRecorder) SetNodeAgentAttestationProviderType(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodeAgentAttestationProviderType", reflect.TypeOf((*MockInterface)(nil).SetNodeAgentAttestationProviderType), arg0)
+}
+
 // SetSSHPublicKeys mocks base method.
 func (m *MockInterface) SetSSHPublicKeys(arg0 []string) {
 	m.ctrl.T.Helper()
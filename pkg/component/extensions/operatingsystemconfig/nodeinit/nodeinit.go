@@ -96,10 +96,14 @@ WantedBy=multi-user.target`),
 	// itself). Hence, the files for gardener-node-agent (component configuration and kubeconfig) must be present on the
 	// machine so that it can start successfully.
 	config = config.DeepCopy()
-	config.Bootstrap, err = getBootstrapConfiguration(worker)
+	bootstrapConfiguration, err := getBootstrapConfiguration(worker)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed computing bootstrap configuration: %w", err)
 	}
+	if config.Bootstrap != nil {
+		bootstrapConfiguration.Attestation = config.Bootstrap.Attestation
+	}
+	config.Bootstrap = bootstrapConfiguration
 
 	nodeAgentFiles, err := nodeagent.Files(config)
 	if err != nil {
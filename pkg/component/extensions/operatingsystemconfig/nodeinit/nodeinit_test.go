@@ -195,6 +195,29 @@ server: {}
 				}))
 			})
 
+			It("should keep the attestation configuration", func() {
+				config.Bootstrap = &nodeagentv1alpha1.BootstrapConfiguration{Attestation: &nodeagentv1alpha1.AttestationConfiguration{ProviderType: "local"}}
+
+				_, files, err := Config(worker, image, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				var configFile *extensionsv1alpha1.File
+				for _, file := range files {
+					if file.Path == "/var/lib/gardener-node-agent/config.yaml" {
+						configFile = &file
+					}
+				}
+				Expect(configFile).NotTo(BeNil())
+
+				data, err := utils.DecodeBase64(configFile.Content.Inline.Data)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(data)).To(ContainSubstring(`bootstrap:
+  attestation:
+    providerType: local
+  kubeletDataVolumeSize: 1369088
+`))
+			})
+
 			It("should ensure the size of the configuration is not exceeding a certain limit", func() {
 				units, files, err := Config(worker, image, config)
 				Expect(err).NotTo(HaveOccurred())
@@ -36,6 +36,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/logger"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -87,6 +88,8 @@ type Interface interface {
 	SetCredentialsRotationStatus(*gardencorev1beta1.ShootCredentialsRotation)
 	// SetSSHPublicKeys sets the SSHPublicKeys value.
 	SetSSHPublicKeys([]string)
+	// SetNodeAgentAttestationProviderType sets the NodeAgentAttestationProviderType value.
+	SetNodeAgentAttestationProviderType(*string)
 	// WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 	// containing both the init and the original operating system config data.
 	WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs
@@ -116,6 +119,9 @@ type InitValues struct {
 	// APIServerURL is the address (including https:// protocol prefix) to the kube-apiserver (from which the original
 	// cloud-config user data will be downloaded).
 	APIServerURL string
+	// NodeAgentAttestationProviderType is the infrastructure provider type whose attestor is used by gardener-node-agent
+	// to prove the identity of the machine during bootstrapping. If nil, machines are not attested.
+	NodeAgentAttestationProviderType *string
 }
 
 // OriginalValues are configuration values required for the 'reconcile' OperatingSystemConfigPurpose.
@@ -149,6 +155,9 @@ type OriginalValues struct {
 	NodeLocalDNSEnabled bool
 	// PrimaryIPFamily represents the preferred IP family (IPv4 or IPv6) to be used.
 	PrimaryIPFamily gardencorev1beta1.IPFamily
+	// SystemComponentImages is a list of images of the system components running on every node which do not depend on
+	// the Kubernetes version of the worker pool. They are pre-pulled on the nodes of worker pools which enable it.
+	SystemComponentImages []string
 }
 
 // New creates a new instance of Interface.
@@ -652,6 +661,11 @@ func (o *operatingSystemConfig) SetSSHPublicKeys(keys []string) {
 	o.values.SSHPublicKeys = keys
 }
 
+// SetNodeAgentAttestationProviderType sets the NodeAgentAttestationProviderType value.
+func (o *operatingSystemConfig) SetNodeAgentAttestationProviderType(providerType *string) {
+	o.values.NodeAgentAttestationProviderType = providerType
+}
+
 // WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 // containing both the init script and the original config.
 func (o *operatingSystemConfig) WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs {
@@ -715,6 +729,11 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		return deployer{}, err
 	}
 
+	prePullImages, err := o.prePullImages(worker, kubernetesVersion)
+	if err != nil {
+		return deployer{}, err
+	}
+
 	return deployer{
 		client:                  o.client,
 		osc:                     osc,
@@ -743,9 +762,36 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
 		primaryIPFamily:         o.values.PrimaryIPFamily,
 		taints:                  worker.Taints,
+		prePullImages:           prePullImages,
+
+		nodeAgentAttestationProviderType: o.values.NodeAgentAttestationProviderType,
 	}, nil
 }
 
+func (o *operatingSystemConfig) prePullImages(worker gardencorev1beta1.Worker, kubernetesVersion *semver.Version) ([]string, error) {
+	if worker.CRI == nil || worker.CRI.ImagePrePull == nil {
+		return nil, nil
+	}
+
+	images := sets.New[string]()
+
+	if ptr.Deref(worker.CRI.ImagePrePull.SystemComponents, false) {
+		images.Insert(o.values.SystemComponentImages...)
+
+		if o.values.KubeProxyEnabled {
+			kubeProxyImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()))
+			if err != nil {
+				return nil, fmt.Errorf("failed finding kube-proxy image for version %s: %w", kubernetesVersion.String(), err)
+			}
+			images.Insert(kubeProxyImage.String())
+		}
+	}
+
+	images.Insert(worker.CRI.ImagePrePull.Images...)
+
+	return sets.List(images), nil
+}
+
 func setDefaultEvictionMemoryAvailable(evictionHard, evictionSoft map[string]string, machineTypes []gardencorev1beta1.MachineType, machineType string) {
 	evictionHardMemoryAvailable, evictionSoftMemoryAvailable := "100Mi", "200Mi"
 
@@ -809,6 +855,9 @@ type deployer struct {
 	nodeMonitorGracePeriod  metav1.Duration
 	primaryIPFamily         gardencorev1beta1.IPFamily
 	taints                  []corev1.Taint
+	prePullImages           []string
+
+	nodeAgentAttestationProviderType *string
 }
 
 // exposed for testing
@@ -853,10 +902,17 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 
 	switch d.purpose {
 	case extensionsv1alpha1.OperatingSystemConfigPurposeProvision:
+		nodeAgentConfig := nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, nil)
+		if d.nodeAgentAttestationProviderType != nil {
+			nodeAgentConfig.Bootstrap = &nodeagentv1alpha1.BootstrapConfiguration{
+				Attestation: &nodeagentv1alpha1.AttestationConfiguration{ProviderType: *d.nodeAgentAttestationProviderType},
+			}
+		}
+
 		units, files, err = InitConfigFn(
 			d.worker,
 			d.images[imagevector.ContainerImageNameGardenerNodeAgent].String(),
-			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, nil),
+			nodeAgentConfig,
 		)
 		if err != nil {
 			return nil, err
@@ -891,6 +947,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, d.client, d.osc, func() error {
 		metav1.SetMetaDataAnnotation(&d.osc.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 		metav1.SetMetaDataAnnotation(&d.osc.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		logger.AnnotateOperationID(ctx, &d.osc.ObjectMeta)
 		metav1.SetMetaDataLabel(&d.osc.ObjectMeta, v1beta1constants.LabelWorkerPool, d.worker.Name)
 		metav1.SetMetaDataLabel(&d.osc.ObjectMeta, v1beta1constants.LabelExtensionProviderMutatedByControlplaneWebhook, "true")
 
@@ -914,6 +971,31 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			if pauseImage := d.images[imagevector.ContainerImageNamePauseContainer]; pauseImage != nil {
 				d.osc.Spec.CRIConfig.Containerd.SandboxImage = pauseImage.String()
 			}
+
+			d.osc.Spec.CRIConfig.Containerd.PrePullImages = d.prePullImages
+		}
+
+		d.osc.Spec.KernelConfig = nil
+		if d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+			d.osc.Spec.KernelConfig = kernelConfigForWorker(d.worker)
+		}
+
+		d.osc.Spec.DrainPolicy = nil
+		if d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+			d.osc.Spec.DrainPolicy = d.worker.DrainPolicy
+		}
+
+		d.osc.Spec.InPlaceUpdates = nil
+		if d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile && v1beta1helper.IsUpdateStrategyInPlace(d.worker.UpdateStrategy) {
+			d.osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+				OperatingSystemVersion: ptr.Deref(d.worker.Machine.Image.Version, ""),
+				KubeletVersion:         d.kubernetesVersion.String(),
+				MaxUnavailable:         ptr.Deref(d.worker.MaxUnavailable, gardencorev1beta1.DefaultInPlaceWorkerMaxUnavailable),
+			}
+			if mcmSettings := d.worker.MachineControllerManagerSettings; mcmSettings != nil {
+				d.osc.Spec.InPlaceUpdates.MachineDrainTimeout = mcmSettings.MachineDrainTimeout
+				d.osc.Spec.InPlaceUpdates.MaxEvictRetries = mcmSettings.MaxEvictRetries
+			}
 		}
 
 		return nil
@@ -921,6 +1003,26 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 	return d.osc, err
 }
 
+// kernelConfigForWorker returns the kernel config which is applied and verified by gardener-node-agent. Only the kernel
+// settings which are explicitly configured for the worker pool are contained, the defaults are still applied via the
+// kernel settings file (see kernelconfig component) so that extensions can adapt them.
+func kernelConfigForWorker(worker gardencorev1beta1.Worker) *extensionsv1alpha1.KernelConfig {
+	if len(worker.Sysctls) == 0 && len(worker.KernelModules) == 0 && len(worker.HugePages) == 0 {
+		return nil
+	}
+
+	kernelConfig := &extensionsv1alpha1.KernelConfig{Sysctls: worker.Sysctls}
+
+	for _, module := range worker.KernelModules {
+		kernelConfig.Modules = append(kernelConfig.Modules, extensionsv1alpha1.KernelModule{Name: module.Name, Parameters: module.Parameters})
+	}
+	for _, hugePages := range worker.HugePages {
+		kernelConfig.HugePages = append(kernelConfig.HugePages, extensionsv1alpha1.HugePages{PageSize: hugePages.PageSize, Count: hugePages.Count})
+	}
+
+	return kernelConfig
+}
+
 func (o *operatingSystemConfig) calculateKeyForVersion(version int, worker *gardencorev1beta1.Worker) (string, error) {
 	kubernetesVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(o.values.KubernetesVersion, worker.Kubernetes)
 	if err != nil {
@@ -976,7 +1078,8 @@ func KeyV1(workerPoolName string, kubernetesVersion *semver.Version, criConfig *
 
 // KeyV2 returns the key that can be used as secret name based on the provided worker name,
 // Kubernetes version, machine type, image, worker volume, CRI, credentials rotation, node local dns
-// and kubelet configuration.
+// and kubelet configuration. For worker pools which are updated in-place, the Kubernetes version, the image version
+// and the kubelet configuration are not considered since changing them must not lead to new machines.
 func KeyV2(
 	kubernetesVersion *semver.Version,
 	credentialsRotation *gardencorev1beta1.ShootCredentialsRotation,
@@ -988,12 +1091,16 @@ func KeyV2(
 		return ""
 	}
 
-	kubernetesMajorMinorVersion := fmt.Sprintf("%d.%d", kubernetesVersion.Major(), kubernetesVersion.Minor())
+	var (
+		inPlace                     = v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy)
+		kubernetesMajorMinorVersion = fmt.Sprintf("%d.%d", kubernetesVersion.Major(), kubernetesVersion.Minor())
+		data                        []string
+	)
 
-	data := []string{
-		kubernetesMajorMinorVersion,
-		worker.Machine.Type,
-		worker.Machine.Image.Name + *worker.Machine.Image.Version,
+	if inPlace {
+		data = append(data, worker.Machine.Type, worker.Machine.Image.Name)
+	} else {
+		data = append(data, kubernetesMajorMinorVersion, worker.Machine.Type, worker.Machine.Image.Name+*worker.Machine.Image.Version)
 	}
 
 	if worker.Volume != nil {
@@ -1020,7 +1127,7 @@ func KeyV2(
 		data = append(data, "node-local-dns")
 	}
 
-	if kubeletConfiguration != nil {
+	if kubeletConfiguration != nil && !inPlace {
 		if resources := mergeResourceReservations(kubeletConfiguration.KubeReserved, kubeletConfiguration.SystemReserved); resources != nil {
 			data = append(data, fmt.Sprintf("%s-%s-%s-%s", resources.CPU, resources.Memory, resources.PID, resources.EphemeralStorage))
 		}
@@ -567,6 +567,31 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should configure the attestation for gardener-node-agent when it is enabled", func() {
+				var bootstrapConfigs []*nodeagentv1alpha1.BootstrapConfiguration
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						bootstrapConfigs = append(bootstrapConfigs, config.Bootstrap)
+						return initConfigFn(worker, nodeAgentImage, config)
+					},
+					&OriginalConfigFn, originalConfigFn,
+					&values.NodeAgentAttestationProviderType, ptr.To("local"),
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				Expect(bootstrapConfigs).To(HaveLen(len(workers)))
+				for _, bootstrapConfig := range bootstrapConfigs {
+					Expect(bootstrapConfig).To(Equal(&nodeagentv1alpha1.BootstrapConfiguration{
+						Attestation: &nodeagentv1alpha1.AttestationConfiguration{ProviderType: "local"},
+					}))
+				}
+			})
+
 			It("should successfully deploy all extensions resources and SSH access is enabled", func() {
 				DeferCleanup(test.WithVars(
 					&TimeNow, mockNow.Do,
@@ -590,6 +615,161 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should successfully deploy the images to pre-pull", func() {
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				values.Workers = []gardencorev1beta1.Worker{*workers[1].DeepCopy()}
+				values.Workers[0].CRI.ImagePrePull = &gardencorev1beta1.ImagePrePull{
+					SystemComponents: ptr.To(true),
+					Images:           []string{"registry.example.com/app:v1", "registry.example.com/node-problem-detector:v1"},
+				}
+				values.KubeProxyEnabled = true
+				values.SystemComponentImages = []string{"registry.example.com/node-problem-detector:v1", "registry.example.com/node-local-dns:v1"}
+
+				defaultDepWaiter = New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+				Expect(c.List(ctx, oscList, client.InNamespace(namespace))).To(Succeed())
+				Expect(oscList.Items).To(HaveLen(2))
+
+				for _, osc := range oscList.Items {
+					if osc.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+						Expect(osc.Spec.CRIConfig.Containerd).To(BeNil())
+						continue
+					}
+
+					Expect(osc.Spec.CRIConfig.Containerd.PrePullImages).To(Equal([]string{
+						"registry.example.com/app:v1",
+						"registry.example.com/node-local-dns:v1",
+						"registry.example.com/node-problem-detector:v1",
+						"registry.k8s.io/kube-proxy:v" + workerKubernetesVersion,
+					}))
+				}
+			})
+
+			It("should successfully deploy the kernel config", func() {
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				values.Workers = []gardencorev1beta1.Worker{*workers[1].DeepCopy()}
+				values.Workers[0].Sysctls = map[string]string{"net.core.somaxconn": "65535"}
+				values.Workers[0].KernelModules = []gardencorev1beta1.KernelModule{{Name: "nf_conntrack", Parameters: map[string]string{"hashsize": "131072"}}}
+				values.Workers[0].HugePages = []gardencorev1beta1.HugePages{{PageSize: resource.MustParse("2Mi"), Count: 512}}
+
+				defaultDepWaiter = New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+				Expect(c.List(ctx, oscList, client.InNamespace(namespace))).To(Succeed())
+				Expect(oscList.Items).To(HaveLen(2))
+
+				for _, osc := range oscList.Items {
+					if osc.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+						Expect(osc.Spec.KernelConfig).To(BeNil())
+						continue
+					}
+
+					Expect(osc.Spec.KernelConfig).To(Equal(&extensionsv1alpha1.KernelConfig{
+						Sysctls:   map[string]string{"net.core.somaxconn": "65535"},
+						Modules:   []extensionsv1alpha1.KernelModule{{Name: "nf_conntrack", Parameters: map[string]string{"hashsize": "131072"}}},
+						HugePages: []extensionsv1alpha1.HugePages{{PageSize: resource.MustParse("2Mi"), Count: 512}},
+					}))
+				}
+			})
+
+			It("should successfully deploy the drain policy", func() {
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				values.Workers = []gardencorev1beta1.Worker{*workers[1].DeepCopy()}
+				values.Workers[0].DrainPolicy = &gardencorev1beta1.WorkerDrainPolicy{
+					EvictionOrder: ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending),
+					PreDrainHooks: []gardencorev1beta1.PreDrainHook{{Name: "failover", URL: "https://failover.example.com"}},
+				}
+
+				defaultDepWaiter = New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+				Expect(c.List(ctx, oscList, client.InNamespace(namespace))).To(Succeed())
+				Expect(oscList.Items).To(HaveLen(2))
+
+				for _, osc := range oscList.Items {
+					Expect(osc.Spec.InPlaceUpdates).To(BeNil())
+
+					if osc.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+						Expect(osc.Spec.DrainPolicy).To(BeNil())
+						continue
+					}
+
+					Expect(osc.Spec.DrainPolicy).To(Equal(values.Workers[0].DrainPolicy))
+				}
+			})
+
+			It("should successfully deploy the in-place updates config", func() {
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				values.Workers = []gardencorev1beta1.Worker{*workers[1].DeepCopy()}
+				values.Workers[0].UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+				values.Workers[0].MaxUnavailable = ptr.To(intstr.FromString("25%"))
+				values.Workers[0].DrainPolicy = &gardencorev1beta1.WorkerDrainPolicy{
+					EvictionOrder: ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending),
+				}
+				values.Workers[0].MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{
+					MachineDrainTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+					MaxEvictRetries:     ptr.To[int32](5),
+				}
+
+				defaultDepWaiter = New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+				Expect(c.List(ctx, oscList, client.InNamespace(namespace))).To(Succeed())
+				Expect(oscList.Items).To(HaveLen(2))
+
+				for _, osc := range oscList.Items {
+					if osc.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+						Expect(osc.Spec.InPlaceUpdates).To(BeNil())
+						Expect(osc.Spec.DrainPolicy).To(BeNil())
+						continue
+					}
+
+					Expect(osc.Spec.InPlaceUpdates).To(Equal(&extensionsv1alpha1.InPlaceUpdates{
+						OperatingSystemVersion: *values.Workers[0].Machine.Image.Version,
+						KubeletVersion:         workerKubernetesVersion,
+						MaxUnavailable:         intstr.FromString("25%"),
+						MachineDrainTimeout:    &metav1.Duration{Duration: 10 * time.Minute},
+						MaxEvictRetries:        ptr.To[int32](5),
+					}))
+					Expect(osc.Spec.DrainPolicy).To(Equal(&gardencorev1beta1.WorkerDrainPolicy{
+						EvictionOrder: ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending),
+					}))
+				}
+			})
+
 			It("should exclude the bootstrap token file if purpose is not provision", func() {
 				bootstrapTokenFile := extensionsv1alpha1.File{Path: "/var/lib/gardener-node-agent/credentials/bootstrap-token"}
 				initConfigFnWithBootstrapToken := func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
@@ -1524,5 +1704,33 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 		})
+
+		Context("worker pool updated in-place", func() {
+			BeforeEach(func() {
+				p.UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+
+				var err error
+				hash, err = CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not change the hash value when changing versions or the kubelet configuration", func() {
+				p.Machine.Image.Version = ptr.To("new-version")
+				kubernetesVersion = semver.MustParse("1.3.0")
+				kubeletConfig.KubeReserved.CPU = ptr.To(resource.MustParse("100m"))
+				kubeletConfig.CPUManagerPolicy = ptr.To("static")
+
+				Expect(CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)).To(Equal(hash))
+			})
+
+			It("should change the hash value when changing the machine type or image name", func() {
+				p.Machine.Type = "small"
+				Expect(CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)).NotTo(Equal(hash))
+
+				p.Machine.Type = "foo"
+				p.Machine.Image.Name = "new-image"
+				Expect(CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)).NotTo(Equal(hash))
+			})
+		})
 	})
 })
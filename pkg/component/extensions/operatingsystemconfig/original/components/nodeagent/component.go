@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/valitail"
 	valiconstants "github.com/gardener/gardener/pkg/component/observability/logging/vali/constants"
+	"github.com/gardener/gardener/pkg/features"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -118,7 +119,7 @@ func ComponentConfig(
 	caBundle []byte,
 	additionalTokenSyncConfigs []nodeagentv1alpha1.TokenSecretSyncConfig,
 ) *nodeagentv1alpha1.NodeAgentConfiguration {
-	return &nodeagentv1alpha1.NodeAgentConfiguration{
+	config := &nodeagentv1alpha1.NodeAgentConfiguration{
 		APIServer: nodeagentv1alpha1.APIServer{
 			Server:   apiServerURL,
 			CABundle: caBundle,
@@ -140,6 +141,14 @@ func ComponentConfig(
 			},
 		},
 	}
+
+	if features.DefaultFeatureGate.Enabled(features.NodeAgentRemediation) {
+		config.Controllers.HealthCheck = &nodeagentv1alpha1.HealthCheckControllerConfig{
+			Remediation: &nodeagentv1alpha1.RemediationConfig{},
+		}
+	}
+
+	return config
 }
 
 // Files returns the files related to the gardener-node-agent unit.
@@ -16,9 +16,11 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	. "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	"github.com/gardener/gardener/pkg/features"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Component", func() {
@@ -134,6 +136,14 @@ WantedBy=multi-user.target`))
 				},
 			}))
 		})
+
+		It("should enable the remediation if the NodeAgentRemediation feature gate is enabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.NodeAgentRemediation, true))
+
+			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, nil).Controllers.HealthCheck).To(Equal(&nodeagentv1alpha1.HealthCheckControllerConfig{
+				Remediation: &nodeagentv1alpha1.RemediationConfig{},
+			}))
+		})
 	})
 
 	Describe("#Files", func() {
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/authentication/user"
 	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	valiconstants "github.com/gardener/gardener/pkg/component/observability/logging/vali/constants"
@@ -19,8 +20,19 @@ import (
 
 // RBACResourcesData returns a map of serialized Kubernetes resources that allow the gardener-node-agent to
 // access the list of given secrets. Additionally, serialized resources providing permissions to allow initiating the
-// Kubernetes TLS bootstrapping process will be returned.
-func RBACResourcesData(secretNames []string) (map[string][]byte, error) {
+// Kubernetes TLS bootstrapping process will be returned. If attestation is enabled, only machines which proved their
+// identity are permitted to fetch the access token of gardener-node-agent and to request kubelet client certificates,
+// i.e., a bootstrap token alone is only sufficient for requesting an attested client certificate. In this case, the
+// kubelet client certificates requested with attested client certificates are not approved by kube-controller-manager
+// but by gardener-resource-manager, which ensures that they are only issued for the node of the attested machine.
+func RBACResourcesData(secretNames []string, attestationEnabled bool) (map[string][]byte, error) {
+	// bootstrapGroup is the group of the credentials used by gardener-node-agent for fetching its access token and by
+	// kubelet for requesting its client certificate.
+	bootstrapGroup := bootstraptokenapi.BootstrapDefaultGroup
+	if attestationEnabled {
+		bootstrapGroup = nodeagentv1alpha1.AttestedNodesGroup
+	}
+
 	var (
 		clusterRole = &rbacv1.ClusterRole{
 			ObjectMeta: metav1.ObjectMeta{
@@ -89,7 +101,7 @@ func RBACResourcesData(secretNames []string) (map[string][]byte, error) {
 			Subjects: []rbacv1.Subject{
 				{
 					Kind: rbacv1.GroupKind,
-					Name: bootstraptokenapi.BootstrapDefaultGroup,
+					Name: bootstrapGroup,
 				},
 				{
 					Kind:      rbacv1.ServiceAccountKind,
@@ -127,7 +139,7 @@ func RBACResourcesData(secretNames []string) (map[string][]byte, error) {
 			Subjects: []rbacv1.Subject{{
 				APIGroup: rbacv1.SchemeGroupVersion.Group,
 				Kind:     rbacv1.GroupKind,
-				Name:     bootstraptokenapi.BootstrapDefaultGroup,
+				Name:     bootstrapGroup,
 			}},
 		}
 
@@ -148,15 +160,30 @@ func RBACResourcesData(secretNames []string) (map[string][]byte, error) {
 		}
 	)
 
+	objects := []client.Object{
+		clusterRole,
+		clusterRoleBinding,
+		role,
+		roleBinding,
+		clusterRoleBindingNodeBootstrapper,
+		clusterRoleBindingSelfNodeClient,
+	}
+
+	if attestationEnabled {
+		// Bootstrap tokens are still needed for creating the CSR of the attested client certificate, while kubelet uses
+		// the attested client certificate for creating the CSR of its own client certificate.
+		clusterRoleBindingNodeBootstrapper.Subjects = append(clusterRoleBindingNodeBootstrapper.Subjects, rbacv1.Subject{
+			APIGroup: rbacv1.SchemeGroupVersion.Group,
+			Kind:     rbacv1.GroupKind,
+			Name:     nodeagentv1alpha1.AttestedNodesGroup,
+		})
+	} else {
+		// The 'nodeclient' permission allows requesting client certificates for arbitrary nodes. It must not be granted to
+		// attested machines, since they must only be able to request the client certificate of their own node.
+		objects = append(objects, clusterRoleBindingNodeClient)
+	}
+
 	return managedresources.
 		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
-		AddAllAndSerialize(
-			clusterRole,
-			clusterRoleBinding,
-			role,
-			roleBinding,
-			clusterRoleBindingNodeBootstrapper,
-			clusterRoleBindingNodeClient,
-			clusterRoleBindingSelfNodeClient,
-		)
+		AddAllAndSerialize(objects...)
 }
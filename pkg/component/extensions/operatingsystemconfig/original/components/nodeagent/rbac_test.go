@@ -168,7 +168,7 @@ subjects:
 		})
 
 		It("should generate the expected RBAC resources", func() {
-			dataMap, err := RBACResourcesData([]string{"osc-secret1", "osc-secret2"})
+			dataMap, err := RBACResourcesData([]string{"osc-secret1", "osc-secret2"}, false)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(dataMap).To(HaveKey("data.yaml.br"))
@@ -187,5 +187,28 @@ subjects:
 				clusterRoleBindingSelfNodeClientYAML,
 			))
 		})
+
+		It("should generate the expected RBAC resources when attestation is enabled", func() {
+			dataMap, err := RBACResourcesData([]string{"osc-secret1", "osc-secret2"}, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(dataMap).To(HaveKey("data.yaml.br"))
+			compressedData := dataMap["data.yaml.br"]
+			data, err := test.BrotliDecompression(compressedData)
+			Expect(err).NotTo(HaveOccurred())
+
+			manifests := strings.Split(string(data), "---\n")
+			Expect(manifests).To(ConsistOf(
+				clusterRoleYAML,
+				clusterRoleBindingYAML,
+				roleYAML,
+				strings.ReplaceAll(roleBindingYAML, "system:bootstrappers", "gardener.cloud:system:attested-nodes"),
+				clusterRoleBindingNodeBootstrapperYAML+`- apiGroup: rbac.authorization.k8s.io
+  kind: Group
+  name: gardener.cloud:system:attested-nodes
+`,
+				clusterRoleBindingSelfNodeClientYAML,
+			))
+		})
 	})
 })
@@ -11,6 +11,8 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -147,6 +149,46 @@ status:
 			}))
 		})
 
+		It("should include the kernel config", func() {
+			osc.Spec.KernelConfig = &extensionsv1alpha1.KernelConfig{Sysctls: map[string]string{"net.core.somaxconn": "65535"}}
+
+			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["osc.yaml"])).To(ContainSubstring(`  kernelConfig:
+    sysctls:
+      net.core.somaxconn: "65535"
+`))
+		})
+
+		It("should include the in-place updates configuration", func() {
+			osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{OperatingSystemVersion: "1.2.3", KubeletVersion: "1.31.1", MaxUnavailable: intstr.FromInt32(1)}
+			osc.Status.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdatesStatus{OSUpdate: &extensionsv1alpha1.OSUpdate{Command: "/usr/bin/os-update"}}
+
+			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["osc.yaml"])).To(And(
+				ContainSubstring(`  inPlaceUpdates:
+    kubeletVersion: 1.31.1
+    maxUnavailable: 1
+    operatingSystemVersion: 1.2.3
+`),
+				ContainSubstring(`  inPlaceUpdates:
+    osUpdate:
+      command: /usr/bin/os-update
+`),
+			))
+		})
+
+		It("should include the drain policy", func() {
+			osc.Spec.DrainPolicy = &gardencorev1beta1.WorkerDrainPolicy{EvictionOrder: ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending)}
+
+			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["osc.yaml"])).To(ContainSubstring(`  drainPolicy:
+    evictionOrder: PriorityAscending
+`))
+		})
+
 		It("should return an error because a referenced secret cannot be found", func() {
 			osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
 				Path: "/non/existing/path",
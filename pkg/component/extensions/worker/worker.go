@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/logger"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -191,17 +192,22 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 
 		if nodeTemplate == nil || machineType != workerPool.Machine.Type {
 			// initializing nodeTemplate by fetching details from cloudprofile, if present there
-			if machineDetails := v1beta1helper.FindMachineTypeByName(w.values.MachineTypes, workerPool.Machine.Type); machineDetails != nil {
-				nodeTemplate = &extensionsv1alpha1.NodeTemplate{
-					Capacity: corev1.ResourceList{
-						corev1.ResourceCPU:    machineDetails.CPU,
-						"gpu":                 machineDetails.GPU,
-						corev1.ResourceMemory: machineDetails.Memory,
-					},
-				}
-			} else {
-				nodeTemplate = nil
+			nodeTemplate = w.nodeTemplateFromCloudProfile(workerPool.Machine.Type)
+		}
+
+		var alternativeMachineTypes []extensionsv1alpha1.AlternativeMachineType
+		for _, alternativeType := range workerPool.Machine.AlternativeTypes {
+			alternativeNodeTemplate := w.nodeTemplateFromCloudProfile(alternativeType.Type)
+			if alternativeNodeTemplate == nil {
+				alternativeNodeTemplate = w.findAlternativeNodeTemplateByPoolName(obj, workerPool.Name, alternativeType.Type)
 			}
+
+			alternativeMachineTypes = append(alternativeMachineTypes, extensionsv1alpha1.AlternativeMachineType{
+				MachineType:    alternativeType.Type,
+				Architecture:   alternativeType.Architecture,
+				ProviderConfig: alternativeType.ProviderConfig,
+				NodeTemplate:   alternativeNodeTemplate,
+			})
 		}
 
 		var autoscalerOptions *extensionsv1alpha1.ClusterAutoscalerOptions
@@ -253,6 +259,8 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			MachineControllerManagerSettings: workerPool.MachineControllerManagerSettings,
 			Architecture:                     workerPool.Machine.Architecture,
 			ClusterAutoscaler:                autoscalerOptions,
+			UpdateStrategy:                   workerPool.UpdateStrategy,
+			AlternativeMachineTypes:          alternativeMachineTypes,
 		})
 	}
 
@@ -263,6 +271,7 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, w.client, w.worker, func() error {
 		metav1.SetMetaDataAnnotation(&w.worker.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 		metav1.SetMetaDataAnnotation(&w.worker.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		logger.AnnotateOperationID(ctx, &w.worker.ObjectMeta)
 
 		w.worker.Spec = extensionsv1alpha1.WorkerSpec{
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
@@ -405,6 +414,35 @@ func (w *worker) findNodeTemplateAndMachineTypeByPoolName(obj *extensionsv1alpha
 	return nil, ""
 }
 
+func (w *worker) findAlternativeNodeTemplateByPoolName(obj *extensionsv1alpha1.Worker, poolName, machineType string) *extensionsv1alpha1.NodeTemplate {
+	for _, pool := range obj.Spec.Pools {
+		if pool.Name != poolName {
+			continue
+		}
+		for _, alternativeMachineType := range pool.AlternativeMachineTypes {
+			if alternativeMachineType.MachineType == machineType {
+				return alternativeMachineType.NodeTemplate
+			}
+		}
+	}
+	return nil
+}
+
+func (w *worker) nodeTemplateFromCloudProfile(machineType string) *extensionsv1alpha1.NodeTemplate {
+	machineDetails := v1beta1helper.FindMachineTypeByName(w.values.MachineTypes, machineType)
+	if machineDetails == nil {
+		return nil
+	}
+
+	return &extensionsv1alpha1.NodeTemplate{
+		Capacity: corev1.ResourceList{
+			corev1.ResourceCPU:    machineDetails.CPU,
+			"gpu":                 machineDetails.GPU,
+			corev1.ResourceMemory: machineDetails.Memory,
+		},
+	}
+}
+
 // checkWorkerStatusMachineDeploymentsUpdated checks if the status of the worker is updated or not during its reconciliation.
 // It is updated if
 // * The status.MachineDeploymentsLastUpdateTime > the value of the time stamp stored in worker struct before the reconciliation begins.
@@ -516,6 +516,44 @@ var _ = Describe("Worker", func() {
 				Spec: *expectedWorkerSpec,
 			}))
 		})
+
+		It("should successfully deploy the Worker resource with alternative machine types when present", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			alternativeProviderConfig := &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+
+			newValues := *values
+			newValues.Workers[0].Machine.AlternativeTypes = []gardencorev1beta1.AlternativeMachineType{
+				{Type: worker2MachineType, Architecture: worker2Arch, ProviderConfig: alternativeProviderConfig},
+				{Type: "unknown-machine-type", Architecture: ptr.To("amd64")},
+			}
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools[0].AlternativeMachineTypes = []extensionsv1alpha1.AlternativeMachineType{
+				{MachineType: worker2MachineType, Architecture: worker2Arch, ProviderConfig: alternativeProviderConfig, NodeTemplate: workerPool2NodeTemplate},
+				{MachineType: "unknown-machine-type", Architecture: ptr.To("amd64")},
+			}
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+
+			Expect(obj).To(DeepEqual(&extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Annotations: map[string]string{
+						"gardener.cloud/operation": "reconcile",
+						"gardener.cloud/timestamp": now.UTC().Format(time.RFC3339Nano),
+					},
+					ResourceVersion: "1",
+				},
+				Spec: *expectedWorkerSpec,
+			}))
+		})
 	})
 
 	Describe("#Wait", func() {
@@ -28,6 +28,7 @@ import (
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -367,6 +368,40 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				Name:     user.AllAuthenticated,
 			}},
 		}
+		clusterRoleWorkloadIdentityTokenExchange = &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gardener.cloud:system:workloadidentity-token-exchange",
+			},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{securityv1alpha1.GroupName},
+				Resources: []string{"workloadidentities/exchange"},
+				Verbs:     []string{"create"},
+			}},
+		}
+		clusterRoleBindingWorkloadIdentityTokenExchange = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterRoleWorkloadIdentityTokenExchange.Name,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     clusterRoleWorkloadIdentityTokenExchange.Name,
+			},
+			// Workloads of shoot clusters exchange their tokens without credentials for the garden cluster. The request
+			// is authenticated by verifying the shoot service account token contained in the request.
+			Subjects: []rbacv1.Subject{
+				{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "Group",
+					Name:     user.AllAuthenticated,
+				},
+				{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "Group",
+					Name:     user.AllUnauthenticated,
+				},
+			},
+		}
 		clusterRoleProjectCreation = &rbacv1.ClusterRole{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "gardener.cloud:system:project-creation",
@@ -599,6 +634,8 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 		clusterRoleBindingReadGlobalResources,
 		clusterRoleUserAuth,
 		clusterRoleBindingUserAuth,
+		clusterRoleWorkloadIdentityTokenExchange,
+		clusterRoleBindingWorkloadIdentityTokenExchange,
 		clusterRoleProjectCreation,
 		clusterRoleProjectMember,
 		clusterRoleProjectMemberAggregated,
@@ -20,6 +20,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	. "github.com/gardener/gardener/pkg/component/garden/system/virtual"
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
@@ -59,6 +60,8 @@ var _ = Describe("Virtual", func() {
 		clusterRoleBindingReadGlobalResources             *rbacv1.ClusterRoleBinding
 		clusterRoleUserAuth                               *rbacv1.ClusterRole
 		clusterRoleBindingUserAuth                        *rbacv1.ClusterRoleBinding
+		clusterRoleWorkloadIdentityTokenExchange          *rbacv1.ClusterRole
+		clusterRoleBindingWorkloadIdentityTokenExchange   *rbacv1.ClusterRoleBinding
 		clusterRoleProjectCreation                        *rbacv1.ClusterRole
 		clusterRoleProjectMember                          *rbacv1.ClusterRole
 		clusterRoleProjectMemberAggregated                *rbacv1.ClusterRole
@@ -386,6 +389,38 @@ var _ = Describe("Virtual", func() {
 				Name:     "system:authenticated",
 			}},
 		}
+		clusterRoleWorkloadIdentityTokenExchange = &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gardener.cloud:system:workloadidentity-token-exchange",
+			},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{securityv1alpha1.GroupName},
+				Resources: []string{"workloadidentities/exchange"},
+				Verbs:     []string{"create"},
+			}},
+		}
+		clusterRoleBindingWorkloadIdentityTokenExchange = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterRoleWorkloadIdentityTokenExchange.Name,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     clusterRoleWorkloadIdentityTokenExchange.Name,
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "Group",
+					Name:     "system:authenticated",
+				},
+				{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "Group",
+					Name:     "system:unauthenticated",
+				},
+			},
+		}
 		clusterRoleProjectCreation = &rbacv1.ClusterRole{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "gardener.cloud:system:project-creation",
@@ -652,6 +687,8 @@ var _ = Describe("Virtual", func() {
 				clusterRoleBindingReadGlobalResources,
 				clusterRoleUserAuth,
 				clusterRoleBindingUserAuth,
+				clusterRoleWorkloadIdentityTokenExchange,
+				clusterRoleBindingWorkloadIdentityTokenExchange,
 				clusterRoleProjectCreation,
 				clusterRoleProjectMemberAggregated,
 				clusterRoleProjectMember,
@@ -112,6 +112,26 @@ var _ = Describe("GardenerAdmissionController", func() {
 							Size:        resource.MustParse("100Ki"),
 						},
 					},
+					RateLimits: []admissioncontrollerv1alpha1.ResourceRateLimit{
+						{
+							APIGroups:         []string{"core.gardener.cloud"},
+							APIVersions:       []string{"*"},
+							Resources:         []string{"shoots"},
+							Operations:        []string{"CREATE"},
+							Scope:             admissioncontrollerv1alpha1.RateLimitScopeProject,
+							RequestsPerMinute: 10,
+							Burst:             20,
+						},
+						{
+							APIGroups:         []string{""},
+							APIVersions:       []string{"v1"},
+							Resources:         []string{"secrets"},
+							Operations:        []string{"*"},
+							Scope:             admissioncontrollerv1alpha1.RateLimitScopeUser,
+							RequestsPerMinute: 60,
+							Burst:             60,
+						},
+					},
 					UnrestrictedSubjects: []rbacv1.Subject{{
 						Kind:      "ServiceAccount",
 						Name:      "foo",
@@ -130,7 +150,7 @@ var _ = Describe("GardenerAdmissionController", func() {
 		Context("with common values", func() {
 			It("should successfully deploy", func() {
 				Expect(deployer.Deploy(ctx)).To(Succeed())
-				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "4ef77c17", testValues, true)
+				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "3f5f459c", testValues, true)
 			})
 		})
 
@@ -141,7 +161,7 @@ var _ = Describe("GardenerAdmissionController", func() {
 
 			It("should successfully deploy", func() {
 				Expect(deployer.Deploy(ctx)).To(Succeed())
-				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "4ef77c17", testValues, true)
+				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "3f5f459c", testValues, true)
 			})
 		})
 
@@ -152,7 +172,7 @@ var _ = Describe("GardenerAdmissionController", func() {
 
 			It("should successfully deploy", func() {
 				Expect(deployer.Deploy(ctx)).To(Succeed())
-				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "4ef77c17", testValues, false)
+				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "3f5f459c", testValues, false)
 			})
 		})
 
@@ -164,7 +184,7 @@ var _ = Describe("GardenerAdmissionController", func() {
 
 			It("should successfully deploy", func() {
 				Expect(deployer.Deploy(ctx)).To(Succeed())
-				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "4ef77c17", testValues, true)
+				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "3f5f459c", testValues, true)
 			})
 		})
 
@@ -186,7 +206,7 @@ var _ = Describe("GardenerAdmissionController", func() {
 
 			It("should successfully deploy", func() {
 				Expect(deployer.Deploy(ctx)).To(Succeed())
-				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "4ef77c17", testValues, true)
+				verifyExpectations(ctx, fakeClient, consistOf, fakeSecretManager, namespace, "3f5f459c", testValues, true)
 			})
 		})
 	})
@@ -905,6 +925,16 @@ func clusterRole() *rbacv1.ClusterRole {
 				},
 				Verbs: []string{"get", "list", "watch"},
 			},
+			{
+				APIGroups: []string{"authentication.k8s.io"},
+				Resources: []string{"tokenreviews"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{"authorization.k8s.io"},
+				Resources: []string{"subjectaccessreviews"},
+				Verbs:     []string{"create"},
+			},
 		},
 	}
 }
@@ -1084,6 +1114,40 @@ func validatingWebhookConfiguration(namespace string, caBundle []byte, testValue
 				},
 				SideEffects: &sideEffectsNone,
 			},
+			{
+				Name:                    "alerting-rules.gardener.cloud",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				TimeoutSeconds:          ptr.To[int32](10),
+				Rules: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{gardencorev1beta1.GroupName},
+							APIVersions: []string{"v1beta1"},
+							Resources:   []string{"shoots"},
+						},
+					},
+					{
+						Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Update},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{""},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"configmaps"},
+						},
+					},
+				},
+				FailurePolicy: &failurePolicyFail,
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"gardener.cloud/role": "project",
+					},
+				},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					URL:      ptr.To("https://gardener-admission-controller." + namespace + "/webhooks/alerting-rules"),
+					CABundle: caBundle,
+				},
+				SideEffects: &sideEffectsNone,
+			},
 			{
 				Name:                    "admission-plugin-secret.gardener.cloud",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -1135,6 +1199,22 @@ func validatingWebhookConfiguration(namespace string, caBundle []byte, testValue
 						Resources:   []string{"shoots"},
 					},
 				},
+				{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{"core.gardener.cloud"},
+						APIVersions: []string{"*"},
+						Resources:   []string{"shoots"},
+					},
+				},
+				{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{""},
+						APIVersions: []string{"v1"},
+						Resources:   []string{"secrets"},
+					},
+				},
 			},
 			FailurePolicy: &failurePolicyFail,
 			NamespaceSelector: &metav1.LabelSelector{
@@ -5,6 +5,8 @@
 package admissioncontroller
 
 import (
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -94,6 +96,18 @@ func (a *gardenerAdmissionController) clusterRole() *rbacv1.ClusterRole {
 				},
 				Verbs: []string{"get", "list", "watch"},
 			},
+			// TokenReviews and SubjectAccessReviews are required for authenticating and authorizing requests to the log
+			// level endpoint of the metrics server.
+			{
+				APIGroups: []string{authenticationv1.GroupName},
+				Resources: []string{"tokenreviews"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{authorizationv1.GroupName},
+				Resources: []string{"subjectaccessreviews"},
+				Verbs:     []string{"create"},
+			},
 		},
 	}
 }
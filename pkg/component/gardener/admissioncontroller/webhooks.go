@@ -6,6 +6,7 @@ package admissioncontroller
 
 import (
 	"fmt"
+	"slices"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -177,6 +178,40 @@ func (a *gardenerAdmissionController) validatingWebhookConfiguration(caSecret *c
 				},
 				SideEffects: &sideEffectsNone,
 			},
+			{
+				Name:                    "alerting-rules.gardener.cloud",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				TimeoutSeconds:          ptr.To[int32](10),
+				Rules: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{gardencorev1beta1.GroupName},
+							APIVersions: []string{"v1beta1"},
+							Resources:   []string{"shoots"},
+						},
+					},
+					{
+						Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Update},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{corev1.GroupName},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"configmaps"},
+						},
+					},
+				},
+				FailurePolicy: &failurePolicyFail,
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						v1beta1constants.GardenRole: v1beta1constants.GardenRoleProject,
+					},
+				},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					URL:      buildClientConfigURL("/webhooks/alerting-rules", a.namespace),
+					CABundle: caBundle,
+				},
+				SideEffects: &sideEffectsNone,
+			},
 			{
 				Name:                    "admission-plugin-secret.gardener.cloud",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -312,10 +347,10 @@ func (a *gardenerAdmissionController) validatingWebhookConfiguration(caSecret *c
 }
 
 func buildWebhookConfigRulesForResourceSize(config *admissioncontrollerv1alpha1.ResourceAdmissionConfiguration) []admissionregistrationv1.RuleWithOperations {
-	if config == nil || len(config.Limits) == 0 {
+	if config == nil || len(config.Limits)+len(config.RateLimits) == 0 {
 		return nil
 	}
-	rules := make([]admissionregistrationv1.RuleWithOperations, 0, len(config.Limits))
+	rules := make([]admissionregistrationv1.RuleWithOperations, 0, len(config.Limits)+len(config.RateLimits))
 
 	for _, limit := range config.Limits {
 		rules = append(rules, admissionregistrationv1.RuleWithOperations{
@@ -328,9 +363,34 @@ func buildWebhookConfigRulesForResourceSize(config *admissioncontrollerv1alpha1.
 		})
 	}
 
+	for _, rateLimit := range config.RateLimits {
+		rules = append(rules, admissionregistrationv1.RuleWithOperations{
+			Operations: rateLimitOperations(rateLimit.Operations),
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   rateLimit.APIGroups,
+				APIVersions: rateLimit.APIVersions,
+				Resources:   rateLimit.Resources,
+			},
+		})
+	}
+
 	return rules
 }
 
+// rateLimitOperations returns the webhook operations for the given rate limit operations. The handler only supports
+// CREATE and UPDATE requests, hence the wildcard must not be translated to all operations.
+func rateLimitOperations(operations []string) []admissionregistrationv1.OperationType {
+	if len(operations) == 0 || slices.Contains(operations, admissioncontrollerv1alpha1.WildcardAll) {
+		return []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update}
+	}
+
+	out := make([]admissionregistrationv1.OperationType, 0, len(operations))
+	for _, operation := range operations {
+		out = append(out, admissionregistrationv1.OperationType(operation))
+	}
+	return out
+}
+
 func buildClientConfigURL(webhookPath, namespace string) *string {
 	return ptr.To(fmt.Sprintf("https://%s.%s%s", ServiceName, namespace, webhookPath))
 }
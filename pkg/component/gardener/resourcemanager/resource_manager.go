@@ -54,6 +54,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
 	resourcemanagerv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/crddeletionprotection"
@@ -61,6 +62,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podeviction"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopologyspreadconstraints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/projectedtokenmount"
@@ -140,11 +142,13 @@ const (
 	volumeNameAPIServerAccess     = "kube-api-access-gardener"
 	volumeNameRootCA              = "root-ca"
 	volumeNameConfiguration       = "config"
+	volumeNameAttestationVerifier = "node-agent-attestation-verifier"
 
-	volumeMountPathCerts           = "/etc/gardener-resource-manager-tls"
-	volumeMountPathAPIServerAccess = "/var/run/secrets/kubernetes.io/serviceaccount"
-	volumeMountPathRootCA          = "/etc/gardener-resource-manager-root-ca"
-	volumeMountPathConfiguration   = "/etc/gardener-resource-manager-config"
+	volumeMountPathCerts               = "/etc/gardener-resource-manager-tls"
+	volumeMountPathAPIServerAccess     = "/var/run/secrets/kubernetes.io/serviceaccount"
+	volumeMountPathRootCA              = "/etc/gardener-resource-manager-root-ca"
+	volumeMountPathConfiguration       = "/etc/gardener-resource-manager-config"
+	volumeMountPathAttestationVerifier = "/var/run/secrets/gardener.cloud/node-agent-attestation-verifier"
 )
 
 var (
@@ -322,6 +326,10 @@ type Values struct {
 	// operating system configs on nodes. When this is provided, the respective controller is enabled in
 	// resource-manager.
 	NodeAgentReconciliationMaxDelay *metav1.Duration
+	// NodeAgentAttestationVerifierURL is the URL of the endpoint verifying attestation documents when approving client
+	// certificates requested by gardener-node-agent. When this is provided, such requests are handled by the kubelet CSR
+	// approver controller.
+	NodeAgentAttestationVerifierURL *string
 }
 
 func (r *resourceManager) Deploy(ctx context.Context) error {
@@ -603,6 +611,11 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		if r.values.WatchedNamespace != nil {
 			config.Controllers.KubeletCSRApprover.MachineNamespace = *r.values.WatchedNamespace
 		}
+		if r.values.NodeAgentAttestationVerifierURL != nil {
+			config.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierURL = r.values.NodeAgentAttestationVerifierURL
+			config.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierCAFile = ptr.To(volumeMountPathAttestationVerifier + "/" + attestation.VerifierCASecretDataKey)
+			config.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierTokenFile = ptr.To(volumeMountPathAttestationVerifier + "/token")
+		}
 	}
 
 	if v := r.values.MaxConcurrentTokenRequestorWorkers; v != nil {
@@ -644,6 +657,8 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		}
 
 		config.Controllers.NodeCriticalComponents.Enabled = true
+		config.Controllers.NodeDrain.Enabled = true
+		config.Webhooks.PodEviction.Enabled = true
 	}
 
 	// this function should be called at the last to make sure we disable
@@ -993,6 +1008,43 @@ func (r *resourceManager) ensureDeployment(ctx context.Context, configMap *corev
 			}
 		}
 
+		if r.values.NodeAgentAttestationVerifierURL != nil {
+			// The CA bundle of the verification endpoint is provided by the extension of the infrastructure provider, which
+			// is usually deployed after gardener-resource-manager, hence it is optional.
+			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+				Name: volumeNameAttestationVerifier,
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						DefaultMode: ptr.To[int32](420),
+						Sources: []corev1.VolumeProjection{
+							{
+								ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+									Audience:          attestation.VerifierTokenAudience,
+									ExpirationSeconds: ptr.To(int64(60 * 60)),
+									Path:              "token",
+								},
+							},
+							{
+								Secret: &corev1.SecretProjection{
+									LocalObjectReference: corev1.LocalObjectReference{Name: attestation.VerifierCASecretName},
+									Items: []corev1.KeyToPath{{
+										Key:  attestation.VerifierCASecretDataKey,
+										Path: attestation.VerifierCASecretDataKey,
+									}},
+									Optional: ptr.To(true),
+								},
+							},
+						},
+					},
+				},
+			})
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				MountPath: volumeMountPathAttestationVerifier,
+				Name:      volumeNameAttestationVerifier,
+				ReadOnly:  true,
+			})
+		}
+
 		utilruntime.Must(references.InjectAnnotations(deployment))
 
 		if r.values.TargetDiffersFromSourceCluster {
@@ -1191,7 +1243,11 @@ func (r *resourceManager) ensureValidatingWebhookConfiguration(ctx context.Conte
 }
 
 func (r *resourceManager) emptyValidatingWebhookConfiguration() *admissionregistrationv1.ValidatingWebhookConfiguration {
-	return &admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: r.values.NamePrefix + v1beta1constants.DeploymentNameGardenerResourceManager, Namespace: r.namespace}}
+	suffix := ""
+	if r.values.TargetDiffersFromSourceCluster {
+		suffix = "-shoot"
+	}
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: r.values.NamePrefix + v1beta1constants.DeploymentNameGardenerResourceManager + suffix, Namespace: r.namespace}}
 }
 
 func (r *resourceManager) ensureShootResources(ctx context.Context) error {
@@ -1226,10 +1282,23 @@ func (r *resourceManager) ensureShootResources(ctx context.Context) error {
 	mutatingWebhookConfiguration.Labels = r.appLabel()
 	mutatingWebhookConfiguration.Webhooks = r.getMutatingWebhookConfigurationWebhooks(secretServerCA, r.buildWebhookClientConfig)
 
-	data, err := registry.AddAllAndSerialize(
-		mutatingWebhookConfiguration,
-		clusterRoleBinding,
-	)
+	if err := registry.Add(mutatingWebhookConfiguration, clusterRoleBinding); err != nil {
+		return err
+	}
+
+	if !r.values.IsWorkerless {
+		validatingWebhookConfiguration := r.emptyValidatingWebhookConfiguration()
+		validatingWebhookConfiguration.Labels = r.appLabel()
+		validatingWebhookConfiguration.Webhooks = []admissionregistrationv1.ValidatingWebhook{
+			GetPodEvictionValidatingWebhook(secretServerCA, r.buildWebhookClientConfig),
+		}
+
+		if err := registry.Add(validatingWebhookConfiguration); err != nil {
+			return err
+		}
+	}
+
+	data, err := registry.SerializedObjects()
 	if err != nil {
 		return err
 	}
@@ -1596,6 +1665,36 @@ func (r *resourceManager) getProjectedTokenMountMutatingWebhook(namespaceSelecto
 	}
 }
 
+// GetPodEvictionValidatingWebhook returns the pod-eviction validating webhook for the resourcemanager component for
+// reuse between the component and integration tests.
+func GetPodEvictionValidatingWebhook(secretServerCA *corev1.Secret, buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig) admissionregistrationv1.ValidatingWebhook {
+	var (
+		failurePolicy = admissionregistrationv1.Ignore
+		matchPolicy   = admissionregistrationv1.Exact
+		sideEffect    = admissionregistrationv1.SideEffectClassNone
+	)
+
+	return admissionregistrationv1.ValidatingWebhook{
+		Name: "pod-eviction.resources.gardener.cloud",
+		Rules: []admissionregistrationv1.RuleWithOperations{{
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{corev1.GroupName},
+				APIVersions: []string{corev1.SchemeGroupVersion.Version},
+				Resources:   []string{"pods/eviction"},
+			},
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+		}},
+		NamespaceSelector:       &metav1.LabelSelector{},
+		ObjectSelector:          &metav1.LabelSelector{},
+		ClientConfig:            buildClientConfigFn(secretServerCA, podeviction.WebhookPath),
+		AdmissionReviewVersions: []string{admissionv1beta1.SchemeGroupVersion.Version, admissionv1.SchemeGroupVersion.Version},
+		FailurePolicy:           &failurePolicy,
+		MatchPolicy:             &matchPolicy,
+		SideEffects:             &sideEffect,
+		TimeoutSeconds:          ptr.To[int32](10),
+	}
+}
+
 // GetPodSchedulerNameMutatingWebhook returns the pod-scheduler-name1 mutating webhook for the resourcemanager component for reuse
 // between the component and integration tests.
 func GetPodSchedulerNameMutatingWebhook(namespaceSelector *metav1.LabelSelector, secretServerCA *corev1.Secret, buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig) admissionregistrationv1.MutatingWebhook {
@@ -2004,6 +2103,10 @@ func (r *resourceManager) getNetworkPolicyLabels() map[string]string {
 		labels[gardenerutils.NetworkPolicyLabel(r.values.NamePrefix+v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port)] = v1beta1constants.LabelNetworkPolicyAllowed
 	}
 
+	if r.values.NodeAgentAttestationVerifierURL != nil {
+		labels["networking.resources.gardener.cloud/to-"+v1beta1constants.LabelNetworkPolicyAttestationVerifierTargets] = v1beta1constants.LabelNetworkPolicyAllowed
+	}
+
 	return labels
 }
 
@@ -2071,8 +2174,10 @@ func disableControllersAndWebhooksForWorkerlessShoot(config *resourcemanagerv1al
 	// disable unneeded controllers
 	config.Controllers.KubeletCSRApprover.Enabled = false
 	config.Controllers.NodeCriticalComponents.Enabled = false
+	config.Controllers.NodeDrain.Enabled = false
 
 	// disable unneeded webhooks
+	config.Webhooks.PodEviction.Enabled = false
 	config.Webhooks.PodSchedulerName.Enabled = false
 	config.Webhooks.SystemComponentsConfig.Enabled = false
 	config.Webhooks.ProjectedTokenMount.Enabled = false
@@ -137,6 +137,7 @@ var _ = Describe("ResourceManager", func() {
 		validatingWebhookConfiguration      *admissionregistrationv1.ValidatingWebhookConfiguration
 		managedResourceSecret               *corev1.Secret
 		managedResource                     *resourcesv1alpha1.ManagedResource
+		managedResourceFor                  func(isWorkerless bool) (*corev1.Secret, *resourcesv1alpha1.ManagedResource)
 	)
 
 	BeforeEach(func() {
@@ -457,6 +458,8 @@ var _ = Describe("ResourceManager", func() {
 				}
 
 				config.Controllers.NodeCriticalComponents.Enabled = !isWorkerless
+				config.Controllers.NodeDrain.Enabled = !isWorkerless
+				config.Webhooks.PodEviction.Enabled = !isWorkerless
 				config.Webhooks.PodSchedulerName = resourcemanagerv1alpha1.PodSchedulerNameWebhookConfig{
 					Enabled:       !isWorkerless,
 					SchedulerName: ptr.To("bin-packing-scheduler"),
@@ -1835,39 +1838,81 @@ subjects:
 			},
 		}
 
-		compressedData, err := test.BrotliCompressionForManifests(mutatingWebhookConfigurationYAML, clusterRoleBindingTargetYAML)
-		Expect(err).NotTo(HaveOccurred())
+		validatingWebhookConfigurationYAML := `apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata:
+  creationTimestamp: null
+  labels:
+    app: gardener-resource-manager
+  name: gardener-resource-manager-shoot
+  namespace: fake-ns
+webhooks:
+- admissionReviewVersions:
+  - v1beta1
+  - v1
+  clientConfig:
+    url: https://gardener-resource-manager.` + deployNamespace + `:443/webhooks/validate-pod-eviction
+  failurePolicy: Ignore
+  matchPolicy: Exact
+  name: pod-eviction.resources.gardener.cloud
+  namespaceSelector: {}
+  objectSelector: {}
+  rules:
+  - apiGroups:
+    - ""
+    apiVersions:
+    - v1
+    operations:
+    - CREATE
+    resources:
+    - pods/eviction
+  sideEffects: None
+  timeoutSeconds: 10
+`
 
-		managedResourceSecret = &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "managedresource-shoot-core-gardener-resource-manager",
-				Namespace: deployNamespace,
-			},
-			Type: corev1.SecretTypeOpaque,
-			Data: map[string][]byte{
-				"data.yaml.br": compressedData,
-			},
-		}
-		utilruntime.Must(kubernetesutils.MakeUnique(managedResourceSecret))
+		managedResourceFor = func(isWorkerless bool) (*corev1.Secret, *resourcesv1alpha1.ManagedResource) {
+			manifests := []string{mutatingWebhookConfigurationYAML, validatingWebhookConfigurationYAML, clusterRoleBindingTargetYAML}
+			if isWorkerless {
+				manifests = []string{mutatingWebhookConfigurationYAML, clusterRoleBindingTargetYAML}
+			}
 
-		managedResource = &resourcesv1alpha1.ManagedResource{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "shoot-core-gardener-resource-manager",
-				Namespace: deployNamespace,
-				Labels: map[string]string{
-					"origin": "gardener",
-					"foo":    "bar",
+			compressedData, err := test.BrotliCompressionForManifests(manifests...)
+			Expect(err).NotTo(HaveOccurred())
+
+			managedResourceSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "managedresource-shoot-core-gardener-resource-manager",
+					Namespace: deployNamespace,
 				},
-			},
-			Spec: resourcesv1alpha1.ManagedResourceSpec{
-				SecretRefs: []corev1.LocalObjectReference{
-					{Name: managedResourceSecret.Name},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					"data.yaml.br": compressedData,
 				},
-				InjectLabels: map[string]string{"shoot.gardener.cloud/no-cleanup": "true"},
-				KeepObjects:  ptr.To(false),
-			},
+			}
+			utilruntime.Must(kubernetesutils.MakeUnique(managedResourceSecret))
+
+			managedResource := &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot-core-gardener-resource-manager",
+					Namespace: deployNamespace,
+					Labels: map[string]string{
+						"origin": "gardener",
+						"foo":    "bar",
+					},
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					SecretRefs: []corev1.LocalObjectReference{
+						{Name: managedResourceSecret.Name},
+					},
+					InjectLabels: map[string]string{"shoot.gardener.cloud/no-cleanup": "true"},
+					KeepObjects:  ptr.To(false),
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(managedResource))
+
+			return managedResourceSecret, managedResource
 		}
-		utilruntime.Must(references.InjectAnnotations(managedResource))
+		managedResourceSecret, managedResource = managedResourceFor(false)
 	})
 
 	AfterEach(func() {
@@ -2185,6 +2230,7 @@ subjects:
 				cfg.WatchedNamespace = nil
 				cfg.IsWorkerless = true
 				configMap = configMapFor(nil, ptr.To(gardenerutils.PathGenericKubeconfig), true)
+				managedResourceSecret, managedResource = managedResourceFor(true)
 				deployment = deploymentFor(configMap.Name, true, nil)
 
 				resourceManager = New(c, deployNamespace, sm, cfg)
@@ -5,6 +5,8 @@
 package scheduler
 
 import (
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -75,6 +77,18 @@ func (g *gardenerScheduler) clusterRole() *rbacv1.ClusterRole {
 				},
 				Verbs: []string{"get", "watch", "update"},
 			},
+			// TokenReviews and SubjectAccessReviews are required for authenticating and authorizing requests to the log
+			// level endpoint of the metrics server.
+			{
+				APIGroups: []string{authenticationv1.GroupName},
+				Resources: []string{"tokenreviews"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{authorizationv1.GroupName},
+				Resources: []string{"subjectaccessreviews"},
+				Verbs:     []string{"create"},
+			},
 		},
 	}
 }
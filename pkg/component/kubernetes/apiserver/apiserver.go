@@ -15,6 +15,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
@@ -76,6 +77,9 @@ type Values struct {
 	// APIAudiences are identifiers of the API. The service account token authenticator will validate that tokens used
 	// against the API are bound to at least one of these audiences.
 	APIAudiences []string
+	// AuditForwarder contains configuration for the audit forwarder. If set, the kube-apiserver sends its audit events to
+	// the audit forwarder which runs as a sidecar and forwards them to the configured backends.
+	AuditForwarder *AuditForwarderConfig
 	// AuthenticationConfiguration contains authentication configuration.
 	AuthenticationConfiguration *string
 	// AuthenticationWebhook contains configuration for the authentication webhook.
@@ -125,6 +129,44 @@ type Values struct {
 	VPN VPNConfig
 }
 
+// AuditForwarderConfig contains configuration for the audit forwarder.
+type AuditForwarderConfig struct {
+	// Log contains configuration for the backend writing the audit events into the logging stack.
+	Log *AuditForwarderLogBackend
+	// Webhook contains configuration for the backend sending the audit events to an external HTTPS endpoint.
+	Webhook *AuditForwarderWebhookBackend
+}
+
+// AuditForwarderLogBackend contains configuration for the log backend of the audit forwarder.
+type AuditForwarderLogBackend struct {
+	// Policy is the audit policy document of the backend in YAML format.
+	Policy *string
+}
+
+// AuditForwarderWebhookBackend contains configuration for the webhook backend of the audit forwarder.
+type AuditForwarderWebhookBackend struct {
+	// URL is the HTTPS endpoint to which the audit events are sent.
+	URL string
+	// Policy is the audit policy document of the backend in YAML format.
+	Policy *string
+	// Token is the bearer token used to authenticate against the endpoint.
+	Token []byte
+	// CABundle is the CA bundle used to verify the certificate of the endpoint.
+	CABundle []byte
+	// SigningKey is the key used to sign the batches.
+	SigningKey []byte
+	// BatchMaxSize is the maximum number of events in a batch.
+	BatchMaxSize *int32
+	// BatchMaxWait is the amount of time to wait before force sending a batch that has not reached the maximum size.
+	BatchMaxWait *metav1.Duration
+	// TruncateMaxBatchSize is the maximum size of a batch.
+	TruncateMaxBatchSize *resource.Quantity
+	// TruncateMaxEventSize is the maximum size of an audit event.
+	TruncateMaxEventSize *resource.Quantity
+	// InitialBackoff is the amount of time to wait before retrying the first failed request.
+	InitialBackoff *metav1.Duration
+}
+
 // AuthenticationWebhook contains configuration for the authentication webhook.
 type AuthenticationWebhook struct {
 	// Kubeconfig contains the webhook configuration for token authentication in kubeconfig format. The API server will
@@ -152,6 +194,8 @@ type AuthorizationWebhook struct {
 
 // Images is a set of container images used for the containers of the kube-apiserver pods.
 type Images struct {
+	// AuditForwarder is the container image for the gardener-audit-forwarder.
+	AuditForwarder string
 	// KubeAPIServer is the container image for the kube-apiserver.
 	KubeAPIServer string
 	// VPNClient is the container image for the vpn-seed-client.
@@ -259,6 +303,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		secretETCDEncryptionConfiguration     = k.emptySecret(v1beta1constants.SecretNamePrefixETCDEncryptionConfiguration)
 		secretOIDCCABundle                    = k.emptySecret(secretOIDCCABundleNamePrefix)
 		secretAuditWebhookKubeconfig          = k.emptySecret(secretAuditWebhookKubeconfigNamePrefix)
+		secretAuditForwarderConfig            = k.emptySecret(secretAuditForwarderConfigNamePrefix)
 		secretAuthenticationWebhookKubeconfig = k.emptySecret(secretAuthenticationWebhookKubeconfigNamePrefix)
 		secretAuthorizationWebhookKubeconfig  = k.emptySecret(secretAuthorizationWebhookKubeconfigNamePrefix)
 		configMapAdmissionConfigs             = k.emptyConfigMap(configMapAdmissionNamePrefix)
@@ -337,10 +382,17 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if err := apiserver.ReconcileConfigMapAuditPolicy(ctx, k.client.Client(), configMapAuditPolicy, k.values.Audit); err != nil {
+	auditConfig, err := k.computeAuditConfig()
+	if err != nil {
+		return err
+	}
+	if err := apiserver.ReconcileConfigMapAuditPolicy(ctx, k.client.Client(), configMapAuditPolicy, auditConfig); err != nil {
+		return err
+	}
+	if err := apiserver.ReconcileSecretAuditWebhookKubeconfig(ctx, k.client.Client(), secretAuditWebhookKubeconfig, auditConfig); err != nil {
 		return err
 	}
-	if err := apiserver.ReconcileSecretAuditWebhookKubeconfig(ctx, k.client.Client(), secretAuditWebhookKubeconfig, k.values.Audit); err != nil {
+	if err := k.reconcileSecretAuditForwarderConfig(ctx, secretAuditForwarderConfig); err != nil {
 		return err
 	}
 
@@ -393,6 +445,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		ctx,
 		deployment,
 		serviceAccount,
+		auditConfig,
 		configMapAuditPolicy,
 		configMapAuthenticationConfig,
 		configMapAdmissionConfigs,
@@ -409,6 +462,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		secretHAVPNSeedClient,
 		secretHAVPNClientSeedTLSAuth,
 		secretAuditWebhookKubeconfig,
+		secretAuditForwarderConfig,
 		secretAuthenticationWebhookKubeconfig,
 		secretAuthorizationWebhookKubeconfig,
 		tlsSNISecrets,
@@ -1685,6 +1685,81 @@ resources:
 				}))
 			})
 
+			It("should successfully deploy the audit forwarder config secret resource", func() {
+				kapi = New(kubernetesInterface, namespace, sm, Values{
+					Values: apiserver.Values{
+						Audit:          &apiserver.AuditConfig{Policy: ptr.To("some-policy")},
+						RuntimeVersion: runtimeVersion,
+					},
+					AuditForwarder: &AuditForwarderConfig{
+						Log: &AuditForwarderLogBackend{Policy: ptr.To("some-log-policy")},
+						Webhook: &AuditForwarderWebhookBackend{
+							URL:                  "https://audit.example.com/events",
+							Policy:               ptr.To("some-webhook-policy"),
+							Token:                []byte("some-token"),
+							CABundle:             []byte("some-ca"),
+							SigningKey:           []byte("some-key"),
+							BatchMaxSize:         ptr.To[int32](100),
+							BatchMaxWait:         &metav1.Duration{Duration: 5 * time.Second},
+							TruncateMaxEventSize: ptr.To(resource.MustParse("100Ki")),
+							InitialBackoff:       &metav1.Duration{Duration: 20 * time.Second},
+						},
+					},
+					Version: version,
+				})
+
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+
+				secretList := &corev1.SecretList{}
+				Expect(c.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+
+				var actualSecret *corev1.Secret
+				for _, secret := range secretList.Items {
+					if strings.HasPrefix(secret.Name, "kube-apiserver-audit-forwarder-config-") {
+						actualSecret = secret.DeepCopy()
+					}
+				}
+
+				Expect(actualSecret).NotTo(BeNil())
+				Expect(actualSecret.Labels).To(HaveKeyWithValue("resources.gardener.cloud/garbage-collectable-reference", "true"))
+				Expect(actualSecret.Immutable).To(PointTo(BeTrue()))
+				Expect(actualSecret.Data).To(Equal(map[string][]byte{
+					"config.yaml": []byte(`apiVersion: auditforwarder.config.gardener.cloud/v1alpha1
+backends:
+  log:
+    policyFile: /etc/gardener-audit-forwarder/log-policy.yaml
+  webhook:
+    batch:
+      maxSize: 100
+      maxWait: 5s
+    caFile: /etc/gardener-audit-forwarder/ca.crt
+    policyFile: /etc/gardener-audit-forwarder/webhook-policy.yaml
+    retry:
+      initialBackoff: 20s
+    signingKeyFile: /etc/gardener-audit-forwarder/signing.key
+    tokenFile: /etc/gardener-audit-forwarder/token
+    truncate:
+      maxEventSize: 100Ki
+    url: https://audit.example.com/events
+kind: AuditForwarderConfiguration
+queue:
+  directory: /var/lib/gardener-audit-forwarder
+server:
+  events:
+    bindAddress: 127.0.0.1
+    port: 2761
+  healthProbes:
+    bindAddress: ""
+    port: 2762
+`),
+					"log-policy.yaml":     []byte("some-log-policy"),
+					"webhook-policy.yaml": []byte("some-webhook-policy"),
+					"token":               []byte("some-token"),
+					"ca.crt":              []byte("some-ca"),
+					"signing.key":         []byte("some-key"),
+				}))
+			})
+
 			It("should successfully deploy the authentication webhook kubeconfig secret resource", func() {
 				var (
 					kubeconfig        = []byte("some-kubeconfig")
@@ -4215,6 +4290,110 @@ kind: AuthenticationConfiguration
 					))
 				})
 
+				It("should properly configure the audit forwarder", func() {
+					values.Audit = &apiserver.AuditConfig{Policy: ptr.To("some-policy")}
+					values.AuditForwarder = &AuditForwarderConfig{Log: &AuditForwarderLogBackend{}}
+					values.Images.AuditForwarder = "audit-forwarder-image:tag"
+					kapi = New(kubernetesInterface, namespace, sm, values)
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+						"--audit-webhook-config-file=/etc/kubernetes/webhook/audit/kubeconfig.yaml",
+						"--audit-webhook-batch-max-wait=1s",
+					))
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(HavePrefix("--audit-log-path")))
+
+					secretList := &corev1.SecretList{}
+					Expect(c.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+
+					var secretAuditForwarderConfig, secretAuditWebhookKubeconfig string
+					for _, secret := range secretList.Items {
+						switch {
+						case strings.HasPrefix(secret.Name, "kube-apiserver-audit-forwarder-config-"):
+							secretAuditForwarderConfig = secret.Name
+						case strings.HasPrefix(secret.Name, "kube-apiserver-audit-webhook-kubeconfig-"):
+							secretAuditWebhookKubeconfig = secret.Name
+							Expect(string(secret.Data["kubeconfig.yaml"])).To(ContainSubstring("server: http://127.0.0.1:2761/events"))
+						}
+					}
+					Expect(secretAuditForwarderConfig).NotTo(BeEmpty())
+					Expect(secretAuditWebhookKubeconfig).NotTo(BeEmpty())
+
+					Expect(deployment.Spec.Template.Spec.Containers).To(ContainElement(corev1.Container{
+						Name:            "audit-forwarder",
+						Image:           "audit-forwarder-image:tag",
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Args:            []string{"--config=/etc/gardener-audit-forwarder/config.yaml"},
+						LivenessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{
+									Path:   "/healthz",
+									Scheme: corev1.URISchemeHTTP,
+									Port:   intstr.FromInt32(2762),
+								},
+							},
+							InitialDelaySeconds: 15,
+							FailureThreshold:    3,
+							PeriodSeconds:       10,
+							SuccessThreshold:    1,
+							TimeoutSeconds:      5,
+						},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{
+									Path:   "/readyz",
+									Scheme: corev1.URISchemeHTTP,
+									Port:   intstr.FromInt32(2762),
+								},
+							},
+							InitialDelaySeconds: 5,
+							FailureThreshold:    3,
+							PeriodSeconds:       10,
+							SuccessThreshold:    1,
+							TimeoutSeconds:      5,
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("10m"),
+								corev1.ResourceMemory: resource.MustParse("32Mi"),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+						},
+						TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+						TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      "audit-forwarder-config",
+								MountPath: "/etc/gardener-audit-forwarder",
+								ReadOnly:  true,
+							},
+							{
+								Name:      "audit-forwarder-queue",
+								MountPath: "/var/lib/gardener-audit-forwarder",
+							},
+						},
+					}))
+					Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElements(
+						corev1.Volume{
+							Name: "audit-forwarder-config",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName:  secretAuditForwarderConfig,
+									DefaultMode: ptr.To[int32](0640),
+								},
+							},
+						},
+						corev1.Volume{
+							Name: "audit-forwarder-queue",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					))
+				})
+
 				It("should properly configure the authentication settings with webhook", func() {
 					values.AuthenticationWebhook = &AuthenticationWebhook{
 						Kubeconfig: []byte("foo"),
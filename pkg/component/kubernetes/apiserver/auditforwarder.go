@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	auditforwarderv1alpha1 "github.com/gardener/gardener/pkg/auditforwarder/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	containerNameAuditForwarder = "audit-forwarder"
+
+	secretAuditForwarderConfigNamePrefix = "kube-apiserver-audit-forwarder-config" // #nosec G101 -- No credential.
+
+	secretAuditForwarderDataKeyConfig        = "config.yaml"
+	secretAuditForwarderDataKeyLogPolicy     = "log-policy.yaml"
+	secretAuditForwarderDataKeyWebhookPolicy = "webhook-policy.yaml"
+	secretAuditForwarderDataKeyToken         = "token"
+	secretAuditForwarderDataKeyCABundle      = "ca.crt"
+	secretAuditForwarderDataKeySigningKey    = "signing.key"
+
+	volumeNameAuditForwarderConfig = "audit-forwarder-config"
+	volumeNameAuditForwarderQueue  = "audit-forwarder-queue"
+
+	volumeMountPathAuditForwarderConfig = "/etc/gardener-audit-forwarder"
+	volumeMountPathAuditForwarderQueue  = "/var/lib/gardener-audit-forwarder"
+
+	auditForwarderPortEvents       int32 = 2761
+	auditForwarderPortHealthProbes int32 = 2762
+)
+
+var auditForwarderCodec runtime.Codec
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(auditforwarderv1alpha1.AddToScheme(scheme))
+
+	var (
+		ser = json.NewSerializerWithOptions(json.DefaultMetaFactory, scheme, scheme, json.SerializerOptions{
+			Yaml:   true,
+			Pretty: false,
+			Strict: false,
+		})
+		versions = schema.GroupVersions([]schema.GroupVersion{
+			auditforwarderv1alpha1.SchemeGroupVersion,
+		})
+	)
+
+	auditForwarderCodec = serializer.NewCodecFactory(scheme).CodecForVersions(ser, ser, versions, versions)
+}
+
+// computeAuditConfig returns the audit configuration of the kube-apiserver. If the audit forwarder is enabled, the
+// kube-apiserver sends its audit events via the audit webhook to the audit forwarder in the same pod.
+func (k *kubeAPIServer) computeAuditConfig() (*apiserver.AuditConfig, error) {
+	if k.values.AuditForwarder == nil || k.values.Audit == nil {
+		return k.values.Audit, nil
+	}
+
+	config := kubernetesutils.NewKubeconfig(containerNameAuditForwarder, clientcmdv1.Cluster{}, clientcmdv1.AuthInfo{})
+	// The audit forwarder only listens on the loopback interface of the pod, hence the events are sent via plain HTTP.
+	config.Clusters[0].Cluster.Server = "http://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(int(auditForwarderPortEvents))) + "/events"
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating audit webhook kubeconfig for audit forwarder: %w", err)
+	}
+
+	return &apiserver.AuditConfig{
+		Policy: k.values.Audit.Policy,
+		Webhook: &apiserver.AuditWebhook{
+			Kubeconfig: kubeconfig,
+		},
+	}, nil
+}
+
+func (k *kubeAPIServer) reconcileSecretAuditForwarderConfig(ctx context.Context, secret *corev1.Secret) error {
+	if k.values.AuditForwarder == nil {
+		// We don't delete the secret here as we don't know its name (as it's unique). Instead, we rely on the usual
+		// garbage collection for unique secrets/configmaps.
+		return nil
+	}
+
+	secret.Data = map[string][]byte{}
+
+	config := &auditforwarderv1alpha1.AuditForwarderConfiguration{
+		Server: auditforwarderv1alpha1.ServerConfiguration{
+			Events: auditforwarderv1alpha1.Server{
+				BindAddress: "127.0.0.1",
+				Port:        int(auditForwarderPortEvents),
+			},
+			HealthProbes: &auditforwarderv1alpha1.Server{
+				Port: int(auditForwarderPortHealthProbes),
+			},
+		},
+		Queue: auditforwarderv1alpha1.QueueConfiguration{
+			Directory: volumeMountPathAuditForwarderQueue,
+		},
+	}
+
+	if log := k.values.AuditForwarder.Log; log != nil {
+		config.Backends.Log = &auditforwarderv1alpha1.LogBackendConfiguration{
+			PolicyFile: k.addAuditForwarderFile(secret, secretAuditForwarderDataKeyLogPolicy, []byte(ptr.Deref(log.Policy, ""))),
+		}
+	}
+
+	if webhook := k.values.AuditForwarder.Webhook; webhook != nil {
+		config.Backends.Webhook = &auditforwarderv1alpha1.WebhookBackendConfiguration{
+			URL:            webhook.URL,
+			CAFile:         k.addAuditForwarderFile(secret, secretAuditForwarderDataKeyCABundle, webhook.CABundle),
+			TokenFile:      k.addAuditForwarderFile(secret, secretAuditForwarderDataKeyToken, webhook.Token),
+			SigningKeyFile: k.addAuditForwarderFile(secret, secretAuditForwarderDataKeySigningKey, webhook.SigningKey),
+			PolicyFile:     k.addAuditForwarderFile(secret, secretAuditForwarderDataKeyWebhookPolicy, []byte(ptr.Deref(webhook.Policy, ""))),
+			Batch: auditforwarderv1alpha1.BatchConfiguration{
+				MaxSize: webhook.BatchMaxSize,
+				MaxWait: webhook.BatchMaxWait,
+			},
+			Retry: auditforwarderv1alpha1.RetryConfiguration{
+				InitialBackoff: webhook.InitialBackoff,
+			},
+		}
+
+		if webhook.TruncateMaxBatchSize != nil || webhook.TruncateMaxEventSize != nil {
+			config.Backends.Webhook.Truncate = &auditforwarderv1alpha1.TruncateConfiguration{
+				MaxBatchSize: webhook.TruncateMaxBatchSize,
+				MaxEventSize: webhook.TruncateMaxEventSize,
+			}
+		}
+	}
+
+	data, err := runtime.Encode(auditForwarderCodec, config)
+	if err != nil {
+		return err
+	}
+
+	secret.Data[secretAuditForwarderDataKeyConfig] = data
+	utilruntime.Must(kubernetesutils.MakeUnique(secret))
+
+	return client.IgnoreAlreadyExists(k.client.Client().Create(ctx, secret))
+}
+
+// addAuditForwarderFile adds the given data to the secret and returns the path of the file in the audit forwarder
+// container. It returns nil if there is no data.
+func (k *kubeAPIServer) addAuditForwarderFile(secret *corev1.Secret, key string, data []byte) *string {
+	if len(data) == 0 {
+		return nil
+	}
+
+	secret.Data[key] = data
+	return ptr.To(volumeMountPathAuditForwarderConfig + "/" + key)
+}
+
+func (k *kubeAPIServer) handleAuditForwarderSettings(deployment *appsv1.Deployment, secretAuditForwarderConfig *corev1.Secret) {
+	if k.values.AuditForwarder == nil {
+		return
+	}
+
+	// The audit forwarder persists the audit events before acknowledging them, hence the kube-apiserver should not keep
+	// them in its buffer for long.
+	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-batch-max-wait=1s")
+
+	deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{
+		Name:            containerNameAuditForwarder,
+		Image:           k.values.Images.AuditForwarder,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Args:            []string{fmt.Sprintf("--config=%s/%s", volumeMountPathAuditForwarderConfig, secretAuditForwarderDataKeyConfig)},
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/healthz",
+					Scheme: corev1.URISchemeHTTP,
+					Port:   intstr.FromInt32(auditForwarderPortHealthProbes),
+				},
+			},
+			InitialDelaySeconds: 15,
+			FailureThreshold:    3,
+			PeriodSeconds:       10,
+			SuccessThreshold:    1,
+			TimeoutSeconds:      5,
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/readyz",
+					Scheme: corev1.URISchemeHTTP,
+					Port:   intstr.FromInt32(auditForwarderPortHealthProbes),
+				},
+			},
+			InitialDelaySeconds: 5,
+			FailureThreshold:    3,
+			PeriodSeconds:       10,
+			SuccessThreshold:    1,
+			TimeoutSeconds:      5,
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+		},
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      volumeNameAuditForwarderConfig,
+				MountPath: volumeMountPathAuditForwarderConfig,
+				ReadOnly:  true,
+			},
+			{
+				Name:      volumeNameAuditForwarderQueue,
+				MountPath: volumeMountPathAuditForwarderQueue,
+			},
+		},
+	})
+
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes,
+		corev1.Volume{
+			Name: volumeNameAuditForwarderConfig,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  secretAuditForwarderConfig.Name,
+					DefaultMode: ptr.To[int32](0640),
+				},
+			},
+		},
+		// The queue survives restarts of the audit forwarder container, but not the deletion of the pod.
+		corev1.Volume{
+			Name: volumeNameAuditForwarderQueue,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	)
+}
@@ -104,6 +104,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 	ctx context.Context,
 	deployment *appsv1.Deployment,
 	serviceAccount *corev1.ServiceAccount,
+	auditConfig *apiserver.AuditConfig,
 	configMapAuditPolicy *corev1.ConfigMap,
 	configMapAuthenticationConfig *corev1.ConfigMap,
 	configMapAdmissionConfigs *corev1.ConfigMap,
@@ -120,6 +121,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 	secretHAVPNSeedClient *corev1.Secret,
 	secretHAVPNSeedClientSeedTLSAuth *corev1.Secret,
 	secretAuditWebhookKubeconfig *corev1.Secret,
+	secretAuditForwarderConfig *corev1.Secret,
 	secretAuthenticationWebhookKubeconfig *corev1.Secret,
 	secretAuthorizationWebhookKubeconfig *corev1.Secret,
 	tlsSNISecrets []tlsSNISecret,
@@ -369,7 +371,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 		}
 
 		apiserver.InjectDefaultSettings(deployment, k.values.NamePrefix, k.values.Values, secretCAETCD, secretETCDClient, secretServer)
-		apiserver.InjectAuditSettings(deployment, configMapAuditPolicy, secretAuditWebhookKubeconfig, k.values.Audit)
+		apiserver.InjectAuditSettings(deployment, configMapAuditPolicy, secretAuditWebhookKubeconfig, auditConfig)
 		apiserver.InjectAdmissionSettings(deployment, configMapAdmissionConfigs, secretAdmissionKubeconfigs, k.values.Values)
 		apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
 		k.handleSNISettings(deployment)
@@ -378,6 +380,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 		k.handleServiceAccountSigningKeySettings(deployment)
 		k.handleAuthenticationWebhookSettings(deployment, secretAuthenticationWebhookKubeconfig)
 		k.handleAuthorizationSettings(deployment, secretAuthorizationWebhookKubeconfig)
+		k.handleAuditForwarderSettings(deployment, secretAuditForwarderConfig)
 		if err := k.handleVPNSettings(deployment, serviceAccount, configMapEgressSelector, secretHTTPProxy, secretHAVPNSeedClient, secretHAVPNSeedClientSeedTLSAuth); err != nil {
 			return err
 		}
@@ -17,7 +17,15 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 )
 
-// CentralLoggingConfiguration returns a fluent-bit parser and filter for the kube-apiserver logs.
+const (
+	auditEventKey   = "audit"
+	auditParserName = containerNameAuditForwarder + "-audit-parser"
+	// auditEventRegex matches the beginning of audit events which are written to the standard output by the log backend
+	// of the audit forwarder.
+	auditEventRegex = `^\{"kind":"Event","apiVersion":"audit\.k8s\.io/v1",`
+)
+
+// CentralLoggingConfiguration returns fluent-bit parsers and filters for the kube-apiserver and audit forwarder logs.
 func CentralLoggingConfiguration() (component.CentralLoggingConfig, error) {
 	return component.CentralLoggingConfig{Filters: generateClusterFilters(), Parsers: generateClusterParsers()}, nil
 }
@@ -42,6 +50,47 @@ func generateClusterFilters() []*fluentbitv1alpha2.ClusterFilter {
 				},
 			},
 		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("%s--%s", v1beta1constants.DeploymentNameKubeAPIServer, containerNameAuditForwarder),
+				Labels: map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource},
+			},
+			Spec: fluentbitv1alpha2.FilterSpec{
+				Match: fmt.Sprintf("kubernetes.*%s*%s*", v1beta1constants.DeploymentNameKubeAPIServer, containerNameAuditForwarder),
+				FilterItems: []fluentbitv1alpha2.FilterItem{
+					// Only the audit events are moved to a dedicated key, decoded and labelled with a dedicated job, i.e., the
+					// log messages of the audit forwarder itself are left untouched.
+					{
+						Modify: &fluentbitv1alpha2filter.Modify{
+							Conditions: []fluentbitv1alpha2filter.Condition{
+								{KeyValueMatches: map[string]string{"log": auditEventRegex}},
+							},
+							Rules: []fluentbitv1alpha2filter.Rule{
+								{Rename: map[string]string{"log": auditEventKey}},
+							},
+						},
+					},
+					{
+						Parser: &fluentbitv1alpha2filter.Parser{
+							KeyName:     auditEventKey,
+							Parser:      auditParserName,
+							ReserveData: ptr.To(true),
+						},
+					},
+					{
+						Modify: &fluentbitv1alpha2filter.Modify{
+							Conditions: []fluentbitv1alpha2filter.Condition{
+								{KeyValueEquals: map[string]string{"kind": "Event", "apiVersion": "audit.k8s.io/v1"}},
+								{KeyExists: "auditID"},
+							},
+							Rules: []fluentbitv1alpha2filter.Rule{
+								{Add: map[string]string{"job": "audit-logging"}},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -60,5 +109,18 @@ func generateClusterParsers() []*fluentbitv1alpha2.ClusterParser {
 				},
 			},
 		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   auditParserName,
+				Labels: map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource},
+			},
+			Spec: fluentbitv1alpha2.ParserSpec{
+				JSON: &fluentbitv1alpha2parser.JSON{
+					TimeKey:    "stageTimestamp",
+					TimeFormat: "%Y-%m-%dT%H:%M:%S.%LZ",
+					TimeKeep:   ptr.To(true),
+				},
+			},
+		},
 	}
 }
@@ -18,7 +18,7 @@ import (
 
 var _ = Describe("Logging", func() {
 	Describe("#CentralLoggingConfiguration", func() {
-		It("should return the expected logging parser and filter", func() {
+		It("should return the expected logging parsers and filters", func() {
 			loggingConfig, err := CentralLoggingConfiguration()
 
 			Expect(err).NotTo(HaveOccurred())
@@ -42,6 +42,45 @@ var _ = Describe("Logging", func() {
 							},
 						},
 					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "kube-apiserver--audit-forwarder",
+							Labels: map[string]string{"fluentbit.gardener/type": "seed"},
+						},
+						Spec: fluentbitv1alpha2.FilterSpec{
+							Match: "kubernetes.*kube-apiserver*audit-forwarder*",
+							FilterItems: []fluentbitv1alpha2.FilterItem{
+								{
+									Modify: &fluentbitv1alpha2filter.Modify{
+										Conditions: []fluentbitv1alpha2filter.Condition{
+											{KeyValueMatches: map[string]string{"log": `^\{"kind":"Event","apiVersion":"audit\.k8s\.io/v1",`}},
+										},
+										Rules: []fluentbitv1alpha2filter.Rule{
+											{Rename: map[string]string{"log": "audit"}},
+										},
+									},
+								},
+								{
+									Parser: &fluentbitv1alpha2filter.Parser{
+										KeyName:     "audit",
+										Parser:      "audit-forwarder-audit-parser",
+										ReserveData: ptr.To(true),
+									},
+								},
+								{
+									Modify: &fluentbitv1alpha2filter.Modify{
+										Conditions: []fluentbitv1alpha2filter.Condition{
+											{KeyValueEquals: map[string]string{"kind": "Event", "apiVersion": "audit.k8s.io/v1"}},
+											{KeyExists: "auditID"},
+										},
+										Rules: []fluentbitv1alpha2filter.Rule{
+											{Add: map[string]string{"job": "audit-logging"}},
+										},
+									},
+								},
+							},
+						},
+					},
 				}))
 			Expect(loggingConfig.Parsers).To(Equal(
 				[]*fluentbitv1alpha2.ClusterParser{
@@ -58,6 +97,19 @@ var _ = Describe("Logging", func() {
 							},
 						},
 					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "audit-forwarder-audit-parser",
+							Labels: map[string]string{"fluentbit.gardener/type": "seed"},
+						},
+						Spec: fluentbitv1alpha2.ParserSpec{
+							JSON: &fluentbitv1alpha2parser.JSON{
+								TimeKey:    "stageTimestamp",
+								TimeFormat: "%Y-%m-%dT%H:%M:%S.%LZ",
+								TimeKeep:   ptr.To(true),
+							},
+						},
+					},
 				}))
 			Expect(loggingConfig.Inputs).To(BeNil())
 		})
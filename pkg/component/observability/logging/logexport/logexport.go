@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logexport
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins"
+	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/filter"
+	fluentbitv1alpha2output "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/output"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "shoot-log-export"
+
+	// DefaultRecordsPerSecond is the rate limit which is applied to an output if the shoot owner did not configure one.
+	// The fluent-bit instances of the seed are shared by all shoots, hence the exported log volume is always limited.
+	DefaultRecordsPerSecond int32 = 500
+
+	tagPrefix      = "log-export"
+	throttleWindow = 5
+)
+
+// Output contains information about an endpoint to which logs are exported.
+type Output struct {
+	// Name is the name of the output.
+	Name string
+	// Type is the protocol used to send the logs to the endpoint.
+	Type gardencorev1beta1.LoggingOutputType
+	// URL is the HTTPS endpoint to which logs are sent.
+	URL string
+	// Sources is the list of log streams which are exported to the endpoint.
+	Sources []gardencorev1beta1.LoggingOutputSource
+	// RecordsPerSecond is the maximum number of log records per second which are sent to the endpoint.
+	RecordsPerSecond int32
+	// MemoryBufferLimit is the maximum amount of memory used for buffering log records of this output.
+	MemoryBufferLimit *resource.Quantity
+	// RetryLimit is the maximum number of retries for sending a chunk of log records.
+	RetryLimit *int32
+	// BasicAuthSecretData contains the `username` and `password` used to authenticate against the endpoint.
+	BasicAuthSecretData map[string][]byte
+}
+
+// Values are the values for the log export.
+type Values struct {
+	// Outputs is the list of endpoints to which logs are exported.
+	Outputs []Output
+}
+
+// Interface contains functions for managing the export of shoot logs to user-defined endpoints.
+type Interface interface {
+	component.DeployWaiter
+	// SetOutputs sets the outputs to which logs are exported.
+	SetOutputs([]Output)
+}
+
+type logExport struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+// New creates a new instance of DeployWaiter for the export of shoot logs to user-defined endpoints. The logs are
+// collected by the fluent-bit instances of the seed, duplicated under a dedicated tag per output and sent to the
+// endpoints in addition to the Gardener-managed logging stack.
+func New(client client.Client, namespace string, values Values) Interface {
+	return &logExport{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+func (l *logExport) Deploy(ctx context.Context) error {
+	var (
+		registry  = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+		resources []client.Object
+	)
+
+	for _, output := range l.values.Outputs {
+		objects, err := l.computeResourcesForOutput(output)
+		if err != nil {
+			return fmt.Errorf("failed computing resources for log export output %q: %w", output.Name, err)
+		}
+		resources = append(resources, objects...)
+	}
+
+	serializedResources, err := registry.AddAllAndSerialize(resources...)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeedWithLabels(ctx, l.client, l.namespace, ManagedResourceName, false, map[string]string{v1beta1constants.LabelCareConditionType: v1beta1constants.ObservabilityComponentsHealthy}, serializedResources)
+}
+
+func (l *logExport) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, l.client, l.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (l *logExport) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, l.client, l.namespace, ManagedResourceName)
+}
+
+func (l *logExport) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, l.client, l.namespace, ManagedResourceName)
+}
+
+func (l *logExport) SetOutputs(outputs []Output) {
+	l.values.Outputs = outputs
+}
+
+func (l *logExport) computeResourcesForOutput(output Output) ([]client.Object, error) {
+	endpoint, err := url.Parse(output.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	port := int32(443)
+	if p := endpoint.Port(); p != "" {
+		parsedPort, err := strconv.ParseInt(p, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", p, err)
+		}
+		port = int32(parsedPort)
+	}
+
+	var (
+		resourceName = l.resourceName(output.Name)
+		tag          = strings.Join([]string{tagPrefix, l.namespace, output.Name}, ".")
+		labels       = map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource}
+
+		recordsPerSecond = output.RecordsPerSecond
+		objects          []client.Object
+	)
+
+	if recordsPerSecond <= 0 {
+		recordsPerSecond = DefaultRecordsPerSecond
+	}
+
+	rewriteTag := &fluentbitv1alpha2filter.RewriteTag{
+		Rules:       []string{fmt.Sprintf("$tag %s %s true", l.sourceRegex(output.Sources), tag)},
+		EmitterName: resourceName,
+	}
+	if output.MemoryBufferLimit != nil {
+		rewriteTag.EmitterMemBufLimit = strconv.FormatInt(output.MemoryBufferLimit.Value(), 10)
+	}
+
+	objects = append(objects,
+		&fluentbitv1alpha2.ClusterFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				// This filter must be executed after all Gardener-managed filters because the operator orders them by
+				// name. This way, the exported records have been parsed and enriched in the same way as the records
+				// sent to Vali.
+				Name:   "zzz-" + resourceName,
+				Labels: labels,
+			},
+			Spec: fluentbitv1alpha2.FilterSpec{
+				Match:       fmt.Sprintf("kubernetes.*%s*", l.namespace),
+				FilterItems: []fluentbitv1alpha2.FilterItem{{RewriteTag: rewriteTag}},
+			},
+		},
+		&fluentbitv1alpha2.ClusterFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   resourceName + "-throttle",
+				Labels: labels,
+			},
+			Spec: fluentbitv1alpha2.FilterSpec{
+				Match: tag,
+				FilterItems: []fluentbitv1alpha2.FilterItem{{
+					Throttle: &fluentbitv1alpha2filter.Throttle{
+						Rate:     ptr.To(int64(recordsPerSecond)),
+						Window:   ptr.To[int64](throttleWindow),
+						Interval: "1s",
+					},
+				}},
+			},
+		},
+	)
+
+	var httpUser, httpPassword *plugins.Secret
+	if output.BasicAuthSecretData != nil {
+		objects = append(objects, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: v1beta1constants.GardenNamespace,
+				Labels:    map[string]string{v1beta1constants.LabelRole: v1beta1constants.LabelLogging},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: output.BasicAuthSecretData,
+		})
+
+		httpUser, httpPassword = secretKeyRef(resourceName, "username"), secretKeyRef(resourceName, "password")
+	}
+
+	clusterOutput := &fluentbitv1alpha2.ClusterOutput{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   resourceName,
+			Labels: labels,
+		},
+		Spec: fluentbitv1alpha2.OutputSpec{
+			Match: tag,
+		},
+	}
+
+	if output.RetryLimit != nil {
+		clusterOutput.Spec.RetryLimit = strconv.Itoa(int(*output.RetryLimit))
+	}
+
+	tls := &plugins.TLS{Verify: ptr.To(true)}
+
+	switch output.Type {
+	case gardencorev1beta1.LoggingOutputTypeOTLP:
+		clusterOutput.Spec.OpenTelemetry = &fluentbitv1alpha2output.OpenTelemetry{
+			Host:       endpoint.Hostname(),
+			Port:       &port,
+			HTTPUser:   httpUser,
+			HTTPPasswd: httpPassword,
+			LogsUri:    uriOrDefault(endpoint, "/v1/logs"),
+			TLS:        tls,
+		}
+	case gardencorev1beta1.LoggingOutputTypeHTTP:
+		clusterOutput.Spec.HTTP = &fluentbitv1alpha2output.HTTP{
+			Host:       endpoint.Hostname(),
+			Port:       &port,
+			HTTPUser:   httpUser,
+			HTTPPasswd: httpPassword,
+			Uri:        uriOrDefault(endpoint, "/"),
+			Format:     "json_lines",
+			TLS:        tls,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported output type %q", output.Type)
+	}
+
+	return append(objects, clusterOutput, l.networkPolicy(resourceName, port)), nil
+}
+
+// networkPolicy returns the network policy allowing the fluent-bit pods of the seed to send logs to the endpoint of the
+// given output. The fluent-bit pods are not labeled with `networking.gardener.cloud/to-public-networks` because they
+// would then be allowed to reach any public endpoint on any port, even if no shoot on the seed exports its logs.
+// Instead, egress is only opened for the lifetime of an output and only for its port. Private networks are excluded,
+// as validation forbids internal endpoints anyway.
+func (l *logExport) networkPolicy(name string, port int32) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: v1beta1constants.GardenNamespace,
+			Annotations: map[string]string{
+				v1beta1constants.GardenerDescription: fmt.Sprintf("Allows egress from fluent-bit pods to public "+
+					"networks on port %d for exporting the logs of shoot namespace %s.", port, l.namespace),
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{
+				v1beta1constants.LabelApp:  v1beta1constants.DaemonSetNameFluentBit,
+				v1beta1constants.LabelRole: v1beta1constants.LabelLogging,
+			}},
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10"}}},
+					{IPBlock: &networkingv1.IPBlock{CIDR: "::/0", Except: []string{"fe80::/10", "fc00::/7"}}},
+				},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(port))}},
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+		},
+	}
+}
+
+// resourceName returns the name of the cluster-scoped fluent-bit resources of the given output. The shoot namespace is
+// part of the name since the resources of all shoots on the seed are read by the same fluent-bit instances.
+func (l *logExport) resourceName(outputName string) string {
+	return fmt.Sprintf("%s-%s-%s", tagPrefix, l.namespace, outputName)
+}
+
+// sourceRegex returns the regular expression matching the tags of the records which shall be exported for the given
+// sources. The tags have the format `kubernetes.var.log.containers.<pod>_<namespace>_<container>-<id>.log`.
+func (l *logExport) sourceRegex(sources []gardencorev1beta1.LoggingOutputSource) string {
+	var (
+		exportControlPlane bool
+		exportEvents       bool
+		eventLoggerPrefix  = regexp.QuoteMeta(v1beta1constants.DeploymentNameEventLogger + "-")
+		namespace          = regexp.QuoteMeta(l.namespace)
+	)
+
+	for _, source := range sources {
+		switch source {
+		case gardencorev1beta1.LoggingOutputSourceControlPlane:
+			exportControlPlane = true
+		case gardencorev1beta1.LoggingOutputSourceEvents:
+			exportEvents = true
+		}
+	}
+
+	podNameRegex := `[^_]+`
+	switch {
+	case exportControlPlane && !exportEvents:
+		podNameRegex = `(?!` + eventLoggerPrefix + `)[^_]+`
+	case !exportControlPlane && exportEvents:
+		podNameRegex = eventLoggerPrefix + `[^_]+`
+	}
+
+	return fmt.Sprintf(`^kubernetes\.var\.log\.containers\.%s_%s_`, podNameRegex, namespace)
+}
+
+func secretKeyRef(secretName, key string) *plugins.Secret {
+	return &plugins.Secret{
+		ValueFrom: plugins.ValueSource{
+			SecretKeyRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+func uriOrDefault(endpoint *url.URL, defaultURI string) string {
+	if endpoint.Path == "" || endpoint.Path == "/" {
+		return defaultURI
+	}
+	return endpoint.RequestURI()
+}
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logexport_test
+
+import (
+	"context"
+
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins"
+	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/filter"
+	fluentbitv1alpha2output "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/output"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/observability/logging/logexport"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	testruntime "github.com/gardener/gardener/pkg/utils/test/runtime"
+)
+
+var _ = Describe("LogExport", func() {
+	var (
+		ctx = context.Background()
+
+		namespace = "shoot--foo--bar"
+		labels    = map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource}
+
+		c         client.Client
+		logExport Interface
+
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		logExport = New(c, namespace, Values{})
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot-log-export",
+				Namespace: namespace,
+			},
+		}
+		managedResourceSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedresource-" + managedResource.Name,
+				Namespace: namespace,
+			},
+		}
+	})
+
+	Describe("#Deploy", func() {
+		It("should successfully deploy all resources", func() {
+			logExport.SetOutputs([]Output{
+				{
+					Name:                "central",
+					Type:                gardencorev1beta1.LoggingOutputTypeOTLP,
+					URL:                 "https://otel.example.com",
+					Sources:             []gardencorev1beta1.LoggingOutputSource{gardencorev1beta1.LoggingOutputSourceControlPlane},
+					BasicAuthSecretData: map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+				},
+				{
+					Name:              "audit",
+					Type:              gardencorev1beta1.LoggingOutputTypeHTTP,
+					URL:               "https://logs.example.com:8443/ingest?stream=events",
+					Sources:           []gardencorev1beta1.LoggingOutputSource{gardencorev1beta1.LoggingOutputSourceEvents},
+					RecordsPerSecond:  50,
+					MemoryBufferLimit: ptr.To(resource.MustParse("20Mi")),
+					RetryLimit:        ptr.To[int32](3),
+				},
+			})
+
+			Expect(logExport.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			expectedMr := &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      managedResource.Name,
+					Namespace: namespace,
+					Labels: map[string]string{
+						"care.gardener.cloud/condition-type": "ObservabilityComponentsHealthy",
+					},
+					ResourceVersion: "1",
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					Class:       ptr.To("seed"),
+					SecretRefs:  []corev1.LocalObjectReference{{Name: managedResource.Spec.SecretRefs[0].Name}},
+					KeepObjects: ptr.To(false),
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(expectedMr))
+			Expect(managedResource).To(DeepEqual(expectedMr))
+
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			manifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+			Expect(err).NotTo(HaveOccurred())
+
+			secretRef := func(key string) *plugins.Secret {
+				return &plugins.Secret{ValueFrom: plugins.ValueSource{SecretKeyRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "log-export-shoot--foo--bar-central"},
+					Key:                  key,
+				}}}
+			}
+
+			Expect(manifests).To(ConsistOf(
+				testruntime.Serialize(&fluentbitv1alpha2.ClusterFilter{
+					ObjectMeta: metav1.ObjectMeta{Name: "zzz-log-export-shoot--foo--bar-central", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "kubernetes.*shoot--foo--bar*",
+						FilterItems: []fluentbitv1alpha2.FilterItem{{RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+							Rules:       []string{`$tag ^kubernetes\.var\.log\.containers\.(?!event-logger-)[^_]+_shoot--foo--bar_ log-export.shoot--foo--bar.central true`},
+							EmitterName: "log-export-shoot--foo--bar-central",
+						}}},
+					},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&fluentbitv1alpha2.ClusterFilter{
+					ObjectMeta: metav1.ObjectMeta{Name: "log-export-shoot--foo--bar-central-throttle", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "log-export.shoot--foo--bar.central",
+						FilterItems: []fluentbitv1alpha2.FilterItem{{Throttle: &fluentbitv1alpha2filter.Throttle{
+							Rate:     ptr.To[int64](500),
+							Window:   ptr.To[int64](5),
+							Interval: "1s",
+						}}},
+					},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "log-export-shoot--foo--bar-central",
+						Namespace: "garden",
+						Labels:    map[string]string{"role": "logging"},
+					},
+					Type: corev1.SecretTypeOpaque,
+					Data: map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&fluentbitv1alpha2.ClusterOutput{
+					ObjectMeta: metav1.ObjectMeta{Name: "log-export-shoot--foo--bar-central", Labels: labels},
+					Spec: fluentbitv1alpha2.OutputSpec{
+						Match: "log-export.shoot--foo--bar.central",
+						OpenTelemetry: &fluentbitv1alpha2output.OpenTelemetry{
+							Host:       "otel.example.com",
+							Port:       ptr.To[int32](443),
+							HTTPUser:   secretRef("username"),
+							HTTPPasswd: secretRef("password"),
+							LogsUri:    "/v1/logs",
+							TLS:        &plugins.TLS{Verify: ptr.To(true)},
+						},
+					},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&networkingv1.NetworkPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "log-export-shoot--foo--bar-central",
+						Namespace:   "garden",
+						Annotations: map[string]string{"gardener.cloud/description": "Allows egress from fluent-bit pods to public networks on port 443 for exporting the logs of shoot namespace shoot--foo--bar."},
+					},
+					Spec: networkingv1.NetworkPolicySpec{
+						PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "fluent-bit", "role": "logging"}},
+						Egress: []networkingv1.NetworkPolicyEgressRule{{
+							To: []networkingv1.NetworkPolicyPeer{
+								{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10"}}},
+								{IPBlock: &networkingv1.IPBlock{CIDR: "::/0", Except: []string{"fe80::/10", "fc00::/7"}}},
+							},
+							Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(443))}},
+						}},
+						PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+					},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&fluentbitv1alpha2.ClusterFilter{
+					ObjectMeta: metav1.ObjectMeta{Name: "zzz-log-export-shoot--foo--bar-audit", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "kubernetes.*shoot--foo--bar*",
+						FilterItems: []fluentbitv1alpha2.FilterItem{{RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+							Rules:              []string{`$tag ^kubernetes\.var\.log\.containers\.event-logger-[^_]+_shoot--foo--bar_ log-export.shoot--foo--bar.audit true`},
+							EmitterName:        "log-export-shoot--foo--bar-audit",
+							EmitterMemBufLimit: "20971520",
+						}}},
+					},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&fluentbitv1alpha2.ClusterFilter{
+					ObjectMeta: metav1.ObjectMeta{Name: "log-export-shoot--foo--bar-audit-throttle", Labels: labels},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "log-export.shoot--foo--bar.audit",
+						FilterItems: []fluentbitv1alpha2.FilterItem{{Throttle: &fluentbitv1alpha2filter.Throttle{
+							Rate:     ptr.To[int64](50),
+							Window:   ptr.To[int64](5),
+							Interval: "1s",
+						}}},
+					},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&fluentbitv1alpha2.ClusterOutput{
+					ObjectMeta: metav1.ObjectMeta{Name: "log-export-shoot--foo--bar-audit", Labels: labels},
+					Spec: fluentbitv1alpha2.OutputSpec{
+						Match:      "log-export.shoot--foo--bar.audit",
+						RetryLimit: "3",
+						HTTP: &fluentbitv1alpha2output.HTTP{
+							Host:   "logs.example.com",
+							Port:   ptr.To[int32](8443),
+							Uri:    "/ingest?stream=events",
+							Format: "json_lines",
+							TLS:    &plugins.TLS{Verify: ptr.To(true)},
+						},
+					},
+				}, kubernetes.SeedScheme),
+				testruntime.Serialize(&networkingv1.NetworkPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "log-export-shoot--foo--bar-audit",
+						Namespace:   "garden",
+						Annotations: map[string]string{"gardener.cloud/description": "Allows egress from fluent-bit pods to public networks on port 8443 for exporting the logs of shoot namespace shoot--foo--bar."},
+					},
+					Spec: networkingv1.NetworkPolicySpec{
+						PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "fluent-bit", "role": "logging"}},
+						Egress: []networkingv1.NetworkPolicyEgressRule{{
+							To: []networkingv1.NetworkPolicyPeer{
+								{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10"}}},
+								{IPBlock: &networkingv1.IPBlock{CIDR: "::/0", Except: []string{"fe80::/10", "fc00::/7"}}},
+							},
+							Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(8443))}},
+						}},
+						PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+					},
+				}, kubernetes.SeedScheme),
+			))
+		})
+
+		It("should fail for an unsupported output type", func() {
+			logExport.SetOutputs([]Output{{Name: "central", Type: "Loki", URL: "https://logs.example.com"}})
+
+			Expect(logExport.Deploy(ctx)).To(MatchError(ContainSubstring(`unsupported output type "Loki"`)))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
+
+			Expect(logExport.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
+		})
+	})
+})
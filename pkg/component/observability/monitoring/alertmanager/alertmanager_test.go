@@ -530,6 +530,55 @@ var _ = Describe("Alertmanager", func() {
 				})
 			})
 
+			When("webhook receivers are configured", func() {
+				BeforeEach(func() {
+					values.WebhookReceivers = []gardencorev1beta1.AlertingWebhookReceiver{
+						{Name: "foo", URL: "https://foo.example.com/alerts"},
+						{Name: "bar", URL: "http://bar.example.com", SendResolved: ptr.To(false)},
+					}
+
+					config.Spec.Route.Routes = []apiextensionsv1.JSON{
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|operator"}],"receiver":"email-kubernetes-ops"}`)},
+						{Raw: []byte(`{"matchers":[{"matchType":"=~","name":"visibility","value":"all|operator"}],"receiver":"webhook"}`)},
+					}
+					config.Spec.Receivers = append(config.Spec.Receivers, monitoringv1alpha1.Receiver{
+						Name: "webhook",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{URL: ptr.To("https://foo.example.com/alerts"), SendResolved: ptr.To(true)},
+							{URL: ptr.To("http://bar.example.com"), SendResolved: ptr.To(false)},
+						},
+					})
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(managedResource).To(consistOf(
+						service,
+						alertManager,
+						vpa,
+						config,
+						smtpSecret,
+					))
+				})
+
+				When("no alerting smtp secret is configured", func() {
+					BeforeEach(func() {
+						values.AlertingSMTPSecret = nil
+
+						config.Spec.Route.Routes = config.Spec.Route.Routes[1:]
+						config.Spec.Receivers = []monitoringv1alpha1.Receiver{config.Spec.Receivers[0], config.Spec.Receivers[2]}
+					})
+
+					It("should successfully deploy all resources", func() {
+						Expect(managedResource).To(consistOf(
+							service,
+							alertManager,
+							vpa,
+							config,
+						))
+					})
+				})
+			})
+
 			When("there are more than 1 replicas", func() {
 				BeforeEach(func() {
 					values.Replicas = 2
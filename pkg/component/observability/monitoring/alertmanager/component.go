@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
@@ -59,6 +60,8 @@ type Values struct {
 	// EmailReceivers is a list of email addresses to which alerts should be sent. If this list is empty, the alerts
 	// will be sent to the email address in `.data.to` in the alerting SMTP secret.
 	EmailReceivers []string
+	// WebhookReceivers is a list of generic HTTP endpoints to which alerts should be sent.
+	WebhookReceivers []gardencorev1beta1.AlertingWebhookReceiver
 	// Ingress contains configuration for exposing this AlertManager instance via an Ingress resource.
 	Ingress *IngressValues
 }
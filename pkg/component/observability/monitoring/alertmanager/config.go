@@ -9,6 +9,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/component"
 )
@@ -16,19 +17,53 @@ import (
 const dataKeyAuthPassword = "auth_password"
 
 func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
-	if !a.hasSMTPSecret() {
+	if !a.hasConfig() {
 		return nil
 	}
 
 	var (
-		emailReceiverName = "email-kubernetes-ops"
-		visibility        = "operator"
+		emailReceiverName   = "email-kubernetes-ops"
+		webhookReceiverName = "webhook"
+		visibility          = "operator"
+
+		routes    []apiextensionsv1.JSON
+		receivers = []monitoringv1alpha1.Receiver{{Name: "dev-null"}}
 	)
 
 	if a.values.ClusterType == component.ClusterTypeShoot {
 		visibility = "owner"
 	}
 
+	route := func(receiver string, continueMatching bool) apiextensionsv1.JSON {
+		raw := `
+				  {"matchers": [{"name": "visibility",
+				                 "matchType": "=~",
+				                 "value": "all|` + visibility + `"}],
+				   "receiver": "` + receiver + `"`
+		if continueMatching {
+			raw += `,
+				   "continue": true`
+		}
+		return apiextensionsv1.JSON{Raw: []byte(raw + `}`)}
+	}
+
+	if a.hasSMTPSecret() {
+		// email only for critical and blocker
+		routes = append(routes, route(emailReceiverName, len(a.values.WebhookReceivers) > 0))
+		receivers = append(receivers, monitoringv1alpha1.Receiver{
+			Name:         emailReceiverName,
+			EmailConfigs: a.emailConfigs(),
+		})
+	}
+
+	if len(a.values.WebhookReceivers) > 0 {
+		routes = append(routes, route(webhookReceiverName, false))
+		receivers = append(receivers, monitoringv1alpha1.Receiver{
+			Name:           webhookReceiverName,
+			WebhookConfigs: a.webhookConfigs(),
+		})
+	}
+
 	return &monitoringv1alpha1.AlertmanagerConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      a.name(),
@@ -51,12 +86,7 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 				RepeatInterval: "72h",
 				// Send alerts by default to nowhere
 				Receiver: "dev-null",
-				// email only for critical and blocker
-				Routes: []apiextensionsv1.JSON{{Raw: []byte(`
-				  {"matchers": [{"name": "visibility",
-				                 "matchType": "=~",
-				                 "value": "all|` + visibility + `"}],
-				   "receiver": "` + emailReceiverName + `"}`)}},
+				Routes:   routes,
 			},
 			InhibitRules: []monitoringv1alpha1.InhibitRule{
 				// Apply inhibition if the alert name is the same.
@@ -97,13 +127,7 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 					Equal:       []string{"cluster"},
 				},
 			},
-			Receivers: []monitoringv1alpha1.Receiver{
-				{Name: "dev-null"},
-				{
-					Name:         emailReceiverName,
-					EmailConfigs: a.emailConfigs(),
-				},
-			},
+			Receivers: receivers,
 		},
 	}
 }
@@ -123,6 +147,10 @@ func (a *alertManager) smtpSecret() *corev1.Secret {
 	}
 }
 
+func (a *alertManager) hasConfig() bool {
+	return a.hasSMTPSecret() || len(a.values.WebhookReceivers) > 0
+}
+
 func (a *alertManager) hasSMTPSecret() bool {
 	return a.values.AlertingSMTPSecret != nil && string(a.values.AlertingSMTPSecret.Data["auth_type"]) == "smtp"
 }
@@ -150,3 +178,15 @@ func (a *alertManager) emailConfigs() []monitoringv1alpha1.EmailConfig {
 
 	return configs
 }
+
+func (a *alertManager) webhookConfigs() []monitoringv1alpha1.WebhookConfig {
+	var configs []monitoringv1alpha1.WebhookConfig
+	for _, receiver := range a.values.WebhookReceivers {
+		configs = append(configs, monitoringv1alpha1.WebhookConfig{
+			URL:          ptr.To(receiver.URL),
+			SendResolved: ptr.To(ptr.Deref(receiver.SendResolved, true)),
+		})
+	}
+
+	return configs
+}
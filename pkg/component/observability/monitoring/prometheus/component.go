@@ -50,6 +50,10 @@ type Interface interface {
 	SetCentralScrapeConfigs([]*monitoringv1alpha1.ScrapeConfig)
 	// SetNamespaceUID sets the namespace UID.
 	SetNamespaceUID(name types.UID)
+	// SetCustomPrometheusRules sets the user-defined PrometheusRule objects.
+	SetCustomPrometheusRules([]*monitoringv1.PrometheusRule)
+	// SetAdditionalRemoteWrites sets the user-defined remote write endpoints.
+	SetAdditionalRemoteWrites([]AdditionalRemoteWriteValues)
 }
 
 // Values contains configuration values for the prometheus resources.
@@ -87,12 +91,17 @@ type Values struct {
 	// CentralConfigs contains configuration for this Prometheus instance that is created together with it. This should
 	// only contain configuration that cannot be directly assigned to another component package.
 	CentralConfigs CentralConfigs
+	// CustomPrometheusRules is a list of user-defined PrometheusRule objects for this prometheus instance. They are
+	// created together with the central configs.
+	CustomPrometheusRules []*monitoringv1.PrometheusRule
 	// IngressValues contains configuration for exposing this Prometheus instance via an Ingress resource.
 	Ingress *IngressValues
 	// Alerting contains alerting configuration for this Prometheus instance.
 	Alerting *AlertingValues
 	// RemoteWrite contains remote write configuration for this Prometheus instance.
 	RemoteWrite *RemoteWriteValues
+	// AdditionalRemoteWrites contains configuration for user-defined remote write endpoints of this Prometheus instance.
+	AdditionalRemoteWrites []AdditionalRemoteWriteValues
 	// AdditionalResources contains any additional resources which get added to the ManagedResource.
 	AdditionalResources []client.Object
 	// Cortex contains configuration for the cortex frontend sidecar container.
@@ -151,6 +160,20 @@ type RemoteWriteValues struct {
 	GlobalShootRemoteWriteSecret *corev1.Secret
 }
 
+// AdditionalRemoteWriteValues contains configuration for a user-defined remote write endpoint of this Prometheus
+// instance.
+type AdditionalRemoteWriteValues struct {
+	// Name is the name of the remote write endpoint.
+	Name string
+	// URL is the remote url.
+	URL string
+	// KeptMetrics is a list of metrics to keep. All other metrics are dropped.
+	KeptMetrics []string
+	// BasicAuthSecretData contains the basic auth credentials (`username` and `password`) for the remote write
+	// endpoint.
+	BasicAuthSecretData map[string][]byte
+}
+
 // IngressValues contains configuration for exposing this Prometheus instance via an Ingress resource.
 type IngressValues struct {
 	// AuthSecretName is the name of the auth secret.
@@ -227,7 +250,7 @@ func (p *prometheus) Deploy(ctx context.Context) error {
 		cortexConfigMap = p.cortexConfigMap()
 	}
 
-	resources, err := registry.AddAllAndSerialize(
+	objects := []client.Object{
 		p.serviceAccount(),
 		p.service(),
 		p.clusterRoleBinding(),
@@ -239,7 +262,12 @@ func (p *prometheus) Deploy(ctx context.Context) error {
 		p.vpa(),
 		p.podDisruptionBudget(),
 		ingress,
-	)
+	}
+	for _, secret := range p.secretsAdditionalRemoteWriteBasicAuth() {
+		objects = append(objects, secret)
+	}
+
+	resources, err := registry.AddAllAndSerialize(objects...)
 	if err != nil {
 		return err
 	}
@@ -316,6 +344,14 @@ func (p *prometheus) SetNamespaceUID(uid types.UID) {
 	p.values.NamespaceUID = &uid
 }
 
+func (p *prometheus) SetCustomPrometheusRules(rules []*monitoringv1.PrometheusRule) {
+	p.values.CustomPrometheusRules = rules
+}
+
+func (p *prometheus) SetAdditionalRemoteWrites(remoteWrites []AdditionalRemoteWriteValues) {
+	p.values.AdditionalRemoteWrites = remoteWrites
+}
+
 func (p *prometheus) name() string {
 	return "prometheus-" + p.values.Name
 }
@@ -342,6 +378,9 @@ func (p *prometheus) addCentralConfigsToRegistry(registry *managedresources.Regi
 	for _, obj := range p.values.CentralConfigs.PrometheusRules {
 		add(obj)
 	}
+	for _, obj := range p.values.CustomPrometheusRules {
+		add(obj)
+	}
 	for _, obj := range p.values.CentralConfigs.ScrapeConfigs {
 		add(obj)
 	}
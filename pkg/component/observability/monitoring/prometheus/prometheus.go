@@ -154,6 +154,31 @@ func (p *prometheus) prometheus(cortexConfigMap *corev1.ConfigMap) *monitoringv1
 		obj.Spec.RemoteWrite = append(obj.Spec.RemoteWrite, spec)
 	}
 
+	for _, remoteWrite := range p.values.AdditionalRemoteWrites {
+		spec := monitoringv1.RemoteWriteSpec{
+			Name: remoteWrite.Name,
+			URL:  remoteWrite.URL,
+			// Only the explicitly kept metrics are sent to user-defined endpoints, hence the relabel config is always
+			// added.
+			WriteRelabelConfigs: []monitoringv1.RelabelConfig{monitoringutils.StandardMetricRelabelConfig(remoteWrite.KeptMetrics...)[0]},
+		}
+
+		if remoteWrite.BasicAuthSecretData != nil {
+			spec.BasicAuth = &monitoringv1.BasicAuth{
+				Username: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: p.secretNameAdditionalRemoteWriteBasicAuth(remoteWrite.Name)},
+					Key:                  "username",
+				},
+				Password: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: p.secretNameAdditionalRemoteWriteBasicAuth(remoteWrite.Name)},
+					Key:                  "password",
+				},
+			}
+		}
+
+		obj.Spec.RemoteWrite = append(obj.Spec.RemoteWrite, spec)
+	}
+
 	if p.values.Cortex != nil {
 		obj.Spec.Containers = append(obj.Spec.Containers, p.cortexContainer())
 		obj.Spec.Volumes = append(obj.Spec.Volumes, p.cortexVolume(cortexConfigMap.Name))
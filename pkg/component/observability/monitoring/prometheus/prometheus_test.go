@@ -642,6 +642,55 @@ honor_labels: true`
 				})
 			})
 
+			When("custom prometheus rules are set", func() {
+				var customPrometheusRule *monitoringv1.PrometheusRule
+
+				BeforeEach(func() {
+					customPrometheusRule = &monitoringv1.PrometheusRule{
+						ObjectMeta: metav1.ObjectMeta{Name: "custom"},
+						Spec: monitoringv1.PrometheusRuleSpec{
+							Groups: []monitoringv1.RuleGroup{{Name: "bar"}},
+						},
+					}
+				})
+
+				JustBeforeEach(func() {
+					deployer.SetCustomPrometheusRules([]*monitoringv1.PrometheusRule{customPrometheusRule})
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+				})
+
+				It("should successfully deploy all resources", func() {
+					prometheusRule.Namespace = namespace
+					metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&scrapeConfig.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&podMonitor.ObjectMeta, "prometheus", name)
+
+					Expect(customPrometheusRule.Name).To(Equal(name + "-custom"))
+					customPrometheusRule.Namespace = namespace
+					metav1.SetMetaDataLabel(&customPrometheusRule.ObjectMeta, "prometheus", name)
+
+					Expect(managedResource).To(consistOf(
+						serviceAccount,
+						service,
+						clusterRoleBinding,
+						prometheusFor(nil, false),
+						vpa,
+						prometheusRule,
+						customPrometheusRule,
+						scrapeConfig,
+						serviceMonitor,
+						podMonitor,
+						secretAdditionalScrapeConfigs,
+						additionalConfigMap,
+					))
+				})
+			})
+
 			When("cluster type is shoot", func() {
 				BeforeEach(func() {
 					values.ClusterType = component.ClusterTypeShoot
@@ -1244,6 +1293,104 @@ query_range:
 				})
 			})
 
+			When("additional remote writes are set", func() {
+				JustBeforeEach(func() {
+					deployer.SetAdditionalRemoteWrites([]AdditionalRemoteWriteValues{
+						{
+							Name:        "central",
+							URL:         "https://central.example.com",
+							KeptMetrics: []string{"apiserver_request_total"},
+							BasicAuthSecretData: map[string][]byte{
+								"username": []byte(`uname`),
+								"password": []byte(`pass`),
+							},
+						},
+						{
+							Name:        "other",
+							URL:         "https://other.example.com",
+							KeptMetrics: []string{"etcd_server_has_leader", "scheduler_pending_pods"},
+						},
+					})
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+				})
+
+				It("should successfully deploy all resources", func() {
+					secretAdditionalRemoteWriteBasicAuth := &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "prometheus-" + name + "-remote-write-central-basic-auth",
+							Namespace: namespace,
+							Labels: map[string]string{
+								"app":  "prometheus",
+								"role": "monitoring",
+								"name": name,
+							},
+						},
+						Type: corev1.SecretTypeOpaque,
+						Data: map[string][]byte{
+							"username": []byte(`uname`),
+							"password": []byte(`pass`),
+						},
+					}
+
+					prometheusObj := prometheusFor(nil, false)
+					prometheusObj.Spec.RemoteWrite = []monitoringv1.RemoteWriteSpec{
+						{
+							Name: "central",
+							URL:  "https://central.example.com",
+							WriteRelabelConfigs: []monitoringv1.RelabelConfig{{
+								SourceLabels: []monitoringv1.LabelName{"__name__"},
+								Action:       "keep",
+								Regex:        `^(apiserver_request_total)$`,
+							}},
+							BasicAuth: &monitoringv1.BasicAuth{
+								Username: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: secretAdditionalRemoteWriteBasicAuth.Name},
+									Key:                  "username",
+								},
+								Password: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: secretAdditionalRemoteWriteBasicAuth.Name},
+									Key:                  "password",
+								},
+							},
+						},
+						{
+							Name: "other",
+							URL:  "https://other.example.com",
+							WriteRelabelConfigs: []monitoringv1.RelabelConfig{{
+								SourceLabels: []monitoringv1.LabelName{"__name__"},
+								Action:       "keep",
+								Regex:        `^(etcd_server_has_leader|scheduler_pending_pods)$`,
+							}},
+						},
+					}
+
+					prometheusRule.Namespace = namespace
+					metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&scrapeConfig.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&podMonitor.ObjectMeta, "prometheus", name)
+
+					Expect(managedResource).To(consistOf(
+						serviceAccount,
+						service,
+						clusterRoleBinding,
+						prometheusObj,
+						vpa,
+						prometheusRule,
+						scrapeConfig,
+						serviceMonitor,
+						podMonitor,
+						secretAdditionalScrapeConfigs,
+						additionalConfigMap,
+						secretAdditionalRemoteWriteBasicAuth,
+					))
+				})
+			})
+
 			When("target cluster is configured", func() {
 				var (
 					managedResourceTarget       *resourcesv1alpha1.ManagedResource
@@ -19,6 +19,7 @@ const (
 	secretNameSuffixAdditionalAlertRelabelConfigs = "-additional-alert-relabel-configs"
 	secretNameSuffixAdditionalAlertmanagerConfigs = "-additional-alertmanager-configs"
 	secretNameSuffixRemoteWriteBasicAuth          = "-remote-write-basic-auth"
+	secretNameInfixAdditionalRemoteWrite          = "-remote-write-"
 )
 
 func (p *prometheus) secretAdditionalScrapeConfigs() *corev1.Secret {
@@ -91,3 +92,29 @@ func (p *prometheus) secretRemoteWriteBasicAuth() *corev1.Secret {
 		Data: p.values.RemoteWrite.GlobalShootRemoteWriteSecret.Data,
 	}
 }
+
+func (p *prometheus) secretsAdditionalRemoteWriteBasicAuth() []*corev1.Secret {
+	var secrets []*corev1.Secret
+
+	for _, remoteWrite := range p.values.AdditionalRemoteWrites {
+		if remoteWrite.BasicAuthSecretData == nil {
+			continue
+		}
+
+		secrets = append(secrets, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      p.secretNameAdditionalRemoteWriteBasicAuth(remoteWrite.Name),
+				Namespace: p.namespace,
+				Labels:    p.getLabels(),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: remoteWrite.BasicAuthSecretData,
+		})
+	}
+
+	return secrets
+}
+
+func (p *prometheus) secretNameAdditionalRemoteWriteBasicAuth(remoteWriteName string) string {
+	return p.name() + secretNameInfixAdditionalRemoteWrite + remoteWriteName + "-basic-auth"
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// CustomRulesConfigMapDataKey is the key in the ConfigMap referenced in the Shoot specification which contains the
+	// user-defined alerting rules.
+	CustomRulesConfigMapDataKey = "rules.yaml"
+	// CustomRulesMaxSize is the maximum size in bytes of the user-defined alerting rules.
+	CustomRulesMaxSize = 64 * 1024
+	// CustomRulesMaxGroups is the maximum number of rule groups of the user-defined alerting rules.
+	CustomRulesMaxGroups = 20
+	// CustomRulesMaxRules is the maximum total number of user-defined alerting rules.
+	CustomRulesMaxRules = 100
+	// CustomRulesMinInterval is the minimum evaluation interval of the user-defined rule groups. Rules cannot be
+	// evaluated more often than the metrics are scraped by the Prometheus of the shoot control plane.
+	CustomRulesMinInterval = model.Duration(time.Minute)
+
+	customRulesName = "custom"
+	// labelVisibility is the label used by the alertmanagers to route alerts to either the shoot owners or operators.
+	labelVisibility = "visibility"
+)
+
+var alertNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// DecodeCustomRules decodes and validates the given user-defined alerting rules. The rules must be given in the format
+// of the `spec` of a PrometheusRule resource.
+func DecodeCustomRules(data string) (*monitoringv1.PrometheusRuleSpec, error) {
+	if len(data) > CustomRulesMaxSize {
+		return nil, fmt.Errorf("alerting rules must not exceed %d bytes, got %d bytes", CustomRulesMaxSize, len(data))
+	}
+
+	spec := &monitoringv1.PrometheusRuleSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), spec); err != nil {
+		return nil, fmt.Errorf("failed decoding alerting rules: %w", err)
+	}
+
+	if errs := validateCustomRules(spec, field.NewPath("groups")); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return spec, nil
+}
+
+func validateCustomRules(spec *monitoringv1.PrometheusRuleSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Groups) == 0 {
+		return append(allErrs, field.Required(fldPath, "at least one rule group must be provided"))
+	}
+
+	if len(spec.Groups) > CustomRulesMaxGroups {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(spec.Groups), CustomRulesMaxGroups))
+	}
+
+	var (
+		groupNames = sets.New[string]()
+		numRules   int
+	)
+
+	for i, group := range spec.Groups {
+		idxPath := fldPath.Index(i)
+
+		if len(group.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if groupNames.Has(group.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		groupNames.Insert(group.Name)
+
+		if group.Interval != nil {
+			if interval, err := model.ParseDuration(string(*group.Interval)); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("interval"), *group.Interval, err.Error()))
+			} else if interval < CustomRulesMinInterval {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("interval"), *group.Interval, "must be at least "+CustomRulesMinInterval.String()))
+			}
+		}
+
+		if len(group.Rules) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("rules"), "at least one rule must be provided"))
+		}
+
+		for j, rule := range group.Rules {
+			allErrs = append(allErrs, validateCustomRule(rule, idxPath.Child("rules").Index(j))...)
+		}
+		numRules += len(group.Rules)
+	}
+
+	if numRules > CustomRulesMaxRules {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("must not contain more than %d rules in total, got %d rules", CustomRulesMaxRules, numRules)))
+	}
+
+	return allErrs
+}
+
+func validateCustomRule(rule monitoringv1.Rule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(rule.Record) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("record"), "only alerting rules are supported"))
+	}
+
+	if len(rule.Alert) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("alert"), "must provide an alert name"))
+	} else if !alertNameRegex.MatchString(rule.Alert) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("alert"), rule.Alert, "must match regex "+alertNameRegex.String()))
+	}
+
+	if rule.Expr.Type != intstr.String || len(rule.Expr.StrVal) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expr"), "must provide a PromQL expression"))
+	} else if _, err := parser.ParseExpr(rule.Expr.StrVal); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expr"), rule.Expr.StrVal, "must be a valid PromQL expression: "+err.Error()))
+	}
+
+	if rule.For != nil {
+		allErrs = append(allErrs, validateDuration(string(*rule.For), fldPath.Child("for"))...)
+	}
+
+	if rule.KeepFiringFor != nil {
+		allErrs = append(allErrs, validateDuration(string(*rule.KeepFiringFor), fldPath.Child("keep_firing_for"))...)
+	}
+
+	for name := range rule.Labels {
+		if !model.LabelName(name).IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labels").Key(name), name, "must be a valid Prometheus label name"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDuration(duration string, fldPath *field.Path) field.ErrorList {
+	if _, err := model.ParseDuration(duration); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, duration, err.Error())}
+	}
+	return nil
+}
+
+// CustomPrometheusRule returns the PrometheusRule resource for the given user-defined alerting rules. The alerts are
+// always routed to the shoot owners only.
+func CustomPrometheusRule(spec *monitoringv1.PrometheusRuleSpec) *monitoringv1.PrometheusRule {
+	rule := &monitoringv1.PrometheusRule{
+		TypeMeta:   metav1.TypeMeta{APIVersion: monitoringv1.SchemeGroupVersion.String(), Kind: monitoringv1.PrometheusRuleKind},
+		ObjectMeta: metav1.ObjectMeta{Name: customRulesName},
+		Spec:       *spec.DeepCopy(),
+	}
+
+	for i := range rule.Spec.Groups {
+		for j := range rule.Spec.Groups[i].Rules {
+			if rule.Spec.Groups[i].Rules[j].Labels == nil {
+				rule.Spec.Groups[i].Rules[j].Labels = map[string]string{}
+			}
+			rule.Spec.Groups[i].Rules[j].Labels[labelVisibility] = "owner"
+		}
+	}
+
+	return rule
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+var _ = ginkgo.Describe("CustomRules", func() {
+	const validRules = `groups:
+- name: my-rules
+  interval: 1m
+  rules:
+  - alert: ApiServerTooManyRequests
+    expr: sum(rate(apiserver_request_total{code="429"}[5m])) > 10
+    for: 10m
+    labels:
+      severity: warning
+      visibility: operator
+    annotations:
+      summary: The API server rejects too many requests.
+`
+
+	ginkgo.Describe("#DecodeCustomRules", func() {
+		tooManyGroups := func() string {
+			data := "groups:\n"
+			for i := range CustomRulesMaxGroups + 1 {
+				data += fmt.Sprintf("- name: g%d\n  rules: [{alert: A, expr: up}]\n", i)
+			}
+			return data
+		}
+
+		tooManyRules := func() string {
+			data := "groups:\n- name: a\n  rules:\n"
+			for i := range CustomRulesMaxRules + 1 {
+				data += fmt.Sprintf("  - {alert: A%d, expr: up}\n", i)
+			}
+			return data
+		}
+
+		ginkgo.It("should decode valid rules", func() {
+			spec, err := DecodeCustomRules(validRules)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Groups).To(HaveLen(1))
+			Expect(spec.Groups[0].Rules).To(ConsistOf(monitoringv1.Rule{
+				Alert:       "ApiServerTooManyRequests",
+				Expr:        intstr.FromString(`sum(rate(apiserver_request_total{code="429"}[5m])) > 10`),
+				For:         ptr.To(monitoringv1.Duration("10m")),
+				Labels:      map[string]string{"severity": "warning", "visibility": "operator"},
+				Annotations: map[string]string{"summary": "The API server rejects too many requests."},
+			}))
+		})
+
+		ginkgo.DescribeTable("should reject invalid rules",
+			func(data, errMessage string) {
+				_, err := DecodeCustomRules(data)
+				Expect(err).To(MatchError(ContainSubstring(errMessage)))
+			},
+
+			ginkgo.Entry("too large", "groups: []\n"+strings.Repeat("#", CustomRulesMaxSize), "must not exceed 65536 bytes"),
+			ginkgo.Entry("unknown field", "groups: []\nfoo: bar", "failed decoding alerting rules"),
+			ginkgo.Entry("no groups", "groups: []", "groups: Required value"),
+			ginkgo.Entry("duplicate group names", "groups:\n- name: a\n  rules: [{alert: A, expr: up}]\n- name: a\n  rules: [{alert: B, expr: up}]", "groups[1].name: Duplicate value"),
+			ginkgo.Entry("invalid interval", "groups:\n- name: a\n  interval: 5x\n  rules: [{alert: A, expr: up}]", "groups[0].interval: Invalid value"),
+			ginkgo.Entry("too short interval", "groups:\n- name: a\n  interval: 30s\n  rules: [{alert: A, expr: up}]", "groups[0].interval: Invalid value: \"30s\": must be at least 1m"),
+			ginkgo.Entry("too many groups", tooManyGroups(), "groups: Too many: 21: must have at most 20 items"),
+			ginkgo.Entry("too many rules", tooManyRules(), "must not contain more than 100 rules in total, got 101 rules"),
+			ginkgo.Entry("no rules", "groups:\n- name: a", "groups[0].rules: Required value"),
+			ginkgo.Entry("recording rule", "groups:\n- name: a\n  rules: [{record: foo, expr: up}]", "groups[0].rules[0].record: Forbidden"),
+			ginkgo.Entry("invalid alert name", "groups:\n- name: a\n  rules: [{alert: foo-bar, expr: up}]", "groups[0].rules[0].alert: Invalid value"),
+			ginkgo.Entry("missing expression", "groups:\n- name: a\n  rules: [{alert: A}]", "groups[0].rules[0].expr: Required value"),
+			ginkgo.Entry("invalid expression", "groups:\n- name: a\n  rules: [{alert: A, expr: 'sum(rate(up[5m])'}]", "groups[0].rules[0].expr: Invalid value"),
+			ginkgo.Entry("function call with wrong argument type", "groups:\n- name: a\n  rules: [{alert: A, expr: 'rate(foo)'}]", "expected type range vector in call to function \"rate\""),
+			ginkgo.Entry("incomplete binary expression", "groups:\n- name: a\n  rules: [{alert: A, expr: 'up =='}]", "groups[0].rules[0].expr: Invalid value"),
+			ginkgo.Entry("invalid for", "groups:\n- name: a\n  rules: [{alert: A, expr: up, for: foo}]", "groups[0].rules[0].for: Invalid value"),
+			ginkgo.Entry("invalid label name", "groups:\n- name: a\n  rules: [{alert: A, expr: up, labels: {foo-bar: baz}}]", "groups[0].rules[0].labels[foo-bar]: Invalid value"),
+		)
+	})
+
+	ginkgo.Describe("#CustomPrometheusRule", func() {
+		ginkgo.It("should return the PrometheusRule and route all alerts to the shoot owners", func() {
+			spec, err := DecodeCustomRules(validRules + "  - alert: Foo\n    expr: up == 0\n")
+			Expect(err).NotTo(HaveOccurred())
+
+			rule := CustomPrometheusRule(spec)
+			Expect(rule.Name).To(Equal("custom"))
+			Expect(rule.Kind).To(Equal("PrometheusRule"))
+			Expect(rule.Spec.Groups[0].Rules).To(HaveLen(2))
+			Expect(rule.Spec.Groups[0].Rules[0].Labels).To(Equal(map[string]string{"severity": "warning", "visibility": "owner"}))
+			Expect(rule.Spec.Groups[0].Rules[1].Labels).To(Equal(map[string]string{"visibility": "owner"}))
+
+			Expect(spec.Groups[0].Rules[0].Labels).To(HaveKeyWithValue("visibility", "operator"), "input must not be mutated")
+		})
+	})
+})
@@ -19,10 +19,16 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/component/apiserver"
+	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
+// dataKeyAuditWebhookSigningKey is the key in the data of the audit webhook secret containing the key for signing the
+// batches of audit events.
+const dataKeyAuditWebhookSigningKey = "signing.key" // #nosec G101 -- No credential.
+
 func computeAPIServerAuditConfig(
 	ctx context.Context,
 	cl client.Client,
@@ -37,29 +43,109 @@ func computeAPIServerAuditConfig(
 		return nil, nil
 	}
 
+	policy, err := getAuditPolicy(ctx, cl, objectMeta, config.AuditPolicy.ConfigMapRef.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiserver.AuditConfig{
+		Policy:  policy,
+		Webhook: webhookConfig,
+	}, nil
+}
+
+func computeKubeAPIServerAuditForwarderConfig(
+	ctx context.Context,
+	cl client.Client,
+	objectMeta metav1.ObjectMeta,
+	config *gardencorev1beta1.AuditConfig,
+) (
+	*kubeapiserver.AuditForwarderConfig,
+	error,
+) {
+	if config == nil || config.Backends == nil {
+		return nil, nil
+	}
+
 	var (
-		out = &apiserver.AuditConfig{
-			Webhook: webhookConfig,
+		out = &kubeapiserver.AuditForwarderConfig{}
+		err error
+	)
+
+	if log := config.Backends.Log; log != nil {
+		out.Log = &kubeapiserver.AuditForwarderLogBackend{}
+		if out.Log.Policy, err = getAuditBackendPolicy(ctx, cl, objectMeta, log.Policy); err != nil {
+			return nil, err
+		}
+	}
+
+	if webhook := config.Backends.Webhook; webhook != nil {
+		out.Webhook = &kubeapiserver.AuditForwarderWebhookBackend{
+			URL:            webhook.URL,
+			InitialBackoff: webhook.InitialBackoff,
+		}
+
+		if out.Webhook.Policy, err = getAuditBackendPolicy(ctx, cl, objectMeta, webhook.Policy); err != nil {
+			return nil, err
 		}
-		key = client.ObjectKey{Namespace: objectMeta.Namespace, Name: config.AuditPolicy.ConfigMapRef.Name}
+
+		if webhook.SecretName != nil {
+			key := client.ObjectKey{Namespace: objectMeta.Namespace, Name: *webhook.SecretName}
+
+			secret := &corev1.Secret{}
+			if err := cl.Get(ctx, key, secret); err != nil {
+				// Ignore missing credentials on cluster deletion for the same reason as for the audit policy.
+				if !apierrors.IsNotFound(err) || objectMeta.DeletionTimestamp == nil {
+					return nil, fmt.Errorf("retrieving audit webhook credentials from the Secret %s failed: %w", key, err)
+				}
+			}
+
+			out.Webhook.Token = secret.Data[secretsutils.DataKeyToken]
+			out.Webhook.CABundle = secret.Data[secretsutils.DataKeyCertificateCA]
+			out.Webhook.SigningKey = secret.Data[dataKeyAuditWebhookSigningKey]
+		}
+
+		if batch := webhook.Batch; batch != nil {
+			out.Webhook.BatchMaxSize = batch.MaxSize
+			out.Webhook.BatchMaxWait = batch.MaxWait
+		}
+
+		if truncate := webhook.Truncate; truncate != nil {
+			out.Webhook.TruncateMaxBatchSize = truncate.MaxBatchSize
+			out.Webhook.TruncateMaxEventSize = truncate.MaxEventSize
+		}
+	}
+
+	return out, nil
+}
+
+func getAuditBackendPolicy(ctx context.Context, cl client.Client, objectMeta metav1.ObjectMeta, policy *gardencorev1beta1.AuditPolicy) (*string, error) {
+	if policy == nil || policy.ConfigMapRef == nil {
+		return nil, nil
+	}
+	return getAuditPolicy(ctx, cl, objectMeta, policy.ConfigMapRef.Name)
+}
+
+func getAuditPolicy(ctx context.Context, cl client.Client, objectMeta metav1.ObjectMeta, name string) (*string, error) {
+	var (
+		key       = client.ObjectKey{Namespace: objectMeta.Namespace, Name: name}
+		configMap = &corev1.ConfigMap{}
 	)
 
-	configMap := &corev1.ConfigMap{}
 	if err := cl.Get(ctx, key, configMap); err != nil {
 		// Ignore missing audit configuration on cluster deletion to prevent failing redeployments of the
 		// API server in case the end-user deleted the configmap before/simultaneously to the deletion.
 		if !apierrors.IsNotFound(err) || objectMeta.DeletionTimestamp == nil {
 			return nil, fmt.Errorf("retrieving audit policy from the ConfigMap %s failed: %w", key, err)
 		}
-	} else {
-		policy, ok := configMap.Data["policy"]
-		if !ok {
-			return nil, fmt.Errorf("missing '.data.policy' in audit policy ConfigMap %s", key)
-		}
-		out.Policy = &policy
+		return nil, nil
 	}
 
-	return out, nil
+	policy, ok := configMap.Data["policy"]
+	if !ok {
+		return nil, fmt.Errorf("missing '.data.policy' in audit policy ConfigMap %s", key)
+	}
+	return &policy, nil
 }
 
 func computeAPIServerAuthenticationConfig(
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-base/version"
 	admissionapiv1 "k8s.io/pod-security-admission/admission/api/v1"
 	admissionapiv1alpha1 "k8s.io/pod-security-admission/admission/api/v1alpha1"
 	admissionapiv1beta1 "k8s.io/pod-security-admission/admission/api/v1beta1"
@@ -102,6 +103,7 @@ func NewKubeAPIServer(
 		disabledAdmissionPlugins            []gardencorev1beta1.AdmissionPlugin
 		apiAudiences                        = []string{"kubernetes", "gardener"}
 		auditConfig                         *apiserver.AuditConfig
+		auditForwarderConfig                *kubeapiserver.AuditForwarderConfig
 		authenticationConfiguration         *string
 		defaultNotReadyTolerationSeconds    *int64
 		defaultUnreachableTolerationSeconds *int64
@@ -135,6 +137,20 @@ func NewKubeAPIServer(
 			return nil, err
 		}
 
+		auditForwarderConfig, err = computeKubeAPIServerAuditForwarderConfig(ctx, resourceConfigClient, objectMeta, apiServerConfig.AuditConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		if auditForwarderConfig != nil {
+			imageAuditForwarder, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerAuditForwarder)
+			if err != nil {
+				return nil, err
+			}
+			imageAuditForwarder.WithOptionalTag(version.Get().GitVersion)
+			images.AuditForwarder = imageAuditForwarder.String()
+		}
+
 		authenticationConfiguration, err = computeAPIServerAuthenticationConfig(ctx, resourceConfigClient, objectMeta, apiServerConfig.StructuredAuthentication)
 		if err != nil {
 			return nil, err
@@ -174,6 +190,7 @@ func NewKubeAPIServer(
 			},
 			AnonymousAuthenticationEnabled:      v1beta1helper.AnonymousAuthenticationEnabled(apiServerConfig),
 			APIAudiences:                        apiAudiences,
+			AuditForwarder:                      auditForwarderConfig,
 			AuthenticationConfiguration:         authenticationConfiguration,
 			AuthenticationWebhook:               authenticationWebhookConfig,
 			AuthorizationWebhook:                authorizationWebhookConfig,
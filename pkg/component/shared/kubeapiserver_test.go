@@ -709,6 +709,138 @@ exemptions:
 			)
 		})
 
+		Describe("AuditForwarderConfig", func() {
+			var (
+				policy            = "some-policy"
+				logPolicy         = "some-log-policy"
+				webhookPolicy     = "some-webhook-policy"
+				auditPolicyConfig *gardencorev1beta1.AuditPolicy
+			)
+
+			BeforeEach(func() {
+				for name, data := range map[string]string{"audit-policy": policy, "log-policy": logPolicy, "webhook-policy": webhookPolicy} {
+					Expect(resourceConfigClient.Create(ctx, &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: objectMeta.Namespace},
+						Data:       map[string]string{"policy": data},
+					})).To(Succeed())
+				}
+
+				auditPolicyConfig = &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "audit-policy"}}
+			})
+
+			It("should not configure the audit forwarder if no backends are configured", func() {
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+					AuditConfig: &gardencorev1beta1.AuditConfig{AuditPolicy: auditPolicyConfig},
+				}
+
+				kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().AuditForwarder).To(BeNil())
+				Expect(kubeAPIServer.GetValues().Images.AuditForwarder).To(BeEmpty())
+			})
+
+			It("should compute the audit forwarder config from the backends", func() {
+				Expect(resourceConfigClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "audit-credentials", Namespace: objectMeta.Namespace},
+					Data:       map[string][]byte{"token": []byte("some-token"), "ca.crt": []byte("some-ca"), "signing.key": []byte("some-key")},
+				})).To(Succeed())
+
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+					AuditConfig: &gardencorev1beta1.AuditConfig{
+						AuditPolicy: auditPolicyConfig,
+						Backends: &gardencorev1beta1.AuditBackends{
+							Log: &gardencorev1beta1.AuditLogBackend{
+								Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "log-policy"}},
+							},
+							Webhook: &gardencorev1beta1.AuditWebhookBackend{
+								URL:            "https://audit.example.com/events",
+								SecretName:     ptr.To("audit-credentials"),
+								Policy:         &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "webhook-policy"}},
+								Batch:          &gardencorev1beta1.AuditBatch{MaxSize: ptr.To[int32](100), MaxWait: &metav1.Duration{Duration: 5 * time.Second}},
+								Truncate:       &gardencorev1beta1.AuditTruncate{MaxBatchSize: ptr.To(resource.MustParse("10Mi")), MaxEventSize: ptr.To(resource.MustParse("100Ki"))},
+								InitialBackoff: &metav1.Duration{Duration: 20 * time.Second},
+							},
+						},
+					},
+				}
+
+				kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().Audit).To(Equal(&apiserver.AuditConfig{Policy: &policy}))
+				Expect(kubeAPIServer.GetValues().AuditForwarder).To(Equal(&kubeapiserver.AuditForwarderConfig{
+					Log: &kubeapiserver.AuditForwarderLogBackend{Policy: &logPolicy},
+					Webhook: &kubeapiserver.AuditForwarderWebhookBackend{
+						URL:                  "https://audit.example.com/events",
+						Policy:               &webhookPolicy,
+						Token:                []byte("some-token"),
+						CABundle:             []byte("some-ca"),
+						SigningKey:           []byte("some-key"),
+						BatchMaxSize:         ptr.To[int32](100),
+						BatchMaxWait:         &metav1.Duration{Duration: 5 * time.Second},
+						TruncateMaxBatchSize: ptr.To(resource.MustParse("10Mi")),
+						TruncateMaxEventSize: ptr.To(resource.MustParse("100Ki")),
+						InitialBackoff:       &metav1.Duration{Duration: 20 * time.Second},
+					},
+				}))
+				Expect(kubeAPIServer.GetValues().Images.AuditForwarder).To(ContainSubstring("gardener/audit-forwarder"))
+			})
+
+			It("should fail if the policy of a backend is missing", func() {
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+					AuditConfig: &gardencorev1beta1.AuditConfig{
+						AuditPolicy: auditPolicyConfig,
+						Backends: &gardencorev1beta1.AuditBackends{
+							Log: &gardencorev1beta1.AuditLogBackend{
+								Policy: &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "missing-policy"}},
+							},
+						},
+					},
+				}
+
+				_, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
+				Expect(err).To(MatchError(ContainSubstring("retrieving audit policy from the ConfigMap")))
+			})
+
+			It("should fail if the credentials of the webhook backend are missing", func() {
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+					AuditConfig: &gardencorev1beta1.AuditConfig{
+						AuditPolicy: auditPolicyConfig,
+						Backends: &gardencorev1beta1.AuditBackends{
+							Webhook: &gardencorev1beta1.AuditWebhookBackend{
+								URL:        "https://audit.example.com/events",
+								SecretName: ptr.To("audit-credentials"),
+							},
+						},
+					},
+				}
+
+				_, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
+				Expect(err).To(MatchError(ContainSubstring("retrieving audit webhook credentials from the Secret")))
+			})
+
+			It("should ignore missing policies and credentials if the shoot is being deleted", func() {
+				objectMeta.DeletionTimestamp = &metav1.Time{}
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+					AuditConfig: &gardencorev1beta1.AuditConfig{
+						AuditPolicy: auditPolicyConfig,
+						Backends: &gardencorev1beta1.AuditBackends{
+							Webhook: &gardencorev1beta1.AuditWebhookBackend{
+								URL:        "https://audit.example.com/events",
+								SecretName: ptr.To("audit-credentials"),
+								Policy:     &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "missing-policy"}},
+							},
+						},
+					},
+				}
+
+				kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().AuditForwarder).To(Equal(&kubeapiserver.AuditForwarderConfig{
+					Webhook: &kubeapiserver.AuditForwarderWebhookBackend{URL: "https://audit.example.com/events"},
+				}))
+			})
+		})
+
 		Describe("AuthenticationConfiguration", func() {
 			var (
 				config                        = "some-config"
@@ -262,6 +262,31 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootControlPlaneRestarter *bool
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	EnableShootCoreAddonRestarter *bool
+	// AutoUpdateRollout configures how automatic updates of Kubernetes patch versions and machine image versions are
+	// rolled out across all shoots. If not set, shoots are updated in their maintenance time windows without any further
+	// restrictions.
+	AutoUpdateRollout *AutoUpdateRolloutConfiguration
+}
+
+// AutoUpdateRolloutConfiguration configures how automatic updates of Kubernetes patch versions and machine image versions
+// are rolled out across all shoots. Canary shoots are updated first, the remaining shoots are distributed to waves which
+// are updated one after another. A version is only rolled out to a wave if enough shoots of the previous wave already
+// run it and are healthy. Forceful updates of expired versions are not subject to the rollout.
+type AutoUpdateRolloutConfiguration struct {
+	// CanarySelector selects shoots based on their labels which receive automatic updates first.
+	CanarySelector *metav1.LabelSelector
+	// CanaryPurposes is a list of shoot purposes. Shoots with one of these purposes receive automatic updates first.
+	CanaryPurposes []string
+	// Waves is the number of waves the non-canary shoots are distributed to. Shoots are assigned to waves
+	// deterministically based on their UID.
+	Waves *int
+	// MinHealthyPercentage is the minimum percentage of shoots of the previous wave which must run a version and be
+	// healthy before this version is rolled out to the next wave. Only shoots which have automatic updates enabled and
+	// could be updated to the version are considered.
+	MinHealthyPercentage *int
+	// MaxFailurePercentage is the maximum percentage of unhealthy shoots among all shoots running a version. If it is
+	// exceeded, the rollout of this version is paused for all shoots.
+	MaxFailurePercentage *int
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
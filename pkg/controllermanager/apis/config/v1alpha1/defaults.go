@@ -240,6 +240,19 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	}
 }
 
+// SetDefaults_AutoUpdateRolloutConfiguration sets defaults for the AutoUpdateRolloutConfiguration.
+func SetDefaults_AutoUpdateRolloutConfiguration(obj *AutoUpdateRolloutConfiguration) {
+	if obj.Waves == nil {
+		obj.Waves = ptr.To(1)
+	}
+	if obj.MinHealthyPercentage == nil {
+		obj.MinHealthyPercentage = ptr.To(80)
+	}
+	if obj.MaxFailurePercentage == nil {
+		obj.MaxFailurePercentage = ptr.To(10)
+	}
+}
+
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
 func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
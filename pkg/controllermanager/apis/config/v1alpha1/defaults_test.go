@@ -602,6 +602,17 @@ var _ = Describe("Defaults", func() {
 
 			Expect(&obj.Controllers.ShootMaintenance).To(Equal(expected))
 		})
+
+		It("should default the AutoUpdateRolloutConfiguration correctly", func() {
+			obj.Controllers.ShootMaintenance.AutoUpdateRollout = &AutoUpdateRolloutConfiguration{}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMaintenance.AutoUpdateRollout).To(Equal(&AutoUpdateRolloutConfiguration{
+				Waves:                ptr.To(1),
+				MinHealthyPercentage: ptr.To(80),
+				MaxFailurePercentage: ptr.To(10),
+			}))
+		})
 	})
 
 	Describe("ShootQuotaControllerConfiguration defaulting", func() {
@@ -319,6 +319,37 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// AutoUpdateRollout configures how automatic updates of Kubernetes patch versions and machine image versions are
+	// rolled out across all shoots. If not set, shoots are updated in their maintenance time windows without any further
+	// restrictions.
+	// +optional
+	AutoUpdateRollout *AutoUpdateRolloutConfiguration `json:"autoUpdateRollout,omitempty"`
+}
+
+// AutoUpdateRolloutConfiguration configures how automatic updates of Kubernetes patch versions and machine image versions
+// are rolled out across all shoots. Canary shoots are updated first, the remaining shoots are distributed to waves which
+// are updated one after another. A version is only rolled out to a wave if enough shoots of the previous wave already
+// run it and are healthy. Forceful updates of expired versions are not subject to the rollout.
+type AutoUpdateRolloutConfiguration struct {
+	// CanarySelector selects shoots based on their labels which receive automatic updates first.
+	// +optional
+	CanarySelector *metav1.LabelSelector `json:"canarySelector,omitempty"`
+	// CanaryPurposes is a list of shoot purposes. Shoots with one of these purposes receive automatic updates first.
+	// +optional
+	CanaryPurposes []string `json:"canaryPurposes,omitempty"`
+	// Waves is the number of waves the non-canary shoots are distributed to. Shoots are assigned to waves
+	// deterministically based on their UID. Defaults to 1.
+	// +optional
+	Waves *int `json:"waves,omitempty"`
+	// MinHealthyPercentage is the minimum percentage of shoots of the previous wave which must run a version and be
+	// healthy before this version is rolled out to the next wave. Only shoots which have automatic updates enabled and
+	// could be updated to the version are considered. Defaults to 80.
+	// +optional
+	MinHealthyPercentage *int `json:"minHealthyPercentage,omitempty"`
+	// MaxFailurePercentage is the maximum percentage of unhealthy shoots among all shoots running a version. If it is
+	// exceeded, the rollout of this version is paused for all shoots. Defaults to 10.
+	// +optional
+	MaxFailurePercentage *int `json:"maxFailurePercentage,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -27,6 +27,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AutoUpdateRolloutConfiguration)(nil), (*config.AutoUpdateRolloutConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AutoUpdateRolloutConfiguration_To_config_AutoUpdateRolloutConfiguration(a.(*AutoUpdateRolloutConfiguration), b.(*config.AutoUpdateRolloutConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AutoUpdateRolloutConfiguration)(nil), (*AutoUpdateRolloutConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AutoUpdateRolloutConfiguration_To_v1alpha1_AutoUpdateRolloutConfiguration(a.(*config.AutoUpdateRolloutConfiguration), b.(*AutoUpdateRolloutConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionControllerConfiguration)(nil), (*config.BastionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionControllerConfiguration_To_config_BastionControllerConfiguration(a.(*BastionControllerConfiguration), b.(*config.BastionControllerConfiguration), scope)
 	}); err != nil {
@@ -320,6 +330,34 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AutoUpdateRolloutConfiguration_To_config_AutoUpdateRolloutConfiguration(in *AutoUpdateRolloutConfiguration, out *config.AutoUpdateRolloutConfiguration, s conversion.Scope) error {
+	out.CanarySelector = (*v1.LabelSelector)(unsafe.Pointer(in.CanarySelector))
+	out.CanaryPurposes = *(*[]string)(unsafe.Pointer(&in.CanaryPurposes))
+	out.Waves = (*int)(unsafe.Pointer(in.Waves))
+	out.MinHealthyPercentage = (*int)(unsafe.Pointer(in.MinHealthyPercentage))
+	out.MaxFailurePercentage = (*int)(unsafe.Pointer(in.MaxFailurePercentage))
+	return nil
+}
+
+// Convert_v1alpha1_AutoUpdateRolloutConfiguration_To_config_AutoUpdateRolloutConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_AutoUpdateRolloutConfiguration_To_config_AutoUpdateRolloutConfiguration(in *AutoUpdateRolloutConfiguration, out *config.AutoUpdateRolloutConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AutoUpdateRolloutConfiguration_To_config_AutoUpdateRolloutConfiguration(in, out, s)
+}
+
+func autoConvert_config_AutoUpdateRolloutConfiguration_To_v1alpha1_AutoUpdateRolloutConfiguration(in *config.AutoUpdateRolloutConfiguration, out *AutoUpdateRolloutConfiguration, s conversion.Scope) error {
+	out.CanarySelector = (*v1.LabelSelector)(unsafe.Pointer(in.CanarySelector))
+	out.CanaryPurposes = *(*[]string)(unsafe.Pointer(&in.CanaryPurposes))
+	out.Waves = (*int)(unsafe.Pointer(in.Waves))
+	out.MinHealthyPercentage = (*int)(unsafe.Pointer(in.MinHealthyPercentage))
+	out.MaxFailurePercentage = (*int)(unsafe.Pointer(in.MaxFailurePercentage))
+	return nil
+}
+
+// Convert_config_AutoUpdateRolloutConfiguration_To_v1alpha1_AutoUpdateRolloutConfiguration is an autogenerated conversion function.
+func Convert_config_AutoUpdateRolloutConfiguration_To_v1alpha1_AutoUpdateRolloutConfiguration(in *config.AutoUpdateRolloutConfiguration, out *AutoUpdateRolloutConfiguration, s conversion.Scope) error {
+	return autoConvert_config_AutoUpdateRolloutConfiguration_To_v1alpha1_AutoUpdateRolloutConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionControllerConfiguration_To_config_BastionControllerConfiguration(in *BastionControllerConfiguration, out *config.BastionControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
@@ -991,6 +1029,7 @@ func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.AutoUpdateRollout = (*config.AutoUpdateRolloutConfiguration)(unsafe.Pointer(in.AutoUpdateRollout))
 	return nil
 }
 
@@ -1003,6 +1042,7 @@ func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.AutoUpdateRollout = (*AutoUpdateRolloutConfiguration)(unsafe.Pointer(in.AutoUpdateRollout))
 	return nil
 }
 
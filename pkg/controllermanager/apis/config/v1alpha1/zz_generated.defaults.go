@@ -73,6 +73,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 		SetDefaults_SeedBackupBucketsCheckControllerConfiguration(in.Controllers.SeedBackupBucketsCheck)
 	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
+	if in.Controllers.ShootMaintenance.AutoUpdateRollout != nil {
+		SetDefaults_AutoUpdateRolloutConfiguration(in.Controllers.ShootMaintenance.AutoUpdateRollout)
+	}
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
 	}
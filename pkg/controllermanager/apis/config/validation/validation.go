@@ -41,6 +41,36 @@ func validateControllerManagerControllerConfiguration(conf config.ControllerMana
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	if conf.ShootMaintenance.AutoUpdateRollout != nil {
+		allErrs = append(allErrs, validateAutoUpdateRolloutConfiguration(conf.ShootMaintenance.AutoUpdateRollout, fldPath.Child("shootMaintenance", "autoUpdateRollout"))...)
+	}
+
+	return allErrs
+}
+
+func validateAutoUpdateRolloutConfiguration(conf *config.AutoUpdateRolloutConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.CanarySelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(conf.CanarySelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("canarySelector"))...)
+	}
+
+	if conf.Waves != nil && *conf.Waves < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("waves"), *conf.Waves, "must be at least 1"))
+	}
+
+	for _, percentage := range []struct {
+		name  string
+		value *int
+	}{
+		{"minHealthyPercentage", conf.MinHealthyPercentage},
+		{"maxFailurePercentage", conf.MaxFailurePercentage},
+	} {
+		if percentage.value != nil && (*percentage.value < 0 || *percentage.value > 100) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(percentage.name), *percentage.value, "must be between 0 and 100"))
+		}
+	}
+
 	return allErrs
 }
 
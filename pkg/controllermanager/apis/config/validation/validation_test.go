@@ -11,6 +11,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
@@ -105,4 +106,50 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("ShootMaintenanceControllerConfiguration", func() {
+		Context("AutoUpdateRolloutConfiguration", func() {
+			BeforeEach(func() {
+				conf.Controllers.ShootMaintenance.AutoUpdateRollout = &config.AutoUpdateRolloutConfiguration{
+					CanarySelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"rollout.example.com/canary": "true"},
+					},
+					CanaryPurposes:       []string{"evaluation"},
+					Waves:                ptr.To(3),
+					MinHealthyPercentage: ptr.To(80),
+					MaxFailurePercentage: ptr.To(10),
+				}
+			})
+
+			It("should pass because the rollout configuration is valid", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail because the rollout configuration is invalid", func() {
+				conf.Controllers.ShootMaintenance.AutoUpdateRollout.CanarySelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "role", Operator: "In"}}
+				conf.Controllers.ShootMaintenance.AutoUpdateRollout.Waves = ptr.To(0)
+				conf.Controllers.ShootMaintenance.AutoUpdateRollout.MinHealthyPercentage = ptr.To(101)
+				conf.Controllers.ShootMaintenance.AutoUpdateRollout.MaxFailurePercentage = ptr.To(-1)
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootMaintenance.autoUpdateRollout.canarySelector.matchExpressions[0].values"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMaintenance.autoUpdateRollout.waves"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMaintenance.autoUpdateRollout.minHealthyPercentage"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootMaintenance.autoUpdateRollout.maxFailurePercentage"),
+					})),
+				))
+			})
+		})
+	})
 })
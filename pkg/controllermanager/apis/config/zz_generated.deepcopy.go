@@ -15,6 +15,47 @@ import (
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoUpdateRolloutConfiguration) DeepCopyInto(out *AutoUpdateRolloutConfiguration) {
+	*out = *in
+	if in.CanarySelector != nil {
+		in, out := &in.CanarySelector, &out.CanarySelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanaryPurposes != nil {
+		in, out := &in.CanaryPurposes, &out.CanaryPurposes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinHealthyPercentage != nil {
+		in, out := &in.MinHealthyPercentage, &out.MinHealthyPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxFailurePercentage != nil {
+		in, out := &in.MaxFailurePercentage, &out.MaxFailurePercentage
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoUpdateRolloutConfiguration.
+func (in *AutoUpdateRolloutConfiguration) DeepCopy() *AutoUpdateRolloutConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoUpdateRolloutConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionControllerConfiguration) DeepCopyInto(out *BastionControllerConfiguration) {
 	*out = *in
@@ -745,6 +786,11 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AutoUpdateRollout != nil {
+		in, out := &in.AutoUpdateRollout, &out.AutoUpdateRollout
+		*out = new(AutoUpdateRolloutConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -90,14 +90,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
 	}
 
+	// the bastion's max duration can only shorten the configured maximum lifetime
+	maxLifetime := r.Config.MaxLifetime.Duration
+	if bastion.Spec.MaxDuration != nil && bastion.Spec.MaxDuration.Duration < maxLifetime {
+		maxLifetime = bastion.Spec.MaxDuration.Duration
+	}
+
 	// delete the bastion once it has reached its maximum lifetime
-	if r.Clock.Since(bastion.CreationTimestamp.Time) > r.Config.MaxLifetime.Duration {
-		log.Info("Deleting bastion because it reached its maximum lifetime", "creationTimestamp", bastion.CreationTimestamp.Time, "maxLifetime", r.Config.MaxLifetime.Duration)
+	if r.Clock.Since(bastion.CreationTimestamp.Time) > maxLifetime {
+		log.Info("Deleting bastion because it reached its maximum lifetime", "creationTimestamp", bastion.CreationTimestamp.Time, "maxLifetime", maxLifetime)
 		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
 	}
 
 	// requeue when the Bastion expires or reaches its lifetime, whichever is sooner
-	requeueAfter := bastion.CreationTimestamp.Add(r.Config.MaxLifetime.Duration).Sub(r.Clock.Now())
+	requeueAfter := bastion.CreationTimestamp.Add(maxLifetime).Sub(r.Clock.Now())
 	if bastion.Status.ExpirationTimestamp != nil {
 		expiresIn := bastion.Status.ExpirationTimestamp.Sub(r.Clock.Now())
 		if expiresIn < requeueAfter {
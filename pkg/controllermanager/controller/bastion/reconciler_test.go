@@ -124,6 +124,71 @@ var _ = Describe("Controller", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should requeue soon-to-reach-max-duration Bastions", func() {
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: shootName}, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: bastionName}, gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				now := time.Now()
+				created := now.Add(-time.Hour)
+				expires := now.Add(30 * time.Minute)
+
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, &expires)
+				obj.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour + 10*time.Minute} // reaches end-of-life in 10 minutes
+				return nil
+			})
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: bastionName}})
+			Expect(result.RequeueAfter).To(BeNumerically("~", 10*time.Minute, 1*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should delete Bastions which reached their max duration", func() {
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: shootName}, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: bastionName}, gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				now := time.Now()
+				created := now.Add(-time.Hour)
+				expires := now.Add(30 * time.Minute)
+
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, &expires)
+				obj.Spec.MaxDuration = &metav1.Duration{Duration: 30 * time.Minute}
+				return nil
+			})
+
+			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: bastionName}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not extend the maximum lifetime by the Bastion's max duration", func() {
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: shootName}, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Shoot, _ ...client.GetOption) error {
+				*obj = newShoot(namespace, shootName, &seedName)
+				return nil
+			})
+
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: bastionName}, gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *operationsv1alpha1.Bastion, _ ...client.GetOption) error {
+				created := time.Now().Add(-maxLifetime * 2)
+
+				*obj = newBastion(namespace, bastionName, shootName, &seedName, &created, nil)
+				obj.Spec.MaxDuration = &metav1.Duration{Duration: maxLifetime * 3}
+				return nil
+			})
+
+			mockClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&operationsv1alpha1.Bastion{}))
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: bastionName}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("should delete Bastions with missing shoots", func() {
 			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: shootName}, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).Return(apierrors.NewNotFound(schema.GroupResource{}, ""))
 
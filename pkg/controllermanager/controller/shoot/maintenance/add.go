@@ -31,6 +31,8 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
+	r.rolloutStatsCache = newRolloutStatsCache(r.Clock)
+	r.rolloutDeferrals = newRolloutDeferralStates()
 
 	return builder.
 		ControllerManagedBy(mgr).
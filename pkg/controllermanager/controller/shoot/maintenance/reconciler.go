@@ -41,6 +41,9 @@ type Reconciler struct {
 	Config   config.ShootMaintenanceControllerConfiguration
 	Clock    clock.Clock
 	Recorder record.EventRecorder
+
+	rolloutStatsCache *rolloutStatsCache
+	rolloutDeferrals  *rolloutDeferralStates
 }
 
 // Reconcile reconciles Shoots and maintains them by updating versions or triggering operations.
@@ -54,6 +57,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			r.rolloutDeferrals.forget(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -61,6 +65,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if shoot.DeletionTimestamp != nil {
 		log.V(1).Info("Skipping Shoot because it is marked for deletion")
+		r.rolloutDeferrals.forget(request.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
@@ -118,15 +123,20 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		return err
 	}
 
+	policy, err := newRolloutPolicy(r.Client, r.rolloutStatsCache, r.Config.AutoUpdateRollout)
+	if err != nil {
+		return err
+	}
+
 	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
+		workerToMachineImageUpdate, err = maintainMachineImages(ctx, log, maintainedShoot, cloudProfile, policy)
 		if err != nil {
 			// continue execution to allow the kubernetes version update
 			log.Error(err, "Failed to maintain Shoot machine images")
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, policy.kubernetesVersionAllowed(ctx, shoot), func(v string) (string, error) {
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -165,7 +175,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, policy.kubernetesVersionAllowed(ctx, shoot), func(v string) (string, error) {
 			workerPoolSemver, err := semver.NewVersion(v)
 			if err != nil {
 				return "", err
@@ -338,6 +348,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
 	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
 
+	if policy != nil && r.rolloutDeferrals.changed(client.ObjectKeyFromObject(shoot), policy.deferrals) {
+		for _, deferral := range policy.deferrals {
+			r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventMaintenanceRolloutDeferred, deferral)
+		}
+	}
+
 	log.Info("Shoot maintenance completed")
 	return nil
 }
@@ -455,8 +471,9 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanc
 	}
 }
 
-// maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+// maintainMachineImages updates the machine images of a Shoot's worker pools if necessary. Automatic updates are only
+// performed if the given rollout policy allows them, forceful updates of expired versions are always performed.
+func maintainMachineImages(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, policy *rolloutPolicy) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -478,7 +495,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			return nil, err
 		}
 
-		filteredMachineImageVersionsFromCloudProfile := filterForArchitecture(&machineImageFromCloudProfile, worker.Machine.Architecture)
+		filteredMachineImageVersionsFromCloudProfile := filterForArchitectures(&machineImageFromCloudProfile, v1beta1helper.GetMachineArchitectures(worker.Machine))
 		filteredMachineImageVersionsFromCloudProfile = filterForCRI(filteredMachineImageVersionsFromCloudProfile, worker.CRI)
 		filteredMachineImageVersionsFromCloudProfile = filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion)
 
@@ -503,6 +520,11 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			continue
 		}
 
+		if !isExpired && !policy.machineImageVersionAllowed(ctx, shoot, workerImage.Name, updatedMachineImageVersion) {
+			workerLog.Info("MachineImage update deferred by rollout policy", "newVersion", updatedMachineImageVersion)
+			continue
+		}
+
 		workerLog.Info("MachineImage will be updated", "newVersion", updatedMachineImageVersion, "reason", reason)
 		maintenanceResults[worker.Name] = updateResult{
 			description:  fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
@@ -517,8 +539,9 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	return maintenanceResults, nil
 }
 
-// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
+// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done.
+// Automatic updates are only performed if 'rolloutAllowed' is nil or returns true for the new version.
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, rolloutAllowed func(string) bool, updateFunc func(string) (string, error)) (*updateResult, error) {
 	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
 	if err != nil {
 		return nil, err
@@ -540,6 +563,11 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 		return nil, nil
 	}
 
+	if !isExpired && rolloutAllowed != nil && !rolloutAllowed(updatedKubernetesVersion) {
+		log.Info("Kubernetes version update deferred by rollout policy", "version", kubernetesVersion, "newVersion", updatedKubernetesVersion)
+		return nil, nil
+	}
+
 	// In case the updatedKubernetesVersion for workerpool is higher than the controlplane version, actualUpdatedKubernetesVersion is set to controlplane version
 	actualUpdatedKubernetesVersion, err := updateFunc(updatedKubernetesVersion)
 	if err != nil {
@@ -626,7 +654,8 @@ func getOperation(shoot *gardencorev1beta1.Shoot) string {
 	return operation
 }
 
-func filterForArchitecture(machineImageFromCloudProfile *gardencorev1beta1.MachineImage, arch *string) *gardencorev1beta1.MachineImage {
+// filterForArchitectures returns the versions of the given machine image which support all the given architectures.
+func filterForArchitectures(machineImageFromCloudProfile *gardencorev1beta1.MachineImage, architectures []string) *gardencorev1beta1.MachineImage {
 	filteredMachineImages := gardencorev1beta1.MachineImage{
 		Name:           machineImageFromCloudProfile.Name,
 		UpdateStrategy: machineImageFromCloudProfile.UpdateStrategy,
@@ -634,7 +663,7 @@ func filterForArchitecture(machineImageFromCloudProfile *gardencorev1beta1.Machi
 	}
 
 	for _, cloudProfileVersion := range machineImageFromCloudProfile.Versions {
-		if slices.Contains(cloudProfileVersion.Architectures, *arch) {
+		if gardenerutils.SupportsArchitectures(cloudProfileVersion.Architectures, architectures) {
 			filteredMachineImages.Versions = append(filteredMachineImages.Versions, cloudProfileVersion)
 		}
 	}
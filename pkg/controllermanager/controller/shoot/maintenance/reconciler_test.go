@@ -5,6 +5,7 @@
 package maintenance
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 
 var _ = Describe("Shoot Maintenance", func() {
 	var (
+		ctx = context.TODO()
 		log logr.Logger
 		now time.Time
 
@@ -134,7 +136,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			})
 
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available", func() {
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -170,7 +172,43 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				shoot.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
+			})
+
+			It("should update machine image version to overall latest supporting the architectures of all machine types. Auto update: alternative machine types with different architecture", func() {
+				expectedVersion := "1.1.2"
+
+				// set all version for image only compatible with amd64
+				for i := 0; i < len(cloudProfile.Spec.MachineImages[0].Versions); i++ {
+					cloudProfile.Spec.MachineImages[0].Versions[i].Architectures = []string{"amd64"}
+				}
+
+				// add relevant multi-arch image to be updated to
+				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, gardencorev1beta1.MachineImageVersion{
+					ExpirableVersion: gardencorev1beta1.ExpirableVersion{
+						Version:        expectedVersion,
+						ExpirationDate: &expirationDateInTheFuture,
+					},
+					CRI:           []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+					Architectures: []string{"amd64", "arm64"},
+				})
+
+				// add overall higher version which does not support the architecture of the alternative machine type (should be ignored)
+				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, gardencorev1beta1.MachineImageVersion{
+					ExpirableVersion: gardencorev1beta1.ExpirableVersion{
+						Version:        "1.7.1",
+						ExpirationDate: &expirationDateInTheFuture,
+					},
+					CRI:           []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+					Architectures: []string{"amd64"},
+				})
+
+				shoot.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("amd64")
+				shoot.Spec.Provider.Workers[0].Machine.AlternativeTypes = []gardencorev1beta1.AlternativeMachineType{{Type: "arm-type", Architecture: ptr.To("arm64")}}
+
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
 			})
@@ -213,7 +251,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, otherWorker)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -233,7 +271,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestForMinor)
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -243,7 +281,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -278,7 +316,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -294,7 +332,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -302,7 +340,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should not change version: already on highest version.", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &overallLatestVersion
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -337,7 +375,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -393,7 +431,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -436,7 +474,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchNextMinor)
@@ -472,7 +510,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewPatchVersionNplusTwoMinor.Version)
@@ -517,7 +555,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expiredPatchVersionNextMinor.Version)
@@ -551,7 +589,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMinor
 				expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 			})
@@ -566,7 +604,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -618,7 +656,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchCurrentMinor)
@@ -667,7 +705,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -717,7 +755,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -763,7 +801,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &latestVersionForCurrentMajor
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionNextMajor)
@@ -799,7 +837,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewVersionNplusTwoMajor.Version)
@@ -815,7 +853,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -851,7 +889,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMajor
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForMajor)
@@ -861,7 +899,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should treat workers with `cri: nil` like `cri.name: containerd` and not update if `containerd` is not explicitly supported by the machine image", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
 		})
@@ -870,7 +908,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
@@ -881,7 +919,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers[0].CRI = &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -894,7 +932,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-cri-config", Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -910,7 +948,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update to the highest patch version of the same minor
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-containerruntime", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -925,7 +963,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor-and-kata", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-container"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -938,7 +976,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Kubernetes.Version = "1.26.0"
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -947,7 +985,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].KubeletVersionConstraint = ptr.To("< 1.26")
 			shoot.Spec.Kubernetes.Version = "1.25.1"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 		})
@@ -960,7 +998,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -973,7 +1011,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				Version: ptr.To("1.26.0"),
 			}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", cloudProfile.Spec.MachineImages[0].Versions[1].Version)
 		})
@@ -981,7 +1019,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.name) machine image defined", func() {
 			cloudProfile.Spec.MachineImages = cloudProfile.Spec.MachineImages[1:]
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(ctx, log, shoot, cloudProfile, nil)
 
 			Expect(err).To(HaveOccurred())
 		})
@@ -1050,7 +1088,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1068,7 +1106,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// mark latest version 1.02 as preview
 			cloudProfile.Spec.Kubernetes.Versions[3].Classification = &previewClassification
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1082,7 +1120,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1096,7 +1134,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1105,6 +1143,34 @@ var _ = Describe("Shoot Maintenance", func() {
 			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.2"))
 		})
 
+		It("should not update the shoot kubernetes version if the automatic update is deferred by the rollout policy", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
+
+			result, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(string) bool { return false }, func(v string) (string, error) {
+				shoot.Spec.Kubernetes.Version = v
+				return v, nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.1"))
+		})
+
+		It("should update an expired shoot kubernetes version even if the rollout policy defers automatic updates", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInThePast
+			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
+
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(string) bool { return false }, func(v string) (string, error) {
+				shoot.Spec.Kubernetes.Version = v
+				return v, nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.2"))
+		})
+
 		// special case when all the patch versions of the consecutive minor versions are expired
 		It("should determine that the shoot kubernetes version must be maintained - ForceUpdate to latest qualifying patch version (is expired) of next minor version.", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
@@ -1117,7 +1183,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[1].ExpirationDate = &expirationDateInThePast
 			cloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate = &expirationDateInThePast
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1135,7 +1201,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1147,7 +1213,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1161,7 +1227,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInTheFuture
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1175,7 +1241,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.0"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1189,7 +1255,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1203,7 +1269,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.1.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
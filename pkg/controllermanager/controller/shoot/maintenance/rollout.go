@@ -0,0 +1,410 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// canaryWave is the wave of shoots which receive automatic updates first.
+	canaryWave = 0
+	// rolloutStatsTTL is the duration for which the rollout statistics of an update are reused.
+	rolloutStatsTTL = time.Minute
+)
+
+// rolloutPolicy decides whether an automatic update to a certain version may be rolled out to a shoot. Shoots are
+// distributed to a canary wave and a configurable number of subsequent waves. A version is only rolled out to a wave
+// if enough shoots of the previous wave already run it and are healthy, and if not too many shoots running it are
+// unhealthy. A nil rolloutPolicy allows all updates.
+type rolloutPolicy struct {
+	reader         client.Reader
+	cache          *rolloutStatsCache
+	config         *config.AutoUpdateRolloutConfiguration
+	canarySelector labels.Selector
+
+	// deferrals contains messages about automatic updates which were deferred by the rollout policy.
+	deferrals []string
+}
+
+// newRolloutPolicy returns a new rolloutPolicy for the given configuration. It returns nil if no configuration is
+// given. The statistics of the shoots are computed at most once per update and rolloutStatsTTL if a cache is given.
+func newRolloutPolicy(reader client.Reader, cache *rolloutStatsCache, cfg *config.AutoUpdateRolloutConfiguration) (*rolloutPolicy, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	canarySelector := labels.Nothing()
+	if cfg.CanarySelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(cfg.CanarySelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing canary selector: %w", err)
+		}
+		canarySelector = selector
+	}
+
+	return &rolloutPolicy{
+		reader:         reader,
+		cache:          cache,
+		config:         cfg,
+		canarySelector: canarySelector,
+	}, nil
+}
+
+// wave returns the wave the given shoot is assigned to.
+func (p *rolloutPolicy) wave(shoot *gardencorev1beta1.Shoot) int {
+	if p.canarySelector.Matches(labels.Set(shoot.Labels)) ||
+		(shoot.Spec.Purpose != nil && slices.Contains(p.config.CanaryPurposes, string(*shoot.Spec.Purpose))) {
+		return canaryWave
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(shoot.UID))
+	return 1 + int(h.Sum32()%uint32(ptr.Deref(p.config.Waves, 1)))
+}
+
+// kubernetesVersionAllowed returns a function which checks whether an automatic update of the given shoot's Kubernetes
+// version to a version may be rolled out now.
+func (p *rolloutPolicy) kubernetesVersionAllowed(ctx context.Context, shoot *gardencorev1beta1.Shoot) func(string) bool {
+	if p == nil {
+		return nil
+	}
+
+	return func(version string) bool {
+		return p.allowed(ctx, shoot, fmt.Sprintf("Kubernetes version %q", version),
+			func(s *gardencorev1beta1.Shoot) bool {
+				return slices.Contains(kubernetesVersions(s), version)
+			},
+			func(s *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) bool {
+				if s.Spec.Maintenance == nil || s.Spec.Maintenance.AutoUpdate == nil || !s.Spec.Maintenance.AutoUpdate.KubernetesVersion {
+					return false
+				}
+				// The shoot only receives the version if the automatic update of one of its versions targets it.
+				return slices.ContainsFunc(kubernetesVersions(s), func(v string) bool {
+					targetVersion, err := determineKubernetesVersion(v, cloudProfile, false)
+					return err == nil && targetVersion == version
+				})
+			},
+		)
+	}
+}
+
+// machineImageVersionAllowed checks whether an automatic update of the given shoot's machine image with the given name
+// to a version may be rolled out now.
+func (p *rolloutPolicy) machineImageVersionAllowed(ctx context.Context, shoot *gardencorev1beta1.Shoot, imageName, version string) bool {
+	if p == nil {
+		return true
+	}
+
+	return p.allowed(ctx, shoot, fmt.Sprintf("machine image %q version %q", imageName, version),
+		func(s *gardencorev1beta1.Shoot) bool {
+			return slices.ContainsFunc(s.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+				return worker.Machine.Image != nil && worker.Machine.Image.Name == imageName && ptr.Deref(worker.Machine.Image.Version, "") == version
+			})
+		},
+		func(s *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) bool {
+			if s.Spec.Maintenance == nil || s.Spec.Maintenance.AutoUpdate == nil || !ptr.Deref(s.Spec.Maintenance.AutoUpdate.MachineImageVersion, false) {
+				return false
+			}
+			// The shoot only receives the version if the automatic update of one of its worker pools targets it, i.e., if
+			// the version is available for the pool and matches the update strategy of the machine image.
+			return slices.ContainsFunc(s.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+				return worker.Machine.Image != nil && worker.Machine.Image.Name == imageName && machineImageUpdateTarget(s, worker, cloudProfile) == version
+			})
+		},
+	)
+}
+
+// machineImageUpdateTarget returns the version the machine image of the given worker pool is automatically updated to.
+// It returns an empty string if there is no such version.
+func machineImageUpdateTarget(shoot *gardencorev1beta1.Shoot, worker gardencorev1beta1.Worker, cloudProfile *gardencorev1beta1.CloudProfile) string {
+	machineImage, err := determineMachineImage(cloudProfile, worker.Machine.Image)
+	if err != nil || machineImage.UpdateStrategy == nil {
+		return ""
+	}
+
+	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return ""
+	}
+	kubeletVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(controlPlaneVersion, worker.Kubernetes)
+	if err != nil {
+		return ""
+	}
+
+	filteredMachineImage := filterForArchitectures(&machineImage, v1beta1helper.GetMachineArchitectures(worker.Machine))
+	filteredMachineImage = filterForCRI(filteredMachineImage, worker.CRI)
+	filteredMachineImage = filterForKubeleteVersionConstraint(filteredMachineImage, kubeletVersion)
+
+	version, err := determineMachineImageVersion(worker.Machine.Image, filteredMachineImage, false)
+	if err != nil {
+		return ""
+	}
+	return version
+}
+
+// allowed checks whether the update described by 'update' may be rolled out to the given shoot. 'runsVersion' returns
+// whether a shoot already runs the version, 'eligible' returns whether a shoot would receive the update eventually.
+func (p *rolloutPolicy) allowed(ctx context.Context, shoot *gardencorev1beta1.Shoot, update string, runsVersion func(*gardencorev1beta1.Shoot) bool, eligible func(*gardencorev1beta1.Shoot, *gardencorev1beta1.CloudProfile) bool) bool {
+	stats, err := p.cache.get(update, func() (*rolloutStats, error) {
+		return p.computeStats(ctx, runsVersion, eligible)
+	})
+	if err != nil {
+		p.deferrals = append(p.deferrals, fmt.Sprintf("Rollout of %s is pending because its state could not be determined: %v", update, err))
+		return false
+	}
+
+	if stats.running > 0 && stats.failed*100 > stats.running*ptr.Deref(p.config.MaxFailurePercentage, 0) {
+		p.deferrals = append(p.deferrals, fmt.Sprintf("Rollout of %s is paused because %d/%d shoots running it are unhealthy", update, stats.failed, stats.running))
+		return false
+	}
+
+	wave := p.wave(shoot)
+	if wave == canaryWave {
+		return true
+	}
+
+	previousEligible, previousDone := stats.eligible[wave-1], stats.done[wave-1]
+	if previousEligible > 0 && previousDone*100 < previousEligible*ptr.Deref(p.config.MinHealthyPercentage, 0) {
+		p.deferrals = append(p.deferrals, fmt.Sprintf("Rollout of %s to wave %d is pending because only %d/%d shoots of the previous wave run it and are healthy", update, wave, previousDone, previousEligible))
+		return false
+	}
+
+	return true
+}
+
+// computeStats lists all shoots once and computes the rollout statistics of an update.
+func (p *rolloutPolicy) computeStats(ctx context.Context, runsVersion func(*gardencorev1beta1.Shoot) bool, eligible func(*gardencorev1beta1.Shoot, *gardencorev1beta1.CloudProfile) bool) (*rolloutStats, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := p.reader.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		stats         = &rolloutStats{eligible: map[int]int{}, done: map[int]int{}}
+		cloudProfiles = map[string]*gardencorev1beta1.CloudProfile{}
+	)
+
+	for i := range shootList.Items {
+		s := &shootList.Items[i]
+
+		runs := runsVersion(s)
+		if runs {
+			stats.running++
+			if shootFailed(s) {
+				stats.failed++
+			}
+		}
+
+		if !runs {
+			cloudProfile, err := p.cloudProfileForShoot(ctx, cloudProfiles, s)
+			if err != nil {
+				return nil, err
+			}
+			if cloudProfile == nil || !eligible(s, cloudProfile) {
+				continue
+			}
+		}
+
+		wave := p.wave(s)
+		stats.eligible[wave]++
+		if runs && shootHealthStatus(s) == gardenerutils.ShootStatusHealthy {
+			stats.done[wave]++
+		}
+	}
+
+	return stats, nil
+}
+
+// cloudProfileForShoot returns the cloud profile of the given shoot, using the given map to read every cloud profile at
+// most once. It returns nil if the cloud profile does not exist.
+func (p *rolloutPolicy) cloudProfileForShoot(ctx context.Context, cloudProfiles map[string]*gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error) {
+	reference := gardenerutils.BuildCloudProfileReference(shoot)
+	if reference == nil {
+		return nil, nil
+	}
+
+	key := reference.Kind + "/" + reference.Name
+	if reference.Kind == v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile {
+		key = reference.Kind + "/" + shoot.Namespace + "/" + reference.Name
+	}
+
+	if cloudProfile, ok := cloudProfiles[key]; ok {
+		return cloudProfile, nil
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, p.reader, shoot)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed reading cloud profile %q: %w", key, err)
+		}
+		cloudProfile = nil
+	}
+
+	cloudProfiles[key] = cloudProfile
+	return cloudProfile, nil
+}
+
+// rolloutStats contains the statistics of the shoots relevant for the rollout of an update.
+type rolloutStats struct {
+	// running is the number of shoots running the version, failed is the number of them which are unhealthy.
+	running, failed int
+	// eligible is the number of shoots per wave which run the version or would receive it eventually, done is the
+	// number of them which run the version and are healthy.
+	eligible, done map[int]int
+}
+
+// rolloutStatsCache caches the rollout statistics of updates for rolloutStatsTTL. This way, the statistics are computed
+// once for all shoots maintained at about the same time instead of listing all shoots for every maintained shoot.
+type rolloutStatsCache struct {
+	clock clock.Clock
+
+	lock    sync.Mutex
+	entries map[string]*rolloutStatsCacheEntry
+}
+
+// rolloutStatsCacheEntry contains the statistics of an update. done is closed once they are computed, expires is zero
+// while they are being computed.
+type rolloutStatsCacheEntry struct {
+	done    chan struct{}
+	stats   *rolloutStats
+	err     error
+	expires time.Time
+}
+
+func newRolloutStatsCache(clock clock.Clock) *rolloutStatsCache {
+	return &rolloutStatsCache{clock: clock, entries: map[string]*rolloutStatsCacheEntry{}}
+}
+
+// get returns the cached statistics for the given update or computes them if they are missing or expired. The
+// statistics are computed without holding the lock, concurrent callers for the same update wait for the computation of
+// the first caller and share its result. Errors are returned to all waiting callers but not cached. A nil cache always
+// computes the statistics.
+func (c *rolloutStatsCache) get(update string, compute func() (*rolloutStats, error)) (*rolloutStats, error) {
+	if c == nil {
+		return compute()
+	}
+
+	c.lock.Lock()
+	now := c.clock.Now()
+	if entry, ok := c.entries[update]; ok && (entry.expires.IsZero() || now.Before(entry.expires)) {
+		c.lock.Unlock()
+		<-entry.done
+		return entry.stats, entry.err
+	}
+
+	for key, entry := range c.entries {
+		if !entry.expires.IsZero() && !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+	entry := &rolloutStatsCacheEntry{done: make(chan struct{})}
+	c.entries[update] = entry
+	c.lock.Unlock()
+
+	stats, err := compute()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry.stats, entry.err = stats, err
+	if err != nil {
+		delete(c.entries, update)
+	} else {
+		entry.expires = c.clock.Now().Add(rolloutStatsTTL)
+	}
+	close(entry.done)
+
+	return stats, err
+}
+
+func kubernetesVersions(shoot *gardencorev1beta1.Shoot) []string {
+	versions := []string{shoot.Spec.Kubernetes.Version}
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			versions = append(versions, *worker.Kubernetes.Version)
+		}
+	}
+	return versions
+}
+
+func shootHealthStatus(shoot *gardencorev1beta1.Shoot) gardenerutils.ShootStatus {
+	if value, ok := shoot.Labels[v1beta1constants.ShootStatus]; ok {
+		return gardenerutils.ShootStatus(value)
+	}
+	return gardenerutils.ShootStatusProgressing
+}
+
+func shootFailed(shoot *gardencorev1beta1.Shoot) bool {
+	return shootHealthStatus(shoot) == gardenerutils.ShootStatusUnhealthy ||
+		(shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed)
+}
+
+// rolloutDeferralStates remembers the deferrals of the last maintenance of every shoot. This way, deferral events are
+// only emitted if the deferrals of a shoot change and not whenever the shoot is maintained again.
+type rolloutDeferralStates struct {
+	lock   sync.Mutex
+	states map[types.NamespacedName][]string
+}
+
+func newRolloutDeferralStates() *rolloutDeferralStates {
+	return &rolloutDeferralStates{states: map[types.NamespacedName][]string{}}
+}
+
+// changed stores the given deferrals of a shoot and returns whether they differ from the previously stored ones. A nil
+// rolloutDeferralStates always reports a change.
+func (s *rolloutDeferralStates) changed(key types.NamespacedName, deferrals []string) bool {
+	if s == nil {
+		return true
+	}
+
+	deferrals = slices.Sorted(slices.Values(deferrals))
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if slices.Equal(s.states[key], deferrals) {
+		return false
+	}
+
+	if len(deferrals) == 0 {
+		delete(s.states, key)
+	} else {
+		s.states[key] = deferrals
+	}
+	return true
+}
+
+// forget removes the stored deferrals of a shoot.
+func (s *rolloutDeferralStates) forget(key types.NamespacedName) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.states, key)
+}
@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+var _ = Describe("Rollout policy", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		cfg        *config.AutoUpdateRolloutConfiguration
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	newShoot := func(name string, labels map[string]string, kubernetesVersion, imageVersion string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "garden-foo",
+				UID:       types.UID(name),
+				Labels:    labels,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("profile"),
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: kubernetesVersion},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{
+						KubernetesVersion:   true,
+						MachineImageVersion: ptr.To(true),
+					},
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name: "worker",
+						Machine: gardencorev1beta1.Machine{
+							Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To(imageVersion)},
+							Architecture: ptr.To("amd64"),
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	healthy := map[string]string{v1beta1constants.ShootStatus: "healthy"}
+	unhealthy := map[string]string{v1beta1constants.ShootStatus: "unhealthy"}
+	canary := map[string]string{"canary": "true", v1beta1constants.ShootStatus: "healthy"}
+	unhealthyCanary := map[string]string{"canary": "true", v1beta1constants.ShootStatus: "unhealthy"}
+
+	newCloudProfile := func(name string, kubernetesVersions []string, imageVersions ...gardencorev1beta1.MachineImageVersion) *gardencorev1beta1.CloudProfile {
+		cloudProfile := &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name:           "gardenlinux",
+					UpdateStrategy: ptr.To(gardencorev1beta1.UpdateStrategyMajor),
+					Versions:       imageVersions,
+				}},
+			},
+		}
+		for _, version := range kubernetesVersions {
+			cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, gardencorev1beta1.ExpirableVersion{Version: version})
+		}
+		return cloudProfile
+	}
+
+	imageVersion := func(version string, architectures ...string) gardencorev1beta1.MachineImageVersion {
+		return gardencorev1beta1.MachineImageVersion{
+			ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: version},
+			CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+			Architectures:    architectures,
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		Expect(fakeClient.Create(ctx, newCloudProfile("profile", []string{"1.29.5", "1.30.1", "1.30.2"}, imageVersion("1.0.0", "amd64", "arm64"), imageVersion("1.1.0", "amd64")))).To(Succeed())
+
+		cfg = &config.AutoUpdateRolloutConfiguration{
+			CanarySelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+			Waves:                ptr.To(1),
+			MinHealthyPercentage: ptr.To(50),
+			MaxFailurePercentage: ptr.To(10),
+		}
+
+		shoot = newShoot("shoot", nil, "1.30.1", "1.0.0")
+	})
+
+	create := func(shoots ...*gardencorev1beta1.Shoot) {
+		for _, s := range shoots {
+			Expect(fakeClient.Create(ctx, s)).To(Succeed())
+		}
+	}
+
+	Describe("#newRolloutPolicy", func() {
+		It("should return nil if no configuration is given", func() {
+			policy, err := newRolloutPolicy(fakeClient, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).To(BeNil())
+
+			Expect(policy.kubernetesVersionAllowed(ctx, shoot)).To(BeNil())
+			Expect(policy.machineImageVersionAllowed(ctx, shoot, "gardenlinux", "1.1.0")).To(BeTrue())
+		})
+
+		It("should fail if the canary selector is invalid", func() {
+			cfg.CanarySelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			_, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing canary selector")))
+		})
+	})
+
+	Describe("#wave", func() {
+		It("should assign shoots matching the canary selector to the canary wave", func() {
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.wave(newShoot("canary", canary, "1.30.1", "1.0.0"))).To(Equal(canaryWave))
+			Expect(policy.wave(shoot)).To(Equal(1))
+		})
+
+		It("should assign shoots with a canary purpose to the canary wave", func() {
+			cfg.CanaryPurposes = []string{"evaluation"}
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeEvaluation)
+			Expect(policy.wave(shoot)).To(Equal(canaryWave))
+		})
+
+		It("should distribute other shoots to the configured waves", func() {
+			cfg.Waves = ptr.To(3)
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			wave := policy.wave(shoot)
+			Expect(wave).To(And(BeNumerically(">=", 1), BeNumerically("<=", 3)))
+			Expect(policy.wave(shoot)).To(Equal(wave))
+		})
+	})
+
+	Describe("#kubernetesVersionAllowed", func() {
+		It("should always allow updates for canary shoots", func() {
+			create(newShoot("other", nil, "1.30.1", "1.0.0"))
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.kubernetesVersionAllowed(ctx, newShoot("canary", canary, "1.30.1", "1.0.0"))("1.30.2")).To(BeTrue())
+			Expect(policy.deferrals).To(BeEmpty())
+		})
+
+		It("should defer the update if the canary shoots do not run the version yet", func() {
+			create(
+				newShoot("canary-1", canary, "1.30.2", "1.0.0"),
+				newShoot("canary-2", canary, "1.30.1", "1.0.0"),
+				newShoot("canary-3", canary, "1.30.1", "1.0.0"),
+			)
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.kubernetesVersionAllowed(ctx, shoot)("1.30.2")).To(BeFalse())
+			Expect(policy.deferrals).To(ConsistOf(ContainSubstring("only 1/3 shoots of the previous wave run it and are healthy")))
+		})
+
+		It("should allow the update if enough canary shoots run the version and are healthy", func() {
+			create(
+				newShoot("canary-1", canary, "1.30.2", "1.0.0"),
+				newShoot("canary-2", canary, "1.30.2", "1.0.0"),
+				newShoot("canary-3", canary, "1.30.1", "1.0.0"),
+				newShoot("canary-4", canary, "1.29.5", "1.0.0"),
+			)
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.kubernetesVersionAllowed(ctx, shoot)("1.30.2")).To(BeTrue())
+			Expect(policy.deferrals).To(BeEmpty())
+		})
+
+		It("should pause the rollout if too many shoots running the version are unhealthy", func() {
+			create(
+				newShoot("canary-1", canary, "1.30.2", "1.0.0"),
+				newShoot("canary-2", unhealthyCanary, "1.30.2", "1.0.0"),
+			)
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.kubernetesVersionAllowed(ctx, newShoot("canary-3", canary, "1.30.1", "1.0.0"))("1.30.2")).To(BeFalse())
+			Expect(policy.deferrals).To(ConsistOf(ContainSubstring("is paused because 1/2 shoots running it are unhealthy")))
+		})
+
+		It("should not wait for shoots whose cloud profile does not offer the version", func() {
+			Expect(fakeClient.Create(ctx, newCloudProfile("other-profile", []string{"1.30.1"}))).To(Succeed())
+			otherCanary := newShoot("canary-2", canary, "1.30.1", "1.0.0")
+			otherCanary.Spec.CloudProfileName = ptr.To("other-profile")
+			create(newShoot("canary-1", canary, "1.30.2", "1.0.0"), otherCanary)
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.kubernetesVersionAllowed(ctx, shoot)("1.30.2")).To(BeTrue())
+			Expect(policy.deferrals).To(BeEmpty())
+		})
+
+		It("should reuse the statistics of an update until they expire", func() {
+			fakeClock := testclock.NewFakeClock(time.Now())
+			cache := newRolloutStatsCache(fakeClock)
+			create(newShoot("canary-1", canary, "1.30.2", "1.0.0"))
+
+			policy, err := newRolloutPolicy(fakeClient, cache, cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.kubernetesVersionAllowed(ctx, shoot)("1.30.2")).To(BeTrue())
+
+			create(newShoot("canary-2", canary, "1.30.1", "1.0.0"), newShoot("canary-3", canary, "1.30.1", "1.0.0"))
+			Expect(policy.kubernetesVersionAllowed(ctx, shoot)("1.30.2")).To(BeTrue())
+
+			fakeClock.Step(rolloutStatsTTL)
+			Expect(policy.kubernetesVersionAllowed(ctx, shoot)("1.30.2")).To(BeFalse())
+			Expect(policy.deferrals).To(ConsistOf(ContainSubstring("only 1/3 shoots of the previous wave run it and are healthy")))
+		})
+	})
+
+	Describe("#machineImageVersionAllowed", func() {
+		It("should defer the update if the canary shoots running the version are not healthy", func() {
+			cfg.MaxFailurePercentage = ptr.To(100)
+			create(
+				newShoot("canary-1", unhealthyCanary, "1.30.1", "1.1.0"),
+				newShoot("other", unhealthy, "1.30.1", "1.0.0"),
+			)
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.machineImageVersionAllowed(ctx, shoot, "gardenlinux", "1.1.0")).To(BeFalse())
+			Expect(policy.deferrals).To(ConsistOf(ContainSubstring(`machine image "gardenlinux" version "1.1.0" to wave 1 is pending`)))
+		})
+
+		It("should allow the update if no canary shoot uses the machine image", func() {
+			canaryShoot := newShoot("canary-1", canary, "1.30.1", "1.0.0")
+			canaryShoot.Spec.Provider.Workers[0].Machine.Image.Name = "suse-chost"
+			create(canaryShoot, newShoot("other", healthy, "1.30.1", "1.1.0"))
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.machineImageVersionAllowed(ctx, shoot, "gardenlinux", "1.1.0")).To(BeTrue())
+		})
+
+		It("should not wait for shoots whose worker pools do not support the version", func() {
+			armCanary := newShoot("canary-2", canary, "1.30.1", "1.0.0")
+			armCanary.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
+			create(newShoot("canary-1", canary, "1.30.1", "1.1.0"), armCanary)
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.machineImageVersionAllowed(ctx, shoot, "gardenlinux", "1.1.0")).To(BeTrue())
+			Expect(policy.deferrals).To(BeEmpty())
+		})
+
+		It("should wait for shoots whose worker pools receive the version", func() {
+			create(newShoot("canary-1", canary, "1.30.1", "1.1.0"), newShoot("canary-2", canary, "1.30.1", "1.0.0"), newShoot("canary-3", canary, "1.30.1", "1.0.0"))
+			policy, err := newRolloutPolicy(fakeClient, nil, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(policy.machineImageVersionAllowed(ctx, shoot, "gardenlinux", "1.1.0")).To(BeFalse())
+			Expect(policy.deferrals).To(ConsistOf(ContainSubstring("only 1/3 shoots of the previous wave run it and are healthy")))
+		})
+	})
+
+	Describe("#rolloutStatsCache", func() {
+		var fakeClock *testclock.FakeClock
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Now())
+		})
+
+		It("should compute the statistics of an update once for concurrent callers", func() {
+			var (
+				cache    = newRolloutStatsCache(fakeClock)
+				release  = make(chan struct{})
+				computed = make(chan struct{}, 2)
+				results  = make(chan *rolloutStats, 2)
+				stats    = &rolloutStats{running: 1}
+			)
+
+			compute := func() (*rolloutStats, error) {
+				computed <- struct{}{}
+				<-release
+				return stats, nil
+			}
+
+			go func() {
+				defer GinkgoRecover()
+				s, err := cache.get("update", compute)
+				Expect(err).NotTo(HaveOccurred())
+				results <- s
+			}()
+			Eventually(computed).Should(Receive())
+
+			go func() {
+				defer GinkgoRecover()
+				s, err := cache.get("update", compute)
+				Expect(err).NotTo(HaveOccurred())
+				results <- s
+			}()
+
+			By("Computing the statistics of another update while the first computation is running")
+			otherStats, err := cache.get("other-update", func() (*rolloutStats, error) { return &rolloutStats{}, nil })
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherStats).NotTo(BeIdenticalTo(stats))
+
+			close(release)
+			Eventually(results).Should(Receive(BeIdenticalTo(stats)))
+			Eventually(results).Should(Receive(BeIdenticalTo(stats)))
+			Consistently(computed).ShouldNot(Receive())
+		})
+
+		It("should not cache errors", func() {
+			cache := newRolloutStatsCache(fakeClock)
+
+			_, err := cache.get("update", func() (*rolloutStats, error) { return nil, errors.New("fake") })
+			Expect(err).To(MatchError("fake"))
+
+			stats := &rolloutStats{}
+			Expect(cache.get("update", func() (*rolloutStats, error) { return stats, nil })).To(BeIdenticalTo(stats))
+		})
+	})
+
+	Describe("#rolloutDeferralStates", func() {
+		It("should only report changed deferrals", func() {
+			var (
+				states = newRolloutDeferralStates()
+				key    = types.NamespacedName{Namespace: "garden-foo", Name: "foo"}
+			)
+
+			Expect(states.changed(key, nil)).To(BeFalse())
+			Expect(states.changed(key, []string{"a", "b"})).To(BeTrue())
+			Expect(states.changed(key, []string{"b", "a"})).To(BeFalse())
+			Expect(states.changed(key, []string{"a"})).To(BeTrue())
+			Expect(states.changed(key, nil)).To(BeTrue())
+			Expect(states.changed(key, nil)).To(BeFalse())
+
+			Expect(states.changed(key, []string{"a"})).To(BeTrue())
+			states.forget(key)
+			Expect(states.changed(key, []string{"a"})).To(BeTrue())
+		})
+	})
+})
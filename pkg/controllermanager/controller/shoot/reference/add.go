@@ -62,6 +62,9 @@ func getReferencedSecretNames(obj client.Object) []string {
 
 	var out []string
 	out = append(out, secretNamesForDNSProviders(shoot)...)
+	if secretName := v1beta1helper.GetShootAuditWebhookSecretName(shoot.Spec.Kubernetes.KubeAPIServer); secretName != "" {
+		out = append(out, secretName)
+	}
 	out = append(out, namesForReferencedResources(shoot, "Secret")...)
 	return out
 }
@@ -76,6 +79,7 @@ func getReferencedConfigMapNames(obj client.Object) []string {
 	if configMapRef := getAuditPolicyConfigMapRef(shoot.Spec.Kubernetes.KubeAPIServer); configMapRef != nil {
 		out = append(out, configMapRef.Name)
 	}
+	out = append(out, v1beta1helper.GetShootAuditBackendPolicyConfigMapNames(shoot.Spec.Kubernetes.KubeAPIServer)...)
 	out = append(out, namesForReferencedResources(shoot, "ConfigMap")...)
 	return out
 }
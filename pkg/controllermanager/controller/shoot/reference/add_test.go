@@ -63,6 +63,19 @@ var _ = Describe("Add", func() {
 			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
 		})
 
+		It("should return true because the audit backends field changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig = &gardencorev1beta1.AuditConfig{
+				Backends: &gardencorev1beta1.AuditBackends{
+					Webhook: &gardencorev1beta1.AuditWebhookBackend{
+						SecretName: ptr.To("audit-webhook-credentials"),
+						Policy:     &gardencorev1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "audit-webhook-policy"}},
+					},
+				},
+			}
+			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
+		})
+
 		It("should return true because the resources field changed", func() {
 			oldShoot := shoot.DeepCopy()
 			shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
@@ -90,6 +90,21 @@ const (
 	// owner: @MartinWeindel @ScheererJ @axel7born @DockToFuture
 	// alpha: v1.104.0
 	NewVPN featuregate.Feature = "NewVPN"
+
+	// NodeAgentAttestation enables the attestation of machines during the bootstrapping of gardener-node-agent. Only
+	// machines which prove their identity with an attestation document of their infrastructure provider are issued
+	// credentials.
+	// alpha: v1.105.0
+	NodeAgentAttestation featuregate.Feature = "NodeAgentAttestation"
+
+	// NodeAgentRemediation enables the remediation of unhealthy nodes by gardener-node-agent. Detected problems are
+	// remediated by restarting units, cleaning the image cache, rebooting the node, or requesting its replacement.
+	// alpha: v1.105.0
+	NodeAgentRemediation featuregate.Feature = "NodeAgentRemediation"
+
+	// ShootAuditBackends enables the usage of the `auditConfig.backends` API in the kube-apiserver settings of shoots.
+	// alpha: v1.105.0
+	ShootAuditBackends featuregate.Feature = "ShootAuditBackends"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -129,6 +144,9 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	ShootCredentialsBinding:   {Default: false, PreRelease: featuregate.Alpha},
 	NewWorkerPoolHash:         {Default: false, PreRelease: featuregate.Alpha},
 	NewVPN:                    {Default: false, PreRelease: featuregate.Alpha},
+	NodeAgentAttestation:      {Default: false, PreRelease: featuregate.Alpha},
+	NodeAgentRemediation:      {Default: false, PreRelease: featuregate.Alpha},
+	ShootAuditBackends:        {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
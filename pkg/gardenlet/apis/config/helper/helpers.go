@@ -15,6 +15,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // SeedNameFromSeedConfig returns an empty string if the given seed config is nil, or the
@@ -115,3 +116,16 @@ func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *m
 	}
 	return nil
 }
+
+// ImageRewriteRules returns the image rewrite rules of the given config as rewrite rules for image vectors.
+func ImageRewriteRules(c *config.GardenletConfiguration) []imagevectorutils.RewriteRule {
+	if c == nil || len(c.ImageRewriteRules) == 0 {
+		return nil
+	}
+
+	rules := make([]imagevectorutils.RewriteRule, 0, len(c.ImageRewriteRules))
+	for _, rule := range c.ImageRewriteRules {
+		rules = append(rules, imagevectorutils.RewriteRule{Prefix: rule.Prefix, Mirror: rule.Mirror, Digests: rule.Digests})
+	}
+	return rules
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 var _ = Describe("helper", func() {
@@ -308,4 +309,25 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#ImageRewriteRules", func() {
+		It("should return nil if no rules are configured", func() {
+			Expect(ImageRewriteRules(nil)).To(BeNil())
+			Expect(ImageRewriteRules(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should convert the configured rules", func() {
+			Expect(ImageRewriteRules(&config.GardenletConfiguration{
+				ImageRewriteRules: []config.ImageRewriteRule{{
+					Prefix:  "registry.k8s.io",
+					Mirror:  "registry.example.com/k8s",
+					Digests: map[string]string{"registry.k8s.io/foo:v1": "sha256:abc"},
+				}},
+			})).To(Equal([]imagevectorutils.RewriteRule{{
+				Prefix:  "registry.k8s.io",
+				Mirror:  "registry.example.com/k8s",
+				Digests: map[string]string{"registry.k8s.io/foo:v1": "sha256:abc"},
+			}}))
+		})
+	})
 })
@@ -63,6 +63,9 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// ImageRewriteRules is a list of rules for rewriting the repositories of the images in the image vectors, e.g., to
+	// point them to a registry mirror in air-gapped landscapes. The first matching rule is applied.
+	ImageRewriteRules []ImageRewriteRule
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -174,6 +177,9 @@ type BackupEntryControllerConfiguration struct {
 	// DeletionGracePeriodShootPurposes is a list of shoot purposes for which the deletion grace period applies. All
 	// BackupEntries corresponding to Shoots with different purposes will be deleted immediately.
 	DeletionGracePeriodShootPurposes []gardencore.ShootPurpose
+	// ReplicationSyncPeriod is the duration how often BackupEntries are replicated to the secondary object store of
+	// their BackupBucket, if replication is configured for it.
+	ReplicationSyncPeriod *metav1.Duration
 }
 
 // BastionControllerConfiguration defines the configuration of the Bastion
@@ -573,3 +579,15 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// ImageRewriteRule contains a rule for rewriting the repositories of the images in the image vectors.
+type ImageRewriteRule struct {
+	// Prefix is the prefix of the image repositories which are rewritten, e.g.,
+	// `europe-docker.pkg.dev/gardener-project/releases`. It only matches complete path segments.
+	Prefix string
+	// Mirror replaces the prefix of the matching image repositories, e.g., `registry.example.com/gardener`.
+	Mirror string
+	// Digests optionally pins images to digests. The keys are the image references (`<repository>:<tag>`) before
+	// rewriting, the values are the digests (`sha256:...`) of the rewritten images.
+	Digests map[string]string
+}
@@ -228,6 +228,10 @@ func SetDefaults_BackupEntryControllerConfiguration(obj *BackupEntryControllerCo
 		v := DefaultBackupEntryDeletionGracePeriodHours
 		obj.DeletionGracePeriodHours = &v
 	}
+
+	if obj.ReplicationSyncPeriod == nil {
+		obj.ReplicationSyncPeriod = &metav1.Duration{Duration: 30 * time.Minute}
+	}
 }
 
 // SetDefaults_MonitoringConfig sets the defaults for the monitoring stack.
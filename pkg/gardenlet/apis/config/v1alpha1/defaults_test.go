@@ -198,6 +198,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.BackupEntry.ConcurrentSyncs).To(PointTo(Equal(20)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodHours).To(PointTo(Equal(0)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodShootPurposes).To(BeEmpty())
+			Expect(obj.Controllers.BackupEntry.ReplicationSyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
 		})
 
 		It("should not overwrite already set values for the backup entry controller configuration", func() {
@@ -207,6 +208,7 @@ var _ = Describe("Defaults", func() {
 					ConcurrentSyncs:                  ptr.To(10),
 					DeletionGracePeriodHours:         ptr.To(1),
 					DeletionGracePeriodShootPurposes: deletionGracePeriodShootPurposes,
+					ReplicationSyncPeriod:            &metav1.Duration{Duration: time.Hour},
 				},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -214,6 +216,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.BackupEntry.ConcurrentSyncs).To(PointTo(Equal(10)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodHours).To(PointTo(Equal(1)))
 			Expect(obj.Controllers.BackupEntry.DeletionGracePeriodShootPurposes).To(Equal(deletionGracePeriodShootPurposes))
+			Expect(obj.Controllers.BackupEntry.ReplicationSyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
 		})
 	})
 
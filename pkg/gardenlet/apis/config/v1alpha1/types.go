@@ -80,6 +80,10 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// ImageRewriteRules is a list of rules for rewriting the repositories of the images in the image vectors, e.g., to
+	// point them to a registry mirror in air-gapped landscapes. The first matching rule is applied.
+	// +optional
+	ImageRewriteRules []ImageRewriteRule `json:"imageRewriteRules,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -220,6 +224,10 @@ type BackupEntryControllerConfiguration struct {
 	// BackupEntries corresponding to Shoots with different purposes will be deleted immediately.
 	// +optional
 	DeletionGracePeriodShootPurposes []gardencorev1beta1.ShootPurpose `json:"deletionGracePeriodShootPurposes,omitempty"`
+	// ReplicationSyncPeriod is the duration how often BackupEntries are replicated to the secondary object store of
+	// their BackupBucket, if replication is configured for it.
+	// +optional
+	ReplicationSyncPeriod *metav1.Duration `json:"replicationSyncPeriod,omitempty"`
 }
 
 // BastionControllerConfiguration defines the configuration of the Bastion
@@ -523,6 +531,19 @@ type ServerConfiguration struct {
 	Metrics *Server `json:"metrics,omitempty"`
 }
 
+// ImageRewriteRule contains a rule for rewriting the repositories of the images in the image vectors.
+type ImageRewriteRule struct {
+	// Prefix is the prefix of the image repositories which are rewritten, e.g.,
+	// `europe-docker.pkg.dev/gardener-project/releases`. It only matches complete path segments.
+	Prefix string `json:"prefix"`
+	// Mirror replaces the prefix of the matching image repositories, e.g., `registry.example.com/gardener`.
+	Mirror string `json:"mirror"`
+	// Digests optionally pins images to digests. The keys are the image references (`<repository>:<tag>`) before
+	// rewriting, the values are the digests (`sha256:...`) of the rewritten images.
+	// +optional
+	Digests map[string]string `json:"digests,omitempty"`
+}
+
 // Server contains information for HTTP(S) server configuration.
 type Server struct {
 	// BindAddress is the IP address on which to listen for the specified port.
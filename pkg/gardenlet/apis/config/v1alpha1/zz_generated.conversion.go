@@ -211,6 +211,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageRewriteRule)(nil), (*config.ImageRewriteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(a.(*ImageRewriteRule), b.(*config.ImageRewriteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImageRewriteRule)(nil), (*ImageRewriteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(a.(*config.ImageRewriteRule), b.(*ImageRewriteRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeconfigValidity)(nil), (*config.KubeconfigValidity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(a.(*KubeconfigValidity), b.(*config.KubeconfigValidity), scope)
 	}); err != nil {
@@ -566,6 +576,7 @@ func autoConvert_v1alpha1_BackupEntryControllerConfiguration_To_config_BackupEnt
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.DeletionGracePeriodHours = (*int)(unsafe.Pointer(in.DeletionGracePeriodHours))
 	out.DeletionGracePeriodShootPurposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.DeletionGracePeriodShootPurposes))
+	out.ReplicationSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.ReplicationSyncPeriod))
 	return nil
 }
 
@@ -578,6 +589,7 @@ func autoConvert_config_BackupEntryControllerConfiguration_To_v1alpha1_BackupEnt
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.DeletionGracePeriodHours = (*int)(unsafe.Pointer(in.DeletionGracePeriodHours))
 	out.DeletionGracePeriodShootPurposes = *(*[]v1beta1.ShootPurpose)(unsafe.Pointer(&in.DeletionGracePeriodShootPurposes))
+	out.ReplicationSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.ReplicationSyncPeriod))
 	return nil
 }
 
@@ -933,6 +945,7 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.ImageRewriteRules = *(*[]config.ImageRewriteRule)(unsafe.Pointer(&in.ImageRewriteRules))
 	return nil
 }
 
@@ -1010,6 +1023,7 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.ImageRewriteRules = *(*[]ImageRewriteRule)(unsafe.Pointer(&in.ImageRewriteRules))
 	return nil
 }
 
@@ -1090,6 +1104,30 @@ func Convert_config_GardenletObjectControllerConfiguration_To_v1alpha1_Gardenlet
 	return autoConvert_config_GardenletObjectControllerConfiguration_To_v1alpha1_GardenletObjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(in *ImageRewriteRule, out *config.ImageRewriteRule, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.Mirror = in.Mirror
+	out.Digests = *(*map[string]string)(unsafe.Pointer(&in.Digests))
+	return nil
+}
+
+// Convert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule is an autogenerated conversion function.
+func Convert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(in *ImageRewriteRule, out *config.ImageRewriteRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(in, out, s)
+}
+
+func autoConvert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(in *config.ImageRewriteRule, out *ImageRewriteRule, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.Mirror = in.Mirror
+	out.Digests = *(*map[string]string)(unsafe.Pointer(&in.Digests))
+	return nil
+}
+
+// Convert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule is an autogenerated conversion function.
+func Convert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(in *config.ImageRewriteRule, out *ImageRewriteRule, s conversion.Scope) error {
+	return autoConvert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(in *KubeconfigValidity, out *config.KubeconfigValidity, s conversion.Scope) error {
 	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
 	out.AutoRotationJitterPercentageMin = (*int32)(unsafe.Pointer(in.AutoRotationJitterPercentageMin))
@@ -97,6 +97,11 @@ func (in *BackupEntryControllerConfiguration) DeepCopyInto(out *BackupEntryContr
 		*out = make([]v1beta1.ShootPurpose, len(*in))
 		copy(*out, *in)
 	}
+	if in.ReplicationSyncPeriod != nil {
+		in, out := &in.ReplicationSyncPeriod, &out.ReplicationSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -501,6 +506,13 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageRewriteRules != nil {
+		in, out := &in.ImageRewriteRules, &out.ImageRewriteRules
+		*out = make([]ImageRewriteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -644,6 +656,29 @@ func (in *GardenletObjectControllerConfiguration) DeepCopy() *GardenletObjectCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRewriteRule) DeepCopyInto(out *ImageRewriteRule) {
+	*out = *in
+	if in.Digests != nil {
+		in, out := &in.Digests, &out.Digests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRewriteRule.
+func (in *ImageRewriteRule) DeepCopy() *ImageRewriteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRewriteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -19,7 +19,9 @@ import (
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/logger"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // ValidateGardenletConfiguration validates a GardenletConfiguration object.
@@ -130,6 +132,8 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, imagevectorutils.ValidateRewriteRules(helper.ImageRewriteRules(cfg), fldPath.Child("imageRewriteRules"))...)
+
 	return allErrs
 }
 
@@ -258,6 +262,10 @@ func validateBackupEntryControllerConfiguration(cfg *config.BackupEntryControlle
 		}
 	}
 
+	if cfg.ReplicationSyncPeriod != nil && cfg.ReplicationSyncPeriod.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicationSyncPeriod"), cfg.ReplicationSyncPeriod.Duration.String(), "must be at least 1m"))
+	}
+
 	return allErrs
 }
 
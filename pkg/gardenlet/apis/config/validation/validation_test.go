@@ -363,6 +363,17 @@ var _ = Describe("GardenletConfiguration", func() {
 					})),
 				))
 			})
+
+			It("should forbid too short replication sync periods", func() {
+				cfg.Controllers.BackupEntry.ReplicationSyncPeriod = &metav1.Duration{Duration: time.Second}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.backupEntry.replicationSyncPeriod"),
+					})),
+				))
+			})
 		})
 
 		Context("bastion controller", func() {
@@ -632,6 +643,39 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("imageRewriteRules", func() {
+			It("should pass with valid image rewrite rules", func() {
+				cfg.ImageRewriteRules = []config.ImageRewriteRule{{
+					Prefix:  "registry.k8s.io",
+					Mirror:  "registry.example.com/k8s",
+					Digests: map[string]string{"registry.k8s.io/foo:v1": "sha256:abc"},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid image rewrite rules", func() {
+				cfg.ImageRewriteRules = []config.ImageRewriteRule{{
+					Digests: map[string]string{"registry.k8s.io/foo:v1": "abc"},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("imageRewriteRules[0].prefix"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("imageRewriteRules[0].mirror"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("imageRewriteRules[0].digests[registry.k8s.io/foo:v1]"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
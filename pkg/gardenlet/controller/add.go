@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupbucket"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupentry"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/backupreplication"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/gardenlet"
@@ -83,6 +84,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding BackupEntry controller: %w", err)
 	}
 
+	if err := (&backupreplication.Reconciler{
+		Config:   *cfg.Controllers.BackupEntry,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(ctx, mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding BackupEntry replication controller: %w", err)
+	}
+
 	if err := (&bastion.Reconciler{
 		Config: *cfg.Controllers.Bastion,
 	}).AddToManager(ctx, mgr, gardenCluster, seedCluster); err != nil {
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -119,6 +120,10 @@ func (r *Reconciler) reconcileBackupBucket(
 		}
 	}
 
+	if err := r.reconcileReplicaBackupBucket(gardenCtx, log, backupBucket); err != nil {
+		return fmt.Errorf("failed to reconcile replica BackupBucket: %w", err)
+	}
+
 	var (
 		mustReconcileExtensionBackupBucket = false
 		// we should reconcile the secret only when the data has changed, since now we depend on
@@ -333,6 +338,53 @@ func (r *Reconciler) deleteBackupBucket(
 	return reconcile.Result{}, nil
 }
 
+// reconcileReplicaBackupBucket ensures the replica BackupBucket in the secondary object store if replication is
+// configured for the given BackupBucket. Otherwise, an existing replica BackupBucket is deleted. The replica BackupBucket
+// is reconciled by this controller like any other BackupBucket. It is controlled by the primary BackupBucket, hence it
+// is garbage collected once the primary BackupBucket is gone.
+func (r *Reconciler) reconcileReplicaBackupBucket(ctx context.Context, log logr.Logger, backupBucket *gardencorev1beta1.BackupBucket) error {
+	if strings.HasPrefix(backupBucket.Name, v1beta1constants.BackupReplicaPrefix+"-") {
+		return nil
+	}
+
+	replicaBackupBucket := &gardencorev1beta1.BackupBucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-%s", v1beta1constants.BackupReplicaPrefix, backupBucket.Name),
+		},
+	}
+
+	if backupBucket.Spec.Replication == nil {
+		if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupBucket), replicaBackupBucket); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if replicaBackupBucket.DeletionTimestamp != nil {
+			return nil
+		}
+		log.Info("Deleting replica BackupBucket since replication is no longer configured", "replicaBackupBucket", client.ObjectKeyFromObject(replicaBackupBucket))
+		if err := client.IgnoreNotFound(r.GardenClient.Delete(ctx, replicaBackupBucket)); err != nil {
+			// The deletion is rejected as long as replica BackupEntries still reference the replica BackupBucket. Do
+			// not fail the reconciliation of the primary BackupBucket in this case, the deletion is retried with the
+			// next reconciliation.
+			log.Error(err, "Failed deleting replica BackupBucket, will be retried", "replicaBackupBucket", client.ObjectKeyFromObject(replicaBackupBucket))
+		}
+		return nil
+	}
+
+	_, err := controllerutils.CreateOrGetAndStrategicMergePatch(ctx, r.GardenClient, replicaBackupBucket, func() error {
+		replicaBackupBucket.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(backupBucket, gardencorev1beta1.SchemeGroupVersion.WithKind("BackupBucket")),
+		}
+		replicaBackupBucket.Spec = gardencorev1beta1.BackupBucketSpec{
+			Provider:       backupBucket.Spec.Replication.Provider,
+			ProviderConfig: backupBucket.Spec.Replication.ProviderConfig,
+			SecretRef:      backupBucket.Spec.Replication.SecretRef,
+			SeedName:       backupBucket.Spec.SeedName,
+		}
+		return nil
+	})
+	return err
+}
+
 func (r *Reconciler) emptyExtensionSecret(backupBucketName string) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/backupbucket"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 const (
@@ -242,6 +243,52 @@ var _ = Describe("Controller", func() {
 		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupBucket), extensionBackupBucket)).To(Succeed())
 		Expect(extensionBackupBucket.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
 	})
+
+	Context("replication", func() {
+		var replicaBackupBucket *gardencorev1beta1.BackupBucket
+
+		BeforeEach(func() {
+			replicaBackupBucket = &gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "replica-" + backupBucket.Name}}
+		})
+
+		It("should create the replica BackupBucket if replication is configured", func() {
+			backupBucket.Spec.Replication = &gardencorev1beta1.BackupBucketReplication{
+				Provider: gardencorev1beta1.BackupBucketProvider{
+					Type:   "other-provider-type",
+					Region: "other-region",
+				},
+				ProviderConfig: providerConfig,
+				SecretRef: corev1.SecretReference{
+					Name:      "replica-secret",
+					Namespace: gardenNamespaceName,
+				},
+				MaxBackups: ptr.To[int32](3),
+			}
+			Expect(gardenClient.Update(ctx, backupBucket)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupBucket), replicaBackupBucket)).To(Succeed())
+			Expect(replicaBackupBucket.OwnerReferences).To(ConsistOf(*metav1.NewControllerRef(backupBucket, gardencorev1beta1.SchemeGroupVersion.WithKind("BackupBucket"))))
+			Expect(replicaBackupBucket.Spec).To(Equal(gardencorev1beta1.BackupBucketSpec{
+				Provider:       backupBucket.Spec.Replication.Provider,
+				ProviderConfig: providerConfig,
+				SecretRef:      backupBucket.Spec.Replication.SecretRef,
+				SeedName:       ptr.To(seedName),
+			}))
+		})
+
+		It("should delete the replica BackupBucket if replication is no longer configured", func() {
+			replicaBackupBucket.Spec.SeedName = ptr.To(seedName)
+			Expect(gardenClient.Create(ctx, replicaBackupBucket)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupBucket), replicaBackupBucket)).To(BeNotFoundError())
+		})
+	})
 })
 
 func generateBackupBucketSecretName(backupBucketName string) string {
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupreplication
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "backupentry-replication"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gardenCluster, seedCluster cluster.Cluster) error {
+	if r.GardenClient == nil {
+		r.GardenClient = gardenCluster.GetClient()
+	}
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		WatchesRawSource(
+			source.Kind(gardenCluster.GetCache(), &gardencorev1beta1.BackupEntry{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(
+				&predicate.GenerationChangedPredicate{},
+				predicateutils.SeedNamePredicate(r.SeedName, gardenerutils.GetBackupEntrySeedNames),
+				r.IsPrimaryBackupEntry(),
+			),
+		).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(gardenCluster.GetCache(), &gardencorev1beta1.BackupBucket{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapBackupBucketToBackupEntries), mapper.UpdateWithNew, c.GetLogger()),
+		r.BackupBucketReplicationChanged(),
+	)
+}
+
+// IsPrimaryBackupEntry returns a predicate which filters out source, replica and bastion session BackupEntries since
+// only the primary BackupEntries of shoots get replicated.
+func (r *Reconciler) IsPrimaryBackupEntry() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return isPrimaryBackupEntryName(obj.GetName())
+	})
+}
+
+func isPrimaryBackupEntryName(name string) bool {
+	return !strings.HasPrefix(name, v1beta1constants.BackupSourcePrefix+"-") &&
+		!strings.HasPrefix(name, v1beta1constants.BackupReplicaPrefix+"-") &&
+		!gardenerutils.IsBastionSessionsBackupEntryName(name)
+}
+
+// BackupBucketReplicationChanged returns a predicate which returns true if the replication configuration of a
+// BackupBucket has changed. The BackupEntries of all BackupBuckets are reconciled when the controller starts, hence
+// create events are not relevant.
+func (r *Reconciler) BackupBucketReplicationChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			backupBucket, ok := e.ObjectNew.(*gardencorev1beta1.BackupBucket)
+			if !ok {
+				return false
+			}
+
+			oldBackupBucket, ok := e.ObjectOld.(*gardencorev1beta1.BackupBucket)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldBackupBucket.Spec.Replication, backupBucket.Spec.Replication)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// MapBackupBucketToBackupEntries is a mapper.MapFunc for mapping a core.gardener.cloud/v1beta1.BackupBucket to the
+// primary core.gardener.cloud/v1beta1.BackupEntries on this seed which reference it.
+func (r *Reconciler) MapBackupBucketToBackupEntries(ctx context.Context, log logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	backupBucket, ok := obj.(*gardencorev1beta1.BackupBucket)
+	if !ok {
+		return nil
+	}
+
+	backupEntryList := &gardencorev1beta1.BackupEntryList{}
+	if err := r.GardenClient.List(ctx, backupEntryList, client.MatchingFields{gardencore.BackupEntryBucketName: backupBucket.Name}); err != nil {
+		log.Error(err, "Failed to list backupentries referencing this bucket", "backupBucketName", backupBucket.Name)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, backupEntry := range backupEntryList.Items {
+		if !isPrimaryBackupEntryName(backupEntry.Name) || ptr.Deref(backupEntry.Spec.SeedName, "") != r.SeedName {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: backupEntry.Namespace, Name: backupEntry.Name}})
+	}
+
+	return requests
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupreplication_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/backupreplication"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#IsPrimaryBackupEntry", func() {
+		DescribeTable("should filter source, replica and bastion sessions BackupEntries",
+			func(name string, matcher OmegaMatcher) {
+				p := (&Reconciler{}).IsPrimaryBackupEntry()
+				backupEntry := &gardencorev1beta1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Name: name}}
+
+				Expect(p.Create(event.CreateEvent{Object: backupEntry})).To(matcher)
+				Expect(p.Update(event.UpdateEvent{ObjectNew: backupEntry})).To(matcher)
+				Expect(p.Delete(event.DeleteEvent{Object: backupEntry})).To(matcher)
+				Expect(p.Generic(event.GenericEvent{Object: backupEntry})).To(matcher)
+			},
+
+			Entry("primary BackupEntry", "shoot--dev--foo--uid", BeTrue()),
+			Entry("source BackupEntry", "source-shoot--dev--foo--uid", BeFalse()),
+			Entry("replica BackupEntry", "replica-shoot--dev--foo--uid", BeFalse()),
+			Entry("bastion sessions BackupEntry", "bastion-sessions-shoot--dev--foo--uid", BeFalse()),
+		)
+	})
+
+	Describe("#BackupBucketReplicationChanged", func() {
+		var (
+			p            = (&Reconciler{}).BackupBucketReplicationChanged()
+			backupBucket *gardencorev1beta1.BackupBucket
+		)
+
+		BeforeEach(func() {
+			backupBucket = &gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+		})
+
+		It("should return false for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: backupBucket})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: backupBucket})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: backupBucket})).To(BeFalse())
+		})
+
+		It("should return false if the replication did not change", func() {
+			oldBackupBucket := backupBucket.DeepCopy()
+			backupBucket.Spec.Provider.Region = "other-region"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldBackupBucket, ObjectNew: backupBucket})).To(BeFalse())
+		})
+
+		It("should return true if the replication was enabled", func() {
+			oldBackupBucket := backupBucket.DeepCopy()
+			backupBucket.Spec.Replication = &gardencorev1beta1.BackupBucketReplication{}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldBackupBucket, ObjectNew: backupBucket})).To(BeTrue())
+		})
+
+		It("should return true if the replication was disabled", func() {
+			backupBucket.Spec.Replication = &gardencorev1beta1.BackupBucketReplication{}
+			oldBackupBucket := backupBucket.DeepCopy()
+			backupBucket.Spec.Replication = nil
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldBackupBucket, ObjectNew: backupBucket})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapBackupBucketToBackupEntries", func() {
+		var (
+			ctx = context.Background()
+			log = logr.Discard()
+
+			reconciler   *Reconciler
+			backupBucket *gardencorev1beta1.BackupBucket
+		)
+
+		BeforeEach(func() {
+			backupBucket = &gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+
+			fakeClient := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.BackupEntry{}, core.BackupEntryBucketName, indexer.BackupEntryBucketNameIndexerFunc).
+				Build()
+			reconciler = &Reconciler{GardenClient: fakeClient, SeedName: "seed"}
+
+			for _, backupEntry := range []*gardencorev1beta1.BackupEntry{
+				newBackupEntry("shoot--dev--foo--uid", "bucket", "seed"),
+				newBackupEntry("replica-shoot--dev--foo--uid", "bucket", "seed"),
+				newBackupEntry("source-shoot--dev--foo--uid", "bucket", "seed"),
+				newBackupEntry("bastion-sessions-shoot--dev--foo--uid", "bucket", "seed"),
+				newBackupEntry("shoot--dev--bar--uid", "bucket", "other-seed"),
+				newBackupEntry("shoot--dev--baz--uid", "other-bucket", "seed"),
+			} {
+				Expect(fakeClient.Create(ctx, backupEntry)).To(Succeed())
+			}
+		})
+
+		It("should return nil when the object is not a BackupBucket", func() {
+			Expect(reconciler.MapBackupBucketToBackupEntries(ctx, log, nil, &corev1.Secret{})).To(BeNil())
+		})
+
+		It("should return requests for the primary BackupEntries on this seed referencing the BackupBucket", func() {
+			Expect(reconciler.MapBackupBucketToBackupEntries(ctx, log, nil, backupBucket)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-dev", Name: "shoot--dev--foo--uid"}},
+			))
+		})
+	})
+})
+
+func newBackupEntry(name, bucketName, seedName string) *gardencorev1beta1.BackupEntry {
+	return &gardencorev1beta1.BackupEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"},
+		Spec: gardencorev1beta1.BackupEntrySpec{
+			BucketName: bucketName,
+			SeedName:   ptr.To(seedName),
+		},
+	}
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupreplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	etcdcopybackupstask "github.com/gardener/gardener/pkg/component/etcd/copybackupstask"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// TaskName is the name of the EtcdCopyBackupsTask which is used for replicating the backups of a shoot to the secondary
+// object store.
+const TaskName = "backup-replication"
+
+// RequeueDurationWhileReplicating is the duration used for requeueing while the EtcdCopyBackupsTask is not yet finished
+// or the BackupEntries are not yet ready for replication. Exposed for testing.
+var RequeueDurationWhileReplicating = 30 * time.Second
+
+// LagRefreshPeriod is the maximum duration after which the replication lag in the status of a BackupEntry is refreshed,
+// even if no replication happened in the meantime. This way, the lag keeps growing if the replication stalls. Exposed
+// for testing.
+var LagRefreshPeriod = 5 * time.Minute
+
+// Reconciler replicates the backups of BackupEntries to the secondary object store of their BackupBucket if
+// replication is configured for it.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.BackupEntryControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile ensures the replica BackupEntry and periodically copies the backups of the BackupEntry to it with the
+// help of an EtcdCopyBackupsTask in the shoot namespace of the seed cluster.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	backupEntry := &gardencorev1beta1.BackupEntry{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, backupEntry); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// The replica BackupEntry is garbage collected together with the BackupEntry, hence there is nothing to do for
+	// BackupEntries in deletion or BackupEntries which are currently migrated to another seed.
+	if backupEntry.DeletionTimestamp != nil ||
+		ptr.Deref(backupEntry.Spec.SeedName, "") != r.SeedName ||
+		ptr.Deref(backupEntry.Status.SeedName, "") != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	backupBucket := &gardencorev1beta1.BackupBucket{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: backupEntry.Spec.BucketName}, backupBucket); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed getting associated BackupBucket %q: %w", backupEntry.Spec.BucketName, err)
+	}
+
+	replicaBackupEntry := &gardencorev1beta1.BackupEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", v1beta1constants.BackupReplicaPrefix, backupEntry.Name),
+			Namespace: backupEntry.Namespace,
+		},
+	}
+
+	if backupBucket.Spec.Replication == nil {
+		return reconcile.Result{}, r.disableReplication(ctx, log, backupEntry, replicaBackupEntry)
+	}
+
+	if err := r.reconcileReplicaBackupEntry(ctx, backupEntry, replicaBackupEntry); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reconciling replica BackupEntry: %w", err)
+	}
+
+	if !isReconciled(backupEntry) || !isReconciled(replicaBackupEntry) {
+		log.Info("Waiting until BackupEntry and its replica are reconciled successfully", "replicaBackupEntry", client.ObjectKeyFromObject(replicaBackupEntry))
+		return reconcile.Result{RequeueAfter: RequeueDurationWhileReplicating}, r.refreshLag(ctx, backupEntry)
+	}
+
+	shootTechnicalID, _ := gardenerutils.ExtractShootDetailsFromBackupEntryName(backupEntry.Name)
+	task := &druidv1alpha1.EtcdCopyBackupsTask{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: shootTechnicalID, Name: TaskName}, task); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		if status := backupEntry.Status.Replication; status != nil && status.LastReplicationTime != nil {
+			if nextReplication := status.LastReplicationTime.Add(r.Config.ReplicationSyncPeriod.Duration); r.Clock.Now().Before(nextReplication) {
+				return reconcile.Result{RequeueAfter: min(nextReplication.Sub(r.Clock.Now()), LagRefreshPeriod)}, r.refreshLag(ctx, backupEntry)
+			}
+		}
+
+		log.Info("Starting replication of backups", "task", client.ObjectKey{Namespace: shootTechnicalID, Name: TaskName})
+		if err := r.deployTask(ctx, log, shootTechnicalID, backupEntry, backupBucket); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed deploying EtcdCopyBackupsTask: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: RequeueDurationWhileReplicating}, nil
+	}
+
+	return r.checkTask(ctx, log, backupEntry, task)
+}
+
+func (r *Reconciler) reconcileReplicaBackupEntry(ctx context.Context, backupEntry, replicaBackupEntry *gardencorev1beta1.BackupEntry) error {
+	_, err := controllerutils.CreateOrGetAndMergePatch(ctx, r.GardenClient, replicaBackupEntry, func() error {
+		ownerReferences := []metav1.OwnerReference{
+			*metav1.NewControllerRef(backupEntry, gardencorev1beta1.SchemeGroupVersion.WithKind("BackupEntry")),
+		}
+		// The owner reference to the shoot is required for restoring the replica BackupEntry after a control plane
+		// migration.
+		for _, ownerRef := range backupEntry.OwnerReferences {
+			if ownerRef.Kind == "Shoot" {
+				ownerRef.Controller = ptr.To(false)
+				ownerRef.BlockOwnerDeletion = ptr.To(false)
+				ownerReferences = append(ownerReferences, ownerRef)
+			}
+		}
+		replicaBackupEntry.OwnerReferences = ownerReferences
+
+		if purpose, ok := backupEntry.Annotations[v1beta1constants.ShootPurpose]; ok {
+			metav1.SetMetaDataAnnotation(&replicaBackupEntry.ObjectMeta, v1beta1constants.ShootPurpose, purpose)
+		}
+
+		replicaBackupEntry.Spec = gardencorev1beta1.BackupEntrySpec{
+			BucketName: fmt.Sprintf("%s-%s", v1beta1constants.BackupReplicaPrefix, backupEntry.Spec.BucketName),
+			SeedName:   backupEntry.Spec.SeedName,
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *Reconciler) disableReplication(ctx context.Context, log logr.Logger, backupEntry, replicaBackupEntry *gardencorev1beta1.BackupEntry) error {
+	if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupEntry), replicaBackupEntry); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else if replicaBackupEntry.DeletionTimestamp == nil {
+		log.Info("Deleting replica BackupEntry since replication is no longer configured", "replicaBackupEntry", client.ObjectKeyFromObject(replicaBackupEntry))
+		if err := client.IgnoreNotFound(r.GardenClient.Delete(ctx, replicaBackupEntry)); err != nil {
+			return err
+		}
+	}
+
+	if backupEntry.Status.Replication == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(backupEntry.DeepCopy())
+	backupEntry.Status.Replication = nil
+	return r.GardenClient.Status().Patch(ctx, backupEntry, patch)
+}
+
+func (r *Reconciler) deployTask(ctx context.Context, log logr.Logger, namespace string, backupEntry *gardencorev1beta1.BackupEntry, backupBucket *gardencorev1beta1.BackupBucket) error {
+	sourceSecret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1beta1constants.BackupSecretName}, sourceSecret); err != nil {
+		return err
+	}
+	targetSecret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: fmt.Sprintf("%s-%s", v1beta1constants.BackupReplicaPrefix, v1beta1constants.BackupSecretName)}, targetSecret); err != nil {
+		return err
+	}
+
+	var (
+		replication     = backupBucket.Spec.Replication
+		prefix          = fmt.Sprintf("%s/etcd-%s", backupEntry.Name, v1beta1constants.ETCDRoleMain)
+		sourceProvider  = druidv1alpha1.StorageProvider(backupBucket.Spec.Provider.Type)
+		targetProvider  = druidv1alpha1.StorageProvider(replication.Provider.Type)
+		sourceContainer = string(sourceSecret.Data[v1beta1constants.DataKeyBackupBucketName])
+		targetContainer = string(targetSecret.Data[v1beta1constants.DataKeyBackupBucketName])
+		values          = &etcdcopybackupstask.Values{
+			Name:      TaskName,
+			Namespace: namespace,
+			SourceStore: druidv1alpha1.StoreSpec{
+				Provider:  &sourceProvider,
+				SecretRef: &corev1.SecretReference{Name: sourceSecret.Name},
+				Prefix:    prefix,
+				Container: &sourceContainer,
+			},
+			// The backups are stored with the same prefix in the secondary object store, so that they can be restored
+			// from there the same way as from the primary object store.
+			TargetStore: druidv1alpha1.StoreSpec{
+				Provider:  &targetProvider,
+				SecretRef: &corev1.SecretReference{Name: targetSecret.Name},
+				Prefix:    prefix,
+				Container: &targetContainer,
+			},
+		}
+	)
+
+	if replication.MaxBackupAge != nil {
+		values.MaxBackupAge = ptr.To(uint32(*replication.MaxBackupAge))
+	}
+	if replication.MaxBackups != nil {
+		values.MaxBackups = ptr.To(uint32(*replication.MaxBackups))
+	}
+
+	return etcdcopybackupstask.New(log, r.SeedClient, values, etcdcopybackupstask.DefaultInterval, etcdcopybackupstask.DefaultSevereThreshold, etcdcopybackupstask.DefaultTimeout).Deploy(ctx)
+}
+
+func (r *Reconciler) checkTask(ctx context.Context, log logr.Logger, backupEntry *gardencorev1beta1.BackupEntry, task *druidv1alpha1.EtcdCopyBackupsTask) (reconcile.Result, error) {
+	var (
+		lastReplicationTime *metav1.Time
+		lastError           *gardencorev1beta1.LastError
+		finished            bool
+	)
+
+	if backupEntry.Status.Replication != nil {
+		lastReplicationTime = backupEntry.Status.Replication.LastReplicationTime
+		lastError = backupEntry.Status.Replication.LastError
+	}
+
+	for _, condition := range task.Status.Conditions {
+		if condition.Status != druidv1alpha1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case druidv1alpha1.EtcdCopyBackupsTaskSucceeded:
+			log.Info("Replication of backups succeeded")
+			lastReplicationTime = ptr.To(task.CreationTimestamp)
+			lastError = nil
+			finished = true
+		case druidv1alpha1.EtcdCopyBackupsTaskFailed:
+			log.Info("Replication of backups failed", "reason", condition.Message)
+			lastError = &gardencorev1beta1.LastError{
+				Description:    fmt.Sprintf("Replication of backups failed: %s", condition.Message),
+				LastUpdateTime: ptr.To(metav1.NewTime(r.Clock.Now())),
+			}
+			finished = true
+		}
+	}
+
+	if !finished {
+		return reconcile.Result{RequeueAfter: RequeueDurationWhileReplicating}, r.updateReplicationStatus(ctx, backupEntry, lastReplicationTime, lastError)
+	}
+
+	if err := r.updateReplicationStatus(ctx, backupEntry, lastReplicationTime, lastError); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := client.IgnoreNotFound(r.SeedClient.Delete(ctx, task)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed deleting finished EtcdCopyBackupsTask: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: min(r.Config.ReplicationSyncPeriod.Duration, LagRefreshPeriod)}, nil
+}
+
+func (r *Reconciler) updateReplicationStatus(ctx context.Context, backupEntry *gardencorev1beta1.BackupEntry, lastReplicationTime *metav1.Time, lastError *gardencorev1beta1.LastError) error {
+	patch := client.MergeFrom(backupEntry.DeepCopy())
+
+	backupEntry.Status.Replication = &gardencorev1beta1.BackupEntryReplicationStatus{
+		LastReplicationTime: lastReplicationTime,
+		LastError:           lastError,
+	}
+	if lastReplicationTime != nil {
+		backupEntry.Status.Replication.Lag = &metav1.Duration{Duration: r.Clock.Since(lastReplicationTime.Time).Round(time.Second)}
+	}
+
+	return r.GardenClient.Status().Patch(ctx, backupEntry, patch)
+}
+
+// refreshLag updates the replication lag in the status of the given BackupEntry based on its last replication time.
+func (r *Reconciler) refreshLag(ctx context.Context, backupEntry *gardencorev1beta1.BackupEntry) error {
+	status := backupEntry.Status.Replication
+	if status == nil || status.LastReplicationTime == nil {
+		return nil
+	}
+
+	return r.updateReplicationStatus(ctx, backupEntry, status.LastReplicationTime, status.LastError)
+}
+
+func isReconciled(backupEntry *gardencorev1beta1.BackupEntry) bool {
+	return backupEntry.Status.LastOperation != nil &&
+		backupEntry.Status.LastOperation.Type != gardencorev1beta1.LastOperationTypeDelete &&
+		backupEntry.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
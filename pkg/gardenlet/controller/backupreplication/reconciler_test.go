@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupreplication_test
+
+import (
+	"context"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/backupreplication"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName         = "seed"
+		shootTechnicalID = "shoot--dev--foo"
+		syncPeriod       = 30 * time.Minute
+	)
+
+	var (
+		ctx          = context.TODO()
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+
+		backupBucket       *gardencorev1beta1.BackupBucket
+		backupEntry        *gardencorev1beta1.BackupEntry
+		replicaBackupEntry *gardencorev1beta1.BackupEntry
+		request            reconcile.Request
+
+		succeeded = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeReconcile,
+			State: gardencorev1beta1.LastOperationStateSucceeded,
+		}
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.BackupEntry{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: config.BackupEntryControllerConfiguration{
+				ReplicationSyncPeriod: &metav1.Duration{Duration: syncPeriod},
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+		}
+
+		backupBucket = &gardencorev1beta1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+			Spec: gardencorev1beta1.BackupBucketSpec{
+				Provider: gardencorev1beta1.BackupBucketProvider{Type: "local", Region: "primary"},
+				SeedName: ptr.To(seedName),
+				Replication: &gardencorev1beta1.BackupBucketReplication{
+					Provider:   gardencorev1beta1.BackupBucketProvider{Type: "local", Region: "secondary"},
+					MaxBackups: ptr.To[int32](3),
+				},
+			},
+		}
+		Expect(gardenClient.Create(ctx, backupBucket)).To(Succeed())
+
+		backupEntry = &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        shootTechnicalID + "--uid",
+				Namespace:   "garden-dev",
+				UID:         "entry-uid",
+				Annotations: map[string]string{"shoot.gardener.cloud/purpose": "production"},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "core.gardener.cloud/v1beta1",
+					Kind:       "Shoot",
+					Name:       "foo",
+					UID:        "shoot-uid",
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: gardencorev1beta1.BackupEntrySpec{
+				BucketName: backupBucket.Name,
+				SeedName:   ptr.To(seedName),
+			},
+		}
+		Expect(gardenClient.Create(ctx, backupEntry)).To(Succeed())
+		backupEntry.Status = gardencorev1beta1.BackupEntryStatus{SeedName: ptr.To(seedName), LastOperation: succeeded}
+		Expect(gardenClient.Status().Update(ctx, backupEntry)).To(Succeed())
+
+		replicaBackupEntry = &gardencorev1beta1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Name: "replica-" + backupEntry.Name, Namespace: backupEntry.Namespace}}
+
+		for _, name := range []string{"etcd-backup", "replica-etcd-backup"} {
+			Expect(seedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shootTechnicalID},
+				Data:       map[string][]byte{"bucketName": []byte(name + "-bucket")},
+			})).To(Succeed())
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(backupEntry)}
+	})
+
+	markReplicaReconciled := func() {
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupEntry), replicaBackupEntry)).To(Succeed())
+		replicaBackupEntry.Status.LastOperation = succeeded
+		Expect(gardenClient.Status().Update(ctx, replicaBackupEntry)).To(Succeed())
+	}
+
+	setTaskCondition := func(conditionType druidv1alpha1.ConditionType, message string) *druidv1alpha1.EtcdCopyBackupsTask {
+		task := &druidv1alpha1.EtcdCopyBackupsTask{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: shootTechnicalID, Name: TaskName}, task)).To(Succeed())
+		task.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+		task.Status.Conditions = []druidv1alpha1.Condition{{Type: conditionType, Status: druidv1alpha1.ConditionTrue, Message: message}}
+		Expect(seedClient.Update(ctx, task)).To(Succeed())
+		return task
+	}
+
+	It("should create the replica BackupEntry and wait until it is reconciled", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: RequeueDurationWhileReplicating}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupEntry), replicaBackupEntry)).To(Succeed())
+		Expect(replicaBackupEntry.Spec).To(Equal(gardencorev1beta1.BackupEntrySpec{
+			BucketName: "replica-" + backupBucket.Name,
+			SeedName:   ptr.To(seedName),
+		}))
+		Expect(replicaBackupEntry.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/purpose", "production"))
+		Expect(replicaBackupEntry.OwnerReferences).To(ConsistOf(
+			*metav1.NewControllerRef(backupEntry, gardencorev1beta1.SchemeGroupVersion.WithKind("BackupEntry")),
+			metav1.OwnerReference{
+				APIVersion:         "core.gardener.cloud/v1beta1",
+				Kind:               "Shoot",
+				Name:               "foo",
+				UID:                "shoot-uid",
+				Controller:         ptr.To(false),
+				BlockOwnerDeletion: ptr.To(false),
+			},
+		))
+
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: shootTechnicalID, Name: TaskName}, &druidv1alpha1.EtcdCopyBackupsTask{})).To(BeNotFoundError())
+	})
+
+	It("should deploy the EtcdCopyBackupsTask once the replica BackupEntry is reconciled", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		markReplicaReconciled()
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: RequeueDurationWhileReplicating}))
+
+		task := &druidv1alpha1.EtcdCopyBackupsTask{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: shootTechnicalID, Name: TaskName}, task)).To(Succeed())
+		Expect(task.Spec.SourceStore).To(Equal(druidv1alpha1.StoreSpec{
+			Provider:  ptr.To[druidv1alpha1.StorageProvider]("local"),
+			SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+			Prefix:    backupEntry.Name + "/etcd-main",
+			Container: ptr.To("etcd-backup-bucket"),
+		}))
+		Expect(task.Spec.TargetStore).To(Equal(druidv1alpha1.StoreSpec{
+			Provider:  ptr.To[druidv1alpha1.StorageProvider]("local"),
+			SecretRef: &corev1.SecretReference{Name: "replica-etcd-backup"},
+			Prefix:    backupEntry.Name + "/etcd-main",
+			Container: ptr.To("replica-etcd-backup-bucket"),
+		}))
+		Expect(task.Spec.MaxBackups).To(PointTo(Equal(uint32(3))))
+		Expect(task.Spec.MaxBackupAge).To(BeNil())
+	})
+
+	It("should report the replication and delete the task after it succeeded", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		markReplicaReconciled()
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		task := setTaskCondition(druidv1alpha1.EtcdCopyBackupsTaskSucceeded, "")
+		fakeClock.Step(5 * time.Minute)
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: LagRefreshPeriod}))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, backupEntry)).To(Succeed())
+		Expect(backupEntry.Status.Replication).NotTo(BeNil())
+		Expect(backupEntry.Status.Replication.LastReplicationTime.Time).To(BeTemporally("==", task.CreationTimestamp.Time))
+		Expect(backupEntry.Status.Replication.Lag).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+		Expect(backupEntry.Status.Replication.LastError).To(BeNil())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(task), task)).To(BeNotFoundError())
+	})
+
+	It("should report the error and delete the task after it failed", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		markReplicaReconciled()
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		task := setTaskCondition(druidv1alpha1.EtcdCopyBackupsTaskFailed, "bucket not reachable")
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: LagRefreshPeriod}))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, backupEntry)).To(Succeed())
+		Expect(backupEntry.Status.Replication.LastReplicationTime).To(BeNil())
+		Expect(backupEntry.Status.Replication.LastError.Description).To(ContainSubstring("bucket not reachable"))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(task), task)).To(BeNotFoundError())
+	})
+
+	It("should not start a new replication before the sync period has passed", func() {
+		lastReplicationTime := metav1.NewTime(fakeClock.Now().Add(-10 * time.Minute))
+		backupEntry.Status.Replication = &gardencorev1beta1.BackupEntryReplicationStatus{LastReplicationTime: &lastReplicationTime}
+		Expect(gardenClient.Status().Update(ctx, backupEntry)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		markReplicaReconciled()
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: LagRefreshPeriod}))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, backupEntry)).To(Succeed())
+		Expect(backupEntry.Status.Replication.Lag).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: shootTechnicalID, Name: TaskName}, &druidv1alpha1.EtcdCopyBackupsTask{})).To(BeNotFoundError())
+	})
+
+	It("should refresh the lag while the replication is stalled", func() {
+		lastReplicationTime := metav1.NewTime(fakeClock.Now().Add(-2 * time.Hour))
+		backupEntry.Status.Replication = &gardencorev1beta1.BackupEntryReplicationStatus{
+			LastReplicationTime: &lastReplicationTime,
+			Lag:                 &metav1.Duration{Duration: 5 * time.Second},
+		}
+		Expect(gardenClient.Status().Update(ctx, backupEntry)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: RequeueDurationWhileReplicating}))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, backupEntry)).To(Succeed())
+		Expect(backupEntry.Status.Replication.LastReplicationTime.Time).To(BeTemporally("==", lastReplicationTime.Time))
+		Expect(backupEntry.Status.Replication.Lag).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
+	})
+
+	It("should delete the replica BackupEntry and reset the status if replication is not configured", func() {
+		Expect(gardenClient.Create(ctx, replicaBackupEntry)).To(Succeed())
+		backupEntry.Status.Replication = &gardencorev1beta1.BackupEntryReplicationStatus{LastReplicationTime: ptr.To(metav1.NewTime(fakeClock.Now()))}
+		Expect(gardenClient.Status().Update(ctx, backupEntry)).To(Succeed())
+
+		backupBucket.Spec.Replication = nil
+		Expect(gardenClient.Update(ctx, backupBucket)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupEntry), replicaBackupEntry)).To(BeNotFoundError())
+		Expect(gardenClient.Get(ctx, request.NamespacedName, backupEntry)).To(Succeed())
+		Expect(backupEntry.Status.Replication).To(BeNil())
+	})
+
+	It("should do nothing if the BackupEntry is not scheduled to this seed", func() {
+		backupEntry.Spec.SeedName = ptr.To("other-seed")
+		Expect(gardenClient.Update(ctx, backupEntry)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(replicaBackupEntry), replicaBackupEntry)).To(BeNotFoundError())
+	})
+})
@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -101,6 +102,26 @@ func (r *Reconciler) reconcileBastion(
 		}
 	}
 
+	if bastion.Spec.Approval != nil && bastion.Spec.Approval.ApprovedBy == nil {
+		log.Info("Bastion is waiting for approval")
+		if err := patchReadyCondition(gardenCtx, r.GardenClient, r.Clock, bastion, gardencorev1beta1.ConditionFalse, "ApprovalPending", "The bastion is waiting for approval."); err != nil {
+			return fmt.Errorf("failed patching ready condition of Bastion: %w", err)
+		}
+		return nil
+	}
+
+	var sessionRecording *extensionsv1alpha1.BastionSessionRecording
+	if sessionRecordingEnabled(bastion) {
+		backupEntry, err := r.reconcileSessionRecordingBackupEntry(gardenCtx, bastion, shoot)
+		if err != nil {
+			if patchErr := patchReadyCondition(gardenCtx, r.GardenClient, r.Clock, bastion, gardencorev1beta1.ConditionFalse, "FailedReconciling", err.Error()); patchErr != nil {
+				log.Error(patchErr, "Failed patching ready condition")
+			}
+			return err
+		}
+		sessionRecording = &extensionsv1alpha1.BastionSessionRecording{BackupEntryName: backupEntry.Name}
+	}
+
 	extensionBastion := newBastionExtension(bastion, shoot)
 	extensionIngress := make([]extensionsv1alpha1.BastionIngressPolicy, len(bastion.Spec.Ingress))
 	for i, ingress := range bastion.Spec.Ingress {
@@ -116,8 +137,9 @@ func (r *Reconciler) reconcileBastion(
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
 				Type: *bastion.Spec.ProviderType,
 			},
-			UserData: createUserData(bastion),
-			Ingress:  extensionIngress,
+			UserData:         createUserData(bastion),
+			Ingress:          extensionIngress,
+			SessionRecording: sessionRecording,
 		}
 	)
 
@@ -222,6 +244,18 @@ func (r *Reconciler) cleanupBastion(
 
 	if err := r.SeedClient.Delete(seedCtx, extensionBastion); err != nil {
 		if apierrors.IsNotFound(err) {
+			if sessionRecordingEnabled(bastion) {
+				// The session recordings are kept in the backup bucket until the deletion grace period of the
+				// BackupEntry has passed.
+				backupEntry, err := newSessionRecordingBackupEntry(bastion, shoot)
+				if err != nil {
+					return err
+				}
+				if err := r.GardenClient.Delete(gardenCtx, backupEntry); client.IgnoreNotFound(err) != nil {
+					return fmt.Errorf("failed to delete session recording BackupEntry: %w", err)
+				}
+			}
+
 			log.Info("Successfully deleted")
 
 			if controllerutil.ContainsFinalizer(bastion, gardencorev1beta1.GardenerName) {
@@ -253,6 +287,58 @@ func newBastionExtension(bastion *operationsv1alpha1.Bastion, shoot *gardencorev
 	}
 }
 
+func sessionRecordingEnabled(bastion *operationsv1alpha1.Bastion) bool {
+	return bastion.Spec.SessionRecording != nil && bastion.Spec.SessionRecording.Enabled
+}
+
+func newSessionRecordingBackupEntry(bastion *operationsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.BackupEntry, error) {
+	name, err := gardenerutils.GenerateBastionSessionsBackupEntryName(shoot.Status.TechnicalID, bastion.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gardencorev1beta1.BackupEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: bastion.Namespace,
+		},
+	}, nil
+}
+
+// reconcileSessionRecordingBackupEntry ensures the BackupEntry to which the session recordings of the bastion are
+// uploaded. It uses the same backup bucket as the Shoot's BackupEntry.
+func (r *Reconciler) reconcileSessionRecordingBackupEntry(ctx context.Context, bastion *operationsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.BackupEntry, error) {
+	shootBackupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	shootBackupEntry := &gardencorev1beta1.BackupEntry{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: shootBackupEntryName}, shootBackupEntry); err != nil {
+		return nil, fmt.Errorf("failed to get BackupEntry of shoot for session recordings: %w", err)
+	}
+
+	backupEntry, err := newSessionRecordingBackupEntry(bastion, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.GardenClient, backupEntry, func() error {
+		if shoot.Spec.Purpose != nil {
+			metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, v1beta1constants.ShootPurpose, string(*shoot.Spec.Purpose))
+		}
+		backupEntry.OwnerReferences = kubernetesutils.MergeOwnerReferences(backupEntry.OwnerReferences, *metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot")))
+
+		backupEntry.Spec.BucketName = shootBackupEntry.Spec.BucketName
+		backupEntry.Spec.SeedName = shootBackupEntry.Spec.SeedName
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to ensure session recording BackupEntry: %w", err)
+	}
+
+	return backupEntry, nil
+}
+
 func setReadyCondition(clock clock.Clock, bastion *operationsv1alpha1.Bastion, status gardencorev1beta1.ConditionStatus, reason string, message string) {
 	condition := v1beta1helper.GetOrInitConditionWithClock(clock, bastion.Status.Conditions, operationsv1alpha1.BastionReady)
 	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
@@ -267,6 +353,10 @@ func patchReadyCondition(ctx context.Context, c client.StatusClient, clock clock
 }
 
 func createUserData(bastion *operationsv1alpha1.Bastion) []byte {
+	if sessionRecordingEnabled(bastion) {
+		return createSessionRecordingUserData(bastion)
+	}
+
 	userData := fmt.Sprintf(`#!/bin/bash -eu
 
 id gardener || useradd gardener -mU
@@ -279,3 +369,52 @@ systemctl start ssh
 
 	return []byte(userData)
 }
+
+// createSessionRecordingUserData returns the user data for a bastion host recording all shell sessions. The gardener
+// user does not get root privileges, hence all shells and commands on the bastion host must go through the recorded
+// shell. Local port forwarding is only permitted to SSH ports, so that worker nodes can still be reached via
+// ProxyJump (ssh -J). The recorder runs as root via sudo and only drops to the gardener user for the session itself, so
+// the recordings in the root-owned /var/log/bastion-sessions cannot be modified by the recorded user. The extension
+// must upload the recordings from this directory only.
+func createSessionRecordingUserData(bastion *operationsv1alpha1.Bastion) []byte {
+	userData := fmt.Sprintf(`#!/bin/bash -eu
+
+id gardener || useradd gardener -mU
+mkdir -p /home/gardener/.ssh
+echo "%s" > /home/gardener/.ssh/authorized_keys
+chown gardener:gardener /home/gardener/.ssh/authorized_keys
+
+mkdir -p /var/log/bastion-sessions
+chown root:root /var/log/bastion-sessions
+chmod 0700 /var/log/bastion-sessions
+cat <<'EOF' >/usr/local/sbin/bastion-session-recorder
+#!/bin/bash -eu
+recording="/var/log/bastion-sessions/$(date -u +%%Y%%m%%dT%%H%%M%%SZ)-$$"
+if [ -n "${SSH_ORIGINAL_COMMAND:-}" ]; then
+  export BASTION_SESSION_COMMAND="${SSH_ORIGINAL_COMMAND}"
+  exec script --quiet --flush --timing="${recording}.timing" --command 'exec runuser -u gardener -- /bin/bash -c "${BASTION_SESSION_COMMAND}"' "${recording}.log"
+fi
+exec script --quiet --flush --timing="${recording}.timing" --command 'exec runuser -u gardener -- /bin/bash -l' "${recording}.log"
+EOF
+chown root:root /usr/local/sbin/bastion-session-recorder
+chmod 0755 /usr/local/sbin/bastion-session-recorder
+cat <<'EOF' >/etc/sudoers.d/bastion-session-recorder
+Defaults!/usr/local/sbin/bastion-session-recorder env_keep += "SSH_ORIGINAL_COMMAND"
+gardener ALL=(root) NOPASSWD: /usr/local/sbin/bastion-session-recorder
+EOF
+chmod 0440 /etc/sudoers.d/bastion-session-recorder
+cat <<'EOF' >>/etc/ssh/sshd_config
+
+Match User gardener
+  AllowTcpForwarding local
+  PermitOpen *:22
+  AllowStreamLocalForwarding no
+  PermitTunnel no
+  X11Forwarding no
+  ForceCommand /usr/bin/sudo --non-interactive /usr/local/sbin/bastion-session-recorder
+EOF
+systemctl start ssh
+`, bastion.Spec.SSHPublicKey)
+
+	return []byte(userData)
+}
@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.Background()
+		gardenClient client.Client
+		seedClient   client.Client
+		reconciler   *Reconciler
+		request      reconcile.Request
+
+		namespace        = "garden-dev"
+		shootTechnicalID = "shoot--dev--foo"
+		bastionUID       = "bastion-uid"
+
+		shoot             *gardencorev1beta1.Shoot
+		shootBackupEntry  *gardencorev1beta1.BackupEntry
+		bastion           *operationsv1alpha1.Bastion
+		extensionsBastion *extensionsv1alpha1.Bastion
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: namespace,
+				UID:       "shoot-uid",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Purpose: ptr.To(gardencorev1beta1.ShootPurposeProduction),
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: shootTechnicalID,
+				UID:         "shoot-uid",
+			},
+		}
+		shootBackupEntry = &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      shootTechnicalID + "--shoot-uid",
+				Namespace: namespace,
+			},
+			Spec: gardencorev1beta1.BackupEntrySpec{
+				BucketName: "bucket",
+				SeedName:   ptr.To("seed"),
+			},
+		}
+		bastion = &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bastion",
+				Namespace: namespace,
+				UID:       "bastion-uid",
+			},
+			Spec: operationsv1alpha1.BastionSpec{
+				ShootRef:     corev1.LocalObjectReference{Name: shoot.Name},
+				ProviderType: ptr.To("local"),
+				SSHPublicKey: "ssh-ed25519 AAAA",
+				Ingress:      []operationsv1alpha1.BastionIngressPolicy{{IPBlock: networkingv1.IPBlock{CIDR: "1.2.3.4/32"}}},
+			},
+		}
+		extensionsBastion = &extensionsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bastion.Name,
+				Namespace: shootTechnicalID,
+			},
+		}
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&operationsv1alpha1.Bastion{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        testclock.NewFakeClock(time.Now()),
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(bastion)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create the extension Bastion", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(bastion.Finalizers).To(ConsistOf("gardener"))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(Succeed())
+			Expect(extensionsBastion.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
+			Expect(extensionsBastion.Spec.Type).To(Equal("local"))
+			Expect(extensionsBastion.Spec.Ingress).To(Equal([]extensionsv1alpha1.BastionIngressPolicy{{IPBlock: networkingv1.IPBlock{CIDR: "1.2.3.4/32"}}}))
+			Expect(extensionsBastion.Spec.SessionRecording).To(BeNil())
+
+			userData := string(extensionsBastion.Spec.UserData)
+			Expect(userData).To(ContainSubstring(`echo "ssh-ed25519 AAAA" > /home/gardener/.ssh/authorized_keys`))
+			Expect(userData).To(ContainSubstring("gardener ALL=(ALL) NOPASSWD:ALL"))
+			Expect(userData).NotTo(ContainSubstring("bastion-session-recorder"))
+		})
+
+		It("should copy the status of the extension Bastion once it is ready", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(Succeed())
+			extensionsBastion.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}
+			extensionsBastion.Status.Ingress = &corev1.LoadBalancerIngress{IP: "5.6.7.8"}
+			Expect(seedClient.Update(ctx, extensionsBastion)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(bastion.Status.Ingress).To(Equal(&corev1.LoadBalancerIngress{IP: "5.6.7.8"}))
+			Expect(v1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1beta1.ConditionTrue),
+				"Reason": Equal("SuccessfullyReconciled"),
+			})))
+		})
+
+		Context("approval", func() {
+			BeforeEach(func() {
+				bastion.Spec.Approval = &operationsv1alpha1.BastionApproval{}
+			})
+
+			It("should not create the extension Bastion as long as the Bastion is not approved", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+				Expect(bastion.Finalizers).To(ConsistOf("gardener"))
+				Expect(v1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Status": Equal(gardencorev1beta1.ConditionFalse),
+					"Reason": Equal("ApprovalPending"),
+				})))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(BeNotFoundError())
+			})
+
+			It("should create the extension Bastion once the Bastion is approved", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(BeNotFoundError())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+				bastion.Spec.Approval.ApprovedBy = ptr.To("approver")
+				Expect(gardenClient.Update(ctx, bastion)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(Succeed())
+			})
+		})
+
+		Context("session recording", func() {
+			var sessionsBackupEntry *gardencorev1beta1.BackupEntry
+
+			BeforeEach(func() {
+				bastion.Spec.SessionRecording = &operationsv1alpha1.BastionSessionRecording{Enabled: true}
+				sessionsBackupEntry = &gardencorev1beta1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bastion-sessions-" + shootTechnicalID + "--" + bastionUID,
+						Namespace: namespace,
+					},
+				}
+			})
+
+			It("should fail if the Shoot has no BackupEntry", func() {
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(MatchError(ContainSubstring("failed to get BackupEntry of shoot for session recordings")))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+				Expect(v1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Status": Equal(gardencorev1beta1.ConditionFalse),
+					"Reason": Equal("FailedReconciling"),
+				})))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(BeNotFoundError())
+			})
+
+			It("should create a dedicated BackupEntry and reference it in the extension Bastion", func() {
+				Expect(gardenClient.Create(ctx, shootBackupEntry)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(sessionsBackupEntry), sessionsBackupEntry)).To(Succeed())
+				Expect(sessionsBackupEntry.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/purpose", "production"))
+				Expect(sessionsBackupEntry.OwnerReferences).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"Kind":       Equal("Shoot"),
+					"Name":       Equal(shoot.Name),
+					"Controller": PointTo(BeTrue()),
+				})))
+				Expect(sessionsBackupEntry.Spec.BucketName).To(Equal("bucket"))
+				Expect(sessionsBackupEntry.Spec.SeedName).To(PointTo(Equal("seed")))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(Succeed())
+				Expect(extensionsBastion.Spec.SessionRecording).To(Equal(&extensionsv1alpha1.BastionSessionRecording{BackupEntryName: sessionsBackupEntry.Name}))
+
+				userData := string(extensionsBastion.Spec.UserData)
+				Expect(userData).To(ContainSubstring(`echo "ssh-ed25519 AAAA" > /home/gardener/.ssh/authorized_keys`))
+				Expect(userData).To(ContainSubstring("chmod 0700 /var/log/bastion-sessions"))
+				Expect(userData).To(ContainSubstring("gardener ALL=(root) NOPASSWD: /usr/local/sbin/bastion-session-recorder"))
+				Expect(userData).To(ContainSubstring("ForceCommand /usr/bin/sudo --non-interactive /usr/local/sbin/bastion-session-recorder"))
+				Expect(userData).To(ContainSubstring("runuser -u gardener"))
+				Expect(userData).To(ContainSubstring("AllowTcpForwarding local"))
+				Expect(userData).To(ContainSubstring("PermitOpen *:22"))
+				Expect(userData).NotTo(ContainSubstring("NOPASSWD:ALL"))
+			})
+		})
+
+		Context("deletion", func() {
+			BeforeEach(func() {
+				bastion.Finalizers = []string{"gardener"}
+				bastion.Spec.SessionRecording = &operationsv1alpha1.BastionSessionRecording{Enabled: true}
+			})
+
+			JustBeforeEach(func() {
+				Expect(gardenClient.Delete(ctx, bastion)).To(Succeed())
+			})
+
+			It("should delete the extension Bastion and requeue", func() {
+				Expect(seedClient.Create(ctx, extensionsBastion)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionsBastion), extensionsBastion)).To(BeNotFoundError())
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+				Expect(v1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Status": Equal(gardencorev1beta1.ConditionFalse),
+					"Reason": Equal("DeletionInProgress"),
+				})))
+			})
+
+			It("should delete the session recording BackupEntry and remove the finalizer once the extension Bastion is gone", func() {
+				sessionsBackupEntry := &gardencorev1beta1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bastion-sessions-" + shootTechnicalID + "--" + bastionUID,
+						Namespace: namespace,
+					},
+				}
+				Expect(gardenClient.Create(ctx, sessionsBackupEntry)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(sessionsBackupEntry), sessionsBackupEntry)).To(BeNotFoundError())
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(BeNotFoundError())
+			})
+		})
+	})
+})
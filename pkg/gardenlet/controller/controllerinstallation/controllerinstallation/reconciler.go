@@ -43,6 +43,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/gardener/gardener/pkg/utils/oci"
@@ -65,6 +66,8 @@ type Reconciler struct {
 	Clock                 clock.Clock
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
+	// ImageRewriteRules are the image rewrite rules of gardenlet which are passed to the extension charts.
+	ImageRewriteRules []imagevectorutils.RewriteRule
 }
 
 // Reconcile reconciles ControllerInstallations and deploys them into the seed cluster.
@@ -217,6 +220,13 @@ func (r *Reconciler) reconcile(
 		featureToEnabled[feature] = features.DefaultFeatureGate.Enabled(feature)
 	}
 
+	gardenletValues := map[string]any{
+		"featureGates": featureToEnabled,
+	}
+	if len(r.ImageRewriteRules) > 0 {
+		gardenletValues["imageRewriteRules"] = r.ImageRewriteRules
+	}
+
 	// Mix-in some standard values for garden and seed.
 	gardenerValues := map[string]any{
 		"gardener": map[string]any{
@@ -242,9 +252,7 @@ func (r *Reconciler) reconcile(
 				"blockCIDRs":      seed.Spec.Networks.BlockCIDRs,
 				"spec":            seed.Spec,
 			},
-			"gardenlet": map[string]any{
-				"featureGates": featureToEnabled,
-			},
+			"gardenlet": gardenletValues,
 		},
 	}
 
@@ -518,7 +518,8 @@ func deployBackupBucketInGarden(ctx context.Context, k8sGardenClient client.Clie
 				Name:      seed.Spec.Backup.SecretRef.Name,
 				Namespace: seed.Spec.Backup.SecretRef.Namespace,
 			},
-			SeedName: &seed.Name, // In future this will be moved to gardener-scheduler.
+			SeedName:    &seed.Name, // In future this will be moved to gardener-scheduler.
+			Replication: seed.Spec.Backup.Replication,
 		}
 		return nil
 	})
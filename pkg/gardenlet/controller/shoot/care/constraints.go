@@ -5,20 +5,28 @@
 package care
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
+	"github.com/prometheus/common/expfmt"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -157,9 +165,16 @@ func (c *Constraint) constraintsChecks(
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, status, reason, message)
 	}
 
+	status, reason, message, err = c.CheckIfRemovedAPIsInUse(ctx, c.shootClient, shootClient.RESTClient())
+	if err != nil {
+		constraints.noRemovedAPIsInUse = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.noRemovedAPIsInUse, err)
+	} else {
+		constraints.noRemovedAPIsInUse = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.noRemovedAPIsInUse, status, reason, message)
+	}
+
 	return filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks},
+		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.noRemovedAPIsInUse},
 	)
 }
 
@@ -270,6 +285,156 @@ func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx con
 		nil
 }
 
+// metricRequestedDeprecatedAPIs is the name of the kube-apiserver metric which reports the deprecated APIs that have
+// been requested since the kube-apiserver has been started.
+const metricRequestedDeprecatedAPIs = "apiserver_requested_deprecated_apis"
+
+type removedAPI struct {
+	resource     string
+	kind         string
+	groupVersion schema.GroupVersion
+	// listVersion is a version of the API which is still served and not deprecated in the current minor version. Stored
+	// objects are listed via this version, as listing them via the removed version would be counted as a request to a
+	// deprecated API itself.
+	listVersion string
+}
+
+// removedAPIs contains the built-in APIs which are removed in the given Kubernetes minor version, see
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/.
+var removedAPIs = map[string][]removedAPI{
+	"1.26": {
+		{groupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1"}, resource: "flowschemas", kind: "FlowSchema", listVersion: "v1beta2"},
+		{groupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1"}, resource: "prioritylevelconfigurations", kind: "PriorityLevelConfiguration", listVersion: "v1beta2"},
+		{groupVersion: schema.GroupVersion{Group: "autoscaling", Version: "v2beta2"}, resource: "horizontalpodautoscalers", kind: "HorizontalPodAutoscaler", listVersion: "v2"},
+	},
+	"1.27": {
+		{groupVersion: schema.GroupVersion{Group: "storage.k8s.io", Version: "v1beta1"}, resource: "csistoragecapacities", kind: "CSIStorageCapacity", listVersion: "v1"},
+	},
+	"1.29": {
+		{groupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2"}, resource: "flowschemas", kind: "FlowSchema", listVersion: "v1beta3"},
+		{groupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2"}, resource: "prioritylevelconfigurations", kind: "PriorityLevelConfiguration", listVersion: "v1beta3"},
+	},
+	"1.32": {
+		{groupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3"}, resource: "flowschemas", kind: "FlowSchema", listVersion: "v1"},
+		{groupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3"}, resource: "prioritylevelconfigurations", kind: "PriorityLevelConfiguration", listVersion: "v1"},
+	},
+}
+
+// CheckIfRemovedAPIsInUse checks whether deprecated APIs which are removed in the next minor Kubernetes version are
+// still used in the Shoot cluster. It evaluates the 'apiserver_requested_deprecated_apis' metric of the shoot's
+// kube-apiserver. As this metric is reset when the kube-apiserver restarts, it additionally checks the stored objects
+// of the removed built-in APIs for field managers which have written them via the removed API version. The objects are
+// listed via a version which is not deprecated, so that this check does not show up in the metric itself.
+func (c *Constraint) CheckIfRemovedAPIsInUse(ctx context.Context, shootClient client.Client, restClient rest.Interface) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	if c.shoot.KubernetesVersion == nil {
+		return "", "", "", fmt.Errorf("kubernetes version of shoot is unknown")
+	}
+	nextMinorVersion := c.shoot.KubernetesVersion.IncMinor()
+
+	removedAPIsInUse, err := removedAPIsRequested(ctx, restClient, nextMinorVersion)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	removedAPIsStored, err := removedAPIsWrittenToStoredObjects(ctx, shootClient, nextMinorVersion)
+	if err != nil {
+		return "", "", "", err
+	}
+	removedAPIsInUse.Insert(removedAPIsStored.UnsortedList()...)
+
+	if removedAPIsInUse.Len() > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			"RemovedAPIsInUse",
+			fmt.Sprintf("Some APIs which are removed in Kubernetes version %d.%d are still in use: %s. Please migrate your workload before updating the Kubernetes version.",
+				nextMinorVersion.Major(), nextMinorVersion.Minor(), strings.Join(sets.List(removedAPIsInUse), ", ")),
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"NoRemovedAPIsInUse",
+		fmt.Sprintf("No APIs which are removed in Kubernetes version %d.%d are in use.", nextMinorVersion.Major(), nextMinorVersion.Minor()),
+		nil
+}
+
+func removedAPIsRequested(ctx context.Context, restClient rest.Interface, nextMinorVersion semver.Version) (sets.Set[string], error) {
+	metrics, err := restClient.Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch metrics of the shoot's kube-apiserver: %w", err)
+	}
+
+	var parser expfmt.TextParser
+	metricFamilies, err := parser.TextToMetricFamilies(bytes.NewReader(metrics))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse metrics of the shoot's kube-apiserver: %w", err)
+	}
+
+	removedAPIsInUse := sets.New[string]()
+	if metricFamily, ok := metricFamilies[metricRequestedDeprecatedAPIs]; ok {
+		for _, metric := range metricFamily.GetMetric() {
+			if metric.GetGauge().GetValue() <= 0 {
+				continue
+			}
+
+			labels := make(map[string]string, len(metric.GetLabel()))
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			removedRelease, err := semver.NewVersion(labels["removed_release"])
+			if err != nil {
+				// APIs without a planned removal release are not relevant for the next minor version.
+				continue
+			}
+
+			if removedRelease.Major() != nextMinorVersion.Major() || removedRelease.Minor() > nextMinorVersion.Minor() {
+				continue
+			}
+
+			api := labels["resource"]
+			if subresource := labels["subresource"]; subresource != "" {
+				api += "/" + subresource
+			}
+			groupVersion := labels["version"]
+			if group := labels["group"]; group != "" {
+				groupVersion = group + "/" + groupVersion
+			}
+			removedAPIsInUse.Insert(fmt.Sprintf("%s %s (removed in %d.%d)", groupVersion, api, removedRelease.Major(), removedRelease.Minor()))
+		}
+	}
+
+	return removedAPIsInUse, nil
+}
+
+func removedAPIsWrittenToStoredObjects(ctx context.Context, shootClient client.Client, nextMinorVersion semver.Version) (sets.Set[string], error) {
+	removedAPIsInUse := sets.New[string]()
+
+	for _, api := range removedAPIs[fmt.Sprintf("%d.%d", nextMinorVersion.Major(), nextMinorVersion.Minor())] {
+		listGroupVersion := schema.GroupVersion{Group: api.groupVersion.Group, Version: api.listVersion}
+
+		objectList := &metav1.PartialObjectMetadataList{}
+		objectList.SetGroupVersionKind(listGroupVersion.WithKind(api.kind + "List"))
+
+		if err := shootClient.List(ctx, objectList); err != nil {
+			if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+				// The API is not served by the kube-apiserver, hence there cannot be any objects written via it.
+				continue
+			}
+			return nil, fmt.Errorf("could not list %s %s in the shoot cluster: %w", listGroupVersion, api.resource, err)
+		}
+
+		for _, object := range objectList.Items {
+			if slices.ContainsFunc(object.ManagedFields, func(entry metav1.ManagedFieldsEntry) bool {
+				return entry.APIVersion == api.groupVersion.String()
+			}) {
+				removedAPIsInUse.Insert(fmt.Sprintf("%s %s (removed in %d.%d)", api.groupVersion, api.resource, nextMinorVersion.Major(), nextMinorVersion.Minor()))
+				break
+			}
+		}
+	}
+
+	return removedAPIsInUse, nil
+}
+
 // CheckForProblematicWebhooks checks the Shoot for problematic webhooks which could prevent shoot worker nodes from
 // joining the cluster.
 func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, []gardencorev1beta1.ErrorCode, error) {
@@ -425,6 +590,7 @@ type ShootConstraints struct {
 	maintenancePreconditionsSatisfied     gardencorev1beta1.Condition
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
+	noRemovedAPIsInUse                    gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -434,6 +600,7 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.maintenancePreconditionsSatisfied,
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
+		g.noRemovedAPIsInUse,
 	}
 }
 
@@ -444,6 +611,7 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.maintenancePreconditionsSatisfied.Type,
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
+		g.noRemovedAPIsInUse.Type,
 	}
 }
 
@@ -455,5 +623,6 @@ func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoo
 		maintenancePreconditionsSatisfied:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootMaintenancePreconditionsSatisfied),
 		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
 		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
+		noRemovedAPIsInUse:                    v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootNoRemovedAPIsInUse),
 	}
 }
@@ -5,11 +5,15 @@
 package care_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -22,6 +26,7 @@ import (
 	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -34,12 +39,16 @@ import (
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	fakerestclient "k8s.io/client-go/rest/fake"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -442,10 +451,13 @@ var _ = Describe("Constraints", func() {
 
 	Describe("Constraint", func() {
 		var (
-			ctx           = context.Background()
-			seedNamespace = "shoot--foo--bar"
-			seedClient    client.Client
-			shootClient   client.Client
+			ctx             = context.Background()
+			seedNamespace   = "shoot--foo--bar"
+			seedClient      client.Client
+			shootClient     client.Client
+			shootMetrics    string
+			shootRESTClient rest.Interface
+			operationShoot  *shootpkg.Shoot
 
 			constraint *Constraint
 
@@ -469,18 +481,29 @@ var _ = Describe("Constraints", func() {
 		BeforeEach(func() {
 			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			shootMetrics = ""
+			shootRESTClient = &fakerestclient.RESTClient{
+				NegotiatedSerializer: scheme.Codecs,
+				Client: fakerestclient.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+					if req.URL.Path != "/metrics" {
+						return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(&bytes.Buffer{})}, nil
+					}
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(shootMetrics))}, nil
+				}),
+			}
 
-			shoot := &shootpkg.Shoot{
-				SeedNamespace: seedNamespace,
+			operationShoot = &shootpkg.Shoot{
+				SeedNamespace:     seedNamespace,
+				KubernetesVersion: semver.MustParse("1.29.3"),
 			}
-			shoot.SetInfo(&gardencorev1beta1.Shoot{})
+			operationShoot.SetInfo(&gardencorev1beta1.Shoot{})
 
 			constraint = NewConstraint(
 				logr.Discard(),
-				shoot,
+				operationShoot,
 				seedClient,
 				func() (kubernetes.Interface, bool, error) {
-					return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+					return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).WithRESTClient(shootRESTClient).Build(), true, nil
 				},
 				clock,
 			)
@@ -494,6 +517,7 @@ var _ = Describe("Constraints", func() {
 							{Type: gardencorev1beta1.ShootHibernationPossible},
 							{Type: gardencorev1beta1.ShootMaintenancePreconditionsSatisfied},
 							{Type: gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks},
+							{Type: gardencorev1beta1.ShootNoRemovedAPIsInUse},
 						},
 					},
 				}
@@ -517,6 +541,24 @@ var _ = Describe("Constraints", func() {
 				))
 			})
 
+			It("should not keep the `NoRemovedAPIsInUse` condition when it's true", func() {
+				Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+					OfType(gardencorev1beta1.ShootNoRemovedAPIsInUse),
+				))
+			})
+
+			It("should keep the `NoRemovedAPIsInUse` condition when it's false", func() {
+				shootMetrics = `# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="flowschemas",subresource="",version="v1beta2"} 1
+`
+
+				Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+					OfType(gardencorev1beta1.ShootNoRemovedAPIsInUse),
+					WithStatus(gardencorev1beta1.ConditionProgressing),
+					WithReason("RemovedAPIsInUse"),
+				))
+			})
+
 			It("should not keep the `CRDsWithProblematicConversionWebhooks` condition when it's true", func() {
 				Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
 					OfType(gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
@@ -562,6 +604,108 @@ var _ = Describe("Constraints", func() {
 			})
 		})
 
+		Describe("#CheckIfRemovedAPIsInUse", func() {
+			It("should return a 'true' condition when no deprecated APIs are in use", func() {
+				status, reason, message, err := constraint.CheckIfRemovedAPIsInUse(ctx, shootClient, shootRESTClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("NoRemovedAPIsInUse"))
+				Expect(message).To(Equal("No APIs which are removed in Kubernetes version 1.30 are in use."))
+			})
+
+			It("should return a 'true' condition when only deprecated APIs removed in later versions are in use", func() {
+				shootMetrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="example.com",removed_release="",resource="foos",subresource="",version="v1alpha1"} 1
+`
+
+				status, reason, _, err := constraint.CheckIfRemovedAPIsInUse(ctx, shootClient, shootRESTClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("NoRemovedAPIsInUse"))
+			})
+
+			It("should return a 'false' condition when deprecated APIs removed in the next minor version are in use", func() {
+				shootMetrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="flowschemas",subresource="",version="v1beta2"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="1.30",resource="foos",subresource="status",version="v1beta1"} 1
+`
+
+				status, reason, message, err := constraint.CheckIfRemovedAPIsInUse(ctx, shootClient, shootRESTClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+				Expect(reason).To(Equal("RemovedAPIsInUse"))
+				Expect(message).To(Equal("Some APIs which are removed in Kubernetes version 1.30 are still in use: flowcontrol.apiserver.k8s.io/v1beta2 flowschemas (removed in 1.29), v1beta1 foos/status (removed in 1.30). Please migrate your workload before updating the Kubernetes version."))
+			})
+
+			It("should return a 'false' condition when stored objects have been written via APIs removed in the next minor version", func() {
+				operationShoot.KubernetesVersion = semver.MustParse("1.31.2")
+
+				Expect(shootClient.Create(ctx, &flowcontrolv1.FlowSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:          "written-via-v1",
+						ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "foo", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "flowcontrol.apiserver.k8s.io/v1"}},
+					},
+				})).To(Succeed())
+				Expect(shootClient.Create(ctx, &flowcontrolv1.PriorityLevelConfiguration{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:          "written-via-v1beta3",
+						ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "bar", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "flowcontrol.apiserver.k8s.io/v1beta3"}},
+					},
+				})).To(Succeed())
+
+				status, reason, message, err := constraint.CheckIfRemovedAPIsInUse(ctx, shootClient, shootRESTClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+				Expect(reason).To(Equal("RemovedAPIsInUse"))
+				Expect(message).To(Equal("Some APIs which are removed in Kubernetes version 1.32 are still in use: flowcontrol.apiserver.k8s.io/v1beta3 prioritylevelconfigurations (removed in 1.32). Please migrate your workload before updating the Kubernetes version."))
+			})
+
+			It("should return a 'true' condition when stored objects have only been written via APIs which are not removed", func() {
+				operationShoot.KubernetesVersion = semver.MustParse("1.31.2")
+
+				Expect(shootClient.Create(ctx, &flowcontrolv1.FlowSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:          "written-via-v1",
+						ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "foo", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "flowcontrol.apiserver.k8s.io/v1"}},
+					},
+				})).To(Succeed())
+
+				status, reason, message, err := constraint.CheckIfRemovedAPIsInUse(ctx, shootClient, shootRESTClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("NoRemovedAPIsInUse"))
+				Expect(message).To(Equal("No APIs which are removed in Kubernetes version 1.32 are in use."))
+			})
+
+			It("should not list stored objects via the removed API versions", func() {
+				operationShoot.KubernetesVersion = semver.MustParse("1.31.2")
+
+				var listedGroupVersions []string
+				shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						listedGroupVersions = append(listedGroupVersions, list.GetObjectKind().GroupVersionKind().GroupVersion().String())
+						return c.List(ctx, list, opts...)
+					},
+				}).Build()
+
+				status, _, _, err := constraint.CheckIfRemovedAPIsInUse(ctx, shootClient, shootRESTClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(listedGroupVersions).To(ConsistOf("flowcontrol.apiserver.k8s.io/v1", "flowcontrol.apiserver.k8s.io/v1"))
+			})
+
+			It("should return an error when the metrics cannot be parsed", func() {
+				shootMetrics = "this is not a metric"
+
+				_, _, _, err := constraint.CheckIfRemovedAPIsInUse(ctx, shootClient, shootRESTClient)
+				Expect(err).To(MatchError(ContainSubstring("could not parse metrics of the shoot's kube-apiserver")))
+			})
+		})
+
 		Describe("#CheckIfCACertificateValiditiesAcceptable", func() {
 			var (
 				expectTrueCondition = func(status gardencorev1beta1.ConditionStatus, reason, message string, errorCodes []gardencorev1beta1.ErrorCode) {
@@ -641,6 +785,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -660,6 +805,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -673,6 +819,7 @@ var _ = Describe("Constraints", func() {
 					OfType("MaintenancePreconditionsSatisfied"),
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
+					OfType("NoRemovedAPIsInUse"),
 				))
 			})
 		})
@@ -686,6 +833,7 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("MaintenancePreconditionsSatisfied"),
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
+					gardencorev1beta1.ConditionType("NoRemovedAPIsInUse"),
 				))
 			})
 		})
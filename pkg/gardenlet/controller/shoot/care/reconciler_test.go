@@ -628,5 +628,10 @@ func consistOfConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
 			"Message": Equal(message),
 		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootNoRemovedAPIsInUse),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
 	)
 }
@@ -44,6 +44,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/utils"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -86,6 +87,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	// The operation ID is propagated to the extension resources and ManagedResources deployed during this reconciliation
+	// so that the logs of all involved components can be correlated.
+	operationID := logger.NewOperationID()
+	ctx = logger.ContextWithOperationID(ctx, operationID)
+	log = log.WithValues(logger.KeyOperationID, operationID)
+
 	if shoot.DeletionTimestamp != nil {
 		return r.deleteShoot(ctx, log, shoot)
 	}
@@ -611,6 +618,10 @@ func (r *Reconciler) updateShootStatusOperationStart(
 	case v1beta1constants.OperationRotateETCDEncryptionKeyComplete:
 		mustRemoveOperationAnnotation = true
 		completeRotationETCDEncryptionKey(shoot, &now)
+
+	case v1beta1constants.ShootOperationRestoreETCD:
+		mustRemoveOperationAnnotation = true
+		startETCDRestore(shoot, &now)
 	}
 
 	if err := r.GardenClient.Status().Update(ctx, shoot); err != nil {
@@ -1013,3 +1024,11 @@ func startRotationObservability(shoot *gardencorev1beta1.Shoot, now *metav1.Time
 		rotation.LastInitiationTime = now
 	})
 }
+
+func startETCDRestore(shoot *gardencorev1beta1.Shoot, now *metav1.Time) {
+	shoot.Status.LastETCDRestore = &gardencorev1beta1.LastETCDRestore{
+		Description:   "Restore of main etcd from the latest snapshot in its backup initialized.",
+		TriggeredTime: *now,
+		State:         gardencorev1beta1.LastOperationStateProcessing,
+	}
+}
@@ -400,17 +400,8 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 			Fn:           botanist.Shoot.Components.BackupEntry.WaitMigrate,
 			Dependencies: flow.NewTaskIDs(migrateBackupEntryInGarden),
 		})
-		destroyEtcd = g.Add(flow.Task{
-			Name:         "Destroying main and events etcd",
-			Fn:           flow.TaskFn(botanist.DestroyEtcd).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(syncPoint, createETCDSnapshot, waitUntilBackupEntryInGardenMigrated),
-		})
-		waitUntilEtcdDeleted = g.Add(flow.Task{
-			Name:         "Waiting until main and event etcd have been destroyed",
-			Fn:           flow.TaskFn(botanist.WaitUntilEtcdsDeleted).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(destroyEtcd),
-		})
-		deleteNamespace = g.Add(flow.Task{
+		waitUntilEtcdDeleted = addDestroyEtcdTasks(g, botanist, false, defaultInterval, defaultTimeout, syncPoint, createETCDSnapshot, waitUntilBackupEntryInGardenMigrated)
+		deleteNamespace      = g.Add(flow.Task{
 			Name:         "Deleting shoot namespace in Seed",
 			Fn:           flow.TaskFn(botanist.DeleteSeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(syncPoint, waitUntilBackupEntryInGardenMigrated, deleteExtensionResources, destroyDNSRecords, waitForManagedResourcesDeletion, waitUntilEtcdDeleted),
@@ -436,3 +427,22 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 	o.Logger.Info("Successfully prepared Shoot cluster for restoration")
 	return nil
 }
+
+// addDestroyEtcdTasks adds the tasks for destroying the main and events etcd to the given graph and returns the ID of
+// the task waiting until they are gone. The tasks are used when migrating the control plane as well as when restoring
+// the main etcd from its backup.
+func addDestroyEtcdTasks(g *flow.Graph, botanist *botanistpkg.Botanist, skip bool, interval, timeout time.Duration, dependencies ...flow.TaskIDer) flow.TaskID {
+	destroyEtcd := g.Add(flow.Task{
+		Name:         "Destroying main and events etcd",
+		Fn:           flow.TaskFn(botanist.DestroyEtcd).RetryUntilTimeout(interval, timeout),
+		SkipIf:       skip,
+		Dependencies: flow.NewTaskIDs(dependencies...),
+	})
+
+	return g.Add(flow.Task{
+		Name:         "Waiting until main and event etcd have been destroyed",
+		Fn:           flow.TaskFn(botanist.WaitUntilEtcdsDeleted).RetryUntilTimeout(interval, timeout),
+		SkipIf:       skip,
+		Dependencies: flow.NewTaskIDs(destroyEtcd),
+	})
+}
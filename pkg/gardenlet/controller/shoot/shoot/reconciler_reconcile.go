@@ -102,6 +102,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		shootControlPlaneLoggingEnabled = botanist.Shoot.IsShootControlPlaneLoggingEnabled(botanist.Config)
 		deployKubeAPIServerTaskTimeout  = defaultTimeout
 		shootSSHAccessEnabled           = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
+		etcdRestoreRequested            = botanist.IsETCDRestoreRequested() && allowBackup && !o.Shoot.HibernationEnabled
 	)
 
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
@@ -121,6 +122,15 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitExtensionAfterKAPIMsg = "Waiting until extension resources hibernated before kube-apiserver hibernation are ready"
 	}
 
+	// The main etcd is only restored from its backup if it was not yet re-created since the restore was requested, i.e.,
+	// the restore is performed at most once even if the restore is not completed in this reconciliation.
+	etcdRestorePending, err := botanist.IsETCDRestorePending(ctx)
+	if err != nil {
+		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+	}
+	etcdRestorePending = etcdRestorePending && etcdRestoreRequested
+	o.Shoot.ETCDRestorePending = etcdRestorePending
+
 	if staticNodesCIDR {
 		networks, err := shoot.ToNetworks(o.Shoot.GetInfo(), o.Shoot.IsWorkerless)
 		if err != nil {
@@ -281,10 +291,19 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       !isCopyOfBackupsRequired,
 			Dependencies: flow.NewTaskIDs(waitUntilEtcdBackupsCopied),
 		})
+		// The main etcd is restored from its backup like during the restore phase of a control plane migration, i.e., it
+		// is destroyed in the same way as in the migrate flow, and it restores its data when it is deployed again.
+		waitUntilEtcdDeletedForRestore = addDestroyEtcdTasks(g, botanist, !etcdRestorePending, defaultInterval, defaultTimeout, waitUntilBackupEntryInGardenReconciled)
+		destroyETCDForRestore          = g.Add(flow.Task{
+			Name:         "Deleting volumes of main etcd for restoration from backup",
+			Fn:           flow.TaskFn(botanist.DeleteMainEtcdVolumes).Timeout(10 * time.Minute),
+			SkipIf:       !etcdRestorePending,
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdDeletedForRestore),
+		})
 		deployETCD = g.Add(flow.Task{
 			Name:         "Deploying main and events etcd",
 			Fn:           flow.TaskFn(botanist.DeployEtcd).RetryUntilTimeout(defaultInterval, helper.GetEtcdDeployTimeout(o.Shoot, defaultTimeout)),
-			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilEtcdBackupsCopied),
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilEtcdBackupsCopied, destroyETCDForRestore),
 		})
 		destroySourceBackupEntry = g.Add(flow.Task{
 			Name:         "Destroying source backup entry",
@@ -304,6 +323,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
+		restartKubeAPIServerAfterETCDRestore = g.Add(flow.Task{
+			Name:         "Restarting Kubernetes API server after restoration of main etcd",
+			Fn:           botanist.RestartKubeAPIServer,
+			SkipIf:       !etcdRestorePending,
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdReady),
+		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
 			Name:         "Deploying extension resources before kube-apiserver",
 			Fn:           flow.TaskFn(botanist.DeployExtensionsBeforeKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -323,6 +348,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				initializeSecretsManagement,
 				deployETCD,
 				waitUntilEtcdReady,
+				restartKubeAPIServerAfterETCDRestore,
 				waitUntilKubeAPIServerServiceIsReady,
 				waitUntilExtensionResourcesBeforeKAPIReady,
 			).InsertIf(!staticNodesCIDR, waitUntilInfrastructureReady),
@@ -336,15 +362,23 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		scaleEtcdAfterRestore = g.Add(flow.Task{
 			Name:         "Scaling main and events etcd after kube-apiserver is ready",
 			Fn:           flow.TaskFn(botanist.ScaleUpETCD).RetryUntilTimeout(defaultInterval, helper.GetEtcdDeployTimeout(o.Shoot, defaultTimeout)),
-			SkipIf:       !v1beta1helper.IsHAControlPlaneConfigured(botanist.Shoot.GetInfo()) || !botanist.IsRestorePhase() || o.Shoot.HibernationEnabled || skipReadiness,
+			SkipIf:       !v1beta1helper.IsHAControlPlaneConfigured(botanist.Shoot.GetInfo()) || (!botanist.IsRestorePhase() && !etcdRestorePending) || o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady),
 		})
-		_ = g.Add(flow.Task{
+		waitUntilEtcdScaledAfterRestore = g.Add(flow.Task{
 			Name:         "Waiting until main and events etcd scaled up after kube-apiserver is ready",
 			Fn:           flow.TaskFn(botanist.WaitUntilEtcdsReady),
-			SkipIf:       !v1beta1helper.IsHAControlPlaneConfigured(botanist.Shoot.GetInfo()) || !botanist.IsRestorePhase() || o.Shoot.HibernationEnabled || skipReadiness,
+			SkipIf:       !v1beta1helper.IsHAControlPlaneConfigured(botanist.Shoot.GetInfo()) || (!botanist.IsRestorePhase() && !etcdRestorePending) || o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(scaleEtcdAfterRestore),
 		})
+		// The restore is also completed if the main etcd was already re-created from its backup in a previous
+		// reconciliation which failed afterwards.
+		_ = g.Add(flow.Task{
+			Name:         "Completing restoration of main etcd from backup",
+			Fn:           flow.TaskFn(botanist.CompleteETCDRestore).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !etcdRestoreRequested,
+			Dependencies: flow.NewTaskIDs(restartKubeAPIServerAfterETCDRestore, waitUntilKubeAPIServerIsReady, waitUntilEtcdScaledAfterRestore),
+		})
 		deployGardenerResourceManager = g.Add(flow.Task{
 			Name:         "Deploying gardener-resource-manager",
 			Fn:           flow.TaskFn(botanist.DeployGardenerResourceManager).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -28,5 +28,7 @@ func GetFeatures() []featuregate.Feature {
 		features.VPAAndHPAForAPIServer,
 		features.NewWorkerPoolHash,
 		features.NewVPN,
+		features.NodeAgentAttestation,
+		features.NodeAgentRemediation,
 	}
 }
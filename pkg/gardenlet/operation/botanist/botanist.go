@@ -167,6 +167,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 	if err != nil {
 		return nil, err
 	}
+	o.Shoot.Components.ControlPlane.LogExport = b.DefaultLogExport()
 
 	// system components
 	o.Shoot.Components.SystemComponents.Resources = b.DefaultShootSystem()
@@ -6,7 +6,10 @@ package botanist
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -24,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
@@ -128,7 +132,31 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 		}
 	}
 
-	return b.deployOrRestoreEtcd(ctx)
+	if err := b.deployOrRestoreEtcd(ctx); err != nil {
+		return err
+	}
+
+	if b.Shoot.ETCDRestorePending {
+		return b.markEtcdRestored(ctx)
+	}
+	return nil
+}
+
+// markEtcdRestored annotates the main etcd which was re-created for the requested restore from its backup with the time
+// when the restore was triggered, so that the restore is not performed again.
+func (b *Botanist) markEtcdRestored(ctx context.Context) error {
+	etcdMain, err := b.Shoot.Components.ControlPlane.EtcdMain.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(etcdMain.DeepCopy())
+	metav1.SetMetaDataAnnotation(&etcdMain.ObjectMeta, v1beta1constants.AnnotationETCDRestoreTriggeredTime, etcdRestoreMarker(b.Shoot.GetInfo().Status.LastETCDRestore))
+	return b.SeedClientSet.Client().Patch(ctx, etcdMain, patch)
+}
+
+func etcdRestoreMarker(restore *gardencorev1beta1.LastETCDRestore) string {
+	return restore.TriggeredTime.UTC().Format(time.RFC3339)
 }
 
 // WaitUntilEtcdsReady waits until both etcd-main and etcd-events are ready.
@@ -155,6 +183,73 @@ func (b *Botanist) WaitUntilEtcdsDeleted(ctx context.Context) error {
 	)(ctx)
 }
 
+// IsETCDRestoreRequested returns true if a restore of the main etcd from its backup was requested and is not yet
+// completed.
+func (b *Botanist) IsETCDRestoreRequested() bool {
+	restore := b.Shoot.GetInfo().Status.LastETCDRestore
+	return restore != nil && restore.State == gardencorev1beta1.LastOperationStateProcessing
+}
+
+// IsETCDRestorePending returns true if a restore of the main etcd from its backup was requested and the main etcd was
+// not yet re-created for it, i.e., it does not carry the time when the restore was triggered in its annotations.
+func (b *Botanist) IsETCDRestorePending(ctx context.Context) (bool, error) {
+	if !b.IsETCDRestoreRequested() {
+		return false, nil
+	}
+
+	etcdMain, err := b.Shoot.Components.ControlPlane.EtcdMain.Get(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return etcdMain.Annotations[v1beta1constants.AnnotationETCDRestoreTriggeredTime] != etcdRestoreMarker(b.Shoot.GetInfo().Status.LastETCDRestore), nil
+}
+
+// DeleteMainEtcdVolumes deletes the volumes of the main etcd and waits until they are gone, so that the main etcd is
+// restored from the latest snapshot in its backup when it is deployed again. The main etcd must have been destroyed
+// before. The volumes are found by the labels which the etcd StatefulSet adds to the PersistentVolumeClaims of its
+// volume claim template, hence all volumes are deleted independent of the number of replicas. The volume of the events
+// etcd is kept as it is not restored from a backup.
+func (b *Botanist) DeleteMainEtcdVolumes(ctx context.Context) error {
+	var (
+		pvcList = &corev1.PersistentVolumeClaimList{}
+		labels  = client.MatchingLabels((&druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}}).GetDefaultLabels())
+	)
+
+	if err := b.SeedClientSet.Client().List(ctx, pvcList, client.InNamespace(b.Shoot.SeedNamespace), labels); err != nil {
+		return fmt.Errorf("failed listing volumes of %s: %w", v1beta1constants.ETCDMain, err)
+	}
+
+	for _, pvc := range pvcList.Items {
+		if err := kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), &pvc); err != nil {
+			return fmt.Errorf("failed deleting volume %s: %w", pvc.Name, err)
+		}
+	}
+
+	if err := kubernetesutils.WaitUntilResourcesDeleted(ctx, b.SeedClientSet.Client(), &corev1.PersistentVolumeClaimList{}, 5*time.Second, client.InNamespace(b.Shoot.SeedNamespace), labels); err != nil {
+		return fmt.Errorf("failed waiting for deletion of volumes of %s: %w", v1beta1constants.ETCDMain, err)
+	}
+
+	return nil
+}
+
+// CompleteETCDRestore marks the restore of the main etcd from its backup as succeeded.
+func (b *Botanist) CompleteETCDRestore(ctx context.Context) error {
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		if shoot.Status.LastETCDRestore == nil {
+			return nil
+		}
+
+		shoot.Status.LastETCDRestore.State = gardencorev1beta1.LastOperationStateSucceeded
+		shoot.Status.LastETCDRestore.Description = "Main etcd has been successfully restored from the latest snapshot in its backup."
+		shoot.Status.LastETCDRestore.CompletionTime = ptr.To(metav1.Now())
+		return nil
+	})
+}
+
 // SnapshotEtcd executes into the etcd-main pod and triggers a full snapshot.
 func (b *Botanist) SnapshotEtcd(ctx context.Context) error {
 	return shared.SnapshotEtcd(ctx, b.SecretsManager, b.Shoot.Components.ControlPlane.EtcdMain)
@@ -194,7 +289,7 @@ func (b *Botanist) deployOrRestoreEtcd(ctx context.Context) error {
 }
 
 func (b *Botanist) isRestorationOfMultiNodeMainEtcdRequired(ctx context.Context) (bool, error) {
-	if !b.IsRestorePhase() || !v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()) {
+	if (!b.IsRestorePhase() && !b.Shoot.ETCDRestorePending) || !v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()) {
 		return false, nil
 	}
 
@@ -45,6 +45,7 @@ import (
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
@@ -521,6 +522,182 @@ var _ = Describe("Etcd", func() {
 			Expect(botanist.DestroyEtcd(ctx)).To(Succeed())
 		})
 	})
+
+	Context("restore of main etcd", func() {
+		var (
+			etcdMain      *mocketcd.MockInterface
+			triggeredTime = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		)
+
+		BeforeEach(func() {
+			etcdMain = mocketcd.NewMockInterface(ctrl)
+
+			botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build()
+			botanist.Shoot = &shootpkg.Shoot{
+				SeedNamespace: namespace,
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						EtcdMain: etcdMain,
+					},
+				},
+			}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					LastETCDRestore: &gardencorev1beta1.LastETCDRestore{
+						State:         gardencorev1beta1.LastOperationStateProcessing,
+						TriggeredTime: triggeredTime,
+					},
+				},
+			})
+		})
+
+		Describe("#IsETCDRestorePending", func() {
+			It("should return false if no restore was requested", func() {
+				botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+				Expect(botanist.IsETCDRestorePending(ctx)).To(BeFalse())
+			})
+
+			It("should return false if the restore already succeeded", func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Status.LastETCDRestore.State = gardencorev1beta1.LastOperationStateSucceeded
+				botanist.Shoot.SetInfo(shoot)
+
+				Expect(botanist.IsETCDRestorePending(ctx)).To(BeFalse())
+			})
+
+			It("should return false if etcd-main was re-created for the restore", func() {
+				etcdMain.EXPECT().Get(ctx).Return(&druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"shoot.gardener.cloud/etcd-restore-triggered-time": "2024-01-01T00:00:00Z"},
+				}}, nil)
+
+				Expect(botanist.IsETCDRestorePending(ctx)).To(BeFalse())
+			})
+
+			It("should return true if etcd-main was re-created for a previous restore", func() {
+				etcdMain.EXPECT().Get(ctx).Return(&druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"shoot.gardener.cloud/etcd-restore-triggered-time": "2023-01-01T00:00:00Z"},
+				}}, nil)
+
+				Expect(botanist.IsETCDRestorePending(ctx)).To(BeTrue())
+			})
+
+			It("should return true if etcd-main was not re-created for a restore", func() {
+				etcdMain.EXPECT().Get(ctx).Return(&druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(triggeredTime.Add(time.Hour)),
+				}}, nil)
+
+				Expect(botanist.IsETCDRestorePending(ctx)).To(BeTrue())
+			})
+
+			It("should return true if etcd-main does not exist", func() {
+				etcdMain.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "etcd-main"))
+
+				Expect(botanist.IsETCDRestorePending(ctx)).To(BeTrue())
+			})
+
+			It("should fail when the etcd-main cannot be read", func() {
+				etcdMain.EXPECT().Get(ctx).Return(nil, fakeErr)
+
+				_, err := botanist.IsETCDRestorePending(ctx)
+				Expect(err).To(MatchError(fakeErr))
+			})
+		})
+
+		Describe("#DeployEtcd", func() {
+			var (
+				etcdEvents *mocketcd.MockInterface
+				seedClient client.Client
+				etcd       *druidv1alpha1.Etcd
+			)
+
+			BeforeEach(func() {
+				etcdEvents = mocketcd.NewMockInterface(ctrl)
+				botanist.Shoot.Components.ControlPlane.EtcdEvents = etcdEvents
+
+				seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+				botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build()
+				botanist.Seed = &seedpkg.Seed{}
+				botanist.Seed.SetInfo(&gardencorev1beta1.Seed{})
+
+				etcd = &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace}}
+				Expect(seedClient.Create(ctx, etcd)).To(Succeed())
+
+				etcdMain.EXPECT().Deploy(ctx)
+				etcdEvents.EXPECT().Deploy(ctx)
+			})
+
+			It("should mark etcd-main as re-created for the restore", func() {
+				botanist.Shoot.ETCDRestorePending = true
+				etcdMain.EXPECT().Get(ctx).DoAndReturn(func(ctx context.Context) (*druidv1alpha1.Etcd, error) {
+					return etcd, seedClient.Get(ctx, client.ObjectKeyFromObject(etcd), etcd)
+				})
+
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(etcd), etcd)).To(Succeed())
+				Expect(etcd.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/etcd-restore-triggered-time", "2024-01-01T00:00:00Z"))
+			})
+
+			It("should not mark etcd-main if no restore is pending", func() {
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(etcd), etcd)).To(Succeed())
+				Expect(etcd.Annotations).NotTo(HaveKey("shoot.gardener.cloud/etcd-restore-triggered-time"))
+			})
+		})
+
+		Describe("#DeleteMainEtcdVolumes", func() {
+			It("should only delete the volumes of the main etcd", func() {
+				newPVC := func(name, instance string) *corev1.PersistentVolumeClaim {
+					return &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+						Labels:    map[string]string{"name": "etcd", "instance": instance},
+					}}
+				}
+
+				pvcMain0 := newPVC("main-etcd-etcd-main-0", "etcd-main")
+				pvcMain4 := newPVC("main-etcd-etcd-main-4", "etcd-main")
+				pvcEvents := newPVC("etcd-events-etcd-events-0", "etcd-events")
+				pvcOther := newPVC("other", "other")
+				for _, pvc := range []*corev1.PersistentVolumeClaim{pvcMain0, pvcMain4, pvcEvents, pvcOther} {
+					Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+				}
+
+				Expect(botanist.DeleteMainEtcdVolumes(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvcMain0), &corev1.PersistentVolumeClaim{})).To(BeNotFoundError())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvcMain4), &corev1.PersistentVolumeClaim{})).To(BeNotFoundError())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvcEvents), &corev1.PersistentVolumeClaim{})).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvcOther), &corev1.PersistentVolumeClaim{})).To(Succeed())
+			})
+		})
+
+		Describe("#CompleteETCDRestore", func() {
+			It("should mark the restore as succeeded", func() {
+				shoot := &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+					Status: gardencorev1beta1.ShootStatus{
+						LastETCDRestore: &gardencorev1beta1.LastETCDRestore{
+							State:         gardencorev1beta1.LastOperationStateProcessing,
+							TriggeredTime: triggeredTime,
+						},
+					},
+				}
+				gardenClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).WithStatusSubresource(shoot).Build()
+				botanist.GardenClient = gardenClient
+				botanist.Shoot.SetInfo(shoot)
+
+				Expect(botanist.CompleteETCDRestore(ctx)).To(Succeed())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.LastETCDRestore.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+				Expect(shoot.Status.LastETCDRestore.CompletionTime).NotTo(BeNil())
+				Expect(botanist.Shoot.GetInfo().Status.LastETCDRestore.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			})
+		})
+	})
 })
 
 type newEtcdValidator struct {
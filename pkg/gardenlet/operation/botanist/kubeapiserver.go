@@ -358,6 +358,12 @@ func (b *Botanist) WakeUpKubeAPIServer(ctx context.Context) error {
 	return b.Shoot.Components.ControlPlane.KubeAPIServer.Wait(ctx)
 }
 
+// RestartKubeAPIServer deletes the kube-apiserver pods so that they are re-created with an empty watch cache, e.g.
+// after the etcd was restored from its backup.
+func (b *Botanist) RestartKubeAPIServer(ctx context.Context) error {
+	return b.SeedClientSet.Client().DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(b.Shoot.SeedNamespace), client.MatchingLabels(kubeapiserver.GetLabels()))
+}
+
 // ScaleKubeAPIServerToOne scales kube-apiserver replicas to one.
 func (b *Botanist) ScaleKubeAPIServerToOne(ctx context.Context) error {
 	return kubernetes.ScaleDeployment(ctx, b.SeedClientSet.Client(), client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: v1beta1constants.DeploymentNameKubeAPIServer}, 1)
@@ -6,12 +6,14 @@ package botanist
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gardener/gardener/imagevector"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/logging/eventlogger"
+	"github.com/gardener/gardener/pkg/component/observability/logging/logexport"
 	"github.com/gardener/gardener/pkg/component/shared"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -33,6 +35,10 @@ func (b *Botanist) DeployLogging(ctx context.Context) error {
 		}
 	}
 
+	if err := b.deployLogExport(ctx); err != nil {
+		return err
+	}
+
 	// check if vali is enabled in gardenlet config, default is true
 	if !gardenlethelper.IsValiEnabled(b.Config) {
 		return b.Shoot.Components.ControlPlane.Vali.Destroy(ctx)
@@ -47,9 +53,66 @@ func (b *Botanist) DestroySeedLogging(ctx context.Context) error {
 		return err
 	}
 
+	if err := b.Shoot.Components.ControlPlane.LogExport.Destroy(ctx); err != nil {
+		return err
+	}
+
 	return b.Shoot.Components.ControlPlane.Vali.Destroy(ctx)
 }
 
+func (b *Botanist) deployLogExport(ctx context.Context) error {
+	outputs, err := b.computeLogExportOutputs(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(outputs) == 0 {
+		return b.Shoot.Components.ControlPlane.LogExport.Destroy(ctx)
+	}
+
+	b.Shoot.Components.ControlPlane.LogExport.SetOutputs(outputs)
+	return b.Shoot.Components.ControlPlane.LogExport.Deploy(ctx)
+}
+
+func (b *Botanist) computeLogExportOutputs(ctx context.Context) ([]logexport.Output, error) {
+	logging := b.Shoot.GetInfo().Spec.Logging
+	if logging == nil || len(logging.Outputs) == 0 {
+		return nil, nil
+	}
+
+	var outputs []logexport.Output
+
+	for _, loggingOutput := range logging.Outputs {
+		output := logexport.Output{
+			Name:    loggingOutput.Name,
+			Type:    loggingOutput.Type,
+			URL:     loggingOutput.URL,
+			Sources: loggingOutput.Sources,
+		}
+
+		if loggingOutput.RateLimit != nil {
+			output.RecordsPerSecond = loggingOutput.RateLimit.RecordsPerSecond
+		}
+
+		if loggingOutput.Buffer != nil {
+			output.MemoryBufferLimit = loggingOutput.Buffer.MemoryLimit
+			output.RetryLimit = loggingOutput.Buffer.RetryLimit
+		}
+
+		if loggingOutput.SecretName != nil {
+			basicAuthSecretData, err := b.readBasicAuthCredentials(ctx, *loggingOutput.SecretName, fmt.Sprintf("logging output %q", loggingOutput.Name))
+			if err != nil {
+				return nil, err
+			}
+			output.BasicAuthSecretData = basicAuthSecretData
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
 func (b *Botanist) isShootNodeLoggingEnabled() bool {
 	if b.Shoot != nil && !b.Shoot.IsWorkerless && b.Shoot.IsShootControlPlaneLoggingEnabled(b.Config) &&
 		gardenlethelper.IsValiEnabled(b.Config) && b.Config != nil &&
@@ -85,6 +148,11 @@ func (b *Botanist) DefaultEventLogger() (component.Deployer, error) {
 	)
 }
 
+// DefaultLogExport returns a deployer for the export of shoot logs to user-defined endpoints.
+func (b *Botanist) DefaultLogExport() logexport.Interface {
+	return logexport.New(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, logexport.Values{})
+}
+
 // DefaultVali returns a deployer for Vali.
 func (b *Botanist) DefaultVali() (component.Deployer, error) {
 	return shared.NewVali(
@@ -12,9 +12,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -22,6 +25,8 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	"github.com/gardener/gardener/pkg/component/observability/logging/logexport"
+	mocklogexport "github.com/gardener/gardener/pkg/component/observability/logging/logexport/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -41,6 +46,7 @@ var _ = Describe("Logging", func() {
 		botanist            *Botanist
 		eventLoggerDeployer *mockcomponent.MockDeployer
 		valiDeployer        *mockcomponent.MockDeployer
+		logExport           *mocklogexport.MockInterface
 		fakeSecretManager   secretsmanager.Interface
 		chartApplier        *mock.MockChartApplier
 		ctx                 = context.TODO()
@@ -66,6 +72,7 @@ var _ = Describe("Logging", func() {
 
 		eventLoggerDeployer = mockcomponent.NewMockDeployer(ctrl)
 		valiDeployer = mockcomponent.NewMockDeployer(ctrl)
+		logExport = mocklogexport.NewMockInterface(ctrl)
 		fakeSecretManager = fakesecretsmanager.New(c, seedNamespace)
 
 		botanist = &Botanist{
@@ -97,6 +104,7 @@ var _ = Describe("Logging", func() {
 						ControlPlane: &shootpkg.ControlPlane{
 							EventLogger: eventLoggerDeployer,
 							Vali:        valiDeployer,
+							LogExport:   logExport,
 						},
 					},
 					IsWorkerless: false,
@@ -134,6 +142,8 @@ var _ = Describe("Logging", func() {
 			gomock.InOrder(
 				// Destroying the Shoot Event Logging
 				eventLoggerDeployer.EXPECT().Destroy(ctx),
+				// Destroying the log export
+				logExport.EXPECT().Destroy(ctx),
 				// Delete Vali
 				valiDeployer.EXPECT().Destroy(ctx),
 			)
@@ -146,6 +156,8 @@ var _ = Describe("Logging", func() {
 			gomock.InOrder(
 				// Destroying the Shoot Event Logging
 				eventLoggerDeployer.EXPECT().Destroy(ctx),
+				// Destroying the log export
+				logExport.EXPECT().Destroy(ctx),
 				// Delete Vali
 				valiDeployer.EXPECT().Destroy(ctx),
 			)
@@ -157,6 +169,8 @@ var _ = Describe("Logging", func() {
 			gomock.InOrder(
 				// deploy Shoot Event Logging
 				eventLoggerDeployer.EXPECT().Deploy(ctx),
+				// destroy log export as no outputs are configured
+				logExport.EXPECT().Destroy(ctx),
 				// deploy Vali
 				valiDeployer.EXPECT().Deploy(ctx),
 			)
@@ -169,6 +183,8 @@ var _ = Describe("Logging", func() {
 			gomock.InOrder(
 				// destroy Shoot Event Logging
 				eventLoggerDeployer.EXPECT().Destroy(ctx),
+				// destroy log export as no outputs are configured
+				logExport.EXPECT().Destroy(ctx),
 				// deploy Vali
 				valiDeployer.EXPECT().Deploy(ctx),
 			)
@@ -181,6 +197,8 @@ var _ = Describe("Logging", func() {
 			gomock.InOrder(
 				// deploy Shoot Event Logging
 				eventLoggerDeployer.EXPECT().Deploy(ctx),
+				// destroy log export as no outputs are configured
+				logExport.EXPECT().Destroy(ctx),
 				// deploy Vali
 				valiDeployer.EXPECT().Deploy(ctx),
 			)
@@ -193,6 +211,8 @@ var _ = Describe("Logging", func() {
 			gomock.InOrder(
 				// deploy Shoot Event Logging
 				eventLoggerDeployer.EXPECT().Deploy(ctx),
+				// destroy log export as no outputs are configured
+				logExport.EXPECT().Destroy(ctx),
 				// deploy Vali
 				valiDeployer.EXPECT().Deploy(ctx),
 			)
@@ -205,6 +225,8 @@ var _ = Describe("Logging", func() {
 			gomock.InOrder(
 				// deploy Shoot Event Logging
 				eventLoggerDeployer.EXPECT().Deploy(ctx),
+				// destroy log export as no outputs are configured
+				logExport.EXPECT().Destroy(ctx),
 				// deploy Vali
 				valiDeployer.EXPECT().Destroy(ctx),
 			)
@@ -212,6 +234,70 @@ var _ = Describe("Logging", func() {
 			Expect(botanist.DeployLogging(ctx)).To(Succeed())
 		})
 
+		Context("with logging outputs", func() {
+			var gardenClient client.Client
+
+			BeforeEach(func() {
+				gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+				botanist.GardenClient = gardenClient
+
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Logging = &gardencorev1beta1.Logging{
+					Outputs: []gardencorev1beta1.LoggingOutput{{
+						Name:       "central",
+						Type:       gardencorev1beta1.LoggingOutputTypeOTLP,
+						URL:        "https://otel.example.com/v1/logs",
+						SecretName: ptr.To("log-export-credentials"),
+						Sources:    []gardencorev1beta1.LoggingOutputSource{gardencorev1beta1.LoggingOutputSourceEvents},
+						RateLimit:  &gardencorev1beta1.LoggingOutputRateLimit{RecordsPerSecond: 100},
+						Buffer:     &gardencorev1beta1.LoggingOutputBuffer{RetryLimit: ptr.To[int32](3)},
+					}},
+				}
+				botanist.Shoot.SetInfo(shoot)
+			})
+
+			It("should deploy the log export with the configured outputs", func() {
+				Expect(gardenClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "log-export-credentials", Namespace: projectNamespace},
+					Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+				})).To(Succeed())
+
+				gomock.InOrder(
+					eventLoggerDeployer.EXPECT().Deploy(ctx),
+					logExport.EXPECT().SetOutputs([]logexport.Output{{
+						Name:                "central",
+						Type:                gardencorev1beta1.LoggingOutputTypeOTLP,
+						URL:                 "https://otel.example.com/v1/logs",
+						Sources:             []gardencorev1beta1.LoggingOutputSource{gardencorev1beta1.LoggingOutputSourceEvents},
+						RecordsPerSecond:    100,
+						RetryLimit:          ptr.To[int32](3),
+						BasicAuthSecretData: map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+					}}),
+					logExport.EXPECT().Deploy(ctx),
+					valiDeployer.EXPECT().Deploy(ctx),
+				)
+
+				Expect(botanist.DeployLogging(ctx)).To(Succeed())
+			})
+
+			It("should fail when the credentials secret does not exist", func() {
+				eventLoggerDeployer.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployLogging(ctx)).To(MatchError(ContainSubstring(`failed reading credentials for logging output "central"`)))
+			})
+
+			It("should fail when the credentials secret does not contain a password", func() {
+				Expect(gardenClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "log-export-credentials", Namespace: projectNamespace},
+					Data:       map[string][]byte{"username": []byte("user")},
+				})).To(Succeed())
+
+				eventLoggerDeployer.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployLogging(ctx)).To(MatchError(ContainSubstring("missing '.data[password]'")))
+			})
+		})
+
 		Context("Tests expecting a failure", func() {
 			It("should fail to delete the logging stack when ShootEventLoggerDeployer Destroy return error", func() {
 				*botanist.Config.Logging.Enabled = false
@@ -226,6 +312,8 @@ var _ = Describe("Logging", func() {
 				gomock.InOrder(
 					// Destroying the Shoot Event Logging
 					eventLoggerDeployer.EXPECT().Destroy(ctx),
+					// Destroying the log export
+					logExport.EXPECT().Destroy(ctx),
 					// Delete Vali
 					valiDeployer.EXPECT().Destroy(ctx).Return(fakeErr),
 				)
@@ -253,6 +341,8 @@ var _ = Describe("Logging", func() {
 			It("should fail to deploy the logging stack when ValiDeployer Deploy returns error", func() {
 				gomock.InOrder(
 					eventLoggerDeployer.EXPECT().Deploy(ctx),
+					// destroy log export as no outputs are configured
+					logExport.EXPECT().Destroy(ctx),
 					valiDeployer.EXPECT().Deploy(ctx).Return(fakeErr),
 				)
 
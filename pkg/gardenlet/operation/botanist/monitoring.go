@@ -13,8 +13,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
@@ -27,9 +30,13 @@ import (
 
 // DefaultAlertmanager creates a new alertmanager deployer.
 func (b *Botanist) DefaultAlertmanager() (alertmanager.Interface, error) {
-	var emailReceivers []string
+	var (
+		emailReceivers   []string
+		webhookReceivers []gardencorev1beta1.AlertingWebhookReceiver
+	)
 	if monitoring := b.Shoot.GetInfo().Spec.Monitoring; monitoring != nil && monitoring.Alerting != nil {
 		emailReceivers = monitoring.Alerting.EmailReceivers
+		webhookReceivers = monitoring.Alerting.WebhookReceivers
 	}
 
 	return sharedcomponent.NewAlertmanager(b.Logger, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, alertmanager.Values{
@@ -40,6 +47,7 @@ func (b *Botanist) DefaultAlertmanager() (alertmanager.Interface, error) {
 		Replicas:           b.Shoot.GetReplicas(1),
 		AlertingSMTPSecret: b.LoadSecret(v1beta1constants.GardenRoleAlerting),
 		EmailReceivers:     emailReceivers,
+		WebhookReceivers:   webhookReceivers,
 		Ingress: &alertmanager.IngressValues{
 			Host:           b.ComputeAlertManagerHost(),
 			SecretsManager: b.SecretsManager,
@@ -168,9 +176,106 @@ func (b *Botanist) DeployPrometheus(ctx context.Context) error {
 	}
 	b.Shoot.Components.ControlPlane.Prometheus.SetCentralScrapeConfigs(shootprometheus.CentralScrapeConfigs(b.Shoot.SeedNamespace, caSecret.Name, b.Shoot.IsWorkerless))
 
+	customPrometheusRules, err := b.computeCustomPrometheusRules(ctx)
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.ControlPlane.Prometheus.SetCustomPrometheusRules(customPrometheusRules)
+
+	additionalRemoteWrites, err := b.computeAdditionalRemoteWrites(ctx)
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.ControlPlane.Prometheus.SetAdditionalRemoteWrites(additionalRemoteWrites)
+
 	return b.Shoot.Components.ControlPlane.Prometheus.Deploy(ctx)
 }
 
+// computeCustomPrometheusRules reads the user-defined alerting rules from the ConfigMap referenced in the shoot
+// specification.
+func (b *Botanist) computeCustomPrometheusRules(ctx context.Context) ([]*monitoringv1.PrometheusRule, error) {
+	configMapName := v1beta1helper.GetShootAlertingCustomRulesConfigMapName(b.Shoot.GetInfo().Spec.Monitoring)
+	if len(configMapName) == 0 {
+		return nil, nil
+	}
+
+	var (
+		configMap = &corev1.ConfigMap{}
+		key       = client.ObjectKey{Namespace: b.Shoot.GetInfo().Namespace, Name: configMapName}
+	)
+
+	if err := b.GardenClient.Get(ctx, key, configMap); err != nil {
+		return nil, fmt.Errorf("failed reading custom alerting rules from ConfigMap %s: %w", key, err)
+	}
+
+	data, ok := configMap.Data[shootprometheus.CustomRulesConfigMapDataKey]
+	if !ok {
+		return nil, fmt.Errorf("missing '.data[%s]' in custom alerting rules ConfigMap %s", shootprometheus.CustomRulesConfigMapDataKey, key)
+	}
+
+	spec, err := shootprometheus.DecodeCustomRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom alerting rules in ConfigMap %s: %w", key, err)
+	}
+
+	return []*monitoringv1.PrometheusRule{shootprometheus.CustomPrometheusRule(spec)}, nil
+}
+
+// computeAdditionalRemoteWrites computes the user-defined remote write endpoints from the shoot specification. The basic
+// auth credentials are read from the referenced secrets in the project namespace.
+func (b *Botanist) computeAdditionalRemoteWrites(ctx context.Context) ([]prometheus.AdditionalRemoteWriteValues, error) {
+	monitoring := b.Shoot.GetInfo().Spec.Monitoring
+	if monitoring == nil || len(monitoring.RemoteWrite) == 0 {
+		return nil, nil
+	}
+
+	var remoteWrites []prometheus.AdditionalRemoteWriteValues
+
+	for _, remoteWrite := range monitoring.RemoteWrite {
+		values := prometheus.AdditionalRemoteWriteValues{
+			Name:        remoteWrite.Name,
+			URL:         remoteWrite.URL,
+			KeptMetrics: remoteWrite.Keep,
+		}
+
+		if remoteWrite.SecretName != nil {
+			basicAuthSecretData, err := b.readBasicAuthCredentials(ctx, *remoteWrite.SecretName, fmt.Sprintf("remote write endpoint %q", remoteWrite.Name))
+			if err != nil {
+				return nil, err
+			}
+			values.BasicAuthSecretData = basicAuthSecretData
+		}
+
+		remoteWrites = append(remoteWrites, values)
+	}
+
+	return remoteWrites, nil
+}
+
+// readBasicAuthCredentials reads the secret with the given name from the project namespace and returns its username and
+// password data. The purpose is used in error messages to describe which user-defined endpoint references the secret.
+func (b *Botanist) readBasicAuthCredentials(ctx context.Context, secretName, purpose string) (map[string][]byte, error) {
+	var (
+		secret = &corev1.Secret{}
+		key    = client.ObjectKey{Namespace: b.Shoot.GetInfo().Namespace, Name: secretName}
+	)
+
+	if err := b.GardenClient.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("failed reading credentials for %s from secret %s: %w", purpose, key, err)
+	}
+
+	for _, dataKey := range []string{"username", "password"} {
+		if len(secret.Data[dataKey]) == 0 {
+			return nil, fmt.Errorf("missing '.data[%s]' in credentials secret %s for %s", dataKey, key, purpose)
+		}
+	}
+
+	return map[string][]byte{
+		"username": secret.Data["username"],
+		"password": secret.Data["password"],
+	}, nil
+}
+
 // DestroyPrometheus destroys the shoot Prometheus.
 func (b *Botanist) DestroyPrometheus(ctx context.Context) error {
 	if err := b.Shoot.Components.ControlPlane.Prometheus.Destroy(ctx); err != nil {
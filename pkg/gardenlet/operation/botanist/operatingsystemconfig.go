@@ -7,8 +7,10 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -19,10 +21,12 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
 	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/networking/nodelocaldns/constants"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -57,6 +61,11 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 		valitailEnabled, valiIngressHost = true, b.ComputeValiHost()
 	}
 
+	systemComponentImages, err := b.systemComponentImagesForPrePull()
+	if err != nil {
+		return nil, err
+	}
+
 	kubeProxyEnabled := true
 	if b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy != nil && b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy.Enabled != nil {
 		kubeProxyEnabled = *b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy.Enabled
@@ -82,6 +91,7 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 				NodeLocalDNSEnabled:    v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
 				NodeMonitorGracePeriod: *b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod,
 				PrimaryIPFamily:        b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				SystemComponentImages:  systemComponentImages,
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
@@ -90,6 +100,35 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 	), nil
 }
 
+// systemComponentImagesForPrePull returns the images of the system components which run on every node of the shoot and
+// which do not depend on the Kubernetes version of the worker pools. The kube-proxy image is added per worker pool by
+// the OperatingSystemConfig component.
+func (b *Botanist) systemComponentImagesForPrePull() ([]string, error) {
+	imageNames := []string{imagevector.ContainerImageNameNodeProblemDetector}
+	if v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents) {
+		imageNames = append(imageNames, imagevector.ContainerImageNameNodeLocalDns)
+	}
+	if b.ShootUsesDNS() {
+		imageNames = append(imageNames, imagevector.ContainerImageNameApiserverProxy, imagevector.ContainerImageNameApiserverProxySidecar)
+	}
+	if b.IsShootMonitoringEnabled() {
+		imageNames = append(imageNames, imagevector.ContainerImageNameNodeExporter)
+	}
+
+	images, err := imagevectorutils.FindImages(imagevector.Containers(), imageNames, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(images))
+	for _, image := range images {
+		result = append(result, image.String())
+	}
+	slices.Sort(result)
+
+	return result, nil
+}
+
 // DeployOperatingSystemConfig deploys the OperatingSystemConfig custom resource and triggers the restore operation in
 // case the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
@@ -132,6 +171,12 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 	}
 	b.Shoot.Components.Extensions.OperatingSystemConfig.SetClusterDNSAddresses(clusterDNSAddresses)
 
+	nodeAgentAttestationProviderType, err := b.nodeAgentAttestationProviderType(ctx)
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.Extensions.OperatingSystemConfig.SetNodeAgentAttestationProviderType(nodeAgentAttestationProviderType)
+
 	if b.IsRestorePhase() {
 		return b.Shoot.Components.Extensions.OperatingSystemConfig.Restore(ctx, b.Shoot.GetShootState())
 	}
@@ -139,6 +184,31 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.OperatingSystemConfig.Deploy(ctx)
 }
 
+// nodeAgentAttestationProviderType returns the infrastructure provider type whose attestor is used by
+// gardener-node-agent. Machines are only attested if the extension of the infrastructure provider deployed the
+// verification endpoint and its CA bundle into the control plane namespace of the shoot.
+func (b *Botanist) nodeAgentAttestationProviderType(ctx context.Context) (*string, error) {
+	if b.Shoot.NodeAgentAttestationProviderType == nil {
+		return nil, nil
+	}
+
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: attestation.VerifierServiceName, Namespace: b.Shoot.SeedNamespace}, &corev1.Service{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading attestation verifier service: %w", err)
+	}
+
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: attestation.VerifierCASecretName, Namespace: b.Shoot.SeedNamespace}, &corev1.Secret{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading attestation verifier CA secret: %w", err)
+	}
+
+	return b.Shoot.NodeAgentAttestationProviderType, nil
+}
+
 func (b *Botanist) getOperatingSystemConfigCABundle(clusterCABundle []byte) *string {
 	var caBundle string
 
@@ -182,6 +252,7 @@ func (b *Botanist) DeployManagedResourceForGardenerNodeAgent(ctx context.Context
 		managedResourceSecretNameToData  = make(map[string]map[string][]byte, managedResourceSecretsCount)
 
 		secretNames                           []string
+		registryCredentialsSecretNames        = sets.New[string]()
 		workerNameToOperatingSystemConfigMaps = b.Shoot.Components.Extensions.OperatingSystemConfig.WorkerPoolNameToOperatingSystemConfigsMap()
 
 		fns = make([]flow.TaskFn, 0, managedResourceSecretsCount)
@@ -200,10 +271,19 @@ func (b *Botanist) DeployManagedResourceForGardenerNodeAgent(ctx context.Context
 		}
 
 		secretNames = append(secretNames, secretName)
+		registryCredentialsSecretNames.Insert(containerdRegistryCredentialsSecretNames(oscData.Original.Object)...)
 		managedResourceSecretNameToData["shoot-gardener-node-agent-"+worker.Name] = data
 	}
 
-	rbacResourcesData, err := NodeAgentRBACResourcesDataFn(secretNames)
+	// gardener-node-agent must be able to read the credentials for the containerd registry hosts.
+	secretNames = append(secretNames, sets.List(registryCredentialsSecretNames)...)
+
+	nodeAgentAttestationProviderType, err := b.nodeAgentAttestationProviderType(ctx)
+	if err != nil {
+		return err
+	}
+
+	rbacResourcesData, err := NodeAgentRBACResourcesDataFn(secretNames, nodeAgentAttestationProviderType != nil)
 	if err != nil {
 		return err
 	}
@@ -255,6 +335,23 @@ func (b *Botanist) DeployManagedResourceForGardenerNodeAgent(ctx context.Context
 	})
 }
 
+func containerdRegistryCredentialsSecretNames(osc *extensionsv1alpha1.OperatingSystemConfig) []string {
+	if osc == nil || osc.Spec.CRIConfig == nil || osc.Spec.CRIConfig.Containerd == nil {
+		return nil
+	}
+
+	var secretNames []string
+	for _, registry := range osc.Spec.CRIConfig.Containerd.Registries {
+		for _, host := range registry.Hosts {
+			if host.CredentialsSecretName != nil {
+				secretNames = append(secretNames, *host.CredentialsSecretName)
+			}
+		}
+	}
+
+	return secretNames
+}
+
 func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 	ctx context.Context,
 	worker gardencorev1beta1.Worker,
@@ -130,6 +130,7 @@ var _ = Describe("operatingsystemconfig", func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
 				operatingSystemConfig.EXPECT().SetSSHPublicKeys(gomock.AssignableToTypeOf([]string{}))
 				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
+				operatingSystemConfig.EXPECT().SetNodeAgentAttestationProviderType(nil)
 			})
 
 			It("should deploy successfully (only CloudProfile CA)", func() {
@@ -169,6 +170,7 @@ var _ = Describe("operatingsystemconfig", func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
 				operatingSystemConfig.EXPECT().SetSSHPublicKeys(gomock.AssignableToTypeOf([]string{}))
 				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
+				operatingSystemConfig.EXPECT().SetNodeAgentAttestationProviderType(nil)
 
 				shoot := botanist.Shoot.GetInfo()
 				shoot.Status = gardencorev1beta1.ShootStatus{
@@ -191,6 +193,43 @@ var _ = Describe("operatingsystemconfig", func() {
 				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(MatchError(fakeErr))
 			})
 		})
+
+		Context("node agent attestation", func() {
+			BeforeEach(func() {
+				botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build()
+				botanist.Shoot.SeedNamespace = namespace
+				botanist.Shoot.NodeAgentAttestationProviderType = ptr.To("local")
+
+				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
+				operatingSystemConfig.EXPECT().SetSSHPublicKeys(gomock.AssignableToTypeOf([]string{}))
+				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
+				operatingSystemConfig.EXPECT().SetCABundle(nil)
+			})
+
+			It("should not enable the attestation if the verifier service does not exist", func() {
+				operatingSystemConfig.EXPECT().SetNodeAgentAttestationProviderType(nil)
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+
+			It("should not enable the attestation if the CA secret of the verifier does not exist", func() {
+				Expect(fakeClient.Create(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "node-agent-attestation-verifier", Namespace: namespace}})).To(Succeed())
+				operatingSystemConfig.EXPECT().SetNodeAgentAttestationProviderType(nil)
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+
+			It("should enable the attestation if the verifier service and its CA secret exist", func() {
+				Expect(fakeClient.Create(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "node-agent-attestation-verifier", Namespace: namespace}})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "node-agent-attestation-verifier-ca", Namespace: namespace}})).To(Succeed())
+				operatingSystemConfig.EXPECT().SetNodeAgentAttestationProviderType(ptr.To("local"))
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+		})
 	})
 
 	Context("Operating System Config secrets", func() {
@@ -216,6 +255,15 @@ var _ = Describe("operatingsystemconfig", func() {
 							Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
 								Units: []extensionsv1alpha1.Unit{{Name: "w1u1"}, {Name: "w1u2"}},
 								Files: []extensionsv1alpha1.File{{Path: "w1f1"}, {Path: "w1f2"}},
+								CRIConfig: &extensionsv1alpha1.CRIConfig{
+									Name: extensionsv1alpha1.CRINameContainerD,
+									Containerd: &extensionsv1alpha1.ContainerdConfig{
+										Registries: []extensionsv1alpha1.RegistryConfig{{
+											Upstream: "docker.io",
+											Hosts:    []extensionsv1alpha1.RegistryHost{{URL: "https://mirror1", CredentialsSecretName: ptr.To("registry-credentials")}, {URL: "https://mirror2"}},
+										}},
+									},
+								},
 							},
 						},
 					},
@@ -230,6 +278,15 @@ var _ = Describe("operatingsystemconfig", func() {
 							Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
 								Units: []extensionsv1alpha1.Unit{{Name: "w2u1"}, {Name: "w2u2"}},
 								Files: []extensionsv1alpha1.File{{Path: "w2f1"}, {Path: "w2f2"}},
+								CRIConfig: &extensionsv1alpha1.CRIConfig{
+									Name: extensionsv1alpha1.CRINameContainerD,
+									Containerd: &extensionsv1alpha1.ContainerdConfig{
+										Registries: []extensionsv1alpha1.RegistryConfig{{
+											Upstream: "docker.io",
+											Hosts:    []extensionsv1alpha1.RegistryHost{{URL: "https://mirror1", CredentialsSecretName: ptr.To("registry-credentials")}, {URL: "https://mirror3", CredentialsSecretName: ptr.To("other-credentials")}},
+										}},
+									},
+								},
 							},
 						},
 					},
@@ -290,8 +347,26 @@ var _ = Describe("operatingsystemconfig", func() {
 					Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(MatchError(fakeErr))
 				})
 
+				It("should enable the attestation in the RBAC resources only if the verifier service and its CA secret exist", func() {
+					var attestationEnabled []bool
+					DeferCleanup(test.WithVar(&NodeAgentRBACResourcesDataFn, func(_ []string, enabled bool) (map[string][]byte, error) {
+						attestationEnabled = append(attestationEnabled, enabled)
+						return nil, fakeErr
+					}))
+					botanist.Shoot.NodeAgentAttestationProviderType = ptr.To("local")
+
+					Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(MatchError(fakeErr))
+
+					Expect(fakeClient.Create(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "node-agent-attestation-verifier", Namespace: namespace}})).To(Succeed())
+					Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "node-agent-attestation-verifier-ca", Namespace: namespace}})).To(Succeed())
+					operatingSystemConfig.EXPECT().WorkerPoolNameToOperatingSystemConfigsMap().Return(workerNameToOperatingSystemConfigMaps)
+					Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(MatchError(fakeErr))
+
+					Expect(attestationEnabled).To(Equal([]bool{false, true}))
+				})
+
 				It("should fail because the RBAC resources data generation function fails", func() {
-					DeferCleanup(test.WithVar(&NodeAgentRBACResourcesDataFn, func([]string) (map[string][]byte, error) {
+					DeferCleanup(test.WithVar(&NodeAgentRBACResourcesDataFn, func([]string, bool) (map[string][]byte, error) {
 						return nil, fakeErr
 					}))
 
@@ -352,7 +427,7 @@ var _ = Describe("operatingsystemconfig", func() {
 					}
 					utilruntime.Must(kubernetesutils.MakeUnique(expectedMRSecretWorker2))
 
-					nodeAgentRBACResourcesData, err := NodeAgentRBACResourcesDataFn([]string{expectedOSCSecretWorker1.Name, expectedOSCSecretWorker2.Name})
+					nodeAgentRBACResourcesData, err := NodeAgentRBACResourcesDataFn([]string{expectedOSCSecretWorker1.Name, expectedOSCSecretWorker2.Name, "other-credentials", "registry-credentials"}, false)
 					Expect(err).NotTo(HaveOccurred())
 					expectedMRSecretRBAC := &corev1.Secret{
 						ObjectMeta: metav1.ObjectMeta{
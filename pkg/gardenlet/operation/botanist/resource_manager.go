@@ -6,6 +6,7 @@ package botanist
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
@@ -19,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/gardener/resourcemanager"
 	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -36,6 +38,11 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		defaultUnreachableTolerationSeconds = nodeToleration.DefaultUnreachableTolerationSeconds
 	}
 
+	var nodeAgentAttestationVerifierURL *string
+	if b.Shoot.NodeAgentAttestationProviderType != nil {
+		nodeAgentAttestationVerifierURL = ptr.To(fmt.Sprintf("https://%s.%s.svc%s", attestation.VerifierServiceName, b.Shoot.SeedNamespace, attestation.VerifierPath))
+	}
+
 	return shared.NewTargetGardenerResourceManager(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
@@ -56,6 +63,7 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		b.Shoot.IsWorkerless,
 		[]string{metav1.NamespaceSystem, v1beta1constants.KubernetesDashboardNamespace, corev1.NamespaceNodeLease},
 		b.Shoot.OSCSyncJitterPeriod,
+		nodeAgentAttestationVerifierURL,
 	)
 }
 
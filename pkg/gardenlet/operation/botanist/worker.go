@@ -140,6 +140,12 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 				continue
 			}
 
+			// Nodes of worker pools which are updated in-place apply the operating system config one after another
+			// (according to the maxUnavailable setting of the worker pool). Hence, we do not wait for them here.
+			if _, ok := node.Labels[nodeagentv1alpha1.LabelInPlaceUpdate]; ok {
+				continue
+			}
+
 			if nodeChecksum, ok := node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; nodeChecksum != secretChecksum {
 				if !ok {
 					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet", node.Name))
@@ -332,6 +332,22 @@ var _ = Describe("Worker", func() {
 			}},
 			BeNil(),
 		),
+		Entry("skip node which is updated in-place",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"checksum/cloud-config-data": "outdated"},
+				Labels: map[string]string{
+					"worker.gardener.cloud/kubernetes-version":              "1.24.0",
+					"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0",
+					"worker.gardener.cloud/in-place-update":                 "pending",
+				},
+			}}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/data-script": "foo"},
+			}},
+			BeNil(),
+		),
 		Entry("everything up-to-date",
 			[]gardencorev1beta1.Worker{{Name: "pool1"}, {Name: "pool2"}},
 			map[string][]corev1.Node{
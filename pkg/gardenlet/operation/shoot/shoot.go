@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -338,6 +339,10 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	}
 	shoot.OSCSyncJitterPeriod = &metav1.Duration{Duration: time.Duration(oscSyncJitterPeriod) * time.Second}
 
+	if features.DefaultFeatureGate.Enabled(features.NodeAgentAttestation) && !shoot.IsWorkerless {
+		shoot.NodeAgentAttestationProviderType = ptr.To(shootObject.Spec.Provider.Type)
+	}
+
 	if lastOperation := shootObject.Status.LastOperation; lastOperation != nil &&
 		lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore &&
 		lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
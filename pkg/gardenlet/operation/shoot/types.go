@@ -40,6 +40,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/networking/nodelocaldns"
 	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/component/observability/logging/logexport"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
@@ -96,7 +97,9 @@ type Shoot struct {
 	TopologyAwareRoutingEnabled             bool
 	Networks                                *Networks
 	BackupEntryName                         string
+	ETCDRestorePending                      bool
 	OSCSyncJitterPeriod                     *metav1.Duration
+	NodeAgentAttestationProviderType        *string
 	ResourcesToEncrypt                      []string
 	EncryptedResources                      []string
 	ServiceAccountIssuerHostname            *string
@@ -132,6 +135,7 @@ type ControlPlane struct {
 	KubeScheduler            component.DeployWaiter
 	KubeControllerManager    kubecontrollermanager.Interface
 	KubeStateMetrics         component.DeployWaiter
+	LogExport                logexport.Interface
 	MachineControllerManager machinecontrollermanager.Interface
 	Plutono                  plutono.Interface
 	Prometheus               prometheus.Interface
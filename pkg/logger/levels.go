@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LevelsHandlerPath is the path of the HTTP handler for reading and changing the log levels at runtime.
+const LevelsHandlerPath = "/debug/log-level"
+
+// keyController is the key under which controller-runtime adds the name of a controller to the values of its loggers.
+const keyController = "controller"
+
+// Levels contains the log levels of a logger which can be changed at runtime. Besides the default level, it contains
+// levels for individual controllers (identified by the `controller` value of their loggers) and for named loggers
+// (identified by their name or a prefix of it, e.g. `webhook` for all loggers created with `WithName("webhook")`).
+type Levels struct {
+	lock         sync.RWMutex
+	defaultLevel string
+	levels       map[string]string
+	// mostVerbose is the most verbose of all configured levels. Only entries of at least this level need to be checked.
+	mostVerbose zapcore.Level
+}
+
+// NewLevels returns new Levels with the given default level.
+func NewLevels(defaultLevel string) (*Levels, error) {
+	if _, err := zapLevel(defaultLevel); err != nil {
+		return nil, err
+	}
+
+	l := &Levels{defaultLevel: defaultLevel, levels: map[string]string{}}
+	l.computeMostVerbose()
+	return l, nil
+}
+
+// SetDefault sets the default level used for all loggers without a dedicated level.
+func (l *Levels) SetDefault(level string) error {
+	if _, err := zapLevel(level); err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.defaultLevel = level
+	l.computeMostVerbose()
+	return nil
+}
+
+// Set sets the level for the controller or named logger with the given name.
+func (l *Levels) Set(name, level string) error {
+	if name == "" {
+		return fmt.Errorf("name must not be empty")
+	}
+	if _, err := zapLevel(level); err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.levels[name] = level
+	l.computeMostVerbose()
+	return nil
+}
+
+// Reset removes the level for the controller or named logger with the given name, i.e., the default level applies again.
+func (l *Levels) Reset(name string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.levels, name)
+	l.computeMostVerbose()
+}
+
+// Enabled implements zapcore.LevelEnabler. It returns true if the given level is enabled for at least one logger.
+func (l *Levels) Enabled(level zapcore.Level) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return level >= l.mostVerbose
+}
+
+func (l *Levels) enabledFor(controller, loggerName string, level zapcore.Level) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	configured, ok := l.levels[controller]
+	if controller == "" || !ok {
+		configured = l.levelForName(loggerName)
+	}
+
+	enabledLevel, _ := zapLevel(configured)
+	return level >= enabledLevel
+}
+
+// levelForName returns the level for the given logger name. The most specific name, i.e., the longest prefix of the
+// name consisting of complete name segments, wins.
+func (l *Levels) levelForName(name string) string {
+	for name != "" {
+		if level, ok := l.levels[name]; ok {
+			return level
+		}
+
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+
+	return l.defaultLevel
+}
+
+func (l *Levels) computeMostVerbose() {
+	l.mostVerbose, _ = zapLevel(l.defaultLevel)
+	for _, level := range l.levels {
+		if zl, _ := zapLevel(level); zl < l.mostVerbose {
+			l.mostVerbose = zl
+		}
+	}
+}
+
+func (l *Levels) wrapCore(core zapcore.Core) zapcore.Core {
+	return &levelsCore{Core: core, levels: l}
+}
+
+// levelsCore is a zapcore.Core which filters entries based on the Levels for the controller and the name of the
+// logger.
+type levelsCore struct {
+	zapcore.Core
+	levels     *Levels
+	controller string
+}
+
+func (c *levelsCore) With(fields []zapcore.Field) zapcore.Core {
+	controller := c.controller
+	for _, field := range fields {
+		if field.Key == keyController && field.Type == zapcore.StringType {
+			controller = field.String
+		}
+	}
+
+	return &levelsCore{Core: c.Core.With(fields), levels: c.levels, controller: controller}
+}
+
+func (c *levelsCore) Check(entry zapcore.Entry, checkedEntry *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.levels.enabledFor(c.controller, entry.LoggerName, entry.Level) {
+		return checkedEntry
+	}
+	return c.Core.Check(entry, checkedEntry)
+}
+
+// LevelsStatus is the representation of Levels returned by the HTTP handler.
+type LevelsStatus struct {
+	// Default is the default level used for all loggers without a dedicated level.
+	Default string `json:"default"`
+	// Levels contains the levels for controllers and named loggers.
+	Levels map[string]string `json:"levels,omitempty"`
+}
+
+// ServeHTTP implements http.Handler. It returns the current levels on `GET` requests. On `PUT` requests, it sets the
+// level given in the `level` query parameter for the controller or named logger given in the `name` parameter, or the
+// default level if no name is given. On `DELETE` requests, it resets the level for the given `name`.
+func (l *Levels) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		name  = r.URL.Query().Get("name")
+		level = r.URL.Query().Get("level")
+		err   error
+	)
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		if name == "" {
+			err = l.SetDefault(level)
+		} else {
+			err = l.Set(name, level)
+		}
+	case http.MethodDelete:
+		if name == "" {
+			err = fmt.Errorf("name must not be empty")
+		} else {
+			l.Reset(name)
+		}
+	default:
+		w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPut, http.MethodDelete}, ", "))
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	l.lock.RLock()
+	status := LevelsStatus{Default: l.defaultLevel, Levels: make(map[string]string, len(l.levels))}
+	for k, v := range l.levels {
+		status.Levels[k] = v
+	}
+	l.lock.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+func zapLevel(level string) (zapcore.Level, error) {
+	switch level {
+	case DebugLevel:
+		return zap.DebugLevel, nil
+	case ErrorLevel:
+		return zap.ErrorLevel, nil
+	case "", InfoLevel:
+		return zap.InfoLevel, nil
+	default:
+		return 0, fmt.Errorf("invalid log level %q", level)
+	}
+}
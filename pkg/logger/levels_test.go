@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	. "github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("Levels", func() {
+	var (
+		buffer *gbytes.Buffer
+		log    logr.Logger
+		levels *Levels
+	)
+
+	BeforeEach(func() {
+		var err error
+		buffer = gbytes.NewBuffer()
+		log, levels, err = NewZapLoggerWithLevels(InfoLevel, FormatJSON, logzap.WriteTo(buffer))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should reject invalid levels", func() {
+		Expect(levels.SetDefault("foo")).To(MatchError(`invalid log level "foo"`))
+		Expect(levels.Set("shoot", "foo")).To(MatchError(`invalid log level "foo"`))
+		Expect(levels.Set("", DebugLevel)).To(MatchError("name must not be empty"))
+	})
+
+	It("should change the default level", func() {
+		log.V(1).Info("hidden")
+		Expect(buffer.Contents()).To(BeEmpty())
+
+		Expect(levels.SetDefault(DebugLevel)).To(Succeed())
+		log.V(1).Info("visible")
+		Expect(buffer).To(gbytes.Say("visible"))
+	})
+
+	It("should change the level for a controller", func() {
+		var (
+			shootLog = log.WithValues("controller", "shoot")
+			seedLog  = log.WithValues("controller", "seed")
+		)
+
+		Expect(levels.Set("shoot", DebugLevel)).To(Succeed())
+		seedLog.V(1).Info("seed debug")
+		shootLog.WithValues("foo", "bar").V(1).Info("shoot debug")
+		Expect(buffer).To(gbytes.Say("shoot debug"))
+		Expect(string(buffer.Contents())).NotTo(ContainSubstring("seed debug"))
+
+		Expect(levels.Set("seed", ErrorLevel)).To(Succeed())
+		seedLog.Info("seed info")
+		Expect(string(buffer.Contents())).NotTo(ContainSubstring("seed info"))
+
+		levels.Reset("shoot")
+		shootLog.V(1).Info("hidden again")
+		Expect(string(buffer.Contents())).NotTo(ContainSubstring("hidden again"))
+	})
+
+	It("should change the level for named loggers", func() {
+		Expect(levels.Set("webhook", DebugLevel)).To(Succeed())
+		Expect(levels.Set("webhook.noisy", ErrorLevel)).To(Succeed())
+
+		log.WithName("webhook").WithName("handler").V(1).Info("handler debug")
+		Expect(buffer).To(gbytes.Say("handler debug"))
+
+		log.WithName("webhook").WithName("noisy").Info("noisy info")
+		log.WithName("webhooks").V(1).Info("other debug")
+		Expect(string(buffer.Contents())).NotTo(Or(ContainSubstring("noisy info"), ContainSubstring("other debug")))
+	})
+
+	Describe("#ServeHTTP", func() {
+		It("should set, return and reset the levels", func() {
+			recorder := httptest.NewRecorder()
+			levels.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, LevelsHandlerPath+"?name=shoot&level=debug", nil))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"default":"info","levels":{"shoot":"debug"}}`))
+
+			recorder = httptest.NewRecorder()
+			levels.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, LevelsHandlerPath+"?level=error", nil))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"default":"error","levels":{"shoot":"debug"}}`))
+
+			recorder = httptest.NewRecorder()
+			levels.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, LevelsHandlerPath+"?name=shoot", nil))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"default":"error"}`))
+
+			recorder = httptest.NewRecorder()
+			levels.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, LevelsHandlerPath, nil))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"default":"error"}`))
+		})
+
+		It("should reject invalid requests", func() {
+			recorder := httptest.NewRecorder()
+			levels.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, LevelsHandlerPath+"?name=shoot&level=trace", nil))
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+
+			recorder = httptest.NewRecorder()
+			levels.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, LevelsHandlerPath, nil))
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+})
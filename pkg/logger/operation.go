@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// KeyOperationID is the key of the operation ID in log messages.
+const KeyOperationID = "operationID"
+
+type operationIDContextKey struct{}
+
+// NewOperationID returns a new random operation ID.
+func NewOperationID() string {
+	return string(uuid.NewUUID())
+}
+
+// ContextWithOperationID returns a copy of the given context carrying the given operation ID.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDContextKey{}, operationID)
+}
+
+// OperationIDFromContext returns the operation ID carried by the given context, or an empty string if there is none.
+func OperationIDFromContext(ctx context.Context) string {
+	operationID, _ := ctx.Value(operationIDContextKey{}).(string)
+	return operationID
+}
+
+// AnnotateOperationID sets the operation ID carried by the given context as annotation on the given object. It is a
+// no-op if the context does not carry an operation ID.
+func AnnotateOperationID(ctx context.Context, obj metav1.Object) {
+	if operationID := OperationIDFromContext(ctx); operationID != "" {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string, 1)
+		}
+		annotations[v1beta1constants.GardenerOperationID] = operationID
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// WithOperationIDFromObject reads the operation ID from the annotation of the given object. If it is present, it
+// returns a copy of the given context carrying the operation ID and the given logger with the operation ID as value.
+// This way, the operation ID is propagated to all resources which are annotated while handling the object.
+func WithOperationIDFromObject(ctx context.Context, log logr.Logger, obj metav1.Object) (context.Context, logr.Logger) {
+	operationID, ok := obj.GetAnnotations()[v1beta1constants.GardenerOperationID]
+	if !ok || operationID == "" {
+		return ctx, log
+	}
+
+	return ContextWithOperationID(ctx, operationID), log.WithValues(KeyOperationID, operationID)
+}
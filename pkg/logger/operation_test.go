@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	. "github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("Operation", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should generate unique operation IDs", func() {
+		Expect(NewOperationID()).NotTo(Equal(NewOperationID()))
+	})
+
+	It("should carry the operation ID in the context", func() {
+		Expect(OperationIDFromContext(ctx)).To(BeEmpty())
+		Expect(OperationIDFromContext(ContextWithOperationID(ctx, "foo"))).To(Equal("foo"))
+	})
+
+	Describe("#AnnotateOperationID", func() {
+		It("should do nothing if the context does not carry an operation ID", func() {
+			obj := &corev1.ConfigMap{}
+			AnnotateOperationID(ctx, obj)
+			Expect(obj.Annotations).To(BeNil())
+		})
+
+		It("should annotate the object", func() {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}}}
+			AnnotateOperationID(ContextWithOperationID(ctx, "1234"), obj)
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar", "gardener.cloud/operation-id": "1234"}))
+		})
+	})
+
+	Describe("#WithOperationIDFromObject", func() {
+		It("should propagate the operation ID from the object to the context and the logger", func() {
+			buffer := gbytes.NewBuffer()
+			log := MustNewZapLogger(InfoLevel, FormatJSON, logzap.WriteTo(buffer))
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"gardener.cloud/operation-id": "1234"}}}
+
+			ctx, log = WithOperationIDFromObject(ctx, log, obj)
+			Expect(OperationIDFromContext(ctx)).To(Equal("1234"))
+
+			log.Info("test")
+			Expect(buffer).To(gbytes.Say(`"operationID":"1234"`))
+		})
+
+		It("should not change anything if the object is not annotated", func() {
+			log := MustNewZapLogger(InfoLevel, FormatJSON)
+
+			newCtx, newLog := WithOperationIDFromObject(ctx, log, &corev1.ConfigMap{})
+			Expect(newCtx).To(Equal(ctx))
+			Expect(newLog).To(Equal(log))
+		})
+	})
+})
@@ -29,23 +29,22 @@ func MustNewZapLogger(level string, format string, additionalOpts ...logzap.Opts
 
 // NewZapLogger creates a new logr.Logger backed by Zap.
 func NewZapLogger(level string, format string, additionalOpts ...logzap.Opts) (logr.Logger, error) {
+	logger, _, err := NewZapLoggerWithLevels(level, format, additionalOpts...)
+	return logger, err
+}
+
+// NewZapLoggerWithLevels creates a new logr.Logger backed by Zap. It additionally returns the Levels of the logger
+// which allow changing the log level at runtime.
+func NewZapLoggerWithLevels(level string, format string, additionalOpts ...logzap.Opts) (logr.Logger, *Levels, error) {
 	var opts []logzap.Opts
 
 	// map our log levels to zap levels
-	var zapLevel zapcore.LevelEnabler
-
-	switch level {
-	case DebugLevel:
-		zapLevel = zap.DebugLevel
-	case ErrorLevel:
-		zapLevel = zap.ErrorLevel
-	case "", InfoLevel:
-		zapLevel = zap.InfoLevel
-	default:
-		return logr.Logger{}, fmt.Errorf("invalid log level %q", level)
+	levels, err := NewLevels(level)
+	if err != nil {
+		return logr.Logger{}, nil, err
 	}
 
-	opts = append(opts, logzap.Level(zapLevel))
+	opts = append(opts, logzap.Level(levels), logzap.RawZapOpts(zap.WrapCore(levels.wrapCore)))
 
 	// map our log format to encoder
 	switch format {
@@ -54,8 +53,8 @@ func NewZapLogger(level string, format string, additionalOpts ...logzap.Opts) (l
 	case "", FormatJSON:
 		opts = append(opts, logzap.JSONEncoder(setCommonEncoderConfigOptions))
 	default:
-		return logr.Logger{}, fmt.Errorf("invalid log format %q", format)
+		return logr.Logger{}, nil, fmt.Errorf("invalid log format %q", format)
 	}
 
-	return logzap.New(append(opts, additionalOpts...)...), nil
+	return logzap.New(append(opts, additionalOpts...)...), levels, nil
 }
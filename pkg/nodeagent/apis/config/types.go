@@ -51,6 +51,16 @@ type BootstrapConfiguration struct {
 	// KubeletDataVolumeSize sets the data volume size of an unformatted disk on the worker node, which is used for
 	// /var/lib on the worker.
 	KubeletDataVolumeSize *int64
+	// Attestation contains configuration for proving the identity of the machine before credentials are issued. If not
+	// set, the bootstrap token is exchanged for credentials without attestation.
+	Attestation *AttestationConfiguration
+}
+
+// AttestationConfiguration contains configuration for proving the identity of the machine.
+type AttestationConfiguration struct {
+	// ProviderType is the type of the infrastructure provider of the machine. Its extension provides the executable
+	// which creates the attestation documents.
+	ProviderType string
 }
 
 // ControllerConfiguration defines the configuration of the controllers.
@@ -59,6 +69,8 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig
+	// HealthCheck is the configuration for the health check controller.
+	HealthCheck *HealthCheckControllerConfig
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -73,6 +85,20 @@ type OperatingSystemConfigControllerConfig struct {
 	KubernetesVersion *semver.Version
 }
 
+// HealthCheckControllerConfig defines the configuration of the health check controller.
+type HealthCheckControllerConfig struct {
+	// Remediation is the configuration for remediating node problems without human intervention. If not set, node
+	// problems are not remediated.
+	Remediation *RemediationConfig
+}
+
+// RemediationConfig defines the configuration for remediating node problems.
+type RemediationConfig struct {
+	// MaxDisruptiveRemediations is the maximum number of nodes of a worker pool which are rebooted or replaced at the
+	// same time for remediating node problems.
+	MaxDisruptiveRemediations *int32
+}
+
 // TokenControllerConfig defines the configuration of the access token controller.
 type TokenControllerConfig struct {
 	// SyncConfigs is the list of configurations for syncing access tokens.
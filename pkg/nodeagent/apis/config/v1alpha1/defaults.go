@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -42,6 +43,13 @@ func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	}
 }
 
+// SetDefaults_RemediationConfig sets defaults for the RemediationConfig object.
+func SetDefaults_RemediationConfig(obj *RemediationConfig) {
+	if obj.MaxDisruptiveRemediations == nil {
+		obj.MaxDisruptiveRemediations = ptr.To[int32](1)
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
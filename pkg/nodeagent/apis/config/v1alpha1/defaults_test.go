@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -86,6 +87,26 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 				})
 			})
+
+			Describe("Remediation", func() {
+				It("should default the object", func() {
+					obj := &RemediationConfig{}
+
+					SetDefaults_RemediationConfig(obj)
+
+					Expect(obj.MaxDisruptiveRemediations).To(PointTo(Equal(int32(1))))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &RemediationConfig{
+						MaxDisruptiveRemediations: ptr.To[int32](3),
+					}
+
+					SetDefaults_RemediationConfig(obj)
+
+					Expect(obj.MaxDisruptiveRemediations).To(PointTo(Equal(int32(3))))
+				})
+			})
 		})
 
 		Describe("Server configuration", func() {
@@ -26,8 +26,20 @@ const (
 	BootstrapTokenFilePath = CredentialsDir + "/bootstrap-token"
 	// TokenFilePath is the file path on the worker node that contains the access token of the gardener-node-agent.
 	TokenFilePath = CredentialsDir + "/token"
+	// AttestedClientCertificateFilePath is the file path on the worker node that contains the client certificate and
+	// the private key which are issued after the machine proved its identity via attestation.
+	AttestedClientCertificateFilePath = CredentialsDir + "/attested-client.pem"
+	// AttestorFilePath is the file path on the worker node of the executable which creates attestation documents for
+	// the machine. It is provided by the extension of the infrastructure provider.
+	AttestorFilePath = BinaryDir + "/gardener-node-agent-attestor"
 	// ConfigFilePath is the file path on the worker node that contains the configuration of the gardener-node-agent.
 	ConfigFilePath = BaseDir + "/config.yaml"
+	// LastAppliedOperatingSystemConfigFilePath is the file path on the worker node that contains the last applied
+	// operating system config.
+	LastAppliedOperatingSystemConfigFilePath = BaseDir + "/last-applied-osc.yaml"
+	// InPlaceUpdateRebootFilePath is the file path on the worker node that contains the operating system version and the
+	// boot ID of the node when it was rebooted for completing an in-place update of the operating system.
+	InPlaceUpdateRebootFilePath = BaseDir + "/in-place-update-reboot.yaml"
 
 	// UnitName is the name of the gardener-node-agent systemd service.
 	UnitName = "gardener-node-agent.service"
@@ -43,6 +55,59 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+
+	// NodeConditionRegistryMirrorsHealthy is a constant for the type of a Node condition describing whether all
+	// configured containerd registry mirrors are healthy.
+	NodeConditionRegistryMirrorsHealthy = "RegistryMirrorsHealthy"
+	// NodeConditionImagesPrePulled is a constant for the type of a Node condition describing whether all images which
+	// are configured to be pre-pulled are present and pinned on the node.
+	NodeConditionImagesPrePulled = "ImagesPrePulled"
+	// NodeConditionKernelConfigApplied is a constant for the type of a Node condition describing whether the kernel
+	// configuration (sysctls, kernel modules, huge pages) of the operating system config is applied on the node.
+	NodeConditionKernelConfigApplied = "KernelConfigApplied"
+
+	// LabelInPlaceUpdate is a constant for a label key on a Node describing the state of an in-place update of the
+	// node.
+	LabelInPlaceUpdate = "worker.gardener.cloud/in-place-update"
+	// InPlaceUpdatePending is a constant for the value of the LabelInPlaceUpdate label describing that the node waits
+	// for its in-place update to be started.
+	InPlaceUpdatePending = "pending"
+	// InPlaceUpdateInProgress is a constant for the value of the LabelInPlaceUpdate label describing that the in-place
+	// update of the node is in progress.
+	InPlaceUpdateInProgress = "in-progress"
+	// AnnotationKeyInPlaceUpdateNodes is a constant for an annotation key on the in-place update Lease of a worker pool
+	// describing the comma-separated names of the nodes which are currently updated in-place.
+	AnnotationKeyInPlaceUpdateNodes = "worker.gardener.cloud/in-place-update-nodes"
+	// AnnotationKeyDrain is a constant for an annotation key on a Node describing the state of draining the node for
+	// an in-place update. gardener-node-agent requests the drain, and gardener-resource-manager drains the node.
+	AnnotationKeyDrain = "worker.gardener.cloud/drain"
+	// DrainRequested is a constant for the value of the AnnotationKeyDrain annotation describing that the node must be
+	// drained.
+	DrainRequested = "requested"
+	// DrainCompleted is a constant for the value of the AnnotationKeyDrain annotation describing that the node has been
+	// drained.
+	DrainCompleted = "completed"
+	// AnnotationKeyPreDrainHooks is a constant for an annotation key on a Node describing the state of calling the
+	// pre-drain hooks of its worker pool before machine-controller-manager drains the node. gardener-resource-manager
+	// calls the hooks and rejects evictions of pods running on the node until they are completed.
+	AnnotationKeyPreDrainHooks = "worker.gardener.cloud/pre-drain-hooks"
+	// PreDrainHooksCompleted is a constant for the value of the AnnotationKeyPreDrainHooks annotation describing that
+	// the pre-drain hooks have been called.
+	PreDrainHooksCompleted = "completed"
+	// AnnotationKeyRemediationNodes is a constant for an annotation key on the remediation Lease of a worker pool
+	// describing the comma-separated names of the nodes which recently executed a disruptive remediation action, each
+	// suffixed with the time of the action (`<node>=<RFC3339 time>`).
+	AnnotationKeyRemediationNodes = "worker.gardener.cloud/remediation-nodes"
+
+	// AnnotationKeyAttestationDocument is a constant for an annotation key on a CertificateSigningRequest containing the
+	// base64-encoded attestation document which proves the identity of the machine requesting the certificate.
+	AnnotationKeyAttestationDocument = "node-agent.gardener.cloud/attestation-document"
+	// AttestedUserNamePrefix is the prefix of the common name of client certificates which are issued after the machine
+	// proved its identity via attestation. It is followed by the host name of the machine.
+	AttestedUserNamePrefix = "gardener.cloud:system:node-agent:"
+	// AttestedNodesGroup is the organization of client certificates which are issued after the machine proved its
+	// identity via attestation.
+	AttestedNodesGroup = "gardener.cloud:system:attested-nodes"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -90,6 +155,17 @@ type BootstrapConfiguration struct {
 	// /var/lib on the worker.
 	// +optional
 	KubeletDataVolumeSize *int64 `json:"kubeletDataVolumeSize,omitempty"`
+	// Attestation contains configuration for proving the identity of the machine before credentials are issued. If not
+	// set, the bootstrap token is exchanged for credentials without attestation.
+	// +optional
+	Attestation *AttestationConfiguration `json:"attestation,omitempty"`
+}
+
+// AttestationConfiguration contains configuration for proving the identity of the machine.
+type AttestationConfiguration struct {
+	// ProviderType is the type of the infrastructure provider of the machine. Its extension provides the executable
+	// which creates the attestation documents.
+	ProviderType string `json:"providerType"`
 }
 
 // ControllerConfiguration defines the configuration of the controllers.
@@ -98,6 +174,9 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig `json:"operatingSystemConfig"`
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig `json:"token"`
+	// HealthCheck is the configuration for the health check controller.
+	// +optional
+	HealthCheck *HealthCheckControllerConfig `json:"healthCheck,omitempty"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -113,6 +192,22 @@ type OperatingSystemConfigControllerConfig struct {
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
 }
 
+// HealthCheckControllerConfig defines the configuration of the health check controller.
+type HealthCheckControllerConfig struct {
+	// Remediation is the configuration for remediating node problems without human intervention. If not set, node
+	// problems are not remediated.
+	// +optional
+	Remediation *RemediationConfig `json:"remediation,omitempty"`
+}
+
+// RemediationConfig defines the configuration for remediating node problems.
+type RemediationConfig struct {
+	// MaxDisruptiveRemediations is the maximum number of nodes of a worker pool which are rebooted or replaced at the
+	// same time for remediating node problems (default: 1).
+	// +optional
+	MaxDisruptiveRemediations *int32 `json:"maxDisruptiveRemediations,omitempty"`
+}
+
 // TokenControllerConfig defines the configuration of the access token controller.
 type TokenControllerConfig struct {
 	// SyncConfigs is the list of configurations for syncing access tokens.
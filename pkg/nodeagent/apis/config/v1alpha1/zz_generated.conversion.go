@@ -38,6 +38,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AttestationConfiguration)(nil), (*config.AttestationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AttestationConfiguration_To_config_AttestationConfiguration(a.(*AttestationConfiguration), b.(*config.AttestationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AttestationConfiguration)(nil), (*AttestationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AttestationConfiguration_To_v1alpha1_AttestationConfiguration(a.(*config.AttestationConfiguration), b.(*AttestationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BootstrapConfiguration)(nil), (*config.BootstrapConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BootstrapConfiguration_To_config_BootstrapConfiguration(a.(*BootstrapConfiguration), b.(*config.BootstrapConfiguration), scope)
 	}); err != nil {
@@ -58,6 +68,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HealthCheckControllerConfig)(nil), (*config.HealthCheckControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthCheckControllerConfig_To_config_HealthCheckControllerConfig(a.(*HealthCheckControllerConfig), b.(*config.HealthCheckControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HealthCheckControllerConfig)(nil), (*HealthCheckControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HealthCheckControllerConfig_To_v1alpha1_HealthCheckControllerConfig(a.(*config.HealthCheckControllerConfig), b.(*HealthCheckControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeAgentConfiguration)(nil), (*config.NodeAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(a.(*NodeAgentConfiguration), b.(*config.NodeAgentConfiguration), scope)
 	}); err != nil {
@@ -78,6 +98,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RemediationConfig)(nil), (*config.RemediationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RemediationConfig_To_config_RemediationConfig(a.(*RemediationConfig), b.(*config.RemediationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RemediationConfig)(nil), (*RemediationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RemediationConfig_To_v1alpha1_RemediationConfig(a.(*config.RemediationConfig), b.(*RemediationConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -143,8 +173,29 @@ func Convert_config_APIServer_To_v1alpha1_APIServer(in *config.APIServer, out *A
 	return autoConvert_config_APIServer_To_v1alpha1_APIServer(in, out, s)
 }
 
+func autoConvert_v1alpha1_AttestationConfiguration_To_config_AttestationConfiguration(in *AttestationConfiguration, out *config.AttestationConfiguration, s conversion.Scope) error {
+	out.ProviderType = in.ProviderType
+	return nil
+}
+
+// Convert_v1alpha1_AttestationConfiguration_To_config_AttestationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_AttestationConfiguration_To_config_AttestationConfiguration(in *AttestationConfiguration, out *config.AttestationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AttestationConfiguration_To_config_AttestationConfiguration(in, out, s)
+}
+
+func autoConvert_config_AttestationConfiguration_To_v1alpha1_AttestationConfiguration(in *config.AttestationConfiguration, out *AttestationConfiguration, s conversion.Scope) error {
+	out.ProviderType = in.ProviderType
+	return nil
+}
+
+// Convert_config_AttestationConfiguration_To_v1alpha1_AttestationConfiguration is an autogenerated conversion function.
+func Convert_config_AttestationConfiguration_To_v1alpha1_AttestationConfiguration(in *config.AttestationConfiguration, out *AttestationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_AttestationConfiguration_To_v1alpha1_AttestationConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_BootstrapConfiguration_To_config_BootstrapConfiguration(in *BootstrapConfiguration, out *config.BootstrapConfiguration, s conversion.Scope) error {
 	out.KubeletDataVolumeSize = (*int64)(unsafe.Pointer(in.KubeletDataVolumeSize))
+	out.Attestation = (*config.AttestationConfiguration)(unsafe.Pointer(in.Attestation))
 	return nil
 }
 
@@ -155,6 +206,7 @@ func Convert_v1alpha1_BootstrapConfiguration_To_config_BootstrapConfiguration(in
 
 func autoConvert_config_BootstrapConfiguration_To_v1alpha1_BootstrapConfiguration(in *config.BootstrapConfiguration, out *BootstrapConfiguration, s conversion.Scope) error {
 	out.KubeletDataVolumeSize = (*int64)(unsafe.Pointer(in.KubeletDataVolumeSize))
+	out.Attestation = (*AttestationConfiguration)(unsafe.Pointer(in.Attestation))
 	return nil
 }
 
@@ -170,6 +222,7 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	if err := Convert_v1alpha1_TokenControllerConfig_To_config_TokenControllerConfig(&in.Token, &out.Token, s); err != nil {
 		return err
 	}
+	out.HealthCheck = (*config.HealthCheckControllerConfig)(unsafe.Pointer(in.HealthCheck))
 	return nil
 }
 
@@ -185,6 +238,7 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	if err := Convert_config_TokenControllerConfig_To_v1alpha1_TokenControllerConfig(&in.Token, &out.Token, s); err != nil {
 		return err
 	}
+	out.HealthCheck = (*HealthCheckControllerConfig)(unsafe.Pointer(in.HealthCheck))
 	return nil
 }
 
@@ -193,6 +247,26 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HealthCheckControllerConfig_To_config_HealthCheckControllerConfig(in *HealthCheckControllerConfig, out *config.HealthCheckControllerConfig, s conversion.Scope) error {
+	out.Remediation = (*config.RemediationConfig)(unsafe.Pointer(in.Remediation))
+	return nil
+}
+
+// Convert_v1alpha1_HealthCheckControllerConfig_To_config_HealthCheckControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_HealthCheckControllerConfig_To_config_HealthCheckControllerConfig(in *HealthCheckControllerConfig, out *config.HealthCheckControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthCheckControllerConfig_To_config_HealthCheckControllerConfig(in, out, s)
+}
+
+func autoConvert_config_HealthCheckControllerConfig_To_v1alpha1_HealthCheckControllerConfig(in *config.HealthCheckControllerConfig, out *HealthCheckControllerConfig, s conversion.Scope) error {
+	out.Remediation = (*RemediationConfig)(unsafe.Pointer(in.Remediation))
+	return nil
+}
+
+// Convert_config_HealthCheckControllerConfig_To_v1alpha1_HealthCheckControllerConfig is an autogenerated conversion function.
+func Convert_config_HealthCheckControllerConfig_To_v1alpha1_HealthCheckControllerConfig(in *config.HealthCheckControllerConfig, out *HealthCheckControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_HealthCheckControllerConfig_To_v1alpha1_HealthCheckControllerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(in *NodeAgentConfiguration, out *config.NodeAgentConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -285,6 +359,26 @@ func Convert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingS
 	return autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingSystemConfigControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_RemediationConfig_To_config_RemediationConfig(in *RemediationConfig, out *config.RemediationConfig, s conversion.Scope) error {
+	out.MaxDisruptiveRemediations = (*int32)(unsafe.Pointer(in.MaxDisruptiveRemediations))
+	return nil
+}
+
+// Convert_v1alpha1_RemediationConfig_To_config_RemediationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RemediationConfig_To_config_RemediationConfig(in *RemediationConfig, out *config.RemediationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RemediationConfig_To_config_RemediationConfig(in, out, s)
+}
+
+func autoConvert_config_RemediationConfig_To_v1alpha1_RemediationConfig(in *config.RemediationConfig, out *RemediationConfig, s conversion.Scope) error {
+	out.MaxDisruptiveRemediations = (*int32)(unsafe.Pointer(in.MaxDisruptiveRemediations))
+	return nil
+}
+
+// Convert_config_RemediationConfig_To_v1alpha1_RemediationConfig is an autogenerated conversion function.
+func Convert_config_RemediationConfig_To_v1alpha1_RemediationConfig(in *config.RemediationConfig, out *RemediationConfig, s conversion.Scope) error {
+	return autoConvert_config_RemediationConfig_To_v1alpha1_RemediationConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -37,6 +37,22 @@ func (in *APIServer) DeepCopy() *APIServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttestationConfiguration) DeepCopyInto(out *AttestationConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttestationConfiguration.
+func (in *AttestationConfiguration) DeepCopy() *AttestationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AttestationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BootstrapConfiguration) DeepCopyInto(out *BootstrapConfiguration) {
 	*out = *in
@@ -45,6 +61,11 @@ func (in *BootstrapConfiguration) DeepCopyInto(out *BootstrapConfiguration) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Attestation != nil {
+		in, out := &in.Attestation, &out.Attestation
+		*out = new(AttestationConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -63,6 +84,11 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	in.OperatingSystemConfig.DeepCopyInto(&out.OperatingSystemConfig)
 	in.Token.DeepCopyInto(&out.Token)
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -76,6 +102,27 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckControllerConfig) DeepCopyInto(out *HealthCheckControllerConfig) {
+	*out = *in
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(RemediationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckControllerConfig.
+func (in *HealthCheckControllerConfig) DeepCopy() *HealthCheckControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -148,6 +195,27 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationConfig) DeepCopyInto(out *RemediationConfig) {
+	*out = *in
+	if in.MaxDisruptiveRemediations != nil {
+		in, out := &in.MaxDisruptiveRemediations, &out.MaxDisruptiveRemediations
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationConfig.
+func (in *RemediationConfig) DeepCopy() *RemediationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -27,4 +27,9 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
+	if in.Controllers.HealthCheck != nil {
+		if in.Controllers.HealthCheck.Remediation != nil {
+			SetDefaults_RemediationConfig(in.Controllers.HealthCheck.Remediation)
+		}
+	}
 }
@@ -35,9 +35,17 @@ func ValidateNodeAgentConfiguration(conf *config.NodeAgentConfiguration) field.E
 	return allErrs
 }
 
-func validateBootstrapConfiguration(_ *config.BootstrapConfiguration, _ *field.Path) field.ErrorList {
+func validateBootstrapConfiguration(conf *config.BootstrapConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.Attestation != nil && len(conf.Attestation.ProviderType) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("attestation", "providerType"), "must provide the infrastructure provider type"))
+	}
+
 	return allErrs
 }
 
@@ -47,6 +55,20 @@ func validateControllerConfiguration(conf config.ControllerConfiguration, fldPat
 	allErrs = append(allErrs, validateOperatingSystemConfigControllerConfiguration(conf.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
 	allErrs = append(allErrs, validateTokenControllerConfiguration(conf.Token, fldPath.Child("token"))...)
 
+	if conf.HealthCheck != nil && conf.HealthCheck.Remediation != nil {
+		allErrs = append(allErrs, validateRemediationConfiguration(*conf.HealthCheck.Remediation, fldPath.Child("healthCheck", "remediation"))...)
+	}
+
+	return allErrs
+}
+
+func validateRemediationConfiguration(conf config.RemediationConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MaxDisruptiveRemediations == nil || *conf.MaxDisruptiveRemediations < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDisruptiveRemediations"), conf.MaxDisruptiveRemediations, "must be at least 1"))
+	}
+
 	return allErrs
 }
 
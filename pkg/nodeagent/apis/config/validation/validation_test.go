@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/validation"
@@ -44,6 +45,25 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 		Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
 	})
 
+	Context("Bootstrap", func() {
+		It("should pass because the attestation provider type is set", func() {
+			config.Bootstrap = &BootstrapConfiguration{Attestation: &AttestationConfiguration{ProviderType: "local"}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the attestation provider type is empty", func() {
+			config.Bootstrap = &BootstrapConfiguration{Attestation: &AttestationConfiguration{}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("bootstrap.attestation.providerType"),
+				})),
+			))
+		})
+	})
+
 	Context("Operating System Config Controller", func() {
 		It("should fail because kubernetes version is empty", func() {
 			config.Controllers.OperatingSystemConfig.KubernetesVersion = nil
@@ -142,4 +162,23 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 			))
 		})
 	})
+
+	Context("Health Check Controller", func() {
+		It("should pass because the remediation configuration is valid", func() {
+			config.Controllers.HealthCheck = &HealthCheckControllerConfig{Remediation: &RemediationConfig{MaxDisruptiveRemediations: ptr.To[int32](1)}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the maximum number of disruptive remediations is too small", func() {
+			config.Controllers.HealthCheck = &HealthCheckControllerConfig{Remediation: &RemediationConfig{MaxDisruptiveRemediations: ptr.To[int32](0)}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.remediation.maxDisruptiveRemediations"),
+				})),
+			))
+		})
+	})
 })
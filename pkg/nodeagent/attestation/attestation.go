@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"time"
+)
+
+const (
+	// ClientCertificateValidity is the validity of client certificates issued after a machine proved its identity. They
+	// only need to be valid until gardener-node-agent fetched its access token and kubelet obtained its own client
+	// certificate.
+	ClientCertificateValidity = time.Hour
+
+	// VerifierServiceName is the name of the Service in the control plane namespace of a shoot which is deployed by the
+	// extension of the infrastructure provider if it supports the attestation of machines. It serves the verification
+	// endpoint at VerifierPath.
+	VerifierServiceName = "node-agent-attestation-verifier"
+	// VerifierPort is the port of the Service serving the verification endpoint via HTTPS.
+	VerifierPort = 443
+	// VerifierPath is the path of the verification endpoint.
+	VerifierPath = "/verify"
+	// VerifierCASecretName is the name of the Secret in the control plane namespace of a shoot which contains the CA
+	// bundle for verifying the serving certificate of the verification endpoint. It is deployed by the extension of the
+	// infrastructure provider along with the verifier Service.
+	VerifierCASecretName = "node-agent-attestation-verifier-ca"
+	// VerifierCASecretDataKey is the data key of the CA bundle in the Secret named VerifierCASecretName.
+	VerifierCASecretDataKey = "bundle.crt"
+	// VerifierTokenAudience is the audience of the ServiceAccount tokens which gardener-resource-manager sends to the
+	// verification endpoint for authenticating itself.
+	VerifierTokenAudience = "node-agent-attestation-verifier"
+)
+
+// Attestor creates attestation documents which prove the identity of the machine gardener-node-agent is running on.
+type Attestor interface {
+	// Attest returns an attestation document for the machine. The document must be bound to the given nonce so that it
+	// cannot be replayed for other certificate signing requests.
+	Attest(ctx context.Context, nonce []byte) ([]byte, error)
+}
+
+// Verifier verifies attestation documents created by an Attestor.
+type Verifier interface {
+	// Verify verifies the given attestation document and checks that it is bound to the given nonce. It returns the
+	// provider ID of the attested machine.
+	Verify(ctx context.Context, document, nonce []byte) (string, error)
+}
+
+// VerificationRequest is the body of requests sent to the verification endpoint.
+type VerificationRequest struct {
+	// Document is the attestation document.
+	Document []byte `json:"document"`
+	// Nonce is the nonce the attestation document must be bound to.
+	Nonce []byte `json:"nonce"`
+}
+
+// VerificationResponse is the body of responses returned by the verification endpoint.
+type VerificationResponse struct {
+	// ProviderID is the provider ID of the attested machine. It is only set if the attestation document is valid.
+	ProviderID string `json:"providerID,omitempty"`
+	// Error describes why the attestation document is invalid.
+	Error string `json:"error,omitempty"`
+}
+
+// Nonce computes the nonce for the given public key of a certificate signing request. Attestation documents bound to
+// this nonce can only be used for certificate signing requests of the same key pair.
+func Nonce(publicKey any) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling public key: %w", err)
+	}
+
+	sum := sha256.Sum256(der)
+	return sum[:], nil
+}
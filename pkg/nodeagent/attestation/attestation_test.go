@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/attestation"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+type fakeVerifier struct {
+	providerID string
+	err        error
+}
+
+type fakeAuthenticator struct {
+	token string
+}
+
+func (f *fakeAuthenticator) Authenticate(_ context.Context, token string) error {
+	if token != f.token {
+		return errors.New("unexpected token")
+	}
+	return nil
+}
+
+func (f *fakeVerifier) Verify(_ context.Context, document, nonce []byte) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	if string(document) != "document" || string(nonce) != "nonce" {
+		return "", errors.New("unexpected document or nonce")
+	}
+	return f.providerID, nil
+}
+
+var _ = Describe("Attestation", func() {
+	var ctx = context.Background()
+
+	Describe("#Nonce", func() {
+		It("should compute the same nonce for the same public key only", func() {
+			key1, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			key2, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			nonce1, err := Nonce(&key1.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nonce1).To(HaveLen(32))
+			Expect(Nonce(&key1.PublicKey)).To(Equal(nonce1))
+			Expect(Nonce(&key2.PublicKey)).NotTo(Equal(nonce1))
+		})
+	})
+
+	Describe("ExecAttestor", func() {
+		var attestor *ExecAttestor
+
+		BeforeEach(func() {
+			attestor = NewExecAttestor("/opt/bin/gardener-node-agent-attestor")
+		})
+
+		It("should execute the attestor with the base64-encoded nonce", func() {
+			attestor.Execute = func(_ context.Context, name string, args ...string) ([]byte, error) {
+				Expect(name).To(Equal("/opt/bin/gardener-node-agent-attestor"))
+				Expect(args).To(ConsistOf("bm9uY2U="))
+				return []byte("document"), nil
+			}
+
+			Expect(attestor.Attest(ctx, []byte("nonce"))).To(Equal([]byte("document")))
+		})
+
+		It("should fail if the attestor fails", func() {
+			attestor.Execute = func(context.Context, string, ...string) ([]byte, error) { return nil, errors.New("fake") }
+
+			_, err := attestor.Attest(ctx, []byte("nonce"))
+			Expect(err).To(MatchError("failed executing attestor /opt/bin/gardener-node-agent-attestor: fake"))
+		})
+
+		It("should fail if the attestor does not return a document", func() {
+			attestor.Execute = func(context.Context, string, ...string) ([]byte, error) { return nil, nil }
+
+			_, err := attestor.Attest(ctx, []byte("nonce"))
+			Expect(err).To(MatchError("attestor /opt/bin/gardener-node-agent-attestor did not return an attestation document"))
+		})
+	})
+
+	Describe("WebhookVerifier", func() {
+		var (
+			verifier      *fakeVerifier
+			authenticator *fakeAuthenticator
+			server        *httptest.Server
+			caFile        string
+			tokenFile     string
+
+			newWebhookVerifier = func(url string) *WebhookVerifier {
+				webhookVerifier, err := NewWebhookVerifier(url, caFile, tokenFile)
+				Expect(err).NotTo(HaveOccurred())
+				return webhookVerifier
+			}
+		)
+
+		BeforeEach(func() {
+			verifier = &fakeVerifier{providerID: "provider-id"}
+			authenticator = &fakeAuthenticator{token: "token"}
+			server = httptest.NewTLSServer(NewVerifierHandler(verifier, authenticator))
+			DeferCleanup(server.Close)
+
+			dir := GinkgoT().TempDir()
+			caFile = filepath.Join(dir, "bundle.crt")
+			Expect(os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)).To(Succeed())
+			tokenFile = filepath.Join(dir, "token")
+			Expect(os.WriteFile(tokenFile, []byte("token\n"), 0600)).To(Succeed())
+		})
+
+		It("should return the provider ID of valid attestation documents", func() {
+			Expect(newWebhookVerifier(server.URL+VerifierPath).Verify(ctx, []byte("document"), []byte("nonce"))).To(Equal("provider-id"))
+		})
+
+		It("should fail for invalid attestation documents", func() {
+			verifier.err = errors.New("invalid signature")
+
+			_, err := newWebhookVerifier(server.URL+VerifierPath).Verify(ctx, []byte("document"), []byte("nonce"))
+			Expect(err).To(MatchError("attestation document was rejected with status code 403: invalid signature"))
+		})
+
+		It("should fail if the verifier does not return a provider ID", func() {
+			verifier.providerID = ""
+
+			_, err := newWebhookVerifier(server.URL+VerifierPath).Verify(ctx, []byte("document"), []byte("nonce"))
+			Expect(err).To(MatchError("verification response does not contain a provider ID"))
+		})
+
+		It("should fail if the endpoint does not respond with a verification response", func() {
+			server := httptest.NewTLSServer(http.NotFoundHandler())
+			DeferCleanup(server.Close)
+			Expect(os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)).To(Succeed())
+
+			_, err := newWebhookVerifier(server.URL).Verify(ctx, []byte("document"), []byte("nonce"))
+			Expect(err).To(MatchError(ContainSubstring("failed decoding verification response with status code 404")))
+		})
+
+		It("should fail if the token is not accepted", func() {
+			Expect(os.WriteFile(tokenFile, []byte("other-token"), 0600)).To(Succeed())
+
+			_, err := newWebhookVerifier(server.URL+VerifierPath).Verify(ctx, []byte("document"), []byte("nonce"))
+			Expect(err).To(MatchError("attestation document was rejected with status code 401: unexpected token"))
+		})
+
+		It("should fail if the serving certificate is not signed by the CA bundle", func() {
+			otherCA, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(caFile, otherCA.CertificatePEM, 0600)).To(Succeed())
+
+			_, err = newWebhookVerifier(server.URL+VerifierPath).Verify(ctx, []byte("document"), []byte("nonce"))
+			Expect(err).To(MatchError(ContainSubstring("failed sending verification request")))
+		})
+
+		It("should fail if the CA bundle does not exist yet", func() {
+			Expect(os.Remove(caFile)).To(Succeed())
+
+			_, err := newWebhookVerifier(server.URL+VerifierPath).Verify(ctx, []byte("document"), []byte("nonce"))
+			Expect(err).To(MatchError(ContainSubstring("failed reading CA bundle of verification endpoint")))
+		})
+
+		It("should not allow endpoints which are not served via https", func() {
+			_, err := NewWebhookVerifier("http://node-agent-attestation-verifier/verify", caFile, tokenFile)
+			Expect(err).To(MatchError(`verification endpoint must be served via https, got scheme "http"`))
+		})
+
+		It("should reject requests without a bearer token", func() {
+			response, err := server.Client().Post(server.URL, "application/json", nil)
+			Expect(err).NotTo(HaveOccurred())
+			defer response.Body.Close()
+
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should reject requests with other methods", func() {
+			response, err := server.Client().Get(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			defer response.Body.Close()
+
+			Expect(response.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+
+	Describe("TokenReviewAuthenticator", func() {
+		var (
+			tokenReviewStatus authenticationv1.TokenReviewStatus
+			authenticator     *TokenReviewAuthenticator
+		)
+
+		BeforeEach(func() {
+			tokenReviewStatus = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				Audiences:     []string{"node-agent-attestation-verifier"},
+				User:          authenticationv1.UserInfo{Username: "system:serviceaccount:shoot--foo--bar:gardener-resource-manager"},
+			}
+
+			fakeClient := fakeclient.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					tokenReview, ok := obj.(*authenticationv1.TokenReview)
+					Expect(ok).To(BeTrue())
+					Expect(tokenReview.Spec.Token).To(Equal("token"))
+					Expect(tokenReview.Spec.Audiences).To(ConsistOf("node-agent-attestation-verifier"))
+					tokenReview.Status = tokenReviewStatus
+					return nil
+				},
+			}).Build()
+
+			authenticator = NewTokenReviewAuthenticator(fakeClient, "shoot--foo--bar")
+		})
+
+		It("should accept tokens of gardener-resource-manager", func() {
+			Expect(authenticator.Authenticate(ctx, "token")).To(Succeed())
+		})
+
+		It("should reject tokens which are not authenticated", func() {
+			tokenReviewStatus.Authenticated = false
+			tokenReviewStatus.Error = "expired"
+
+			Expect(authenticator.Authenticate(ctx, "token")).To(MatchError("token is not authenticated: expired"))
+		})
+
+		It("should reject tokens issued for other audiences", func() {
+			tokenReviewStatus.Audiences = []string{"kubernetes"}
+
+			Expect(authenticator.Authenticate(ctx, "token")).To(MatchError(`token is not issued for audience "node-agent-attestation-verifier"`))
+		})
+
+		It("should reject tokens of other users", func() {
+			tokenReviewStatus.User.Username = "system:serviceaccount:shoot--foo--baz:gardener-resource-manager"
+
+			Expect(authenticator.Authenticate(ctx, "token")).To(MatchError(`token of user "system:serviceaccount:shoot--foo--baz:gardener-resource-manager" is not allowed to use the verification endpoint`))
+		})
+	})
+})
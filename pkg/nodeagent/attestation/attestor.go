@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ExecAttestor creates attestation documents by executing an executable which is provided by the extension of the
+// infrastructure provider. The executable is called with the base64-encoded nonce as only argument and must print the
+// attestation document to its standard output.
+type ExecAttestor struct {
+	// Path is the path of the executable.
+	Path string
+	// Execute executes the given command and returns its standard output. Exposed for testing.
+	Execute func(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// NewExecAttestor creates a new attestor which executes the executable at the given path.
+func NewExecAttestor(path string) *ExecAttestor {
+	return &ExecAttestor{
+		Path: path,
+		Execute: func(ctx context.Context, name string, args ...string) ([]byte, error) {
+			out, err := exec.CommandContext(ctx, name, args...).Output()
+			if exitErr := (&exec.ExitError{}); errors.As(err, &exitErr) {
+				return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+			}
+			return out, err
+		},
+	}
+}
+
+// Attest executes the attestor executable and returns the attestation document printed by it.
+func (e *ExecAttestor) Attest(ctx context.Context, nonce []byte) ([]byte, error) {
+	document, err := e.Execute(ctx, e.Path, base64.StdEncoding.EncodeToString(nonce))
+	if err != nil {
+		return nil, fmt.Errorf("failed executing attestor %s: %w", e.Path, err)
+	}
+
+	if len(document) == 0 {
+		return nil, fmt.Errorf("attestor %s did not return an attestation document", e.Path)
+	}
+
+	return document, nil
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// maxVerificationBodySize is the maximum size of request and response bodies of the verification endpoint.
+const maxVerificationBodySize = 1 << 20
+
+// WebhookVerifier verifies attestation documents by sending them to the verification endpoint which is served by the
+// extension of the infrastructure provider.
+type WebhookVerifier struct {
+	// URL is the URL of the verification endpoint.
+	URL string
+	// CAFile is the path of the file containing the CA bundle for verifying the serving certificate of the verification
+	// endpoint.
+	CAFile string
+	// TokenFile is the path of the file containing the bearer token which is sent to the verification endpoint.
+	TokenFile string
+}
+
+// NewWebhookVerifier creates a new verifier for the verification endpoint at the given URL. The endpoint must be served
+// via HTTPS with a certificate signed by the CA bundle stored in the given file. Requests authenticate with the bearer
+// token stored in the given file. Both files are read for each request since the extension might provide the CA bundle
+// only after gardener-resource-manager has been started, and since both are rotated regularly.
+func NewWebhookVerifier(verifierURL, caFile, tokenFile string) (*WebhookVerifier, error) {
+	u, err := url.Parse(verifierURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing URL of verification endpoint: %w", err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("verification endpoint must be served via https, got scheme %q", u.Scheme)
+	}
+
+	return &WebhookVerifier{
+		URL:       verifierURL,
+		CAFile:    caFile,
+		TokenFile: tokenFile,
+	}, nil
+}
+
+// Verify sends the given attestation document and nonce to the verification endpoint. It returns the provider ID of the
+// attested machine if the endpoint accepted the document.
+func (w *WebhookVerifier) Verify(ctx context.Context, document, nonce []byte) (string, error) {
+	caBundle, err := os.ReadFile(w.CAFile) // #nosec: G304 -- The file path is provided via the component configuration.
+	if err != nil {
+		return "", fmt.Errorf("failed reading CA bundle of verification endpoint: %w", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return "", fmt.Errorf("CA bundle of verification endpoint in %s does not contain any certificate", w.CAFile)
+	}
+
+	token, err := os.ReadFile(w.TokenFile) // #nosec: G304 -- The file path is provided via the component configuration.
+	if err != nil {
+		return "", fmt.Errorf("failed reading token for verification endpoint: %w", err)
+	}
+
+	body, err := json.Marshal(VerificationRequest{Document: document, Nonce: nonce})
+	if err != nil {
+		return "", fmt.Errorf("failed encoding verification request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed creating verification request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+
+	httpClient := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:    rootCAs,
+				MinVersion: tls.VersionTLS12,
+			},
+		},
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("failed sending verification request: %w", err)
+	}
+	defer response.Body.Close()
+
+	var result VerificationResponse
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxVerificationBodySize)).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed decoding verification response with status code %d: %w", response.StatusCode, err)
+	}
+
+	if response.StatusCode != http.StatusOK || result.Error != "" {
+		return "", fmt.Errorf("attestation document was rejected with status code %d: %s", response.StatusCode, result.Error)
+	}
+
+	if result.ProviderID == "" {
+		return "", fmt.Errorf("verification response does not contain a provider ID")
+	}
+
+	return result.ProviderID, nil
+}
+
+// Authenticator authenticates the bearer tokens of requests sent to the verification endpoint.
+type Authenticator interface {
+	// Authenticate returns an error if the given token must not be used for sending requests to the verification
+	// endpoint.
+	Authenticate(ctx context.Context, token string) error
+}
+
+// TokenReviewAuthenticator authenticates bearer tokens by reviewing them with the kube-apiserver of the seed. It only
+// accepts tokens of the ServiceAccount of gardener-resource-manager in the control plane namespace of the shoot which
+// have been issued for the VerifierTokenAudience.
+type TokenReviewAuthenticator struct {
+	// Client is the client for the seed cluster.
+	Client client.Client
+	// Username is the name of the user the tokens must belong to.
+	Username string
+}
+
+// NewTokenReviewAuthenticator creates a new authenticator accepting the tokens of gardener-resource-manager running in
+// the given control plane namespace.
+func NewTokenReviewAuthenticator(c client.Client, namespace string) *TokenReviewAuthenticator {
+	return &TokenReviewAuthenticator{
+		Client:   c,
+		Username: serviceaccount.MakeUsername(namespace, v1beta1constants.DeploymentNameGardenerResourceManager),
+	}
+}
+
+// Authenticate reviews the given token and checks that it belongs to the expected user and audience.
+func (t *TokenReviewAuthenticator) Authenticate(ctx context.Context, token string) error {
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: []string{VerifierTokenAudience},
+		},
+	}
+	if err := t.Client.Create(ctx, tokenReview); err != nil {
+		return fmt.Errorf("failed reviewing token: %w", err)
+	}
+
+	if !tokenReview.Status.Authenticated {
+		return fmt.Errorf("token is not authenticated: %s", tokenReview.Status.Error)
+	}
+	if !slices.Contains(tokenReview.Status.Audiences, VerifierTokenAudience) {
+		return fmt.Errorf("token is not issued for audience %q", VerifierTokenAudience)
+	}
+	if tokenReview.Status.User.Username != t.Username {
+		return fmt.Errorf("token of user %q is not allowed to use the verification endpoint", tokenReview.Status.User.Username)
+	}
+
+	return nil
+}
+
+// NewVerifierHandler returns an HTTP handler serving the verification endpoint with the given verifier. Requests are
+// only served if their bearer token is accepted by the given authenticator. Extensions of infrastructure providers can
+// use it for implementing the verification endpoint.
+func NewVerifierHandler(verifier Verifier, authenticator Authenticator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respond := func(statusCode int, response VerificationResponse) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			_ = json.NewEncoder(w).Encode(response)
+		}
+
+		if r.Method != http.MethodPost {
+			respond(http.StatusMethodNotAllowed, VerificationResponse{Error: fmt.Sprintf("method %s is not allowed", r.Method)})
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			respond(http.StatusUnauthorized, VerificationResponse{Error: "request does not contain a bearer token"})
+			return
+		}
+		if err := authenticator.Authenticate(r.Context(), token); err != nil {
+			respond(http.StatusUnauthorized, VerificationResponse{Error: err.Error()})
+			return
+		}
+
+		var request VerificationRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxVerificationBodySize)).Decode(&request); err != nil {
+			respond(http.StatusBadRequest, VerificationResponse{Error: fmt.Sprintf("failed decoding verification request: %v", err)})
+			return
+		}
+
+		providerID, err := verifier.Verify(r.Context(), request.Document, request.Nonce)
+		if err != nil {
+			respond(http.StatusForbidden, VerificationResponse{Error: err.Error()})
+			return
+		}
+
+		respond(http.StatusOK, VerificationResponse{ProviderID: providerID})
+	})
+}
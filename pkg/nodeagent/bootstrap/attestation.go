@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstrap
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+	certutil "k8s.io/client-go/util/cert"
+	csrutil "k8s.io/client-go/util/certificate/csr"
+	"k8s.io/client-go/util/keyutil"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const attestedCSRNamePrefix = "node-agent-attestation-"
+
+var (
+	// WaitForCertificateTimeout is the maximum duration for waiting until the certificate signing request is approved
+	// and signed. Exposed for testing.
+	WaitForCertificateTimeout = 5 * time.Minute
+	// WaitForCertificateInterval is the interval in which the certificate signing request is checked. Exposed for
+	// testing.
+	WaitForCertificateInterval = 2 * time.Second
+)
+
+// RequestAttestedClientCertificate proves the identity of the machine with the given attestor and requests a
+// short-lived client certificate. The attestation document is bound to the key pair of
+// the certificate signing request and verified by gardener-resource-manager before the request is approved. The issued
+// certificate and its private key are written to the AttestedClientCertificateFilePath.
+func RequestAttestedClientCertificate(
+	ctx context.Context,
+	log logr.Logger,
+	fs afero.Afero,
+	client kubernetesclientset.Interface,
+	attestor attestation.Attestor,
+	hostName string,
+) error {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed generating private key: %w", err)
+	}
+
+	csrData, err := certutil.MakeCSR(privateKey, &pkix.Name{
+		CommonName:   nodeagentv1alpha1.AttestedUserNamePrefix + hostName,
+		Organization: []string{nodeagentv1alpha1.AttestedNodesGroup},
+	}, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed generating certificate signing request: %w", err)
+	}
+
+	nonce, err := attestation.Nonce(&privateKey.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Creating attestation document")
+	document, err := attestor.Attest(ctx, nonce)
+	if err != nil {
+		return fmt.Errorf("failed creating attestation document: %w", err)
+	}
+
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: attestedCSRNamePrefix,
+			Annotations:  map[string]string{nodeagentv1alpha1.AnnotationKeyAttestationDocument: base64.StdEncoding.EncodeToString(document)},
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           csrData,
+			SignerName:        certificatesv1.KubeAPIServerClientSignerName,
+			ExpirationSeconds: csrutil.DurationToExpirationSeconds(attestation.ClientCertificateValidity),
+			Usages:            []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth},
+		},
+	}
+
+	csr, err = client.CertificatesV1().CertificateSigningRequests().Create(ctx, csr, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed creating certificate signing request: %w", err)
+	}
+
+	log.Info("Waiting for certificate signing request to be approved and signed", "certificateSigningRequestName", csr.Name)
+	certData, err := waitForCertificate(ctx, client, csr)
+	if err != nil {
+		return err
+	}
+
+	keyData, err := keyutil.MarshalPrivateKeyToPEM(privateKey)
+	if err != nil {
+		return fmt.Errorf("failed marshalling private key: %w", err)
+	}
+
+	log.Info("Writing attested client certificate to disk", "path", nodeagentv1alpha1.AttestedClientCertificateFilePath)
+	if err := fs.MkdirAll(filepath.Dir(nodeagentv1alpha1.AttestedClientCertificateFilePath), os.ModeDir); err != nil {
+		return fmt.Errorf("unable to create directory %q: %w", filepath.Dir(nodeagentv1alpha1.AttestedClientCertificateFilePath), err)
+	}
+	if err := fs.WriteFile(nodeagentv1alpha1.AttestedClientCertificateFilePath, append(certData, keyData...), 0600); err != nil {
+		return fmt.Errorf("unable to write attested client certificate to %s: %w", nodeagentv1alpha1.AttestedClientCertificateFilePath, err)
+	}
+
+	return nil
+}
+
+func waitForCertificate(ctx context.Context, client kubernetesclientset.Interface, csr *certificatesv1.CertificateSigningRequest) ([]byte, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, WaitForCertificateTimeout)
+	defer cancel()
+
+	var certData []byte
+
+	if err := retry.Until(timeoutCtx, WaitForCertificateInterval, func(ctx context.Context) (bool, error) {
+		current, err := client.CertificatesV1().CertificateSigningRequests().Get(ctx, csr.Name, metav1.GetOptions{})
+		if err != nil {
+			return retry.MinorError(fmt.Errorf("failed reading certificate signing request %s: %w", csr.Name, err))
+		}
+
+		if current.UID != csr.UID {
+			return retry.SevereError(fmt.Errorf("certificate signing request %s changed UIDs", csr.Name))
+		}
+
+		approved := false
+		for _, c := range current.Status.Conditions {
+			switch c.Type {
+			case certificatesv1.CertificateDenied:
+				return retry.SevereError(fmt.Errorf("certificate signing request %s is denied, reason: %s, message: %s", csr.Name, c.Reason, c.Message))
+			case certificatesv1.CertificateFailed:
+				return retry.SevereError(fmt.Errorf("certificate signing request %s failed, reason: %s, message: %s", csr.Name, c.Reason, c.Message))
+			case certificatesv1.CertificateApproved:
+				approved = true
+			}
+		}
+
+		if !approved {
+			return retry.MinorError(fmt.Errorf("certificate signing request %s is not yet approved", csr.Name))
+		}
+
+		if len(current.Status.Certificate) == 0 {
+			return retry.MinorError(fmt.Errorf("certificate signing request %s is approved, waiting to be issued", csr.Name))
+		}
+
+		certData = current.Status.Certificate
+		return retry.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return certData, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstrap_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	testing "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
+	. "github.com/gardener/gardener/pkg/nodeagent/bootstrap"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+type fakeAttestor struct {
+	err error
+}
+
+func (f *fakeAttestor) Attest(_ context.Context, nonce []byte) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return append([]byte("machine-0:"), nonce...), nil
+}
+
+var _ = Describe("Attestation", func() {
+	Describe("#RequestAttestedClientCertificate", func() {
+		var (
+			ctx = context.Background()
+			log = logr.Discard()
+
+			fakeFS        afero.Afero
+			fakeClientset *fake.Clientset
+			attestor      *fakeAttestor
+
+			createdCSR *certificatesv1.CertificateSigningRequest
+			conditions []certificatesv1.CertificateSigningRequestCondition
+			certData   = []byte("-----BEGIN CERTIFICATE-----\nfoo\n-----END CERTIFICATE-----\n")
+		)
+
+		BeforeEach(func() {
+			fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+			fakeClientset = fake.NewSimpleClientset()
+			attestor = &fakeAttestor{}
+
+			createdCSR = nil
+			conditions = []certificatesv1.CertificateSigningRequestCondition{{Type: certificatesv1.CertificateApproved, Status: corev1.ConditionTrue}}
+
+			fakeClientset.PrependReactor("create", "certificatesigningrequests", func(action testing.Action) (bool, runtime.Object, error) {
+				createdCSR = action.(testing.CreateAction).GetObject().(*certificatesv1.CertificateSigningRequest).DeepCopy()
+				createdCSR.Name = createdCSR.GenerateName + "abcde"
+				createdCSR.UID = "uid"
+				return true, createdCSR, nil
+			})
+			fakeClientset.PrependReactor("get", "certificatesigningrequests", func(testing.Action) (bool, runtime.Object, error) {
+				csr := createdCSR.DeepCopy()
+				csr.Status.Conditions = conditions
+				csr.Status.Certificate = certData
+				return true, csr, nil
+			})
+
+			DeferCleanup(test.WithVars(
+				&WaitForCertificateInterval, time.Millisecond,
+				&WaitForCertificateTimeout, 100*time.Millisecond,
+			))
+		})
+
+		It("should request the certificate with a valid attestation document and write it to disk", func() {
+			Expect(RequestAttestedClientCertificate(ctx, log, fakeFS, fakeClientset, attestor, "machine-0")).To(Succeed())
+
+			Expect(createdCSR.Name).To(HavePrefix("node-agent-attestation-"))
+			Expect(createdCSR.Spec.SignerName).To(Equal(certificatesv1.KubeAPIServerClientSignerName))
+			Expect(createdCSR.Spec.ExpirationSeconds).To(Equal(ptr.To[int32](3600)))
+			Expect(createdCSR.Spec.Usages).To(ConsistOf(certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth))
+
+			x509cr, err := utils.DecodeCertificateRequest(createdCSR.Spec.Request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(x509cr.Subject.CommonName).To(Equal("gardener.cloud:system:node-agent:machine-0"))
+			Expect(x509cr.Subject.Organization).To(ConsistOf("gardener.cloud:system:attested-nodes"))
+
+			document, err := base64.StdEncoding.DecodeString(createdCSR.Annotations["node-agent.gardener.cloud/attestation-document"])
+			Expect(err).NotTo(HaveOccurred())
+			nonce, err := attestation.Nonce(x509cr.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(document).To(Equal(append([]byte("machine-0:"), nonce...)))
+
+			content, err := fakeFS.ReadFile(nodeagentv1alpha1.AttestedClientCertificateFilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(And(HavePrefix(string(certData)), ContainSubstring("PRIVATE KEY")))
+		})
+
+		It("should fail if the attestation document cannot be created", func() {
+			attestor.err = errors.New("fake")
+
+			Expect(RequestAttestedClientCertificate(ctx, log, fakeFS, fakeClientset, attestor, "machine-0")).To(MatchError("failed creating attestation document: fake"))
+			Expect(createdCSR).To(BeNil())
+		})
+
+		It("should fail if the certificate signing request is denied", func() {
+			conditions = []certificatesv1.CertificateSigningRequestCondition{{Type: certificatesv1.CertificateDenied, Status: corev1.ConditionTrue, Reason: "RequestDenied", Message: "invalid attestation"}}
+
+			Expect(RequestAttestedClientCertificate(ctx, log, fakeFS, fakeClientset, attestor, "machine-0")).To(MatchError(ContainSubstring("is denied, reason: RequestDenied, message: invalid attestation")))
+
+			_, err := fakeFS.Stat(nodeagentv1alpha1.AttestedClientCertificateFilePath)
+			Expect(err).To(MatchError(afero.ErrFileNotFound))
+		})
+
+		It("should time out if the certificate signing request is not approved", func() {
+			conditions = nil
+
+			Expect(RequestAttestedClientCertificate(ctx, log, fakeFS, fakeClientset, attestor, "machine-0")).To(MatchError(ContainSubstring("is not yet approved")))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Credentials contains the credentials for a registry host.
+type Credentials struct {
+	// Username is the username for basic authentication.
+	Username string
+	// Password is the password for basic authentication.
+	Password string
+	// Token is the token for bearer token authentication. It takes precedence over basic authentication.
+	Token string
+	// ClientCertificate is the PEM-encoded client certificate for mutual TLS.
+	ClientCertificate []byte
+	// ClientKey is the PEM-encoded client key for mutual TLS.
+	ClientKey []byte
+}
+
+func (c *Credentials) authorizationHeader() string {
+	switch {
+	case c.Token != "":
+		return "Bearer " + c.Token
+	case c.Username != "" || c.Password != "":
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(c.Username+":"+c.Password))
+	default:
+		return ""
+	}
+}
+
+func (c *Credentials) hasClientCertificate() bool {
+	return len(c.ClientCertificate) > 0 && len(c.ClientKey) > 0
+}
+
+// ReadCredentials reads the credentials of all hosts of the given registry configuration from the referenced secrets in
+// the kube-system namespace. The result is keyed by host URL, hosts without referenced secret are omitted.
+func ReadCredentials(ctx context.Context, reader client.Reader, registryConfig extensionsv1alpha1.RegistryConfig) (map[string]*Credentials, error) {
+	credentials := make(map[string]*Credentials)
+
+	for _, host := range registryConfig.Hosts {
+		if host.CredentialsSecretName == nil {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := reader.Get(ctx, client.ObjectKey{Name: *host.CredentialsSecretName, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+			return nil, fmt.Errorf("failed reading credentials secret %q for host %s of upstream %s: %w", *host.CredentialsSecretName, host.URL, registryConfig.Upstream, err)
+		}
+
+		creds := &Credentials{
+			Username:          string(secret.Data[extensionsv1alpha1.RegistryCredentialsDataKeyUsername]),
+			Password:          string(secret.Data[extensionsv1alpha1.RegistryCredentialsDataKeyPassword]),
+			Token:             string(secret.Data[extensionsv1alpha1.RegistryCredentialsDataKeyToken]),
+			ClientCertificate: secret.Data[extensionsv1alpha1.RegistryCredentialsDataKeyClientCertificate],
+			ClientKey:         secret.Data[extensionsv1alpha1.RegistryCredentialsDataKeyClientKey],
+		}
+
+		if (len(creds.ClientCertificate) > 0) != (len(creds.ClientKey) > 0) {
+			return nil, fmt.Errorf("credentials secret %q for host %s of upstream %s must contain both %q and %q or none of them", *host.CredentialsSecretName, host.URL, registryConfig.Upstream, extensionsv1alpha1.RegistryCredentialsDataKeyClientCertificate, extensionsv1alpha1.RegistryCredentialsDataKeyClientKey)
+		}
+
+		credentials[host.URL] = creds
+	}
+
+	return credentials, nil
+}
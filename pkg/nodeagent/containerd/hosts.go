@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/spf13/afero"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// CertsDir is the directory containing the registry host configurations for containerd.
+	CertsDir = "/etc/containerd/certs.d"
+
+	hostsConfigFileName   = "hosts.toml"
+	clientCertificateName = "client.crt"
+	clientKeyName         = "client.key"
+
+	defaultDirPermissions os.FileMode = 0755
+)
+
+var (
+	//go:embed templates/hosts.toml.tpl
+	tplContentHosts string
+	tplHosts        *template.Template
+)
+
+func init() {
+	tplHosts = template.Must(template.
+		New("hosts.toml").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(tplContentHosts))
+}
+
+// RegistryDir returns the directory containing the configuration for the given upstream registry.
+func RegistryDir(upstream string) string {
+	return path.Join(CertsDir, upstream)
+}
+
+// HostsConfigFilePath returns the path of the hosts.toml file for the given upstream registry.
+func HostsConfigFilePath(upstream string) string {
+	return path.Join(RegistryDir(upstream), hostsConfigFileName)
+}
+
+// hostDir returns the directory containing the files (e.g., client certificates) for the given registry host.
+func hostDir(upstream, hostURL string) string {
+	name := hostURL
+	if u, err := url.Parse(hostURL); err == nil && u.Host != "" {
+		name = u.Host + u.Path
+	}
+
+	return path.Join(RegistryDir(upstream), "hosts", strings.NewReplacer(":", "_", "/", "_").Replace(strings.TrimSuffix(name, "/")))
+}
+
+// WriteHostsConfig renders the hosts.toml file for the given registry configuration and writes it to the disk. Hosts
+// are rendered in the order of the given configuration, i.e., callers can reorder or omit hosts. Credentials are
+// looked up by host URL and their client certificates are written next to the hosts.toml file. Files are only written
+// if their content changed. The returned bool states whether the hosts.toml file was (re-)written.
+func WriteHostsConfig(fs afero.Afero, registryConfig extensionsv1alpha1.RegistryConfig, credentials map[string]*Credentials) (bool, error) {
+	if err := fs.MkdirAll(RegistryDir(registryConfig.Upstream), defaultDirPermissions); err != nil {
+		return false, fmt.Errorf("unable to ensure registry config base directory: %w", err)
+	}
+
+	var (
+		permissions os.FileMode = 0644
+		values                  = map[string]any{
+			"server":      ptr.Deref(registryConfig.Server, ""),
+			"hostConfigs": make([]any, 0, len(registryConfig.Hosts)),
+		}
+	)
+
+	for _, host := range registryConfig.Hosts {
+		hostConfig := map[string]any{
+			"hostURL": host.URL,
+			"capabilities": []extensionsv1alpha1.RegistryCapability{
+				extensionsv1alpha1.PullCapability,
+				extensionsv1alpha1.ResolveCapability,
+			},
+		}
+
+		if len(host.Capabilities) > 0 {
+			hostConfig["capabilities"] = host.Capabilities
+		}
+		if len(host.CACerts) > 0 {
+			hostConfig["ca"] = host.CACerts
+		}
+
+		if creds := credentials[host.URL]; creds != nil {
+			if authorization := creds.authorizationHeader(); authorization != "" {
+				hostConfig["authorization"] = authorization
+				// The hosts.toml file contains credentials, hence it must only be readable by root.
+				permissions = 0600
+			}
+
+			if creds.hasClientCertificate() {
+				certPath, keyPath, err := writeClientCertificate(fs, registryConfig.Upstream, host.URL, creds)
+				if err != nil {
+					return false, fmt.Errorf("failed writing client certificate for host %s: %w", host.URL, err)
+				}
+				hostConfig["client"] = [][]string{{certPath, keyPath}}
+			}
+		}
+
+		values["hostConfigs"] = append(values["hostConfigs"].([]any), hostConfig)
+	}
+
+	var content bytes.Buffer
+	if err := tplHosts.Execute(&content, values); err != nil {
+		return false, fmt.Errorf("failed rendering hosts.toml: %w", err)
+	}
+
+	return writeFileIfChanged(fs, HostsConfigFilePath(registryConfig.Upstream), content.Bytes(), permissions)
+}
+
+func writeClientCertificate(fs afero.Afero, upstream, hostURL string, creds *Credentials) (string, string, error) {
+	dir := hostDir(upstream, hostURL)
+	if err := fs.MkdirAll(dir, defaultDirPermissions); err != nil {
+		return "", "", fmt.Errorf("unable to ensure registry host directory: %w", err)
+	}
+
+	certPath, keyPath := path.Join(dir, clientCertificateName), path.Join(dir, clientKeyName)
+	if _, err := writeFileIfChanged(fs, certPath, creds.ClientCertificate, 0600); err != nil {
+		return "", "", err
+	}
+	if _, err := writeFileIfChanged(fs, keyPath, creds.ClientKey, 0600); err != nil {
+		return "", "", err
+	}
+
+	return certPath, keyPath, nil
+}
+
+func writeFileIfChanged(fs afero.Afero, filePath string, content []byte, permissions os.FileMode) (bool, error) {
+	currentContent, err := fs.ReadFile(filePath)
+	if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return false, fmt.Errorf("unable to read file %s: %w", filePath, err)
+	}
+
+	if err == nil && bytes.Equal(currentContent, content) {
+		return false, nil
+	}
+
+	f, err := fs.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, permissions)
+	if err != nil {
+		return false, fmt.Errorf("unable to open file %s: %w", filePath, err)
+	}
+
+	if _, err := f.Write(content); err != nil {
+		return false, errors.Join(fmt.Errorf("unable to write file %s: %w", filePath, err), f.Close())
+	}
+
+	if err := f.Close(); err != nil {
+		return false, fmt.Errorf("unable to close file %s: %w", filePath, err)
+	}
+
+	return true, fs.Chmod(filePath, permissions)
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/nodeagent/containerd"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Hosts", func() {
+	var (
+		fakeFS         afero.Afero
+		registryConfig extensionsv1alpha1.RegistryConfig
+	)
+
+	BeforeEach(func() {
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		registryConfig = extensionsv1alpha1.RegistryConfig{
+			Upstream: "registry.k8s.io",
+			Server:   ptr.To("https://registry.k8s.io"),
+			Hosts: []extensionsv1alpha1.RegistryHost{
+				{URL: "https://mirror1.example.com:8443", Capabilities: []extensionsv1alpha1.RegistryCapability{"pull"}, CACerts: []string{"/var/certs/ca.crt"}},
+				{URL: "https://mirror2.example.com/v2/k8s"},
+			},
+		}
+	})
+
+	Describe("#WriteHostsConfig", func() {
+		It("should write the hosts config without credentials", func() {
+			Expect(WriteHostsConfig(fakeFS, registryConfig, nil)).To(BeTrue())
+
+			test.AssertFileOnDisk(fakeFS, "/etc/containerd/certs.d/registry.k8s.io/hosts.toml", `# managed by gardener-node-agent
+server = "https://registry.k8s.io"
+
+[host."https://mirror1.example.com:8443"]
+  capabilities = ["pull"]
+  ca = ["/var/certs/ca.crt"]
+
+[host."https://mirror2.example.com/v2/k8s"]
+  capabilities = ["pull","resolve"]
+
+`, 0644)
+		})
+
+		It("should write the hosts config with credentials and client certificates", func() {
+			credentials := map[string]*Credentials{
+				"https://mirror1.example.com:8443":   {Username: "user", Password: "pass", ClientCertificate: []byte("cert"), ClientKey: []byte("key")},
+				"https://mirror2.example.com/v2/k8s": {Token: "token"},
+			}
+
+			Expect(WriteHostsConfig(fakeFS, registryConfig, credentials)).To(BeTrue())
+
+			test.AssertFileOnDisk(fakeFS, "/etc/containerd/certs.d/registry.k8s.io/hosts.toml", `# managed by gardener-node-agent
+server = "https://registry.k8s.io"
+
+[host."https://mirror1.example.com:8443"]
+  capabilities = ["pull"]
+  ca = ["/var/certs/ca.crt"]
+  client = [["/etc/containerd/certs.d/registry.k8s.io/hosts/mirror1.example.com_8443/client.crt","/etc/containerd/certs.d/registry.k8s.io/hosts/mirror1.example.com_8443/client.key"]]
+  [host."https://mirror1.example.com:8443".header]
+    Authorization = ["Basic dXNlcjpwYXNz"]
+
+[host."https://mirror2.example.com/v2/k8s"]
+  capabilities = ["pull","resolve"]
+  [host."https://mirror2.example.com/v2/k8s".header]
+    Authorization = ["Bearer token"]
+
+`, 0600)
+			test.AssertFileOnDisk(fakeFS, "/etc/containerd/certs.d/registry.k8s.io/hosts/mirror1.example.com_8443/client.crt", "cert", 0600)
+			test.AssertFileOnDisk(fakeFS, "/etc/containerd/certs.d/registry.k8s.io/hosts/mirror1.example.com_8443/client.key", "key", 0600)
+		})
+
+		It("should only write the hosts config if it changed", func() {
+			Expect(WriteHostsConfig(fakeFS, registryConfig, nil)).To(BeTrue())
+			Expect(WriteHostsConfig(fakeFS, registryConfig, nil)).To(BeFalse())
+
+			registryConfig.Hosts = registryConfig.Hosts[1:]
+			Expect(WriteHostsConfig(fakeFS, registryConfig, nil)).To(BeTrue())
+		})
+	})
+
+	Describe("#ReadCredentials", func() {
+		var ctx = context.Background()
+
+		BeforeEach(func() {
+			registryConfig.Hosts[1].CredentialsSecretName = ptr.To("mirror2")
+		})
+
+		It("should read the credentials from the referenced secrets", func() {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "mirror2", Namespace: metav1.NamespaceSystem},
+				Data: map[string][]byte{
+					"username": []byte("user"),
+					"password": []byte("pass"),
+					"tls.crt":  []byte("cert"),
+					"tls.key":  []byte("key"),
+				},
+			}).Build()
+
+			Expect(ReadCredentials(ctx, fakeClient, registryConfig)).To(Equal(map[string]*Credentials{
+				"https://mirror2.example.com/v2/k8s": {Username: "user", Password: "pass", ClientCertificate: []byte("cert"), ClientKey: []byte("key")},
+			}))
+		})
+
+		It("should fail if the referenced secret does not exist", func() {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+			_, err := ReadCredentials(ctx, fakeClient, registryConfig)
+			Expect(err).To(MatchError(ContainSubstring(`failed reading credentials secret "mirror2"`)))
+		})
+
+		It("should fail if the client key is missing", func() {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "mirror2", Namespace: metav1.NamespaceSystem},
+				Data:       map[string][]byte{"tls.crt": []byte("cert")},
+			}).Build()
+
+			_, err := ReadCredentials(ctx, fakeClient, registryConfig)
+			Expect(err).To(MatchError(ContainSubstring(`must contain both "tls.crt" and "tls.key" or none of them`)))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ProbeTimeout is the timeout for probing a registry host. Exposed for testing.
+var ProbeTimeout = 1 * time.Second
+
+// ProbeHost checks whether the given registry host is reachable. It respects the CA certificates of the host and uses
+// the given credentials (if any). The host is considered unhealthy if it cannot be reached or if it responds with a
+// server error.
+func ProbeHost(ctx context.Context, fs afero.Afero, upstream string, host extensionsv1alpha1.RegistryHost, credentials *Credentials) error {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if len(host.CACerts) > 0 {
+		caCertPool := x509.NewCertPool()
+		for _, caCert := range host.CACerts {
+			if !filepath.IsAbs(caCert) {
+				caCert = filepath.Join(RegistryDir(upstream), caCert)
+			}
+			pemContent, err := fs.ReadFile(caCert)
+			if err != nil {
+				return fmt.Errorf("failed to read ca file %s for host %s and upstream %s: %w", caCert, host.URL, upstream, err)
+			}
+			caCertPool.AppendCertsFromPEM(pemContent)
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, host.URL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to construct http request %s for upstream %s: %w", host.URL, upstream, err)
+	}
+
+	if credentials != nil {
+		if authorization := credentials.authorizationHeader(); authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		if credentials.hasClientCertificate() {
+			clientCertificate, err := tls.X509KeyPair(credentials.ClientCertificate, credentials.ClientKey)
+			if err != nil {
+				return fmt.Errorf("failed to parse client certificate for host %s and upstream %s: %w", host.URL, upstream, err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+		}
+	}
+
+	httpClient := http.Client{
+		Timeout:   ProbeTimeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach registry %s for upstream %s: %w", host.URL, upstream, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("registry %s for upstream %s responded with status code %d", host.URL, upstream, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/containerd"
+)
+
+var _ = Describe("Probe", func() {
+	var (
+		ctx    = context.Background()
+		fakeFS afero.Afero
+
+		statusCode    int
+		authorization string
+		server        *httptest.Server
+	)
+
+	BeforeEach(func() {
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		statusCode = http.StatusOK
+		authorization = ""
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			w.WriteHeader(statusCode)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	Describe("#ProbeHost", func() {
+		It("should succeed if the host is reachable", func() {
+			statusCode = http.StatusUnauthorized
+
+			Expect(ProbeHost(ctx, fakeFS, "docker.io", extensionsv1alpha1.RegistryHost{URL: server.URL}, nil)).To(Succeed())
+		})
+
+		It("should send the credentials", func() {
+			Expect(ProbeHost(ctx, fakeFS, "docker.io", extensionsv1alpha1.RegistryHost{URL: server.URL}, &Credentials{Token: "token"})).To(Succeed())
+			Expect(authorization).To(Equal("Bearer token"))
+		})
+
+		It("should fail if the host responds with a server error", func() {
+			statusCode = http.StatusServiceUnavailable
+
+			Expect(ProbeHost(ctx, fakeFS, "docker.io", extensionsv1alpha1.RegistryHost{URL: server.URL}, nil)).To(MatchError(ContainSubstring("responded with status code 503")))
+		})
+
+		It("should fail if the host is not reachable", func() {
+			server.Close()
+
+			Expect(ProbeHost(ctx, fakeFS, "docker.io", extensionsv1alpha1.RegistryHost{URL: server.URL}, nil)).To(MatchError(ContainSubstring("failed to reach registry")))
+		})
+
+		It("should fail if a CA certificate cannot be read", func() {
+			Expect(ProbeHost(ctx, fakeFS, "docker.io", extensionsv1alpha1.RegistryHost{URL: server.URL, CACerts: []string{"ca.crt"}}, nil)).To(MatchError(ContainSubstring("failed to read ca file /etc/containerd/certs.d/docker.io/ca.crt")))
+		})
+	})
+})
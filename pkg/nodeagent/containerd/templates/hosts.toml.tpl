@@ -8,4 +8,11 @@ server = {{ .server | quote }}
   {{- if .ca }}
   ca = {{ .ca | toJson }}
   {{- end }}
+  {{- if .client }}
+  client = {{ .client | toJson }}
+  {{- end }}
+  {{- if .authorization }}
+  [host.{{ .hostURL | quote }}.header]
+    Authorization = [{{ .authorization | quote }}]
+  {{- end }}
 {{ end }}
@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/hostnamecheck"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/imageprepull"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
@@ -51,10 +52,16 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding lease controller: %w", err)
 	}
 
-	if err := (&healthcheck.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	if err := (&healthcheck.Reconciler{
+		Config: cfg.Controllers.HealthCheck,
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
+	if err := (&imageprepull.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+		return fmt.Errorf("failed adding image-pre-pull controller: %w", err)
+	}
+
 	if err := (&hostnamecheck.Reconciler{
 		HostName:      hostName,
 		CancelContext: cancel,
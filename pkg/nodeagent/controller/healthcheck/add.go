@@ -7,13 +7,17 @@ package healthcheck
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/defaults"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -35,6 +39,14 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 		r.Client = mgr.GetClient()
 	}
 
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
+
+	if r.FS.Fs == nil {
+		r.FS = afero.Afero{Fs: afero.NewOsFs()}
+	}
+
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName)
 	}
@@ -44,7 +56,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 	}
 
 	if len(r.HealthCheckers) == 0 {
-		if err := r.setDefaultHealthChecks(); err != nil {
+		if err := r.setDefaultHealthChecks(mgr.GetConfig()); err != nil {
 			return err
 		}
 	}
@@ -61,7 +73,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 		Complete(r)
 }
 
-func (r *Reconciler) setDefaultHealthChecks() error {
+func (r *Reconciler) setDefaultHealthChecks(restConfig *rest.Config) error {
 	clock := clock.RealClock{}
 
 	address := os.Getenv("CONTAINERD_ADDRESS")
@@ -82,6 +94,25 @@ func (r *Reconciler) setDefaultHealthChecks() error {
 	containerdHealthChecker := NewContainerdHealthChecker(r.Client, client, clock, r.DBus, r.Recorder)
 
 	kubeletHealthChecker := NewKubeletHealthChecker(r.Client, clock, r.DBus, r.Recorder, net.InterfaceAddrs)
-	r.HealthCheckers = []HealthChecker{containerdHealthChecker, kubeletHealthChecker}
+	registryMirrorsHealthChecker := NewRegistryMirrorsHealthChecker(r.Client, r.APIReader, r.FS, clock, r.Recorder)
+	kernelConfigHealthChecker := NewKernelConfigHealthChecker(r.Client, r.FS, clock, r.Recorder)
+
+	r.HealthCheckers = []HealthChecker{containerdHealthChecker, kubeletHealthChecker, registryMirrorsHealthChecker, kernelConfigHealthChecker}
+
+	if r.Config == nil || r.Config.Remediation == nil {
+		return nil
+	}
+
+	clockSkewProbe, err := NewClockSkewProbe(clock, restConfig)
+	if err != nil {
+		return fmt.Errorf("error creating clock skew probe: %w", err)
+	}
+	probes := []Probe{NewDiskPressureProbe(), NewStuckMountProbe(r.FS, clock), NewTimeSyncProbe(), clockSkewProbe}
+	if apiServerURL, err := url.Parse(restConfig.Host); err == nil && net.ParseIP(apiServerURL.Hostname()) == nil {
+		probes = append(probes, NewDNSProbe(apiServerURL.Hostname()))
+	}
+	remediationHealthChecker := NewRemediationHealthChecker(r.Client, r.APIReader, r.FS, clock, r.DBus, r.Recorder, ptr.Deref(r.Config.Remediation.MaxDisruptiveRemediations, 1), probes...)
+
+	r.HealthCheckers = append(r.HealthCheckers, remediationHealthChecker)
 	return nil
 }
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/kernel"
+)
+
+// KernelConfigHealthChecker continuously verifies the kernel configuration (sysctls, kernel modules, huge pages) of the
+// last applied operating system config. Values which were changed by other tools are reported via events and enforced
+// again. The result is reported via a condition on the Node.
+type KernelConfigHealthChecker struct {
+	client   client.Client
+	fs       afero.Afero
+	clock    clock.Clock
+	recorder record.EventRecorder
+
+	// Manager is the manager for applying the kernel configuration. Exposed for testing.
+	Manager *kernel.Manager
+}
+
+// NewKernelConfigHealthChecker creates a new instance of a kernel config health check.
+func NewKernelConfigHealthChecker(client client.Client, fs afero.Afero, clock clock.Clock, recorder record.EventRecorder) *KernelConfigHealthChecker {
+	return &KernelConfigHealthChecker{
+		client:   client,
+		fs:       fs,
+		clock:    clock,
+		recorder: recorder,
+		Manager:  kernel.NewManager(fs),
+	}
+}
+
+// Name returns the name of this health check.
+func (*KernelConfigHealthChecker) Name() string {
+	return "kernel-config"
+}
+
+// Check performs the actual health check for the kernel configuration.
+func (k *KernelConfigHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(k.Name())
+
+	osc, err := nodeagent.ReadLastAppliedOperatingSystemConfig(k.fs)
+	if err != nil {
+		return err
+	}
+
+	var kernelConfig *extensionsv1alpha1.KernelConfig
+	if osc != nil {
+		kernelConfig = osc.Spec.KernelConfig
+	}
+
+	if kernelConfig == nil {
+		hasState, err := k.Manager.HasState()
+		if err != nil {
+			return fmt.Errorf("failed checking for kernel config state: %w", err)
+		}
+		if !hasState {
+			return k.updateNodeCondition(ctx, node, nil)
+		}
+	}
+
+	result, err := k.Manager.Apply(ctx, kernelConfig)
+	if err != nil {
+		return fmt.Errorf("failed applying kernel config: %w", err)
+	}
+
+	for _, conflict := range result.Conflicts {
+		log.Info("Kernel config was changed by another tool, enforcing desired value", "conflict", conflict)
+		k.recorder.Event(node, corev1.EventTypeWarning, "KernelConfigConflict", conflict)
+	}
+	if len(result.RolledBack) > 0 {
+		log.Info("Restored original values of no longer configured kernel settings", "settings", result.RolledBack)
+	}
+
+	if kernelConfig == nil {
+		return k.updateNodeCondition(ctx, node, nil)
+	}
+
+	condition := &corev1.NodeCondition{
+		Status:  corev1.ConditionTrue,
+		Reason:  "KernelConfigApplied",
+		Message: "The kernel configuration is applied.",
+	}
+	if len(result.Failures) > 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = "KernelConfigFailed"
+		condition.Message = "The kernel configuration could not be applied: " + strings.Join(result.Failures, "; ")
+	}
+
+	return k.updateNodeCondition(ctx, node, condition)
+}
+
+func (k *KernelConfigHealthChecker) updateNodeCondition(ctx context.Context, node *corev1.Node, condition *corev1.NodeCondition) error {
+	conditionType := corev1.NodeConditionType(nodeagentv1alpha1.NodeConditionKernelConfigApplied)
+
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+	idx := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == conditionType })
+
+	if condition == nil {
+		if idx < 0 {
+			return nil
+		}
+		node.Status.Conditions = slices.Delete(node.Status.Conditions, idx, idx+1)
+		return k.client.Status().Patch(ctx, node, patch)
+	}
+
+	now := metav1.NewTime(k.clock.Now())
+	condition.Type = conditionType
+	condition.LastHeartbeatTime = now
+	condition.LastTransitionTime = now
+
+	if idx >= 0 {
+		existing := node.Status.Conditions[idx]
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return nil
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		node.Status.Conditions[idx] = *condition
+	} else {
+		node.Status.Conditions = append(node.Status.Conditions, *condition)
+	}
+
+	return k.client.Status().Patch(ctx, node, patch)
+}
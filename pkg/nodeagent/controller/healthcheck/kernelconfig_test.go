@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("KernelConfig", func() {
+	const somaxconnPath = "/proc/sys/net/core/somaxconn"
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeFS     afero.Afero
+		fakeClock  *testing.FakeClock
+		recorder   *record.FakeRecorder
+
+		node *corev1.Node
+		osc  *extensionsv1alpha1.OperatingSystemConfig
+
+		checker *KernelConfigHealthChecker
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(node).WithStatusSubresource(node).Build()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testing.NewFakeClock(time.Now())
+		recorder = record.NewFakeRecorder(10)
+
+		Expect(fakeFS.WriteFile(somaxconnPath, []byte("4096\n"), 0644)).To(Succeed())
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				KernelConfig: &extensionsv1alpha1.KernelConfig{
+					Sysctls: map[string]string{"net.core.somaxconn": "65535"},
+				},
+			},
+		}
+
+		checker = NewKernelConfigHealthChecker(fakeClient, fakeFS, fakeClock, recorder)
+	})
+
+	writeOSC := func() {
+		oscRaw, err := yaml.Marshal(osc)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, fakeFS.WriteFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, oscRaw, 0644)).To(Succeed())
+	}
+
+	check := func() {
+		ExpectWithOffset(1, checker.Check(ctx, node)).To(Succeed())
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+	}
+
+	condition := func() *corev1.NodeCondition {
+		for _, c := range node.Status.Conditions {
+			if c.Type == nodeagentv1alpha1.NodeConditionKernelConfigApplied {
+				return &c
+			}
+		}
+		return nil
+	}
+
+	It("should not report a condition if no kernel config is configured", func() {
+		check()
+		Expect(condition()).To(BeNil())
+		test.AssertFileOnDisk(fakeFS, somaxconnPath, "4096\n", 0644)
+	})
+
+	It("should apply the kernel config and report it", func() {
+		writeOSC()
+
+		check()
+		test.AssertFileOnDisk(fakeFS, somaxconnPath, "65535", 0644)
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(corev1.ConditionTrue),
+			"Reason": Equal("KernelConfigApplied"),
+		})))
+	})
+
+	It("should enforce values changed by other tools and emit an event", func() {
+		writeOSC()
+		check()
+
+		Expect(fakeFS.WriteFile(somaxconnPath, []byte("1024\n"), 0644)).To(Succeed())
+
+		check()
+		test.AssertFileOnDisk(fakeFS, somaxconnPath, "65535", 0644)
+		Expect(recorder.Events).To(Receive(ContainSubstring("KernelConfigConflict")))
+		Expect(condition().Status).To(Equal(corev1.ConditionTrue))
+	})
+
+	It("should report settings which cannot be applied", func() {
+		osc.Spec.KernelConfig.Sysctls["net.foo.bar"] = "1"
+		writeOSC()
+
+		check()
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionFalse),
+			"Reason":  Equal("KernelConfigFailed"),
+			"Message": ContainSubstring("sysctl net.foo.bar: not supported by the kernel"),
+		})))
+	})
+
+	It("should roll back the kernel config and remove the condition if it is no longer configured", func() {
+		writeOSC()
+		check()
+		Expect(condition()).NotTo(BeNil())
+
+		osc.Spec.KernelConfig = nil
+		writeOSC()
+
+		check()
+		test.AssertFileOnDisk(fakeFS, somaxconnPath, "4096", 0644)
+		Expect(condition()).To(BeNil())
+	})
+})
@@ -8,19 +8,24 @@ import (
 	"context"
 	"time"
 
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks for containerd and kubelet health and restarts them if required.
+// Reconciler checks for containerd, kubelet, and registry mirror health and remediates issues if possible.
 type Reconciler struct {
+	Config                     *config.HealthCheckControllerConfig
 	Client                     client.Client
+	APIReader                  client.Reader
+	FS                         afero.Afero
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
 	HealthCheckers             []HealthChecker
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	containerdutils "github.com/gardener/gardener/pkg/nodeagent/containerd"
+)
+
+// registryMirrorFailureThreshold is the number of consecutive failed probes after which a registry mirror is
+// considered unhealthy.
+const registryMirrorFailureThreshold = 3
+
+// RegistryMirrorsHealthChecker continuously probes the registry mirrors (hosts) configured for containerd. The mirrors
+// are reordered by health in the hosts.toml file, i.e., unhealthy mirrors are moved behind the healthy ones so that
+// containerd only falls back to them if all healthy mirrors fail. They are moved back to their configured position once
+// they recover. Credentials referenced by the mirrors are re-synced on every check. The result is reported via a
+// condition on the Node.
+type RegistryMirrorsHealthChecker struct {
+	client        client.Client
+	secretsReader *secretsReader
+	fs            afero.Afero
+	clock     clock.Clock
+	recorder  record.EventRecorder
+
+	// ProbeHost is the function for probing a registry host. Exposed for testing.
+	ProbeHost func(context.Context, afero.Afero, string, extensionsv1alpha1.RegistryHost, *containerdutils.Credentials) error
+
+	failures map[string]int
+}
+
+// NewRegistryMirrorsHealthChecker creates a new instance of a registry mirrors health check.
+func NewRegistryMirrorsHealthChecker(client client.Client, apiReader client.Reader, fs afero.Afero, clock clock.Clock, recorder record.EventRecorder) *RegistryMirrorsHealthChecker {
+	return &RegistryMirrorsHealthChecker{
+		client:        client,
+		secretsReader: newSecretsReader(apiReader),
+		fs:            fs,
+		clock:         clock,
+		recorder:      recorder,
+		ProbeHost:     containerdutils.ProbeHost,
+		failures:      make(map[string]int),
+	}
+}
+
+// Name returns the name of this health check.
+func (*RegistryMirrorsHealthChecker) Name() string {
+	return "registry-mirrors"
+}
+
+// Check performs the actual health check for the registry mirrors.
+func (r *RegistryMirrorsHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(r.Name())
+
+	registries, err := r.readRegistries()
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs           []error
+		monitored      = sets.New[string]()
+		unhealthyHosts []string
+	)
+
+	for _, registryConfig := range registries {
+		if len(registryConfig.Hosts) == 0 {
+			continue
+		}
+
+		// Registries which are not configured yet (e.g., because their readiness probe did not succeed so far) are
+		// handled by the operating system config controller.
+		exists, err := r.fs.Exists(containerdutils.HostsConfigFilePath(registryConfig.Upstream))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to check if registry config file exists for upstream %s: %w", registryConfig.Upstream, err))
+			continue
+		}
+		if !exists {
+			continue
+		}
+
+		credentials, err := containerdutils.ReadCredentials(ctx, r.secretsReader, registryConfig)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		var healthy, unhealthy []extensionsv1alpha1.RegistryHost
+		for _, host := range registryConfig.Hosts {
+			key := registryConfig.Upstream + " " + host.URL
+			monitored.Insert(key)
+
+			if err := r.ProbeHost(ctx, r.fs, registryConfig.Upstream, host, credentials[host.URL]); err != nil {
+				r.failures[key]++
+				log.V(1).Info("Probing registry mirror failed", "upstream", registryConfig.Upstream, "host", host.URL, "failures", r.failures[key], "error", err.Error())
+
+				if r.failures[key] == registryMirrorFailureThreshold {
+					log.Error(err, "Registry mirror is unhealthy", "upstream", registryConfig.Upstream, "host", host.URL)
+					r.recorder.Eventf(node, corev1.EventTypeWarning, "RegistryMirrorUnhealthy", "Registry mirror %s for upstream %s is unhealthy: %s", host.URL, registryConfig.Upstream, err.Error())
+				}
+			} else {
+				if r.failures[key] >= registryMirrorFailureThreshold {
+					log.Info("Registry mirror is healthy again", "upstream", registryConfig.Upstream, "host", host.URL)
+					r.recorder.Eventf(node, corev1.EventTypeNormal, "RegistryMirrorHealthy", "Registry mirror %s for upstream %s is healthy again", host.URL, registryConfig.Upstream)
+				}
+				delete(r.failures, key)
+			}
+
+			if r.failures[key] >= registryMirrorFailureThreshold {
+				unhealthyHosts = append(unhealthyHosts, fmt.Sprintf("%s (upstream %s)", host.URL, registryConfig.Upstream))
+				unhealthy = append(unhealthy, host)
+			} else {
+				healthy = append(healthy, host)
+			}
+		}
+
+		// Unhealthy mirrors are kept as a last resort since they might still serve some images, or they might recover
+		// before the next check.
+		registryConfig.Hosts = append(healthy, unhealthy...)
+
+		changed, err := containerdutils.WriteHostsConfig(r.fs, registryConfig, credentials)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed writing registry config for upstream %s: %w", registryConfig.Upstream, err))
+			continue
+		}
+		if changed {
+			log.Info("Updated registry config", "upstream", registryConfig.Upstream, "healthyHosts", len(healthy), "unhealthyHosts", len(unhealthy))
+		}
+	}
+
+	// Forget about the failures of hosts which are no longer configured.
+	for key := range r.failures {
+		if !monitored.Has(key) {
+			delete(r.failures, key)
+		}
+	}
+
+	if err := r.updateNodeCondition(ctx, node, monitored.Len() > 0, unhealthyHosts); err != nil {
+		errs = append(errs, fmt.Errorf("failed updating node condition: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *RegistryMirrorsHealthChecker) readRegistries() ([]extensionsv1alpha1.RegistryConfig, error) {
+	osc, err := nodeagent.ReadLastAppliedOperatingSystemConfig(r.fs)
+	if err != nil {
+		return nil, err
+	}
+
+	if osc == nil || osc.Spec.CRIConfig == nil || osc.Spec.CRIConfig.Containerd == nil {
+		return nil, nil
+	}
+
+	return osc.Spec.CRIConfig.Containerd.Registries, nil
+}
+
+func (r *RegistryMirrorsHealthChecker) updateNodeCondition(ctx context.Context, node *corev1.Node, monitored bool, unhealthyHosts []string) error {
+	conditionType := corev1.NodeConditionType(nodeagentv1alpha1.NodeConditionRegistryMirrorsHealthy)
+
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+	idx := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == conditionType })
+
+	if !monitored {
+		if idx < 0 {
+			return nil
+		}
+		node.Status.Conditions = slices.Delete(node.Status.Conditions, idx, idx+1)
+		return r.client.Status().Patch(ctx, node, patch)
+	}
+
+	condition := corev1.NodeCondition{
+		Type:    conditionType,
+		Status:  corev1.ConditionTrue,
+		Reason:  "RegistryMirrorsHealthy",
+		Message: "All registry mirrors are healthy.",
+	}
+	if len(unhealthyHosts) > 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = "RegistryMirrorsUnhealthy"
+		condition.Message = "Registry mirrors are unhealthy: " + strings.Join(unhealthyHosts, ", ")
+	}
+
+	now := metav1.NewTime(r.clock.Now())
+	condition.LastHeartbeatTime = now
+	condition.LastTransitionTime = now
+
+	if idx >= 0 {
+		existing := node.Status.Conditions[idx]
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return nil
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		node.Status.Conditions[idx] = condition
+	} else {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	}
+
+	return r.client.Status().Patch(ctx, node, patch)
+}
+
+// secretsReader reads secrets via the given reader but only fetches their data if their resourceVersion changed since
+// the last read. This keeps the requests sent by every node on every check small since the node agent is not allowed to
+// watch the credentials secrets.
+type secretsReader struct {
+	reader  client.Reader
+	secrets map[client.ObjectKey]*corev1.Secret
+}
+
+func newSecretsReader(reader client.Reader) *secretsReader {
+	return &secretsReader{reader: reader, secrets: make(map[client.ObjectKey]*corev1.Secret)}
+}
+
+func (s *secretsReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return s.reader.Get(ctx, key, obj, opts...)
+	}
+
+	metadata := &metav1.PartialObjectMetadata{}
+	metadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	if err := s.reader.Get(ctx, key, metadata, opts...); err != nil {
+		delete(s.secrets, key)
+		return err
+	}
+
+	if cached, ok := s.secrets[key]; !ok || cached.ResourceVersion != metadata.ResourceVersion {
+		fetched := &corev1.Secret{}
+		if err := s.reader.Get(ctx, key, fetched, opts...); err != nil {
+			return err
+		}
+		s.secrets[key] = fetched
+	}
+
+	s.secrets[key].DeepCopyInto(secret)
+	return nil
+}
+
+func (s *secretsReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return s.reader.List(ctx, list, opts...)
+}
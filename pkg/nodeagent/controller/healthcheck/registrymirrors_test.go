@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	containerdutils "github.com/gardener/gardener/pkg/nodeagent/containerd"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("RegistryMirrors", func() {
+	const upstream = "docker.io"
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeFS     afero.Afero
+		fakeClock  *testing.FakeClock
+		recorder   *record.FakeRecorder
+
+		node          *corev1.Node
+		hostsFilePath string
+		unhealthy     map[string]bool
+
+		checker *RegistryMirrorsHealthChecker
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(node).WithStatusSubresource(node).Build()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testing.NewFakeClock(time.Now())
+		recorder = record.NewFakeRecorder(10)
+		hostsFilePath = containerdutils.HostsConfigFilePath(upstream)
+		unhealthy = map[string]bool{}
+
+		osc := &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				CRIConfig: &extensionsv1alpha1.CRIConfig{
+					Name: extensionsv1alpha1.CRINameContainerD,
+					Containerd: &extensionsv1alpha1.ContainerdConfig{
+						Registries: []extensionsv1alpha1.RegistryConfig{{
+							Upstream: upstream,
+							Server:   ptr.To("https://registry-1.docker.io"),
+							Hosts: []extensionsv1alpha1.RegistryHost{
+								{URL: "https://mirror1.example.com"},
+								{URL: "https://mirror2.example.com", CredentialsSecretName: ptr.To("mirror2-credentials")},
+							},
+						}},
+					},
+				},
+			},
+		}
+		oscRaw, err := yaml.Marshal(osc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeFS.WriteFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, oscRaw, 0644)).To(Succeed())
+		Expect(fakeFS.WriteFile(hostsFilePath, []byte("initial"), 0644)).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mirror2-credentials", Namespace: metav1.NamespaceSystem},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		})).To(Succeed())
+
+		checker = NewRegistryMirrorsHealthChecker(fakeClient, fakeClient, fakeFS, fakeClock, recorder)
+		checker.ProbeHost = func(_ context.Context, _ afero.Afero, _ string, host extensionsv1alpha1.RegistryHost, _ *containerdutils.Credentials) error {
+			if unhealthy[host.URL] {
+				return fmt.Errorf("connection refused")
+			}
+			return nil
+		}
+	})
+
+	check := func(times int) {
+		for range times {
+			ExpectWithOffset(1, checker.Check(ctx, node)).To(Succeed())
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		}
+	}
+
+	condition := func() *corev1.NodeCondition {
+		for _, c := range node.Status.Conditions {
+			if c.Type == nodeagentv1alpha1.NodeConditionRegistryMirrorsHealthy {
+				return &c
+			}
+		}
+		return nil
+	}
+
+	It("should write the hosts config with credentials and report healthy mirrors", func() {
+		check(1)
+
+		test.AssertFileOnDisk(fakeFS, hostsFilePath, `# managed by gardener-node-agent
+server = "https://registry-1.docker.io"
+
+[host."https://mirror1.example.com"]
+  capabilities = ["pull","resolve"]
+
+[host."https://mirror2.example.com"]
+  capabilities = ["pull","resolve"]
+  [host."https://mirror2.example.com".header]
+    Authorization = ["Bearer secret-token"]
+
+`, 0600)
+
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(corev1.ConditionTrue),
+			"Reason": Equal("RegistryMirrorsHealthy"),
+		})))
+	})
+
+	hostsOrder := func() []string {
+		content := string(must(fakeFS.ReadFile(hostsFilePath)))
+		mirror1, mirror2 := strings.Index(content, `[host."https://mirror1.example.com"]`), strings.Index(content, `[host."https://mirror2.example.com"]`)
+		ExpectWithOffset(1, mirror1).To(BeNumerically(">=", 0))
+		ExpectWithOffset(1, mirror2).To(BeNumerically(">=", 0))
+		if mirror1 < mirror2 {
+			return []string{"mirror1", "mirror2"}
+		}
+		return []string{"mirror2", "mirror1"}
+	}
+
+	It("should move unhealthy mirrors to the end after the failure threshold and move them back once they recover", func() {
+		unhealthy["https://mirror1.example.com"] = true
+
+		check(2)
+		Expect(hostsOrder()).To(Equal([]string{"mirror1", "mirror2"}))
+		Expect(condition().Status).To(Equal(corev1.ConditionTrue))
+
+		check(1)
+		Expect(hostsOrder()).To(Equal([]string{"mirror2", "mirror1"}))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionFalse),
+			"Reason":  Equal("RegistryMirrorsUnhealthy"),
+			"Message": ContainSubstring("https://mirror1.example.com (upstream docker.io)"),
+		})))
+		Expect(recorder.Events).To(Receive(ContainSubstring("RegistryMirrorUnhealthy")))
+
+		delete(unhealthy, "https://mirror1.example.com")
+		check(1)
+		Expect(hostsOrder()).To(Equal([]string{"mirror1", "mirror2"}))
+		Expect(condition().Status).To(Equal(corev1.ConditionTrue))
+		Expect(recorder.Events).To(Receive(ContainSubstring("RegistryMirrorHealthy")))
+	})
+
+	It("should keep the configured order if none of the mirrors is healthy", func() {
+		unhealthy["https://mirror1.example.com"] = true
+		unhealthy["https://mirror2.example.com"] = true
+
+		check(3)
+		Expect(hostsOrder()).To(Equal([]string{"mirror1", "mirror2"}))
+		Expect(condition().Status).To(Equal(corev1.ConditionFalse))
+	})
+
+	It("should only fetch the credentials secret again if it has changed", func() {
+		var secretGets, metadataGets int
+		apiReader := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mirror2-credentials", Namespace: metav1.NamespaceSystem},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		}).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				switch obj.(type) {
+				case *corev1.Secret:
+					secretGets++
+				case *metav1.PartialObjectMetadata:
+					metadataGets++
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).Build()
+
+		checker = NewRegistryMirrorsHealthChecker(fakeClient, apiReader, fakeFS, fakeClock, recorder)
+		checker.ProbeHost = func(context.Context, afero.Afero, string, extensionsv1alpha1.RegistryHost, *containerdutils.Credentials) error {
+			return nil
+		}
+
+		check(3)
+		Expect(secretGets).To(Equal(1))
+		Expect(metadataGets).To(Equal(3))
+
+		secret := &corev1.Secret{}
+		Expect(apiReader.Get(ctx, client.ObjectKey{Name: "mirror2-credentials", Namespace: metav1.NamespaceSystem}, secret)).To(Succeed())
+		secret.Data["token"] = []byte("new-token")
+		Expect(apiReader.Update(ctx, secret)).To(Succeed())
+		secretGets = 0
+
+		check(1)
+		Expect(secretGets).To(Equal(1))
+		Expect(string(must(fakeFS.ReadFile(hostsFilePath)))).To(ContainSubstring("Bearer new-token"))
+	})
+
+	It("should not touch registries which are not configured yet", func() {
+		Expect(fakeFS.Remove(hostsFilePath)).To(Succeed())
+
+		check(1)
+		test.AssertNoFileOnDisk(fakeFS, hostsFilePath)
+		Expect(condition()).To(BeNil())
+	})
+
+	It("should remove the condition if no mirrors are configured anymore", func() {
+		check(1)
+		Expect(condition()).NotTo(BeNil())
+
+		Expect(fakeFS.Remove(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath)).To(Succeed())
+		check(1)
+		Expect(condition()).To(BeNil())
+	})
+
+	It("should return an error if the credentials secret does not exist", func() {
+		Expect(fakeClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "mirror2-credentials", Namespace: metav1.NamespaceSystem}})).To(Succeed())
+
+		Expect(checker.Check(ctx, node)).To(MatchError(ContainSubstring(`failed reading credentials secret "mirror2-credentials"`)))
+	})
+})
+
+func must[T any](v T, err error) T {
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return v
+}
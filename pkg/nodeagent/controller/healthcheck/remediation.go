@@ -0,0 +1,402 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	// RemediationStateFilePath is the file path of the state of the node self-remediation. It keeps track of the
+	// reboots triggered by gardener-node-agent so that the rate limits also apply across reboots.
+	RemediationStateFilePath = nodeagentv1alpha1.BaseDir + "/remediation-state.yaml"
+	// AnnotationTriggerDeletionByMCM is the annotation on a Node which makes machine-controller-manager replace the
+	// machine of the node.
+	AnnotationTriggerDeletionByMCM = "node.machine.sapcloud.io/trigger-deletion-by-mcm"
+
+	// remediationEscalationInterval is the duration after which the next remediation action is executed if the
+	// problem still persists.
+	remediationEscalationInterval = 5 * time.Minute
+	// maxRebootsPerWindow is the maximum number of reboots gardener-node-agent triggers within rebootWindow.
+	maxRebootsPerWindow = 3
+	// rebootWindow is the floating time window in which reboots are counted for the rate limit.
+	rebootWindow = 24 * time.Hour
+	// minRebootInterval is the minimum duration between two reboots triggered by gardener-node-agent.
+	minRebootInterval = time.Hour
+	// remediationSlotDuration is the duration for which a disruptive remediation action occupies a slot in the
+	// remediation Lease of the worker pool.
+	remediationSlotDuration = 30 * time.Minute
+	// remediationLeaseNamePrefix is the prefix of the name of the Lease which limits the number of concurrent
+	// disruptive remediation actions in a worker pool.
+	remediationLeaseNamePrefix = "gardener-node-agent-remediation-"
+)
+
+// RemediationActionType is a type for remediation actions.
+type RemediationActionType string
+
+const (
+	// RemediationActionRestartUnit restarts a systemd unit.
+	RemediationActionRestartUnit RemediationActionType = "RestartUnit"
+	// RemediationActionCleanImageCache removes all container images which are not used by any container.
+	RemediationActionCleanImageCache RemediationActionType = "CleanImageCache"
+	// RemediationActionReboot reboots the node.
+	RemediationActionReboot RemediationActionType = "Reboot"
+	// RemediationActionReplaceNode marks the node for replacement by machine-controller-manager.
+	RemediationActionReplaceNode RemediationActionType = "ReplaceNode"
+)
+
+// RemediationAction is an action which is executed to remediate a problem detected by a Probe.
+type RemediationAction struct {
+	// Type is the type of the action.
+	Type RemediationActionType
+	// UnitName is the name of the systemd unit which is restarted. Only relevant for RemediationActionRestartUnit.
+	UnitName string
+}
+
+func (a RemediationAction) String() string {
+	if a.Type == RemediationActionRestartUnit {
+		return fmt.Sprintf("%s(%s)", a.Type, a.UnitName)
+	}
+	return string(a.Type)
+}
+
+// Probe detects a problem on the node which can be remediated without human intervention.
+type Probe interface {
+	// Name returns the name of the probe.
+	Name() string
+	// Probe returns an error describing the problem if the node is unhealthy.
+	Probe(ctx context.Context) error
+	// Actions returns the remediation actions for the problem, ordered from the least to the most disruptive one.
+	Actions() []RemediationAction
+}
+
+type probeState struct {
+	firstFailure time.Time
+	lastAction   time.Time
+	nextAction   int
+}
+
+type remediationState struct {
+	Reboots []metav1.Time `json:"reboots,omitempty"`
+}
+
+// RemediationHealthChecker runs probes for node problems and remediates them with escalating actions. An action is
+// executed after the problem persisted for a grace period. If the problem still persists afterwards, the next action
+// is executed after an escalation interval. Reboots are rate limited to avoid reboot loops, and disruptive actions
+// (reboots and node replacements) are limited per worker pool. If an action is not allowed, the escalation stops and
+// the action is retried after the escalation interval.
+type RemediationHealthChecker struct {
+	client                    client.Client
+	apiReader                 client.Reader
+	fs                        afero.Afero
+	clock                     clock.Clock
+	dbus                      dbus.DBus
+	recorder                  record.EventRecorder
+	maxDisruptiveRemediations int
+	probes                    []Probe
+	states                    map[string]*probeState
+
+	// CleanImageCache removes all container images which are not used by any container. Exposed for testing.
+	CleanImageCache func(ctx context.Context) error
+}
+
+// NewRemediationHealthChecker creates a new instance of a remediation health check.
+func NewRemediationHealthChecker(client client.Client, apiReader client.Reader, fs afero.Afero, clock clock.Clock, dbus dbus.DBus, recorder record.EventRecorder, maxDisruptiveRemediations int32, probes ...Probe) *RemediationHealthChecker {
+	return &RemediationHealthChecker{
+		client:                    client,
+		apiReader:                 apiReader,
+		fs:                        fs,
+		clock:                     clock,
+		dbus:                      dbus,
+		recorder:                  recorder,
+		maxDisruptiveRemediations: int(maxDisruptiveRemediations),
+		probes:                    probes,
+		states:                    map[string]*probeState{},
+		CleanImageCache: func(ctx context.Context) error {
+			if out, err := exec.CommandContext(ctx, "crictl", "rmi", "--prune").CombinedOutput(); err != nil {
+				return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+			}
+			return nil
+		},
+	}
+}
+
+// Name returns the name of this health check.
+func (*RemediationHealthChecker) Name() string {
+	return "remediation"
+}
+
+// Check runs all probes and executes the due remediation actions.
+func (r *RemediationHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(r.Name())
+
+	// Nodes which are updated in-place are drained and rebooted on purpose, hence they must not be remediated.
+	if node.Labels[nodeagentv1alpha1.LabelInPlaceUpdate] == nodeagentv1alpha1.InPlaceUpdateInProgress {
+		return nil
+	}
+
+	var errs []error
+	for _, probe := range r.probes {
+		if err := r.check(ctx, log.WithValues("probe", probe.Name()), node, probe); err != nil {
+			errs = append(errs, fmt.Errorf("probe %s: %w", probe.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *RemediationHealthChecker) check(ctx context.Context, log logr.Logger, node *corev1.Node, probe Probe) error {
+	problem := probe.Probe(ctx)
+
+	state, failing := r.states[probe.Name()]
+	if problem == nil {
+		if failing {
+			log.Info("Problem is resolved")
+			r.recorder.Eventf(node, corev1.EventTypeNormal, "RemediationSucceeded", "Probe %s is healthy again", probe.Name())
+			delete(r.states, probe.Name())
+		}
+		return nil
+	}
+
+	now := r.clock.Now()
+	if !failing {
+		log.Info("Problem detected", "problem", problem.Error())
+		r.recorder.Eventf(node, corev1.EventTypeWarning, "ProblemDetected", "Probe %s detected a problem: %s", probe.Name(), problem.Error())
+		r.states[probe.Name()] = &probeState{firstFailure: now}
+		return nil
+	}
+
+	if now.Sub(state.firstFailure) < maxFailureDuration ||
+		(!state.lastAction.IsZero() && now.Sub(state.lastAction) < remediationEscalationInterval) {
+		return nil
+	}
+
+	actions := probe.Actions()
+	if state.nextAction >= len(actions) {
+		log.Info("Problem persists and all remediation actions are exhausted", "problem", problem.Error())
+		return nil
+	}
+	action := actions[state.nextAction]
+
+	// Actions which are not allowed are retried after the escalation interval. The escalation must not continue with
+	// the next (more disruptive) action, otherwise rate limits would escalate remediations instead of limiting them.
+	state.lastAction = now
+	allowed, err := r.allowed(ctx, log, node, action)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		log.Info("Remediation action is rate limited, retrying later", "action", action.String())
+		r.recorder.Eventf(node, corev1.EventTypeWarning, "RemediationRateLimited", "Remediation action %s for probe %s is rate limited, retrying later", action, probe.Name())
+		return nil
+	}
+	state.nextAction++
+
+	log.Info("Executing remediation action", "action", action.String(), "problem", problem.Error())
+	r.recorder.Eventf(node, corev1.EventTypeWarning, "RemediationAction", "Executing remediation action %s for probe %s: %s", action, probe.Name(), problem.Error())
+	if err := r.execute(ctx, node, action); err != nil {
+		r.recorder.Eventf(node, corev1.EventTypeWarning, "RemediationFailed", "Remediation action %s for probe %s failed: %s", action, probe.Name(), err.Error())
+		return fmt.Errorf("failed executing remediation action %s: %w", action, err)
+	}
+	return nil
+}
+
+func (r *RemediationHealthChecker) allowed(ctx context.Context, log logr.Logger, node *corev1.Node, action RemediationAction) (bool, error) {
+	switch action.Type {
+	case RemediationActionReboot:
+		state, err := r.readState()
+		if err != nil {
+			return false, err
+		}
+
+		now := r.clock.Now()
+		reboots := slices.DeleteFunc(state.Reboots, func(t metav1.Time) bool { return now.Sub(t.Time) > rebootWindow })
+		if len(reboots) >= maxRebootsPerWindow {
+			return false, nil
+		}
+		if len(reboots) > 0 && now.Sub(reboots[len(reboots)-1].Time) < minRebootInterval {
+			return false, nil
+		}
+		return r.acquireRemediationSlot(ctx, log, node)
+
+	case RemediationActionReplaceNode:
+		// The replacement was already requested, there is no need to occupy another slot.
+		if node.Annotations[AnnotationTriggerDeletionByMCM] == "true" {
+			return true, nil
+		}
+		return r.acquireRemediationSlot(ctx, log, node)
+	}
+
+	return true, nil
+}
+
+// acquireRemediationSlot registers the node in the remediation Lease of its worker pool. Only as many nodes as allowed
+// by maxDisruptiveRemediations are registered at the same time. Registrations expire after remediationSlotDuration,
+// and nodes which no longer belong to the worker pool are removed from the Lease. Conflicts caused by concurrent updates
+// of the Lease are not considered as errors, the caller just has to retry.
+func (r *RemediationHealthChecker) acquireRemediationSlot(ctx context.Context, log logr.Logger, node *corev1.Node) (bool, error) {
+	poolName := node.Labels[v1beta1constants.LabelWorkerPool]
+
+	nodeList := &corev1.NodeList{}
+	if err := r.apiReader.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: poolName}); err != nil {
+		return false, fmt.Errorf("failed listing nodes of worker pool %q: %w", poolName, err)
+	}
+
+	poolNodeNames := sets.New[string]()
+	for _, n := range nodeList.Items {
+		poolNodeNames.Insert(n.Name)
+	}
+
+	now := r.clock.Now().UTC()
+	lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: remediationLeaseNamePrefix + poolName, Namespace: metav1.NamespaceSystem}}
+	if err := r.apiReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed reading remediation lease: %w", err)
+		}
+
+		metav1.SetMetaDataAnnotation(&lease.ObjectMeta, nodeagentv1alpha1.AnnotationKeyRemediationNodes, formatRemediationNodes(map[string]time.Time{node.Name: now}))
+		if err := r.client.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed creating remediation lease: %w", err)
+		}
+		return true, nil
+	}
+
+	remediatingNodes := map[string]time.Time{}
+	for name, t := range parseRemediationNodes(lease) {
+		if poolNodeNames.Has(name) && name != node.Name && now.Sub(t) < remediationSlotDuration {
+			remediatingNodes[name] = t
+		}
+	}
+
+	if len(remediatingNodes) >= r.maxDisruptiveRemediations {
+		log.Info("Maximum number of disruptive remediations in worker pool is reached, waiting", "maxDisruptiveRemediations", r.maxDisruptiveRemediations, "nodes", sets.List(sets.KeySet(remediatingNodes)))
+		return false, nil
+	}
+
+	remediatingNodes[node.Name] = now
+	metav1.SetMetaDataAnnotation(&lease.ObjectMeta, nodeagentv1alpha1.AnnotationKeyRemediationNodes, formatRemediationNodes(remediatingNodes))
+	if err := r.client.Update(ctx, lease); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed updating remediation lease: %w", err)
+	}
+
+	return true, nil
+}
+
+func parseRemediationNodes(lease *coordinationv1.Lease) map[string]time.Time {
+	nodes := map[string]time.Time{}
+	for _, entry := range strings.Split(lease.Annotations[nodeagentv1alpha1.AnnotationKeyRemediationNodes], ",") {
+		name, timestamp, found := strings.Cut(entry, "=")
+		if !found || name == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			continue
+		}
+		nodes[name] = t
+	}
+	return nodes
+}
+
+func formatRemediationNodes(nodes map[string]time.Time) string {
+	var entries []string
+	for _, name := range sets.List(sets.KeySet(nodes)) {
+		entries = append(entries, name+"="+nodes[name].Format(time.RFC3339))
+	}
+	return strings.Join(entries, ",")
+}
+
+func (r *RemediationHealthChecker) execute(ctx context.Context, node *corev1.Node, action RemediationAction) error {
+	switch action.Type {
+	case RemediationActionRestartUnit:
+		return r.dbus.Restart(ctx, r.recorder, node, action.UnitName)
+
+	case RemediationActionCleanImageCache:
+		return r.CleanImageCache(ctx)
+
+	case RemediationActionReboot:
+		state, err := r.readState()
+		if err != nil {
+			return err
+		}
+
+		now := r.clock.Now()
+		state.Reboots = append(slices.DeleteFunc(state.Reboots, func(t metav1.Time) bool { return now.Sub(t.Time) > rebootWindow }), metav1.NewTime(now))
+		if err := r.writeState(state); err != nil {
+			return err
+		}
+		return r.dbus.Reboot()
+
+	case RemediationActionReplaceNode:
+		if node.Annotations[AnnotationTriggerDeletionByMCM] == "true" {
+			return nil
+		}
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationTriggerDeletionByMCM, "true")
+		return r.client.Patch(ctx, node, patch)
+	}
+
+	return fmt.Errorf("unknown remediation action type %q", action.Type)
+}
+
+func (r *RemediationHealthChecker) readState() (*remediationState, error) {
+	state := &remediationState{}
+
+	content, err := r.fs.ReadFile(RemediationStateFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return state, nil
+		}
+		return nil, fmt.Errorf("failed reading remediation state file %s: %w", RemediationStateFilePath, err)
+	}
+
+	if err := yaml.Unmarshal(content, state); err != nil {
+		return nil, fmt.Errorf("failed decoding remediation state file %s: %w", RemediationStateFilePath, err)
+	}
+
+	return state, nil
+}
+
+func (r *RemediationHealthChecker) writeState(state *remediationState) error {
+	content, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed encoding remediation state: %w", err)
+	}
+
+	if err := r.fs.MkdirAll(nodeagentv1alpha1.BaseDir, 0755); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", nodeagentv1alpha1.BaseDir, err)
+	}
+
+	return r.fs.WriteFile(RemediationStateFilePath, content, 0600)
+}
@@ -0,0 +1,423 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+type fakeProbe struct {
+	name    string
+	problem error
+	actions []RemediationAction
+}
+
+func (f *fakeProbe) Name() string                  { return f.name }
+func (f *fakeProbe) Probe(_ context.Context) error { return f.problem }
+func (f *fakeProbe) Actions() []RemediationAction  { return f.actions }
+
+var _ = Describe("Remediation", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeFS     afero.Afero
+		fakeClock  *testing.FakeClock
+		fakeDBus   *fakedbus.DBus
+		recorder   *record.FakeRecorder
+
+		node  *corev1.Node
+		probe *fakeProbe
+
+		imageCacheCleaned bool
+		checker           *RemediationHealthChecker
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(node).Build()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testing.NewFakeClock(time.Now())
+		fakeDBus = fakedbus.New()
+		recorder = record.NewFakeRecorder(100)
+
+		probe = &fakeProbe{
+			name: "fake",
+			actions: []RemediationAction{
+				{Type: RemediationActionRestartUnit, UnitName: "foo.service"},
+				{Type: RemediationActionCleanImageCache},
+				{Type: RemediationActionReboot},
+				{Type: RemediationActionReplaceNode},
+			},
+		}
+
+		imageCacheCleaned = false
+		checker = NewRemediationHealthChecker(fakeClient, fakeClient, fakeFS, fakeClock, fakeDBus, recorder, 1, probe)
+		checker.CleanImageCache = func(_ context.Context) error {
+			imageCacheCleaned = true
+			return nil
+		}
+	})
+
+	check := func() {
+		ExpectWithOffset(1, checker.Check(ctx, node)).To(Succeed())
+	}
+
+	writeReboots := func(reboots ...time.Time) {
+		var times []metav1.Time
+		for _, t := range reboots {
+			times = append(times, metav1.NewTime(t))
+		}
+		content, err := yaml.Marshal(map[string]any{"reboots": times})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, fakeFS.WriteFile(RemediationStateFilePath, content, 0600)).To(Succeed())
+	}
+
+	It("should do nothing if the probe is healthy", func() {
+		check()
+
+		Expect(fakeDBus.Actions).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should escalate the remediation actions while the problem persists", func() {
+		probe.problem = errors.New("broken")
+
+		By("Detect problem")
+		check()
+		Expect(recorder.Events).To(Receive(ContainSubstring("ProblemDetected")))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+
+		By("Wait for grace period")
+		fakeClock.Step(30 * time.Second)
+		check()
+		Expect(fakeDBus.Actions).To(BeEmpty())
+
+		By("Restart unit")
+		fakeClock.Step(30 * time.Second)
+		check()
+		Expect(recorder.Events).To(Receive(ContainSubstring("Executing remediation action RestartUnit(foo.service)")))
+		Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}}))
+
+		By("Wait for escalation interval")
+		fakeClock.Step(time.Minute)
+		check()
+		Expect(imageCacheCleaned).To(BeFalse())
+
+		By("Clean image cache")
+		fakeClock.Step(4 * time.Minute)
+		check()
+		Expect(imageCacheCleaned).To(BeTrue())
+
+		By("Reboot node")
+		fakeClock.Step(5 * time.Minute)
+		check()
+		Expect(fakeDBus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+		content, err := fakeFS.ReadFile(RemediationStateFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("reboots:"))
+
+		By("Replace node")
+		fakeClock.Step(5 * time.Minute)
+		check()
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).To(HaveKeyWithValue("node.machine.sapcloud.io/trigger-deletion-by-mcm", "true"))
+
+		By("Do nothing once all actions are exhausted")
+		fakeDBus.Actions = nil
+		fakeClock.Step(5 * time.Minute)
+		check()
+		Expect(fakeDBus.Actions).To(BeEmpty())
+	})
+
+	It("should reset the escalation when the problem is resolved", func() {
+		probe.problem = errors.New("broken")
+		check()
+		fakeClock.Step(time.Minute)
+		check()
+		Expect(fakeDBus.Actions).To(HaveLen(1))
+
+		probe.problem = nil
+		check()
+		Expect(recorder.Events).To(Receive(ContainSubstring("ProblemDetected")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("RemediationAction")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("RemediationSucceeded")))
+
+		probe.problem = errors.New("broken again")
+		check()
+		fakeClock.Step(time.Minute)
+		check()
+		Expect(fakeDBus.Actions).To(HaveLen(2))
+		Expect(fakeDBus.Actions[1]).To(Equal(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}}))
+	})
+
+	Context("rate limits", func() {
+		BeforeEach(func() {
+			probe.problem = errors.New("broken")
+			probe.actions = []RemediationAction{{Type: RemediationActionReboot}, {Type: RemediationActionReplaceNode}}
+		})
+
+		It("should not escalate if the maximum number of reboots is reached", func() {
+			writeReboots(fakeClock.Now().Add(-20*time.Hour), fakeClock.Now().Add(-10*time.Hour), fakeClock.Now().Add(-2*time.Hour))
+
+			check()
+			fakeClock.Step(time.Minute)
+			check()
+			fakeClock.Step(5 * time.Minute)
+			check()
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey("node.machine.sapcloud.io/trigger-deletion-by-mcm"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("ProblemDetected")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("RemediationRateLimited")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("RemediationRateLimited")))
+		})
+
+		It("should retry the reboot once the last reboot is long enough ago", func() {
+			writeReboots(fakeClock.Now().Add(-30 * time.Minute))
+
+			check()
+			fakeClock.Step(time.Minute)
+			check()
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring("ProblemDetected")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("RemediationRateLimited")))
+
+			By("Do not retry before the escalation interval passed")
+			fakeClock.Step(4 * time.Minute)
+			check()
+			Expect(recorder.Events).To(BeEmpty())
+
+			fakeClock.Step(25 * time.Minute)
+			check()
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+		})
+
+		It("should reboot if previous reboots are outside of the window", func() {
+			writeReboots(fakeClock.Now().Add(-30*time.Hour), fakeClock.Now().Add(-26*time.Hour), fakeClock.Now().Add(-25*time.Hour))
+
+			check()
+			fakeClock.Step(time.Minute)
+			check()
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+		})
+	})
+
+	Context("worker pool limit", func() {
+		var (
+			otherNode *corev1.Node
+			lease     *coordinationv1.Lease
+		)
+
+		BeforeEach(func() {
+			probe.problem = errors.New("broken")
+			probe.actions = []RemediationAction{{Type: RemediationActionReboot}, {Type: RemediationActionReplaceNode}}
+
+			otherNode = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "other-node", Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}}}
+			Expect(fakeClient.Create(ctx, otherNode)).To(Succeed())
+
+			lease = &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-remediation-pool", Namespace: "kube-system"}}
+		})
+
+		createLease := func(remediationNodes string) {
+			lease.Annotations = map[string]string{"worker.gardener.cloud/remediation-nodes": remediationNodes}
+			ExpectWithOffset(1, fakeClient.Create(ctx, lease)).To(Succeed())
+		}
+
+		It("should register the node in the lease", func() {
+			check()
+			fakeClock.Step(time.Minute)
+			check()
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+			Expect(lease.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/remediation-nodes", "node="+fakeClock.Now().UTC().Format(time.RFC3339)))
+		})
+
+		It("should not escalate if another node of the pool is remediated", func() {
+			createLease("other-node=" + fakeClock.Now().UTC().Format(time.RFC3339))
+
+			check()
+			fakeClock.Step(time.Minute)
+			check()
+			fakeClock.Step(5 * time.Minute)
+			check()
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey("node.machine.sapcloud.io/trigger-deletion-by-mcm"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("ProblemDetected")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("RemediationRateLimited")))
+		})
+
+		It("should ignore expired registrations and nodes which are not part of the pool", func() {
+			createLease("other-node=" + fakeClock.Now().Add(-time.Hour).UTC().Format(time.RFC3339) + ",removed-node=" + fakeClock.Now().UTC().Format(time.RFC3339))
+
+			check()
+			fakeClock.Step(time.Minute)
+			check()
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+			Expect(lease.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/remediation-nodes", "node="+fakeClock.Now().UTC().Format(time.RFC3339)))
+		})
+
+		It("should allow as many concurrent remediations as configured", func() {
+			checker = NewRemediationHealthChecker(fakeClient, fakeClient, fakeFS, fakeClock, fakeDBus, recorder, 2, probe)
+			createLease("other-node=" + fakeClock.Now().UTC().Format(time.RFC3339))
+
+			check()
+			fakeClock.Step(time.Minute)
+			check()
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+		})
+	})
+
+	It("should not remediate nodes which are updated in-place", func() {
+		node.Labels["worker.gardener.cloud/in-place-update"] = "in-progress"
+		probe.problem = errors.New("broken")
+
+		check()
+		fakeClock.Step(time.Minute)
+		check()
+
+		Expect(fakeDBus.Actions).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	Describe("Probes", func() {
+		Describe("#DiskPressureProbe", func() {
+			It("should detect file systems with little available space", func() {
+				probe := NewDiskPressureProbe()
+				probe.StatFS = func(path string) (uint64, uint64, error) {
+					if path == "/var/lib/containerd" {
+						return 100, 5, nil
+					}
+					return 100, 50, nil
+				}
+
+				Expect(probe.Probe(ctx)).To(MatchError("/var/lib/containerd: only 5% of the disk space is available"))
+				Expect(probe.Actions()).To(ConsistOf(RemediationAction{Type: RemediationActionCleanImageCache}))
+			})
+
+			It("should succeed if enough space is available", func() {
+				probe := NewDiskPressureProbe()
+				probe.StatFS = func(_ string) (uint64, uint64, error) { return 100, 50, nil }
+
+				Expect(probe.Probe(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#StuckMountProbe", func() {
+			var probe *StuckMountProbe
+
+			BeforeEach(func() {
+				Expect(fakeFS.WriteFile("/proc/mounts", []byte(`/dev/sda1 / ext4 rw 0 0
+10.0.0.1:/share /mnt/share nfs4 rw 0 0
+10.0.0.2:/other /mnt/other nfs rw 0 0
+`), 0644)).To(Succeed())
+
+				probe = NewStuckMountProbe(fakeFS, fakeClock)
+			})
+
+			It("should detect network file systems which do not respond", func() {
+				block := make(chan struct{})
+				DeferCleanup(func() { close(block) })
+
+				var statted []string
+				probe.Stat = func(path string) error {
+					statted = append(statted, path)
+					if path == "/mnt/share" {
+						<-block
+					}
+					return nil
+				}
+
+				go func() {
+					defer GinkgoRecover()
+					Eventually(fakeClock.HasWaiters).Should(BeTrue())
+					fakeClock.Step(10 * time.Second)
+				}()
+
+				Expect(probe.Probe(ctx)).To(MatchError(ContainSubstring("mounts do not respond within 10s: /mnt/share")))
+				Expect(statted).To(ConsistOf("/mnt/share", "/mnt/other"))
+				Expect(probe.Actions()).To(Equal([]RemediationAction{{Type: RemediationActionReboot}, {Type: RemediationActionReplaceNode}}))
+			})
+
+			It("should succeed if all mounts respond", func() {
+				probe.Stat = func(_ string) error { return nil }
+
+				Expect(probe.Probe(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#TimeSyncProbe", func() {
+			It("should detect an unsynchronized clock", func() {
+				probe := NewTimeSyncProbe()
+				probe.Synchronized = func(_ context.Context) (bool, error) { return false, nil }
+
+				Expect(probe.Probe(ctx)).To(MatchError("system clock is not synchronized"))
+				Expect(probe.Actions()).To(ConsistOf(RemediationAction{Type: RemediationActionRestartUnit, UnitName: "systemd-timesyncd.service"}))
+			})
+		})
+
+		Describe("#ClockSkewProbe", func() {
+			var probe *ClockSkewProbe
+
+			BeforeEach(func() {
+				probe = &ClockSkewProbe{Clock: fakeClock, MaxSkew: 10 * time.Second}
+			})
+
+			It("should detect a skewed clock", func() {
+				probe.ServerTime = func(_ context.Context) (time.Time, error) { return fakeClock.Now().Add(-time.Minute), nil }
+
+				Expect(probe.Probe(ctx)).To(MatchError("system clock deviates by 1m0s from the clock of the kube-apiserver"))
+			})
+
+			It("should tolerate small deviations", func() {
+				probe.ServerTime = func(_ context.Context) (time.Time, error) { return fakeClock.Now().Add(5 * time.Second), nil }
+
+				Expect(probe.Probe(ctx)).To(Succeed())
+			})
+
+			It("should succeed if the kube-apiserver is not reachable", func() {
+				probe.ServerTime = func(_ context.Context) (time.Time, error) { return time.Time{}, errors.New("unreachable") }
+
+				Expect(probe.Probe(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#DNSProbe", func() {
+			It("should detect resolution failures", func() {
+				probe := NewDNSProbe("api.example.com")
+				probe.Resolve = func(_ context.Context, _ string) error { return errors.New("no such host") }
+
+				Expect(probe.Probe(ctx)).To(MatchError("failed resolving api.example.com: no such host"))
+				Expect(probe.Actions()).To(ConsistOf(RemediationAction{Type: RemediationActionRestartUnit, UnitName: "systemd-resolved.service"}))
+			})
+		})
+	})
+})
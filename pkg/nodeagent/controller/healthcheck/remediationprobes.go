@@ -0,0 +1,332 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+)
+
+const (
+	unitNameTimeSync = "systemd-timesyncd.service"
+	unitNameResolved = "systemd-resolved.service"
+)
+
+// DiskPressureProbe detects file systems whose available space falls below a threshold.
+type DiskPressureProbe struct {
+	// Paths are the paths whose file systems are checked.
+	Paths []string
+	// MinAvailablePercent is the minimum percentage of available space.
+	MinAvailablePercent uint64
+	// StatFS returns the total and the available bytes of the file system containing the given path. Exposed for
+	// testing.
+	StatFS func(path string) (total, available uint64, err error)
+}
+
+// NewDiskPressureProbe creates a new disk pressure probe for the file systems of the kubelet and containerd data
+// directories.
+func NewDiskPressureProbe() *DiskPressureProbe {
+	return &DiskPressureProbe{
+		Paths:               []string{"/var/lib/kubelet", "/var/lib/containerd"},
+		MinAvailablePercent: 10,
+		StatFS: func(path string) (uint64, uint64, error) {
+			var stat syscall.Statfs_t
+			if err := syscall.Statfs(path, &stat); err != nil {
+				return 0, 0, err
+			}
+			return stat.Blocks * uint64(stat.Bsize), stat.Bavail * uint64(stat.Bsize), nil
+		},
+	}
+}
+
+// Name returns the name of the probe.
+func (*DiskPressureProbe) Name() string {
+	return "disk-pressure"
+}
+
+// Probe checks the available space of all file systems.
+func (d *DiskPressureProbe) Probe(_ context.Context) error {
+	var problems []string
+	for _, path := range d.Paths {
+		total, available, err := d.StatFS(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+
+		if total > 0 && available*100/total < d.MinAvailablePercent {
+			problems = append(problems, fmt.Sprintf("%s: only %d%% of the disk space is available", path, available*100/total))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// Actions returns the remediation actions for disk pressure.
+func (*DiskPressureProbe) Actions() []RemediationAction {
+	return []RemediationAction{{Type: RemediationActionCleanImageCache}}
+}
+
+var networkFileSystemTypes = []string{"nfs", "nfs4", "cifs", "smb3", "ceph", "glusterfs"}
+
+// StuckMountProbe detects mounts of network file systems which do not respond anymore.
+type StuckMountProbe struct {
+	// FS is used to read the mounts.
+	FS afero.Afero
+	// Clock is used to time out stat calls.
+	Clock clock.Clock
+	// Timeout is the duration after which a mount is considered as stuck.
+	Timeout time.Duration
+	// Stat stats the given path. Exposed for testing.
+	Stat func(path string) error
+
+	// inFlight contains the stat calls which did not return yet. Stuck mounts block stat calls forever, hence they are
+	// not retried.
+	inFlight sync.Map
+}
+
+// NewStuckMountProbe creates a new stuck mount probe.
+func NewStuckMountProbe(fs afero.Afero, clock clock.Clock) *StuckMountProbe {
+	return &StuckMountProbe{
+		FS:      fs,
+		Clock:   clock,
+		Timeout: 10 * time.Second,
+		Stat: func(path string) error {
+			_, err := os.Stat(path)
+			return err
+		},
+	}
+}
+
+// Name returns the name of the probe.
+func (*StuckMountProbe) Name() string {
+	return "stuck-mounts"
+}
+
+// Probe stats all network file system mounts.
+func (s *StuckMountProbe) Probe(ctx context.Context) error {
+	content, err := s.FS.ReadFile("/proc/mounts")
+	if err != nil {
+		return fmt.Errorf("failed reading mounts: %w", err)
+	}
+
+	var stuck []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || !isNetworkFileSystem(fields[2]) {
+			continue
+		}
+
+		if !s.responds(ctx, fields[1]) {
+			stuck = append(stuck, fields[1])
+		}
+	}
+
+	if len(stuck) > 0 {
+		return fmt.Errorf("mounts do not respond within %s: %s", s.Timeout, strings.Join(stuck, ", "))
+	}
+	return nil
+}
+
+func (s *StuckMountProbe) responds(ctx context.Context, path string) bool {
+	done := make(chan struct{})
+	if previous, loaded := s.inFlight.LoadOrStore(path, done); loaded {
+		done = previous.(chan struct{})
+	} else {
+		go func() {
+			_ = s.Stat(path)
+			s.inFlight.Delete(path)
+			close(done)
+		}()
+	}
+
+	timer := s.Clock.NewTimer(s.Timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return true
+	case <-timer.C():
+		return false
+	}
+}
+
+func isNetworkFileSystem(fsType string) bool {
+	for _, t := range networkFileSystemTypes {
+		if fsType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Actions returns the remediation actions for stuck mounts.
+func (*StuckMountProbe) Actions() []RemediationAction {
+	return []RemediationAction{{Type: RemediationActionReboot}, {Type: RemediationActionReplaceNode}}
+}
+
+// TimeSyncProbe detects whether the system clock is not synchronized.
+type TimeSyncProbe struct {
+	// Synchronized returns whether the system clock is synchronized. Exposed for testing.
+	Synchronized func(ctx context.Context) (bool, error)
+}
+
+// NewTimeSyncProbe creates a new time synchronization probe.
+func NewTimeSyncProbe() *TimeSyncProbe {
+	return &TimeSyncProbe{
+		Synchronized: func(ctx context.Context) (bool, error) {
+			out, err := exec.CommandContext(ctx, "timedatectl", "show", "--property=NTPSynchronized", "--value").Output()
+			if err != nil {
+				return false, err
+			}
+			return strings.TrimSpace(string(out)) == "yes", nil
+		},
+	}
+}
+
+// Name returns the name of the probe.
+func (*TimeSyncProbe) Name() string {
+	return "time-sync"
+}
+
+// Probe checks whether the system clock is synchronized.
+func (t *TimeSyncProbe) Probe(ctx context.Context) error {
+	synchronized, err := t.Synchronized(ctx)
+	if err != nil {
+		return fmt.Errorf("failed checking time synchronization: %w", err)
+	}
+	if !synchronized {
+		return fmt.Errorf("system clock is not synchronized")
+	}
+	return nil
+}
+
+// Actions returns the remediation actions for an unsynchronized clock.
+func (*TimeSyncProbe) Actions() []RemediationAction {
+	return []RemediationAction{{Type: RemediationActionRestartUnit, UnitName: unitNameTimeSync}}
+}
+
+// ClockSkewProbe detects whether the system clock deviates from the clock of the kube-apiserver.
+type ClockSkewProbe struct {
+	// Clock is the system clock.
+	Clock clock.Clock
+	// MaxSkew is the maximum tolerated deviation.
+	MaxSkew time.Duration
+	// ServerTime returns the current time of the kube-apiserver. Exposed for testing.
+	ServerTime func(ctx context.Context) (time.Time, error)
+}
+
+// NewClockSkewProbe creates a new clock skew probe which compares the system clock with the 'Date' header of the
+// responses of the kube-apiserver.
+func NewClockSkewProbe(clock clock.Clock, restConfig *rest.Config) (*ClockSkewProbe, error) {
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating HTTP client: %w", err)
+	}
+
+	return &ClockSkewProbe{
+		Clock:   clock,
+		MaxSkew: 10 * time.Second,
+		ServerTime: func(ctx context.Context) (time.Time, error) {
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(restConfig.Host, "/")+"/livez", nil)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			response, err := httpClient.Do(request)
+			if err != nil {
+				return time.Time{}, err
+			}
+			defer response.Body.Close()
+
+			return http.ParseTime(response.Header.Get("Date"))
+		},
+	}, nil
+}
+
+// Name returns the name of the probe.
+func (*ClockSkewProbe) Name() string {
+	return "clock-skew"
+}
+
+// Probe compares the system clock with the clock of the kube-apiserver.
+func (c *ClockSkewProbe) Probe(ctx context.Context) error {
+	serverTime, err := c.ServerTime(ctx)
+	if err != nil {
+		// The kube-apiserver might not be reachable for other reasons which cannot be remediated by synchronizing the
+		// system clock.
+		return nil
+	}
+
+	if skew := c.Clock.Now().Sub(serverTime).Abs(); skew > c.MaxSkew {
+		return fmt.Errorf("system clock deviates by %s from the clock of the kube-apiserver", skew.Round(time.Second))
+	}
+	return nil
+}
+
+// Actions returns the remediation actions for a skewed clock.
+func (*ClockSkewProbe) Actions() []RemediationAction {
+	return []RemediationAction{{Type: RemediationActionRestartUnit, UnitName: unitNameTimeSync}}
+}
+
+// DNSProbe detects whether host names cannot be resolved.
+type DNSProbe struct {
+	// Host is the host name which is resolved.
+	Host string
+	// Resolve resolves the given host name. Exposed for testing.
+	Resolve func(ctx context.Context, host string) error
+}
+
+// NewDNSProbe creates a new DNS probe which resolves the given host name.
+func NewDNSProbe(host string) *DNSProbe {
+	return &DNSProbe{
+		Host: host,
+		Resolve: func(ctx context.Context, host string) error {
+			_, err := net.DefaultResolver.LookupHost(ctx, host)
+			return err
+		},
+	}
+}
+
+// Name returns the name of the probe.
+func (*DNSProbe) Name() string {
+	return "dns"
+}
+
+// Probe resolves the host name.
+func (d *DNSProbe) Probe(ctx context.Context) error {
+	if err := d.Resolve(ctx, d.Host); err != nil {
+		return fmt.Errorf("failed resolving %s: %w", d.Host, err)
+	}
+	return nil
+}
+
+// Actions returns the remediation actions for DNS resolution problems.
+func (*DNSProbe) Actions() []RemediationAction {
+	return []RemediationAction{{Type: RemediationActionRestartUnit, UnitName: unitNameResolved}}
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepull
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+const (
+	// RequeueInterval is the interval after which the node is reconciled again.
+	RequeueInterval = time.Minute
+	// PullTimeout is the timeout for pulling a single image.
+	PullTimeout = 10 * time.Minute
+)
+
+// Reconciler pre-pulls the images configured in the last applied operating system config and pins them so that they
+// are not removed by the image garbage collection of the kubelet. Images which are no longer configured are unpinned.
+// The progress is reported via a condition on the Node.
+type Reconciler struct {
+	Client      client.Client
+	FS          afero.Afero
+	Clock       clock.Clock
+	Recorder    record.EventRecorder
+	ImagePuller registry.ImagePuller
+}
+
+// Reconcile pre-pulls and pins the configured images.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, request.NamespacedName, node); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	osc, err := nodeagent.ReadLastAppliedOperatingSystemConfig(r.FS)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		errs    []error
+		desired []string
+		failed  []string
+	)
+
+	if osc != nil && osc.Spec.CRIConfig != nil && osc.Spec.CRIConfig.Containerd != nil {
+		for _, image := range osc.Spec.CRIConfig.Containerd.PrePullImages {
+			// containerd stores images with their normalized references, hence, the configured references must be
+			// normalized as well to compare them with the pinned images.
+			ref, err := reference.ParseDockerRef(image)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("invalid image reference %q: %w", image, err))
+				failed = append(failed, image)
+				continue
+			}
+			desired = append(desired, ref.String())
+		}
+	}
+
+	pinnedImages, err := r.ImagePuller.PinnedImages(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing pinned images: %w", err)
+	}
+	pinned := sets.New(pinnedImages...)
+
+	for _, image := range sets.List(pinned.Difference(sets.New(desired...))) {
+		log.Info("Unpinning image which is no longer configured to be pre-pulled", "image", image)
+		if err := r.ImagePuller.Unpin(ctx, image); err != nil {
+			errs = append(errs, fmt.Errorf("failed unpinning image %q: %w", image, err))
+			continue
+		}
+		pinned.Delete(image)
+	}
+
+	total := len(desired) + len(failed)
+	for _, image := range desired {
+		if pinned.Has(image) {
+			continue
+		}
+
+		if err := r.updateNodeCondition(ctx, node, total, countPinned(pinned, desired), failed); err != nil {
+			errs = append(errs, fmt.Errorf("failed updating node condition: %w", err))
+		}
+
+		log.Info("Pre-pulling image", "image", image)
+		if err := r.pullAndPin(ctx, image); err != nil {
+			log.Error(err, "Failed pre-pulling image", "image", image)
+			r.Recorder.Eventf(node, corev1.EventTypeWarning, "ImagePrePullFailed", "Failed pre-pulling image %s: %s", image, err.Error())
+			errs = append(errs, fmt.Errorf("failed pre-pulling image %q: %w", image, err))
+			failed = append(failed, image)
+			continue
+		}
+
+		log.Info("Successfully pre-pulled and pinned image", "image", image)
+		pinned.Insert(image)
+	}
+
+	if err := r.updateNodeCondition(ctx, node, total, countPinned(pinned, desired), failed); err != nil {
+		errs = append(errs, fmt.Errorf("failed updating node condition: %w", err))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: RequeueInterval}, nil
+}
+
+func (r *Reconciler) pullAndPin(ctx context.Context, image string) error {
+	pullCtx, cancel := context.WithTimeout(ctx, PullTimeout)
+	defer cancel()
+
+	return r.ImagePuller.PullAndPin(pullCtx, image)
+}
+
+func countPinned(pinned sets.Set[string], desired []string) int {
+	var count int
+	for _, image := range desired {
+		if pinned.Has(image) {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Reconciler) updateNodeCondition(ctx context.Context, node *corev1.Node, total, pulled int, failed []string) error {
+	conditionType := corev1.NodeConditionType(nodeagentv1alpha1.NodeConditionImagesPrePulled)
+
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+	idx := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == conditionType })
+
+	if total == 0 {
+		if idx < 0 {
+			return nil
+		}
+		node.Status.Conditions = slices.Delete(node.Status.Conditions, idx, idx+1)
+		return r.Client.Status().Patch(ctx, node, patch)
+	}
+
+	condition := corev1.NodeCondition{
+		Type:    conditionType,
+		Status:  corev1.ConditionTrue,
+		Reason:  "ImagesPrePulled",
+		Message: fmt.Sprintf("All %d images are pre-pulled and pinned.", total),
+	}
+	switch {
+	case len(failed) > 0:
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = "ImagePrePullFailed"
+		condition.Message = fmt.Sprintf("Pre-pulled %d/%d images, failed pre-pulling: %s", pulled, total, strings.Join(failed, ", "))
+	case pulled < total:
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = "ImagePrePullProgressing"
+		condition.Message = fmt.Sprintf("Pre-pulled %d/%d images.", pulled, total)
+	}
+
+	now := metav1.NewTime(r.Clock.Now())
+	condition.LastHeartbeatTime = now
+	condition.LastTransitionTime = now
+
+	if idx >= 0 {
+		existing := node.Status.Conditions[idx]
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return nil
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		node.Status.Conditions[idx] = condition
+	} else {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	}
+
+	return r.Client.Status().Patch(ctx, node, patch)
+}
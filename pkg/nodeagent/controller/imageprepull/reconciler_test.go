@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepull_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/imageprepull"
+	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeFS     afero.Afero
+		puller     *fakeregistry.ImagePuller
+		recorder   *record.FakeRecorder
+
+		node       *corev1.Node
+		request    reconcile.Request
+		reconciler *Reconciler
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(node).WithStatusSubresource(node).Build()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		puller = fakeregistry.NewImagePuller()
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client:      fakeClient,
+			FS:          fakeFS,
+			Clock:       testing.NewFakeClock(time.Now()),
+			Recorder:    recorder,
+			ImagePuller: puller,
+		}
+	})
+
+	writeOSC := func(images ...string) {
+		osc := &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				CRIConfig: &extensionsv1alpha1.CRIConfig{
+					Name:       extensionsv1alpha1.CRINameContainerD,
+					Containerd: &extensionsv1alpha1.ContainerdConfig{PrePullImages: images},
+				},
+			},
+		}
+		oscRaw, err := yaml.Marshal(osc)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, fakeFS.WriteFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, oscRaw, 0644)).To(Succeed())
+	}
+
+	condition := func() *corev1.NodeCondition {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		for _, c := range node.Status.Conditions {
+			if c.Type == nodeagentv1alpha1.NodeConditionImagesPrePulled {
+				return &c
+			}
+		}
+		return nil
+	}
+
+	It("should do nothing if no OSC was applied so far", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueInterval}))
+		Expect(puller.Pulled).To(BeEmpty())
+		Expect(condition()).To(BeNil())
+	})
+
+	It("should pull and pin the configured images with normalized references", func() {
+		writeOSC("nginx", "registry.example.com/foo:v1")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueInterval}))
+		Expect(puller.Pulled).To(Equal([]string{"docker.io/library/nginx:latest", "registry.example.com/foo:v1"}))
+		Expect(puller.PinnedImages(ctx)).To(ConsistOf("docker.io/library/nginx:latest", "registry.example.com/foo:v1"))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionTrue),
+			"Reason":  Equal("ImagesPrePulled"),
+			"Message": Equal("All 2 images are pre-pulled and pinned."),
+		})))
+	})
+
+	It("should not pull images again which are already pinned", func() {
+		puller = fakeregistry.NewImagePuller("registry.example.com/foo:v1")
+		reconciler.ImagePuller = puller
+		writeOSC("registry.example.com/foo:v1")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueInterval}))
+		Expect(puller.Pulled).To(BeEmpty())
+		Expect(condition().Status).To(Equal(corev1.ConditionTrue))
+	})
+
+	It("should unpin images which are no longer configured", func() {
+		puller = fakeregistry.NewImagePuller("registry.example.com/foo:v1", "registry.example.com/bar:v1")
+		reconciler.ImagePuller = puller
+		writeOSC("registry.example.com/foo:v1")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueInterval}))
+		Expect(puller.PinnedImages(ctx)).To(ConsistOf("registry.example.com/foo:v1"))
+	})
+
+	It("should report the failed images and return an error", func() {
+		puller.PullErrors["registry.example.com/bar:v1"] = fmt.Errorf("not found")
+		writeOSC("registry.example.com/foo:v1", "registry.example.com/bar:v1")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring(`failed pre-pulling image "registry.example.com/bar:v1": not found`)))
+		Expect(puller.PinnedImages(ctx)).To(ConsistOf("registry.example.com/foo:v1"))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(corev1.ConditionFalse),
+			"Reason":  Equal("ImagePrePullFailed"),
+			"Message": Equal("Pre-pulled 1/2 images, failed pre-pulling: registry.example.com/bar:v1"),
+		})))
+		Expect(recorder.Events).To(Receive(ContainSubstring("ImagePrePullFailed")))
+	})
+
+	It("should remove the condition if no images are configured anymore", func() {
+		writeOSC("registry.example.com/foo:v1")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueInterval}))
+		Expect(condition()).NotTo(BeNil())
+
+		writeOSC()
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueInterval}))
+		Expect(puller.PinnedImages(ctx)).To(BeEmpty())
+		Expect(condition()).To(BeNil())
+	})
+})
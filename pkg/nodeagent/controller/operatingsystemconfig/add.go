@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -29,6 +30,8 @@ import (
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/drain"
+	"github.com/gardener/gardener/pkg/nodeagent/kernel"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
 )
 
@@ -40,6 +43,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName)
 	}
@@ -52,6 +58,12 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	if r.Extractor == nil {
 		r.Extractor = registry.NewExtractor()
 	}
+	if r.KernelManager == nil {
+		r.KernelManager = kernel.NewManager(r.FS)
+	}
+	if r.Drainer == nil {
+		r.Drainer = drain.New(r.Client, r.APIReader, r.Recorder, clock.RealClock{})
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -90,10 +90,10 @@ func computeOperatingSystemConfigChanges(fs afero.Afero, newOSC *extensionsv1alp
 	// The reason for assigning files to units is the detection of changes which require the restart of a unit.
 	newOSCFiles := collectAllFiles(newOSC)
 
-	oldOSCRaw, err := fs.ReadFile(lastAppliedOperatingSystemConfigFilePath)
+	oldOSCRaw, err := fs.ReadFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath)
 	if err != nil {
 		if !errors.Is(err, afero.ErrFileNotFound) {
-			return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+			return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
 		}
 
 		var unitChanges []changedUnit
@@ -120,7 +120,7 @@ func computeOperatingSystemConfigChanges(fs afero.Afero, newOSC *extensionsv1alp
 
 	oldOSC := &extensionsv1alpha1.OperatingSystemConfig{}
 	if err := runtime.DecodeInto(decoder, oldOSCRaw, oldOSC); err != nil {
-		return nil, fmt.Errorf("unable to decode the old OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+		return nil, fmt.Errorf("unable to decode the old OSC read from file path %s: %w", nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
 	}
 
 	oldOSCFiles := collectAllFiles(oldOSC)
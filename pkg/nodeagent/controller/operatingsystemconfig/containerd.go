@@ -6,27 +6,22 @@ package operatingsystemconfig
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
-	_ "embed"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
 	"path"
-	"path/filepath"
-	"text/template"
 	"time"
 
-	"github.com/Masterminds/sprig/v3"
 	"github.com/go-logr/logr"
 	"github.com/pelletier/go-toml"
 	"github.com/spf13/afero"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	containerdutils "github.com/gardener/gardener/pkg/nodeagent/containerd"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	"github.com/gardener/gardener/pkg/utils/structuredmap"
@@ -83,7 +78,6 @@ func (r *Reconciler) ReconcileContainerdRegistries(ctx context.Context, log logr
 
 const (
 	baseDir   = "/etc/containerd"
-	certsDir  = baseDir + "/certs.d"
 	configDir = baseDir + "/conf.d"
 	dropinDir = "/etc/systemd/system/containerd.service.d"
 )
@@ -93,7 +87,7 @@ func (r *Reconciler) ensureContainerdConfigDirectories() error {
 		extensionsv1alpha1.ContainerDRuntimeContainersBinFolder,
 		baseDir,
 		configDir,
-		certsDir,
+		containerdutils.CertsDir,
 		dropinDir,
 	} {
 		if err := r.FS.MkdirAll(dir, defaultDirPermissions); err != nil {
@@ -182,7 +176,7 @@ func (r *Reconciler) ensureContainerdConfiguration(log logr.Logger, criConfig *e
 			name: "registry config path",
 			path: structuredmap.Path{"plugins", "io.containerd.grpc.v1.cri", "registry", "config_path"},
 			setFn: func(_ any) (any, error) {
-				return certsDir, nil
+				return containerdutils.CertsDir, nil
 			},
 		},
 		{
@@ -307,7 +301,7 @@ func (r *Reconciler) ensureContainerdRegistries(ctx context.Context, log logr.Lo
 	// Registries without readiness probes can directly and synchronously be added here
 	// since there is no longer blocking operation involved.
 	for _, registryConfig := range registriesWithoutReadiness {
-		if err := addRegistryToContainerdFunc(ctx, log, registryConfig, r.FS); err != nil {
+		if err := addRegistryToContainerdFunc(ctx, log, registryConfig, r.FS, r.APIReader); err != nil {
 			errChan <- err
 			return errChan
 		}
@@ -316,7 +310,7 @@ func (r *Reconciler) ensureContainerdRegistries(ctx context.Context, log logr.Lo
 	fns := make([]flow.TaskFn, 0, len(registriesWithReadiness))
 	for _, registryConfig := range registriesWithReadiness {
 		fns = append(fns, func(ctx context.Context) error {
-			return addRegistryToContainerdFunc(ctx, log, registryConfig, r.FS)
+			return addRegistryToContainerdFunc(ctx, log, registryConfig, r.FS, r.APIReader)
 		})
 	}
 
@@ -327,29 +321,13 @@ func (r *Reconciler) ensureContainerdRegistries(ctx context.Context, log logr.Lo
 	return errChan
 }
 
-var (
-	//go:embed templates/containerd-hosts.toml.tpl
-	tplContentContainerdHosts string
-	tplContainerdHosts        *template.Template
-)
-
-func init() {
-	tplContainerdHosts = template.Must(template.
-		New(tplContentContainerdHosts).
-		Funcs(sprig.TxtFuncMap()).
-		Parse(tplContentContainerdHosts))
-}
-
-func addRegistryToContainerdFunc(ctx context.Context, log logr.Logger, registryConfig extensionsv1alpha1.RegistryConfig, fs afero.Afero) error {
-	httpClient := http.Client{Timeout: 1 * time.Second}
-
-	baseDir := path.Join(certsDir, registryConfig.Upstream)
-	if err := fs.MkdirAll(baseDir, defaultDirPermissions); err != nil {
-		return fmt.Errorf("unable to ensure registry config base directory: %w", err)
+func addRegistryToContainerdFunc(ctx context.Context, log logr.Logger, registryConfig extensionsv1alpha1.RegistryConfig, fs afero.Afero, reader client.Reader) error {
+	credentials, err := containerdutils.ReadCredentials(ctx, reader, registryConfig)
+	if err != nil {
+		return err
 	}
 
-	hostsTomlFilePath := path.Join(baseDir, "hosts.toml")
-	exists, err := fs.Exists(hostsTomlFilePath)
+	exists, err := fs.Exists(containerdutils.HostsConfigFilePath(registryConfig.Upstream))
 	if err != nil {
 		return fmt.Errorf("unable to check if registry config file exists: %w", err)
 	}
@@ -361,34 +339,8 @@ func addRegistryToContainerdFunc(ctx context.Context, log logr.Logger, registryC
 		log.Info("Probing endpoints for image registry", "upstream", registryConfig.Upstream)
 		if err := retry.Until(ctx, 2*time.Second, func(ctx context.Context) (done bool, err error) {
 			for _, registryHost := range registryConfig.Hosts {
-				req, err := http.NewRequestWithContext(ctx, http.MethodGet, registryHost.URL, nil)
-				if err != nil {
-					return false, fmt.Errorf("failed to construct http request %s for upstream %s: %w", registryHost.URL, registryConfig.Upstream, err)
-				}
-
-				if len(registryHost.CACerts) > 0 {
-					caCertPool := x509.NewCertPool()
-					for _, caCert := range registryHost.CACerts {
-						if !filepath.IsAbs(caCert) {
-							caCert = filepath.Join(baseDir, caCert)
-						}
-						pemContent, err := fs.ReadFile(caCert)
-						if err != nil {
-							return false, fmt.Errorf("failed to read ca file %s for host %s and upstream %s: %w", caCert, registryHost.URL, registryConfig.Upstream, err)
-						}
-						caCertPool.AppendCertsFromPEM(pemContent)
-					}
-					httpClient.Transport = &http.Transport{
-						TLSClientConfig: &tls.Config{
-							RootCAs:    caCertPool,
-							MinVersion: tls.VersionTLS12,
-						},
-					}
-				}
-
-				_, err = httpClient.Do(req)
-				if err != nil {
-					return false, fmt.Errorf("failed to reach registry %s for upstream %s: %w", registryHost.URL, registryConfig.Upstream, err)
+				if err := containerdutils.ProbeHost(ctx, fs, registryConfig.Upstream, registryHost, credentials[registryHost.URL]); err != nil {
+					return false, err
 				}
 			}
 			return true, nil
@@ -399,50 +351,14 @@ func addRegistryToContainerdFunc(ctx context.Context, log logr.Logger, registryC
 		log.Info("Probing endpoints for image registry succeeded", "upstream", registryConfig.Upstream)
 	}
 
-	f, err := fs.OpenFile(hostsTomlFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("unable to open hosts.toml: %w", err)
-	}
-
-	defer func() {
-		if err := f.Close(); err != nil {
-			log.Error(err, "Failed closing file", "file", f.Name())
-		}
-	}()
-
-	var (
-		values = map[string]any{
-			"server":      ptr.Deref(registryConfig.Server, ""),
-			"hostConfigs": make([]any, 0, len(registryConfig.Hosts)),
-		}
-	)
-
-	for _, host := range registryConfig.Hosts {
-		hostConfig := map[string]any{
-			"hostURL": host.URL,
-			"capabilities": []extensionsv1alpha1.RegistryCapability{
-				extensionsv1alpha1.PullCapability,
-				extensionsv1alpha1.ResolveCapability,
-			},
-		}
-
-		if len(host.Capabilities) > 0 {
-			hostConfig["capabilities"] = host.Capabilities
-		}
-		if len(host.CACerts) > 0 {
-			hostConfig["ca"] = host.CACerts
-		}
-
-		values["hostConfigs"] = append(values["hostConfigs"].([]any), hostConfig)
-	}
-
-	return tplContainerdHosts.Execute(f, values)
+	_, err = containerdutils.WriteHostsConfig(fs, registryConfig, credentials)
+	return err
 }
 
 func (r *Reconciler) cleanupUnusedContainerdRegistries(log logr.Logger, registriesToRemove []extensionsv1alpha1.RegistryConfig) error {
 	for _, registryConfig := range registriesToRemove {
 		log.Info("Removing obsolete registry directory", "upstream", registryConfig.Upstream)
-		if err := r.FS.RemoveAll(path.Join(certsDir, registryConfig.Upstream)); err != nil {
+		if err := r.FS.RemoveAll(containerdutils.RegistryDir(registryConfig.Upstream)); err != nil {
 			return fmt.Errorf("failed to cleanup obsolete registry directory: %w", err)
 		}
 	}
@@ -0,0 +1,334 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	inPlaceUpdateLeaseNamePrefix = "gardener-node-agent-in-place-update-"
+	osReleaseFilePath            = "/etc/os-release"
+	bootIDFilePath               = "/proc/sys/kernel/random/boot_id"
+)
+
+// inPlaceUpdate describes which parts of the node must be updated in-place.
+type inPlaceUpdate struct {
+	operatingSystem bool
+	kubelet         bool
+}
+
+// computeInPlaceUpdate compares the versions of the given operating system config with the ones of the last applied
+// operating system config. It returns nil if the node does not need to be updated in-place.
+func (r *Reconciler) computeInPlaceUpdate(osc *extensionsv1alpha1.OperatingSystemConfig) (*inPlaceUpdate, error) {
+	if osc.Spec.InPlaceUpdates == nil {
+		return nil, nil
+	}
+
+	lastAppliedOSC, err := nodeagent.ReadLastAppliedOperatingSystemConfig(r.FS)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastAppliedOSC == nil || lastAppliedOSC.Spec.InPlaceUpdates == nil {
+		return nil, nil
+	}
+
+	update := &inPlaceUpdate{
+		operatingSystem: lastAppliedOSC.Spec.InPlaceUpdates.OperatingSystemVersion != osc.Spec.InPlaceUpdates.OperatingSystemVersion,
+		kubelet:         lastAppliedOSC.Spec.InPlaceUpdates.KubeletVersion != osc.Spec.InPlaceUpdates.KubeletVersion,
+	}
+
+	if !update.operatingSystem && !update.kubelet {
+		return nil, nil
+	}
+
+	return update, nil
+}
+
+// prepareInPlaceUpdate waits until the node may be updated according to the maxUnavailable setting of the worker pool,
+// and requests draining it afterwards. The node is drained by gardener-resource-manager, see the node-drain controller.
+// It returns false if the node has to wait for other nodes to finish their in-place updates or for being drained.
+func (r *Reconciler) prepareInPlaceUpdate(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig, update *inPlaceUpdate) (bool, error) {
+	if update.operatingSystem && (osc.Status.InPlaceUpdates == nil || osc.Status.InPlaceUpdates.OSUpdate == nil) {
+		return false, fmt.Errorf("operating system version changed to %q but the operating system config does not provide an update command", osc.Spec.InPlaceUpdates.OperatingSystemVersion)
+	}
+
+	if node.Labels[nodeagentv1alpha1.LabelInPlaceUpdate] != nodeagentv1alpha1.InPlaceUpdateInProgress {
+		if err := r.setInPlaceUpdateLabel(ctx, node, nodeagentv1alpha1.InPlaceUpdatePending); err != nil {
+			return false, err
+		}
+
+		acquired, err := r.acquireInPlaceUpdateSlot(ctx, log, node, osc.Spec.InPlaceUpdates.MaxUnavailable)
+		if err != nil {
+			return false, fmt.Errorf("failed acquiring in-place update slot: %w", err)
+		}
+		if !acquired {
+			return false, nil
+		}
+
+		if err := r.setInPlaceUpdateLabel(ctx, node, nodeagentv1alpha1.InPlaceUpdateInProgress); err != nil {
+			return false, err
+		}
+		r.Recorder.Eventf(node, corev1.EventTypeNormal, "InPlaceUpdateStarted", "Started in-place update to operating system version %q and kubelet version %q", osc.Spec.InPlaceUpdates.OperatingSystemVersion, osc.Spec.InPlaceUpdates.KubeletVersion)
+	}
+
+	switch node.Annotations[nodeagentv1alpha1.AnnotationKeyDrain] {
+	case nodeagentv1alpha1.DrainCompleted:
+		return true, nil
+
+	case nodeagentv1alpha1.DrainRequested:
+		log.Info("Waiting for node to be drained")
+		return false, nil
+	}
+
+	if policy := osc.Spec.DrainPolicy; policy != nil {
+		if err := r.Drainer.CallPreDrainHooks(ctx, log, node, policy.PreDrainHooks); err != nil {
+			return false, err
+		}
+	}
+
+	log.Info("Requesting node drain")
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyDrain, nodeagentv1alpha1.DrainRequested)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return false, fmt.Errorf("failed requesting node drain: %w", err)
+	}
+
+	return false, nil
+}
+
+// updateOperatingSystem executes the update command provided by the operating system extension and reboots the node.
+// It returns true once the node runs the desired operating system version, i.e., the version reported as VERSION_ID
+// in /etc/os-release equals the version in the operating system config. If the node was already rebooted for the
+// update but still runs a different version, an error is returned.
+func (r *Reconciler) updateOperatingSystem(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig) (bool, error) {
+	version := osc.Spec.InPlaceUpdates.OperatingSystemVersion
+
+	bootedVersion, err := r.bootedOperatingSystemVersion()
+	if err != nil {
+		return false, err
+	}
+
+	if bootedVersion == version {
+		log.Info("Node runs the desired operating system version", "version", version)
+		if err := r.FS.Remove(nodeagentv1alpha1.InPlaceUpdateRebootFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return false, fmt.Errorf("failed removing file %q: %w", nodeagentv1alpha1.InPlaceUpdateRebootFilePath, err)
+		}
+		return true, nil
+	}
+
+	bootID, err := r.FS.ReadFile(bootIDFilePath)
+	if err != nil {
+		return false, fmt.Errorf("failed reading boot ID: %w", err)
+	}
+
+	lastReboot, err := r.readInPlaceUpdateReboot()
+	if err != nil {
+		return false, err
+	}
+
+	if lastReboot != nil && lastReboot.OperatingSystemVersion == version {
+		if lastReboot.BootID == strings.TrimSpace(string(bootID)) {
+			log.Info("Waiting for node to be rebooted")
+			return false, nil
+		}
+		return false, fmt.Errorf("node was rebooted for updating the operating system to version %q but still runs version %q", version, bootedVersion)
+	}
+
+	osUpdate := osc.Status.InPlaceUpdates.OSUpdate
+	log.Info("Executing operating system update command", "command", osUpdate.Command, "args", osUpdate.Args)
+	if out, err := Exec(ctx, osUpdate.Command, osUpdate.Args...); err != nil {
+		return false, fmt.Errorf("command %q failed: %w (output: %s)", osUpdate.Command, err, strings.TrimSpace(string(out)))
+	}
+
+	rebootRaw, err := yaml.Marshal(inPlaceUpdateReboot{OperatingSystemVersion: version, BootID: strings.TrimSpace(string(bootID))})
+	if err != nil {
+		return false, fmt.Errorf("failed marshalling reboot information: %w", err)
+	}
+	if err := r.FS.WriteFile(nodeagentv1alpha1.InPlaceUpdateRebootFilePath, rebootRaw, 0600); err != nil {
+		return false, fmt.Errorf("failed writing file %q: %w", nodeagentv1alpha1.InPlaceUpdateRebootFilePath, err)
+	}
+
+	log.Info("Rebooting node to complete the operating system update")
+	r.Recorder.Event(node, corev1.EventTypeNormal, "InPlaceUpdateReboot", "Rebooting node to complete the operating system update")
+	if err := r.DBus.Reboot(); err != nil {
+		return false, fmt.Errorf("failed rebooting node: %w", err)
+	}
+
+	return false, nil
+}
+
+// inPlaceUpdateReboot is persisted on the node before it is rebooted for an operating system update.
+type inPlaceUpdateReboot struct {
+	// OperatingSystemVersion is the operating system version the node is updated to.
+	OperatingSystemVersion string `json:"operatingSystemVersion"`
+	// BootID is the boot ID of the node before the reboot.
+	BootID string `json:"bootID"`
+}
+
+func (r *Reconciler) readInPlaceUpdateReboot() (*inPlaceUpdateReboot, error) {
+	raw, err := r.FS.ReadFile(nodeagentv1alpha1.InPlaceUpdateRebootFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading file %q: %w", nodeagentv1alpha1.InPlaceUpdateRebootFilePath, err)
+	}
+
+	reboot := &inPlaceUpdateReboot{}
+	if err := yaml.Unmarshal(raw, reboot); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling file %q: %w", nodeagentv1alpha1.InPlaceUpdateRebootFilePath, err)
+	}
+	return reboot, nil
+}
+
+// bootedOperatingSystemVersion returns the VERSION_ID of the running operating system.
+func (r *Reconciler) bootedOperatingSystemVersion() (string, error) {
+	raw, err := r.FS.ReadFile(osReleaseFilePath)
+	if err != nil {
+		return "", fmt.Errorf("failed reading file %q: %w", osReleaseFilePath, err)
+	}
+
+	for _, line := range strings.Split(string(raw), "\n") {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(line), "VERSION_ID="); ok {
+			return strings.Trim(value, `"'`), nil
+		}
+	}
+
+	return "", fmt.Errorf("file %q does not contain VERSION_ID", osReleaseFilePath)
+}
+
+// completeInPlaceUpdate uncordons the node and releases its in-place update slot. The caller is responsible for
+// removing the in-place update label from the node.
+func (r *Reconciler) completeInPlaceUpdate(ctx context.Context, log logr.Logger, node *corev1.Node) error {
+	if node.Labels[nodeagentv1alpha1.LabelInPlaceUpdate] == nodeagentv1alpha1.InPlaceUpdateInProgress {
+		log.Info("Uncordoning node")
+		if err := r.Drainer.Uncordon(ctx, node); err != nil {
+			return fmt.Errorf("failed uncordoning node: %w", err)
+		}
+	}
+
+	if err := r.releaseInPlaceUpdateSlot(ctx, node); err != nil {
+		return fmt.Errorf("failed releasing in-place update slot: %w", err)
+	}
+
+	r.Recorder.Event(node, corev1.EventTypeNormal, "InPlaceUpdateCompleted", "In-place update has been completed successfully")
+	return nil
+}
+
+func (r *Reconciler) setInPlaceUpdateLabel(ctx context.Context, node *corev1.Node, value string) error {
+	if node.Labels[nodeagentv1alpha1.LabelInPlaceUpdate] == value {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataLabel(&node.ObjectMeta, nodeagentv1alpha1.LabelInPlaceUpdate, value)
+	return r.Client.Patch(ctx, node, patch)
+}
+
+// acquireInPlaceUpdateSlot registers the node in the in-place update Lease of its worker pool. Only as many nodes as
+// allowed by maxUnavailable are registered at the same time. Nodes which no longer belong to the worker pool are
+// removed from the Lease. Conflicts caused by concurrent updates of the Lease are not considered as errors, the
+// caller just has to retry.
+func (r *Reconciler) acquireInPlaceUpdateSlot(ctx context.Context, log logr.Logger, node *corev1.Node, maxUnavailable intstr.IntOrString) (bool, error) {
+	poolName := node.Labels[v1beta1constants.LabelWorkerPool]
+
+	nodeList := &corev1.NodeList{}
+	if err := r.APIReader.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: poolName}); err != nil {
+		return false, fmt.Errorf("failed listing nodes of worker pool %q: %w", poolName, err)
+	}
+
+	poolNodeNames := sets.New[string]()
+	for _, n := range nodeList.Items {
+		poolNodeNames.Insert(n.Name)
+	}
+
+	limit, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, len(nodeList.Items), false)
+	if err != nil {
+		return false, fmt.Errorf("failed computing maximum number of unavailable nodes: %w", err)
+	}
+	limit = max(limit, 1)
+
+	lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: inPlaceUpdateLeaseNamePrefix + poolName, Namespace: metav1.NamespaceSystem}}
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed reading in-place update lease: %w", err)
+		}
+
+		metav1.SetMetaDataAnnotation(&lease.ObjectMeta, nodeagentv1alpha1.AnnotationKeyInPlaceUpdateNodes, node.Name)
+		if err := r.Client.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed creating in-place update lease: %w", err)
+		}
+		return true, nil
+	}
+
+	updatingNodeNames := inPlaceUpdateNodeNames(lease).Intersection(poolNodeNames)
+	if updatingNodeNames.Has(node.Name) {
+		return true, nil
+	}
+
+	if updatingNodeNames.Len() >= limit {
+		log.Info("Maximum number of nodes which are updated in-place concurrently is reached, waiting", "maxUnavailable", limit, "nodes", sets.List(updatingNodeNames))
+		return false, nil
+	}
+
+	metav1.SetMetaDataAnnotation(&lease.ObjectMeta, nodeagentv1alpha1.AnnotationKeyInPlaceUpdateNodes, strings.Join(sets.List(updatingNodeNames.Insert(node.Name)), ","))
+	if err := r.Client.Update(ctx, lease); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed updating in-place update lease: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *Reconciler) releaseInPlaceUpdateSlot(ctx context.Context, node *corev1.Node) error {
+	lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: inPlaceUpdateLeaseNamePrefix + node.Labels[v1beta1constants.LabelWorkerPool], Namespace: metav1.NamespaceSystem}}
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	updatingNodeNames := inPlaceUpdateNodeNames(lease)
+	if !updatingNodeNames.Has(node.Name) {
+		return nil
+	}
+
+	metav1.SetMetaDataAnnotation(&lease.ObjectMeta, nodeagentv1alpha1.AnnotationKeyInPlaceUpdateNodes, strings.Join(sets.List(updatingNodeNames.Delete(node.Name)), ","))
+	return r.Client.Update(ctx, lease)
+}
+
+func inPlaceUpdateNodeNames(lease *coordinationv1.Lease) sets.Set[string] {
+	names := sets.New[string]()
+	for _, name := range strings.Split(lease.Annotations[nodeagentv1alpha1.AnnotationKeyInPlaceUpdateNodes], ",") {
+		if name != "" {
+			names.Insert(name)
+		}
+	}
+	return names
+}
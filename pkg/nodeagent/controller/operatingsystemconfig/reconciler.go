@@ -36,22 +36,25 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/drain"
 	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
+	"github.com/gardener/gardener/pkg/nodeagent/kernel"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-const lastAppliedOperatingSystemConfigFilePath = nodeagentv1alpha1.BaseDir + "/last-applied-osc.yaml"
-
 // Reconciler decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
 type Reconciler struct {
 	Client        client.Client
+	APIReader     client.Reader
 	Config        config.OperatingSystemConfigControllerConfig
 	Recorder      record.EventRecorder
 	DBus          dbus.DBus
 	FS            afero.Afero
 	Extractor     registry.Extractor
+	KernelManager *kernel.Manager
+	Drainer       *drain.Drainer
 	CancelContext context.CancelFunc
 	HostName      string
 	NodeName      string
@@ -94,6 +97,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	var inPlaceUpdate *inPlaceUpdate
+	if node != nil {
+		inPlaceUpdate, err = r.computeInPlaceUpdate(osc)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed computing in-place update: %w", err)
+		}
+	}
+
+	if inPlaceUpdate != nil {
+		log.Info("Node must be updated in-place", "operatingSystemUpdate", inPlaceUpdate.operatingSystem, "kubeletUpdate", inPlaceUpdate.kubelet)
+		started, err := r.prepareInPlaceUpdate(ctx, log, node, osc, inPlaceUpdate)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed preparing in-place update: %w", err)
+		}
+		if !started {
+			log.Info("Waiting for in-place update to be started, requeuing")
+			return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+	}
+
 	log.Info("Applying containerd configuration")
 	if err := r.ReconcileContainerdConfig(ctx, log, osc.Spec.CRIConfig); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed reconciling containerd configuration: %w", err)
@@ -138,6 +161,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
 	}
 
+	// The kernel configuration is applied after the units were started so that it takes precedence over settings
+	// applied by units (e.g., systemd-sysctl).
+	log.Info("Applying kernel configuration")
+	if err := r.applyKernelConfig(ctx, log, node, osc.Spec.KernelConfig); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed applying kernel configuration: %w", err)
+	}
+
 	// After the node is prepared, we can wait for the registries to be configured.
 	// The ones with readiness probes should also succeed here since their cache/mirror pods
 	// can now start as workload in the cluster.
@@ -158,9 +188,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"deletedUnits", len(oscChanges.units.deleted),
 	)
 
-	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
-	if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, oscRaw, 0644); err != nil {
-		return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	// The last applied operating system config is persisted only after the node runs the desired operating system
+	// version, i.e., after it has been rebooted successfully.
+	if inPlaceUpdate != nil && inPlaceUpdate.operatingSystem {
+		updated, err := r.updateOperatingSystem(ctx, log, node, osc)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating operating system: %w", err)
+		}
+		if !updated {
+			return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+	}
+
+	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err := r.FS.WriteFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, oscRaw, 0644); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
 	}
 
 	if mustRestartGardenerNodeAgent {
@@ -181,11 +223,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.FS.Remove(nodeagentv1alpha1.BootstrapTokenFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
 		return reconcile.Result{}, fmt.Errorf("failed removing bootstrap token file %q: %w", nodeagentv1alpha1.BootstrapTokenFilePath, err)
 	}
+	if err := r.FS.Remove(nodeagentv1alpha1.AttestedClientCertificateFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return reconcile.Result{}, fmt.Errorf("failed removing attested client certificate file %q: %w", nodeagentv1alpha1.AttestedClientCertificateFilePath, err)
+	}
+
+	if _, ok := node.Labels[nodeagentv1alpha1.LabelInPlaceUpdate]; ok {
+		log.Info("Completing in-place update")
+		if err := r.completeInPlaceUpdate(ctx, log, node); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed completing in-place update: %w", err)
+		}
+	}
+
+	kubernetesVersion := r.Config.KubernetesVersion.String()
+	if osc.Spec.InPlaceUpdates != nil {
+		kubernetesVersion = osc.Spec.InPlaceUpdates.KubeletVersion
+	}
 
 	r.Recorder.Event(node, corev1.EventTypeNormal, "OSCApplied", "Operating system config has been applied successfully")
 	patch := client.MergeFrom(node.DeepCopy())
-	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
+	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, kubernetesVersion)
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
+	delete(node.Labels, nodeagentv1alpha1.LabelInPlaceUpdate)
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyDrain)
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
 }
@@ -442,3 +501,29 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 
 	return mustRestartGardenerNodeAgent, flow.Parallel(fns...)(ctx)
 }
+
+func (r *Reconciler) applyKernelConfig(ctx context.Context, log logr.Logger, node *corev1.Node, kernelConfig *extensionsv1alpha1.KernelConfig) error {
+	result, err := r.KernelManager.Apply(ctx, kernelConfig)
+	if err != nil {
+		return err
+	}
+
+	for _, conflict := range result.Conflicts {
+		log.Info("Kernel configuration was changed by another tool, enforcing desired value", "conflict", conflict)
+		if node != nil {
+			r.Recorder.Event(node, corev1.EventTypeWarning, "KernelConfigConflict", conflict)
+		}
+	}
+
+	if len(result.RolledBack) > 0 {
+		log.Info("Restored original values of no longer configured kernel settings", "settings", result.RolledBack)
+	}
+
+	// Failures are not considered as hard errors since the remaining configuration shall still be applied. They are
+	// reported via the node condition maintained by the kernel config health check.
+	if len(result.Failures) > 0 {
+		log.Info("Kernel configuration could not be applied completely", "failures", result.Failures)
+	}
+
+	return nil
+}
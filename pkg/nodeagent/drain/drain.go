@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// DefaultTimeout is the default duration after which draining a node is given up and the caller proceeds.
+	DefaultTimeout = 2 * time.Minute
+	// DefaultPollInterval is the default interval in which pods are evicted again until the node is drained.
+	DefaultPollInterval = 5 * time.Second
+	// DefaultPreDrainHookTimeout is the default timeout for calling a pre-drain hook.
+	DefaultPreDrainHookTimeout = 30 * time.Second
+
+	// NodeConditionTerminating is the type of the Node condition which machine-controller-manager sets right before it
+	// drains the node of a machine which is deleted.
+	NodeConditionTerminating corev1.NodeConditionType = "Terminating"
+)
+
+// PreDrainHookRequest is the body of the POST request which is sent to pre-drain hooks.
+type PreDrainHookRequest struct {
+	// NodeName is the name of the node which is going to be drained.
+	NodeName string `json:"nodeName"`
+	// WorkerPool is the name of the worker pool the node belongs to.
+	WorkerPool string `json:"workerPool,omitempty"`
+}
+
+// Options configure how a node is drained.
+type Options struct {
+	// EvictionOrder is the order in which the pods of the node are evicted. Defaults to Parallel.
+	EvictionOrder *gardencorev1beta1.PodEvictionOrder
+	// Timeout is the maximum duration for draining the node. Afterwards, the remaining pods are deleted forcefully. If
+	// it is not set, draining the node is given up after the timeout of the Drainer.
+	Timeout *metav1.Duration
+	// MaxEvictRetries is the number of times the eviction of a pod is retried when it is rejected. Afterwards, the
+	// remaining pods are deleted forcefully.
+	MaxEvictRetries *int32
+}
+
+// OptionsForInPlaceUpdates returns the options for draining a node before it is updated in-place according to the
+// given operating system config.
+func OptionsForInPlaceUpdates(osc *extensionsv1alpha1.OperatingSystemConfig) Options {
+	var options Options
+
+	if inPlaceUpdates := osc.Spec.InPlaceUpdates; inPlaceUpdates != nil {
+		options.Timeout = inPlaceUpdates.MachineDrainTimeout
+		options.MaxEvictRetries = inPlaceUpdates.MaxEvictRetries
+	}
+	if drainPolicy := osc.Spec.DrainPolicy; drainPolicy != nil {
+		options.EvictionOrder = drainPolicy.EvictionOrder
+	}
+
+	return options
+}
+
+// OperatingSystemConfigForNode reads the operating system config of the node's worker pool from the secret in the
+// kube-system namespace which is also downloaded by gardener-node-agent.
+func OperatingSystemConfigForNode(ctx context.Context, reader client.Reader, node *corev1.Node) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	poolName := node.Labels[v1beta1constants.LabelWorkerPool]
+
+	secretList := &corev1.SecretList{}
+	if err := reader.List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{
+		v1beta1constants.GardenRole:      v1beta1constants.GardenRoleOperatingSystemConfig,
+		v1beta1constants.LabelWorkerPool: poolName,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing operating system config secrets of worker pool %q: %w", poolName, err)
+	}
+
+	if len(secretList.Items) != 1 {
+		return nil, fmt.Errorf("expected exactly one operating system config secret for worker pool %q but found %d", poolName, len(secretList.Items))
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := yaml.Unmarshal(secretList.Items[0].Data[nodeagentv1alpha1.DataKeyOperatingSystemConfig], osc); err != nil {
+		return nil, fmt.Errorf("failed decoding operating system config of worker pool %q: %w", poolName, err)
+	}
+
+	return osc, nil
+}
+
+// IsTerminating returns true if machine-controller-manager is about to drain the node because its machine is deleted.
+func IsTerminating(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == NodeConditionTerminating {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// Drainer cordons nodes and evicts their pods.
+type Drainer struct {
+	// Client is used to patch the node and to evict pods.
+	Client client.Client
+	// Reader is used to list the pods running on the node.
+	Reader client.Reader
+	// Recorder is used to report drain problems as events on the node.
+	Recorder record.EventRecorder
+	// Clock is used to determine whether the timeout is exceeded.
+	Clock clock.Clock
+	// Timeout is the duration after which draining the node is given up if the options do not configure a timeout. In
+	// this case, an event is recorded and Drain returns without error so that the caller can proceed.
+	Timeout time.Duration
+	// PollInterval is the interval in which pods are evicted again until the node is drained.
+	PollInterval time.Duration
+}
+
+// New returns a new Drainer with default timeout and poll interval.
+func New(c client.Client, reader client.Reader, recorder record.EventRecorder, clock clock.Clock) *Drainer {
+	return &Drainer{
+		Client:       c,
+		Reader:       reader,
+		Recorder:     recorder,
+		Clock:        clock,
+		Timeout:      DefaultTimeout,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// Cordon marks the node as unschedulable.
+func (d *Drainer) Cordon(ctx context.Context, node *corev1.Node) error {
+	return d.setUnschedulable(ctx, node, true)
+}
+
+// Uncordon marks the node as schedulable.
+func (d *Drainer) Uncordon(ctx context.Context, node *corev1.Node) error {
+	return d.setUnschedulable(ctx, node, false)
+}
+
+func (d *Drainer) setUnschedulable(ctx context.Context, node *corev1.Node, unschedulable bool) error {
+	if node.Spec.Unschedulable == unschedulable {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = unschedulable
+	return d.Client.Patch(ctx, node, patch)
+}
+
+// CallPreDrainHooks calls the given pre-drain hooks for the node. It returns an error if a hook fails, unless the
+// failure policy of the hook is Ignore.
+func (d *Drainer) CallPreDrainHooks(ctx context.Context, log logr.Logger, node *corev1.Node, hooks []gardencorev1beta1.PreDrainHook) error {
+	for _, hook := range hooks {
+		if err := d.callPreDrainHook(ctx, node, hook); err != nil {
+			if ptr.Deref(hook.FailurePolicy, gardencorev1beta1.PreDrainHookFailurePolicyFail) == gardencorev1beta1.PreDrainHookFailurePolicyIgnore {
+				log.Info("Pre-drain hook failed, ignoring", "hook", hook.Name, "reason", err.Error())
+				d.Recorder.Eventf(node, corev1.EventTypeWarning, "PreDrainHookFailed", "Pre-drain hook %q failed, ignoring: %v", hook.Name, err)
+				continue
+			}
+			d.Recorder.Eventf(node, corev1.EventTypeWarning, "PreDrainHookFailed", "Pre-drain hook %q failed: %v", hook.Name, err)
+			return fmt.Errorf("pre-drain hook %q failed: %w", hook.Name, err)
+		}
+		log.Info("Called pre-drain hook", "hook", hook.Name)
+	}
+
+	return nil
+}
+
+// Drain cordons the node and evicts all pods running on it, except for pods managed by DaemonSets and static pods.
+// Evictions rejected because of PodDisruptionBudgets are retried. If the given options configure a timeout or a maximum
+// number of eviction retries and they are exceeded, the pods which are currently evicted are deleted forcefully. With
+// the priority ascending eviction order, these are only the pods with the lowest priority, i.e., pods with a higher
+// priority are still evicted after them. Otherwise, draining the node is given up after the default timeout. Pre-drain
+// hooks are not called, see CallPreDrainHooks.
+func (d *Drainer) Drain(ctx context.Context, log logr.Logger, node *corev1.Node, options Options) error {
+	if err := d.Cordon(ctx, node); err != nil {
+		return fmt.Errorf("failed cordoning node: %w", err)
+	}
+
+	var (
+		start             = d.Clock.Now()
+		timeout           = d.Timeout
+		rejectedEvictions = make(map[types.UID]int32)
+	)
+
+	if options.Timeout != nil {
+		timeout = options.Timeout.Duration
+	}
+
+	for {
+		pods, err := PodsToEvict(ctx, d.Reader, node.Name)
+		if err != nil {
+			return err
+		}
+
+		if len(pods) == 0 {
+			log.Info("Node is drained")
+			return nil
+		}
+
+		podsToEvictNow := pods
+		if ptr.Deref(options.EvictionOrder, gardencorev1beta1.PodEvictionOrderParallel) == gardencorev1beta1.PodEvictionOrderPriorityAscending {
+			podsToEvictNow = podsWithLowestPriority(pods)
+		}
+
+		var forceDrainReason string
+		for _, pod := range podsToEvictNow {
+			if err := d.evict(ctx, &pod); err != nil {
+				if !apierrors.IsTooManyRequests(err) {
+					return fmt.Errorf("failed evicting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+				}
+
+				rejectedEvictions[pod.UID]++
+				if options.MaxEvictRetries != nil && rejectedEvictions[pod.UID] > *options.MaxEvictRetries {
+					forceDrainReason = fmt.Sprintf("eviction of pod %s was rejected more than %d time(s)", client.ObjectKeyFromObject(&pod), *options.MaxEvictRetries)
+					break
+				}
+				log.Info("Eviction of pod is currently not allowed, retrying", "pod", client.ObjectKeyFromObject(&pod), "reason", err.Error())
+			}
+		}
+
+		if forceDrainReason == "" && d.Clock.Since(start) >= timeout {
+			if options.Timeout == nil {
+				msg := fmt.Sprintf("Node could not be drained within %s, %d pod(s) are still running, proceeding", timeout, len(pods))
+				log.Info(msg)
+				d.Recorder.Event(node, corev1.EventTypeWarning, "DrainTimeout", msg)
+				return nil
+			}
+			forceDrainReason = fmt.Sprintf("node could not be drained within %s", timeout)
+		}
+
+		if forceDrainReason != "" {
+			if err := d.forceDrain(ctx, log, node, podsToEvictNow, forceDrainReason); err != nil {
+				return err
+			}
+
+			// Pods with a higher priority are evicted once the forcefully deleted pods are gone, hence the drain continues.
+			if len(podsToEvictNow) == len(pods) {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-d.Clock.After(d.PollInterval):
+		}
+	}
+}
+
+// forceDrain deletes the given pods without respecting their PodDisruptionBudgets. Pods which are already being deleted
+// are skipped.
+func (d *Drainer) forceDrain(ctx context.Context, log logr.Logger, node *corev1.Node, pods []corev1.Pod, reason string) error {
+	pods = slices.DeleteFunc(slices.Clone(pods), func(pod corev1.Pod) bool { return pod.DeletionTimestamp != nil })
+	if len(pods) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("Draining node forcefully because %s, deleting %d pod(s)", reason, len(pods))
+	log.Info(msg)
+	d.Recorder.Event(node, corev1.EventTypeWarning, "ForceDrain", msg)
+
+	for _, pod := range pods {
+		if err := d.Client.Delete(ctx, &pod); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+		}
+	}
+
+	return nil
+}
+
+func (d *Drainer) callPreDrainHook(ctx context.Context, node *corev1.Node, hook gardencorev1beta1.PreDrainHook) error {
+	body, err := json.Marshal(PreDrainHookRequest{
+		NodeName:   node.Name,
+		WorkerPool: node.Labels[v1beta1constants.LabelWorkerPool],
+	})
+	if err != nil {
+		return fmt.Errorf("failed marshalling request: %w", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, ptr.Deref(hook.Timeout, metav1.Duration{Duration: DefaultPreDrainHookTimeout}).Duration)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(timeoutCtx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if hook.CABundle != nil {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM([]byte(*hook.CABundle)) {
+			return fmt.Errorf("failed parsing CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+
+	httpClient := &http.Client{Transport: transport}
+	defer httpClient.CloseIdleConnections()
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %q", response.Status)
+	}
+	return nil
+}
+
+// PodsToEvict returns the pods running on the given node which are evicted when the node is drained, i.e., all pods
+// except for pods managed by DaemonSets, static pods and pods which are already terminated.
+func PodsToEvict(ctx context.Context, reader client.Reader, nodeName string) ([]corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := reader.List(ctx, podList, client.MatchingFields{indexer.PodNodeName: nodeName}); err != nil {
+		return nil, fmt.Errorf("failed listing pods on node: %w", err)
+	}
+
+	var pods []corev1.Pod
+	for _, pod := range podList.Items {
+		if isDaemonSetPod(pod) || isMirrorPod(pod) || isTerminated(pod) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+func (d *Drainer) evict(ctx context.Context, pod *corev1.Pod) error {
+	eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}
+	if err := d.Client.SubResource("eviction").Create(ctx, pod, eviction); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// podsWithLowestPriority returns the pods of the given list which have the lowest priority.
+func podsWithLowestPriority(pods []corev1.Pod) []corev1.Pod {
+	var (
+		lowestPriority = ptr.Deref(pods[0].Spec.Priority, 0)
+		result         []corev1.Pod
+	)
+
+	for _, pod := range pods {
+		priority := ptr.Deref(pod.Spec.Priority, 0)
+		if priority < lowestPriority {
+			lowestPriority, result = priority, nil
+		}
+		if priority == lowestPriority {
+			result = append(result, pod)
+		}
+	}
+
+	return result
+}
+
+func isDaemonSetPod(pod corev1.Pod) bool {
+	controllerRef := metav1.GetControllerOf(&pod)
+	return controllerRef != nil && controllerRef.Kind == "DaemonSet"
+}
+
+func isMirrorPod(pod corev1.Pod) bool {
+	_, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]
+	return ok
+}
+
+func isTerminated(pod corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
@@ -0,0 +1,419 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/nodeagent/drain"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Drainer", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		builder    *fakeclient.ClientBuilder
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		drainer    *Drainer
+
+		node *corev1.Node
+	)
+
+	newPod := func(name, nodeName string, mutate func(*corev1.Pod)) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+		}
+		if mutate != nil {
+			mutate(pod)
+		}
+		return pod
+	}
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+
+		builder = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			WithObjects(node)
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = builder.Build()
+		drainer = New(fakeClient, fakeClient, recorder, clock.RealClock{})
+		drainer.PollInterval = 10 * time.Millisecond
+		drainer.Timeout = 50 * time.Millisecond
+	})
+
+	Describe("#Cordon, #Uncordon", func() {
+		It("should mark the node as unschedulable and schedulable again", func() {
+			Expect(drainer.Cordon(ctx, node)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+
+			Expect(drainer.Uncordon(ctx, node)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+		})
+	})
+
+	Describe("#Drain", func() {
+		var (
+			regularPod, daemonSetPod, mirrorPod, completedPod, otherNodePod *corev1.Pod
+		)
+
+		BeforeEach(func() {
+			regularPod = newPod("regular", node.Name, nil)
+			daemonSetPod = newPod("daemonset", node.Name, func(pod *corev1.Pod) {
+				pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", UID: "1", Controller: ptr.To(true)}}
+			})
+			mirrorPod = newPod("mirror", node.Name, func(pod *corev1.Pod) {
+				pod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "foo"}
+			})
+			completedPod = newPod("completed", node.Name, func(pod *corev1.Pod) {
+				pod.Status.Phase = corev1.PodSucceeded
+			})
+			otherNodePod = newPod("other", "other-node", nil)
+
+			builder.WithObjects(regularPod, daemonSetPod, mirrorPod, completedPod, otherNodePod)
+		})
+
+		It("should cordon the node and evict all pods except for daemon set, mirror and completed pods", func() {
+			Expect(drainer.Drain(ctx, log, node, Options{})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(regularPod), regularPod)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(daemonSetPod), daemonSetPod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mirrorPod), mirrorPod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(completedPod), completedPod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherNodePod), otherNodePod)).To(Succeed())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		Context("eviction is blocked by a pod disruption budget", func() {
+			var attempts int
+
+			BeforeEach(func() {
+				attempts = 0
+				builder.WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceCreate: func(_ context.Context, _ client.Client, _ string, _ client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+						attempts++
+						return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+					},
+				})
+			})
+
+			It("should retry the eviction and proceed with an event after the timeout", func() {
+				Expect(drainer.Drain(ctx, log, node, Options{})).To(Succeed())
+
+				Expect(attempts).To(BeNumerically(">", 1))
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(regularPod), regularPod)).To(Succeed())
+				Expect(recorder.Events).To(Receive(ContainSubstring("DrainTimeout")))
+			})
+		})
+
+		Context("drain options", func() {
+			var options Options
+
+			BeforeEach(func() {
+				options = Options{}
+			})
+
+			Context("eviction is blocked by a pod disruption budget", func() {
+				BeforeEach(func() {
+					builder.WithInterceptorFuncs(interceptor.Funcs{
+						SubResourceCreate: func(_ context.Context, _ client.Client, _ string, _ client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+							return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+						},
+					})
+				})
+
+				It("should drain the node forcefully after the timeout", func() {
+					options.Timeout = &metav1.Duration{Duration: 30 * time.Millisecond}
+
+					Expect(drainer.Drain(ctx, log, node, options)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(regularPod), regularPod)).To(BeNotFoundError())
+					Expect(recorder.Events).To(Receive(And(ContainSubstring("ForceDrain"), ContainSubstring("node could not be drained within 30ms"))))
+				})
+
+				It("should drain the node forcefully after the maximum number of eviction retries", func() {
+					options.MaxEvictRetries = ptr.To[int32](0)
+
+					Expect(drainer.Drain(ctx, log, node, options)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(regularPod), regularPod)).To(BeNotFoundError())
+					Expect(recorder.Events).To(Receive(And(ContainSubstring("ForceDrain"), ContainSubstring("eviction of pod default/regular was rejected more than 0 time(s)"))))
+				})
+			})
+
+			Context("priority ascending eviction order", func() {
+				var (
+					highPriorityPod *corev1.Pod
+					evictedPods     []string
+					blockedPods     sets.Set[string]
+				)
+
+				BeforeEach(func() {
+					options.EvictionOrder = ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending)
+
+					regularPod.Spec.Priority = ptr.To[int32](1)
+					highPriorityPod = newPod("high-priority", node.Name, func(pod *corev1.Pod) {
+						pod.Spec.Priority = ptr.To[int32](1000)
+					})
+					builder.WithObjects(highPriorityPod)
+
+					evictedPods, blockedPods = nil, sets.New[string]()
+					builder.WithInterceptorFuncs(interceptor.Funcs{
+						SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+							evictedPods = append(evictedPods, obj.GetName())
+							if blockedPods.Has(obj.GetName()) {
+								return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+							}
+							return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+						},
+					})
+				})
+
+				It("should evict pods with a higher priority only after pods with a lower priority are gone", func() {
+					Expect(drainer.Drain(ctx, log, node, options)).To(Succeed())
+
+					Expect(evictedPods).To(Equal([]string{"regular", "high-priority"}))
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(highPriorityPod), highPriorityPod)).To(BeNotFoundError())
+				})
+
+				It("should not evict pods with a higher priority while pods with a lower priority are still running", func() {
+					blockedPods.Insert("regular")
+
+					Expect(drainer.Drain(ctx, log, node, options)).To(Succeed())
+
+					Expect(evictedPods).NotTo(ContainElement("high-priority"))
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(highPriorityPod), highPriorityPod)).To(Succeed())
+					Expect(recorder.Events).To(Receive(ContainSubstring("DrainTimeout")))
+				})
+
+				It("should only delete the pods with the lowest priority forcefully after the maximum number of eviction retries", func() {
+					blockedPods.Insert("regular")
+					options.MaxEvictRetries = ptr.To[int32](0)
+
+					Expect(drainer.Drain(ctx, log, node, options)).To(Succeed())
+
+					Expect(evictedPods).To(Equal([]string{"regular", "high-priority"}))
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(regularPod), regularPod)).To(BeNotFoundError())
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(highPriorityPod), highPriorityPod)).To(BeNotFoundError())
+					Expect(recorder.Events).To(Receive(And(ContainSubstring("ForceDrain"), ContainSubstring("deleting 1 pod(s)"))))
+					Expect(recorder.Events).To(BeEmpty())
+				})
+
+				It("should drain the pods forcefully in ascending order of their priority after the timeout", func() {
+					blockedPods.Insert("regular", "high-priority")
+					options.Timeout = &metav1.Duration{Duration: 30 * time.Millisecond}
+
+					Expect(drainer.Drain(ctx, log, node, options)).To(Succeed())
+
+					firstHighPriorityEviction := slices.Index(evictedPods, "high-priority")
+					Expect(firstHighPriorityEviction).To(BeNumerically(">", 0))
+					Expect(evictedPods[firstHighPriorityEviction:]).NotTo(ContainElement("regular"))
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(regularPod), regularPod)).To(BeNotFoundError())
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(highPriorityPod), highPriorityPod)).To(BeNotFoundError())
+					Expect(recorder.Events).To(Receive(And(ContainSubstring("ForceDrain"), ContainSubstring("deleting 1 pod(s)"))))
+					Expect(recorder.Events).To(Receive(And(ContainSubstring("ForceDrain"), ContainSubstring("deleting 1 pod(s)"))))
+				})
+			})
+		})
+
+		Context("eviction fails", func() {
+			BeforeEach(func() {
+				builder.WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceCreate: func(_ context.Context, _ client.Client, _ string, _ client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+						return apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "regular", nil)
+					},
+				})
+			})
+
+			It("should return the error", func() {
+				Expect(drainer.Drain(ctx, log, node, Options{})).To(MatchError(ContainSubstring("failed evicting pod default/regular")))
+			})
+		})
+	})
+
+	Describe("#OptionsForInPlaceUpdates", func() {
+		It("should return empty options if there is neither an in-place update configuration nor a drain policy", func() {
+			Expect(OptionsForInPlaceUpdates(&extensionsv1alpha1.OperatingSystemConfig{})).To(Equal(Options{}))
+		})
+
+		It("should return the options from the in-place update configuration and the drain policy", func() {
+			Expect(OptionsForInPlaceUpdates(&extensionsv1alpha1.OperatingSystemConfig{
+				Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+					InPlaceUpdates: &extensionsv1alpha1.InPlaceUpdates{
+						MachineDrainTimeout: &metav1.Duration{Duration: time.Minute},
+						MaxEvictRetries:     ptr.To[int32](5),
+					},
+					DrainPolicy: &gardencorev1beta1.WorkerDrainPolicy{EvictionOrder: ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending)},
+				},
+			})).To(Equal(Options{
+				EvictionOrder:   ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending),
+				Timeout:         &metav1.Duration{Duration: time.Minute},
+				MaxEvictRetries: ptr.To[int32](5),
+			}))
+		})
+	})
+
+	Describe("#OperatingSystemConfigForNode", func() {
+		newSecret := func(name, poolName string, osc *extensionsv1alpha1.OperatingSystemConfig) *corev1.Secret {
+			data, err := yaml.Marshal(osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "kube-system",
+					Labels: map[string]string{
+						"gardener.cloud/role":        "operating-system-config",
+						"worker.gardener.cloud/pool": poolName,
+					},
+				},
+				Data: map[string][]byte{"osc.yaml": data},
+			}
+		}
+
+		var osc *extensionsv1alpha1.OperatingSystemConfig
+
+		BeforeEach(func() {
+			osc = &extensionsv1alpha1.OperatingSystemConfig{Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				DrainPolicy: &gardencorev1beta1.WorkerDrainPolicy{EvictionOrder: ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending)},
+			}}
+
+			builder.WithObjects(
+				newSecret("osc-pool", "pool", osc),
+				newSecret("osc-other", "other", &extensionsv1alpha1.OperatingSystemConfig{}),
+			)
+		})
+
+		It("should return the operating system config of the node's worker pool", func() {
+			node.Labels = map[string]string{"worker.gardener.cloud/pool": "pool"}
+
+			Expect(OperatingSystemConfigForNode(ctx, fakeClient, node)).To(Equal(osc))
+		})
+
+		It("should fail if there is no operating system config secret for the node's worker pool", func() {
+			node.Labels = map[string]string{"worker.gardener.cloud/pool": "unknown"}
+
+			Expect(OperatingSystemConfigForNode(ctx, fakeClient, node)).Error().To(MatchError(`expected exactly one operating system config secret for worker pool "unknown" but found 0`))
+		})
+	})
+
+	Describe("#IsTerminating", func() {
+		It("should return false if the node does not have the terminating condition", func() {
+			Expect(IsTerminating(node)).To(BeFalse())
+		})
+
+		It("should return false if the terminating condition is not true", func() {
+			node.Status.Conditions = []corev1.NodeCondition{{Type: "Terminating", Status: corev1.ConditionFalse}}
+			Expect(IsTerminating(node)).To(BeFalse())
+		})
+
+		It("should return true if the terminating condition is true", func() {
+			node.Status.Conditions = []corev1.NodeCondition{{Type: "Ready", Status: corev1.ConditionTrue}, {Type: "Terminating", Status: corev1.ConditionTrue}}
+			Expect(IsTerminating(node)).To(BeTrue())
+		})
+	})
+
+	Describe("#CallPreDrainHooks", func() {
+		var (
+			server   *httptest.Server
+			caBundle string
+			requests []PreDrainHookRequest
+			status   int
+			hooks    []gardencorev1beta1.PreDrainHook
+		)
+
+		BeforeEach(func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, "worker.gardener.cloud/pool", "pool")
+
+			requests, status = nil, http.StatusOK
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				request := PreDrainHookRequest{}
+				Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+				requests = append(requests, request)
+				w.WriteHeader(status)
+			}))
+			DeferCleanup(server.Close)
+
+			caBundle = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+			hooks = []gardencorev1beta1.PreDrainHook{{
+				Name:     "notify",
+				URL:      server.URL,
+				CABundle: &caBundle,
+			}}
+		})
+
+		It("should call the hooks", func() {
+			Expect(drainer.CallPreDrainHooks(ctx, log, node, hooks)).To(Succeed())
+
+			Expect(requests).To(ConsistOf(PreDrainHookRequest{NodeName: "node", WorkerPool: "pool"}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should fail if a hook fails", func() {
+			status = http.StatusInternalServerError
+
+			Expect(drainer.CallPreDrainHooks(ctx, log, node, hooks)).To(MatchError(ContainSubstring(`pre-drain hook "notify" failed: unexpected response status "500 Internal Server Error"`)))
+			Expect(recorder.Events).To(Receive(ContainSubstring("PreDrainHookFailed")))
+		})
+
+		It("should succeed if a hook fails and its failure policy is Ignore", func() {
+			status = http.StatusInternalServerError
+			hooks[0].FailurePolicy = ptr.To(gardencorev1beta1.PreDrainHookFailurePolicyIgnore)
+
+			Expect(drainer.CallPreDrainHooks(ctx, log, node, hooks)).To(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring("PreDrainHookFailed")))
+		})
+
+		It("should fail if the serving certificate of the hook cannot be verified", func() {
+			hooks[0].CABundle = nil
+
+			Expect(drainer.CallPreDrainHooks(ctx, log, node, hooks)).To(MatchError(ContainSubstring("certificate signed by unknown authority")))
+			Expect(requests).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,349 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kernel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// ProcSysDir is the directory of the /proc/sys file system containing the kernel parameters.
+	ProcSysDir = "/proc/sys"
+	// HugePagesDir is the directory containing the huge pages configuration per page size.
+	HugePagesDir = "/sys/kernel/mm/hugepages"
+	// ModulesDir is the directory containing the loaded kernel modules.
+	ModulesDir = "/sys/module"
+	// ModulesLoadFilePath is the file path of the configuration for loading the kernel modules at boot.
+	ModulesLoadFilePath = "/etc/modules-load.d/gardener-node-agent.conf"
+	// ModprobeFilePath is the file path of the configuration for the kernel module parameters.
+	ModprobeFilePath = "/etc/modprobe.d/gardener-node-agent.conf"
+	// StateFilePath is the file path of the state of the kernel configuration applied by gardener-node-agent. It is
+	// used to detect conflicts with other tools and to restore the original values of no longer configured settings.
+	StateFilePath = nodeagentv1alpha1.BaseDir + "/kernel-config-state.yaml"
+
+	fileHeader = "# managed by gardener-node-agent\n"
+)
+
+var sysctlNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+([./][a-zA-Z0-9_-]+)*$`)
+
+// Result contains the result of applying a kernel configuration.
+type Result struct {
+	// Conflicts contains descriptions of settings which were changed by other tools since they were applied last. The
+	// desired values are enforced again.
+	Conflicts []string
+	// Failures contains descriptions of settings which could not be applied or verified.
+	Failures []string
+	// RolledBack contains the names of settings which are no longer configured and were restored to their original
+	// values.
+	RolledBack []string
+}
+
+// Manager applies and verifies kernel configurations.
+type Manager struct {
+	fs afero.Afero
+
+	// LoadModule loads the kernel module with the given name. Exposed for testing.
+	LoadModule func(ctx context.Context, name string) error
+}
+
+// NewManager creates a new manager for kernel configurations.
+func NewManager(fs afero.Afero) *Manager {
+	return &Manager{
+		fs: fs,
+		LoadModule: func(ctx context.Context, name string) error {
+			if out, err := exec.CommandContext(ctx, "modprobe", name).CombinedOutput(); err != nil {
+				return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+			}
+			return nil
+		},
+	}
+}
+
+type setting struct {
+	Applied  string `json:"applied"`
+	Original string `json:"original"`
+}
+
+type state struct {
+	Sysctls   map[string]setting `json:"sysctls,omitempty"`
+	HugePages map[string]setting `json:"hugePages,omitempty"`
+}
+
+// Apply applies the given kernel configuration and verifies it by reading back the effective values from the kernel.
+// Settings which were applied previously but are no longer part of the configuration are restored to the values they
+// had before they were applied first. A nil configuration rolls back all previously applied settings.
+func (m *Manager) Apply(ctx context.Context, config *extensionsv1alpha1.KernelConfig) (*Result, error) {
+	if config == nil {
+		config = &extensionsv1alpha1.KernelConfig{}
+	}
+
+	st, err := m.readState()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+
+	desiredSysctls := make(map[string]string, len(config.Sysctls))
+	for name, value := range config.Sysctls {
+		if !sysctlNameRegex.MatchString(name) {
+			result.Failures = append(result.Failures, fmt.Sprintf("sysctl %s: invalid name", name))
+			continue
+		}
+		desiredSysctls[name] = value
+	}
+	m.applySettings(st.Sysctls, desiredSysctls, "sysctl", sysctlPath, result)
+
+	desiredHugePages := make(map[string]string, len(config.HugePages))
+	for _, hugePages := range config.HugePages {
+		desiredHugePages[hugePagesName(hugePages)] = strconv.Itoa(int(hugePages.Count))
+	}
+	m.applySettings(st.HugePages, desiredHugePages, "huge pages", hugePagesPath, result)
+
+	if err := m.applyModules(ctx, config.Modules, result); err != nil {
+		return nil, err
+	}
+
+	if err := m.writeState(st); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(result.Conflicts)
+	slices.Sort(result.Failures)
+	slices.Sort(result.RolledBack)
+
+	return result, nil
+}
+
+// HasState returns true if gardener-node-agent has applied a kernel configuration which was not rolled back so far.
+func (m *Manager) HasState() (bool, error) {
+	return m.fs.Exists(StateFilePath)
+}
+
+func (m *Manager) applySettings(applied map[string]setting, desired map[string]string, kind string, pathFn func(string) string, result *Result) {
+	for _, name := range sets.List(sets.KeySet(applied).Difference(sets.KeySet(desired))) {
+		if err := m.fs.WriteFile(pathFn(name), []byte(applied[name].Original), 0644); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			result.Failures = append(result.Failures, fmt.Sprintf("%s %s: failed restoring original value: %v", kind, name, err))
+			continue
+		}
+		result.RolledBack = append(result.RolledBack, fmt.Sprintf("%s %s", kind, name))
+		delete(applied, name)
+	}
+
+	for _, name := range sets.List(sets.KeySet(desired)) {
+		filePath, value := pathFn(name), desired[name]
+
+		current, err := m.read(filePath)
+		if err != nil {
+			result.Failures = append(result.Failures, fmt.Sprintf("%s %s: %v", kind, name, err))
+			continue
+		}
+
+		previous, ok := applied[name]
+		if !ok {
+			previous = setting{Original: current}
+		} else if current != previous.Applied && current != normalize(value) {
+			result.Conflicts = append(result.Conflicts, fmt.Sprintf("%s %s: value was changed from %q to %q", kind, name, previous.Applied, current))
+		}
+
+		if current != normalize(value) {
+			if err := m.fs.WriteFile(filePath, []byte(value), 0644); err != nil {
+				result.Failures = append(result.Failures, fmt.Sprintf("%s %s: failed writing value: %v", kind, name, err))
+				continue
+			}
+
+			if current, err = m.read(filePath); err != nil {
+				result.Failures = append(result.Failures, fmt.Sprintf("%s %s: %v", kind, name, err))
+				continue
+			}
+			if current != normalize(value) {
+				result.Failures = append(result.Failures, fmt.Sprintf("%s %s: expected %q, but kernel reports %q", kind, name, normalize(value), current))
+			}
+		}
+
+		applied[name] = setting{Applied: normalize(value), Original: previous.Original}
+	}
+}
+
+func (m *Manager) applyModules(ctx context.Context, modules []extensionsv1alpha1.KernelModule, result *Result) error {
+	var modulesLoad, modprobe strings.Builder
+	for _, module := range modules {
+		modulesLoad.WriteString(module.Name + "\n")
+
+		if len(module.Parameters) > 0 {
+			modprobe.WriteString("options " + module.Name)
+			for _, key := range sets.List(sets.KeySet(module.Parameters)) {
+				modprobe.WriteString(" " + key + "=" + module.Parameters[key])
+			}
+			modprobe.WriteString("\n")
+		}
+	}
+
+	if err := m.writeOrRemoveFile(ModprobeFilePath, modprobe.String()); err != nil {
+		return err
+	}
+	if err := m.writeOrRemoveFile(ModulesLoadFilePath, modulesLoad.String()); err != nil {
+		return err
+	}
+
+	for _, module := range modules {
+		moduleDir := path.Join(ModulesDir, module.Name)
+
+		loaded, err := m.fs.DirExists(moduleDir)
+		if err != nil {
+			return fmt.Errorf("failed checking if kernel module %s is loaded: %w", module.Name, err)
+		}
+
+		if !loaded {
+			if err := m.LoadModule(ctx, module.Name); err != nil {
+				result.Failures = append(result.Failures, fmt.Sprintf("kernel module %s: failed loading: %v", module.Name, err))
+				continue
+			}
+			if loaded, err = m.fs.DirExists(moduleDir); err != nil {
+				return fmt.Errorf("failed checking if kernel module %s is loaded: %w", module.Name, err)
+			}
+			if !loaded {
+				result.Failures = append(result.Failures, fmt.Sprintf("kernel module %s: not loaded", module.Name))
+				continue
+			}
+		}
+
+		// Parameters only take effect when a module is loaded. Hence, differences can only be reported.
+		for _, key := range sets.List(sets.KeySet(module.Parameters)) {
+			current, err := m.read(path.Join(moduleDir, "parameters", key))
+			if err != nil {
+				// Not all parameters are exposed via the sys file system.
+				continue
+			}
+			if current != normalize(module.Parameters[key]) {
+				result.Conflicts = append(result.Conflicts, fmt.Sprintf("kernel module %s: parameter %s is %q instead of %q, reboot the node or reload the module to apply it", module.Name, key, current, module.Parameters[key]))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) writeOrRemoveFile(filePath, content string) error {
+	if len(content) == 0 {
+		if err := m.fs.Remove(filePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing file %s: %w", filePath, err)
+		}
+		return nil
+	}
+
+	content = fileHeader + content
+	if current, err := m.fs.ReadFile(filePath); err == nil && string(current) == content {
+		return nil
+	}
+
+	if err := m.fs.MkdirAll(path.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed creating directory for file %s: %w", filePath, err)
+	}
+	if err := m.fs.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed writing file %s: %w", filePath, err)
+	}
+	return nil
+}
+
+func (m *Manager) read(filePath string) (string, error) {
+	content, err := m.fs.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return "", fmt.Errorf("not supported by the kernel (%s does not exist)", filePath)
+		}
+		return "", fmt.Errorf("failed reading %s: %w", filePath, err)
+	}
+	return normalize(string(content)), nil
+}
+
+func (m *Manager) readState() (*state, error) {
+	st := &state{}
+
+	content, err := m.fs.ReadFile(StateFilePath)
+	if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return nil, fmt.Errorf("failed reading kernel config state from %s: %w", StateFilePath, err)
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(content, st); err != nil {
+			return nil, fmt.Errorf("failed decoding kernel config state from %s: %w", StateFilePath, err)
+		}
+	}
+
+	if st.Sysctls == nil {
+		st.Sysctls = map[string]setting{}
+	}
+	if st.HugePages == nil {
+		st.HugePages = map[string]setting{}
+	}
+	return st, nil
+}
+
+func (m *Manager) writeState(st *state) error {
+	if len(st.Sysctls) == 0 && len(st.HugePages) == 0 {
+		if err := m.fs.Remove(StateFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing kernel config state file %s: %w", StateFilePath, err)
+		}
+		return nil
+	}
+
+	content, err := yaml.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("failed encoding kernel config state: %w", err)
+	}
+	if err := m.fs.MkdirAll(path.Dir(StateFilePath), 0755); err != nil {
+		return fmt.Errorf("failed creating directory for kernel config state file: %w", err)
+	}
+	if err := m.fs.WriteFile(StateFilePath, content, 0600); err != nil {
+		return fmt.Errorf("failed writing kernel config state to %s: %w", StateFilePath, err)
+	}
+	return nil
+}
+
+// sysctlPath returns the path of the given sysctl in the /proc/sys file system. Like sysctl(8), names containing dots
+// use them as separators while slashes denote literal dots (e.g., in interface names like 'eth0.100').
+func sysctlPath(name string) string {
+	if strings.Contains(name, ".") {
+		name = strings.Map(func(r rune) rune {
+			switch r {
+			case '.':
+				return '/'
+			case '/':
+				return '.'
+			}
+			return r
+		}, name)
+	}
+	return path.Join(ProcSysDir, name)
+}
+
+func hugePagesName(hugePages extensionsv1alpha1.HugePages) string {
+	return fmt.Sprintf("hugepages-%dkB", hugePages.PageSize.Value()/1024)
+}
+
+func hugePagesPath(name string) string {
+	return path.Join(HugePagesDir, name, "nr_hugepages")
+}
+
+// normalize trims the given value and collapses whitespaces since the kernel reports values consisting of multiple
+// fields separated by tabs.
+func normalize(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
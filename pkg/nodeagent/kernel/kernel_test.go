@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kernel_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/kernel"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Kernel", func() {
+	var (
+		ctx     = context.Background()
+		fakeFS  afero.Afero
+		manager *Manager
+		config  *extensionsv1alpha1.KernelConfig
+
+		loadedModules []string
+	)
+
+	const (
+		somaxconnPath  = "/proc/sys/net/core/somaxconn"
+		vlanPath       = "/proc/sys/net/ipv4/conf/eth0.100/forwarding"
+		hugePages2Mi   = "/sys/kernel/mm/hugepages/hugepages-2048kB/nr_hugepages"
+		conntrackParam = "/sys/module/nf_conntrack/parameters/hashsize"
+	)
+
+	BeforeEach(func() {
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		loadedModules = nil
+
+		manager = NewManager(fakeFS)
+		manager.LoadModule = func(_ context.Context, name string) error {
+			if name == "unknown" {
+				return fmt.Errorf("module not found")
+			}
+			loadedModules = append(loadedModules, name)
+			return fakeFS.MkdirAll("/sys/module/"+name, 0755)
+		}
+
+		Expect(fakeFS.WriteFile(somaxconnPath, []byte("4096\n"), 0644)).To(Succeed())
+		Expect(fakeFS.WriteFile(vlanPath, []byte("0\n"), 0644)).To(Succeed())
+		Expect(fakeFS.WriteFile(hugePages2Mi, []byte("0\n"), 0644)).To(Succeed())
+
+		config = &extensionsv1alpha1.KernelConfig{
+			Sysctls: map[string]string{
+				"net.core.somaxconn":                "65535",
+				"net.ipv4.conf.eth0/100.forwarding": "1",
+			},
+			Modules: []extensionsv1alpha1.KernelModule{
+				{Name: "br_netfilter"},
+				{Name: "nf_conntrack", Parameters: map[string]string{"hashsize": "131072", "expect_hashsize": "1024"}},
+			},
+			HugePages: []extensionsv1alpha1.HugePages{{PageSize: resource.MustParse("2Mi"), Count: 512}},
+		}
+	})
+
+	Describe("#Apply", func() {
+		It("should apply and verify the kernel config", func() {
+			Expect(manager.Apply(ctx, config)).To(Equal(&Result{}))
+
+			test.AssertFileOnDisk(fakeFS, somaxconnPath, "65535", 0644)
+			test.AssertFileOnDisk(fakeFS, vlanPath, "1", 0644)
+			test.AssertFileOnDisk(fakeFS, hugePages2Mi, "512", 0644)
+			test.AssertFileOnDisk(fakeFS, "/etc/modules-load.d/gardener-node-agent.conf", "# managed by gardener-node-agent\nbr_netfilter\nnf_conntrack\n", 0644)
+			test.AssertFileOnDisk(fakeFS, "/etc/modprobe.d/gardener-node-agent.conf", "# managed by gardener-node-agent\noptions nf_conntrack expect_hashsize=1024 hashsize=131072\n", 0644)
+			Expect(loadedModules).To(ConsistOf("br_netfilter", "nf_conntrack"))
+			Expect(manager.HasState()).To(BeTrue())
+		})
+
+		It("should report settings which are not supported by the kernel", func() {
+			config.Sysctls["net.foo.bar"] = "1"
+			config.HugePages = append(config.HugePages, extensionsv1alpha1.HugePages{PageSize: resource.MustParse("1Gi"), Count: 1})
+			config.Modules = append(config.Modules, extensionsv1alpha1.KernelModule{Name: "unknown"})
+
+			result, err := manager.Apply(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf(
+				ContainSubstring("sysctl net.foo.bar: not supported by the kernel"),
+				ContainSubstring("huge pages hugepages-1048576kB: not supported by the kernel"),
+				"kernel module unknown: failed loading: module not found",
+			))
+		})
+
+		It("should detect and remediate values changed by other tools", func() {
+			Expect(manager.Apply(ctx, config)).To(Equal(&Result{}))
+
+			Expect(fakeFS.WriteFile(somaxconnPath, []byte("1024\n"), 0644)).To(Succeed())
+
+			result, err := manager.Apply(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Conflicts).To(ConsistOf(`sysctl net.core.somaxconn: value was changed from "65535" to "1024"`))
+			test.AssertFileOnDisk(fakeFS, somaxconnPath, "65535", 0644)
+		})
+
+		It("should report kernel module parameters which differ from the loaded module", func() {
+			Expect(fakeFS.WriteFile(conntrackParam, []byte("65536\n"), 0644)).To(Succeed())
+
+			result, err := manager.Apply(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loadedModules).To(ConsistOf("br_netfilter"))
+			Expect(result.Conflicts).To(ConsistOf(ContainSubstring(`kernel module nf_conntrack: parameter hashsize is "65536" instead of "131072"`)))
+		})
+
+		It("should restore the original values of no longer configured settings", func() {
+			Expect(manager.Apply(ctx, config)).To(Equal(&Result{}))
+
+			delete(config.Sysctls, "net.core.somaxconn")
+			config.HugePages = nil
+			config.Modules = config.Modules[:1]
+
+			result, err := manager.Apply(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RolledBack).To(ConsistOf("sysctl net.core.somaxconn", "huge pages hugepages-2048kB"))
+			test.AssertFileOnDisk(fakeFS, somaxconnPath, "4096", 0644)
+			test.AssertFileOnDisk(fakeFS, hugePages2Mi, "0", 0644)
+			test.AssertFileOnDisk(fakeFS, vlanPath, "1", 0644)
+			test.AssertFileOnDisk(fakeFS, "/etc/modules-load.d/gardener-node-agent.conf", "# managed by gardener-node-agent\nbr_netfilter\n", 0644)
+			test.AssertNoFileOnDisk(fakeFS, "/etc/modprobe.d/gardener-node-agent.conf")
+		})
+
+		It("should roll back everything if no kernel config is given", func() {
+			Expect(manager.Apply(ctx, config)).To(Equal(&Result{}))
+
+			result, err := manager.Apply(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RolledBack).To(HaveLen(3))
+			test.AssertFileOnDisk(fakeFS, somaxconnPath, "4096", 0644)
+			test.AssertFileOnDisk(fakeFS, vlanPath, "0", 0644)
+			test.AssertNoFileOnDisk(fakeFS, "/etc/modules-load.d/gardener-node-agent.conf")
+			Expect(manager.HasState()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// ReadLastAppliedOperatingSystemConfig reads the operating system config which was last applied by the operating
+// system config controller from the disk. It returns nil if no operating system config was applied so far.
+func ReadLastAppliedOperatingSystemConfig(fs afero.Afero) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	oscRaw, err := fs.ReadFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := yaml.Unmarshal(oscRaw, osc); err != nil {
+		return nil, fmt.Errorf("unable to decode the last applied OSC read from file path %s: %w", nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	return osc, nil
+}
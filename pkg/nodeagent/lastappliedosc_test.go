@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+var _ = Describe("LastAppliedOSC", func() {
+	Describe("#ReadLastAppliedOperatingSystemConfig", func() {
+		var fakeFS afero.Afero
+
+		BeforeEach(func() {
+			fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		})
+
+		It("should return nil if no OSC was applied so far", func() {
+			Expect(ReadLastAppliedOperatingSystemConfig(fakeFS)).To(BeNil())
+		})
+
+		It("should return the last applied OSC", func() {
+			Expect(fakeFS.WriteFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, []byte(`spec:
+  type: foo
+  purpose: reconcile
+`), 0644)).To(Succeed())
+
+			osc, err := ReadLastAppliedOperatingSystemConfig(fakeFS)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(osc.Spec.Type).To(Equal("foo"))
+			Expect(osc.Spec.Purpose).To(Equal(extensionsv1alpha1.OperatingSystemConfigPurposeReconcile))
+		})
+
+		It("should fail if the last applied OSC cannot be decoded", func() {
+			Expect(fakeFS.WriteFile(nodeagentv1alpha1.LastAppliedOperatingSystemConfigFilePath, []byte(`{`), 0644)).To(Succeed())
+
+			_, err := ReadLastAppliedOperatingSystemConfig(fakeFS)
+			Expect(err).To(MatchError(ContainSubstring("unable to decode the last applied OSC")))
+		})
+	})
+})
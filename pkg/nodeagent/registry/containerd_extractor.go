@@ -15,6 +15,7 @@ import (
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/remotes"
 	"github.com/containerd/containerd/remotes/docker"
 	"github.com/containerd/containerd/remotes/docker/config"
 	"github.com/containerd/containerd/snapshots"
@@ -37,18 +38,14 @@ func NewExtractor() Extractor {
 func (e *containerdExtractor) CopyFromImage(ctx context.Context, imageRef string, filePathInImage string, destination string, permissions os.FileMode) error {
 	fs := afero.Afero{Fs: afero.NewOsFs()}
 
-	address := os.Getenv("CONTAINERD_ADDRESS")
-	if address == "" {
-		address = defaults.DefaultAddress
-	}
 	namespace := os.Getenv(namespaces.NamespaceEnvVar)
 	if namespace == "" {
 		namespace = namespaces.Default
 	}
 
-	client, err := containerd.New(address, containerd.WithDefaultNamespace(namespace))
+	client, err := newContainerdClient(namespace)
 	if err != nil {
-		return fmt.Errorf("error creating containerd client: %w", err)
+		return err
 	}
 	ctx, done, err := client.WithLease(ctx)
 	if err != nil {
@@ -57,11 +54,7 @@ func (e *containerdExtractor) CopyFromImage(ctx context.Context, imageRef string
 
 	defer func() { utilruntime.HandleError(done(ctx)) }()
 
-	resolver := docker.NewResolver(docker.ResolverOptions{
-		Hosts: config.ConfigureHosts(ctx, config.HostOptions{HostDir: config.HostDirFromRoot("/etc/containerd/certs.d")}),
-	})
-
-	image, err := client.Pull(ctx, imageRef, containerd.WithPullSnapshotter(containerd.DefaultSnapshotter), containerd.WithResolver(resolver), containerd.WithPullUnpack)
+	image, err := client.Pull(ctx, imageRef, containerd.WithPullSnapshotter(containerd.DefaultSnapshotter), containerd.WithResolver(newResolver(ctx)), containerd.WithPullUnpack)
 	if err != nil {
 		return fmt.Errorf("error pulling image: %w", err)
 	}
@@ -87,6 +80,26 @@ func (e *containerdExtractor) CopyFromImage(ctx context.Context, imageRef string
 	return unmountImage(ctx, snapshotter, imageMountDirectory)
 }
 
+func newContainerdClient(namespace string) (*containerd.Client, error) {
+	address := os.Getenv("CONTAINERD_ADDRESS")
+	if address == "" {
+		address = defaults.DefaultAddress
+	}
+
+	client, err := containerd.New(address, containerd.WithDefaultNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating containerd client: %w", err)
+	}
+
+	return client, nil
+}
+
+func newResolver(ctx context.Context) remotes.Resolver {
+	return docker.NewResolver(docker.ResolverOptions{
+		Hosts: config.ConfigureHosts(ctx, config.HostOptions{HostDir: config.HostDirFromRoot("/etc/containerd/certs.d")}),
+	})
+}
+
 func mountImage(ctx context.Context, image containerd.Image, snapshotter snapshots.Snapshotter, directory string) error {
 	diffIDs, err := image.RootFS(ctx)
 	if err != nil {
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	criconstants "github.com/containerd/containerd/pkg/cri/constants"
+	crilabels "github.com/containerd/containerd/pkg/cri/labels"
+	"github.com/distribution/reference"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+const (
+	// LabelPrePulledByNodeAgent is the label on images in containerd's image store which marks images that were
+	// pre-pulled by gardener-node-agent.
+	LabelPrePulledByNodeAgent = "node-agent.gardener.cloud/pre-pulled"
+	// LabelPinnedByNodeAgent is the label on images in containerd's image store which marks images whose CRI pin was set
+	// by gardener-node-agent. It is used to distinguish them from images which were already pinned by other means (e.g.,
+	// the sandbox image pinned by containerd), so that only the pins set by gardener-node-agent are removed again.
+	LabelPinnedByNodeAgent = "node-agent.gardener.cloud/pinned"
+)
+
+type containerdImagePuller struct{}
+
+// NewImagePuller creates a new instance of a containerd image puller. Images are pulled into the namespace used by the
+// CRI plugin of containerd so that they are visible to the kubelet.
+func NewImagePuller() ImagePuller {
+	return &containerdImagePuller{}
+}
+
+// PinnedImages returns the references of all images which were pre-pulled and pinned by gardener-node-agent.
+func (p *containerdImagePuller) PinnedImages(ctx context.Context) ([]string, error) {
+	client, err := newContainerdClient(criconstants.K8sContainerdNamespace)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { utilruntime.HandleError(client.Close()) }()
+
+	images, err := client.ImageService().List(ctx, fmt.Sprintf("labels.%q==true", LabelPrePulledByNodeAgent))
+	if err != nil {
+		return nil, fmt.Errorf("error listing pinned images: %w", err)
+	}
+
+	refs := make([]string, 0, len(images))
+	for _, image := range images {
+		refs = append(refs, image.Name)
+	}
+
+	return refs, nil
+}
+
+// PullAndPin pulls the given image reference (if not present yet) and pins it.
+func (p *containerdImagePuller) PullAndPin(ctx context.Context, imageRef string) error {
+	ref, err := reference.ParseDockerRef(imageRef)
+	if err != nil {
+		return fmt.Errorf("error parsing image reference %q: %w", imageRef, err)
+	}
+
+	client, err := newContainerdClient(criconstants.K8sContainerdNamespace)
+	if err != nil {
+		return err
+	}
+	defer func() { utilruntime.HandleError(client.Close()) }()
+
+	var existing *images.Image
+	if image, err := client.ImageService().Get(ctx, ref.String()); err == nil {
+		existing = &image
+	} else if !errdefs.IsNotFound(err) {
+		return fmt.Errorf("error getting image %q: %w", ref.String(), err)
+	}
+
+	ctx, done, err := client.WithLease(ctx)
+	if err != nil {
+		return fmt.Errorf("error adding lease to containerd client: %w", err)
+	}
+	defer func() { utilruntime.HandleError(done(ctx)) }()
+
+	if _, err := client.Pull(ctx, ref.String(),
+		containerd.WithPullSnapshotter(containerd.DefaultSnapshotter),
+		containerd.WithResolver(newResolver(ctx)),
+		containerd.WithPullUnpack,
+		containerd.WithPullLabels(pullAndPinLabels(existing)),
+	); err != nil {
+		return fmt.Errorf("error pulling image %q: %w", ref.String(), err)
+	}
+
+	return nil
+}
+
+// Unpin removes the pin from the given image reference if it was set by gardener-node-agent.
+func (p *containerdImagePuller) Unpin(ctx context.Context, imageRef string) error {
+	client, err := newContainerdClient(criconstants.K8sContainerdNamespace)
+	if err != nil {
+		return err
+	}
+	defer func() { utilruntime.HandleError(client.Close()) }()
+
+	imageService := client.ImageService()
+
+	image, err := imageService.Get(ctx, imageRef)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting image %q: %w", imageRef, err)
+	}
+
+	if _, err := imageService.Update(ctx, image, unpin(&image)...); err != nil {
+		return fmt.Errorf("error unpinning image %q: %w", imageRef, err)
+	}
+
+	return nil
+}
+
+// pullAndPinLabels returns the labels for pulling and pinning an image. containerd replaces the labels of images which
+// already exist, hence their labels are kept. The CRI pin is only marked as set by gardener-node-agent if the image was
+// not pinned before.
+func pullAndPinLabels(existing *images.Image) map[string]string {
+	labels := make(map[string]string)
+	if existing != nil {
+		maps.Copy(labels, existing.Labels)
+	}
+
+	if labels[crilabels.PinnedImageLabelKey] != crilabels.PinnedImageLabelValue {
+		labels[LabelPinnedByNodeAgent] = "true"
+	}
+
+	labels[crilabels.ImageLabelKey] = crilabels.ImageLabelValue
+	labels[crilabels.PinnedImageLabelKey] = crilabels.PinnedImageLabelValue
+	labels[LabelPrePulledByNodeAgent] = "true"
+
+	return labels
+}
+
+// unpin removes the labels of gardener-node-agent from the given image and returns the paths of the removed labels. The
+// CRI pin is only removed if it was set by gardener-node-agent.
+func unpin(image *images.Image) []string {
+	fieldPaths := []string{"labels." + LabelPrePulledByNodeAgent}
+	delete(image.Labels, LabelPrePulledByNodeAgent)
+
+	if image.Labels[LabelPinnedByNodeAgent] == "true" {
+		fieldPaths = append(fieldPaths, "labels."+crilabels.PinnedImageLabelKey, "labels."+LabelPinnedByNodeAgent)
+		delete(image.Labels, crilabels.PinnedImageLabelKey)
+		delete(image.Labels, LabelPinnedByNodeAgent)
+	}
+
+	return fieldPaths
+}
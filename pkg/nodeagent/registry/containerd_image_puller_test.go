@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry_test
+
+import (
+	"github.com/containerd/containerd/images"
+	crilabels "github.com/containerd/containerd/pkg/cri/labels"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+var _ = Describe("ContainerdImagePuller", func() {
+	Describe("#PullAndPinLabels", func() {
+		It("should pin new images and mark the pin as set by gardener-node-agent", func() {
+			Expect(PullAndPinLabels(nil)).To(Equal(map[string]string{
+				crilabels.ImageLabelKey:       crilabels.ImageLabelValue,
+				crilabels.PinnedImageLabelKey: crilabels.PinnedImageLabelValue,
+				LabelPrePulledByNodeAgent:     "true",
+				LabelPinnedByNodeAgent:        "true",
+			}))
+		})
+
+		It("should keep the labels of existing images and mark the pin as set by gardener-node-agent", func() {
+			Expect(PullAndPinLabels(&images.Image{Labels: map[string]string{"foo": "bar"}})).To(Equal(map[string]string{
+				"foo":                         "bar",
+				crilabels.ImageLabelKey:       crilabels.ImageLabelValue,
+				crilabels.PinnedImageLabelKey: crilabels.PinnedImageLabelValue,
+				LabelPrePulledByNodeAgent:     "true",
+				LabelPinnedByNodeAgent:        "true",
+			}))
+		})
+
+		It("should not mark the pin as set by gardener-node-agent if the image was already pinned", func() {
+			Expect(PullAndPinLabels(&images.Image{Labels: map[string]string{
+				crilabels.ImageLabelKey:       crilabels.ImageLabelValue,
+				crilabels.PinnedImageLabelKey: crilabels.PinnedImageLabelValue,
+			}})).To(Equal(map[string]string{
+				crilabels.ImageLabelKey:       crilabels.ImageLabelValue,
+				crilabels.PinnedImageLabelKey: crilabels.PinnedImageLabelValue,
+				LabelPrePulledByNodeAgent:     "true",
+			}))
+		})
+	})
+
+	Describe("#Unpin", func() {
+		It("should remove the pin if it was set by gardener-node-agent", func() {
+			image := &images.Image{Labels: map[string]string{
+				crilabels.ImageLabelKey:       crilabels.ImageLabelValue,
+				crilabels.PinnedImageLabelKey: crilabels.PinnedImageLabelValue,
+				LabelPrePulledByNodeAgent:     "true",
+				LabelPinnedByNodeAgent:        "true",
+			}}
+
+			Expect(Unpin(image)).To(ConsistOf(
+				"labels."+LabelPrePulledByNodeAgent,
+				"labels."+LabelPinnedByNodeAgent,
+				"labels."+crilabels.PinnedImageLabelKey,
+			))
+			Expect(image.Labels).To(Equal(map[string]string{crilabels.ImageLabelKey: crilabels.ImageLabelValue}))
+		})
+
+		It("should keep the pin if it was not set by gardener-node-agent", func() {
+			image := &images.Image{Labels: map[string]string{
+				crilabels.ImageLabelKey:       crilabels.ImageLabelValue,
+				crilabels.PinnedImageLabelKey: crilabels.PinnedImageLabelValue,
+				LabelPrePulledByNodeAgent:     "true",
+			}}
+
+			Expect(Unpin(image)).To(ConsistOf("labels." + LabelPrePulledByNodeAgent))
+			Expect(image.Labels).To(Equal(map[string]string{
+				crilabels.ImageLabelKey:       crilabels.ImageLabelValue,
+				crilabels.PinnedImageLabelKey: crilabels.PinnedImageLabelValue,
+			}))
+		})
+	})
+})
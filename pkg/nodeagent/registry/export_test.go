@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+// Functions exported for testing.
+
+var (
+	PullAndPinLabels = pullAndPinLabels
+	Unpin            = unpin
+)
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+// ImagePuller is a simple implementation of registry.ImagePuller which can be used to fake the image puller in unit
+// tests.
+type ImagePuller struct {
+	lock   sync.Mutex
+	pinned sets.Set[string]
+
+	// PullErrors contains errors which are returned by PullAndPin for the respective image references.
+	PullErrors map[string]error
+	// Pulled contains all image references which were pulled so far.
+	Pulled []string
+}
+
+var _ registry.ImagePuller = &ImagePuller{}
+
+// NewImagePuller returns a new fake image puller with the given images already being pinned.
+func NewImagePuller(pinned ...string) *ImagePuller {
+	return &ImagePuller{pinned: sets.New(pinned...), PullErrors: map[string]error{}}
+}
+
+// PinnedImages returns the references of all pinned images.
+func (p *ImagePuller) PinnedImages(_ context.Context) ([]string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return sets.List(p.pinned), nil
+}
+
+// PullAndPin records the image reference as pulled and pinned or returns the configured error.
+func (p *ImagePuller) PullAndPin(_ context.Context, imageRef string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if err := p.PullErrors[imageRef]; err != nil {
+		return err
+	}
+
+	if !slices.Contains(p.Pulled, imageRef) {
+		p.Pulled = append(p.Pulled, imageRef)
+	}
+	p.pinned.Insert(imageRef)
+	return nil
+}
+
+// Unpin removes the pin from the image reference.
+func (p *ImagePuller) Unpin(_ context.Context, imageRef string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.pinned.Delete(imageRef)
+	return nil
+}
@@ -14,3 +14,15 @@ type Extractor interface {
 	// CopyFromImage copies a file from a given image reference to the destination file.
 	CopyFromImage(ctx context.Context, imageRef string, filePathInImage string, destination string, permissions os.FileMode) error
 }
+
+// ImagePuller is an interface for pulling images into the image store of the container runtime and pinning them so
+// that they are not removed by the image garbage collection of the kubelet.
+type ImagePuller interface {
+	// PinnedImages returns the references of all images which were pinned by this puller.
+	PinnedImages(ctx context.Context) ([]string, error)
+	// PullAndPin pulls the given image reference (if not present yet) and pins it.
+	PullAndPin(ctx context.Context, imageRef string) error
+	// Unpin removes the pin from the given image reference if it was set by this puller. The image itself is kept and
+	// becomes subject to the image garbage collection again unless it was pinned by other means before.
+	Unpin(ctx context.Context, imageRef string) error
+}
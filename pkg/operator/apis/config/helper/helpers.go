@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// ImageRewriteRules returns the image rewrite rules of the given config as rewrite rules for image vectors.
+func ImageRewriteRules(c *config.OperatorConfiguration) []imagevectorutils.RewriteRule {
+	if c == nil || len(c.ImageRewriteRules) == 0 {
+		return nil
+	}
+
+	rules := make([]imagevectorutils.RewriteRule, 0, len(c.ImageRewriteRules))
+	for _, rule := range c.ImageRewriteRules {
+		rules = append(rules, imagevectorutils.RewriteRule{Prefix: rule.Prefix, Mirror: rule.Mirror, Digests: rule.Digests})
+	}
+	return rules
+}
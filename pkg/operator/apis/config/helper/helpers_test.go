@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+	. "github.com/gardener/gardener/pkg/operator/apis/config/helper"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("Helpers", func() {
+	Describe("#ImageRewriteRules", func() {
+		It("should return nil if no rules are configured", func() {
+			Expect(ImageRewriteRules(nil)).To(BeNil())
+			Expect(ImageRewriteRules(&config.OperatorConfiguration{})).To(BeNil())
+		})
+
+		It("should convert the configured rules", func() {
+			Expect(ImageRewriteRules(&config.OperatorConfiguration{
+				ImageRewriteRules: []config.ImageRewriteRule{{
+					Prefix:  "registry.k8s.io",
+					Mirror:  "registry.example.com/k8s",
+					Digests: map[string]string{"registry.k8s.io/foo:v1": "sha256:abc"},
+				}},
+			})).To(Equal([]imagevectorutils.RewriteRule{{
+				Prefix:  "registry.k8s.io",
+				Mirror:  "registry.example.com/k8s",
+				Digests: map[string]string{"registry.k8s.io/foo:v1": "sha256:abc"},
+			}}))
+		})
+	})
+})
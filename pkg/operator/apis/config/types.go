@@ -40,6 +40,9 @@ type OperatorConfiguration struct {
 	Controllers ControllerConfiguration
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeTolerationConfiguration
+	// ImageRewriteRules is a list of rules for rewriting the repositories of the images in the image vectors, e.g., to
+	// point them to a registry mirror in air-gapped landscapes. The first matching rule is applied.
+	ImageRewriteRules []ImageRewriteRule
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -131,6 +134,18 @@ type Server struct {
 	Port int
 }
 
+// ImageRewriteRule contains a rule for rewriting the repositories of the images in the image vectors.
+type ImageRewriteRule struct {
+	// Prefix is the prefix of the image repositories which are rewritten, e.g.,
+	// `europe-docker.pkg.dev/gardener-project/releases`. It only matches complete path segments.
+	Prefix string
+	// Mirror replaces the prefix of the matching image repositories, e.g., `registry.example.com/gardener`.
+	Mirror string
+	// Digests optionally pins images to digests. The keys are the image references (`<repository>:<tag>`) before
+	// rewriting, the values are the digests (`sha256:...`) of the rewritten images.
+	Digests map[string]string
+}
+
 // NodeTolerationConfiguration contains information about node toleration options.
 type NodeTolerationConfiguration struct {
 	// DefaultNotReadyTolerationSeconds specifies the seconds for the `node.kubernetes.io/not-ready` toleration that
@@ -43,6 +43,10 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// ImageRewriteRules is a list of rules for rewriting the repositories of the images in the image vectors, e.g., to
+	// point them to a registry mirror in air-gapped landscapes. The first matching rule is applied.
+	// +optional
+	ImageRewriteRules []ImageRewriteRule `json:"imageRewriteRules,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -146,6 +150,19 @@ type Server struct {
 	Port int `json:"port"`
 }
 
+// ImageRewriteRule contains a rule for rewriting the repositories of the images in the image vectors.
+type ImageRewriteRule struct {
+	// Prefix is the prefix of the image repositories which are rewritten, e.g.,
+	// `europe-docker.pkg.dev/gardener-project/releases`. It only matches complete path segments.
+	Prefix string `json:"prefix"`
+	// Mirror replaces the prefix of the matching image repositories, e.g., `registry.example.com/gardener`.
+	Mirror string `json:"mirror"`
+	// Digests optionally pins images to digests. The keys are the image references (`<repository>:<tag>`) before
+	// rewriting, the values are the digests (`sha256:...`) of the rewritten images.
+	// +optional
+	Digests map[string]string `json:"digests,omitempty"`
+}
+
 // NodeTolerationConfiguration contains information about node toleration options.
 type NodeTolerationConfiguration struct {
 	// DefaultNotReadyTolerationSeconds specifies the seconds for the `node.kubernetes.io/not-ready` toleration that
@@ -89,6 +89,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageRewriteRule)(nil), (*config.ImageRewriteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(a.(*ImageRewriteRule), b.(*config.ImageRewriteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImageRewriteRule)(nil), (*ImageRewriteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(a.(*config.ImageRewriteRule), b.(*ImageRewriteRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyControllerConfiguration)(nil), (*config.NetworkPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(a.(*NetworkPolicyControllerConfiguration), b.(*config.NetworkPolicyControllerConfiguration), scope)
 	}); err != nil {
@@ -314,6 +324,30 @@ func Convert_config_GardenletDeployerControllerConfig_To_v1alpha1_GardenletDeplo
 	return autoConvert_config_GardenletDeployerControllerConfig_To_v1alpha1_GardenletDeployerControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(in *ImageRewriteRule, out *config.ImageRewriteRule, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.Mirror = in.Mirror
+	out.Digests = *(*map[string]string)(unsafe.Pointer(&in.Digests))
+	return nil
+}
+
+// Convert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule is an autogenerated conversion function.
+func Convert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(in *ImageRewriteRule, out *config.ImageRewriteRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageRewriteRule_To_config_ImageRewriteRule(in, out, s)
+}
+
+func autoConvert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(in *config.ImageRewriteRule, out *ImageRewriteRule, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.Mirror = in.Mirror
+	out.Digests = *(*map[string]string)(unsafe.Pointer(&in.Digests))
+	return nil
+}
+
+// Convert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule is an autogenerated conversion function.
+func Convert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(in *config.ImageRewriteRule, out *ImageRewriteRule, s conversion.Scope) error {
+	return autoConvert_config_ImageRewriteRule_To_v1alpha1_ImageRewriteRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(in *NetworkPolicyControllerConfiguration, out *config.NetworkPolicyControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.AdditionalNamespaceSelectors = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.AdditionalNamespaceSelectors))
@@ -387,6 +421,7 @@ func autoConvert_v1alpha1_OperatorConfiguration_To_config_OperatorConfiguration(
 		return err
 	}
 	out.NodeToleration = (*config.NodeTolerationConfiguration)(unsafe.Pointer(in.NodeToleration))
+	out.ImageRewriteRules = *(*[]config.ImageRewriteRule)(unsafe.Pointer(&in.ImageRewriteRules))
 	return nil
 }
 
@@ -424,6 +459,7 @@ func autoConvert_config_OperatorConfiguration_To_v1alpha1_OperatorConfiguration(
 		return err
 	}
 	out.NodeToleration = (*NodeTolerationConfiguration)(unsafe.Pointer(in.NodeToleration))
+	out.ImageRewriteRules = *(*[]ImageRewriteRule)(unsafe.Pointer(&in.ImageRewriteRules))
 	return nil
 }
 
@@ -16,6 +16,8 @@ import (
 
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
+	"github.com/gardener/gardener/pkg/operator/apis/config/helper"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // ValidateOperatorConfiguration validates the given `OperatorConfiguration`.
@@ -32,6 +34,7 @@ func ValidateOperatorConfiguration(conf *config.OperatorConfiguration) field.Err
 
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
+	allErrs = append(allErrs, imagevectorutils.ValidateRewriteRules(helper.ImageRewriteRules(conf), field.NewPath("imageRewriteRules"))...)
 
 	return allErrs
 }
@@ -212,4 +212,37 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("image rewrite rules", func() {
+		It("should pass with valid image rewrite rules", func() {
+			conf.ImageRewriteRules = []config.ImageRewriteRule{{
+				Prefix:  "registry.k8s.io",
+				Mirror:  "registry.example.com/k8s",
+				Digests: map[string]string{"registry.k8s.io/foo:v1": "sha256:abc"},
+			}}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with invalid image rewrite rules", func() {
+			conf.ImageRewriteRules = []config.ImageRewriteRule{{
+				Digests: map[string]string{"registry.k8s.io/foo:v1": "abc"},
+			}}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("imageRewriteRules[0].prefix"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("imageRewriteRules[0].mirror"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("imageRewriteRules[0].digests[registry.k8s.io/foo:v1]"),
+				})),
+			))
+		})
+	})
 })
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/nodeagent"
+)
+
+type document struct {
+	ProviderID string `json:"providerID"`
+	Nonce      []byte `json:"nonce"`
+}
+
+// Provider creates and verifies attestation documents for machines of the local infrastructure provider whose provider
+// ID equals their host name. The documents are not signed by any authority, hence they do not prove anything. This
+// provider must only be used for development and testing purposes.
+type Provider struct {
+	// HostName returns the host name of the machine. Exposed for testing.
+	HostName func() (string, error)
+}
+
+// NewProvider creates a new attestation provider for the local infrastructure provider.
+func NewProvider() *Provider {
+	return &Provider{HostName: nodeagent.GetHostName}
+}
+
+// Attest returns an attestation document containing the host name of the machine as provider ID.
+func (p *Provider) Attest(_ context.Context, nonce []byte) ([]byte, error) {
+	hostName, err := p.HostName()
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching host name: %w", err)
+	}
+
+	return json.Marshal(document{ProviderID: hostName, Nonce: nonce})
+}
+
+// Verify decodes the given attestation document and checks that it is bound to the given nonce.
+func (p *Provider) Verify(_ context.Context, data, nonce []byte) (string, error) {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", fmt.Errorf("failed decoding attestation document: %w", err)
+	}
+
+	if doc.ProviderID == "" {
+		return "", fmt.Errorf("attestation document does not contain a provider ID")
+	}
+
+	if !bytes.Equal(doc.Nonce, nonce) {
+		return "", fmt.Errorf("attestation document is not bound to the public key of the certificate signing request")
+	}
+
+	return doc.ProviderID, nil
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/provider-local/attestation"
+)
+
+var _ = Describe("Provider", func() {
+	var (
+		ctx      = context.Background()
+		provider *Provider
+		nonce    = []byte("nonce")
+	)
+
+	BeforeEach(func() {
+		provider = &Provider{HostName: func() (string, error) { return "machine-0", nil }}
+	})
+
+	It("should verify its own attestation documents", func() {
+		document, err := provider.Attest(ctx, nonce)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(provider.Verify(ctx, document, nonce)).To(Equal("machine-0"))
+	})
+
+	It("should fail if the document is bound to another nonce", func() {
+		document, err := provider.Attest(ctx, []byte("other"))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = provider.Verify(ctx, document, nonce)
+		Expect(err).To(MatchError(ContainSubstring("not bound to the public key")))
+	})
+
+	It("should fail if the document does not contain a provider ID", func() {
+		_, err := provider.Verify(ctx, []byte(`{"nonce":"bm9uY2U="}`), nonce)
+		Expect(err).To(MatchError("attestation document does not contain a provider ID"))
+	})
+
+	It("should fail if the document cannot be decoded", func() {
+		_, err := provider.Verify(ctx, []byte(`foo`), nonce)
+		Expect(err).To(MatchError(ContainSubstring("failed decoding attestation document")))
+	})
+})
@@ -41,6 +41,7 @@ func (a *actuator) GetETCDSecretData(_ context.Context, _ logr.Logger, _ *extens
 
 func (a *actuator) Delete(_ context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
 	entryName := strings.TrimPrefix(be.Name, v1beta1constants.BackupSourcePrefix+"-")
+	entryName = strings.TrimPrefix(entryName, v1beta1constants.BackupReplicaPrefix+"-")
 	path := filepath.Join(a.backBucketPath, be.Spec.BucketName, entryName)
 	log.Info("Deleting directory", "path", path)
 	return os.RemoveAll(path)
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/provider-local/apis/local/helper"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	labelKeyApp       = "app"
+	labelValueBastion = "bastion"
+	labelValueMachine = "machine"
+	labelKeyBastion   = "bastion.local.provider.extensions.gardener.cloud/name"
+
+	// sessionRecordingsPath is the directory on the bastion host to which the user data provided by gardenlet writes
+	// the session recordings.
+	sessionRecordingsPath = "/var/log/bastion-sessions"
+)
+
+var (
+	// RequeueAfterPodIP is the duration after which the reconciliation is retried while the bastion pod has no IP yet.
+	RequeueAfterPodIP = 5 * time.Second
+	// RequeueAfterPodDeletion is the duration after which the deletion is retried while the bastion pod still exists.
+	RequeueAfterPodDeletion = 5 * time.Second
+)
+
+type actuator struct {
+	client             client.Client
+	containerMountPath string
+}
+
+// NewActuator creates a new Actuator that runs bastion hosts as pods in the shoot namespace. The session recordings
+// are written directly to the directory of the referenced BackupEntry in the local backup bucket, which is mounted
+// from the host at <containerMountPath>.
+func NewActuator(mgr manager.Manager, containerMountPath string) bastion.Actuator {
+	return &actuator{
+		client:             mgr.GetClient(),
+		containerMountPath: containerMountPath,
+	}
+}
+
+func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	image, err := machineImage(cluster)
+	if err != nil {
+		return err
+	}
+
+	var recordingsPath string
+	if bastion.Spec.SessionRecording != nil {
+		backupEntry := &extensionsv1alpha1.BackupEntry{}
+		if err := a.client.Get(ctx, client.ObjectKey{Name: bastion.Spec.SessionRecording.BackupEntryName}, backupEntry); err != nil {
+			return fmt.Errorf("failed getting BackupEntry for session recordings: %w", err)
+		}
+		recordingsPath = filepath.Join(a.containerMountPath, backupEntry.Spec.BucketName, backupEntry.Name)
+	}
+
+	userDataSecret := emptyUserDataSecret(bastion)
+	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, userDataSecret, func() error {
+		userDataSecret.Data = map[string][]byte{"userdata": bastion.Spec.UserData}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling user data secret: %w", err)
+	}
+
+	networkPolicyBastion, networkPolicyMachines := emptyNetworkPolicies(bastion)
+	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, networkPolicyBastion, func() error {
+		networkPolicyBastion.Spec = networkPolicySpecBastion(bastion)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling network policy for bastion: %w", err)
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, networkPolicyMachines, func() error {
+		networkPolicyMachines.Spec = networkPolicySpecMachines(bastion)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling network policy for machines: %w", err)
+	}
+
+	pod := emptyPod(bastion)
+	if err := a.client.Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed getting bastion pod: %w", err)
+		}
+
+		// The pod spec is immutable, hence the pod is only created but never updated. Changes to the Bastion require a
+		// new bastion host anyway.
+		pod.Labels = podLabels(bastion)
+		pod.Spec = podSpec(image, userDataSecret.Name, recordingsPath)
+		if err := a.client.Create(ctx, pod); err != nil {
+			return fmt.Errorf("failed creating bastion pod: %w", err)
+		}
+	}
+
+	if pod.Status.PodIP == "" {
+		return &reconcilerutils.RequeueAfterError{
+			RequeueAfter: RequeueAfterPodIP,
+			Cause:        errors.New("bastion pod has no IP yet"),
+		}
+	}
+
+	patch := client.MergeFrom(bastion.DeepCopy())
+	bastion.Status.Ingress = &corev1.LoadBalancerIngress{IP: pod.Status.PodIP}
+	return a.client.Status().Patch(ctx, bastion, patch)
+}
+
+func (a *actuator) Delete(ctx context.Context, _ logr.Logger, bastion *extensionsv1alpha1.Bastion, _ *extensionscontroller.Cluster) error {
+	// The session recordings are written directly to the backup bucket, hence there is nothing left to upload before
+	// the bastion host is deleted.
+	pod := emptyPod(bastion)
+	networkPolicyBastion, networkPolicyMachines := emptyNetworkPolicies(bastion)
+
+	if err := kubernetesutils.DeleteObjects(ctx, a.client, pod, networkPolicyBastion, networkPolicyMachines, emptyUserDataSecret(bastion)); err != nil {
+		return err
+	}
+
+	if err := a.client.Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	return &reconcilerutils.RequeueAfterError{
+		RequeueAfter: RequeueAfterPodDeletion,
+		Cause:        errors.New("bastion pod is still present"),
+	}
+}
+
+func (a *actuator) ForceDelete(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	return a.Delete(ctx, log, bastion, cluster)
+}
+
+// machineImage returns the image of the machine image used by the first worker pool of the shoot. It runs the user
+// data like the machine pods do.
+func machineImage(cluster *extensionscontroller.Cluster) (string, error) {
+	if cluster.Shoot == nil || len(cluster.Shoot.Spec.Provider.Workers) == 0 {
+		return "", errors.New("shoot has no worker pools")
+	}
+
+	worker := cluster.Shoot.Spec.Provider.Workers[0]
+	if worker.Machine.Image == nil {
+		return "", fmt.Errorf("worker pool %q has no machine image", worker.Name)
+	}
+
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return "", err
+	}
+
+	return helper.FindImageFromCloudProfile(cloudProfileConfig, worker.Machine.Image.Name, ptr.Deref(worker.Machine.Image.Version, ""))
+}
+
+func resourceName(bastion *extensionsv1alpha1.Bastion) string {
+	return "bastion-" + bastion.Name
+}
+
+func emptyUserDataSecret(bastion *extensionsv1alpha1.Bastion) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: resourceName(bastion) + "-userdata", Namespace: bastion.Namespace}}
+}
+
+func emptyPod(bastion *extensionsv1alpha1.Bastion) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: resourceName(bastion), Namespace: bastion.Namespace}}
+}
+
+func emptyNetworkPolicies(bastion *extensionsv1alpha1.Bastion) (*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicy) {
+	return &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: resourceName(bastion), Namespace: bastion.Namespace}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: resourceName(bastion) + "-to-machines", Namespace: bastion.Namespace}}
+}
+
+func podLabels(bastion *extensionsv1alpha1.Bastion) map[string]string {
+	return map[string]string{
+		labelKeyApp:     labelValueBastion,
+		labelKeyBastion: bastion.Name,
+	}
+}
+
+func podSpec(image, userDataSecretName, recordingsPath string) corev1.PodSpec {
+	spec := corev1.PodSpec{
+		AutomountServiceAccountToken: ptr.To(false),
+		Containers: []corev1.Container{{
+			Name:            "bastion",
+			Image:           image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			SecurityContext: &corev1.SecurityContext{
+				Privileged: ptr.To(true),
+			},
+			Ports: []corev1.ContainerPort{{
+				Name:          "ssh",
+				ContainerPort: 22,
+				Protocol:      corev1.ProtocolTCP,
+			}},
+			VolumeMounts: []corev1.VolumeMount{{
+				Name:      "userdata",
+				MountPath: "/etc/machine",
+			}},
+		}},
+		Volumes: []corev1.Volume{{
+			Name: "userdata",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  userDataSecretName,
+					DefaultMode: ptr.To[int32](0700),
+				},
+			},
+		}},
+	}
+
+	if recordingsPath != "" {
+		spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "session-recordings",
+			MountPath: sessionRecordingsPath,
+		})
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: "session-recordings",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: recordingsPath,
+					Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+				},
+			},
+		})
+	}
+
+	return spec
+}
+
+func networkPolicySpecBastion(bastion *extensionsv1alpha1.Bastion) networkingv1.NetworkPolicySpec {
+	ingressPeers := make([]networkingv1.NetworkPolicyPeer, 0, len(bastion.Spec.Ingress))
+	for _, ingress := range bastion.Spec.Ingress {
+		ingressPeers = append(ingressPeers, networkingv1.NetworkPolicyPeer{IPBlock: ingress.IPBlock.DeepCopy()})
+	}
+
+	return networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{MatchLabels: podLabels(bastion)},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{
+			From:  ingressPeers,
+			Ports: []networkingv1.NetworkPolicyPort{sshPort()},
+		}},
+		Egress: []networkingv1.NetworkPolicyEgressRule{{
+			To:    []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{labelKeyApp: labelValueMachine}}}},
+			Ports: []networkingv1.NetworkPolicyPort{sshPort()},
+		}},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+	}
+}
+
+func networkPolicySpecMachines(bastion *extensionsv1alpha1.Bastion) networkingv1.NetworkPolicySpec {
+	return networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{labelKeyApp: labelValueMachine}},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{
+			From:  []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: podLabels(bastion)}}},
+			Ports: []networkingv1.NetworkPolicyPort{sshPort()},
+		}},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+	}
+}
+
+func sshPort() networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(corev1.ProtocolTCP),
+		Port:     ptr.To(intstr.FromInt32(22)),
+	}
+}
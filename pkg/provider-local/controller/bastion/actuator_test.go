@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	. "github.com/gardener/gardener/pkg/provider-local/controller/bastion"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		ctrl       *gomock.Controller
+		mgr        *mockmanager.MockManager
+		fakeClient client.Client
+		actuator   bastion.Actuator
+
+		namespace = "shoot--dev--foo"
+
+		cluster          *extensionscontroller.Cluster
+		extensionBastion *extensionsv1alpha1.Bastion
+		backupEntry      *extensionsv1alpha1.BackupEntry
+		pod              *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Bastion{}).Build()
+		mgr = mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetClient().Return(fakeClient)
+		actuator = NewActuator(mgr, "/etc/gardener/local-backupbuckets")
+
+		cluster = &extensionscontroller.Cluster{
+			CloudProfile: &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","machineImages":[{"name":"local","versions":[{"version":"1.0.0","image":"local/node:v1.0.0"}]}]}`)},
+				},
+			},
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{
+							Name: "worker",
+							Machine: gardencorev1beta1.Machine{
+								Image: &gardencorev1beta1.ShootMachineImage{Name: "local", Version: ptr.To("1.0.0")},
+							},
+						}},
+					},
+				},
+			},
+		}
+
+		extensionBastion = &extensionsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.BastionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"},
+				UserData:    []byte("#!/bin/bash"),
+				Ingress:     []extensionsv1alpha1.BastionIngressPolicy{{IPBlock: networkingv1.IPBlock{CIDR: "1.2.3.4/32"}}},
+			},
+		}
+		backupEntry = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "bastion-sessions-" + namespace + "--uid"},
+			Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: "bucket"},
+		}
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bastion-foo", Namespace: namespace}}
+
+		Expect(fakeClient.Create(ctx, extensionBastion)).To(Succeed())
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create the bastion pod and wait for its IP", func() {
+			err := actuator.Reconcile(ctx, log, extensionBastion, cluster)
+			Expect(err).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+			Expect(pod.Labels).To(Equal(map[string]string{
+				"app": "bastion",
+				"bastion.local.provider.extensions.gardener.cloud/name": "foo",
+			}))
+			Expect(pod.Spec.Containers).To(HaveLen(1))
+			Expect(pod.Spec.Containers[0].Image).To(Equal("local/node:v1.0.0"))
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(ConsistOf(corev1.VolumeMount{Name: "userdata", MountPath: "/etc/machine"}))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bastion-foo-userdata"}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("userdata", []byte("#!/bin/bash")))
+
+			networkPolicy := &networkingv1.NetworkPolicy{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bastion-foo"}, networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Spec.Ingress).To(HaveLen(1))
+			Expect(networkPolicy.Spec.Ingress[0].From).To(ConsistOf(networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: "1.2.3.4/32"}}))
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bastion-foo-to-machines"}, networkPolicy)).To(Succeed())
+
+			pod.Status.PodIP = "10.0.0.1"
+			Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+
+			Expect(actuator.Reconcile(ctx, log, extensionBastion, cluster)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(extensionBastion), extensionBastion)).To(Succeed())
+			Expect(extensionBastion.Status.Ingress).To(Equal(&corev1.LoadBalancerIngress{IP: "10.0.0.1"}))
+		})
+
+		It("should fail if the machine image is not found in the cloud profile", func() {
+			cluster.Shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("2.0.0")
+
+			Expect(actuator.Reconcile(ctx, log, extensionBastion, cluster)).To(MatchError(ContainSubstring("could not find an image")))
+		})
+
+		Context("session recording", func() {
+			BeforeEach(func() {
+				extensionBastion.Spec.SessionRecording = &extensionsv1alpha1.BastionSessionRecording{BackupEntryName: backupEntry.Name}
+			})
+
+			It("should fail if the BackupEntry does not exist", func() {
+				Expect(actuator.Reconcile(ctx, log, extensionBastion, cluster)).To(MatchError(ContainSubstring("failed getting BackupEntry for session recordings")))
+			})
+
+			It("should write the session recordings to the directory of the BackupEntry", func() {
+				Expect(fakeClient.Create(ctx, backupEntry)).To(Succeed())
+
+				Expect(actuator.Reconcile(ctx, log, extensionBastion, cluster)).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "session-recordings", MountPath: "/var/log/bastion-sessions"}))
+				Expect(pod.Spec.Volumes).To(ContainElement(corev1.Volume{
+					Name: "session-recordings",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/etc/gardener/local-backupbuckets/bucket/" + backupEntry.Name,
+							Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+						},
+					},
+				}))
+			})
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all resources of the bastion", func() {
+			Expect(actuator.Reconcile(ctx, log, extensionBastion, cluster)).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+
+			Expect(actuator.Delete(ctx, log, extensionBastion, cluster)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bastion-foo-userdata"}, &corev1.Secret{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bastion-foo"}, &networkingv1.NetworkPolicy{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bastion-foo-to-machines"}, &networkingv1.NetworkPolicy{})).To(BeNotFoundError())
+		})
+	})
+})
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
+	"github.com/gardener/gardener/pkg/provider-local/local"
+)
+
+// DefaultAddOptions are the default AddOptions for AddToManager. The bastion controller uses the backup options since
+// the session recordings are stored in the local backup bucket.
+var DefaultAddOptions = backupoptions.AddOptions{}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+// The opts.Reconciler is being set with a newly instantiated actuator.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts backupoptions.AddOptions) error {
+	return bastion.Add(mgr, bastion.AddArgs{
+		Actuator:          NewActuator(mgr, opts.ContainerMountPath),
+		ControllerOptions: opts.Controller,
+		Predicates:        bastion.DefaultPredicates(opts.IgnoreOperationAnnotation),
+		Type:              local.Type,
+		ExtensionClass:    opts.ExtensionClass,
+	})
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
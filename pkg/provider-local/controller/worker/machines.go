@@ -66,99 +66,104 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		machineDeployments  worker.MachineDeployments
 	)
 
-	for _, pool := range w.worker.Spec.Pools {
-		workerPoolHash, err := worker.WorkerPoolHash(pool, w.cluster, nil, nil)
-		if err != nil {
-			return err
-		}
+	for _, workerPool := range w.worker.Spec.Pools {
+		for _, prioritizedPool := range worker.PrioritizedWorkerPools(workerPool) {
+			pool := prioritizedPool.WorkerPool
 
-		image, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
-		if err != nil {
-			return err
-		}
-		machineImages = appendMachineImage(machineImages, api.MachineImage{
-			Name:    pool.MachineImage.Name,
-			Version: pool.MachineImage.Version,
-			Image:   image,
-		})
-
-		userData, err := worker.FetchUserData(ctx, w.client, w.worker.Namespace, pool)
-		if err != nil {
-			return err
-		}
+			workerPoolHash, err := worker.WorkerPoolHash(pool, w.cluster, nil, nil)
+			if err != nil {
+				return err
+			}
 
-		var (
-			deploymentName = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
-			className      = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
-		)
-
-		machineClassSecrets = append(machineClassSecrets, &corev1.Secret{
-			TypeMeta: metav1.TypeMeta{
-				APIVersion: corev1.SchemeGroupVersion.String(),
-				Kind:       "Secret",
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      className,
-				Namespace: w.worker.Namespace,
-				Labels:    map[string]string{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass},
-			},
-			Type: corev1.SecretTypeOpaque,
-			Data: map[string][]byte{"userData": userData},
-		})
-
-		providerConfig := map[string]interface{}{
-			"image": image,
-		}
+			image, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
+			if err != nil {
+				return err
+			}
+			machineImages = appendMachineImage(machineImages, api.MachineImage{
+				Name:    pool.MachineImage.Name,
+				Version: pool.MachineImage.Version,
+				Image:   image,
+			})
+
+			userData, err := worker.FetchUserData(ctx, w.client, w.worker.Namespace, pool)
+			if err != nil {
+				return err
+			}
 
-		for _, ipFamily := range w.cluster.Shoot.Spec.Networking.IPFamilies {
-			key := "ipPoolNameV4"
-			if ipFamily == gardencorev1beta1.IPFamilyIPv6 {
-				key = "ipPoolNameV6"
+			var (
+				deploymentName = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
+				className      = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
+			)
+
+			machineClassSecrets = append(machineClassSecrets, &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: corev1.SchemeGroupVersion.String(),
+					Kind:       "Secret",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      className,
+					Namespace: w.worker.Namespace,
+					Labels:    map[string]string{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{"userData": userData},
+			})
+
+			providerConfig := map[string]interface{}{
+				"image": image,
 			}
 
-			providerConfig[key] = infrastructure.IPPoolName(w.worker.Namespace, string(ipFamily))
-		}
+			for _, ipFamily := range w.cluster.Shoot.Spec.Networking.IPFamilies {
+				key := "ipPoolNameV4"
+				if ipFamily == gardencorev1beta1.IPFamilyIPv6 {
+					key = "ipPoolNameV6"
+				}
 
-		providerConfigBytes, err := json.Marshal(providerConfig)
-		if err != nil {
-			return err
-		}
+				providerConfig[key] = infrastructure.IPPoolName(w.worker.Namespace, string(ipFamily))
+			}
+
+			providerConfigBytes, err := json.Marshal(providerConfig)
+			if err != nil {
+				return err
+			}
 
-		machineClasses = append(machineClasses, &machinev1alpha1.MachineClass{
-			TypeMeta: metav1.TypeMeta{
-				APIVersion: machinev1alpha1.SchemeGroupVersion.String(),
-				Kind:       "MachineClass",
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      className,
-				Namespace: w.worker.Namespace,
-			},
-			SecretRef: &corev1.SecretReference{
-				Name:      className,
-				Namespace: w.worker.Namespace,
-			},
-			CredentialsSecretRef: &corev1.SecretReference{
-				Name:      w.worker.Spec.SecretRef.Name,
-				Namespace: w.worker.Spec.SecretRef.Namespace,
-			},
-			Provider:     local.Type,
-			ProviderSpec: runtime.RawExtension{Raw: providerConfigBytes},
-		})
-
-		machineDeployments = append(machineDeployments, worker.MachineDeployment{
-			Name:                         deploymentName,
-			ClassName:                    className,
-			SecretName:                   className,
-			Minimum:                      pool.Minimum,
-			Maximum:                      pool.Maximum,
-			MaxSurge:                     pool.MaxSurge,
-			MaxUnavailable:               pool.MaxUnavailable,
-			Labels:                       pool.Labels,
-			Annotations:                  pool.Annotations,
-			Taints:                       pool.Taints,
-			MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
-			ClusterAutoscalerAnnotations: extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler),
-		})
+			machineClasses = append(machineClasses, &machinev1alpha1.MachineClass{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: machinev1alpha1.SchemeGroupVersion.String(),
+					Kind:       "MachineClass",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      className,
+					Namespace: w.worker.Namespace,
+				},
+				SecretRef: &corev1.SecretReference{
+					Name:      className,
+					Namespace: w.worker.Namespace,
+				},
+				CredentialsSecretRef: &corev1.SecretReference{
+					Name:      w.worker.Spec.SecretRef.Name,
+					Namespace: w.worker.Spec.SecretRef.Namespace,
+				},
+				Provider:     local.Type,
+				ProviderSpec: runtime.RawExtension{Raw: providerConfigBytes},
+			})
+
+			machineDeployments = append(machineDeployments, worker.MachineDeployment{
+				Name:                         deploymentName,
+				ClassName:                    className,
+				SecretName:                   className,
+				Minimum:                      pool.Minimum,
+				Maximum:                      pool.Maximum,
+				MaxSurge:                     pool.MaxSurge,
+				MaxUnavailable:               pool.MaxUnavailable,
+				Labels:                       pool.Labels,
+				Annotations:                  pool.Annotations,
+				Taints:                       pool.Taints,
+				MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
+				ClusterAutoscalerAnnotations: extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler),
+				Priority:                     prioritizedPool.Priority,
+			})
+		}
 	}
 
 	w.machineClassSecrets = machineClassSecrets
@@ -33,3 +33,9 @@ func init() {
 func ImageVector() imagevector.ImageVector {
 	return imageVector
 }
+
+// ApplyRewriteRules applies the given rewrite rules to the images of the image vector. It is called on startup with the
+// rewrite rules of the extension configuration.
+func ApplyRewriteRules(rules []imagevector.RewriteRule) {
+	imageVector = imagevector.WithRewriteRules(imageVector, rules)
+}
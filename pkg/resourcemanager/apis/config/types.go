@@ -100,6 +100,8 @@ type ResourceManagerControllerConfiguration struct {
 	NodeCriticalComponents NodeCriticalComponentsControllerConfig
 	// NodeAgentReconciliationDelay is the configuration for the node-agent reconciliation delay controller.
 	NodeAgentReconciliationDelay NodeAgentReconciliationDelayControllerConfig
+	// NodeDrain is the configuration for the node drain controller.
+	NodeDrain NodeDrainControllerConfig
 	// TokenInvalidator is the configuration for the token-invalidator controller.
 	TokenInvalidator TokenInvalidatorControllerConfig
 	// TokenRequestor is the configuration for the token-requestor controller.
@@ -114,6 +116,34 @@ type KubeletCSRApproverControllerConfig struct {
 	ConcurrentSyncs *int
 	// MachineNamespace is the namespace in the source cluster in which the Machine objects are stored.
 	MachineNamespace string
+	// NodeAgentAttestationVerifierURL is the URL of the verification endpoint which is used for checking the attestation
+	// documents of machines requesting client certificates for gardener-node-agent. The endpoint is served by the
+	// extension of the infrastructure provider. If not set, certificate signing requests with attestation documents are
+	// not approved. The endpoint must be served via HTTPS.
+	NodeAgentAttestationVerifierURL *string
+	// NodeAgentAttestationVerifierCAFile is the path of the file containing the CA bundle for verifying the serving
+	// certificate of the verification endpoint. It is required if NodeAgentAttestationVerifierURL is set.
+	NodeAgentAttestationVerifierCAFile *string
+	// NodeAgentAttestationVerifierTokenFile is the path of the file containing the bearer token which is sent to the
+	// verification endpoint for authentication. It is required if NodeAgentAttestationVerifierURL is set.
+	NodeAgentAttestationVerifierTokenFile *string
+	// ServingCertificatePolicy contains additional policies for approving certificate signing requests of kubelet
+	// server certificates.
+	ServingCertificatePolicy *KubeletServingCertificatePolicy
+}
+
+// KubeletServingCertificatePolicy contains policies for approving certificate signing requests of kubelet server
+// certificates.
+type KubeletServingCertificatePolicy struct {
+	// AllowedDNSNamePatterns is a list of regular expressions. If set, each DNS name in the SANs of a certificate signing
+	// request must fully match at least one of them.
+	AllowedDNSNamePatterns []string
+	// AllowedIPRanges is a list of CIDRs. If set, each IP address in the SANs of a certificate signing request must be
+	// contained in at least one of them.
+	AllowedIPRanges []string
+	// MaxValidity is the maximum validity which may be requested by certificate signing requests. If set, certificate
+	// signing requests must request a validity.
+	MaxValidity *metav1.Duration
 }
 
 // GarbageCollectorControllerConfig is the configuration for the garbage-collector controller.
@@ -206,6 +236,14 @@ type NodeAgentReconciliationDelayControllerConfig struct {
 	MaxDelay *metav1.Duration
 }
 
+// NodeDrainControllerConfig is the configuration for the node drain controller.
+type NodeDrainControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	ConcurrentSyncs *int
+}
+
 // ResourceManagerWebhookConfiguration defines the configuration of the webhooks.
 type ResourceManagerWebhookConfiguration struct {
 	// CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
@@ -218,6 +256,8 @@ type ResourceManagerWebhookConfiguration struct {
 	HighAvailabilityConfig HighAvailabilityConfigWebhookConfig
 	// KubernetesServiceHost is the configuration for the kubernetes-service-host webhook.
 	KubernetesServiceHost KubernetesServiceHostWebhookConfig
+	// PodEviction is the configuration for the pod-eviction webhook.
+	PodEviction PodEvictionWebhookConfig
 	// PodSchedulerName is the configuration for the pod-scheduler-name webhook.
 	PodSchedulerName PodSchedulerNameWebhookConfig
 	// PodTopologySpreadConstraints is the configuration for the pod-topology-spread-constraints webhook.
@@ -282,6 +322,12 @@ type SystemComponentsConfigWebhookConfig struct {
 	PodTolerations []corev1.Toleration
 }
 
+// PodEvictionWebhookConfig is the configuration for the pod-eviction webhook.
+type PodEvictionWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool
+}
+
 // PodSchedulerNameWebhookConfig is the configuration for the pod-scheduler-name webhook.
 type PodSchedulerNameWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
@@ -176,6 +176,13 @@ func SetDefaults_NodeAgentReconciliationDelayControllerConfig(obj *NodeAgentReco
 	}
 }
 
+// SetDefaults_NodeDrainControllerConfig sets defaults for the NodeDrainControllerConfig object.
+func SetDefaults_NodeDrainControllerConfig(obj *NodeDrainControllerConfig) {
+	if obj.Enabled && obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+}
+
 // SetDefaults_PodSchedulerNameWebhookConfig sets defaults for the PodSchedulerNameWebhookConfig object.
 func SetDefaults_PodSchedulerNameWebhookConfig(obj *PodSchedulerNameWebhookConfig) {
 	if obj.Enabled && obj.SchedulerName == nil {
@@ -477,6 +477,37 @@ var _ = Describe("ResourceManager defaulting", func() {
 		})
 	})
 
+	Describe("NodeDrainControllerConfig defaulting", func() {
+		It("should not default the NodeDrainControllerConfig because it is disabled", func() {
+			obj.Controllers.NodeDrain = NodeDrainControllerConfig{}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NodeDrain.ConcurrentSyncs).To(BeNil())
+		})
+
+		It("should default the NodeDrainControllerConfig because it is enabled", func() {
+			obj.Controllers.NodeDrain = NodeDrainControllerConfig{
+				Enabled: true,
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NodeDrain.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite already set values for NodeDrainControllerConfig", func() {
+			obj.Controllers.NodeDrain = NodeDrainControllerConfig{
+				Enabled:         true,
+				ConcurrentSyncs: ptr.To(2),
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NodeDrain.ConcurrentSyncs).To(PointTo(Equal(2)))
+		})
+	})
+
 	Describe("PodSchedulerNameWebhookConfig defaulting", func() {
 		It("should not default the PodSchedulerNameWebhookConfig because it is disabled", func() {
 			obj.Webhooks.PodSchedulerName = PodSchedulerNameWebhookConfig{}
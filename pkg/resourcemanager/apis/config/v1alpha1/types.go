@@ -109,6 +109,8 @@ type ResourceManagerControllerConfiguration struct {
 	NodeCriticalComponents NodeCriticalComponentsControllerConfig `json:"nodeCriticalComponents"`
 	// NodeAgentReconciliationDelay is the configuration for the node-agent reconciliation delay controller.
 	NodeAgentReconciliationDelay NodeAgentReconciliationDelayControllerConfig `json:"nodeAgentReconciliationDelay"`
+	// NodeDrain is the configuration for the node drain controller.
+	NodeDrain NodeDrainControllerConfig `json:"nodeDrain"`
 	// TokenInvalidator is the configuration for the token-invalidator controller.
 	TokenInvalidator TokenInvalidatorControllerConfig `json:"tokenInvalidator"`
 	// TokenRequestor is the configuration for the token-requestor controller.
@@ -124,6 +126,41 @@ type KubeletCSRApproverControllerConfig struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 	// MachineNamespace is the namespace in the source cluster in which the Machine objects are stored.
 	MachineNamespace string `json:"machineNamespace"`
+	// NodeAgentAttestationVerifierURL is the URL of the verification endpoint which is used for checking the attestation
+	// documents of machines requesting client certificates for gardener-node-agent. The endpoint is served by the
+	// extension of the infrastructure provider. If not set, certificate signing requests with attestation documents are
+	// not approved. The endpoint must be served via HTTPS.
+	// +optional
+	NodeAgentAttestationVerifierURL *string `json:"nodeAgentAttestationVerifierURL,omitempty"`
+	// NodeAgentAttestationVerifierCAFile is the path of the file containing the CA bundle for verifying the serving
+	// certificate of the verification endpoint. It is required if NodeAgentAttestationVerifierURL is set.
+	// +optional
+	NodeAgentAttestationVerifierCAFile *string `json:"nodeAgentAttestationVerifierCAFile,omitempty"`
+	// NodeAgentAttestationVerifierTokenFile is the path of the file containing the bearer token which is sent to the
+	// verification endpoint for authentication. It is required if NodeAgentAttestationVerifierURL is set.
+	// +optional
+	NodeAgentAttestationVerifierTokenFile *string `json:"nodeAgentAttestationVerifierTokenFile,omitempty"`
+	// ServingCertificatePolicy contains additional policies for approving certificate signing requests of kubelet
+	// server certificates.
+	// +optional
+	ServingCertificatePolicy *KubeletServingCertificatePolicy `json:"servingCertificatePolicy,omitempty"`
+}
+
+// KubeletServingCertificatePolicy contains policies for approving certificate signing requests of kubelet server
+// certificates.
+type KubeletServingCertificatePolicy struct {
+	// AllowedDNSNamePatterns is a list of regular expressions. If set, each DNS name in the SANs of a certificate signing
+	// request must fully match at least one of them.
+	// +optional
+	AllowedDNSNamePatterns []string `json:"allowedDNSNamePatterns,omitempty"`
+	// AllowedIPRanges is a list of CIDRs. If set, each IP address in the SANs of a certificate signing request must be
+	// contained in at least one of them.
+	// +optional
+	AllowedIPRanges []string `json:"allowedIPRanges,omitempty"`
+	// MaxValidity is the maximum validity which may be requested by certificate signing requests. If set, certificate
+	// signing requests must request a validity.
+	// +optional
+	MaxValidity *metav1.Duration `json:"maxValidity,omitempty"`
 }
 
 // GarbageCollectorControllerConfig is the configuration for the garbage-collector controller.
@@ -232,6 +269,15 @@ type NodeAgentReconciliationDelayControllerConfig struct {
 	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
 }
 
+// NodeDrainControllerConfig is the configuration for the node drain controller.
+type NodeDrainControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ResourceManagerWebhookConfiguration defines the configuration of the webhooks.
 type ResourceManagerWebhookConfiguration struct {
 	// CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
@@ -246,6 +292,8 @@ type ResourceManagerWebhookConfiguration struct {
 	KubernetesServiceHost KubernetesServiceHostWebhookConfig `json:"kubernetesServiceHost"`
 	// SystemComponentsConfig is the configuration for the system-components-config webhook.
 	SystemComponentsConfig SystemComponentsConfigWebhookConfig `json:"systemComponentsConfig"`
+	// PodEviction is the configuration for the pod-eviction webhook.
+	PodEviction PodEvictionWebhookConfig `json:"podEviction"`
 	// PodSchedulerName is the configuration for the pod-scheduler-name webhook.
 	PodSchedulerName PodSchedulerNameWebhookConfig `json:"podSchedulerName"`
 	// PodTopologySpreadConstraints is the configuration for the pod-topology-spread-constraints webhook.
@@ -313,6 +361,12 @@ type SystemComponentsConfigWebhookConfig struct {
 	PodTolerations []corev1.Toleration `json:"podTolerations,omitempty"`
 }
 
+// PodEvictionWebhookConfig is the configuration for the pod-eviction webhook.
+type PodEvictionWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+}
+
 // PodSchedulerNameWebhookConfig is the configuration for the pod-scheduler-name webhook.
 type PodSchedulerNameWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
@@ -128,6 +128,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletServingCertificatePolicy)(nil), (*config.KubeletServingCertificatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeletServingCertificatePolicy_To_config_KubeletServingCertificatePolicy(a.(*KubeletServingCertificatePolicy), b.(*config.KubeletServingCertificatePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.KubeletServingCertificatePolicy)(nil), (*KubeletServingCertificatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_KubeletServingCertificatePolicy_To_v1alpha1_KubeletServingCertificatePolicy(a.(*config.KubeletServingCertificatePolicy), b.(*KubeletServingCertificatePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubernetesServiceHostWebhookConfig)(nil), (*config.KubernetesServiceHostWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubernetesServiceHostWebhookConfig_To_config_KubernetesServiceHostWebhookConfig(a.(*KubernetesServiceHostWebhookConfig), b.(*config.KubernetesServiceHostWebhookConfig), scope)
 	}); err != nil {
@@ -178,6 +188,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeDrainControllerConfig)(nil), (*config.NodeDrainControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeDrainControllerConfig_To_config_NodeDrainControllerConfig(a.(*NodeDrainControllerConfig), b.(*config.NodeDrainControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NodeDrainControllerConfig)(nil), (*NodeDrainControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeDrainControllerConfig_To_v1alpha1_NodeDrainControllerConfig(a.(*config.NodeDrainControllerConfig), b.(*NodeDrainControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodEvictionWebhookConfig)(nil), (*config.PodEvictionWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodEvictionWebhookConfig_To_config_PodEvictionWebhookConfig(a.(*PodEvictionWebhookConfig), b.(*config.PodEvictionWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodEvictionWebhookConfig)(nil), (*PodEvictionWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodEvictionWebhookConfig_To_v1alpha1_PodEvictionWebhookConfig(a.(*config.PodEvictionWebhookConfig), b.(*PodEvictionWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodSchedulerNameWebhookConfig)(nil), (*config.PodSchedulerNameWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(a.(*PodSchedulerNameWebhookConfig), b.(*config.PodSchedulerNameWebhookConfig), scope)
 	}); err != nil {
@@ -533,6 +563,10 @@ func autoConvert_v1alpha1_KubeletCSRApproverControllerConfig_To_config_KubeletCS
 	out.Enabled = in.Enabled
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MachineNamespace = in.MachineNamespace
+	out.NodeAgentAttestationVerifierURL = (*string)(unsafe.Pointer(in.NodeAgentAttestationVerifierURL))
+	out.NodeAgentAttestationVerifierCAFile = (*string)(unsafe.Pointer(in.NodeAgentAttestationVerifierCAFile))
+	out.NodeAgentAttestationVerifierTokenFile = (*string)(unsafe.Pointer(in.NodeAgentAttestationVerifierTokenFile))
+	out.ServingCertificatePolicy = (*config.KubeletServingCertificatePolicy)(unsafe.Pointer(in.ServingCertificatePolicy))
 	return nil
 }
 
@@ -545,6 +579,10 @@ func autoConvert_config_KubeletCSRApproverControllerConfig_To_v1alpha1_KubeletCS
 	out.Enabled = in.Enabled
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MachineNamespace = in.MachineNamespace
+	out.NodeAgentAttestationVerifierURL = (*string)(unsafe.Pointer(in.NodeAgentAttestationVerifierURL))
+	out.NodeAgentAttestationVerifierCAFile = (*string)(unsafe.Pointer(in.NodeAgentAttestationVerifierCAFile))
+	out.NodeAgentAttestationVerifierTokenFile = (*string)(unsafe.Pointer(in.NodeAgentAttestationVerifierTokenFile))
+	out.ServingCertificatePolicy = (*KubeletServingCertificatePolicy)(unsafe.Pointer(in.ServingCertificatePolicy))
 	return nil
 }
 
@@ -553,6 +591,30 @@ func Convert_config_KubeletCSRApproverControllerConfig_To_v1alpha1_KubeletCSRApp
 	return autoConvert_config_KubeletCSRApproverControllerConfig_To_v1alpha1_KubeletCSRApproverControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_KubeletServingCertificatePolicy_To_config_KubeletServingCertificatePolicy(in *KubeletServingCertificatePolicy, out *config.KubeletServingCertificatePolicy, s conversion.Scope) error {
+	out.AllowedDNSNamePatterns = *(*[]string)(unsafe.Pointer(&in.AllowedDNSNamePatterns))
+	out.AllowedIPRanges = *(*[]string)(unsafe.Pointer(&in.AllowedIPRanges))
+	out.MaxValidity = (*v1.Duration)(unsafe.Pointer(in.MaxValidity))
+	return nil
+}
+
+// Convert_v1alpha1_KubeletServingCertificatePolicy_To_config_KubeletServingCertificatePolicy is an autogenerated conversion function.
+func Convert_v1alpha1_KubeletServingCertificatePolicy_To_config_KubeletServingCertificatePolicy(in *KubeletServingCertificatePolicy, out *config.KubeletServingCertificatePolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeletServingCertificatePolicy_To_config_KubeletServingCertificatePolicy(in, out, s)
+}
+
+func autoConvert_config_KubeletServingCertificatePolicy_To_v1alpha1_KubeletServingCertificatePolicy(in *config.KubeletServingCertificatePolicy, out *KubeletServingCertificatePolicy, s conversion.Scope) error {
+	out.AllowedDNSNamePatterns = *(*[]string)(unsafe.Pointer(&in.AllowedDNSNamePatterns))
+	out.AllowedIPRanges = *(*[]string)(unsafe.Pointer(&in.AllowedIPRanges))
+	out.MaxValidity = (*v1.Duration)(unsafe.Pointer(in.MaxValidity))
+	return nil
+}
+
+// Convert_config_KubeletServingCertificatePolicy_To_v1alpha1_KubeletServingCertificatePolicy is an autogenerated conversion function.
+func Convert_config_KubeletServingCertificatePolicy_To_v1alpha1_KubeletServingCertificatePolicy(in *config.KubeletServingCertificatePolicy, out *KubeletServingCertificatePolicy, s conversion.Scope) error {
+	return autoConvert_config_KubeletServingCertificatePolicy_To_v1alpha1_KubeletServingCertificatePolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubernetesServiceHostWebhookConfig_To_config_KubernetesServiceHostWebhookConfig(in *KubernetesServiceHostWebhookConfig, out *config.KubernetesServiceHostWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Host = in.Host
@@ -675,6 +737,48 @@ func Convert_config_NodeCriticalComponentsControllerConfig_To_v1alpha1_NodeCriti
 	return autoConvert_config_NodeCriticalComponentsControllerConfig_To_v1alpha1_NodeCriticalComponentsControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeDrainControllerConfig_To_config_NodeDrainControllerConfig(in *NodeDrainControllerConfig, out *config.NodeDrainControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_NodeDrainControllerConfig_To_config_NodeDrainControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NodeDrainControllerConfig_To_config_NodeDrainControllerConfig(in *NodeDrainControllerConfig, out *config.NodeDrainControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeDrainControllerConfig_To_config_NodeDrainControllerConfig(in, out, s)
+}
+
+func autoConvert_config_NodeDrainControllerConfig_To_v1alpha1_NodeDrainControllerConfig(in *config.NodeDrainControllerConfig, out *NodeDrainControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_NodeDrainControllerConfig_To_v1alpha1_NodeDrainControllerConfig is an autogenerated conversion function.
+func Convert_config_NodeDrainControllerConfig_To_v1alpha1_NodeDrainControllerConfig(in *config.NodeDrainControllerConfig, out *NodeDrainControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_NodeDrainControllerConfig_To_v1alpha1_NodeDrainControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodEvictionWebhookConfig_To_config_PodEvictionWebhookConfig(in *PodEvictionWebhookConfig, out *config.PodEvictionWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_PodEvictionWebhookConfig_To_config_PodEvictionWebhookConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PodEvictionWebhookConfig_To_config_PodEvictionWebhookConfig(in *PodEvictionWebhookConfig, out *config.PodEvictionWebhookConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodEvictionWebhookConfig_To_config_PodEvictionWebhookConfig(in, out, s)
+}
+
+func autoConvert_config_PodEvictionWebhookConfig_To_v1alpha1_PodEvictionWebhookConfig(in *config.PodEvictionWebhookConfig, out *PodEvictionWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_config_PodEvictionWebhookConfig_To_v1alpha1_PodEvictionWebhookConfig is an autogenerated conversion function.
+func Convert_config_PodEvictionWebhookConfig_To_v1alpha1_PodEvictionWebhookConfig(in *config.PodEvictionWebhookConfig, out *PodEvictionWebhookConfig, s conversion.Scope) error {
+	return autoConvert_config_PodEvictionWebhookConfig_To_v1alpha1_PodEvictionWebhookConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(in *PodSchedulerNameWebhookConfig, out *config.PodSchedulerNameWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
@@ -851,6 +955,9 @@ func autoConvert_v1alpha1_ResourceManagerControllerConfiguration_To_config_Resou
 	if err := Convert_v1alpha1_NodeAgentReconciliationDelayControllerConfig_To_config_NodeAgentReconciliationDelayControllerConfig(&in.NodeAgentReconciliationDelay, &out.NodeAgentReconciliationDelay, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_NodeDrainControllerConfig_To_config_NodeDrainControllerConfig(&in.NodeDrain, &out.NodeDrain, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_TokenInvalidatorControllerConfig_To_config_TokenInvalidatorControllerConfig(&in.TokenInvalidator, &out.TokenInvalidator, s); err != nil {
 		return err
 	}
@@ -889,6 +996,9 @@ func autoConvert_config_ResourceManagerControllerConfiguration_To_v1alpha1_Resou
 	if err := Convert_config_NodeAgentReconciliationDelayControllerConfig_To_v1alpha1_NodeAgentReconciliationDelayControllerConfig(&in.NodeAgentReconciliationDelay, &out.NodeAgentReconciliationDelay, s); err != nil {
 		return err
 	}
+	if err := Convert_config_NodeDrainControllerConfig_To_v1alpha1_NodeDrainControllerConfig(&in.NodeDrain, &out.NodeDrain, s); err != nil {
+		return err
+	}
 	if err := Convert_config_TokenInvalidatorControllerConfig_To_v1alpha1_TokenInvalidatorControllerConfig(&in.TokenInvalidator, &out.TokenInvalidator, s); err != nil {
 		return err
 	}
@@ -922,6 +1032,9 @@ func autoConvert_v1alpha1_ResourceManagerWebhookConfiguration_To_config_Resource
 	if err := Convert_v1alpha1_SystemComponentsConfigWebhookConfig_To_config_SystemComponentsConfigWebhookConfig(&in.SystemComponentsConfig, &out.SystemComponentsConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_PodEvictionWebhookConfig_To_config_PodEvictionWebhookConfig(&in.PodEviction, &out.PodEviction, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(&in.PodSchedulerName, &out.PodSchedulerName, s); err != nil {
 		return err
 	}
@@ -961,6 +1074,9 @@ func autoConvert_config_ResourceManagerWebhookConfiguration_To_v1alpha1_Resource
 	if err := Convert_config_KubernetesServiceHostWebhookConfig_To_v1alpha1_KubernetesServiceHostWebhookConfig(&in.KubernetesServiceHost, &out.KubernetesServiceHost, s); err != nil {
 		return err
 	}
+	if err := Convert_config_PodEvictionWebhookConfig_To_v1alpha1_PodEvictionWebhookConfig(&in.PodEviction, &out.PodEviction, s); err != nil {
+		return err
+	}
 	if err := Convert_config_PodSchedulerNameWebhookConfig_To_v1alpha1_PodSchedulerNameWebhookConfig(&in.PodSchedulerName, &out.PodSchedulerName, s); err != nil {
 		return err
 	}
@@ -5,6 +5,10 @@
 package validation
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -92,6 +96,22 @@ func validateResourceManagerControllerConfiguration(conf config.ResourceManagerC
 
 	if conf.KubeletCSRApprover.Enabled {
 		allErrs = append(allErrs, validateConcurrentSyncs(conf.KubeletCSRApprover.ConcurrentSyncs, fldPath.Child("kubeletCSRApprover"))...)
+
+		if verifierURL := conf.KubeletCSRApprover.NodeAgentAttestationVerifierURL; verifierURL != nil {
+			if u, err := url.Parse(*verifierURL); err != nil || u.Scheme != "https" || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("kubeletCSRApprover", "nodeAgentAttestationVerifierURL"), *verifierURL, "must be a valid https URL"))
+			}
+			if ptr.Deref(conf.KubeletCSRApprover.NodeAgentAttestationVerifierCAFile, "") == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("kubeletCSRApprover", "nodeAgentAttestationVerifierCAFile"), "must be set when nodeAgentAttestationVerifierURL is set"))
+			}
+			if ptr.Deref(conf.KubeletCSRApprover.NodeAgentAttestationVerifierTokenFile, "") == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("kubeletCSRApprover", "nodeAgentAttestationVerifierTokenFile"), "must be set when nodeAgentAttestationVerifierURL is set"))
+			}
+		}
+
+		if policy := conf.KubeletCSRApprover.ServingCertificatePolicy; policy != nil {
+			allErrs = append(allErrs, validateKubeletServingCertificatePolicy(*policy, fldPath.Child("kubeletCSRApprover", "servingCertificatePolicy"))...)
+		}
 	}
 
 	if conf.GarbageCollector.Enabled {
@@ -111,6 +131,32 @@ func validateResourceManagerControllerConfiguration(conf config.ResourceManagerC
 		allErrs = append(allErrs, validateNodeAgentReconciliationDelayControllerConfiguration(conf.NodeAgentReconciliationDelay, fldPath.Child("nodeAgentReconciliationDelay"))...)
 	}
 
+	if conf.NodeDrain.Enabled {
+		allErrs = append(allErrs, validateConcurrentSyncs(conf.NodeDrain.ConcurrentSyncs, fldPath.Child("nodeDrain"))...)
+	}
+
+	return allErrs
+}
+
+func validateKubeletServingCertificatePolicy(policy config.KubeletServingCertificatePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, pattern := range policy.AllowedDNSNamePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedDNSNamePatterns").Index(i), pattern, fmt.Sprintf("must be a valid regular expression: %v", err)))
+		}
+	}
+
+	for i, ipRange := range policy.AllowedIPRanges {
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedIPRanges").Index(i), ipRange, fmt.Sprintf("must be a valid CIDR: %v", err)))
+		}
+	}
+
+	if policy.MaxValidity != nil && policy.MaxValidity.Duration < 10*time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxValidity"), policy.MaxValidity.Duration.String(), "must be at least 10m"))
+	}
+
 	return allErrs
 }
 
@@ -184,6 +184,89 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow a valid node-agent attestation verifier configuration", func() {
+					conf.Controllers.KubeletCSRApprover.Enabled = true
+					conf.Controllers.KubeletCSRApprover.ConcurrentSyncs = ptr.To(1)
+					conf.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierURL = ptr.To("https://node-agent-attestation-verifier.shoot--foo--bar.svc/verify")
+					conf.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierCAFile = ptr.To("/var/run/secrets/ca.crt")
+					conf.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierTokenFile = ptr.To("/var/run/secrets/token")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors because the node-agent attestation verifier URL is invalid", func() {
+					conf.Controllers.KubeletCSRApprover.Enabled = true
+					conf.Controllers.KubeletCSRApprover.ConcurrentSyncs = ptr.To(1)
+					conf.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierURL = ptr.To("foo")
+					conf.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierCAFile = ptr.To("/var/run/secrets/ca.crt")
+					conf.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierTokenFile = ptr.To("/var/run/secrets/token")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.kubeletCSRApprover.nodeAgentAttestationVerifierURL"),
+						})),
+					))
+				})
+
+				It("should return errors because the node-agent attestation verifier is not served via https and its CA and token are missing", func() {
+					conf.Controllers.KubeletCSRApprover.Enabled = true
+					conf.Controllers.KubeletCSRApprover.ConcurrentSyncs = ptr.To(1)
+					conf.Controllers.KubeletCSRApprover.NodeAgentAttestationVerifierURL = ptr.To("http://node-agent-attestation-verifier.shoot--foo--bar.svc/verify")
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.kubeletCSRApprover.nodeAgentAttestationVerifierURL"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.kubeletCSRApprover.nodeAgentAttestationVerifierCAFile"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.kubeletCSRApprover.nodeAgentAttestationVerifierTokenFile"),
+						})),
+					))
+				})
+
+				It("should allow a valid serving certificate policy", func() {
+					conf.Controllers.KubeletCSRApprover.Enabled = true
+					conf.Controllers.KubeletCSRApprover.ConcurrentSyncs = ptr.To(1)
+					conf.Controllers.KubeletCSRApprover.ServingCertificatePolicy = &config.KubeletServingCertificatePolicy{
+						AllowedDNSNamePatterns: []string{`ip-10-.*\.ec2\.internal`},
+						AllowedIPRanges:        []string{"10.250.0.0/16", "2001:db8::/64"},
+						MaxValidity:            &metav1.Duration{Duration: 24 * time.Hour},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors because the serving certificate policy is invalid", func() {
+					conf.Controllers.KubeletCSRApprover.Enabled = true
+					conf.Controllers.KubeletCSRApprover.ConcurrentSyncs = ptr.To(1)
+					conf.Controllers.KubeletCSRApprover.ServingCertificatePolicy = &config.KubeletServingCertificatePolicy{
+						AllowedDNSNamePatterns: []string{"foo", "(bar"},
+						AllowedIPRanges:        []string{"10.250.0.0/16", "10.250.0.1"},
+						MaxValidity:            &metav1.Duration{Duration: time.Minute},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.kubeletCSRApprover.servingCertificatePolicy.allowedDNSNamePatterns[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.kubeletCSRApprover.servingCertificatePolicy.allowedIPRanges[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.kubeletCSRApprover.servingCertificatePolicy.maxValidity"),
+						})),
+					))
+				})
 			})
 
 			Context("garbage collector", func() {
@@ -207,6 +207,26 @@ func (in *KubeletCSRApproverControllerConfig) DeepCopyInto(out *KubeletCSRApprov
 		*out = new(int)
 		**out = **in
 	}
+	if in.NodeAgentAttestationVerifierURL != nil {
+		in, out := &in.NodeAgentAttestationVerifierURL, &out.NodeAgentAttestationVerifierURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeAgentAttestationVerifierCAFile != nil {
+		in, out := &in.NodeAgentAttestationVerifierCAFile, &out.NodeAgentAttestationVerifierCAFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeAgentAttestationVerifierTokenFile != nil {
+		in, out := &in.NodeAgentAttestationVerifierTokenFile, &out.NodeAgentAttestationVerifierTokenFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServingCertificatePolicy != nil {
+		in, out := &in.ServingCertificatePolicy, &out.ServingCertificatePolicy
+		*out = new(KubeletServingCertificatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -220,6 +240,37 @@ func (in *KubeletCSRApproverControllerConfig) DeepCopy() *KubeletCSRApproverCont
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletServingCertificatePolicy) DeepCopyInto(out *KubeletServingCertificatePolicy) {
+	*out = *in
+	if in.AllowedDNSNamePatterns != nil {
+		in, out := &in.AllowedDNSNamePatterns, &out.AllowedDNSNamePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIPRanges != nil {
+		in, out := &in.AllowedIPRanges, &out.AllowedIPRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxValidity != nil {
+		in, out := &in.MaxValidity, &out.MaxValidity
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletServingCertificatePolicy.
+func (in *KubeletServingCertificatePolicy) DeepCopy() *KubeletServingCertificatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletServingCertificatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesServiceHostWebhookConfig) DeepCopyInto(out *KubernetesServiceHostWebhookConfig) {
 	*out = *in
@@ -357,6 +408,43 @@ func (in *NodeCriticalComponentsControllerConfig) DeepCopy() *NodeCriticalCompon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainControllerConfig) DeepCopyInto(out *NodeDrainControllerConfig) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainControllerConfig.
+func (in *NodeDrainControllerConfig) DeepCopy() *NodeDrainControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodEvictionWebhookConfig) DeepCopyInto(out *PodEvictionWebhookConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodEvictionWebhookConfig.
+func (in *PodEvictionWebhookConfig) DeepCopy() *PodEvictionWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodEvictionWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSchedulerNameWebhookConfig) DeepCopyInto(out *PodSchedulerNameWebhookConfig) {
 	*out = *in
@@ -475,6 +563,7 @@ func (in *ResourceManagerControllerConfiguration) DeepCopyInto(out *ResourceMana
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	in.NodeCriticalComponents.DeepCopyInto(&out.NodeCriticalComponents)
 	in.NodeAgentReconciliationDelay.DeepCopyInto(&out.NodeAgentReconciliationDelay)
+	in.NodeDrain.DeepCopyInto(&out.NodeDrain)
 	in.TokenInvalidator.DeepCopyInto(&out.TokenInvalidator)
 	in.TokenRequestor.DeepCopyInto(&out.TokenRequestor)
 	return
@@ -498,6 +587,7 @@ func (in *ResourceManagerWebhookConfiguration) DeepCopyInto(out *ResourceManager
 	out.ExtensionValidation = in.ExtensionValidation
 	in.HighAvailabilityConfig.DeepCopyInto(&out.HighAvailabilityConfig)
 	out.KubernetesServiceHost = in.KubernetesServiceHost
+	out.PodEviction = in.PodEviction
 	in.PodSchedulerName.DeepCopyInto(&out.PodSchedulerName)
 	out.PodTopologySpreadConstraints = in.PodTopologySpreadConstraints
 	in.ProjectedTokenMount.DeepCopyInto(&out.ProjectedTokenMount)
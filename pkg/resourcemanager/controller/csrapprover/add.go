@@ -5,6 +5,9 @@
 package csrapprover
 
 import (
+	"fmt"
+	"strings"
+
 	certificatesv1 "k8s.io/api/certificates/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -17,6 +20,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
 )
 
 // ControllerName is the name of the controller.
@@ -30,6 +35,27 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 	if r.TargetClient == nil {
 		r.TargetClient = targetCluster.GetClient()
 	}
+	if r.Recorder == nil {
+		r.Recorder = targetCluster.GetEventRecorderFor(ControllerName + "-controller")
+	}
+	if r.AttestationVerifier == nil && r.Config.NodeAgentAttestationVerifierURL != nil {
+		verifier, err := attestation.NewWebhookVerifier(
+			*r.Config.NodeAgentAttestationVerifierURL,
+			ptr.Deref(r.Config.NodeAgentAttestationVerifierCAFile, ""),
+			ptr.Deref(r.Config.NodeAgentAttestationVerifierTokenFile, ""),
+		)
+		if err != nil {
+			return fmt.Errorf("failed creating attestation verifier: %w", err)
+		}
+		r.AttestationVerifier = verifier
+	}
+	if r.Config.ServingCertificatePolicy != nil {
+		policy, err := newServingCertificatePolicy(*r.Config.ServingCertificatePolicy)
+		if err != nil {
+			return err
+		}
+		r.servingCertificatePolicy = policy
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -44,8 +70,25 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 				predicateutils.ForEventTypes(predicateutils.Create, predicateutils.Update),
 				predicate.NewPredicateFuncs(func(obj client.Object) bool {
 					csr, ok := obj.(*certificatesv1.CertificateSigningRequest)
-					return ok && csr.Spec.SignerName == certificatesv1.KubeletServingSignerName
+					return ok && (csr.Spec.SignerName == certificatesv1.KubeletServingSignerName || r.isAttestedClientCertificateRequest(csr) || r.isAttestedKubeletClientCertificateRequest(csr))
 				}),
 			),
 		).Complete(r)
 }
+
+// isAttestedClientCertificateRequest returns whether the given CSR was created by gardener-node-agent for proving the
+// identity of its machine. Such CSRs are only handled if attestation is enabled.
+func (r *Reconciler) isAttestedClientCertificateRequest(csr *certificatesv1.CertificateSigningRequest) bool {
+	_, hasDocument := csr.Annotations[nodeagentv1alpha1.AnnotationKeyAttestationDocument]
+	return r.AttestationVerifier != nil &&
+		csr.Spec.SignerName == certificatesv1.KubeAPIServerClientSignerName &&
+		hasDocument
+}
+
+// isAttestedKubeletClientCertificateRequest returns whether the given CSR was created by kubelet with the attested
+// client certificate of gardener-node-agent. Such CSRs are only handled if attestation is enabled.
+func (r *Reconciler) isAttestedKubeletClientCertificateRequest(csr *certificatesv1.CertificateSigningRequest) bool {
+	return r.AttestationVerifier != nil &&
+		csr.Spec.SignerName == certificatesv1.KubeAPIServerClientKubeletSignerName &&
+		strings.HasPrefix(csr.Spec.Username, nodeagentv1alpha1.AttestedUserNamePrefix)
+}
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrapprover
+
+import (
+	"crypto/x509"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// CheckServingCertificatePolicy parses the given policy and checks the given CSR against it. Exported for testing.
+func CheckServingCertificatePolicy(policy config.KubeletServingCertificatePolicy, csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) (string, error) {
+	p, err := newServingCertificatePolicy(policy)
+	if err != nil {
+		return "", err
+	}
+	return p.check(csr, x509cr), nil
+}
@@ -7,9 +7,13 @@ package csrapprover
 import (
 	"context"
 	"crypto/x509"
+	"encoding/base64"
 	"fmt"
+	"net"
+	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -18,12 +22,17 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/authentication/user"
 	certificatesclientv1 "k8s.io/client-go/kubernetes/typed/certificates/v1"
+	"k8s.io/client-go/tools/record"
+	csrutil "k8s.io/client-go/util/certificate/csr"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -34,6 +43,12 @@ type Reconciler struct {
 	TargetClient       client.Client
 	CertificatesClient certificatesclientv1.CertificateSigningRequestInterface
 	Config             config.KubeletCSRApproverControllerConfig
+	Recorder           record.EventRecorder
+	// AttestationVerifier verifies the attestation documents of machines requesting client certificates for
+	// gardener-node-agent. If nil, such requests are not approved.
+	AttestationVerifier attestation.Verifier
+
+	servingCertificatePolicy *servingCertificatePolicy
 }
 
 // Reconcile performs the main reconciliation logic.
@@ -74,31 +89,59 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("unable to parse csr: %w", err)
 	}
 
-	reason, allowed, err := r.mustApprove(ctx, csr, x509cr)
+	var (
+		reason      string
+		allowed     bool
+		description = "kubelet server certificate"
+	)
+
+	switch csr.Spec.SignerName {
+	case certificatesv1.KubeAPIServerClientSignerName:
+		description = "gardener-node-agent attested client certificate"
+		reason, allowed, err = r.mustApproveAttestedClientCertificate(ctx, csr, x509cr)
+	case certificatesv1.KubeAPIServerClientKubeletSignerName:
+		description = "kubelet client certificate of attested machine"
+		reason, allowed, err = r.mustApproveAttestedKubeletClientCertificate(ctx, csr, x509cr)
+	default:
+		reason, allowed, err = r.mustApprove(ctx, csr, x509cr)
+	}
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed when checking for approval conditions: %w", err)
 	}
 
+	var (
+		condition certificatesv1.CertificateSigningRequestCondition
+		eventType string
+	)
+
 	if allowed {
 		log.Info("Auto-approving CSR", "reason", reason)
-		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		condition = certificatesv1.CertificateSigningRequestCondition{
 			Type:    certificatesv1.CertificateApproved,
 			Status:  corev1.ConditionTrue,
 			Reason:  "RequestApproved",
-			Message: fmt.Sprintf("Approving kubelet server certificate CSR (%s)", reason),
-		})
+			Message: fmt.Sprintf("Approving %s CSR (%s)", description, reason),
+		}
+		eventType = corev1.EventTypeNormal
 	} else {
 		log.Info("Denying CSR", "reason", reason)
-		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		condition = certificatesv1.CertificateSigningRequestCondition{
 			Type:    certificatesv1.CertificateDenied,
 			Status:  corev1.ConditionTrue,
 			Reason:  "RequestDenied",
-			Message: fmt.Sprintf("Denying kubelet server certificate CSR (%s)", reason),
-		})
+			Message: fmt.Sprintf("Denying %s CSR (%s)", description, reason),
+		}
+		eventType = corev1.EventTypeWarning
 	}
+	csr.Status.Conditions = append(csr.Status.Conditions, condition)
 
-	_, err = r.CertificatesClient.UpdateApproval(ctx, csr.Name, csr, kubernetes.DefaultUpdateOptions())
-	return reconcile.Result{}, err
+	if _, err := r.CertificatesClient.UpdateApproval(ctx, csr.Name, csr, kubernetes.DefaultUpdateOptions()); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The event serves as audit trail for the decision, hence it contains the requesting user next to the reason.
+	r.Recorder.Eventf(csr, eventType, condition.Reason, "%s, requested by user %q", condition.Message, csr.Spec.Username)
+	return reconcile.Result{}, nil
 }
 
 func (r *Reconciler) mustApprove(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) (string, bool, error) {
@@ -118,6 +161,12 @@ func (r *Reconciler) mustApprove(ctx context.Context, csr *certificatesv1.Certif
 		return "organization in CSR does not match nodes group", false, nil
 	}
 
+	if r.servingCertificatePolicy != nil {
+		if reason := r.servingCertificatePolicy.check(csr, x509cr); reason != "" {
+			return reason, false, nil
+		}
+	}
+
 	nodeName := strings.TrimPrefix(x509cr.Subject.CommonName, "system:node:")
 
 	node := &corev1.Node{}
@@ -166,3 +215,191 @@ func (r *Reconciler) mustApprove(ctx context.Context, csr *certificatesv1.Certif
 
 	return "all checks passed", true, nil
 }
+
+// mustApproveAttestedClientCertificate checks CSRs which are created by gardener-node-agent with a bootstrap token for
+// proving the identity of its machine. They are only approved if the attestation document is valid, bound to the key
+// pair of the CSR, and attests a machine which is managed by machine-controller-manager and whose node has the host name
+// contained in the CSR.
+func (r *Reconciler) mustApproveAttestedClientCertificate(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) (string, bool, error) {
+	if r.AttestationVerifier == nil {
+		return "attestation of machines is not enabled", false, nil
+	}
+
+	if prefix := bootstraptokenapi.BootstrapUserPrefix; !strings.HasPrefix(csr.Spec.Username, prefix) {
+		return fmt.Sprintf("username %q is not prefixed with %q", csr.Spec.Username, prefix), false, nil
+	}
+
+	hostName, ok := strings.CutPrefix(x509cr.Subject.CommonName, nodeagentv1alpha1.AttestedUserNamePrefix)
+	if !ok || hostName == "" {
+		return fmt.Sprintf("common name in CSR is not prefixed with %q followed by the host name", nodeagentv1alpha1.AttestedUserNamePrefix), false, nil
+	}
+
+	if len(x509cr.Subject.Organization) != 1 || !slices.Contains(x509cr.Subject.Organization, nodeagentv1alpha1.AttestedNodesGroup) {
+		return "organization in CSR does not match attested nodes group", false, nil
+	}
+
+	if len(x509cr.DNSNames)+len(x509cr.IPAddresses)+len(x509cr.EmailAddresses)+len(x509cr.URIs) > 0 {
+		return "CSR must not contain SANs", false, nil
+	}
+
+	allowedUsages := sets.New(certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageClientAuth)
+	if !allowedUsages.HasAll(csr.Spec.Usages...) || !slices.Contains(csr.Spec.Usages, certificatesv1.UsageClientAuth) {
+		return "key usages in CSR must contain 'client auth' and must not contain others than 'digital signature' or 'key encipherment'", false, nil
+	}
+
+	if csr.Spec.ExpirationSeconds == nil || csrutil.ExpirationSecondsToDuration(*csr.Spec.ExpirationSeconds) > attestation.ClientCertificateValidity {
+		return fmt.Sprintf("requested validity of CSR must not exceed %s", attestation.ClientCertificateValidity), false, nil
+	}
+
+	document, err := base64.StdEncoding.DecodeString(csr.Annotations[nodeagentv1alpha1.AnnotationKeyAttestationDocument])
+	if err != nil || len(document) == 0 {
+		return "attestation document is missing or cannot be decoded", false, nil
+	}
+
+	nonce, err := attestation.Nonce(x509cr.PublicKey)
+	if err != nil {
+		return fmt.Sprintf("public key in CSR is invalid: %v", err), false, nil
+	}
+
+	providerID, err := r.AttestationVerifier.Verify(ctx, document, nonce)
+	if err != nil {
+		return fmt.Sprintf("attestation document is invalid: %v", err), false, nil
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := r.SourceClient.List(ctx, machineList, client.InNamespace(r.Config.MachineNamespace)); err != nil {
+		return "", false, err
+	}
+
+	for _, machine := range machineList.Items {
+		if machine.Spec.ProviderID != providerID {
+			continue
+		}
+
+		// machine-controller-manager labels the Machine with the name of its node as soon as the machine was created.
+		// The host name in the CSR must match it, otherwise an attested machine could request credentials for another
+		// node.
+		if nodeName := machine.Labels[machinev1alpha1.NodeLabelKey]; nodeName != hostName {
+			return fmt.Sprintf("host name %q in CSR does not match node name %q of machine %q with provider ID %q", hostName, nodeName, machine.Name, providerID), false, nil
+		}
+
+		return fmt.Sprintf("attestation of machine %q with provider ID %q verified", machine.Name, providerID), true, nil
+	}
+
+	return fmt.Sprintf("could not find machine with provider ID %q in namespace %q", providerID, r.Config.MachineNamespace), false, nil
+}
+
+// mustApproveAttestedKubeletClientCertificate checks CSRs which are created by kubelet with the attested client
+// certificate of gardener-node-agent. The attested client certificate is not permitted to request client certificates
+// for arbitrary nodes, hence they are only approved if they are requested for the node whose host name was attested.
+func (r *Reconciler) mustApproveAttestedKubeletClientCertificate(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) (string, bool, error) {
+	if r.AttestationVerifier == nil {
+		return "attestation of machines is not enabled", false, nil
+	}
+
+	hostName, ok := strings.CutPrefix(csr.Spec.Username, nodeagentv1alpha1.AttestedUserNamePrefix)
+	if !ok || hostName == "" {
+		return fmt.Sprintf("username %q is not prefixed with %q followed by the host name", csr.Spec.Username, nodeagentv1alpha1.AttestedUserNamePrefix), false, nil
+	}
+
+	if !slices.Contains(csr.Spec.Groups, nodeagentv1alpha1.AttestedNodesGroup) {
+		return fmt.Sprintf("user is not in group %q", nodeagentv1alpha1.AttestedNodesGroup), false, nil
+	}
+
+	if nodeUserName := "system:node:" + hostName; x509cr.Subject.CommonName != nodeUserName {
+		return fmt.Sprintf("common name in CSR does not match attested node user %q", nodeUserName), false, nil
+	}
+
+	if len(x509cr.Subject.Organization) != 1 || !slices.Contains(x509cr.Subject.Organization, user.NodesGroup) {
+		return "organization in CSR does not match nodes group", false, nil
+	}
+
+	if len(x509cr.DNSNames)+len(x509cr.IPAddresses)+len(x509cr.EmailAddresses)+len(x509cr.URIs) > 0 {
+		return "CSR must not contain SANs", false, nil
+	}
+
+	allowedUsages := sets.New(certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageClientAuth)
+	if !allowedUsages.HasAll(csr.Spec.Usages...) || !slices.Contains(csr.Spec.Usages, certificatesv1.UsageClientAuth) {
+		return "key usages in CSR must contain 'client auth' and must not contain others than 'digital signature' or 'key encipherment'", false, nil
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := r.SourceClient.List(ctx, machineList, client.InNamespace(r.Config.MachineNamespace), client.MatchingLabels{machinev1alpha1.NodeLabelKey: hostName}); err != nil {
+		return "", false, err
+	}
+
+	if length := len(machineList.Items); length != 1 {
+		return fmt.Sprintf("Expected exactly one machine in namespace %q for node %q but found %d", r.Config.MachineNamespace, hostName, length), false, nil
+	}
+
+	return fmt.Sprintf("node %q matches attested machine %q", hostName, machineList.Items[0].Name), true, nil
+}
+
+// servingCertificatePolicy is the parsed form of config.KubeletServingCertificatePolicy.
+type servingCertificatePolicy struct {
+	dnsNamePatterns []*regexp.Regexp
+	ipRanges        []*net.IPNet
+	maxValidity     *time.Duration
+}
+
+// newServingCertificatePolicy parses the given policy. The patterns and IP ranges are parsed once instead of for every
+// certificate signing request.
+func newServingCertificatePolicy(policy config.KubeletServingCertificatePolicy) (*servingCertificatePolicy, error) {
+	p := &servingCertificatePolicy{}
+
+	for _, pattern := range policy.AllowedDNSNamePatterns {
+		// Patterns must match the complete DNS name, otherwise `node-.*` would also allow `evil.com/node-1`.
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("failed compiling allowed DNS name pattern %q: %w", pattern, err)
+		}
+		p.dnsNamePatterns = append(p.dnsNamePatterns, re)
+	}
+
+	for _, ipRange := range policy.AllowedIPRanges {
+		_, ipNet, err := net.ParseCIDR(ipRange)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing allowed IP range %q: %w", ipRange, err)
+		}
+		p.ipRanges = append(p.ipRanges, ipNet)
+	}
+
+	if policy.MaxValidity != nil {
+		p.maxValidity = &policy.MaxValidity.Duration
+	}
+
+	return p, nil
+}
+
+// check checks the given CSR against the policy. It returns the reason for the violation, or an empty string if the CSR
+// complies with the policy.
+func (p *servingCertificatePolicy) check(csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) string {
+	if len(p.dnsNamePatterns) > 0 {
+		for _, dnsName := range x509cr.DNSNames {
+			if !slices.ContainsFunc(p.dnsNamePatterns, func(re *regexp.Regexp) bool { return re.MatchString(dnsName) }) {
+				return fmt.Sprintf("DNS name %q in CSR does not match any allowed pattern", dnsName)
+			}
+		}
+	}
+
+	if len(p.ipRanges) > 0 {
+		for _, ip := range x509cr.IPAddresses {
+			if !slices.ContainsFunc(p.ipRanges, func(ipNet *net.IPNet) bool { return ipNet.Contains(ip) }) {
+				return fmt.Sprintf("IP address %q in CSR is not contained in any allowed IP range", ip.String())
+			}
+		}
+	}
+
+	if p.maxValidity != nil {
+		// Without a requested validity, the validity is determined by the signer, i.e., by the
+		// `--cluster-signing-duration` flag of kube-controller-manager, and hence cannot be limited by the policy.
+		if csr.Spec.ExpirationSeconds == nil {
+			return fmt.Sprintf("CSR must request a validity of at most %s", *p.maxValidity)
+		}
+		if csrutil.ExpirationSecondsToDuration(*csr.Spec.ExpirationSeconds) > *p.maxValidity {
+			return fmt.Sprintf("requested validity of CSR must not exceed %s", *p.maxValidity)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrapprover_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	certutil "k8s.io/client-go/util/cert"
+	csrutil "k8s.io/client-go/util/certificate/csr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/csrapprover"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		nodeName         = "node"
+		userName         = "system:node:" + nodeName
+		machineNamespace = "shoot--foo--bar"
+	)
+
+	var (
+		ctx = context.Background()
+
+		dnsNames   []string
+		ips        []net.IP
+		conditions []certificatesv1.CertificateSigningRequestCondition
+
+		csr        *certificatesv1.CertificateSigningRequest
+		node       *corev1.Node
+		machine    *machinev1alpha1.Machine
+		clientset  *kubernetesfake.Clientset
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+	)
+
+	newCSR := func(dnsNames []string, ips []net.IP) *certificatesv1.CertificateSigningRequest {
+		privateKey, err := secretsutils.FakeGenerateKey(rand.Reader, 4096)
+		Expect(err).NotTo(HaveOccurred())
+
+		request, err := certutil.MakeCSR(privateKey, &pkix.Name{CommonName: userName, Organization: []string{user.NodesGroup}}, dnsNames, ips)
+		Expect(err).NotTo(HaveOccurred())
+
+		return &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "csr"},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				Request:           request,
+				SignerName:        certificatesv1.KubeletServingSignerName,
+				Usages:            []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth},
+				Username:          userName,
+				ExpirationSeconds: csrutil.DurationToExpirationSeconds(24 * time.Hour),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		dnsNames = []string{"node.example.com"}
+		ips = []net.IP{net.ParseIP("10.250.0.1")}
+		conditions = nil
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: nodeName},
+			Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeHostName, Address: "node.example.com"},
+				{Type: corev1.NodeInternalIP, Address: "10.250.0.1"},
+			}},
+		}
+		machine = &machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: machineNamespace, Labels: map[string]string{"node": nodeName}}}
+		recorder = record.NewFakeRecorder(1)
+	})
+
+	JustBeforeEach(func() {
+		csr = newCSR(dnsNames, ips)
+		csr.Status.Conditions = conditions
+		clientset = kubernetesfake.NewSimpleClientset(csr.DeepCopy())
+
+		reconciler = &Reconciler{
+			SourceClient:       fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(machine).Build(),
+			TargetClient:       fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(csr, node).Build(),
+			CertificatesClient: clientset.CertificatesV1().CertificateSigningRequests(),
+			Config:             config.KubeletCSRApproverControllerConfig{MachineNamespace: machineNamespace},
+			Recorder:           recorder,
+		}
+	})
+
+	reconcileAndGetCondition := func() certificatesv1.CertificateSigningRequestCondition {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(csr)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+		updated, err := clientset.CertificatesV1().CertificateSigningRequests().Get(ctx, csr.Name, metav1.GetOptions{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, updated.Status.Conditions).To(HaveLen(1))
+		return updated.Status.Conditions[0]
+	}
+
+	It("should approve the CSR if all checks pass and record an event", func() {
+		Expect(reconcileAndGetCondition()).To(And(
+			HaveField("Type", certificatesv1.CertificateApproved),
+			HaveField("Message", "Approving kubelet server certificate CSR (all checks passed)"),
+		))
+		Expect(recorder.Events).To(Receive(And(
+			ContainSubstring("RequestApproved"),
+			ContainSubstring(`requested by user "`+userName+`"`),
+		)))
+	})
+
+	Context("IP addresses do not match the node addresses", func() {
+		BeforeEach(func() {
+			node.Status.Addresses[1].Address = "10.250.0.2"
+		})
+
+		It("should deny the CSR", func() {
+			Expect(reconcileAndGetCondition()).To(And(
+				HaveField("Type", certificatesv1.CertificateDenied),
+				HaveField("Message", ContainSubstring("IP addresses in CSR do not match addresses of type 'InternalIP' or 'ExternalIP' in node object")),
+			))
+			Expect(recorder.Events).To(Receive(ContainSubstring("RequestDenied")))
+		})
+	})
+
+	Context("no machine for the node", func() {
+		BeforeEach(func() {
+			machine.Labels["node"] = "other-node"
+		})
+
+		It("should deny the CSR", func() {
+			Expect(reconcileAndGetCondition()).To(And(
+				HaveField("Type", certificatesv1.CertificateDenied),
+				HaveField("Message", ContainSubstring(`Expected exactly one machine in namespace "`+machineNamespace+`" for node "`+nodeName+`" but found 0`)),
+			))
+		})
+	})
+
+	Describe("serving certificate policy", func() {
+		var policy config.KubeletServingCertificatePolicy
+
+		BeforeEach(func() {
+			policy = config.KubeletServingCertificatePolicy{
+				AllowedDNSNamePatterns: []string{`node(-[0-9]+)?\.example\.com`},
+				AllowedIPRanges:        []string{"10.250.0.0/16"},
+				MaxValidity:            &metav1.Duration{Duration: 48 * time.Hour},
+			}
+		})
+
+		check := func() string {
+			x509cr, err := utils.DecodeCertificateRequest(csr.Spec.Request)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+			reason, err := CheckServingCertificatePolicy(policy, csr, x509cr)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return reason
+		}
+
+		It("should allow CSRs complying with the policy", func() {
+			Expect(check()).To(BeEmpty())
+		})
+
+		It("should only allow DNS names fully matching a pattern", func() {
+			csr = newCSR([]string{"evil.com/node.example.com"}, ips)
+			Expect(check()).To(Equal(`DNS name "evil.com/node.example.com" in CSR does not match any allowed pattern`))
+		})
+
+		It("should only allow IP addresses contained in an allowed range", func() {
+			csr = newCSR(dnsNames, []net.IP{net.ParseIP("10.251.0.1")})
+			Expect(check()).To(Equal(`IP address "10.251.0.1" in CSR is not contained in any allowed IP range`))
+		})
+
+		It("should deny CSRs requesting a validity exceeding the maximum", func() {
+			csr.Spec.ExpirationSeconds = csrutil.DurationToExpirationSeconds(72 * time.Hour)
+			Expect(check()).To(Equal("requested validity of CSR must not exceed 48h0m0s"))
+		})
+
+		It("should deny CSRs not requesting a validity if a maximum is configured", func() {
+			csr.Spec.ExpirationSeconds = nil
+			Expect(check()).To(Equal("CSR must request a validity of at most 48h0m0s"))
+		})
+
+		It("should allow CSRs not requesting a validity if no maximum is configured", func() {
+			policy.MaxValidity = nil
+			csr.Spec.ExpirationSeconds = nil
+			Expect(check()).To(BeEmpty())
+		})
+
+		It("should fail for invalid patterns and IP ranges", func() {
+			x509cr := &x509.CertificateRequest{}
+
+			_, err := CheckServingCertificatePolicy(config.KubeletServingCertificatePolicy{AllowedDNSNamePatterns: []string{"("}}, csr, x509cr)
+			Expect(err).To(MatchError(ContainSubstring("failed compiling allowed DNS name pattern")))
+
+			_, err = CheckServingCertificatePolicy(config.KubeletServingCertificatePolicy{AllowedIPRanges: []string{"foo"}}, csr, x509cr)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing allowed IP range")))
+		})
+	})
+
+	Context("CSR already approved", func() {
+		BeforeEach(func() {
+			conditions = []certificatesv1.CertificateSigningRequestCondition{{Type: certificatesv1.CertificateApproved, Status: corev1.ConditionTrue}}
+		})
+
+		It("should ignore the CSR", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(csr)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clientset.Actions()).To(BeEmpty())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+})
@@ -13,6 +13,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/agentreconciliationdelay"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/criticalcomponents"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/drain"
 )
 
 // AddToManager adds all node controllers to the given manager.
@@ -33,5 +34,13 @@ func AddToManager(mgr manager.Manager, targetCluster cluster.Cluster, cfg config
 		}
 	}
 
+	if cfg.Controllers.NodeDrain.Enabled {
+		if err := (&drain.Reconciler{
+			Config: cfg.Controllers.NodeDrain,
+		}).AddToManager(mgr, targetCluster); err != nil {
+			return fmt.Errorf("failed adding node-drain controller: %w", err)
+		}
+	}
+
 	return nil
 }
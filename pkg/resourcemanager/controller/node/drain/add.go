@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	nodeagentdrain "github.com/gardener/gardener/pkg/nodeagent/drain"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "node-drain"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, targetCluster cluster.Cluster) error {
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+	if r.TargetReader == nil {
+		r.TargetReader = targetCluster.GetAPIReader()
+	}
+	if r.Drainer == nil {
+		r.Drainer = nodeagentdrain.New(r.TargetClient, r.TargetClient, targetCluster.GetEventRecorderFor(ControllerName+"-controller"), clock.RealClock{})
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		WatchesRawSource(
+			source.Kind(targetCluster.GetCache(), &corev1.Node{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(r.NodePredicate()),
+		).
+		Complete(r)
+}
+
+// NodePredicate returns a predicate that filters for Node objects which are updated in-place and whose drain was
+// requested by gardener-node-agent, or which are about to be drained by machine-controller-manager and whose pre-drain
+// hooks were not called yet.
+func (r *Reconciler) NodePredicate() predicate.Predicate {
+	return predicate.And(
+		predicateutils.ForEventTypes(predicateutils.Create, predicateutils.Update),
+		predicate.Or(
+			predicate.NewPredicateFuncs(NodeDrainRequested),
+			predicate.NewPredicateFuncs(PreDrainHooksPending),
+		),
+	)
+}
+
+// NodeDrainRequested returns true if the given Node is updated in-place and its drain was requested.
+func NodeDrainRequested(obj client.Object) bool {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return false
+	}
+
+	return node.Labels[nodeagentv1alpha1.LabelInPlaceUpdate] == nodeagentv1alpha1.InPlaceUpdateInProgress &&
+		node.Annotations[nodeagentv1alpha1.AnnotationKeyDrain] == nodeagentv1alpha1.DrainRequested
+}
+
+// PreDrainHooksPending returns true if the given Node is about to be drained by machine-controller-manager and the
+// pre-drain hooks of its worker pool were not called yet.
+func PreDrainHooksPending(obj client.Object) bool {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return false
+	}
+
+	return nodeagentdrain.IsTerminating(node) &&
+		node.Annotations[nodeagentv1alpha1.AnnotationKeyPreDrainHooks] != nodeagentv1alpha1.PreDrainHooksCompleted
+}
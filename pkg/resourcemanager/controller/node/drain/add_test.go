@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/drain"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#NodePredicate", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodePredicate()
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Labels:      map[string]string{"worker.gardener.cloud/in-place-update": "in-progress"},
+				Annotations: map[string]string{"worker.gardener.cloud/drain": "requested"},
+			}}
+		})
+
+		It("should return true if the drain of the node was requested", func() {
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeTrue())
+		})
+
+		It("should return false if the object is not a Node", func() {
+			Expect(p.Create(event.CreateEvent{Object: &corev1.ConfigMap{}})).To(BeFalse())
+		})
+
+		It("should return false if the drain of the node was not requested", func() {
+			delete(node.Annotations, "worker.gardener.cloud/drain")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return false if the drain of the node is completed", func() {
+			node.Annotations["worker.gardener.cloud/drain"] = "completed"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return false if the node is not updated in-place", func() {
+			node.Labels["worker.gardener.cloud/in-place-update"] = "pending"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return true if the node is about to be drained by machine-controller-manager and its pre-drain hooks are pending", func() {
+			node.Labels, node.Annotations = nil, nil
+			node.Status.Conditions = []corev1.NodeCondition{{Type: "Terminating", Status: corev1.ConditionTrue}}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeTrue())
+		})
+
+		It("should return false if the node is about to be drained by machine-controller-manager and its pre-drain hooks are completed", func() {
+			node.Labels, node.Annotations = nil, map[string]string{"worker.gardener.cloud/pre-drain-hooks": "completed"}
+			node.Status.Conditions = []corev1.NodeCondition{{Type: "Terminating", Status: corev1.ConditionTrue}}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: node})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: node})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	nodeagentdrain "github.com/gardener/gardener/pkg/nodeagent/drain"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// Reconciler drains nodes which are updated in-place once gardener-node-agent requested it. This way,
+// gardener-node-agent does not need permissions for evicting pods. For nodes which are drained by
+// machine-controller-manager because their machines are deleted, it calls the pre-drain hooks of the worker pool and
+// marks them as completed. Until then, the pod-eviction webhook rejects the evictions of machine-controller-manager.
+type Reconciler struct {
+	TargetClient client.Client
+	TargetReader client.Reader
+	Drainer      *nodeagentdrain.Drainer
+	Config       config.NodeDrainControllerConfig
+}
+
+// Reconcile drains the node according to the in-place update configuration of its worker pool and marks the drain as
+// completed, or it calls the pre-drain hooks of the worker pool before machine-controller-manager drains the node.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	node := &corev1.Node{}
+	if err := r.TargetClient.Get(reconcileCtx, req.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	switch {
+	case NodeDrainRequested(node):
+		return reconcile.Result{}, r.drain(reconcileCtx, log, node)
+	case PreDrainHooksPending(node):
+		return reconcile.Result{}, r.callPreDrainHooks(reconcileCtx, log, node)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) drain(reconcileCtx context.Context, log logr.Logger, node *corev1.Node) error {
+	osc, err := nodeagentdrain.OperatingSystemConfigForNode(reconcileCtx, r.TargetReader, node)
+	if err != nil {
+		return err
+	}
+	options := nodeagentdrain.OptionsForInPlaceUpdates(osc)
+
+	// Draining the node takes at most the drain timeout, hence the reconciliation timeout has to be extended by it.
+	drainTimeout := r.Drainer.Timeout
+	if options.Timeout != nil {
+		drainTimeout = options.Timeout.Duration
+	}
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, drainTimeout+controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	log.Info("Draining node")
+	if err := r.Drainer.Drain(ctx, log, node, options); err != nil {
+		return fmt.Errorf("failed draining node: %w", err)
+	}
+
+	log.Info("Marking node drain as completed")
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyDrain, nodeagentv1alpha1.DrainCompleted)
+	return r.TargetClient.Patch(ctx, node, patch)
+}
+
+func (r *Reconciler) callPreDrainHooks(reconcileCtx context.Context, log logr.Logger, node *corev1.Node) error {
+	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	osc, err := nodeagentdrain.OperatingSystemConfigForNode(ctx, r.TargetReader, node)
+	if err != nil {
+		return err
+	}
+
+	if drainPolicy := osc.Spec.DrainPolicy; drainPolicy != nil && len(drainPolicy.PreDrainHooks) > 0 {
+		log.Info("Calling pre-drain hooks before node is drained by machine-controller-manager")
+		if err := r.Drainer.CallPreDrainHooks(ctx, log, node, drainPolicy.PreDrainHooks); err != nil {
+			return err
+		}
+	}
+
+	log.Info("Marking pre-drain hooks as completed")
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyPreDrainHooks, nodeagentv1alpha1.PreDrainHooksCompleted)
+	return r.TargetClient.Patch(ctx, node, patch)
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentdrain "github.com/gardener/gardener/pkg/nodeagent/drain"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/drain"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		node      *corev1.Node
+		pod       *corev1.Pod
+		osc       *extensionsv1alpha1.OperatingSystemConfig
+		oscSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        "node",
+			Labels:      map[string]string{"worker.gardener.cloud/pool": "pool", "worker.gardener.cloud/in-place-update": "in-progress"},
+			Annotations: map[string]string{"worker.gardener.cloud/drain": "requested"},
+		}}
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: node.Name},
+		}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+			InPlaceUpdates: &extensionsv1alpha1.InPlaceUpdates{
+				MachineDrainTimeout: &metav1.Duration{Duration: time.Minute},
+			},
+		}}
+	})
+
+	JustBeforeEach(func() {
+		oscRaw, err := yaml.Marshal(osc)
+		Expect(err).NotTo(HaveOccurred())
+
+		oscSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "osc-pool",
+				Namespace: "kube-system",
+				Labels:    map[string]string{"gardener.cloud/role": "operating-system-config", "worker.gardener.cloud/pool": "pool"},
+			},
+			Data: map[string][]byte{"osc.yaml": oscRaw},
+		}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			WithObjects(node, pod, oscSecret).
+			Build()
+
+		drainer := nodeagentdrain.New(fakeClient, fakeClient, record.NewFakeRecorder(10), clock.RealClock{})
+		drainer.PollInterval = 10 * time.Millisecond
+
+		reconciler = &Reconciler{
+			TargetClient: fakeClient,
+			TargetReader: fakeClient,
+			Drainer:      drainer,
+		}
+	})
+
+	It("should drain the node and mark the drain as completed", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Spec.Unschedulable).To(BeTrue())
+		Expect(node.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/drain", "completed"))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+	})
+
+	It("should do nothing if the drain was not requested", func() {
+		delete(node.Annotations, "worker.gardener.cloud/drain")
+		Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Spec.Unschedulable).To(BeFalse())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	})
+
+	It("should do nothing if the node is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "other"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should fail if the operating system config of the worker pool is not found", func() {
+		oscSecret.Labels["worker.gardener.cloud/pool"] = "other"
+		Expect(fakeClient.Update(ctx, oscSecret)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+		Expect(err).To(MatchError(ContainSubstring(`expected exactly one operating system config secret for worker pool "pool" but found 0`)))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	})
+
+	Context("node is drained by machine-controller-manager", func() {
+		var (
+			server   *httptest.Server
+			requests []string
+			status   int
+		)
+
+		BeforeEach(func() {
+			requests, status = nil, http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.URL.Path)
+				w.WriteHeader(status)
+			}))
+			DeferCleanup(server.Close)
+
+			node.Labels = map[string]string{"worker.gardener.cloud/pool": "pool"}
+			node.Annotations = nil
+			node.Status.Conditions = []corev1.NodeCondition{{Type: "Terminating", Status: corev1.ConditionTrue}}
+
+			osc.Spec.InPlaceUpdates = nil
+			osc.Spec.DrainPolicy = &gardencorev1beta1.WorkerDrainPolicy{PreDrainHooks: []gardencorev1beta1.PreDrainHook{
+				{Name: "first", URL: server.URL + "/first"},
+				{Name: "second", URL: server.URL + "/second"},
+			}}
+		})
+
+		It("should call the pre-drain hooks and mark them as completed", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+
+			Expect(requests).To(Equal([]string{"/first", "/second"}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/pre-drain-hooks", "completed"))
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+		})
+
+		Context("worker pool without pre-drain hooks", func() {
+			BeforeEach(func() {
+				osc.Spec.DrainPolicy = nil
+			})
+
+			It("should mark the pre-drain hooks as completed", func() {
+				Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+
+				Expect(requests).To(BeEmpty())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/pre-drain-hooks", "completed"))
+			})
+		})
+
+		It("should fail and not mark the pre-drain hooks as completed if a hook fails", func() {
+			status = http.StatusInternalServerError
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+			Expect(err).To(MatchError(ContainSubstring(`pre-drain hook "first" failed`)))
+
+			Expect(requests).To(Equal([]string{"/first"}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey("worker.gardener.cloud/pre-drain-hooks"))
+		})
+
+		It("should do nothing if the pre-drain hooks are already completed", func() {
+			node.Annotations = map[string]string{"worker.gardener.cloud/pre-drain-hooks": "completed"}
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+
+			Expect(requests).To(BeEmpty())
+		})
+	})
+})
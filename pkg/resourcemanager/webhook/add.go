@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podeviction"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopologyspreadconstraints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/projectedtokenmount"
@@ -83,6 +84,16 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 		}
 	}
 
+	if cfg.Webhooks.PodEviction.Enabled {
+		if err := (&podeviction.Handler{
+			Logger:       mgr.GetLogger().WithName("webhook").WithName(podeviction.HandlerName),
+			TargetClient: targetCluster.GetClient(),
+			TargetReader: targetCluster.GetAPIReader(),
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding %s webhook handler: %w", podeviction.HandlerName, err)
+		}
+	}
+
 	if cfg.Webhooks.PodSchedulerName.Enabled {
 		if err := (&podschedulername.Handler{
 			SchedulerName: *cfg.Webhooks.PodSchedulerName.SchedulerName,
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podeviction
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	nodeagentdrain "github.com/gardener/gardener/pkg/nodeagent/drain"
+)
+
+// Handler rejects evictions of pods running on nodes which are drained by machine-controller-manager as long as the
+// drain policy of the node's worker pool does not allow them yet. Evictions are rejected with status code 429 (Too Many
+// Requests), hence machine-controller-manager retries them until they are allowed or until its drain timeout is
+// exceeded.
+type Handler struct {
+	Logger       logr.Logger
+	TargetClient client.Reader
+	TargetReader client.Reader
+}
+
+// Handle validates the eviction request.
+func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if req.Operation != admissionv1.Create || req.SubResource != "eviction" {
+		return admission.Allowed("request is not a pod eviction")
+	}
+
+	pod := &corev1.Pod{}
+	if err := h.TargetClient.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: req.Name}, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Allowed("pod was not found")
+		}
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if pod.Spec.NodeName == "" {
+		return admission.Allowed("pod is not scheduled")
+	}
+
+	node := &corev1.Node{}
+	if err := h.TargetClient.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Allowed("node was not found")
+		}
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if !nodeagentdrain.IsTerminating(node) {
+		return admission.Allowed("node is not drained by machine-controller-manager")
+	}
+
+	log := h.Logger.WithValues("pod", client.ObjectKeyFromObject(pod), "node", node.Name)
+
+	osc, err := nodeagentdrain.OperatingSystemConfigForNode(ctx, h.TargetReader, node)
+	if err != nil {
+		// The operating system config is not available anymore if the worker pool was removed. Blocking the eviction
+		// would only delay the drain until the drain timeout is exceeded, hence it is allowed.
+		log.Info("Drain policy of node cannot be determined, allowing eviction", "reason", err.Error())
+		return admission.Allowed("drain policy of node cannot be determined")
+	}
+
+	drainPolicy := osc.Spec.DrainPolicy
+	if drainPolicy == nil {
+		return admission.Allowed("worker pool has no drain policy")
+	}
+
+	if len(drainPolicy.PreDrainHooks) > 0 && node.Annotations[nodeagentv1alpha1.AnnotationKeyPreDrainHooks] != nodeagentv1alpha1.PreDrainHooksCompleted {
+		return h.tooManyRequests(log, fmt.Sprintf("pre-drain hooks of worker pool %q have not been called for node %q yet", node.Labels[v1beta1constants.LabelWorkerPool], node.Name))
+	}
+
+	if ptr.Deref(drainPolicy.EvictionOrder, gardencorev1beta1.PodEvictionOrderParallel) == gardencorev1beta1.PodEvictionOrderPriorityAscending {
+		pods, err := nodeagentdrain.PodsToEvict(ctx, h.TargetClient, node.Name)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		priority := ptr.Deref(pod.Spec.Priority, 0)
+		for _, p := range pods {
+			if ptr.Deref(p.Spec.Priority, 0) < priority {
+				return h.tooManyRequests(log, fmt.Sprintf("pod %s with lower priority is still running on node %q", client.ObjectKeyFromObject(&p), node.Name))
+			}
+		}
+	}
+
+	return admission.Allowed("")
+}
+
+func (h *Handler) tooManyRequests(log logr.Logger, reason string) admission.Response {
+	log.Info("Rejecting eviction", "reason", reason)
+
+	response := admission.Denied(reason)
+	response.Result.Code = http.StatusTooManyRequests
+	response.Result.Reason = metav1.StatusReasonTooManyRequests
+	return response
+}
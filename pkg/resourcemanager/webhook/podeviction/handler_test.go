@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podeviction_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/podeviction"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx = context.Background()
+
+		request admission.Request
+
+		node                       *corev1.Node
+		pod, lowPriorityPod        *corev1.Pod
+		daemonSetPod, otherNodePod *corev1.Pod
+		osc                        *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	newPod := func(name, nodeName string, priority int32) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: nodeName, Priority: ptr.To(priority)},
+		}
+	}
+
+	BeforeEach(func() {
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: map[string]string{"worker.gardener.cloud/pool": "pool"}},
+			Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "Terminating", Status: corev1.ConditionTrue}}},
+		}
+		pod = newPod("pod", node.Name, 100)
+		lowPriorityPod = newPod("low-priority", node.Name, 10)
+		daemonSetPod = newPod("daemonset", node.Name, 0)
+		daemonSetPod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", UID: "1", Controller: ptr.To(true)}}
+		otherNodePod = newPod("other", "other-node", 0)
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+			DrainPolicy: &gardencorev1beta1.WorkerDrainPolicy{
+				EvictionOrder: ptr.To(gardencorev1beta1.PodEvictionOrderPriorityAscending),
+				PreDrainHooks: []gardencorev1beta1.PreDrainHook{{Name: "hook", URL: "https://hook.example.com"}},
+			},
+		}}
+
+		request = admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation:   admissionv1.Create,
+			SubResource: "eviction",
+			Namespace:   pod.Namespace,
+			Name:        pod.Name,
+		}}
+	})
+
+	handle := func() admission.Response {
+		oscRaw, err := yaml.Marshal(osc)
+		Expect(err).NotTo(HaveOccurred())
+
+		oscSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "osc-pool",
+				Namespace: "kube-system",
+				Labels:    map[string]string{"gardener.cloud/role": "operating-system-config", "worker.gardener.cloud/pool": "pool"},
+			},
+			Data: map[string][]byte{"osc.yaml": oscRaw},
+		}
+
+		fakeClient := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			WithObjects(node, pod, lowPriorityPod, daemonSetPod, otherNodePod, oscSecret).
+			Build()
+
+		handler := &Handler{Logger: logr.Discard(), TargetClient: fakeClient, TargetReader: fakeClient}
+		return handler.Handle(ctx, request)
+	}
+
+	expectAllowed := func() {
+		response := handle()
+		Expect(response.Allowed).To(BeTrue(), "%+v", response.Result)
+	}
+
+	expectTooManyRequests := func(message string) {
+		response := handle()
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Code).To(Equal(int32(http.StatusTooManyRequests)))
+		Expect(response.Result.Reason).To(Equal(metav1.StatusReasonTooManyRequests))
+		Expect(response.Result.Message).To(Equal(message))
+	}
+
+	It("should allow requests which are not evictions", func() {
+		request.SubResource = ""
+		expectAllowed()
+	})
+
+	It("should allow the eviction if the pod is not found", func() {
+		request.Name = "unknown"
+		expectAllowed()
+	})
+
+	It("should allow the eviction if the node is not drained by machine-controller-manager", func() {
+		node.Status.Conditions = nil
+		expectAllowed()
+	})
+
+	It("should allow the eviction if the operating system config of the worker pool is not found", func() {
+		node.Labels["worker.gardener.cloud/pool"] = "removed"
+		expectAllowed()
+	})
+
+	It("should allow the eviction if the worker pool has no drain policy", func() {
+		osc.Spec.DrainPolicy = nil
+		expectAllowed()
+	})
+
+	Context("pre-drain hooks", func() {
+		BeforeEach(func() {
+			osc.Spec.DrainPolicy.EvictionOrder = nil
+		})
+
+		It("should reject the eviction if the pre-drain hooks have not been called yet", func() {
+			expectTooManyRequests(`pre-drain hooks of worker pool "pool" have not been called for node "node" yet`)
+		})
+
+		It("should allow the eviction if the pre-drain hooks are completed", func() {
+			node.Annotations = map[string]string{"worker.gardener.cloud/pre-drain-hooks": "completed"}
+			expectAllowed()
+		})
+	})
+
+	Context("priority ascending eviction order", func() {
+		BeforeEach(func() {
+			osc.Spec.DrainPolicy.PreDrainHooks = nil
+		})
+
+		It("should reject the eviction if a pod with a lower priority is still running on the node", func() {
+			expectTooManyRequests(`pod default/low-priority with lower priority is still running on node "node"`)
+		})
+
+		It("should allow the eviction of the pod with the lowest priority", func() {
+			request.Name = lowPriorityPod.Name
+			expectAllowed()
+		})
+
+		It("should allow the eviction if the pods with a lower priority are gone", func() {
+			lowPriorityPod.Status.Phase = corev1.PodSucceeded
+			expectAllowed()
+		})
+
+		It("should allow the eviction with the parallel eviction order", func() {
+			osc.Spec.DrainPolicy.EvictionOrder = ptr.To(gardencorev1beta1.PodEvictionOrderParallel)
+			expectAllowed()
+		})
+	})
+})
@@ -28,12 +28,36 @@ func GenerateBackupEntryName(shootTechnicalID string, shootUID types.UID) (strin
 	return shootTechnicalID + backupEntryDelimiter + string(shootUID), nil
 }
 
+// GenerateBastionSessionsBackupEntryName returns the name of the BackupEntry holding the session recordings of the
+// Bastion with the provided <bastionUID>. The name is prefixed so that it cannot be mistaken for the BackupEntry of a
+// Shoot.
+func GenerateBastionSessionsBackupEntryName(shootTechnicalID string, bastionUID types.UID) (string, error) {
+	if bastionUID == "" {
+		return "", errors.New("can't generate backup entry name with an empty bastion UID")
+	}
+
+	name, err := GenerateBackupEntryName(shootTechnicalID, bastionUID)
+	if err != nil {
+		return "", err
+	}
+	return v1beta1constants.BackupBastionSessionsPrefix + "-" + name, nil
+}
+
+// IsBastionSessionsBackupEntryName returns true if the provided <backupEntryName> is the name of a BackupEntry holding
+// the session recordings of a Bastion.
+func IsBastionSessionsBackupEntryName(backupEntryName string) bool {
+	return strings.HasPrefix(backupEntryName, v1beta1constants.BackupBastionSessionsPrefix+"-")
+}
+
 // ExtractShootDetailsFromBackupEntryName returns Shoot resource technicalID its UID from provided <backupEntryName>.
+// For BackupEntries holding the session recordings of a Bastion, the returned UID is the one of the Bastion.
 func ExtractShootDetailsFromBackupEntryName(backupEntryName string) (shootTechnicalID string, shootUID types.UID) {
 	tokens := strings.Split(backupEntryName, backupEntryDelimiter)
 	uid := tokens[len(tokens)-1]
 
-	shootTechnicalID = strings.TrimPrefix(backupEntryName, v1beta1constants.BackupSourcePrefix+"-")
+	shootTechnicalID = strings.TrimPrefix(backupEntryName, v1beta1constants.BackupBastionSessionsPrefix+"-")
+	shootTechnicalID = strings.TrimPrefix(shootTechnicalID, v1beta1constants.BackupSourcePrefix+"-")
+	shootTechnicalID = strings.TrimPrefix(shootTechnicalID, v1beta1constants.BackupReplicaPrefix+"-")
 	shootTechnicalID = strings.TrimSuffix(shootTechnicalID, uid)
 	shootTechnicalID = strings.TrimSuffix(shootTechnicalID, backupEntryDelimiter)
 	shootUID = types.UID(uid)
@@ -17,10 +17,14 @@ import (
 
 var _ = Describe("BackupEntry", func() {
 	var (
-		shootTechnicalID      = "seednamespace"
-		shootUID              = types.UID("1234")
-		backupEntryName       = shootTechnicalID + "--" + string(shootUID)
-		sourceBackupEntryName = "source-" + shootTechnicalID + "--" + string(shootUID)
+		shootTechnicalID       = "seednamespace"
+		shootUID               = types.UID("1234")
+		backupEntryName        = shootTechnicalID + "--" + string(shootUID)
+		sourceBackupEntryName  = "source-" + shootTechnicalID + "--" + string(shootUID)
+		replicaBackupEntryName = "replica-" + shootTechnicalID + "--" + string(shootUID)
+
+		bastionUID                     = types.UID("5678")
+		bastionSessionsBackupEntryName = "bastion-sessions-" + shootTechnicalID + "--" + string(bastionUID)
 	)
 
 	Describe("#GenerateBackupEntryName", func() {
@@ -41,6 +45,36 @@ var _ = Describe("BackupEntry", func() {
 		})
 	})
 
+	Describe("#GenerateBastionSessionsBackupEntryName", func() {
+		It("should compute the correct name", func() {
+			result, err := GenerateBastionSessionsBackupEntryName(shootTechnicalID, bastionUID)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(result).To(Equal(bastionSessionsBackupEntryName))
+		})
+
+		It("should fail if the shoot technical ID is empty", func() {
+			_, err := GenerateBastionSessionsBackupEntryName("", bastionUID)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the bastion UID is empty", func() {
+			_, err := GenerateBastionSessionsBackupEntryName(shootTechnicalID, "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#IsBastionSessionsBackupEntryName", func() {
+		It("should return true for bastion sessions backupentry", func() {
+			Expect(IsBastionSessionsBackupEntryName(bastionSessionsBackupEntryName)).To(BeTrue())
+		})
+
+		It("should return false for shoot backupentries", func() {
+			Expect(IsBastionSessionsBackupEntryName(backupEntryName)).To(BeFalse())
+			Expect(IsBastionSessionsBackupEntryName(sourceBackupEntryName)).To(BeFalse())
+			Expect(IsBastionSessionsBackupEntryName(replicaBackupEntryName)).To(BeFalse())
+		})
+	})
+
 	Describe("#ExtractShootDetailsFromBackupEntryName", func() {
 		It("should return the correct parts of the name for core backupentry", func() {
 			technicalID, uid := ExtractShootDetailsFromBackupEntryName(backupEntryName)
@@ -53,6 +87,18 @@ var _ = Describe("BackupEntry", func() {
 			Expect(technicalID).To(Equal(shootTechnicalID))
 			Expect(uid).To(Equal(shootUID))
 		})
+
+		It("should return the correct parts of the name for replica backupentry", func() {
+			technicalID, uid := ExtractShootDetailsFromBackupEntryName(replicaBackupEntryName)
+			Expect(technicalID).To(Equal(shootTechnicalID))
+			Expect(uid).To(Equal(shootUID))
+		})
+
+		It("should return the correct parts of the name for bastion sessions backupentry", func() {
+			technicalID, uid := ExtractShootDetailsFromBackupEntryName(bastionSessionsBackupEntryName)
+			Expect(technicalID).To(Equal(shootTechnicalID))
+			Expect(uid).To(Equal(bastionUID))
+		})
 	})
 
 	Describe("#GetBackupEntrySeedNames", func() {
@@ -7,6 +7,7 @@ package gardener
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -66,3 +67,13 @@ func BuildCloudProfileReference(shoot *gardencorev1beta1.Shoot) *gardencorev1bet
 	}
 	return nil
 }
+
+// SupportsArchitectures returns true if all given architectures are contained in the list of supported architectures.
+func SupportsArchitectures(supportedArchitectures, architectures []string) bool {
+	for _, architecture := range architectures {
+		if !slices.Contains(supportedArchitectures, architecture) {
+			return false
+		}
+	}
+	return true
+}
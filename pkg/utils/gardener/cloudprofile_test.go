@@ -126,4 +126,17 @@ var _ = Describe("CloudProfile", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+	Describe("#SupportsArchitectures", func() {
+		It("should return true if all architectures are supported", func() {
+			Expect(gardenerutils.SupportsArchitectures([]string{"amd64", "arm64"}, []string{"arm64", "amd64"})).To(BeTrue())
+		})
+
+		It("should return true if no architectures are given", func() {
+			Expect(gardenerutils.SupportsArchitectures([]string{"amd64"}, nil)).To(BeTrue())
+		})
+
+		It("should return false if an architecture is not supported", func() {
+			Expect(gardenerutils.SupportsArchitectures([]string{"amd64"}, []string{"amd64", "arm64"})).To(BeFalse())
+		})
+	})
 })
@@ -101,6 +101,11 @@ func mergeImageSources(old, override *ImageSource) *ImageSource {
 		architectures = old.Architectures
 	}
 
+	rewriteRules := override.rewriteRules
+	if rewriteRules == nil {
+		rewriteRules = old.rewriteRules
+	}
+
 	return &ImageSource{
 		Name:           override.Name,
 		RuntimeVersion: runtimeVersion,
@@ -110,6 +115,7 @@ func mergeImageSources(old, override *ImageSource) *ImageSource {
 		Repository:     repository,
 		Tag:            tag,
 		Version:        version,
+		rewriteRules:   rewriteRules,
 	}
 }
 
@@ -368,7 +374,12 @@ func FindImages(v ImageVector, names []string, opts ...FindOptionFunc) (map[stri
 // ToImage applies the given <targetK8sVersion> to the source to produce an output image. This only works when the image
 // is not specified via 'Ref' and when 'Tag' is not set.
 // If the tag of an image source is empty, it will use the given <targetVersion> as tag.
+// The rewrite rules of the source (see `WithRewriteRules`) are applied to the output image.
 func (i *ImageSource) ToImage(targetVersion *string) *Image {
+	return i.toImage(targetVersion).Rewrite(i.rewriteRules)
+}
+
+func (i *ImageSource) toImage(targetVersion *string) *Image {
 	if i.Ref != nil {
 		return &Image{
 			Name:    i.Name,
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector
+
+import (
+	"cmp"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// WithRewriteRules returns a copy of the given image vector whose images are rewritten by the given rules when they are
+// converted to concrete images (see `ToImage`, `FindImage`, and `FindImages`). The given image vector is not modified.
+func WithRewriteRules(vector ImageVector, rules []RewriteRule) ImageVector {
+	if len(rules) == 0 {
+		return vector
+	}
+
+	out := make(ImageVector, 0, len(vector))
+	for _, source := range vector {
+		s := *source
+		s.rewriteRules = rules
+		out = append(out, &s)
+	}
+	return out
+}
+
+// ReadRewriteRules reads rewrite rules from the given bytes.
+func ReadRewriteRules(buf []byte) ([]RewriteRule, error) {
+	data := struct {
+		RewriteRules []RewriteRule `json:"rewriteRules" yaml:"rewriteRules"`
+	}{}
+
+	if err := yaml.Unmarshal(buf, &data); err != nil {
+		return nil, err
+	}
+
+	if errs := ValidateRewriteRules(data.RewriteRules, field.NewPath("rewriteRules")); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return data.RewriteRules, nil
+}
+
+// ReadRewriteRulesFile reads rewrite rules from the file with the given name.
+func ReadRewriteRulesFile(name string) ([]RewriteRule, error) {
+	buf, err := os.ReadFile(name) // #nosec: G304 -- Rewrite rules are a feature. In reality files can be read from the Pod's file system only.
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadRewriteRules(buf)
+}
+
+// Rewrite applies the first matching rule of the given rewrite rules to the given image and returns the result. The
+// given image is not modified.
+func (i *Image) Rewrite(rules []RewriteRule) *Image {
+	for _, rule := range rules {
+		if out, ok := rule.apply(i); ok {
+			return out
+		}
+	}
+	return i
+}
+
+func (r RewriteRule) apply(image *Image) (*Image, bool) {
+	out := *image
+
+	if image.Ref != nil {
+		ref, ok := r.rewrite(*image.Ref, ":@")
+		if !ok {
+			return nil, false
+		}
+		if digest, ok := r.Digests[*image.Ref]; ok && !strings.Contains(ref, "@") {
+			ref += "@" + digest
+		}
+		out.Ref = &ref
+		return &out, true
+	}
+
+	if image.Repository == nil {
+		return nil, false
+	}
+
+	repository, ok := r.rewrite(*image.Repository, "")
+	if !ok {
+		return nil, false
+	}
+	out.Repository = &repository
+
+	if image.Tag != nil && !strings.Contains(*image.Tag, SHA256TagPrefix) {
+		if digest, ok := r.Digests[*image.Repository+":"+*image.Tag]; ok {
+			tag := *image.Tag + "@" + digest
+			out.Tag = &tag
+		}
+	}
+
+	return &out, true
+}
+
+// rewrite replaces the prefix of the given reference with the mirror. The prefix only matches complete path segments,
+// i.e., it must be followed by `/`, the end of the reference, or one of the given delimiters.
+func (r RewriteRule) rewrite(reference, delimiters string) (string, bool) {
+	prefix := strings.TrimSuffix(r.Prefix, "/")
+	rest, ok := strings.CutPrefix(reference, prefix)
+	if !ok || (rest != "" && !strings.ContainsRune("/"+delimiters, rune(rest[0]))) {
+		return "", false
+	}
+
+	return strings.TrimSuffix(r.Mirror, "/") + rest, true
+}
+
+// ResolvedImage is an image of an image vector resolved to a concrete reference.
+type ResolvedImage struct {
+	// Name is the name of the image in the image vector.
+	Name string `json:"name" yaml:"name"`
+	// Source is the reference of the image before applying the rewrite rules.
+	Source string `json:"source" yaml:"source"`
+	// Target is the reference of the image after applying the rewrite rules.
+	Target string `json:"target" yaml:"target"`
+}
+
+// ResolveImages resolves all images of the given image vector and applies the given rewrite rules. Images without tag
+// get the target Kubernetes version as tag, hence they are resolved for each of the given Kubernetes versions which
+// meets their target version constraint. The result is sorted and free of duplicates, e.g., it can be used for
+// pre-pulling all images into a registry mirror.
+func ResolveImages(vector ImageVector, kubernetesVersions []string, rules []RewriteRule) ([]ResolvedImage, error) {
+	var out []ResolvedImage
+
+	add := func(source *ImageSource, targetVersion *string) {
+		image := source.toImage(targetVersion)
+		out = append(out, ResolvedImage{
+			Name:   source.Name,
+			Source: image.String(),
+			Target: image.Rewrite(rules).String(),
+		})
+	}
+
+	for _, source := range vector {
+		if source.Ref != nil || source.Tag != nil {
+			add(source, nil)
+			continue
+		}
+
+		for _, version := range kubernetesVersions {
+			_, ok, err := checkVersionConstraint(source.TargetVersion, &version)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				add(source, &version)
+			}
+		}
+	}
+
+	slices.SortFunc(out, func(a, b ResolvedImage) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Source, b.Source))
+	})
+	return slices.Compact(out), nil
+}
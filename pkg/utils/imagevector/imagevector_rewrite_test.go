@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("imagevector", func() {
+	Describe("#RewriteRules", func() {
+		var (
+			rules = []RewriteRule{
+				{
+					Prefix:  "europe-docker.pkg.dev/gardener-project/releases",
+					Mirror:  "registry.example.com/gardener/",
+					Digests: map[string]string{"europe-docker.pkg.dev/gardener-project/releases/pinned:v1.0.0": "sha256:abc"},
+				},
+				{
+					Prefix: "registry.k8s.io",
+					Mirror: "registry.example.com/k8s",
+				},
+			}
+
+			rulesYAML = `
+rewriteRules:
+- prefix: europe-docker.pkg.dev/gardener-project/releases
+  mirror: registry.example.com/gardener/
+  digests:
+    europe-docker.pkg.dev/gardener-project/releases/pinned:v1.0.0: sha256:abc
+- prefix: registry.k8s.io
+  mirror: registry.example.com/k8s
+`
+		)
+
+		Describe("#ReadRewriteRules", func() {
+			It("should successfully read the rewrite rules", func() {
+				Expect(ReadRewriteRules([]byte(rulesYAML))).To(Equal(rules))
+			})
+
+			It("should fail reading invalid rewrite rules", func() {
+				_, err := ReadRewriteRules([]byte(`
+rewriteRules:
+- prefix: registry.k8s.io
+  digests:
+    registry.k8s.io/foo:v1: abc
+`))
+				Expect(err).To(MatchError(And(
+					ContainSubstring("rewriteRules[0].mirror: Required value"),
+					ContainSubstring("digest must start with sha256:"),
+				)))
+			})
+		})
+
+		Describe("#ReadRewriteRulesFile", func() {
+			It("should read the rewrite rules from the given file", func() {
+				tmpFile, cleanup := withTempFile("rewrite rules", []byte(rulesYAML))
+				defer cleanup()
+
+				Expect(ReadRewriteRulesFile(tmpFile.Name())).To(Equal(rules))
+			})
+
+			It("should fail if the file does not exist", func() {
+				_, err := ReadRewriteRulesFile("/does/not/exist")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("#Rewrite", func() {
+			DescribeTable("should rewrite the image",
+				func(image, expected *Image) {
+					Expect(image.Rewrite(rules)).To(Equal(expected))
+				},
+
+				Entry("repository matching the prefix",
+					&Image{Name: "foo", Repository: ptr.To("europe-docker.pkg.dev/gardener-project/releases/foo"), Tag: ptr.To("v1.2.3")},
+					&Image{Name: "foo", Repository: ptr.To("registry.example.com/gardener/foo"), Tag: ptr.To("v1.2.3")},
+				),
+				Entry("repository matching the second rule",
+					&Image{Name: "kube-apiserver", Repository: ptr.To("registry.k8s.io/kube-apiserver"), Tag: ptr.To("v1.30.1")},
+					&Image{Name: "kube-apiserver", Repository: ptr.To("registry.example.com/k8s/kube-apiserver"), Tag: ptr.To("v1.30.1")},
+				),
+				Entry("repository with pinned digest",
+					&Image{Name: "pinned", Repository: ptr.To("europe-docker.pkg.dev/gardener-project/releases/pinned"), Tag: ptr.To("v1.0.0")},
+					&Image{Name: "pinned", Repository: ptr.To("registry.example.com/gardener/pinned"), Tag: ptr.To("v1.0.0@sha256:abc")},
+				),
+				Entry("ref matching the prefix",
+					&Image{Name: "foo", Ref: ptr.To("registry.k8s.io/foo:v1.2.3")},
+					&Image{Name: "foo", Ref: ptr.To("registry.example.com/k8s/foo:v1.2.3")},
+				),
+				Entry("ref with pinned digest",
+					&Image{Name: "pinned", Ref: ptr.To("europe-docker.pkg.dev/gardener-project/releases/pinned:v1.0.0")},
+					&Image{Name: "pinned", Ref: ptr.To("registry.example.com/gardener/pinned:v1.0.0@sha256:abc")},
+				),
+				Entry("repository not matching any prefix",
+					&Image{Name: "foo", Repository: ptr.To("docker.io/library/foo"), Tag: ptr.To("v1.2.3")},
+					&Image{Name: "foo", Repository: ptr.To("docker.io/library/foo"), Tag: ptr.To("v1.2.3")},
+				),
+				Entry("repository matching the prefix only partially",
+					&Image{Name: "foo", Repository: ptr.To("registry.k8s.io.example.com/foo"), Tag: ptr.To("v1.2.3")},
+					&Image{Name: "foo", Repository: ptr.To("registry.k8s.io.example.com/foo"), Tag: ptr.To("v1.2.3")},
+				),
+			)
+		})
+
+		Describe("#WithRewriteRules", func() {
+			var vector ImageVector
+
+			BeforeEach(func() {
+				vector = ImageVector{{Name: "kube-apiserver", Repository: ptr.To("registry.k8s.io/kube-apiserver")}}
+			})
+
+			It("should apply the rewrite rules when finding images", func() {
+				image, err := WithRewriteRules(vector, rules).FindImage("kube-apiserver", TargetVersion("1.30.1"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(image.String()).To(Equal("registry.example.com/k8s/kube-apiserver:v1.30.1"))
+			})
+
+			It("should not modify the given image vector", func() {
+				WithRewriteRules(vector, rules)
+
+				image, err := vector.FindImage("kube-apiserver", TargetVersion("1.30.1"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(image.String()).To(Equal("registry.k8s.io/kube-apiserver:v1.30.1"))
+			})
+
+			It("should keep the rewrite rules when merging image vectors", func() {
+				merged := Merge(WithRewriteRules(vector, rules), ImageVector{{Name: "kube-apiserver", Tag: ptr.To("v1.31.0")}})
+
+				image, err := merged.FindImage("kube-apiserver")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(image.String()).To(Equal("registry.example.com/k8s/kube-apiserver:v1.31.0"))
+			})
+		})
+
+		Describe("#ResolveImages", func() {
+			It("should resolve all images", func() {
+				vector := ImageVector{
+					{Name: "foo", Repository: ptr.To("europe-docker.pkg.dev/gardener-project/releases/foo"), Tag: ptr.To("v1.2.3")},
+					{Name: "bar", Ref: ptr.To("docker.io/library/bar:v1")},
+					{Name: "kube-apiserver", Repository: ptr.To("registry.k8s.io/kube-apiserver")},
+					{Name: "hyperkube", Repository: ptr.To("europe-docker.pkg.dev/gardener-project/releases/hyperkube"), TargetVersion: ptr.To(">= 1.30")},
+				}
+
+				Expect(ResolveImages(vector, []string{"1.29.2", "1.30.1"}, rules)).To(Equal([]ResolvedImage{
+					{Name: "bar", Source: "docker.io/library/bar:v1", Target: "docker.io/library/bar:v1"},
+					{Name: "foo", Source: "europe-docker.pkg.dev/gardener-project/releases/foo:v1.2.3", Target: "registry.example.com/gardener/foo:v1.2.3"},
+					{Name: "hyperkube", Source: "europe-docker.pkg.dev/gardener-project/releases/hyperkube:v1.30.1", Target: "registry.example.com/gardener/hyperkube:v1.30.1"},
+					{Name: "kube-apiserver", Source: "registry.k8s.io/kube-apiserver:v1.29.2", Target: "registry.example.com/k8s/kube-apiserver:v1.29.2"},
+					{Name: "kube-apiserver", Source: "registry.k8s.io/kube-apiserver:v1.30.1", Target: "registry.example.com/k8s/kube-apiserver:v1.30.1"},
+				}))
+			})
+		})
+	})
+})
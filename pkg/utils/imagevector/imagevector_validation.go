@@ -5,6 +5,8 @@
 package imagevector
 
 import (
+	"strings"
+
 	"github.com/Masterminds/semver/v3"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -35,6 +37,33 @@ func ValidateComponentImageVectors(componentImageVectors ComponentImageVectors,
 	return allErrs
 }
 
+// ValidateRewriteRules validates the given rewrite rules.
+func ValidateRewriteRules(rules []RewriteRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if rule.Prefix == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("prefix"), "prefix is required"))
+		}
+		if rule.Mirror == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("mirror"), "mirror is required"))
+		}
+
+		for reference, digest := range rule.Digests {
+			if !strings.HasPrefix(reference, strings.TrimSuffix(rule.Prefix, "/")) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("digests").Key(reference), reference, "image reference must start with the prefix of the rule"))
+			}
+			if !strings.HasPrefix(digest, SHA256TagPrefix) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("digests").Key(reference), digest, "digest must start with "+SHA256TagPrefix))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateImageSource(imageSource *ImageSource, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -26,6 +26,9 @@ type ImageSource struct {
 	// Version is a human-readable version of the image (helpful in case the ref/tag does not specify it because only a
 	// digest is used).
 	Version *string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// rewriteRules are applied to the image when the source is converted to a concrete image, see `WithRewriteRules`.
+	rewriteRules []RewriteRule
 }
 
 // Image is a concrete, pullable image with a nonempty tag.
@@ -58,3 +61,15 @@ type FindOptions struct {
 
 // FindOptionFunc is a function that mutates FindOptions.
 type FindOptionFunc func(*FindOptions)
+
+// RewriteRule contains a rule for rewriting the repositories of images, e.g., to point them to a registry mirror.
+type RewriteRule struct {
+	// Prefix is the prefix of the image repositories which are rewritten, e.g.,
+	// `europe-docker.pkg.dev/gardener-project/releases`. It only matches complete path segments.
+	Prefix string `json:"prefix" yaml:"prefix"`
+	// Mirror replaces the prefix of the matching image repositories, e.g., `registry.example.com/gardener`.
+	Mirror string `json:"mirror" yaml:"mirror"`
+	// Digests optionally pins images to digests. The keys are the image references (`<repository>:<tag>`) before
+	// rewriting, the values are the digests (`sha256:...`) of the rewritten images.
+	Digests map[string]string `json:"digests,omitempty" yaml:"digests,omitempty"`
+}
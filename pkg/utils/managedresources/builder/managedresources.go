@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -140,6 +141,7 @@ func (m *ManagedResource) Reconcile(ctx context.Context) error {
 		if apierrors.IsNotFound(err) && m.createIfNotExists {
 			// if the mr is not found just create it
 			mutateFn(resource)
+			logger.AnnotateOperationID(ctx, resource)
 
 			return m.client.Create(ctx, resource)
 		}
@@ -164,6 +166,9 @@ func (m *ManagedResource) Reconcile(ctx context.Context) error {
 		return nil
 	}
 
+	// The operation ID is only updated if the ManagedResource changes anyway to avoid unnecessary updates.
+	logger.AnnotateOperationID(ctx, resource)
+
 	return m.client.Update(ctx, resource)
 }
 
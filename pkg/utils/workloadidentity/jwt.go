@@ -182,16 +182,11 @@ func getECDSASigner(key *ecdsa.PrivateKey) (jose.Signer, error) {
 }
 
 func getOpaqueSigner(key jose.OpaqueSigner) (jose.Signer, error) {
-	alg := jose.SignatureAlgorithm(key.Public().Algorithm)
-
+	// The opaque signer is not wrapped into a JSON web key on purpose, so that the key ID header is determined from its
+	// public key whenever a token is signed. This way, rotations of the signing key are reflected in the issued tokens.
 	sk := jose.SigningKey{
-		Algorithm: alg,
-		Key: jose.JSONWebKey{
-			Algorithm: string(alg),
-			Use:       keyUsageSig,
-			Key:       key,
-			KeyID:     key.Public().KeyID,
-		},
+		Algorithm: jose.SignatureAlgorithm(key.Public().Algorithm),
+		Key:       key,
 	}
 
 	return jose.NewSigner(sk, nil)
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadidentity
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// LocalSigner is a reference implementation of the remote signer protocol which signs with a private key held in
+// memory. It is meant for testing and for local setups, production setups should implement the protocol on top of an
+// HSM or a KMS.
+type LocalSigner struct {
+	key   crypto.Signer
+	keyID string
+	alg   jose.SignatureAlgorithm
+}
+
+// NewLocalSigner creates a new LocalSigner for the given RSA or ECDSA private key.
+func NewLocalSigner(key crypto.Signer) (*LocalSigner, error) {
+	switch key.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey:
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, must be *rsa.PrivateKey or *ecdsa.PrivateKey", key)
+	}
+
+	keyID, err := getKeyID(key.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key id: %w", err)
+	}
+
+	alg, err := getAlg(key.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get algorithm: %w", err)
+	}
+
+	return &LocalSigner{key: key, keyID: keyID, alg: alg}, nil
+}
+
+// Serve serves the remote signer protocol on a Unix domain socket at the given path until the context is cancelled.
+func (l *LocalSigner) Serve(ctx context.Context, socketPath string) error {
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed removing stale socket %q: %w", socketPath, err)
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed listening on socket %q: %w", socketPath, err)
+	}
+
+	server := &http.Server{Handler: l.Handler(), ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Handler returns the HTTP handler implementing the remote signer protocol.
+func (l *LocalSigner) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+remoteSignerPathStatus, l.handleStatus)
+	mux.HandleFunc("GET "+remoteSignerPathPublicKeys, l.handlePublicKeys)
+	mux.HandleFunc("POST "+remoteSignerPathSign, l.handleSign)
+	return mux
+}
+
+func (l *LocalSigner) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, &StatusResponse{
+		Version: RemoteSignerAPIVersion,
+		Healthz: RemoteSignerHealthzOK,
+		KeyID:   l.keyID,
+	})
+}
+
+func (l *LocalSigner) handlePublicKeys(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, &PublicKeysResponse{
+		CurrentKeyID: l.keyID,
+		Keys: []jose.JSONWebKey{{
+			Key:       l.key.Public(),
+			KeyID:     l.keyID,
+			Algorithm: string(l.alg),
+			Use:       keyUsageSig,
+		}},
+	})
+}
+
+func (l *LocalSigner) handleSign(w http.ResponseWriter, r *http.Request) {
+	request := &SignRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		http.Error(w, fmt.Sprintf("failed decoding request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if request.KeyID != l.keyID {
+		http.Error(w, fmt.Sprintf("unknown key id %q", request.KeyID), http.StatusBadRequest)
+		return
+	}
+
+	if request.Algorithm != l.alg {
+		http.Error(w, fmt.Sprintf("unsupported algorithm %q, key only supports %q", request.Algorithm, l.alg), http.StatusBadRequest)
+		return
+	}
+
+	signature, err := l.sign(request.Payload)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed signing payload: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, &SignResponse{Signature: signature})
+}
+
+func (l *LocalSigner) sign(payload []byte) ([]byte, error) {
+	var hash crypto.Hash
+	switch l.alg {
+	case jose.RS256, jose.ES256:
+		hash = crypto.SHA256
+	case jose.ES384:
+		hash = crypto.SHA384
+	case jose.ES512:
+		hash = crypto.SHA512
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", l.alg)
+	}
+
+	hasher := hash.New()
+	_, _ = hasher.Write(payload)
+	digest := hasher.Sum(nil)
+
+	switch key := l.key.(type) {
+	case *rsa.PrivateKey:
+		return rsa.SignPKCS1v15(rand.Reader, key, hash, digest)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// JWS requires the concatenation of the fixed-size big-endian encoded R and S values instead of ASN.1.
+		keySize := (key.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*keySize)
+		r.FillBytes(signature[:keySize])
+		s.FillBytes(signature[keySize:])
+		return signature, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %T", l.key)
+}
+
+func writeJSON(w http.ResponseWriter, obj any) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed encoding response: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
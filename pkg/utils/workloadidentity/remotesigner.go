@@ -0,0 +1,369 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadidentity
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// RemoteSignerAPIVersion is the version of the remote signer protocol.
+	RemoteSignerAPIVersion = "v1"
+	// RemoteSignerHealthzOK is the value of the healthz field in the status response of a healthy remote signer.
+	RemoteSignerHealthzOK = "ok"
+
+	remoteSignerPathStatus     = "/" + RemoteSignerAPIVersion + "/status"
+	remoteSignerPathPublicKeys = "/" + RemoteSignerAPIVersion + "/publickeys"
+	remoteSignerPathSign       = "/" + RemoteSignerAPIVersion + "/sign"
+
+	// remoteSignerHost is a placeholder host since all requests are sent over the Unix domain socket.
+	remoteSignerHost = "http://remote-signer"
+	// remoteSignerMaxResponseSize is the maximum size of a response body read from the remote signer.
+	remoteSignerMaxResponseSize = 1 << 20
+)
+
+// StatusResponse is the response of the status endpoint of a remote signer.
+type StatusResponse struct {
+	// Version is the version of the remote signer protocol.
+	Version string `json:"version"`
+	// Healthz is "ok" if the remote signer is healthy, otherwise it contains a reason.
+	Healthz string `json:"healthz"`
+	// KeyID is the ID of the current signing key.
+	KeyID string `json:"keyID"`
+}
+
+// PublicKeysResponse is the response of the public keys endpoint of a remote signer.
+type PublicKeysResponse struct {
+	// CurrentKeyID is the ID of the key which is used for signing.
+	CurrentKeyID string `json:"currentKeyID"`
+	// Keys are all public keys which are valid for verifying signatures of the remote signer, i.e. the current key and
+	// potentially previous keys which are still trusted.
+	Keys []jose.JSONWebKey `json:"keys"`
+}
+
+// SignRequest is the request sent to the sign endpoint of a remote signer.
+type SignRequest struct {
+	// KeyID is the ID of the key which shall be used for signing.
+	KeyID string `json:"keyID"`
+	// Algorithm is the JSON Web Signature algorithm which shall be used for signing.
+	Algorithm jose.SignatureAlgorithm `json:"algorithm"`
+	// Payload is the JWS signing input.
+	Payload []byte `json:"payload"`
+}
+
+// SignResponse is the response of the sign endpoint of a remote signer.
+type SignResponse struct {
+	// Signature is the JWS signature of the payload.
+	Signature []byte `json:"signature"`
+}
+
+// RemoteSigner is a jose.OpaqueSigner which delegates the signing of tokens to an external signing service reachable
+// via a Unix domain socket, e.g. a service backed by an HSM or a KMS. This way, the private key never needs to be loaded
+// into the memory of gardener-apiserver.
+// The public keys are fetched from the signing service when the signer is created and whenever Refresh is called, so
+// that a rotation of the signing key is picked up without a restart. The key IDs announced by the signing service must
+// be computed the same way as for local keys (base64 URL encoded SHA-256 hash of the DER encoded PKIX public key),
+// otherwise the tokens could not be verified with the published JWKS.
+type RemoteSigner struct {
+	client  *http.Client
+	timeout time.Duration
+
+	lock       sync.RWMutex
+	alg        jose.SignatureAlgorithm
+	current    *jose.JSONWebKey
+	publicKeys []any
+}
+
+var _ jose.OpaqueSigner = &RemoteSigner{}
+
+// NewRemoteSigner creates a new RemoteSigner for the given endpoint, e.g. 'unix:///var/run/signer.sock'. Each request
+// to the signing service times out after the given timeout.
+func NewRemoteSigner(ctx context.Context, endpoint string, timeout time.Duration) (*RemoteSigner, error) {
+	socketPath, err := parseRemoteSignerEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &RemoteSigner{
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+		timeout: timeout,
+	}
+
+	status, err := s.status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.Version != RemoteSignerAPIVersion {
+		return nil, fmt.Errorf("unsupported remote signer version %q, expected %q", status.Version, RemoteSignerAPIVersion)
+	}
+
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func parseRemoteSignerEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid remote signer endpoint %q: %w", endpoint, err)
+	}
+
+	if u.Scheme != "unix" {
+		return "", fmt.Errorf("unsupported scheme %q for remote signer endpoint, only 'unix' is supported", u.Scheme)
+	}
+
+	if u.Path == "" {
+		return "", fmt.Errorf("remote signer endpoint %q does not contain a socket path", endpoint)
+	}
+
+	return u.Path, nil
+}
+
+// ValidateRemoteSignerEndpoint validates the given remote signer endpoint.
+func ValidateRemoteSignerEndpoint(endpoint string) error {
+	_, err := parseRemoteSignerEndpoint(endpoint)
+	return err
+}
+
+// Refresh fetches the public keys from the remote signer and switches to the key which the remote signer announces as
+// current signing key. The signing algorithm must not change during the lifetime of the signer since it cannot be
+// changed for token issuers which were already created.
+func (s *RemoteSigner) Refresh(ctx context.Context) error {
+	response := &PublicKeysResponse{}
+	if err := s.do(ctx, http.MethodGet, remoteSignerPathPublicKeys, nil, response); err != nil {
+		return fmt.Errorf("failed fetching public keys from remote signer: %w", err)
+	}
+
+	var (
+		current    *jose.JSONWebKey
+		publicKeys []any
+	)
+
+	for _, key := range response.Keys {
+		if !key.IsPublic() {
+			return fmt.Errorf("remote signer returned key %q which is not a public key", key.KeyID)
+		}
+
+		keyID, err := getKeyID(key.Key)
+		if err != nil {
+			return fmt.Errorf("failed computing key id of public key %q: %w", key.KeyID, err)
+		}
+		if keyID != key.KeyID {
+			return fmt.Errorf("key id %q announced by remote signer does not match the computed key id %q", key.KeyID, keyID)
+		}
+
+		alg, err := getAlg(key.Key)
+		if err != nil {
+			return fmt.Errorf("failed determining algorithm of public key %q: %w", key.KeyID, err)
+		}
+
+		if key.KeyID == response.CurrentKeyID {
+			current = &jose.JSONWebKey{
+				Key:       key.Key,
+				KeyID:     keyID,
+				Algorithm: string(alg),
+				Use:       keyUsageSig,
+			}
+		}
+
+		publicKeys = append(publicKeys, key.Key)
+	}
+
+	if current == nil {
+		return fmt.Errorf("remote signer did not return public key for the current key id %q", response.CurrentKeyID)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	alg := jose.SignatureAlgorithm(current.Algorithm)
+	if s.alg != "" && s.alg != alg {
+		return fmt.Errorf("remote signer changed the signing algorithm from %q to %q, a restart is required to pick up the new key", s.alg, alg)
+	}
+
+	s.alg = alg
+	s.current = current
+	s.publicKeys = publicKeys
+	return nil
+}
+
+// Start refreshes the public keys of the remote signer in the given interval until the context is cancelled. The
+// given function is called with the error of each failed refresh.
+func (s *RemoteSigner) Start(ctx context.Context, interval time.Duration, onError func(err error)) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := s.Refresh(ctx); err != nil {
+			onError(err)
+		}
+	}, interval)
+}
+
+// Public returns the public key of the current signing key.
+func (s *RemoteSigner) Public() *jose.JSONWebKey {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.current
+}
+
+// Algs returns the signing algorithm of the remote signer.
+func (s *RemoteSigner) Algs() []jose.SignatureAlgorithm {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return []jose.SignatureAlgorithm{s.alg}
+}
+
+// SignPayload sends the payload to the remote signer and returns the signature. The payload is signed with the key
+// whose ID is contained in its protected header. This way, the key ID of the token always matches the signing key even
+// if the current key is rotated while the token is signed.
+func (s *RemoteSigner) SignPayload(payload []byte, alg jose.SignatureAlgorithm) ([]byte, error) {
+	keyID, err := keyIDFromSigningInput(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignResponse{}
+	if err := s.do(context.Background(), http.MethodPost, remoteSignerPathSign, &SignRequest{
+		KeyID:     keyID,
+		Algorithm: alg,
+		Payload:   payload,
+	}, response); err != nil {
+		return nil, fmt.Errorf("failed signing payload with remote signer: %w", err)
+	}
+
+	if len(response.Signature) == 0 {
+		return nil, errors.New("remote signer returned an empty signature")
+	}
+
+	return response.Signature, nil
+}
+
+// keyIDFromSigningInput returns the key ID from the protected header of the given JWS signing input, i.e.
+// `BASE64URL(protected header) || '.' || BASE64URL(payload)`.
+func keyIDFromSigningInput(signingInput []byte) (string, error) {
+	encodedHeader, _, found := bytes.Cut(signingInput, []byte("."))
+	if !found {
+		return "", errors.New("payload is not a JWS signing input")
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(string(encodedHeader))
+	if err != nil {
+		return "", fmt.Errorf("failed decoding protected header: %w", err)
+	}
+
+	header := struct {
+		KeyID string `json:"kid"`
+	}{}
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return "", fmt.Errorf("failed unmarshalling protected header: %w", err)
+	}
+
+	if header.KeyID == "" {
+		return "", errors.New("protected header does not contain a key id")
+	}
+
+	return header.KeyID, nil
+}
+
+// PublicKeys returns all public keys of the remote signer. They can be used to build the OpenID configuration and the
+// JWKS discovery documents, see OpenIDConfig and JWKS.
+func (s *RemoteSigner) PublicKeys() []any {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.publicKeys
+}
+
+// Name returns the name of the health check.
+func (s *RemoteSigner) Name() string {
+	return "workload-identity-remote-signer"
+}
+
+// Check checks whether the remote signer is healthy. It does not modify the signer, the public keys are only refreshed
+// by Refresh, see Start.
+func (s *RemoteSigner) Check(req *http.Request) error {
+	status, err := s.status(req.Context())
+	if err != nil {
+		return err
+	}
+
+	if status.Healthz != RemoteSignerHealthzOK {
+		return fmt.Errorf("remote signer is not healthy: %s", status.Healthz)
+	}
+
+	return nil
+}
+
+func (s *RemoteSigner) status(ctx context.Context) (*StatusResponse, error) {
+	response := &StatusResponse{}
+	if err := s.do(ctx, http.MethodGet, remoteSignerPathStatus, nil, response); err != nil {
+		return nil, fmt.Errorf("failed getting status of remote signer: %w", err)
+	}
+	return response, nil
+}
+
+func (s *RemoteSigner) do(ctx context.Context, method, path string, body, into any) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, remoteSignerHost+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, remoteSignerMaxResponseSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > remoteSignerMaxResponseSize {
+		return fmt.Errorf("response of remote signer exceeds the maximum size of %d bytes", remoteSignerMaxResponseSize)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(bytes.TrimSpace(data)))
+	}
+
+	return json.Unmarshal(data, into)
+}
@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadidentity_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/workloadidentity"
+)
+
+var _ = Describe("#RemoteSigner", func() {
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		socketPath string
+		endpoint   string
+		serveErr   chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		// Unix socket paths are limited in length, hence the shorter temporary directory.
+		dir, err := os.MkdirTemp("", "signer")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { Expect(os.RemoveAll(dir)).To(Succeed()) })
+
+		socketPath = filepath.Join(dir, "signer.sock")
+		endpoint = "unix://" + socketPath
+		serveErr = make(chan error, 1)
+	})
+
+	serveWithContext := func(ctx context.Context, key crypto.Signer) {
+		localSigner, err := workloadidentity.NewLocalSigner(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		go func(serveErr chan<- error, socketPath string) {
+			defer GinkgoRecover()
+			serveErr <- localSigner.Serve(ctx, socketPath)
+		}(serveErr, socketPath)
+
+		Eventually(func() error {
+			_, err := os.Stat(socketPath)
+			return err
+		}).Should(Succeed())
+	}
+
+	serve := func(key crypto.Signer) {
+		serveWithContext(ctx, key)
+	}
+
+	DescribeTable("should issue tokens which can be verified with the published keys",
+		func(key func() crypto.Signer, alg jose.SignatureAlgorithm) {
+			serve(key())
+
+			signer, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.Algs()).To(ConsistOf(alg))
+
+			kid, err := workloadidentity.GetKeyID(key().Public())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.Public().KeyID).To(Equal(kid))
+			Expect(signer.PublicKeys()).To(ConsistOf(key().Public()))
+
+			tokenIssuer, err := workloadidentity.NewTokenIssuer(signer, issuer, 600, 3600)
+			Expect(err).NotTo(HaveOccurred())
+
+			token, _, err := tokenIssuer.IssueToken("sub", []string{"aud"}, 1800)
+			Expect(err).NotTo(HaveOccurred())
+
+			rawJWKS, err := workloadidentity.JWKS(signer.PublicKeys()...)
+			Expect(err).NotTo(HaveOccurred())
+			jwks := &jose.JSONWebKeySet{}
+			Expect(json.Unmarshal(rawJWKS, jwks)).To(Succeed())
+
+			parsed, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{alg})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Headers).To(HaveLen(1))
+			Expect(parsed.Headers[0].KeyID).To(Equal(kid))
+
+			verificationKeys := jwks.Key(parsed.Headers[0].KeyID)
+			Expect(verificationKeys).To(HaveLen(1))
+
+			claims := &jwt.Claims{}
+			Expect(parsed.Claims(verificationKeys[0].Key, claims)).To(Succeed())
+			Expect(claims.Subject).To(Equal("sub"))
+			Expect(claims.Audience).To(ConsistOf("aud"))
+
+			config, err := workloadidentity.OpenIDConfig(issuer, signer.PublicKeys()...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(config)).To(ContainSubstring(`"id_token_signing_alg_values_supported":["` + string(alg) + `"]`))
+		},
+
+		Entry("RSA key", func() crypto.Signer { return rsaPrivateKey }, jose.RS256),
+		Entry("ECDSA key", func() crypto.Signer { return ecdsaPrivateKey }, jose.ES512),
+	)
+
+	It("should report healthy as long as the signer is reachable", func() {
+		serve(rsaPrivateKey)
+
+		signer, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer.Name()).To(Equal("workload-identity-remote-signer"))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/readyz", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer.Check(req)).To(Succeed())
+
+		cancel()
+		Eventually(serveErr).Should(Receive(BeNil()))
+
+		req, err = http.NewRequestWithContext(context.Background(), http.MethodGet, "/readyz", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer.Check(req)).To(MatchError(ContainSubstring("failed getting status of remote signer")))
+	})
+
+	Describe("rotation of the signing key", func() {
+		var (
+			serveCancel context.CancelFunc
+			newKey      *rsa.PrivateKey
+		)
+
+		BeforeEach(func() {
+			var serveCtx context.Context
+			serveCtx, serveCancel = context.WithCancel(ctx)
+			serveWithContext(serveCtx, rsaPrivateKey)
+
+			var err error
+			newKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		rotate := func(key crypto.Signer) {
+			serveCancel()
+			Eventually(serveErr).Should(Receive(BeNil()))
+			serve(key)
+		}
+
+		It("should pick up the new key when the public keys are refreshed", func() {
+			signer, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+
+			tokenIssuer, err := workloadidentity.NewTokenIssuer(signer, issuer, 600, 3600)
+			Expect(err).NotTo(HaveOccurred())
+
+			oldKeyID := signer.Public().KeyID
+			rotate(newKey)
+
+			By("Ensure the readiness check does not refresh the public keys")
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/readyz", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.Check(req)).To(Succeed())
+			Expect(signer.Public().KeyID).To(Equal(oldKeyID))
+			Expect(signer.PublicKeys()).To(ConsistOf(rsaPrivateKey.Public()))
+
+			Expect(signer.Refresh(ctx)).To(Succeed())
+
+			newKeyID, err := workloadidentity.GetKeyID(newKey.Public())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.Public().KeyID).To(Equal(newKeyID))
+			Expect(signer.PublicKeys()).To(ConsistOf(newKey.Public()))
+
+			token, _, err := tokenIssuer.IssueToken("sub", []string{"aud"}, 1800)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.RS256})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Headers[0].KeyID).To(Equal(newKeyID))
+			Expect(parsed.Claims(newKey.Public(), &jwt.Claims{})).To(Succeed())
+		})
+
+		It("should sign with the key from the protected header even if the key was rotated in the meantime", func() {
+			signer, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+
+			oldKeyID := signer.Public().KeyID
+			signingInput := []byte(base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","kid":"`+oldKeyID+`"}`)) + ".cGF5bG9hZA")
+
+			rotate(newKey)
+			Expect(signer.Refresh(ctx)).To(Succeed())
+			Expect(signer.Public().KeyID).NotTo(Equal(oldKeyID))
+
+			_, err = signer.SignPayload(signingInput, jose.RS256)
+			Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("unknown key id %q", oldKeyID))))
+		})
+
+		It("should periodically refresh the public keys", func() {
+			signer, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+
+			rotate(newKey)
+
+			refreshCtx, refreshCancel := context.WithCancel(ctx)
+			refreshDone := make(chan struct{})
+			DeferCleanup(func() {
+				refreshCancel()
+				<-refreshDone
+			})
+
+			go func() {
+				defer close(refreshDone)
+				signer.Start(refreshCtx, 10*time.Millisecond, func(err error) {
+					defer GinkgoRecover()
+					// Refreshes in flight fail when the test is finished.
+					if refreshCtx.Err() != nil {
+						return
+					}
+					Expect(err).NotTo(HaveOccurred())
+				})
+			}()
+
+			Eventually(signer.PublicKeys).Should(ConsistOf(newKey.Public()))
+		})
+
+		It("should refuse to change the signing algorithm", func() {
+			signer, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+
+			rotate(ecdsaPrivateKey)
+
+			Expect(signer.Refresh(ctx)).To(MatchError(ContainSubstring("remote signer changed the signing algorithm")))
+			Expect(signer.PublicKeys()).To(ConsistOf(rsaPrivateKey.Public()))
+		})
+	})
+
+	It("should fail signing payloads without key id in the protected header", func() {
+		serve(rsaPrivateKey)
+
+		signer, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = signer.SignPayload([]byte(base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`))+".cGF5bG9hZA"), jose.RS256)
+		Expect(err).To(MatchError("protected header does not contain a key id"))
+	})
+
+	It("should fail if the signer is not reachable", func() {
+		_, err := workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+		Expect(err).To(MatchError(ContainSubstring("failed getting status of remote signer")))
+	})
+
+	It("should fail if the response of the signer is too large", func() {
+		listener, err := net.Listen("unix", socketPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		server := &http.Server{
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"version":"` + strings.Repeat("v", 2<<20) + `"}`))
+			}),
+			ReadHeaderTimeout: time.Second,
+		}
+		go func() { _ = server.Serve(listener) }()
+		DeferCleanup(server.Close)
+
+		_, err = workloadidentity.NewRemoteSigner(ctx, endpoint, time.Second)
+		Expect(err).To(MatchError(ContainSubstring("response of remote signer exceeds the maximum size")))
+	})
+
+	DescribeTable("#ValidateRemoteSignerEndpoint",
+		func(endpoint string, matcher OmegaMatcher) {
+			Expect(workloadidentity.ValidateRemoteSignerEndpoint(endpoint)).To(matcher)
+		},
+
+		Entry("valid endpoint", "unix:///var/run/signer.sock", Succeed()),
+		Entry("unsupported scheme", "https://signer.local", MatchError(ContainSubstring("only 'unix' is supported"))),
+		Entry("missing socket path", "unix://", MatchError(ContainSubstring("does not contain a socket path"))),
+	)
+})
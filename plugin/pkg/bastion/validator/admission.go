@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -146,6 +147,14 @@ func (v *Bastion) Admit(ctx context.Context, a admission.Attributes, _ admission
 		return apierrors.NewInvalid(gk, bastion.Name, field.ErrorList{fieldErr})
 	}
 
+	if err := v.admitSessionRecording(ctx, a, bastion, shoot); err != nil {
+		return err
+	}
+
+	if err := admitApproval(a, bastion, shoot); err != nil {
+		return err
+	}
+
 	// update bastion
 	bastion.Spec.SeedName = shoot.Spec.SeedName
 	bastion.Spec.ProviderType = &shoot.Spec.Provider.Type
@@ -160,3 +169,77 @@ func (v *Bastion) Admit(ctx context.Context, a admission.Attributes, _ admission
 
 	return nil
 }
+
+func (v *Bastion) admitSessionRecording(ctx context.Context, a admission.Attributes, bastion *operations.Bastion, shoot *gardencorev1beta1.Shoot) error {
+	if a.GetOperation() != admission.Create {
+		return nil
+	}
+
+	var (
+		gk                   = schema.GroupKind{Group: operations.GroupName, Kind: "Bastion"}
+		sessionRecordingPath = field.NewPath("spec", "sessionRecording")
+		recordingEnabled     = bastion.Spec.SessionRecording != nil && bastion.Spec.SessionRecording.Enabled
+	)
+
+	if !recordingEnabled {
+		if kubernetes.HasMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootBastionSessionRecordingRequired, "true") {
+			fieldErr := field.Required(sessionRecordingPath, fmt.Sprintf("shoot %s/%s requires recording of bastion sessions", shoot.Namespace, shoot.Name))
+			return apierrors.NewInvalid(gk, bastion.Name, field.ErrorList{fieldErr})
+		}
+		return nil
+	}
+
+	// ensure the seed has a backup bucket to which the recordings can be shipped
+	seed, err := v.coreClient.CoreV1beta1().Seeds().Get(ctx, *shoot.Spec.SeedName, metav1.GetOptions{})
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not get seed %s: %v", *shoot.Spec.SeedName, err))
+	}
+
+	if seed.Spec.Backup == nil {
+		fieldErr := field.Invalid(sessionRecordingPath.Child("enabled"), true, fmt.Sprintf("seed %s does not have backups enabled", seed.Name))
+		return apierrors.NewInvalid(gk, bastion.Name, field.ErrorList{fieldErr})
+	}
+
+	return nil
+}
+
+func admitApproval(a admission.Attributes, bastion *operations.Bastion, shoot *gardencorev1beta1.Shoot) error {
+	var (
+		gk           = schema.GroupKind{Group: operations.GroupName, Kind: "Bastion"}
+		approvalPath = field.NewPath("spec", "approval", "approvedBy")
+	)
+
+	if a.GetOperation() == admission.Create {
+		if bastion.Spec.Approval == nil && kubernetes.HasMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootBastionApprovalRequired, "true") {
+			bastion.Spec.Approval = &operations.BastionApproval{}
+		}
+
+		if bastion.Spec.Approval != nil && bastion.Spec.Approval.ApprovedBy != nil {
+			return apierrors.NewInvalid(gk, bastion.Name, field.ErrorList{field.Forbidden(approvalPath, "bastion cannot be approved by its creator")})
+		}
+		return nil
+	}
+
+	oldBastion, ok := a.GetOldObject().(*operations.Bastion)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert old object to Bastion")
+	}
+
+	if bastion.Spec.Approval == nil || bastion.Spec.Approval.ApprovedBy == nil ||
+		(oldBastion.Spec.Approval != nil && oldBastion.Spec.Approval.ApprovedBy != nil) {
+		return nil
+	}
+
+	userInfo := a.GetUserInfo()
+	if userInfo == nil {
+		return apierrors.NewInvalid(gk, bastion.Name, field.ErrorList{field.Forbidden(approvalPath, "cannot determine approving user")})
+	}
+
+	if userInfo.GetName() == oldBastion.Annotations[v1beta1constants.GardenCreatedBy] {
+		return apierrors.NewInvalid(gk, bastion.Name, field.ErrorList{field.Forbidden(approvalPath, "bastion cannot be approved by its creator")})
+	}
+
+	// always record the user who approved the bastion
+	bastion.Spec.Approval.ApprovedBy = ptr.To(userInfo.GetName())
+	return nil
+}
@@ -278,6 +278,117 @@ var _ = Describe("Bastion", func() {
 			err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)
 			Expect(err).To(Succeed())
 		})
+		Context("session recording", func() {
+			var seed *gardencorev1beta1.Seed
+
+			BeforeEach(func() {
+				seed = &gardencorev1beta1.Seed{
+					ObjectMeta: metav1.ObjectMeta{Name: seedName},
+					Spec: gardencorev1beta1.SeedSpec{
+						Backup: &gardencorev1beta1.SeedBackup{Provider: provider},
+					},
+				}
+
+				coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+				coreClient.AddReactor("get", "seeds", func(_ testing.Action) (bool, runtime.Object, error) {
+					return true, seed, nil
+				})
+			})
+
+			It("should allow the Bastion creation with session recording", func() {
+				bastion.Spec.SessionRecording = &operations.BastionSessionRecording{Enabled: true}
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)).To(Succeed())
+			})
+
+			It("should forbid the Bastion creation with session recording if the Seed has no backup", func() {
+				seed.Spec.Backup = nil
+				bastion.Spec.SessionRecording = &operations.BastionSessionRecording{Enabled: true}
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)
+				Expect(err).To(BeInvalidError())
+				Expect(getErrorList(err)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.sessionRecording.enabled"),
+					})),
+				))
+			})
+
+			It("should forbid the Bastion creation without session recording if the Shoot requires it", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootBastionSessionRecordingRequired, "true")
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)
+				Expect(err).To(BeInvalidError())
+				Expect(getErrorList(err)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.sessionRecording"),
+					})),
+				))
+			})
+		})
+
+		Context("approval", func() {
+			BeforeEach(func() {
+				coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+			})
+
+			It("should not require an approval by default", func() {
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)).To(Succeed())
+				Expect(bastion.Spec.Approval).To(BeNil())
+			})
+
+			It("should require an approval if the Shoot requires it", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootBastionApprovalRequired, "true")
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)).To(Succeed())
+				Expect(bastion.Spec.Approval).To(Equal(&operations.BastionApproval{}))
+			})
+
+			It("should forbid approving the Bastion on creation", func() {
+				bastion.Spec.Approval = &operations.BastionApproval{ApprovedBy: ptr.To("someone")}
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)
+				Expect(err).To(BeInvalidError())
+				Expect(getErrorList(err)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.approval.approvedBy"),
+					})),
+				))
+			})
+
+			It("should forbid approving the Bastion by its creator", func() {
+				bastion.Annotations = map[string]string{v1beta1constants.GardenCreatedBy: userName}
+				bastion.Spec.Approval = &operations.BastionApproval{}
+				oldBastion := bastion.DeepCopy()
+				bastion.Spec.Approval.ApprovedBy = ptr.To(userName)
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)
+				Expect(err).To(BeInvalidError())
+				Expect(getErrorList(err)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.approval.approvedBy"),
+					})),
+				))
+			})
+
+			It("should record the approving user", func() {
+				bastion.Annotations = map[string]string{v1beta1constants.GardenCreatedBy: "creator"}
+				bastion.Spec.Approval = &operations.BastionApproval{}
+				oldBastion := bastion.DeepCopy()
+				bastion.Spec.Approval.ApprovedBy = ptr.To("someone-else")
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)).To(Succeed())
+				Expect(bastion.Spec.Approval.ApprovedBy).To(PointTo(Equal(userName)))
+			})
+		})
 	})
 
 	Describe("#Register", func() {
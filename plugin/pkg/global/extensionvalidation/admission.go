@@ -218,12 +218,24 @@ func (e *ExtensionValidator) Validate(_ context.Context, a admission.Attributes,
 }
 
 func (e *ExtensionValidator) validateBackupBucket(kindToTypesMap map[string]sets.Set[string], spec core.BackupBucketSpec) error {
-	return isExtensionRegistered(
-		kindToTypesMap,
-		extensionsv1alpha1.BackupBucketResource,
-		spec.Provider.Type,
-		"given BackupBucket uses non-registered provider type: "+field.NewPath("spec", "provider", "type").String(),
+	var (
+		message = "given BackupBucket uses non-registered"
+
+		requiredExtensions = requiredExtensions{
+			{extensionsv1alpha1.BackupBucketResource, spec.Provider.Type, fmt.Sprintf("%s provider type: %s", message, field.NewPath("spec", "provider", "type"))},
+		}
 	)
+
+	if spec.Replication != nil {
+		msg := fmt.Sprintf("%s replication provider type: %s", message, field.NewPath("spec", "replication", "provider", "type"))
+		requiredExtensions = append(
+			requiredExtensions,
+			requiredExtension{extensionsv1alpha1.BackupBucketResource, spec.Replication.Provider.Type, msg},
+			requiredExtension{extensionsv1alpha1.BackupEntryResource, spec.Replication.Provider.Type, msg},
+		)
+	}
+
+	return requiredExtensions.areRegistered(kindToTypesMap)
 }
 
 func (e *ExtensionValidator) validateBackupEntry(kindToTypesMap map[string]sets.Set[string], bucketType string) error {
@@ -251,6 +263,15 @@ func (e *ExtensionValidator) validateSeed(kindToTypesMap map[string]sets.Set[str
 			requiredExtension{extensionsv1alpha1.BackupBucketResource, spec.Backup.Provider, msg},
 			requiredExtension{extensionsv1alpha1.BackupEntryResource, spec.Backup.Provider, msg},
 		)
+
+		if spec.Backup.Replication != nil {
+			msg := fmt.Sprintf("%s replication provider type: %s", message, field.NewPath("spec", "backup", "replication", "provider", "type"))
+			requiredExtensions = append(
+				requiredExtensions,
+				requiredExtension{extensionsv1alpha1.BackupBucketResource, spec.Backup.Replication.Provider.Type, msg},
+				requiredExtension{extensionsv1alpha1.BackupEntryResource, spec.Backup.Replication.Provider.Type, msg},
+			)
+		}
 	}
 
 	if spec.Ingress != nil && spec.DNS.Provider != nil {
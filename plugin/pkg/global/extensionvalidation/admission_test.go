@@ -100,6 +100,20 @@ var _ = Describe("ExtensionValidator", func() {
 			attrs := admission.NewAttributesRecord(backupBucket, backupBucket, core.Kind("BackupBucket").WithVersion("version"), backupBucket.Namespace, backupBucket.Name, core.Resource("backupbuckets").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
 			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
 		})
+
+		It("should prevent the object from being created because replication extension type is not registered", func() {
+			backupBucketWithReplication := backupBucket.DeepCopy()
+			backupBucketWithReplication.Spec.Replication = &core.BackupBucketReplication{Provider: core.BackupBucketProvider{Type: "bar"}}
+
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.BackupBucketResource, backupBucket.Spec.Provider.Type, true, nil)
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+
+			attrs := admission.NewAttributesRecord(backupBucketWithReplication, nil, core.Kind("BackupBucket").WithVersion("version"), backupBucket.Namespace, backupBucket.Name, core.Resource("backupbuckets").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+			Expect(err).To(MatchError(ContainSubstring("given BackupBucket uses non-registered replication provider type: spec.replication.provider.type")))
+		})
 	})
 
 	Context("BackupEntry", func() {
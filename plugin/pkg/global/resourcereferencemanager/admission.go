@@ -1000,6 +1000,12 @@ func (r *ReferenceManager) ensureBackupBucketReferences(ctx context.Context, old
 		}
 	}
 
+	if backupBucket.Spec.Replication != nil {
+		if err := r.lookupSecret(ctx, backupBucket.Spec.Replication.SecretRef.Namespace, backupBucket.Spec.Replication.SecretRef.Name); err != nil {
+			return err
+		}
+	}
+
 	return r.lookupSecret(ctx, backupBucket.Spec.SecretRef.Namespace, backupBucket.Spec.SecretRef.Name)
 }
 
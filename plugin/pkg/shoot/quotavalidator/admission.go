@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -419,63 +420,67 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 	)
 
 	for _, worker := range workers {
-		var (
-			machineType *gardencorev1beta1.MachineType
-			volumeType  *gardencorev1beta1.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, e := range machineTypes {
-			element := e
-			if element.Name == worker.Machine.Type {
-				machineType = &element
-				break
+		// The maximum of the worker pool applies to the machines of each machine type individually, hence the resources
+		// of all machine types are accounted for.
+		for _, machineTypeName := range machineTypeNames(worker.Machine) {
+			var (
+				machineType *gardencorev1beta1.MachineType
+				volumeType  *gardencorev1beta1.VolumeType
+			)
+
+			// Get the proper machineType
+			for _, e := range machineTypes {
+				element := e
+				if element.Name == machineTypeName {
+					machineType = &element
+					break
+				}
+			}
+			if machineType == nil {
+				return nil, fmt.Errorf("machineType %s not found in CloudProfile", machineTypeName)
 			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
-		}
 
-		if worker.Volume != nil {
-			if machineType.Storage != nil {
-				volumeType = &gardencorev1beta1.VolumeType{
-					Class: machineType.Storage.Class,
-				}
-			} else {
-				// Get the proper VolumeType
-				for _, e := range volumeTypes {
-					element := e
-					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
-						volumeType = &element
-						break
+			if worker.Volume != nil {
+				if machineType.Storage != nil {
+					volumeType = &gardencorev1beta1.VolumeType{
+						Class: machineType.Storage.Class,
+					}
+				} else {
+					// Get the proper VolumeType
+					for _, e := range volumeTypes {
+						element := e
+						if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
+							volumeType = &element
+							break
+						}
 					}
 				}
 			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
-		}
+			if volumeType == nil {
+				return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", machineTypeName)
+			}
 
-		// For now we always use the max. amount of resources for quota calculation
-		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
-		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
-		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+			// For now we always use the max. amount of resources for quota calculation
+			resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
+			resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
+			resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
 
-		size, _ := resource.ParseQuantity("0Gi")
-		if worker.Volume != nil {
-			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
-			if err != nil {
-				return nil, err
+			size, _ := resource.ParseQuantity("0Gi")
+			if worker.Volume != nil {
+				size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
+				if err != nil {
+					return nil, err
+				}
 			}
-		}
 
-		switch volumeType.Class {
-		case core.VolumeClassStandard:
-			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
-		case core.VolumeClassPremium:
-			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
-		default:
-			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+			switch volumeType.Class {
+			case core.VolumeClassStandard:
+				resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
+			case core.VolumeClassPremium:
+				resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
+			default:
+				return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+			}
 		}
 	}
 
@@ -510,6 +515,14 @@ func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.
 	return workers
 }
 
+func machineTypeNames(machine core.Machine) []string {
+	names := []string{machine.Type}
+	for _, alternativeType := range machine.AlternativeTypes {
+		names = append(names, alternativeType.Type)
+	}
+	return names
+}
+
 func lifetimeVerificationNeeded(new, old core.Shoot) bool {
 	oldLifetime, ok := old.Annotations[v1beta1constants.ShootExpirationTimestamp]
 	if !ok {
@@ -532,7 +545,7 @@ func quotaVerificationNeeded(new, old core.Shoot) bool {
 			if worker.Name == oldWorker.Name {
 				oldHasWorker = true
 
-				if worker.Machine.Type != oldWorker.Machine.Type || worker.Maximum != oldWorker.Maximum || !apiequality.Semantic.DeepEqual(worker.Volume, oldWorker.Volume) {
+				if !slices.Equal(machineTypeNames(worker.Machine), machineTypeNames(oldWorker.Machine)) || worker.Maximum != oldWorker.Maximum || !apiequality.Semantic.DeepEqual(worker.Volume, oldWorker.Volume) {
 					return true
 				}
 			}
@@ -339,6 +339,14 @@ var _ = Describe("quotavalidator", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("should fail because the alternative machine types of a worker exhaust quota limits", func() {
+				shoot.Spec.Provider.Workers[0].Machine.AlternativeTypes = []core.AlternativeMachineType{{Type: machineTypeName2}}
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(HaveOccurred())
+			})
+
 			It("should pass because can update non worker property although quota is exceeded", func() {
 				oldShoot = *shoot.DeepCopy()
 				quotaProject.Spec.Metrics[core.QuotaMetricCPU] = resource.MustParse("1")
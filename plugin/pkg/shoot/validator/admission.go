@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
@@ -46,6 +47,8 @@ import (
 	securityinformers "github.com/gardener/gardener/pkg/client/security/informers/externalversions"
 	securityv1alpha1listers "github.com/gardener/gardener/pkg/client/security/listers/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	plugin "github.com/gardener/gardener/plugin/pkg"
@@ -316,6 +319,12 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	if err := validationContext.validateShootHibernation(a); err != nil {
 		return err
 	}
+	if err := validationContext.validateETCDRestore(a); err != nil {
+		return err
+	}
+	if err := validationContext.validateRemovedAPIsNotInUse(ctx, a); err != nil {
+		return err
+	}
 	if err := validationContext.validateSecretBindingToCredentialsBindingMigration(a, v.secretBindingLister, v.credentialsBindingLister); err != nil {
 		return err
 	}
@@ -631,6 +640,77 @@ func (c *validationContext) validateShootHibernation(a admission.Attributes) err
 	return nil
 }
 
+func (c *validationContext) validateETCDRestore(a admission.Attributes) error {
+	if a.GetOperation() != admission.Update {
+		return nil
+	}
+
+	for _, annotation := range []string{v1beta1constants.GardenerOperation, v1beta1constants.GardenerMaintenanceOperation} {
+		if c.shoot.Annotations[annotation] != v1beta1constants.ShootOperationRestoreETCD || c.oldShoot.Annotations[annotation] == v1beta1constants.ShootOperationRestoreETCD {
+			continue
+		}
+
+		// The main etcd is restored from the snapshots in the backup bucket of the seed, hence a restore is only possible
+		// if the seed has a backup configured.
+		if c.seed == nil || c.seed.Spec.Backup == nil {
+			return admission.NewForbidden(a, fmt.Errorf("cannot restore ETCD of shoot because backup is not configured for its seed"))
+		}
+	}
+
+	return nil
+}
+
+func (c *validationContext) validateRemovedAPIsNotInUse(ctx context.Context, a admission.Attributes) error {
+	// Prevent Shoots from getting updated to the next minor Kubernetes version in case APIs which are removed in this
+	// version are still in use. Otherwise, workload still using these APIs would break after the update.
+	if a.GetOperation() != admission.Update {
+		return nil
+	}
+
+	oldVersion, err := semver.NewVersion(c.oldShoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil
+	}
+	newVersion, err := semver.NewVersion(c.shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil
+	}
+
+	if newVersion.Major() == oldVersion.Major() && newVersion.Minor() <= oldVersion.Minor() {
+		return nil
+	}
+
+	// The constraint is read from the old shoot, as the status of the new shoot is controlled by the requester.
+	removedAPIsConstraint := helper.GetCondition(c.oldShoot.Status.Constraints, core.ShootNoRemovedAPIsInUse)
+	if removedAPIsConstraint == nil || removedAPIsConstraint.Status != core.ConditionFalse {
+		return nil
+	}
+
+	// The shoot maintenance forcefully updates expired Kubernetes versions to the next minor version. Such updates must
+	// not be blocked as the expired version is about to be removed from the cloud profile.
+	if c.isKubernetesVersionExpired(c.oldShoot.Spec.Kubernetes.Version) {
+		warning.AddWarning(ctx, "", fmt.Sprintf("'%s' constraint is '%s' but check is skipped because Kubernetes version %q is expired: %s", core.ShootNoRemovedAPIsInUse, removedAPIsConstraint.Status, c.oldShoot.Spec.Kubernetes.Version, removedAPIsConstraint.Message))
+		return nil
+	}
+
+	if kubernetesutils.HasMetaDataAnnotation(c.shoot, v1beta1constants.AnnotationShootSkipRemovedAPIsCheck, "true") {
+		warning.AddWarning(ctx, "", fmt.Sprintf("'%s' constraint is '%s' but check is skipped because of annotation %s: %s", core.ShootNoRemovedAPIsInUse, removedAPIsConstraint.Status, v1beta1constants.AnnotationShootSkipRemovedAPIsCheck, removedAPIsConstraint.Message))
+		return nil
+	}
+
+	return admission.NewForbidden(a, fmt.Errorf("cannot update Kubernetes version from %q to %q, '%s' constraint is '%s': %s (annotate the shoot with %s=true to update anyway)",
+		c.oldShoot.Spec.Kubernetes.Version, c.shoot.Spec.Kubernetes.Version, core.ShootNoRemovedAPIsInUse, removedAPIsConstraint.Status, removedAPIsConstraint.Message, v1beta1constants.AnnotationShootSkipRemovedAPIsCheck))
+}
+
+func (c *validationContext) isKubernetesVersionExpired(version string) bool {
+	for _, expirableVersion := range c.cloudProfileSpec.Kubernetes.Versions {
+		if expirableVersion.Version == version {
+			return expirableVersion.ExpirationDate != nil && expirableVersion.ExpirationDate.Time.UTC().Before(time.Now().UTC())
+		}
+	}
+	return false
+}
+
 func (c *validationContext) validateSecretBindingToCredentialsBindingMigration(
 	a admission.Attributes,
 	secretBindingLister gardencorev1beta1listers.SecretBindingLister,
@@ -1129,6 +1209,8 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 					allErrs = append(allErrs, err)
 				}
 			}
+
+			allErrs = append(allErrs, c.validateAlternativeMachineTypes(worker, oldWorker, idxPath.Child("machine", "alternativeTypes"))...)
 		}
 		isVolumePresentInCloudprofile, availableInAllZones, isUsableVolume, supportedVolumeTypes := validateVolumeTypes(c.cloudProfileSpec.VolumeTypes, worker.Volume, oldWorker.Volume, c.cloudProfileSpec.Regions, c.shoot.Spec.Region, worker.Zones)
 		if !isVolumePresentInCloudprofile {
@@ -1441,6 +1523,60 @@ func validateKubernetesVersionConstraints(a admission.Attributes, constraints []
 	return allErrs
 }
 
+// validateAlternativeMachineTypes validates that the alternative machine types of the given worker pool are usable
+// like its machine type and that the machine image of the worker pool supports their architectures.
+func (c *validationContext) validateAlternativeMachineTypes(worker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, alternativeType := range worker.Machine.AlternativeTypes {
+		var (
+			idxPath      = fldPath.Index(i)
+			architecture = ptr.Deref(alternativeType.Architecture, *worker.Machine.Architecture)
+			machine      = core.Machine{Type: alternativeType.Type, Architecture: &architecture}
+			oldMachine   = core.Machine{}
+		)
+
+		if !slices.Contains(v1beta1constants.ValidArchitectures, architecture) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("architecture"), architecture, v1beta1constants.ValidArchitectures))
+			continue
+		}
+
+		for _, oldAlternativeType := range oldWorker.Machine.AlternativeTypes {
+			if oldAlternativeType.Type == alternativeType.Type {
+				oldMachine = core.Machine{Type: oldAlternativeType.Type, Architecture: ptr.To(ptr.Deref(oldAlternativeType.Architecture, ptr.Deref(oldWorker.Machine.Architecture, "")))}
+				break
+			}
+		}
+
+		isMachinePresentInCloudprofile, architectureSupported, availableInAllZones, isUsableMachine, supportedMachineTypes := validateMachineTypes(c.cloudProfileSpec.MachineTypes, machine, oldMachine, c.cloudProfileSpec.Regions, c.shoot.Spec.Region, worker.Zones)
+		if !isMachinePresentInCloudprofile {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), alternativeType.Type, supportedMachineTypes))
+		} else if !architectureSupported || !availableInAllZones || !isUsableMachine {
+			detail := fmt.Sprintf("machine type %q ", alternativeType.Type)
+			if !isUsableMachine {
+				detail += "is unusable, "
+			}
+			if !availableInAllZones {
+				detail += "is unavailable in at least one zone, "
+			}
+			if !architectureSupported {
+				detail += fmt.Sprintf("does not support CPU architecture %q, ", architecture)
+			}
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("type"), alternativeType.Type, fmt.Sprintf("%ssupported types are %+v", detail, supportedMachineTypes)))
+		}
+
+		if worker.Machine.Image == nil || (apiequality.Semantic.DeepEqual(worker.Machine.Image, oldWorker.Machine.Image) && ptr.Equal(machine.Architecture, oldMachine.Architecture)) {
+			continue
+		}
+
+		if machineImageVersion, ok := v1beta1helper.FindMachineImageVersion(c.cloudProfileSpec.MachineImages, worker.Machine.Image.Name, worker.Machine.Image.Version); ok && !slices.Contains(machineImageVersion.Architectures, architecture) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("architecture"), architecture, fmt.Sprintf("machine image version '%s:%s' does not support CPU architecture %q", worker.Machine.Image.Name, worker.Machine.Image.Version, architecture)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateMachineTypes(constraints []gardencorev1beta1.MachineType, machine, oldMachine core.Machine, regions []gardencorev1beta1.Region, region string, zones []string) (bool, bool, bool, bool, []string) {
 	if machine.Type == oldMachine.Type && ptr.Equal(machine.Architecture, oldMachine.Architecture) {
 		return true, true, true, true, nil
@@ -1645,7 +1781,7 @@ func validateZone(constraints []gardencorev1beta1.Region, region, zone string) (
 }
 
 // getDefaultMachineImage determines the latest non-preview machine image version from the first machine image in the CloudProfile and considers that as the default image
-func getDefaultMachineImage(machineImages []gardencorev1beta1.MachineImage, imageName string, arch *string, fldPath *field.Path) (*core.ShootMachineImage, *field.Error) {
+func getDefaultMachineImage(machineImages []gardencorev1beta1.MachineImage, imageName string, architectures []string, fldPath *field.Path) (*core.ShootMachineImage, *field.Error) {
 	if len(machineImages) == 0 {
 		return nil, field.Invalid(fldPath, imageName, "the cloud profile does not contain any machine image - cannot create shoot cluster")
 	}
@@ -1669,7 +1805,7 @@ func getDefaultMachineImage(machineImages []gardencorev1beta1.MachineImage, imag
 			return nil, field.Invalid(fldPath, imageName, "image is not supported")
 		}
 	} else {
-		// select the first image which support the required architecture type
+		// select the first image which supports the required architecture types
 		for _, mi := range machineImages {
 			machineImage := mi
 			for _, version := range machineImage.Versions {
@@ -1678,7 +1814,7 @@ func getDefaultMachineImage(machineImages []gardencorev1beta1.MachineImage, imag
 					return nil, field.Invalid(fldPath, machineImage.Name, fmt.Sprintf("failed to convert machine image from cloud profile: %s", err.Error()))
 				}
 
-				if slices.Contains(version.Architectures, *arch) {
+				if gardenerutils.SupportsArchitectures(version.Architectures, architectures) {
 					defaultImage = coreMachineImage
 					break
 				}
@@ -1688,14 +1824,14 @@ func getDefaultMachineImage(machineImages []gardencorev1beta1.MachineImage, imag
 			}
 		}
 		if defaultImage == nil {
-			return nil, field.Invalid(fldPath, imageName, fmt.Sprintf("no valid machine image found that support architecture `%s`", *arch))
+			return nil, field.Invalid(fldPath, imageName, fmt.Sprintf("no valid machine image found that support architecture `%s`", strings.Join(architectures, "`, `")))
 		}
 	}
 
 	var validVersions []core.MachineImageVersion
 
 	for _, version := range defaultImage.Versions {
-		if slices.Contains(version.Architectures, *arch) {
+		if gardenerutils.SupportsArchitectures(version.Architectures, architectures) {
 			validVersions = append(validVersions, version)
 		}
 	}
@@ -1885,7 +2021,7 @@ func ensureMachineImage(oldWorkers []core.Worker, worker core.Worker, images []g
 		imageName = worker.Machine.Image.Name
 	}
 
-	return getDefaultMachineImage(images, imageName, worker.Machine.Architecture, fldPath)
+	return getDefaultMachineImage(images, imageName, helper.GetMachineArchitectures(worker.Machine), fldPath)
 }
 
 func addInfrastructureDeploymentTask(shoot *core.Shoot) {
@@ -23,6 +23,7 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -578,6 +579,139 @@ var _ = Describe("validator", func() {
 			)
 		})
 
+		Context("etcd restore checks", func() {
+			var (
+				oldShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				shoot = *shootBase.DeepCopy()
+				oldShoot = shoot.DeepCopy()
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			DescribeTable("should allow/deny restoring the etcd according to the backup configuration of the seed",
+				func(annotation string, backup *gardencorev1beta1.SeedBackup, match types.GomegaMatcher) {
+					seed.Spec.Backup = backup
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, annotation, "restore-etcd")
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+					Expect(err).To(match)
+				},
+				Entry("should allow operation if seed has backup", "gardener.cloud/operation", &gardencorev1beta1.SeedBackup{}, Not(HaveOccurred())),
+				Entry("should deny operation if seed has no backup", "gardener.cloud/operation", nil, MatchError(ContainSubstring("backup is not configured"))),
+				Entry("should allow maintenance operation if seed has backup", "maintenance.gardener.cloud/operation", &gardencorev1beta1.SeedBackup{}, Not(HaveOccurred())),
+				Entry("should deny maintenance operation if seed has no backup", "maintenance.gardener.cloud/operation", nil, MatchError(ContainSubstring("backup is not configured"))),
+			)
+		})
+
+		Context("removed APIs checks", func() {
+			var (
+				oldShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				cloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.29.3"}, {Version: "1.30.1"}}
+
+				shoot = *shootBase.DeepCopy()
+				shoot.Spec.Kubernetes.Version = "1.30.1"
+				oldShoot = shoot.DeepCopy()
+				oldShoot.Spec.Kubernetes.Version = "1.29.3"
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			DescribeTable("should allow/deny updating the Kubernetes minor version according to NoRemovedAPIsInUse constraint",
+				func(constraints []core.Condition, match types.GomegaMatcher) {
+					oldShoot.Status.Constraints = constraints
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+					Expect(err).To(match)
+				},
+				Entry("should allow if set to True", []core.Condition{
+					{
+						Type:   core.ShootNoRemovedAPIsInUse,
+						Status: core.ConditionTrue,
+					},
+				}, Not(HaveOccurred())),
+				Entry("should deny if set to False", []core.Condition{
+					{
+						Type:    core.ShootNoRemovedAPIsInUse,
+						Status:  core.ConditionFalse,
+						Message: "foo",
+					},
+				}, And(HaveOccurred(), MatchError(ContainSubstring("foo")))),
+				Entry("should allow if set to Unknown", []core.Condition{
+					{
+						Type:    core.ShootNoRemovedAPIsInUse,
+						Status:  core.ConditionUnknown,
+						Message: "foo",
+					},
+				}, Not(HaveOccurred())),
+				Entry("should allow if unset", []core.Condition{}, Not(HaveOccurred())),
+			)
+
+			It("should deny updating the Kubernetes minor version if set to False in the old shoot but removed in the update", func() {
+				oldShoot.Status.Constraints = []core.Condition{{Type: core.ShootNoRemovedAPIsInUse, Status: core.ConditionFalse, Message: "foo"}}
+				shoot.Status.Constraints = nil
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(MatchError(ContainSubstring("foo")))
+			})
+
+			It("should allow updating the Kubernetes patch version if set to False", func() {
+				oldShoot.Spec.Kubernetes.Version = "1.30.0"
+				cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, gardencorev1beta1.ExpirableVersion{Version: "1.30.0"})
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+				oldShoot.Status.Constraints = []core.Condition{{Type: core.ShootNoRemovedAPIsInUse, Status: core.ConditionFalse}}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should allow updating the Kubernetes minor version with a warning if set to False and the check is skipped", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/skip-removed-apis-check", "true")
+				oldShoot.Status.Constraints = []core.Condition{{Type: core.ShootNoRemovedAPIsInUse, Status: core.ConditionFalse, Message: "foo"}}
+
+				recorder := &fakeWarningRecorder{}
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(warning.WithWarningRecorder(ctx, recorder), attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf(ContainSubstring("foo")))
+			})
+
+			It("should allow updating the Kubernetes minor version with a warning if set to False and the old version is expired", func() {
+				cloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.29.3", ExpirationDate: &metav1.Time{Time: metav1.Now().Add(-time.Hour)}}, {Version: "1.30.1"}}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+				oldShoot.Status.Constraints = []core.Condition{{Type: core.ShootNoRemovedAPIsInUse, Status: core.ConditionFalse, Message: "foo"}}
+
+				recorder := &fakeWarningRecorder{}
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(warning.WithWarningRecorder(ctx, recorder), attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf(And(ContainSubstring("is expired"), ContainSubstring("foo"))))
+			})
+
+			It("should deny updating the Kubernetes minor version if set to False and the old version is not yet expired", func() {
+				cloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.29.3", ExpirationDate: &metav1.Time{Time: metav1.Now().Add(time.Hour)}}, {Version: "1.30.1"}}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+				oldShoot.Status.Constraints = []core.Condition{{Type: core.ShootNoRemovedAPIsInUse, Status: core.ConditionFalse, Message: "foo"}}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(MatchError(ContainSubstring("foo")))
+			})
+		})
+
 		Context("shoot maintenance checks", func() {
 			var (
 				oldShoot           *core.Shoot
@@ -4535,6 +4669,72 @@ var _ = Describe("validator", func() {
 					Expect(err).To(BeForbiddenError())
 					Expect(err).To(MatchError(ContainSubstring("machine type %q is unusable, is unavailable in at least one zone, does not support CPU architecture %q, supported types are [%s]", "machine-type-1", architecture, "machine-type-2")))
 				})
+
+				Context("alternative machine types", func() {
+					It("should allow usable alternative machine types with different architectures", func() {
+						shoot.Spec.Provider.Workers[0].Machine.AlternativeTypes = []core.AlternativeMachineType{
+							{Type: "machine-type-2"},
+							{Type: "machine-type-3", Architecture: ptr.To("arm64")},
+						}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should reject alternative machine types which are not present in the cloud profile", func() {
+						shoot.Spec.Provider.Workers[0].Machine.AlternativeTypes = []core.AlternativeMachineType{{Type: "not-present-in-cloudprofile"}}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(And(
+							ContainSubstring("spec.provider.workers[0].machine.alternativeTypes[0].type"),
+							ContainSubstring("Unsupported value: %q", "not-present-in-cloudprofile"),
+						)))
+					})
+
+					It("should reject alternative machine types which do not support the architecture", func() {
+						shoot.Spec.Provider.Workers[0].Machine.AlternativeTypes = []core.AlternativeMachineType{{Type: "machine-type-3"}}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("machine type %q does not support CPU architecture %q", "machine-type-3", "amd64")))
+					})
+
+					It("should reject alternative machine types whose architecture is not supported by the machine image", func() {
+						shoot.Spec.Provider.Workers[0].Machine.Image = &core.ShootMachineImage{Name: validMachineImageName, Version: "0.0.1"}
+						shoot.Spec.Provider.Workers[0].Machine.AlternativeTypes = []core.AlternativeMachineType{{Type: "machine-type-3", Architecture: ptr.To("arm64")}}
+						cloudProfile.Spec.MachineImages[0].Versions[0].Architectures = []string{"amd64"}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("machine image version '%s:0.0.1' does not support CPU architecture %q", validMachineImageName, "arm64")))
+					})
+
+					It("should default the machine image to a version supporting all architectures", func() {
+						shoot.Spec.Provider.Workers[0].Machine.AlternativeTypes = []core.AlternativeMachineType{{Type: "machine-type-3", Architecture: ptr.To("arm64")}}
+						cloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{{
+							Name: validMachineImageName,
+							Versions: []gardencorev1beta1.MachineImageVersion{
+								{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}, Architectures: []string{"amd64", "arm64"}},
+								{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0"}, Architectures: []string{"amd64"}},
+							},
+						}}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).NotTo(HaveOccurred())
+						Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(&core.ShootMachineImage{Name: validMachineImageName, Version: "1.0.0"}))
+					})
+				})
 			})
 
 			Context("volume checks", func() {
@@ -5333,3 +5533,11 @@ var _ = Describe("validator", func() {
 		})
 	})
 })
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (f *fakeWarningRecorder) AddWarning(_, text string) {
+	f.warnings = append(f.warnings, text)
+}
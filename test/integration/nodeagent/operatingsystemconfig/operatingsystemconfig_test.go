@@ -16,11 +16,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -62,6 +64,7 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		imageMountDirectory                string
 		cancelFunc                         cancelFuncEnsurer
 		pathBootstrapTokenFile             = filepath.Join("/", "var", "lib", "gardener-node-agent", "credentials", "bootstrap-token")
+		pathAttestedClientCertFile         = filepath.Join("/", "var", "lib", "gardener-node-agent", "credentials", "attested-client.pem")
 		pathKubeletBootstrapKubeconfigFile = filepath.Join("/", "var", "lib", "kubelet", "kubeconfig-bootstrap")
 	)
 
@@ -90,8 +93,9 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: testRunID,
 				Labels: map[string]string{
-					testID:                   testRunID,
-					"kubernetes.io/hostname": hostName,
+					testID:                       testRunID,
+					"kubernetes.io/hostname":     hostName,
+					"worker.gardener.cloud/pool": testRunID,
 				},
 			},
 		}
@@ -380,6 +384,13 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 			Expect(fakeFS.Remove(pathBootstrapTokenFile)).To(Or(Succeed(), MatchError(afero.ErrFileNotFound)))
 		})
 
+		By("Create attested client certificate file")
+		_, err = fakeFS.Create(pathAttestedClientCertFile)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			Expect(fakeFS.Remove(pathAttestedClientCertFile)).To(Or(Succeed(), MatchError(afero.ErrFileNotFound)))
+		})
+
 		By("Create kubelet bootstrap kubeconfig file")
 		_, err = fakeFS.Create(pathKubeletBootstrapKubeconfigFile)
 		Expect(err).NotTo(HaveOccurred())
@@ -463,6 +474,7 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		By("Assert that bootstrap files have been deleted")
 		test.AssertNoFileOnDisk(fakeFS, pathKubeletBootstrapKubeconfigFile)
 		test.AssertNoFileOnDisk(fakeFS, pathBootstrapTokenFile)
+		test.AssertNoFileOnDisk(fakeFS, pathAttestedClientCertFile)
 
 		By("Assert that cancel func has not been called")
 		Expect(cancelFunc.called).To(BeFalse())
@@ -832,6 +844,164 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		Expect(cancelFunc.called).To(BeTrue())
 	})
 
+	Context("when the node is updated in-place", func() {
+		var lease *coordinationv1.Lease
+
+		BeforeEach(func() {
+			operatingSystemConfig.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+				OperatingSystemVersion: "1.0.0",
+				KubeletVersion:         kubernetesVersion.String(),
+				MaxUnavailable:         intstr.FromInt32(1),
+			}
+
+			Expect(fakeFS.WriteFile("/etc/os-release", []byte("NAME=\"Fake OS\"\nVERSION_ID=\"1.0.0\"\n"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile("/proc/sys/kernel/random/boot_id", []byte("boot-1\n"), 0444)).To(Succeed())
+
+			lease = &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-in-place-update-" + testRunID, Namespace: metav1.NamespaceSystem}}
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(testClient.Delete(ctx, lease))).To(Succeed())
+			})
+		})
+
+		readLastAppliedOSC := func() []byte {
+			var lastAppliedOSC []byte
+			Eventually(func() error {
+				var err error
+				lastAppliedOSC, err = fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")
+				return err
+			}).Should(Succeed())
+			return lastAppliedOSC
+		}
+
+		updateOperatingSystemConfig := func() {
+			fakeDBus.Actions = nil // reset actions on dbus to not repeat assertions from above for update scenario
+
+			var err error
+			oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Update Secret containing the operating system config")
+			patch := client.MergeFrom(oscSecret.DeepCopy())
+			oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(oscRaw)
+			oscSecret.Data["osc.yaml"] = oscRaw
+			Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+		}
+
+		// drainNode simulates the node-drain controller of gardener-resource-manager.
+		drainNode := func() {
+			By("Wait for node drain to be requested")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				return node.Annotations
+			}).Should(HaveKeyWithValue("worker.gardener.cloud/drain", "requested"))
+
+			By("Drain node")
+			patch := client.MergeFrom(node.DeepCopy())
+			node.Spec.Unschedulable = true
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "worker.gardener.cloud/drain", "completed")
+			Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+		}
+
+		It("should update the operating system and persist the last applied config only after the reboot", func() {
+			var executedCommands []string
+			DeferCleanup(test.WithVar(&operatingsystemconfig.Exec, func(_ context.Context, command string, args ...string) ([]byte, error) {
+				executedCommands = append(executedCommands, strings.Join(append([]string{command}, args...), " "))
+				return []byte(""), nil
+			}))
+
+			lastAppliedOSC := readLastAppliedOSC()
+
+			By("Update operating system version")
+			operatingSystemConfig.Spec.InPlaceUpdates.OperatingSystemVersion = "1.1.0"
+			operatingSystemConfig.Status.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdatesStatus{
+				OSUpdate: &extensionsv1alpha1.OSUpdate{Command: "/usr/bin/os-update", Args: []string{"--version", "1.1.0"}},
+			}
+			updateOperatingSystemConfig()
+			drainNode()
+
+			By("Assert that the update command has been executed and the node has been rebooted")
+			Eventually(func() []fakedbus.SystemdAction { return fakeDBus.Actions }).WithTimeout(30 * time.Second).Should(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+			Expect(executedCommands).To(ConsistOf("/usr/bin/os-update --version 1.1.0"))
+			Expect(fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")).To(Equal(lastAppliedOSC))
+
+			By("Assert that the node is marked as being updated")
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+			Expect(node.Labels).To(HaveKeyWithValue("worker.gardener.cloud/in-place-update", "in-progress"))
+
+			By("Assert that the node holds an in-place update slot")
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+			Expect(lease.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/in-place-update-nodes", node.Name))
+
+			By("Simulate reboot into the new operating system version")
+			Expect(fakeFS.WriteFile("/etc/os-release", []byte("NAME=\"Fake OS\"\nVERSION_ID=\"1.1.0\"\n"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile("/proc/sys/kernel/random/boot_id", []byte("boot-2\n"), 0444)).To(Succeed())
+
+			By("Wait for the in-place update to be completed")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				return node.Labels
+			}).WithTimeout(30 * time.Second).ShouldNot(HaveKey("worker.gardener.cloud/in-place-update"))
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(node.Annotations).NotTo(HaveKey("worker.gardener.cloud/drain"))
+			Expect(executedCommands).To(HaveLen(1))
+			Expect(fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")).To(Equal(oscRaw))
+		})
+
+		It("should fail if the node does not run the new operating system version after the reboot", func() {
+			DeferCleanup(test.WithVar(&operatingsystemconfig.Exec, func(context.Context, string, ...string) ([]byte, error) {
+				return []byte(""), nil
+			}))
+
+			lastAppliedOSC := readLastAppliedOSC()
+
+			By("Update operating system version")
+			operatingSystemConfig.Spec.InPlaceUpdates.OperatingSystemVersion = "1.1.0"
+			operatingSystemConfig.Status.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdatesStatus{
+				OSUpdate: &extensionsv1alpha1.OSUpdate{Command: "/usr/bin/os-update", Args: []string{"--version", "1.1.0"}},
+			}
+			updateOperatingSystemConfig()
+			drainNode()
+
+			Eventually(func() []fakedbus.SystemdAction { return fakeDBus.Actions }).WithTimeout(30 * time.Second).Should(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+
+			By("Simulate reboot into the old operating system version")
+			Expect(fakeFS.WriteFile("/proc/sys/kernel/random/boot_id", []byte("boot-2\n"), 0444)).To(Succeed())
+
+			Consistently(func() ([]byte, error) {
+				return fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")
+			}).WithTimeout(15 * time.Second).Should(Equal(lastAppliedOSC))
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Labels).To(HaveKeyWithValue("worker.gardener.cloud/in-place-update", "in-progress"))
+		})
+
+		It("should update the kubelet without rebooting the node", func() {
+			lastAppliedOSC := readLastAppliedOSC()
+
+			By("Update kubelet version")
+			operatingSystemConfig.Spec.InPlaceUpdates.KubeletVersion = "1.2.4"
+			updateOperatingSystemConfig()
+			drainNode()
+
+			By("Wait for the in-place update to be completed")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				return node.Labels
+			}).WithTimeout(30 * time.Second).Should(And(
+				HaveKeyWithValue("worker.gardener.cloud/kubernetes-version", "1.2.4"),
+				Not(HaveKey("worker.gardener.cloud/in-place-update")),
+			))
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(node.Annotations).NotTo(HaveKey("worker.gardener.cloud/drain"))
+			Expect(fakeDBus.Actions).NotTo(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}))
+			Expect(fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")).NotTo(Equal(lastAppliedOSC))
+
+			By("Assert that the in-place update slot has been released")
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+			Expect(lease.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/in-place-update-nodes", ""))
+		})
+	})
+
 	Context("when CRI is not containerd", func() {
 		BeforeEach(func() {
 			operatingSystemConfig.Spec.CRIConfig = nil
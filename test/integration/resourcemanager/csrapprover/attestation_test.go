@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrapprover_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+	csrutil "k8s.io/client-go/util/certificate/csr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
+	localattestation "github.com/gardener/gardener/pkg/provider-local/attestation"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Attested Client CertificateSigningRequest Approver Controller tests", func() {
+	var (
+		privateKey         *ecdsa.PrivateKey
+		certificateSubject *pkix.Name
+		providerID         string
+		nonce              []byte
+
+		csr     *certificatesv1.CertificateSigningRequest
+		machine *machinev1alpha1.Machine
+	)
+
+	BeforeEach(func() {
+		var err error
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		providerID = "machine-" + testRunID
+		certificateSubject = &pkix.Name{
+			CommonName:   "gardener.cloud:system:node-agent:" + providerID,
+			Organization: []string{"gardener.cloud:system:attested-nodes"},
+		}
+
+		nonce, err = attestation.Nonce(&privateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		csr = &certificatesv1.CertificateSigningRequest{
+			// Username, UID, Groups will be injected by API server.
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: testID + "-",
+				Labels:       map[string]string{testID: testRunID},
+			},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				Usages: []certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature,
+					certificatesv1.UsageClientAuth,
+				},
+				SignerName:        certificatesv1.KubeAPIServerClientSignerName,
+				ExpirationSeconds: csrutil.DurationToExpirationSeconds(time.Hour),
+			},
+		}
+
+		machine = &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "machine-",
+				Namespace:    testNamespace.Name,
+				Labels:       map[string]string{testID: testRunID, "node": providerID},
+			},
+			Spec: machinev1alpha1.MachineSpec{
+				ProviderID: providerID,
+			},
+		}
+
+		DeferCleanup(test.WithVar(&testClient, bootstrapClient))
+	})
+
+	JustBeforeEach(func() {
+		By("Generate CSR data")
+		csrData, err := certutil.MakeCSR(privateKey, certificateSubject, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		csr.Spec.Request = csrData
+
+		By("Create attestation document")
+		if _, ok := csr.Annotations["node-agent.gardener.cloud/attestation-document"]; !ok {
+			document, err := (&localattestation.Provider{HostName: func() (string, error) { return providerID, nil }}).Attest(ctx, nonce)
+			Expect(err).NotTo(HaveOccurred())
+			metav1.SetMetaDataAnnotation(&csr.ObjectMeta, "node-agent.gardener.cloud/attestation-document", base64.StdEncoding.EncodeToString(document))
+		}
+
+		By("Create CertificateSigningRequest")
+		Expect(testClient.Create(ctx, csr)).To(Succeed())
+		log.Info("Created CertificateSigningRequest for test", "certificateSigningRequest", client.ObjectKeyFromObject(csr))
+
+		DeferCleanup(func() {
+			By("Delete CertificateSigningRequest")
+			Expect(client.IgnoreNotFound(testClient.Delete(ctx, csr))).To(Succeed())
+		})
+	})
+
+	Context("constraints fulfilled", func() {
+		BeforeEach(func() {
+			createMachine(machine)
+		})
+
+		It("should approve the CSR", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(csr), csr)).To(Succeed())
+				g.Expect(csr.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", certificatesv1.CertificateApproved),
+					HaveField("Reason", "RequestApproved"),
+					HaveField("Message", ContainSubstring("Approving gardener-node-agent attested client certificate CSR (attestation of machine")),
+				)))
+			}).Should(Succeed())
+		})
+	})
+
+	Context("constraints violated", func() {
+		runTest := func(expectedReason string) {
+			It("should deny the CSR", func() {
+				EventuallyWithOffset(1, func(g Gomega) {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(csr), csr)).To(Succeed())
+					g.Expect(csr.Status.Conditions).To(ContainElement(And(
+						HaveField("Type", certificatesv1.CertificateDenied),
+						HaveField("Reason", "RequestDenied"),
+						HaveField("Message", And(
+							ContainSubstring("Denying gardener-node-agent attested client certificate CSR"),
+							ContainSubstring(expectedReason),
+						)),
+					)))
+				}).Should(Succeed())
+			})
+		}
+
+		Context("username not prefixed with system:bootstrap:", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				DeferCleanup(test.WithVar(&testClient, mgrClient))
+			})
+
+			runTest(`is not prefixed with "system:bootstrap:"`)
+		})
+
+		Context("common name not prefixed", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				certificateSubject.CommonName = providerID
+			})
+
+			runTest("common name in CSR is not prefixed with")
+		})
+
+		Context("organization does not contain attested nodes group", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				certificateSubject.Organization = []string{"system:nodes"}
+			})
+
+			runTest("organization in CSR does not match attested nodes group")
+		})
+
+		Context("usages contain server auth", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				csr.Spec.Usages = append(csr.Spec.Usages, certificatesv1.UsageServerAuth)
+			})
+
+			runTest("key usages in CSR must contain 'client auth'")
+		})
+
+		Context("requested validity too long", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				csr.Spec.ExpirationSeconds = csrutil.DurationToExpirationSeconds(24 * time.Hour)
+			})
+
+			runTest("requested validity of CSR must not exceed 1h0m0s")
+		})
+
+		Context("attestation document bound to another key", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				nonce = []byte("other")
+			})
+
+			runTest("attestation document is invalid")
+		})
+
+		Context("attestation document cannot be decoded", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				metav1.SetMetaDataAnnotation(&csr.ObjectMeta, "node-agent.gardener.cloud/attestation-document", "%%%")
+			})
+
+			runTest("attestation document is missing or cannot be decoded")
+		})
+
+		Context("host name does not match node of machine", func() {
+			BeforeEach(func() {
+				machine.Labels["node"] = "other-node"
+				createMachine(machine)
+			})
+
+			runTest("does not match node name \"other-node\" of machine")
+		})
+
+		Context("machine not found", func() {
+			runTest("could not find machine with provider ID")
+		})
+	})
+})
+
+var _ = Describe("Attested Kubelet Client CertificateSigningRequest Approver Controller tests", func() {
+	var (
+		privateKey         *ecdsa.PrivateKey
+		certificateSubject *pkix.Name
+
+		csr     *certificatesv1.CertificateSigningRequest
+		machine *machinev1alpha1.Machine
+	)
+
+	BeforeEach(func() {
+		var err error
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		certificateSubject = &pkix.Name{
+			CommonName:   "system:node:" + nodeName,
+			Organization: []string{"system:nodes"},
+		}
+
+		csr = &certificatesv1.CertificateSigningRequest{
+			// Username, UID, Groups will be injected by API server.
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: testID + "-",
+				Labels:       map[string]string{testID: testRunID},
+			},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				Usages: []certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature,
+					certificatesv1.UsageClientAuth,
+				},
+				SignerName: certificatesv1.KubeAPIServerClientKubeletSignerName,
+			},
+		}
+
+		machine = &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "machine-",
+				Namespace:    testNamespace.Name,
+				Labels:       map[string]string{testID: testRunID, "node": nodeName},
+			},
+		}
+
+		DeferCleanup(test.WithVar(&testClient, attestedClient))
+	})
+
+	JustBeforeEach(func() {
+		By("Generate CSR data")
+		csrData, err := certutil.MakeCSR(privateKey, certificateSubject, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		csr.Spec.Request = csrData
+
+		By("Create CertificateSigningRequest")
+		Expect(testClient.Create(ctx, csr)).To(Succeed())
+		log.Info("Created CertificateSigningRequest for test", "certificateSigningRequest", client.ObjectKeyFromObject(csr))
+
+		DeferCleanup(func() {
+			By("Delete CertificateSigningRequest")
+			Expect(client.IgnoreNotFound(testClient.Delete(ctx, csr))).To(Succeed())
+		})
+	})
+
+	Context("constraints fulfilled", func() {
+		BeforeEach(func() {
+			createMachine(machine)
+		})
+
+		It("should approve the CSR", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(csr), csr)).To(Succeed())
+				g.Expect(csr.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", certificatesv1.CertificateApproved),
+					HaveField("Reason", "RequestApproved"),
+					HaveField("Message", ContainSubstring("Approving kubelet client certificate of attested machine CSR (node")),
+				)))
+			}).Should(Succeed())
+		})
+	})
+
+	Context("constraints violated", func() {
+		runTest := func(expectedReason string) {
+			It("should deny the CSR", func() {
+				EventuallyWithOffset(1, func(g Gomega) {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(csr), csr)).To(Succeed())
+					g.Expect(csr.Status.Conditions).To(ContainElement(And(
+						HaveField("Type", certificatesv1.CertificateDenied),
+						HaveField("Reason", "RequestDenied"),
+						HaveField("Message", And(
+							ContainSubstring("Denying kubelet client certificate of attested machine CSR"),
+							ContainSubstring(expectedReason),
+						)),
+					)))
+				}).Should(Succeed())
+			})
+		}
+
+		Context("common name of another node", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				certificateSubject.CommonName = "system:node:other-node"
+			})
+
+			runTest("common name in CSR does not match attested node user")
+		})
+
+		Context("organization does not contain nodes group", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				certificateSubject.Organization = []string{"gardener.cloud:system:attested-nodes"}
+			})
+
+			runTest("organization in CSR does not match nodes group")
+		})
+
+		Context("usages contain server auth", func() {
+			BeforeEach(func() {
+				createMachine(machine)
+				csr.Spec.Usages = append(csr.Spec.Usages, certificatesv1.UsageServerAuth)
+			})
+
+			runTest("key usages in CSR must contain 'client auth'")
+		})
+
+		Context("machine not found", func() {
+			runTest("Expected exactly one machine")
+		})
+	})
+})
@@ -6,8 +6,13 @@ package csrapprover_test
 
 import (
 	"context"
+	"encoding/pem"
+	"errors"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -29,6 +34,8 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/nodeagent/attestation"
+	localattestation "github.com/gardener/gardener/pkg/provider-local/attestation"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/csrapprover"
@@ -58,6 +65,9 @@ var (
 
 	nodeName string
 	userName string
+
+	bootstrapClient client.Client
+	attestedClient  client.Client
 )
 
 var _ = BeforeSuite(func() {
@@ -102,6 +112,29 @@ var _ = BeforeSuite(func() {
 	testClient, err = client.New(user.Config(), client.Options{Scheme: resourcemanagerclient.CombinedScheme})
 	Expect(err).NotTo(HaveOccurred())
 
+	// Similarly, CSRs for attested client certificates are created by gardener-node-agent with a bootstrap token.
+	bootstrapUser, err := testEnv.AddUser(
+		envtest.User{Name: "system:bootstrap:" + testRunID[:6], Groups: []string{userpkg.SystemPrivilegedGroup}},
+		&rest.Config{QPS: 1000.0, Burst: 2000.0},
+	)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(bootstrapUser).NotTo(BeNil())
+
+	bootstrapClient, err = client.New(bootstrapUser.Config(), client.Options{Scheme: resourcemanagerclient.CombinedScheme})
+	Expect(err).NotTo(HaveOccurred())
+
+	// CSRs for kubelet client certificates of attested machines are created by kubelet with the attested client
+	// certificate.
+	attestedUser, err := testEnv.AddUser(
+		envtest.User{Name: "gardener.cloud:system:node-agent:" + nodeName, Groups: []string{userpkg.SystemPrivilegedGroup, "gardener.cloud:system:attested-nodes"}},
+		&rest.Config{QPS: 1000.0, Burst: 2000.0},
+	)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(attestedUser).NotTo(BeNil())
+
+	attestedClient, err = client.New(attestedUser.Config(), client.Options{Scheme: resourcemanagerclient.CombinedScheme})
+	Expect(err).NotTo(HaveOccurred())
+
 	By("Create test Namespace")
 	testNamespace = &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -129,6 +162,16 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	mgrClient = mgr.GetClient()
 
+	By("Start attestation verifier")
+	verifierServer := httptest.NewTLSServer(attestation.NewVerifierHandler(localattestation.NewProvider(), staticTokenAuthenticator("verifier-token")))
+	DeferCleanup(verifierServer.Close)
+
+	verifierDir := GinkgoT().TempDir()
+	verifierCAFile := filepath.Join(verifierDir, "bundle.crt")
+	Expect(os.WriteFile(verifierCAFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: verifierServer.Certificate().Raw}), 0600)).To(Succeed())
+	verifierTokenFile := filepath.Join(verifierDir, "token")
+	Expect(os.WriteFile(verifierTokenFile, []byte("verifier-token"), 0600)).To(Succeed())
+
 	By("Register controller")
 	kubernetesClient, err := kubernetesclientset.NewForConfig(restConfig)
 	Expect(err).NotTo(HaveOccurred())
@@ -136,8 +179,16 @@ var _ = BeforeSuite(func() {
 	Expect((&csrapprover.Reconciler{
 		CertificatesClient: kubernetesClient.CertificatesV1().CertificateSigningRequests(),
 		Config: config.KubeletCSRApproverControllerConfig{
-			ConcurrentSyncs:  ptr.To(5),
-			MachineNamespace: testNamespace.Name,
+			ConcurrentSyncs:                       ptr.To(5),
+			MachineNamespace:                      testNamespace.Name,
+			NodeAgentAttestationVerifierURL:       ptr.To(verifierServer.URL + attestation.VerifierPath),
+			NodeAgentAttestationVerifierCAFile:    ptr.To(verifierCAFile),
+			NodeAgentAttestationVerifierTokenFile: ptr.To(verifierTokenFile),
+			ServingCertificatePolicy: &config.KubeletServingCertificatePolicy{
+				AllowedDNSNamePatterns: []string{`(foo|bar|baz)\.(foo|bar|baz)`},
+				AllowedIPRanges:        []string{"1.2.3.0/24", "5.6.7.0/24", "9.0.1.0/24"},
+				MaxValidity:            &metav1.Duration{Duration: 48 * time.Hour},
+			},
 		},
 	}).AddToManager(mgr, mgr, mgr)).To(Succeed())
 
@@ -154,3 +205,12 @@ var _ = BeforeSuite(func() {
 		mgrCancel()
 	})
 })
+
+type staticTokenAuthenticator string
+
+func (s staticTokenAuthenticator) Authenticate(_ context.Context, token string) error {
+	if token != string(s) {
+		return errors.New("unexpected token")
+	}
+	return nil
+}
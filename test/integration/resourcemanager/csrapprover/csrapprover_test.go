@@ -9,6 +9,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509/pkix"
 	"net"
+	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -18,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/authentication/user"
 	certutil "k8s.io/client-go/util/cert"
+	csrutil "k8s.io/client-go/util/certificate/csr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
@@ -68,7 +70,8 @@ var _ = Describe("Kubelet Server CertificateSigningRequest Approver Controller t
 					certificatesv1.UsageKeyEncipherment,
 					certificatesv1.UsageClientAuth,
 				},
-				SignerName: certificatesv1.KubeletServingSignerName,
+				SignerName:        certificatesv1.KubeletServingSignerName,
+				ExpirationSeconds: csrutil.DurationToExpirationSeconds(24 * time.Hour),
 			},
 		}
 
@@ -146,6 +149,18 @@ var _ = Describe("Kubelet Server CertificateSigningRequest Approver Controller t
 					)))
 				}).Should(Succeed())
 			})
+
+			It("should record an event for the decision", func() {
+				Eventually(func(g Gomega) {
+					eventList := &corev1.EventList{}
+					g.Expect(testClient.List(ctx, eventList, client.InNamespace(metav1.NamespaceDefault), client.MatchingFields{"involvedObject.name": csr.Name})).To(Succeed())
+					g.Expect(eventList.Items).To(ContainElement(And(
+						HaveField("Type", corev1.EventTypeNormal),
+						HaveField("Reason", "RequestApproved"),
+						HaveField("Message", ContainSubstring(`Approving kubelet server certificate CSR (all checks passed), requested by user "`+userName+`"`)),
+					)))
+				}).Should(Succeed())
+			})
 		})
 
 		Context("constraints violated", func() {
@@ -263,6 +278,38 @@ var _ = Describe("Kubelet Server CertificateSigningRequest Approver Controller t
 
 				runTest("IP addresses in CSR do not match addresses of type 'InternalIP' or 'ExternalIP' in node object")
 			})
+
+			Context("DNS name not allowed by policy", func() {
+				BeforeEach(func() {
+					dnsNames = append(dnsNames, "foo.evil.com")
+				})
+
+				runTest(`DNS name "foo.evil.com" in CSR does not match any allowed pattern`)
+			})
+
+			Context("IP address not allowed by policy", func() {
+				BeforeEach(func() {
+					ips = append(ips, net.ParseIP("10.0.0.1"))
+				})
+
+				runTest(`IP address "10.0.0.1" in CSR is not contained in any allowed IP range`)
+			})
+
+			Context("requested validity exceeds policy", func() {
+				BeforeEach(func() {
+					csr.Spec.ExpirationSeconds = csrutil.DurationToExpirationSeconds(72 * time.Hour)
+				})
+
+				runTest("requested validity of CSR must not exceed 48h0m0s")
+			})
+
+			Context("no requested validity while policy limits validity", func() {
+				BeforeEach(func() {
+					csr.Spec.ExpirationSeconds = nil
+				})
+
+				runTest("CSR must request a validity of at most 48h0m0s")
+			})
 		})
 	})
 })